@@ -10,8 +10,10 @@ import (
 
 	_ "github.com/provenance-io/provenance/client/docs/statik" // registers swagger-ui files with statik
 	"github.com/provenance-io/provenance/internal/antewrapper"
+	"github.com/provenance-io/provenance/internal/querythrottle"
 	"github.com/provenance-io/provenance/internal/statesync"
 
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
@@ -103,6 +105,7 @@ import (
 	// PROVENANCE
 	appparams "github.com/provenance-io/provenance/app/params"
 	"github.com/provenance-io/provenance/x/marker"
+	"github.com/provenance-io/provenance/x/marker/ibcratelimit"
 	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	markerwasm "github.com/provenance-io/provenance/x/marker/wasm"
@@ -122,6 +125,18 @@ import (
 	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 	metadatawasm "github.com/provenance-io/provenance/x/metadata/wasm"
 
+	"github.com/provenance-io/provenance/x/featureflags"
+	featureflagskeeper "github.com/provenance-io/provenance/x/featureflags/keeper"
+	featureflagstypes "github.com/provenance-io/provenance/x/featureflags/types"
+
+	"github.com/provenance-io/provenance/x/resolver"
+	resolverkeeper "github.com/provenance-io/provenance/x/resolver/keeper"
+	resolvertypes "github.com/provenance-io/provenance/x/resolver/types"
+
+	"github.com/provenance-io/provenance/x/smartaccounts"
+	smartaccountskeeper "github.com/provenance-io/provenance/x/smartaccounts/keeper"
+	smartaccountstypes "github.com/provenance-io/provenance/x/smartaccounts/types"
+
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmclient "github.com/CosmWasm/wasmd/x/wasm/client"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -184,6 +199,9 @@ var (
 		attribute.AppModuleBasic{},
 		name.AppModuleBasic{},
 		metadata.AppModuleBasic{},
+		smartaccounts.AppModuleBasic{},
+		featureflags.AppModuleBasic{},
+		resolver.AppModuleBasic{},
 		wasm.AppModuleBasic{},
 	)
 
@@ -199,6 +217,7 @@ var (
 		ibctransfertypes.ModuleName: {authtypes.Minter, authtypes.Burner},
 
 		markertypes.ModuleName: {authtypes.Minter, authtypes.Burner},
+		nametypes.ModuleName:   nil,
 		wasm.ModuleName:        {authtypes.Burner},
 	}
 )
@@ -255,11 +274,14 @@ type App struct {
 	IBCKeeper      *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	TransferKeeper ibctransferkeeper.Keeper
 
-	MarkerKeeper    markerkeeper.Keeper
-	MetadataKeeper  metadatakeeper.Keeper
-	AttributeKeeper attributekeeper.Keeper
-	NameKeeper      namekeeper.Keeper
-	WasmKeeper      wasm.Keeper
+	MarkerKeeper        markerkeeper.Keeper
+	MetadataKeeper      metadatakeeper.Keeper
+	AttributeKeeper     attributekeeper.Keeper
+	NameKeeper          namekeeper.Keeper
+	SmartAccountsKeeper smartaccountskeeper.Keeper
+	FeatureFlagsKeeper  featureflagskeeper.Keeper
+	ResolverKeeper      resolverkeeper.Keeper
+	WasmKeeper          wasm.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -273,6 +295,13 @@ type App struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// throttle of the provenance queries served by the grpc server, nil unless degraded query mode is enabled
+	queryThrottle *querythrottle.Throttle
+
+	// the wasm code directory and features, used to restore wasm code from state sync snapshots
+	wasmDir      string
+	wasmFeatures string
 }
 
 func init() {
@@ -317,6 +346,8 @@ func New(
 		markertypes.StoreKey,
 		attributetypes.StoreKey,
 		nametypes.StoreKey,
+		smartaccountstypes.StoreKey,
+		featureflagstypes.StoreKey,
 		wasm.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
@@ -391,20 +422,33 @@ func New(
 		keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter(),
 	)
 
+	app.FeatureFlagsKeeper = featureflagskeeper.NewKeeper(appCodec, keys[featureflagstypes.StoreKey])
+
 	app.MetadataKeeper = metadatakeeper.NewKeeper(
 		appCodec, keys[metadatatypes.StoreKey], app.GetSubspace(metadatatypes.ModuleName), app.AccountKeeper,
-	)
-
-	app.MarkerKeeper = markerkeeper.NewKeeper(
-		appCodec, keys[markertypes.StoreKey], app.GetSubspace(markertypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.AuthzKeeper, keys[banktypes.StoreKey],
+		app.FeatureFlagsKeeper,
 	)
 
 	app.NameKeeper = namekeeper.NewKeeper(
-		appCodec, keys[nametypes.StoreKey], app.GetSubspace(nametypes.ModuleName),
+		appCodec, keys[nametypes.StoreKey], app.GetSubspace(nametypes.ModuleName), app.BankKeeper, &app.AttributeKeeper,
 	)
 
+	// The wasm keeper is created below (it depends on the attribute keeper for queries) so a reference is provided.
 	app.AttributeKeeper = attributekeeper.NewKeeper(
 		appCodec, keys[attributetypes.StoreKey], app.GetSubspace(attributetypes.ModuleName), app.AccountKeeper, app.NameKeeper,
+		&app.WasmKeeper,
+	)
+
+	app.MarkerKeeper = markerkeeper.NewKeeper(
+		appCodec, keys[markertypes.StoreKey], app.GetSubspace(markertypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.AuthzKeeper, keys[banktypes.StoreKey], &app.GovKeeper,
+		app.AttributeKeeper, app.FeatureFlagsKeeper,
+	)
+
+	app.ResolverKeeper = resolverkeeper.NewKeeper(app.AccountKeeper, app.MarkerKeeper, app.NameKeeper, app.MetadataKeeper)
+
+	// The smart accounts keeper queries wasm authenticator contracts through the same reference.
+	app.SmartAccountsKeeper = smartaccountskeeper.NewKeeper(
+		appCodec, keys[smartaccountstypes.StoreKey], app.GetSubspace(smartaccountstypes.ModuleName), &app.WasmKeeper,
 	)
 
 	// Create IBC Keeper
@@ -412,10 +456,10 @@ func New(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
 	)
 
-	// Create Transfer Keepers
+	// Create Transfer Keepers, with the channel keeper wrapped to rate limit the marker denoms sent
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		ibcratelimit.NewICS4Wrapper(app.IBCKeeper.ChannelKeeper, app.MarkerKeeper), &app.IBCKeeper.PortKeeper,
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 	)
 
@@ -445,6 +489,8 @@ func New(
 
 	// Add the staking feature and indicate that provwasm contracts can be run on this chain.
 	supportedFeatures := "staking,provenance,stargate"
+	app.wasmDir = wasmDir
+	app.wasmFeatures = supportedFeatures
 
 	// The last arguments contain custom message handlers, and custom query handlers,
 	// to allow smart contracts to use provenance modules.
@@ -473,13 +519,14 @@ func New(
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, marker.NewParamChangeProposalHandler(app.MarkerKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.WasmKeeper, wasm.EnableAllProposals)).
 		AddRoute(nametypes.ModuleName, name.NewProposalHandler(app.NameKeeper)).
-		AddRoute(markertypes.ModuleName, marker.NewProposalHandler(app.MarkerKeeper))
+		AddRoute(markertypes.ModuleName, marker.NewProposalHandler(app.MarkerKeeper)).
+		AddRoute(featureflagstypes.ModuleName, featureflags.NewProposalHandler(app.FeatureFlagsKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
@@ -487,9 +534,9 @@ func New(
 
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	// Create static IBC router, add transfer route (rate limiting the marker denoms received), then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, ibcratelimit.NewIBCMiddleware(transferModule, app.MarkerKeeper))
 	ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper))
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -516,7 +563,7 @@ func New(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		newBankModule(bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper), app.BankKeeper.(bankkeeper.BaseKeeper), app.MarkerKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
@@ -535,6 +582,9 @@ func New(
 		marker.NewAppModule(appCodec, app.MarkerKeeper, app.AccountKeeper, app.BankKeeper),
 		name.NewAppModule(appCodec, app.NameKeeper, app.AccountKeeper, app.BankKeeper),
 		attribute.NewAppModule(appCodec, app.AttributeKeeper, app.AccountKeeper, app.BankKeeper, app.NameKeeper),
+		smartaccounts.NewAppModule(appCodec, app.SmartAccountsKeeper),
+		featureflags.NewAppModule(appCodec, app.FeatureFlagsKeeper),
+		resolver.NewAppModule(appCodec, app.ResolverKeeper),
 		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper),
 
 		// IBC
@@ -562,6 +612,9 @@ func New(
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
+		nametypes.ModuleName,
+		attributetypes.ModuleName,
+		markertypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -588,6 +641,9 @@ func New(
 		nametypes.ModuleName,
 		attributetypes.ModuleName,
 		metadatatypes.ModuleName,
+		smartaccountstypes.ModuleName,
+		featureflagstypes.ModuleName,
+		resolvertypes.ModuleName,
 
 		ibchost.ModuleName,
 
@@ -596,6 +652,14 @@ func New(
 		wasm.ModuleName,
 	)
 
+	// Fail fast if a module was wired in out of order with the modules it depends on.
+	if err := ValidateModuleOrder(app.mm, ModuleDependencies); err != nil {
+		panic(err)
+	}
+
+	// Query nodes can reject expensive provenance queries while too many are in flight, see the querythrottle flags.
+	app.queryThrottle = querythrottle.New(logger, querythrottle.ConfigFromAppOptions(appOpts))
+
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -619,6 +683,8 @@ func New(
 		marker.NewAppModule(appCodec, app.MarkerKeeper, app.AccountKeeper, app.BankKeeper),
 		name.NewAppModule(appCodec, app.NameKeeper, app.AccountKeeper, app.BankKeeper),
 		attribute.NewAppModule(appCodec, app.AttributeKeeper, app.AccountKeeper, app.BankKeeper, app.NameKeeper),
+		smartaccounts.NewAppModule(appCodec, app.SmartAccountsKeeper),
+		featureflags.NewAppModule(appCodec, app.FeatureFlagsKeeper),
 		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper),
 
 		ibc.NewAppModule(app.IBCKeeper),
@@ -636,12 +702,15 @@ func New(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	anteHandler, err := antewrapper.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   app.AccountKeeper,
-			BankKeeper:      app.BankKeeper,
-			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-			FeegrantKeeper:  app.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		antewrapper.HandlerOptions{
+			HandlerOptions: ante.HandlerOptions{
+				AccountKeeper:   app.AccountKeeper,
+				BankKeeper:      app.BankKeeper,
+				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+				FeegrantKeeper:  app.FeeGrantKeeper,
+				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			},
+			SmartAccountKeeper: app.SmartAccountsKeeper,
 		})
 	if err != nil {
 		panic(err)
@@ -755,6 +824,17 @@ func (app *App) GetKey(storeKey string) *sdk.KVStoreKey {
 	return app.keys[storeKey]
 }
 
+// ProvenanceStoreKeys returns the KVStoreKeys of the provenance modules.
+func (app *App) ProvenanceStoreKeys() []sdk.StoreKey {
+	return []sdk.StoreKey{
+		app.keys[attributetypes.StoreKey],
+		app.keys[markertypes.StoreKey],
+		app.keys[metadatatypes.StoreKey],
+		app.keys[nametypes.StoreKey],
+		app.keys[smartaccountstypes.StoreKey],
+	}
+}
+
 // GetTKey returns the TransientStoreKey for the provided store key.
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -804,6 +884,12 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 	}
 }
 
+// RegisterGRPCServer registers the query services with the grpc server of the node, throttled in degraded query mode.
+// The query router used by modules and contracts while executing txs is never throttled.
+func (app *App) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(app.queryThrottle.WrapServer(server))
+}
+
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
@@ -851,6 +937,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(markertypes.ModuleName)
 	paramsKeeper.Subspace(nametypes.ModuleName)
 	paramsKeeper.Subspace(attributetypes.ModuleName)
+	paramsKeeper.Subspace(smartaccountstypes.ModuleName)
 	paramsKeeper.Subspace(wasm.ModuleName)
 
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
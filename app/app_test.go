@@ -1,15 +1,22 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/provenance-io/provenance/internal/querythrottle"
 )
 
 func TestSimAppExportAndBlockedAddrs(t *testing.T) {
@@ -51,3 +58,50 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+type testAppOptions map[string]interface{}
+
+func (o testAppOptions) Get(key string) interface{} { return o[key] }
+
+type serviceRecorder struct {
+	descs    map[string]*grpc.ServiceDesc
+	handlers map[string]interface{}
+}
+
+func (r *serviceRecorder) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	r.descs[sd.ServiceName] = sd
+	r.handlers[sd.ServiceName] = ss
+}
+
+func TestQueryThrottleAppliesToGRPCServerOnly(t *testing.T) {
+	encCfg := MakeEncodingConfig()
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg,
+		testAppOptions{querythrottle.FlagInFlight: 1})
+	stateBytes, err := json.Marshal(NewDefaultGenesisState(encCfg.Marshaler))
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+
+	// with a threshold of one every query runs degraded, yet the query router used while executing txs serves an
+	// unpaged scan
+	const method = "/provenance.marker.v1.Query/AllMarkers"
+	route := app.GRPCQueryRouter().Route(method)
+	require.NotNil(t, route, "query route")
+	_, err = route(app.BaseApp.NewContext(true, tmproto.Header{}), abci.RequestQuery{Path: method})
+	require.NoError(t, err, "query router is not throttled")
+
+	// the same query is rejected by the grpc server of the node
+	server := &serviceRecorder{descs: map[string]*grpc.ServiceDesc{}, handlers: map[string]interface{}{}}
+	app.RegisterGRPCServer(server)
+	desc := server.descs["provenance.marker.v1.Query"]
+	require.NotNil(t, desc, "marker query service registered with the grpc server")
+	for _, m := range desc.Methods {
+		if m.MethodName != "AllMarkers" {
+			continue
+		}
+		_, err = m.Handler(server.handlers[desc.ServiceName], context.Background(), func(interface{}) error { return nil }, nil)
+		require.Equal(t, codes.Unavailable, status.Code(err), "grpc server is throttled: %v", err)
+		return
+	}
+	t.Fatal("AllMarkers method not registered with the grpc server")
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+)
+
+// bankModule is the bank AppModule with a msg server that records coin sent directly to marker escrow accounts.
+type bankModule struct {
+	bank.AppModule
+
+	keeper       bankkeeper.BaseKeeper
+	markerKeeper markerkeeper.Keeper
+}
+
+// newBankModule creates a bank AppModule that records sends to marker escrow accounts with the marker keeper.
+func newBankModule(bankModuleBase bank.AppModule, keeper bankkeeper.BaseKeeper, markerKeeper markerkeeper.Keeper) bankModule {
+	return bankModule{
+		AppModule:    bankModuleBase,
+		keeper:       keeper,
+		markerKeeper: markerKeeper,
+	}
+}
+
+// RegisterServices registers the bank module services, wrapping the msg server to record marker escrow deposits.
+func (am bankModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), markerkeeper.NewEscrowDepositMsgServer(bankkeeper.NewMsgServerImpl(am.keeper), am.markerKeeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper)
+	cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2)
+}
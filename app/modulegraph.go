@@ -0,0 +1,95 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+// ModuleDependencies maps a module name to the modules that must run before it wherever both appear in the begin
+// block, end block, or init genesis order.  A module's dependencies must also be in the init genesis order whenever
+// the module is.
+var ModuleDependencies = map[string][]string{
+	markertypes.ModuleName:    {banktypes.ModuleName},
+	attributetypes.ModuleName: {nametypes.ModuleName},
+	genutiltypes.ModuleName:   {stakingtypes.ModuleName},
+	wasm.ModuleName:           {ibctransfertypes.ModuleName},
+}
+
+// ValidateModuleOrder checks the begin block, end block, and init genesis orders of a module manager against the
+// given module dependencies.
+func ValidateModuleOrder(mm *module.Manager, dependencies map[string][]string) error {
+	orders := []struct {
+		name     string
+		modules  []string
+		required bool
+	}{
+		{"begin block", mm.OrderBeginBlockers, false},
+		{"end block", mm.OrderEndBlockers, false},
+		{"init genesis", mm.OrderInitGenesis, true},
+	}
+	var problems []string
+	for _, order := range orders {
+		positions := make(map[string]int, len(order.modules))
+		for i, name := range order.modules {
+			positions[name] = i
+		}
+		for _, name := range sortedKeys(dependencies) {
+			pos, found := positions[name]
+			if !found {
+				continue
+			}
+			for _, dep := range dependencies[name] {
+				depPos, depFound := positions[dep]
+				switch {
+				case !depFound && order.required:
+					problems = append(problems, fmt.Sprintf("%s order: %s requires %s which is missing", order.name, name, dep))
+				case depFound && depPos > pos:
+					problems = append(problems, fmt.Sprintf("%s order: %s must come after %s", order.name, name, dep))
+				}
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid module order: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// ModuleGraph returns the module dependencies in graphviz dot format along with the begin block, end block, and init
+// genesis orders of the app.
+func (app *App) ModuleGraph() string {
+	var sb strings.Builder
+	sb.WriteString("digraph modules {\n")
+	for _, name := range sortedKeys(ModuleDependencies) {
+		for _, dep := range ModuleDependencies[name] {
+			sb.WriteString(fmt.Sprintf("  %q -> %q;\n", name, dep))
+		}
+	}
+	sb.WriteString("}\n")
+	sb.WriteString(fmt.Sprintf("// begin block: %s\n", strings.Join(app.mm.OrderBeginBlockers, ", ")))
+	sb.WriteString(fmt.Sprintf("// end block: %s\n", strings.Join(app.mm.OrderEndBlockers, ", ")))
+	sb.WriteString(fmt.Sprintf("// init genesis: %s\n", strings.Join(app.mm.OrderInitGenesis, ", ")))
+	return sb.String()
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,72 @@
+package app
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestValidateModuleOrder(t *testing.T) {
+	dependencies := map[string][]string{"marker": {"bank"}}
+	newManager := func(begin, end, genesis []string) *module.Manager {
+		mm := module.NewManager()
+		mm.SetOrderBeginBlockers(begin...)
+		mm.SetOrderEndBlockers(end...)
+		mm.SetOrderInitGenesis(genesis...)
+		return mm
+	}
+
+	tests := []struct {
+		name   string
+		mm     *module.Manager
+		errMsg string
+	}{
+		{
+			name: "dependency before module",
+			mm:   newManager([]string{"bank", "marker"}, nil, []string{"auth", "bank", "marker"}),
+		},
+		{
+			name: "dependency without a begin blocker",
+			mm:   newManager([]string{"marker"}, nil, []string{"bank", "marker"}),
+		},
+		{
+			name:   "dependency after module",
+			mm:     newManager(nil, []string{"marker", "bank"}, []string{"bank", "marker"}),
+			errMsg: "invalid module order: end block order: marker must come after bank",
+		},
+		{
+			name:   "dependency missing from init genesis",
+			mm:     newManager(nil, nil, []string{"marker"}),
+			errMsg: "invalid module order: init genesis order: marker requires bank which is missing",
+		},
+		{
+			name:   "multiple problems",
+			mm:     newManager([]string{"marker", "bank"}, nil, []string{"marker", "bank"}),
+			errMsg: "invalid module order: begin block order: marker must come after bank; init genesis order: marker must come after bank",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateModuleOrder(tc.mm, dependencies)
+			if len(tc.errMsg) > 0 {
+				require.EqualError(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestModuleGraph(t *testing.T) {
+	app := New(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{})
+	require.NoError(t, ValidateModuleOrder(app.mm, ModuleDependencies))
+	graph := app.ModuleGraph()
+	require.Contains(t, graph, "digraph modules {\n")
+	require.Contains(t, graph, "  \"marker\" -> \"bank\";\n")
+	require.Contains(t, graph, "  \"attribute\" -> \"name\";\n")
+}
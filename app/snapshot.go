@@ -0,0 +1,93 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	wasmvm "github.com/CosmWasm/wasmvm"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/provenance-io/provenance/internal/statesync"
+)
+
+// wasmContractMemoryLimit is the memory limit (in MiB) the wasm keeper runs contracts with.  Code is only stored, not
+// run, when it is restored from a snapshot.
+const wasmContractMemoryLimit = 32
+
+// SnapshotExtensions returns the state sync snapshot extensions for the data the app keeps outside of its stores.  The
+// committed state the data belongs to is read from the multi store.
+func (app *App) SnapshotExtensions(cms sdk.CommitMultiStore) []statesync.Extension {
+	return []statesync.Extension{
+		wasmSnapshotExtension{app: app, cms: cms},
+	}
+}
+
+// wasmSnapshotExtension adds the code of the stored wasm contracts, which the wasm module keeps on disk with only its
+// checksum in the store, to state sync snapshots.  Without it a node restored from a snapshot cannot run contracts.
+type wasmSnapshotExtension struct {
+	app *App
+	cms sdk.CommitMultiStore
+}
+
+var _ statesync.Extension = wasmSnapshotExtension{}
+
+// Name implements statesync.Extension.
+func (e wasmSnapshotExtension) Name() string {
+	return wasmtypes.ModuleName
+}
+
+// Export implements statesync.Extension.  The code of each stored code checksum is written once, keyed by checksum.
+func (e wasmSnapshotExtension) Export(height uint64, write func(key, value []byte) error) error {
+	ms, err := e.cms.CacheMultiStoreWithVersion(int64(height))
+	if err != nil {
+		return err
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: int64(height)}, false, e.app.Logger())
+	written := make(map[string]bool)
+	e.app.WasmKeeper.IterateCodeInfos(ctx, func(codeID uint64, info wasmtypes.CodeInfo) bool {
+		if written[string(info.CodeHash)] {
+			return false
+		}
+		var code []byte
+		if code, err = e.app.WasmKeeper.GetByteCode(ctx, codeID); err != nil {
+			return true
+		}
+		if err = write(info.CodeHash, code); err != nil {
+			return true
+		}
+		written[string(info.CodeHash)] = true
+		return false
+	})
+	return err
+}
+
+// Import implements statesync.Extension.  The code is stored in the wasm code directory the wasm keeper reads from.
+func (e wasmSnapshotExtension) Import(_ uint64, read func() ([]byte, []byte, error)) error {
+	vm, err := wasmvm.NewVM(filepath.Join(e.app.wasmDir, "wasm"), e.app.wasmFeatures, wasmContractMemoryLimit, false, 0)
+	if err != nil {
+		return err
+	}
+	defer vm.Cleanup()
+	for {
+		checksum, code, err := read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		stored, err := vm.Create(code)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(stored, checksum) {
+			return fmt.Errorf("wasm code checksum %X does not match snapshot checksum %X", stored, checksum)
+		}
+	}
+}
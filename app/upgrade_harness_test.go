@@ -0,0 +1,230 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdksim "github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+// upgradeHarness runs an app block by block and delivers signed txs in them the way a node does, so a registered
+// upgrade can be performed by the upgrade module begin blocker with scripted operations before and after it.
+//
+// This is a single binary simulation, not a run of the previous release followed by the current one: a test links a
+// single version of the app, so the state before the upgrade is written by the current code.  The module version map is
+// rewound to the versions the upgrade migrates from and tests strip the state the previous release did not write, so
+// the upgrade runs its migrations over state shaped like that of the previous release.  Regressions that depend on
+// code only in the previous release, such as a changed encoding of existing records, are not caught here and still
+// need a testnet upgrade.
+type upgradeHarness struct {
+	t       *testing.T
+	app     *App
+	chainID string
+	height  int64
+	priv    *secp256k1.PrivKey
+	addr    sdk.AccAddress
+	seq     uint64
+}
+
+// newUpgradeHarness initializes a chain with a single account and starts its first block.
+func newUpgradeHarness(t *testing.T) *upgradeHarness {
+	priv := secp256k1.GenPrivKey()
+	h := &upgradeHarness{
+		t:       t,
+		chainID: "upgrade-harness",
+		priv:    priv,
+		addr:    sdk.AccAddress(priv.PubKey().Address()),
+	}
+
+	var genesisState GenesisState
+	h.app, genesisState = setup(true, 0)
+	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(),
+		[]authtypes.GenesisAccount{authtypes.NewBaseAccount(h.addr, nil, 0, 0)})
+	genesisState[authtypes.ModuleName] = h.app.AppCodec().MustMarshalJSON(authGenesis)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err, "genesis state")
+
+	h.app.InitChain(abci.RequestInitChain{
+		ChainId:         h.chainID,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: sdksim.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	h.app.Commit()
+	h.height = h.app.LastBlockHeight()
+	h.beginBlock()
+	return h
+}
+
+func (h *upgradeHarness) beginBlock() {
+	h.height++
+	h.app.BeginBlock(abci.RequestBeginBlock{Header: h.header()})
+}
+
+func (h *upgradeHarness) header() tmproto.Header {
+	return tmproto.Header{ChainID: h.chainID, Height: h.height}
+}
+
+// ctx returns a context of the block being run, changes made with it are committed with the block.
+func (h *upgradeHarness) ctx() sdk.Context {
+	return h.app.BaseApp.NewContext(false, h.header())
+}
+
+// nextBlock ends and commits the block being run and begins the next one.
+func (h *upgradeHarness) nextBlock() {
+	h.app.EndBlock(abci.RequestEndBlock{Height: h.height})
+	h.app.Commit()
+	h.beginBlock()
+}
+
+// deliver signs the msgs with the account of the harness and delivers them in a tx of the block being run.
+func (h *upgradeHarness) deliver(msgs ...sdk.Msg) *sdk.Result {
+	txCfg := MakeEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(txCfg, msgs, sdk.Coins{}, helpers.DefaultGenTxGas, h.chainID,
+		[]uint64{h.accountNumber()}, []uint64{h.seq}, h.priv)
+	require.NoError(h.t, err, "generating tx")
+	_, res, err := h.app.Deliver(txCfg.TxEncoder(), tx)
+	require.NoError(h.t, err, "delivering %T", msgs[0])
+	h.seq++
+	return res
+}
+
+func (h *upgradeHarness) accountNumber() uint64 {
+	return h.app.AccountKeeper.GetAccount(h.ctx(), h.addr).GetAccountNumber()
+}
+
+// upgrade schedules the named upgrade for the next block after rewinding the module version map to the given
+// versions, and runs that block.  The upgrade handler panics the begin blocker if it fails.
+func (h *upgradeHarness) upgrade(name string, fromVersions module.VersionMap) {
+	ctx := h.ctx()
+	h.app.UpgradeKeeper.SetModuleVersionMap(ctx, fromVersions)
+	require.NoError(h.t, h.app.UpgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{Name: name, Height: h.height + 1}),
+		"scheduling upgrade %s", name)
+	h.nextBlock()
+	require.Equal(h.t, h.height, h.app.UpgradeKeeper.GetDoneHeight(h.ctx(), name), "upgrade %s done height", name)
+}
+
+// deletePrefix removes all entries under the prefix of a module store in the block being run.
+func (h *upgradeHarness) deletePrefix(storeKey string, prefix []byte) {
+	store := h.ctx().KVStore(h.app.GetKey(storeKey))
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// requireEvents asserts that the result contains events of the given types.
+func requireEvents(t *testing.T, res *sdk.Result, eventTypes ...string) {
+	emitted := make(map[string]bool)
+	for _, e := range res.Events {
+		emitted[e.Type] = true
+	}
+	for _, eventType := range eventTypes {
+		require.True(t, emitted[eventType], "expected %s event", eventType)
+	}
+}
+
+func TestGreenUpgradeHarness(t *testing.T) {
+	h := newUpgradeHarness(t)
+
+	// state written before the upgrade
+	res := h.deliver(
+		markertypes.NewMsgAddMarkerRequest("oldcoin", sdk.NewInt(1000), h.addr, h.addr, markertypes.MarkerType_Coin, false, true),
+		markertypes.NewMsgAddAccessRequest("oldcoin", h.addr, *markertypes.NewAccessGrant(h.addr,
+			[]markertypes.Access{markertypes.Access_Mint, markertypes.Access_Withdraw, markertypes.Access_Admin})),
+		markertypes.NewMsgFinalizeRequest("oldcoin", h.addr),
+		markertypes.NewMsgActivateRequest("oldcoin", h.addr),
+	)
+	requireEvents(t, res, "provenance.marker.v1.EventMarkerAdd", "provenance.marker.v1.EventMarkerActivate")
+	require.NoError(t, h.app.NameKeeper.SetNameRecord(h.ctx(), "harness", h.addr, false), "binding name")
+	require.NoError(t, h.app.NameKeeper.SetNameRecord(h.ctx(), "old.harness", h.addr, false), "binding name")
+
+	// the previous release did not record marker creation, the marker management timeline, or the name subtree index
+	ctx := h.ctx()
+	oldMarker, err := h.app.MarkerKeeper.GetMarkerByDenom(ctx, "oldcoin")
+	require.NoError(t, err, "marker added before the upgrade")
+	oldMarker.SetCreatedHeight(0)
+	oldMarker.SetCreatedBy("")
+	oldMarker.SetCreatedTxHash("")
+	h.app.AccountKeeper.SetAccount(ctx, oldMarker)
+	h.deletePrefix(markertypes.StoreKey, markertypes.ManagementKeyPrefix)
+	h.deletePrefix(nametypes.StoreKey, nametypes.SubtreeKeyPrefix)
+	require.Nil(t, h.app.MarkerKeeper.GetLatestManagementEntry(ctx, oldMarker.GetAddress()), "management history before the upgrade")
+	records, err := h.app.NameKeeper.GetRecordsInSubtree(ctx, "harness")
+	require.NoError(t, err, "name subtree before the upgrade")
+	require.Empty(t, records, "name subtree before the upgrade")
+	h.nextBlock()
+
+	h.upgrade("green", module.VersionMap{attributetypes.ModuleName: 2, markertypes.ModuleName: 2, metadatatypes.ModuleName: 2,
+		nametypes.ModuleName: 2})
+	ctx = h.ctx()
+	versionMap := h.app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	for name, m := range h.app.mm.Modules {
+		require.Equal(t, m.ConsensusVersion(), versionMap[name], "%s module version after the upgrade", name)
+	}
+	oldMarker, err = h.app.MarkerKeeper.GetMarkerByDenom(ctx, "oldcoin")
+	require.NoError(t, err, "marker added before the upgrade")
+	require.Equal(t, markertypes.StatusActive, oldMarker.GetStatus(), "marker added before the upgrade status")
+	require.Equal(t, h.app.UpgradeKeeper.GetDoneHeight(ctx, "green"), oldMarker.GetCreatedHeight(),
+		"marker added before the upgrade created height")
+	require.NotNil(t, h.app.MarkerKeeper.GetLatestManagementEntry(ctx, oldMarker.GetAddress()),
+		"marker added before the upgrade management history")
+	records, err = h.app.NameKeeper.GetRecordsInSubtree(ctx, "harness")
+	require.NoError(t, err, "name subtree after the upgrade")
+	require.Len(t, records, 2, "name subtree after the upgrade")
+
+	// operations after the upgrade
+	res = h.deliver(
+		markertypes.NewMsgMintRequest(h.addr, sdk.NewInt64Coin("oldcoin", 500)),
+		markertypes.NewMsgWithdrawRequest(h.addr, h.addr, "oldcoin", sdk.NewCoins(sdk.NewInt64Coin("oldcoin", 100))),
+	)
+	requireEvents(t, res, "provenance.marker.v1.EventMarkerMint", "provenance.marker.v1.EventMarkerWithdraw")
+	require.Equal(t, sdk.NewInt64Coin("oldcoin", 100), h.app.BankKeeper.GetBalance(h.ctx(), h.addr, "oldcoin"),
+		"withdrawn marker coin")
+
+	res = h.deliver(
+		markertypes.NewMsgAddMarkerRequest("newcoin", sdk.NewInt(1000), h.addr, h.addr, markertypes.MarkerType_RestrictedCoin, true, true),
+	)
+	requireEvents(t, res, "provenance.marker.v1.EventMarkerAdd")
+	newMarker, err := h.app.MarkerKeeper.GetMarkerByDenom(h.ctx(), "newcoin")
+	require.NoError(t, err, "marker added after the upgrade")
+	require.Equal(t, h.addr.String(), newMarker.GetCreatedBy(), "marker added after the upgrade created by")
+	require.Equal(t, h.height, newMarker.GetCreatedHeight(), "marker added after the upgrade created height")
+
+	scopeSpecID := metadatatypes.ScopeSpecMetadataAddress(uuid.New())
+	scopeID := metadatatypes.ScopeMetadataAddress(uuid.New())
+	owners := []metadatatypes.Party{{Address: h.addr.String(), Role: metadatatypes.PartyType_PARTY_TYPE_OWNER}}
+	res = h.deliver(
+		metadatatypes.NewMsgWriteScopeSpecificationRequest(*metadatatypes.NewScopeSpecification(scopeSpecID,
+			nil, []string{h.addr.String()}, []metadatatypes.PartyType{metadatatypes.PartyType_PARTY_TYPE_OWNER}, nil),
+			[]string{h.addr.String()}),
+		metadatatypes.NewMsgWriteScopeRequest(*metadatatypes.NewScope(scopeID, scopeSpecID, owners, nil, h.addr.String()),
+			[]string{h.addr.String()}),
+	)
+	requireEvents(t, res, "provenance.metadata.v1.EventScopeSpecificationCreated", "provenance.metadata.v1.EventScopeCreated")
+	h.nextBlock()
+
+	_, found := h.app.MetadataKeeper.GetScope(h.ctx(), scopeID)
+	require.True(t, found, "scope written after the upgrade")
+}
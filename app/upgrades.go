@@ -13,6 +13,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	ibcconnectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+
+	featureflagstypes "github.com/provenance-io/provenance/x/featureflags/types"
+	smartaccountstypes "github.com/provenance-io/provenance/x/smartaccounts/types"
 )
 
 var (
@@ -125,6 +128,24 @@ var handlers = map[string]appUpgrade{
 		},
 		Added: []string{authz.ModuleName, feegrant.ModuleName},
 	},
+	"green": {
+		Handler: func(app *App, ctx sdk.Context, plan upgradetypes.Plan) (module.VersionMap, error) {
+			orderedMigration := []moduleUpgradeVersion{
+				// provenance modules with store migrations in this release
+				{"attribute", 2},
+				{"marker", 2},
+				{"metadata", 2},
+				{"name", 2},
+
+				// new modules that need to run init genesis
+				{"smartaccounts", 0},
+				{"featureflags", 0},
+				{"resolver", 0},
+			}
+			return RunOrderedMigrations(app, ctx, orderedMigration)
+		},
+		Added: []string{smartaccountstypes.ModuleName, featureflagstypes.ModuleName},
+	},
 	// TODO - Add new upgrade definitions here.
 }
 
@@ -0,0 +1,72 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func TestGreenUpgradeRunsMarkerMigration(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	upgrade, ok := handlers["green"]
+	require.True(t, ok, "green upgrade handler must be registered")
+	require.NotNil(t, upgrade.Handler, "green upgrade must define a handler")
+
+	versionMap, err := upgrade.Handler(app, ctx, upgradetypes.Plan{Name: "green"})
+	require.NoError(t, err, "green upgrade handler")
+	require.Equal(t, app.mm.Modules[markertypes.ModuleName].ConsensusVersion(), versionMap[markertypes.ModuleName],
+		"marker module should be migrated to its current consensus version")
+}
+
+func TestGreenUpgradeRunsAttributeMigration(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "green", addr, false), "binding attribute name")
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+		attributetypes.NewAttribute("green", addr, attributetypes.AttributeType_String, []byte("value")), addr),
+		"setting attribute")
+
+	// attributes written before the upgrade have no entry in the lookup of accounts by attribute name
+	ctx.KVStore(app.GetKey(attributetypes.StoreKey)).Delete(attributetypes.AttributeNameAddrKey("green", addr))
+	res, err := app.AttributeKeeper.AttributeAccountsByName(sdk.WrapSDKContext(ctx),
+		&attributetypes.QueryAttributeAccountsByNameRequest{Name: "green"})
+	require.NoError(t, err, "accounts by attribute name before the upgrade")
+	require.Empty(t, res.Accounts, "accounts by attribute name before the upgrade")
+
+	versionMap, err := handlers["green"].Handler(app, ctx, upgradetypes.Plan{Name: "green"})
+	require.NoError(t, err, "green upgrade handler")
+	require.Equal(t, app.mm.Modules[attributetypes.ModuleName].ConsensusVersion(), versionMap[attributetypes.ModuleName],
+		"attribute module should be migrated to its current consensus version")
+	res, err = app.AttributeKeeper.AttributeAccountsByName(sdk.WrapSDKContext(ctx),
+		&attributetypes.QueryAttributeAccountsByNameRequest{Name: "green"})
+	require.NoError(t, err, "accounts by attribute name after the upgrade")
+	require.Equal(t, []string{addr.String()}, res.Accounts, "accounts by attribute name after the upgrade")
+}
+
+func TestGreenUpgradeRunsNameMigration(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	// names bound before unicode names were allowed may not be normalized
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "ｇｒｅｅｎ", addr, false), "binding unnormalized name")
+
+	versionMap, err := handlers["green"].Handler(app, ctx, upgradetypes.Plan{Name: "green"})
+	require.NoError(t, err, "green upgrade handler")
+	require.Equal(t, app.mm.Modules[nametypes.ModuleName].ConsensusVersion(), versionMap[nametypes.ModuleName],
+		"name module should be migrated to its current consensus version")
+	require.False(t, app.NameKeeper.NameExists(ctx, "ｇｒｅｅｎ"), "unnormalized name after the upgrade")
+	require.True(t, app.NameKeeper.ResolvesTo(ctx, "green", addr), "normalized name after the upgrade")
+}
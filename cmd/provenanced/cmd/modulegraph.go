@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/provenance-io/provenance/app"
+)
+
+// DebugCmd returns the SDK debug command with the provenance module-graph and rebuild-marker commands added.
+func DebugCmd() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(
+		ModuleGraphCmd(),
+		RebuildMarkerCmd(),
+	)
+	return cmd
+}
+
+// ModuleGraphCmd returns a command that validates the module order of the app and outputs the module dependency graph.
+func ModuleGraphCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "module-graph",
+		Short: "Validate the module order and output the module dependency graph",
+		Long: `Validate the begin block, end block, and init genesis module orders against the declared module
+dependencies, then output the dependencies in graphviz dot format followed by each module order.`,
+		Example: fmt.Sprintf("$ %s debug module-graph | dot -Tpng -o modules.png", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			home, err := os.MkdirTemp("", "module-graph")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(home)
+
+			// The app panics during setup when the module order is invalid.
+			defer func() {
+				if r := recover(); r != nil {
+					err = fmt.Errorf("%v", r)
+				}
+			}()
+			provApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, false, map[int64]bool{}, home, 0,
+				app.MakeEncodingConfig(), app.EmptyAppOptions{})
+			cmd.Print(provApp.ModuleGraph())
+			return nil
+		},
+	}
+}
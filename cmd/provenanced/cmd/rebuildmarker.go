@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmnode "github.com/tendermint/tendermint/node"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/markerreplay"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+const flagFromHeight = "from-height"
+
+// RebuildMarkerCmd returns a command that reconstructs the state of a marker from its typed events and diffs it
+// against the state in the store.
+func RebuildMarkerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebuild-marker <denom>",
+		Short: "Reconstruct the state of a marker from its events and diff it against the store",
+		Long: `Reconstruct the state of a marker from its typed events and diff it against the store.
+
+Starting from the state of the marker at the block before the from height, the marker typed events of each block up
+to the latest one are read from the ABCI responses saved by the node and replayed to reconstruct the status, type,
+supply, and access list of the marker.  Each field that differs from the state in the store is reported, pointing to
+a keeper bug or a change made without an event.  Access granted when a marker is added is not part of its add event,
+so start after the marker was added to check its access list.
+
+The node must be stopped, and the application state at the block before the from height must not be pruned.  When the
+from height is 1 the replay starts from no marker, and markers created in genesis are not seen.`,
+		Example: fmt.Sprintf(`$ %[1]s debug rebuild-marker nhash --from-height 2
+$ %[1]s debug rebuild-marker restrictedcoin --from-height 1200000 --home /opt/provenance`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denom := args[0]
+			markerAddr, err := markertypes.MarkerAddress(denom)
+			if err != nil {
+				return err
+			}
+			fromHeight, err := cmd.Flags().GetInt64(flagFromHeight)
+			if err != nil {
+				return err
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			tmConfig := serverCtx.Config
+			stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: tmConfig})
+			if err != nil {
+				return fmt.Errorf("could not open state db, the node must be stopped: %w", err)
+			}
+			defer stateDB.Close()
+			blockStoreDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: tmConfig})
+			if err != nil {
+				return fmt.Errorf("could not open block store db: %w", err)
+			}
+			defer blockStoreDB.Close()
+			appDB, err := sdk.NewLevelDB("application", filepath.Join(tmConfig.RootDir, "data"))
+			if err != nil {
+				return fmt.Errorf("could not open application db: %w", err)
+			}
+			defer appDB.Close()
+
+			stateStore := sm.NewStore(stateDB)
+			blockStore := tmstore.NewBlockStore(blockStoreDB)
+			latest := blockStore.Height()
+			if fromHeight < 1 || fromHeight > latest {
+				return fmt.Errorf("from height %d must be between 1 and the latest height %d", fromHeight, latest)
+			}
+			blockTime := func(height int64) time.Time {
+				if meta := blockStore.LoadBlockMeta(height); meta != nil {
+					return meta.Header.Time
+				}
+				return time.Time{}
+			}
+
+			cms := rootmulti.NewStore(appDB)
+			provApp := app.New(log.NewNopLogger(), appDB, nil, true, map[int64]bool{}, tmConfig.RootDir, 0,
+				app.MakeEncodingConfig(), serverCtx.Viper, func(bApp *baseapp.BaseApp) { bApp.SetCMS(cms) })
+			if provApp.LastBlockHeight() != latest {
+				return fmt.Errorf("application state is at height %d but the block store is at height %d",
+					provApp.LastBlockHeight(), latest)
+			}
+			markerState := func(height int64) (*markerreplay.State, error) {
+				if height < 1 {
+					return markerreplay.NewState(denom), nil
+				}
+				ms, err := cms.CacheMultiStoreWithVersion(height)
+				if err != nil {
+					return nil, fmt.Errorf("could not load application state at height %d, it may be pruned: %w", height, err)
+				}
+				header := tmproto.Header{Height: height, Time: blockTime(height)}
+				ctx := sdk.NewContext(ms, header, false, log.NewNopLogger())
+				m, err := provApp.MarkerKeeper.GetMarker(ctx, markerAddr)
+				if err != nil {
+					return nil, err
+				}
+				circulation := provApp.BankKeeper.GetSupply(ctx, denom).Amount
+				return markerreplay.StateFromMarker(denom, m, circulation, header.Time), nil
+			}
+
+			start, err := markerState(fromHeight - 1)
+			if err != nil {
+				return err
+			}
+			replayer := markerreplay.NewReplayer(start)
+			for height := fromHeight; height <= latest; height++ {
+				responses, err := stateStore.LoadABCIResponses(height)
+				if err != nil {
+					return fmt.Errorf("could not load the ABCI responses at height %d: %w", height, err)
+				}
+				if err = replayer.ApplyEvents(height, blockEvents(responses.BeginBlock, responses.DeliverTxs, responses.EndBlock)); err != nil {
+					return err
+				}
+			}
+			stored, err := markerState(latest)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("replayed %d events of marker %s from height %d to %d\n", replayer.Applied, denom, fromHeight, latest)
+			diffs := markerreplay.Diff(replayer.State(), stored)
+			if len(diffs) == 0 {
+				cmd.Println("the reconstructed state matches the store")
+				return nil
+			}
+			cmd.Printf("the reconstructed state differs from the store in %d fields:\n", len(diffs))
+			for _, diff := range diffs {
+				cmd.Printf("  %s\n", diff)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagFromHeight, 1, "the height of the first block to replay the events of")
+	return cmd
+}
+
+// blockEvents returns the events of a block in the order they were emitted, leaving out the events of failed txs.
+func blockEvents(begin *abci.ResponseBeginBlock, txs []*abci.ResponseDeliverTx, end *abci.ResponseEndBlock) []abci.Event {
+	var events []abci.Event
+	if begin != nil {
+		events = append(events, begin.Events...)
+	}
+	for _, tx := range txs {
+		if tx != nil && tx.Code == abci.CodeTypeOK {
+			events = append(events, tx.Events...)
+		}
+	}
+	if end != nil {
+		events = append(events, end.Events...)
+	}
+	return events
+}
@@ -25,13 +25,13 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -40,6 +40,9 @@ import (
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 
 	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/querythrottle"
+	"github.com/provenance-io/provenance/internal/statecheck"
+	"github.com/provenance-io/provenance/internal/statesync"
 )
 
 const (
@@ -47,6 +50,10 @@ const (
 	EnvTypeFlag = "testnet"
 	// Flag used to indicate coin type.
 	CoinTypeFlag = "coin-type"
+	// StateCheckIntervalFlag is the time between spot checks of the module stores against the committed root hash.
+	StateCheckIntervalFlag = "state-check-interval"
+	// StateCheckSamplesFlag is the number of store keys verified in each spot check.
+	StateCheckSamplesFlag = "state-check-samples"
 )
 
 // ChainID is the id of the running chain
@@ -137,9 +144,10 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		AddGenesisMarkerCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		DebugCmd(),
 		ConfigCmd(),
 		AddMetaAddressCmd(),
+		PsqlCmd(),
 	)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, createAppAndExport, addModuleInitFlags)
@@ -158,6 +166,15 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	startCmd.Flags().Duration(StateCheckIntervalFlag, 0,
+		"Periodically verify random provenance module store keys against the committed root hash to detect local database corruption (0 to disable)")
+	startCmd.Flags().Int(StateCheckSamplesFlag, 10, "The number of store keys verified each state check interval")
+	startCmd.Flags().Int(querythrottle.FlagInFlight, 0,
+		"The number of in-flight provenance queries at which expensive queries are rejected with a retry hint until half as many are in flight (0 to disable)")
+	startCmd.Flags().Uint64(querythrottle.FlagPageLimit, querythrottle.DefaultPageLimit,
+		"The largest page of results served while expensive queries are rejected")
+	startCmd.Flags().Duration(querythrottle.FlagRetryAfter, querythrottle.DefaultRetryAfter,
+		"The time clients of rejected queries are told to wait before retrying")
 }
 
 func queryCommand() *cobra.Command {
@@ -267,12 +284,18 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		}
 	}
 
-	return app.New(
+	// The root multi store is created here, rather than by the base app, so the state check worker can read it.
+	cms := rootmulti.NewStore(db)
+	// State sync snapshots are extended with the data the app keeps outside of its stores.
+	snapshotter := statesync.NewSnapshotter(cms)
+
+	pApp := app.New(
 		logger, db, traceStore, true, skipUpgradeHeights,
 		cast.ToString(appOpts.Get(flags.FlagHome)),
 		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
 		app.MakeEncodingConfig(), // Ideally, we would reuse the one created by NewRootCmd.
 		appOpts,
+		func(bApp *baseapp.BaseApp) { bApp.SetCMS(cms) },
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server.FlagMinGasPrices))),
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
@@ -281,10 +304,18 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		baseapp.SetInterBlockCache(cache),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
-		baseapp.SetSnapshotStore(snapshotStore),
+		statesync.SetSnapshotStore(cms, snapshotter, snapshotStore),
 		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
 		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
 	)
+
+	snapshotter.RegisterExtensions(pApp.SnapshotExtensions(cms)...)
+
+	if interval := cast.ToDuration(appOpts.Get(StateCheckIntervalFlag)); interval > 0 {
+		statecheck.NewWorker(logger, cms, pApp.ProvenanceStoreKeys(), interval, cast.ToInt(appOpts.Get(StateCheckSamplesFlag))).Start()
+	}
+
+	return pApp
 }
 
 func createAppAndExport(
@@ -0,0 +1,485 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+const (
+	flagStressMarkers  = "markers"
+	flagStressHolders  = "holders"
+	flagStressTPS      = "tps"
+	flagStressDuration = "duration"
+	flagStressSeed     = "seed"
+	flagStressWait     = "wait"
+)
+
+const (
+	// stressSupply is the supply each stress marker is activated with, all of it withdrawn to the from address.
+	stressSupply = 1_000_000_000_000
+	// stressSetupBatchSize is the number of markers set up in each setup transaction.
+	stressSetupBatchSize = 10
+	// stressPollInterval is the time waited between queries for the stress transactions still to be committed.
+	stressPollInterval = time.Second
+)
+
+// stressOpType is the kind of msg sent by a stress transaction.
+type stressOpType string
+
+const (
+	stressOpMint      stressOpType = "mint"
+	stressOpTransfer  stressOpType = "transfer"
+	stressOpAttribute stressOpType = "attribute"
+)
+
+// stressOpTypes are the stress op types in the order they are reported.
+var stressOpTypes = []stressOpType{stressOpMint, stressOpTransfer, stressOpAttribute}
+
+// stressOp is a single stress transaction in a stress plan.
+type stressOp struct {
+	Type   stressOpType
+	Marker int
+	Holder int
+	Amount int64
+}
+
+// stressResult is the outcome of a stress transaction.
+type stressResult struct {
+	Op        stressOp
+	TxHash    string
+	Sent      time.Time
+	CheckTx   time.Duration
+	Committed time.Duration
+	Height    int64
+	GasUsed   int64
+	Err       string
+}
+
+// testnetStressCmd returns a command that sends marker, mint, transfer, and attribute load to a local network.
+func testnetStressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stress",
+		Short: "Send deterministic marker, mint, transfer, and attribute load to a local network",
+		Long: strings.TrimSpace(fmt.Sprintf(`Send deterministic marker, mint, transfer, and attribute load to a local network and report
+latency and gas statistics.
+
+The from address must own the "pb" root name, e.g. node0 of a network created with the testnet command.  Setup
+creates --%[1]s restricted markers and binds an attribute name under "pb", all named for the --%[2]s, unless they
+already exist.  The load is then sent at --%[3]s transactions per second for --%[4]s: mints of the stress markers,
+restricted transfers to --%[5]s generated holder addresses, and attributes added to those holders.  The same seed
+always sends the same transactions, so runs with the same seed can be compared to measure keeper changes.
+
+Transactions are broadcast in sync mode with increasing sequences.  Once sent, they are queried for up to --%[6]s to
+collect the commit latency (to the block time) and gas used.`,
+			flagStressMarkers, flagStressSeed, flagStressTPS, flagStressDuration, flagStressHolders, flagStressWait)),
+		Example: fmt.Sprintf(`$ %[1]s testnet stress --markers 1000 --tps 200 --from node0 --keyring-backend test --home ./mytestnet/node0/%[1]s --chain-id testing --fees 100000000nhash`,
+			version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			markers, _ := cmd.Flags().GetInt(flagStressMarkers)
+			holders, _ := cmd.Flags().GetInt(flagStressHolders)
+			tps, _ := cmd.Flags().GetInt(flagStressTPS)
+			duration, _ := cmd.Flags().GetDuration(flagStressDuration)
+			seed, _ := cmd.Flags().GetUint64(flagStressSeed)
+			wait, _ := cmd.Flags().GetDuration(flagStressWait)
+			if markers <= 0 || holders <= 0 || tps <= 0 {
+				return fmt.Errorf("--%s, --%s, and --%s must be greater than zero", flagStressMarkers, flagStressHolders, flagStressTPS)
+			}
+			count := int(duration.Seconds() * float64(tps))
+			if count <= 0 {
+				return fmt.Errorf("--%s must allow at least one transaction", flagStressDuration)
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err = stressSetup(cmd, clientCtx, txf, seed, markers); err != nil {
+				return err
+			}
+
+			plan := stressPlan(seed, markers, holders, count)
+			results, err := stressRun(cmd, clientCtx, txf, seed, plan, stressHolders(seed, holders), tps)
+			if err != nil {
+				return err
+			}
+			stressCollect(cmd, clientCtx, results, wait)
+			bz, err := json.Marshal(newStressReport(results))
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().Int(flagStressMarkers, 100, "the number of stress markers")
+	cmd.Flags().Int(flagStressHolders, 100, "the number of generated holder addresses that receive transfers and attributes")
+	cmd.Flags().Int(flagStressTPS, 50, "the number of transactions sent each second")
+	cmd.Flags().Duration(flagStressDuration, time.Minute, "how long to send transactions for")
+	cmd.Flags().Uint64(flagStressSeed, 1, "the seed of the stress marker names and the transactions sent")
+	cmd.Flags().Duration(flagStressWait, time.Minute, "how long to wait for sent transactions to be committed")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// stressDenom returns the denom of a stress marker.
+func stressDenom(seed uint64, marker int) string {
+	return fmt.Sprintf("stress%dx%d", seed, marker)
+}
+
+// stressAttributeName returns the attribute name the stress attributes are added with.
+func stressAttributeName(seed uint64) string {
+	return fmt.Sprintf("stress%d.pb", seed)
+}
+
+// stressHolders returns the generated holder addresses for a seed.
+func stressHolders(seed uint64, count int) []sdk.AccAddress {
+	holders := make([]sdk.AccAddress, count)
+	for i := range holders {
+		key := secp256k1.GenPrivKeyFromSecret([]byte(fmt.Sprintf("stress-%d-%d", seed, i)))
+		holders[i] = sdk.AccAddress(key.PubKey().Address())
+	}
+	return holders
+}
+
+// stressPlan returns the stress transactions for a seed: half transfers, and a quarter each of mints and attributes.
+func stressPlan(seed uint64, markers, holders, count int) []stressOp {
+	r := rand.New(rand.NewSource(int64(seed))) // #nosec G404 -- the load must be reproducible, not secure.
+	plan := make([]stressOp, count)
+	for i := range plan {
+		op := stressOp{
+			Marker: r.Intn(markers),
+			Holder: r.Intn(holders),
+			Amount: 1 + r.Int63n(1000),
+		}
+		switch n := r.Intn(4); {
+		case n == 0:
+			op.Type = stressOpMint
+		case n == 1:
+			op.Type = stressOpAttribute
+		default:
+			op.Type = stressOpTransfer
+		}
+		plan[i] = op
+	}
+	return plan
+}
+
+// stressMsg returns the msg sent by a stress transaction.  The index makes each attribute value distinct.
+func stressMsg(from sdk.AccAddress, seed uint64, op stressOp, holders []sdk.AccAddress, index int) sdk.Msg {
+	denom := stressDenom(seed, op.Marker)
+	switch op.Type {
+	case stressOpMint:
+		return markertypes.NewMsgMintRequest(from, sdk.NewInt64Coin(denom, op.Amount))
+	case stressOpAttribute:
+		return attrtypes.NewMsgAddAttributeRequest(holders[op.Holder], from, stressAttributeName(seed),
+			attrtypes.AttributeType_String, []byte(fmt.Sprintf("%s-%d", denom, index)))
+	default:
+		return markertypes.NewMsgTransferRequest(from, from, holders[op.Holder], sdk.NewInt64Coin(denom, op.Amount))
+	}
+}
+
+// stressSetup creates the stress markers and binds the stress attribute name, skipping those that already exist.
+func stressSetup(cmd *cobra.Command, clientCtx client.Context, txf tx.Factory, seed uint64, markers int) error {
+	from := clientCtx.GetFromAddress()
+	blockCtx := clientCtx.WithBroadcastMode(flags.BroadcastBlock)
+	markerQuery := markertypes.NewQueryClient(clientCtx)
+	ctx := context.Background()
+
+	var msgs []sdk.Msg
+	name := strings.TrimSuffix(stressAttributeName(seed), ".pb")
+	if _, err := nametypes.NewQueryClient(clientCtx).Resolve(ctx, &nametypes.QueryResolveRequest{Name: stressAttributeName(seed)}); err != nil {
+		msgs = append(msgs, nametypes.NewMsgBindNameRequest(
+			nametypes.NewNameRecord(name, from, false), nametypes.NewNameRecord("pb", from, true)))
+	}
+	created := 0
+	for i := 0; i < markers; i++ {
+		denom := stressDenom(seed, i)
+		if _, err := markerQuery.Marker(ctx, &markertypes.QueryMarkerRequest{Id: denom}); err == nil {
+			continue
+		}
+		add := markertypes.NewMsgAddMarkerRequest(denom, sdk.NewInt(stressSupply), from, from, markertypes.MarkerType_RestrictedCoin, false, false)
+		add.Status = markertypes.StatusFinalized
+		add.AccessList = []markertypes.AccessGrant{*markertypes.NewAccessGrant(from, []markertypes.Access{
+			markertypes.Access_Admin, markertypes.Access_Mint, markertypes.Access_Burn,
+			markertypes.Access_Withdraw, markertypes.Access_Transfer,
+		})}
+		msgs = append(msgs, add,
+			markertypes.NewMsgActivateRequest(denom, from),
+			markertypes.NewMsgWithdrawRequest(from, from, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, stressSupply))))
+		created++
+	}
+	if len(msgs) == 0 {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "setup: all %d stress markers exist\n", markers)
+		return nil
+	}
+
+	// Each marker is set up with three msgs, which must be in the same transaction.
+	batch := stressSetupBatchSize * 3
+	for i := 0; i < len(msgs); {
+		end := i + batch
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+		if _, ok := msgs[i].(*nametypes.MsgBindNameRequest); ok {
+			end = i + 1
+		}
+		accNum, sequence, err := txf.AccountRetriever().GetAccountNumberSequence(clientCtx, from)
+		if err != nil {
+			return err
+		}
+		res, err := stressBroadcast(blockCtx, txf.WithAccountNumber(accNum).WithSequence(sequence), msgs[i:end]...)
+		if err != nil {
+			return fmt.Errorf("setup failed: %w", err)
+		}
+		if res.Code != 0 {
+			return fmt.Errorf("setup tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+		}
+		i = end
+	}
+	_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "setup: created %d of %d stress markers\n", created, markers)
+	return nil
+}
+
+// stressRun sends the stress transactions at the given rate, recording the check tx latency of each.
+func stressRun(
+	cmd *cobra.Command, clientCtx client.Context, txf tx.Factory, seed uint64, plan []stressOp, holders []sdk.AccAddress, tps int,
+) ([]*stressResult, error) {
+	from := clientCtx.GetFromAddress()
+	syncCtx := clientCtx.WithBroadcastMode(flags.BroadcastSync)
+	accNum, sequence, err := txf.AccountRetriever().GetAccountNumberSequence(clientCtx, from)
+	if err != nil {
+		return nil, err
+	}
+	txf = txf.WithAccountNumber(accNum)
+
+	interval := time.Second / time.Duration(tps)
+	start := time.Now()
+	results := make([]*stressResult, 0, len(plan))
+	for i, op := range plan {
+		// Sleep until the scheduled send time; a run that falls behind sends as fast as it can.
+		if wait := time.Until(start.Add(time.Duration(i) * interval)); wait > 0 {
+			time.Sleep(wait)
+		}
+		result := &stressResult{Op: op, Sent: time.Now()}
+		res, err := stressBroadcast(syncCtx, txf.WithSequence(sequence), stressMsg(from, seed, op, holders, i))
+		result.CheckTx = time.Since(result.Sent)
+		switch {
+		case err != nil:
+			result.Err = err.Error()
+		case res.Code != 0:
+			result.Err = res.RawLog
+		default:
+			result.TxHash = res.TxHash
+			sequence++
+		}
+		results = append(results, result)
+		if (i+1)%tps == 0 {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "sent %d of %d transactions\n", i+1, len(plan))
+		}
+	}
+	return results, nil
+}
+
+// stressCollect queries the committed stress transactions for their height, gas used, and commit latency.
+func stressCollect(cmd *cobra.Command, clientCtx client.Context, results []*stressResult, wait time.Duration) {
+	blockTimes := make(map[int64]time.Time)
+	deadline := time.Now().Add(wait)
+	for {
+		pending := 0
+		for _, result := range results {
+			if len(result.TxHash) == 0 || result.Height != 0 {
+				continue
+			}
+			res, err := authtx.QueryTx(clientCtx, result.TxHash)
+			if err != nil {
+				pending++
+				continue
+			}
+			result.Height = res.Height
+			result.GasUsed = res.GasUsed
+			if res.Code != 0 {
+				result.Err = res.RawLog
+			}
+			blockTime, ok := blockTimes[res.Height]
+			if !ok {
+				if block, err := clientCtx.Client.Block(context.Background(), &res.Height); err == nil {
+					blockTime = block.Block.Time
+					blockTimes[res.Height] = blockTime
+				}
+			}
+			if !blockTime.IsZero() {
+				result.Committed = blockTime.Sub(result.Sent)
+			}
+		}
+		if pending == 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%d transactions were not committed within %s\n", pending, wait)
+			return
+		}
+		time.Sleep(stressPollInterval)
+	}
+}
+
+// stressBroadcast signs and broadcasts a transaction of msgs with the broadcast mode of the client context.
+func stressBroadcast(clientCtx client.Context, txf tx.Factory, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	txb, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Sign(txf, clientCtx.GetFromName(), txb, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	return clientCtx.BroadcastTx(txBytes)
+}
+
+// stressReport is the summary of a stress run.
+type stressReport struct {
+	Sent      int                  `json:"sent" yaml:"sent"`
+	Committed int                  `json:"committed" yaml:"committed"`
+	Failed    int                  `json:"failed" yaml:"failed"`
+	Seconds   float64              `json:"seconds" yaml:"seconds"`
+	TPS       float64              `json:"tps" yaml:"tps"`
+	Blocks    int                  `json:"blocks" yaml:"blocks"`
+	CheckTx   stressLatency        `json:"check_tx_latency" yaml:"check_tx_latency"`
+	Commit    stressLatency        `json:"commit_latency" yaml:"commit_latency"`
+	Gas       map[string]stressGas `json:"gas_used" yaml:"gas_used"`
+	Errors    map[string]int       `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// stressLatency is a summary of latencies, in milliseconds.
+type stressLatency struct {
+	P50 int64 `json:"p50_ms" yaml:"p50_ms"`
+	P90 int64 `json:"p90_ms" yaml:"p90_ms"`
+	P99 int64 `json:"p99_ms" yaml:"p99_ms"`
+	Max int64 `json:"max_ms" yaml:"max_ms"`
+}
+
+// stressGas is a summary of the gas used by the committed transactions of an op type.
+type stressGas struct {
+	Count int   `json:"count" yaml:"count"`
+	Min   int64 `json:"min" yaml:"min"`
+	Avg   int64 `json:"avg" yaml:"avg"`
+	Max   int64 `json:"max" yaml:"max"`
+}
+
+// newStressReport summarizes the results of a stress run.
+func newStressReport(results []*stressResult) stressReport {
+	report := stressReport{
+		Sent:   len(results),
+		Gas:    make(map[string]stressGas),
+		Errors: make(map[string]int),
+	}
+	var checkTx, commit []time.Duration
+	gasUsed := make(map[stressOpType][]int64)
+	heights := make(map[int64]bool)
+	var first, last time.Time
+	for _, result := range results {
+		if first.IsZero() || result.Sent.Before(first) {
+			first = result.Sent
+		}
+		if result.Sent.After(last) {
+			last = result.Sent
+		}
+		checkTx = append(checkTx, result.CheckTx)
+		if len(result.Err) > 0 {
+			report.Failed++
+			report.Errors[stressErrorKey(result.Err)]++
+			continue
+		}
+		if result.Height == 0 {
+			continue
+		}
+		report.Committed++
+		heights[result.Height] = true
+		if result.Committed > 0 {
+			commit = append(commit, result.Committed)
+		}
+		gasUsed[result.Op.Type] = append(gasUsed[result.Op.Type], result.GasUsed)
+	}
+	report.Blocks = len(heights)
+	report.Seconds = last.Sub(first).Seconds()
+	if report.Seconds > 0 {
+		report.TPS = float64(report.Sent-1) / report.Seconds
+	}
+	report.CheckTx = newStressLatency(checkTx)
+	report.Commit = newStressLatency(commit)
+	for _, opType := range stressOpTypes {
+		if used := gasUsed[opType]; len(used) > 0 {
+			report.Gas[string(opType)] = newStressGas(used)
+		}
+	}
+	return report
+}
+
+// stressErrorKey groups failures by the first line of their error, without the tx specific details after a colon.
+func stressErrorKey(err string) string {
+	key := strings.SplitN(err, "\n", 2)[0]
+	if i := strings.LastIndex(key, ": "); i >= 0 {
+		key = key[i+2:]
+	}
+	return key
+}
+
+// newStressLatency returns the percentiles of the latencies.
+func newStressLatency(latencies []time.Duration) stressLatency {
+	if len(latencies) == 0 {
+		return stressLatency{}
+	}
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	percentile := func(p int) int64 {
+		return sorted[(len(sorted)-1)*p/100].Milliseconds()
+	}
+	return stressLatency{
+		P50: percentile(50),
+		P90: percentile(90),
+		P99: percentile(99),
+		Max: sorted[len(sorted)-1].Milliseconds(),
+	}
+}
+
+// newStressGas returns the min, average, and max of the gas used.
+func newStressGas(used []int64) stressGas {
+	gas := stressGas{Count: len(used), Min: used[0], Max: used[0]}
+	var total int64
+	for _, u := range used {
+		total += u
+		if u < gas.Min {
+			gas.Min = u
+		}
+		if u > gas.Max {
+			gas.Max = u
+		}
+	}
+	gas.Avg = total / int64(len(used))
+	return gas
+}
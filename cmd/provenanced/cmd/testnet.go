@@ -95,6 +95,8 @@ Note, strict routability for addresses is turned off in the config file.
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
 	cmd.Flags().String(flags.FlagKeyAlgorithm, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
 
+	cmd.AddCommand(testnetStressCmd())
+
 	return cmd
 }
 
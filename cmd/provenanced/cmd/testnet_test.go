@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -49,3 +50,51 @@ func Test_TestnetCmd(t *testing.T) {
 	bankGenState := banktypes.GetGenesisStateFromAppState(encodingConfig.Marshaler, appState)
 	require.NotEmpty(t, bankGenState.Supply.String())
 }
+
+func Test_StressPlan(t *testing.T) {
+	plan := stressPlan(7, 10, 5, 200)
+	require.Equal(t, plan, stressPlan(7, 10, 5, 200), "the same seed must send the same transactions")
+	require.NotEqual(t, plan, stressPlan(8, 10, 5, 200), "a different seed should send different transactions")
+
+	counts := make(map[stressOpType]int)
+	for _, op := range plan {
+		require.Less(t, op.Marker, 10)
+		require.Less(t, op.Holder, 5)
+		require.Greater(t, op.Amount, int64(0))
+		counts[op.Type]++
+	}
+	for _, opType := range stressOpTypes {
+		require.Greater(t, counts[opType], 0, "op type %s", opType)
+	}
+}
+
+func Test_StressReport(t *testing.T) {
+	start := time.Now()
+	var results []*stressResult
+	for i := 0; i < 100; i++ {
+		results = append(results, &stressResult{
+			Op:        stressOp{Type: stressOpTransfer},
+			Sent:      start.Add(time.Duration(i) * 10 * time.Millisecond),
+			CheckTx:   time.Duration(i+1) * time.Millisecond,
+			Committed: time.Second,
+			Height:    int64(1 + i/50),
+			GasUsed:   int64(1000 + i),
+		})
+	}
+	results = append(results, &stressResult{
+		Op:   stressOp{Type: stressOpMint},
+		Sent: start.Add(time.Second),
+		Err:  "failed to execute message; message index: 0: insufficient funds",
+	})
+
+	report := newStressReport(results)
+	require.Equal(t, 101, report.Sent)
+	require.Equal(t, 100, report.Committed)
+	require.Equal(t, 1, report.Failed)
+	require.Equal(t, 2, report.Blocks)
+	require.InDelta(t, 100, report.TPS, 0.01)
+	require.Equal(t, stressLatency{P50: 50, P90: 90, P99: 99, Max: 100}, report.CheckTx)
+	require.Equal(t, int64(1000), report.Commit.Max)
+	require.Equal(t, stressGas{Count: 100, Min: 1000, Avg: 1049, Max: 1099}, report.Gas[string(stressOpTransfer)])
+	require.Equal(t, map[string]int{"insufficient funds": 1}, report.Errors)
+}
@@ -0,0 +1,73 @@
+package antewrapper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MempoolFeeDecorator will check if the transaction's fee is at least as large
+// as the local validator's minimum gasFee (defined in validator config).
+// If fee is too low, decorator returns error and tx is rejected from mempool.
+// Unlike the SDK decorator, the rejection lists each component of the required
+// fee so users can correct the fee without guesswork.
+// Note this only applies when ctx.CheckTx = true
+type MempoolFeeDecorator struct{}
+
+// NewMempoolFeeDecorator creates a new MempoolFeeDecorator
+func NewMempoolFeeDecorator() MempoolFeeDecorator {
+	return MempoolFeeDecorator{}
+}
+
+var _ sdk.AnteDecorator = MempoolFeeDecorator{}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// Ensure that the provided fees meet a minimum threshold for the validator,
+	// if this is a CheckTx. This is only for local mempool purposes, and thus
+	// is only ran on check tx.
+	if ctx.IsCheckTx() && !simulate {
+		minGasPrices := ctx.MinGasPrices()
+		if !minGasPrices.IsZero() {
+			feeCoins := feeTx.GetFee()
+			requiredFees := RequiredGasFees(minGasPrices, feeTx.GetGas())
+			if !feeCoins.IsAnyGTE(requiredFees) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s; %s",
+					feeCoins, requiredFees, DescribeRequiredGasFees(minGasPrices, feeTx.GetGas()))
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// RequiredGasFees returns the fee required in each denom of the minimum gas prices, where
+// fee = ceil(minGasPrice * gasLimit).  Paying any one of them satisfies the minimum.
+func RequiredGasFees(minGasPrices sdk.DecCoins, gas uint64) sdk.Coins {
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+	glDec := sdk.NewDec(int64(gas))
+	for i, gp := range minGasPrices {
+		fee := gp.Amount.Mul(glDec)
+		requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	}
+	return requiredFees
+}
+
+// DescribeRequiredGasFees returns a breakdown of the gas fee required for a tx at each floor gas price.
+// Additional per msg fees are charged by the msgfees module, which is not in this tree, so only the
+// base gas fee is itemized.
+func DescribeRequiredGasFees(minGasPrices sdk.DecCoins, gas uint64) string {
+	options := make([]string, len(minGasPrices))
+	for i, gp := range minGasPrices {
+		fee := RequiredGasFees(sdk.DecCoins{gp}, gas)
+		options[i] = fmt.Sprintf("%s (%d gas at %s per gas)", fee, gas, gp)
+	}
+	return fmt.Sprintf("gas fee: %s", strings.Join(options, " or "))
+}
@@ -0,0 +1,52 @@
+package antewrapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// feeTx is a minimal sdk.FeeTx for exercising the fee decorator.
+type feeTx struct {
+	msgs []sdk.Msg
+	fee  sdk.Coins
+	gas  uint64
+}
+
+func (tx feeTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx feeTx) ValidateBasic() error       { return nil }
+func (tx feeTx) GetGas() uint64             { return tx.gas }
+func (tx feeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx feeTx) FeePayer() sdk.AccAddress   { return nil }
+func (tx feeTx) FeeGranter() sdk.AccAddress { return nil }
+
+func TestMempoolFeeDecorator(t *testing.T) {
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("nhash", sdk.MustNewDecFromStr("1.5")), sdk.NewDecCoin("stake", sdk.NewInt(2)))
+	ctx := sdk.NewContext(ms, tmproto.Header{}, true, log.NewNopLogger()).WithMinGasPrices(minGasPrices)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	decorator := NewMempoolFeeDecorator()
+	msg := &banktypes.MsgSend{}
+
+	_, err := decorator.AnteHandle(ctx, feeTx{msgs: []sdk.Msg{msg}, fee: sdk.NewCoins(sdk.NewInt64Coin("nhash", 10)), gas: 101}, false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	require.Equal(t, "insufficient fees; got: 10nhash required: 152nhash,202stake; "+
+		"gas fee: 152nhash (101 gas at 1.500000000000000000nhash per gas) or 202stake (101 gas at 2.000000000000000000stake per gas): insufficient fee", err.Error())
+
+	_, err = decorator.AnteHandle(ctx, feeTx{msgs: []sdk.Msg{msg}, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 202)), gas: 101}, false, next)
+	require.NoError(t, err, "any one of the required fees is sufficient")
+
+	_, err = decorator.AnteHandle(ctx, feeTx{msgs: []sdk.Msg{msg}, gas: 101}, true, next)
+	require.NoError(t, err, "fees are not checked during simulation")
+
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), feeTx{msgs: []sdk.Msg{msg}, gas: 101}, false, next)
+	require.NoError(t, err, "fees are only checked during CheckTx")
+}
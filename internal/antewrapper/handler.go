@@ -4,9 +4,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	smartaccountsante "github.com/provenance-io/provenance/x/smartaccounts/ante"
 )
 
-func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
+// HandlerOptions are the options required for constructing the provenance AnteHandler.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	// SmartAccountKeeper verifies signatures made with the authenticators registered for an account.
+	SmartAccountKeeper smartaccountsante.SmartAccountKeeper
+}
+
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.AccountKeeper == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
 	}
@@ -19,6 +29,10 @@ func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	if options.SmartAccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "smart account keeper is required for ante builder")
+	}
+
 	var sigGasConsumer = options.SigGasConsumer
 	if sigGasConsumer == nil {
 		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
@@ -28,16 +42,18 @@ func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewGasTracerContextDecorator(),  // gas meter tracer must follow initial context setup
 		ante.NewRejectExtensionOptionsDecorator(),
-		ante.NewMempoolFeeDecorator(),
+		NewMempoolFeeDecorator(),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
-		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		// smart account signatures are verified first, the SDK signature decorators are skipped for those txs
+		smartaccountsante.NewSigVerificationDecorator(options.AccountKeeper, options.SmartAccountKeeper, options.SignModeHandler, sigGasConsumer),
+		smartaccountsante.SkipIfAuthenticated(ante.NewSetPubKeyDecorator(options.AccountKeeper)), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		smartaccountsante.SkipIfAuthenticated(ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer)),
+		smartaccountsante.SkipIfAuthenticated(ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler)),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 	}
 
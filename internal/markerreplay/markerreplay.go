@@ -0,0 +1,253 @@
+package markerreplay
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// State is the part of the state of a marker that can be reconstructed from its typed events.
+type State struct {
+	// Denom is the denom of the marker.
+	Denom string
+	// Exists is false when the marker has not been added or has been removed.
+	Exists bool
+	// Status is the name of the status of the marker.
+	Status string
+	// MarkerType is the name of the type of the marker.
+	MarkerType string
+	// Supply is the supply of the marker.
+	Supply sdk.Int
+	// Access holds the sorted names of the permissions granted to each address.
+	Access map[string][]string
+}
+
+// NewState returns the state of a marker that does not exist.
+func NewState(denom string) *State {
+	return &State{Denom: denom, Supply: sdk.ZeroInt(), Access: map[string][]string{}}
+}
+
+// StateFromMarker returns the state of a marker, nil for one that does not exist.  The supply of a marker that has been
+// activated is its circulation in the bank module, since the supply of a floating supply marker is not updated once it
+// is active.  Access grants that expired before the block time are left out since they are removed the next time the
+// marker is saved.
+func StateFromMarker(denom string, m types.MarkerAccountI, circulation sdk.Int, blockTime time.Time) *State {
+	state := NewState(denom)
+	if m == nil {
+		return state
+	}
+	state.Exists = true
+	state.Status = m.GetStatus().String()
+	state.MarkerType = m.GetMarkerType().String()
+	state.Supply = m.GetSupply().Amount
+	if m.GetStatus() == types.StatusActive || m.GetStatus() == types.StatusCancelled {
+		state.Supply = circulation
+	}
+	for _, grant := range m.GetAccessList() {
+		if grant.IsExpired(blockTime) {
+			continue
+		}
+		state.grant(grant.Address, accessNames(grant.Permissions))
+	}
+	return state
+}
+
+// Replayer applies the typed events of a marker to its state.
+type Replayer struct {
+	state *State
+	// Applied is the number of events applied to the state.
+	Applied int
+}
+
+// NewReplayer creates a replayer starting from the given state.
+func NewReplayer(start *State) *Replayer {
+	return &Replayer{state: start}
+}
+
+// State returns the state reconstructed so far.
+func (r *Replayer) State() *State {
+	return r.state
+}
+
+// ApplyEvents applies the marker typed events, in order, of a block to the state.  Events of other types or markers
+// are ignored.
+func (r *Replayer) ApplyEvents(height int64, events []abci.Event) error {
+	for _, event := range events {
+		if !strings.HasPrefix(event.Type, "provenance.marker.v1.EventMarker") {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return fmt.Errorf("could not parse %s event at height %d: %w", event.Type, height, err)
+		}
+		applied, err := r.apply(msg)
+		if err != nil {
+			return fmt.Errorf("could not apply %s event at height %d: %w", event.Type, height, err)
+		}
+		if applied {
+			r.Applied++
+		}
+	}
+	return nil
+}
+
+// apply applies a typed event to the state, returning false when the event does not change the state of the marker.
+func (r *Replayer) apply(msg interface{}) (bool, error) {
+	s := r.state
+	switch e := msg.(type) {
+	case *types.EventMarkerAdd:
+		if e.Denom != s.Denom {
+			return false, nil
+		}
+		supply, ok := sdk.NewIntFromString(e.Amount)
+		if !ok {
+			return false, fmt.Errorf("invalid amount %q", e.Amount)
+		}
+		*s = *NewState(s.Denom)
+		s.Exists, s.Status, s.MarkerType, s.Supply = true, e.Status, e.MarkerType, supply
+	case *types.EventMarkerAddAccess:
+		if e.Denom != s.Denom {
+			return false, nil
+		}
+		s.grant(e.Access.Address, e.Access.Permissions)
+	case *types.EventMarkerDeleteAccess:
+		if e.Denom != s.Denom {
+			return false, nil
+		}
+		delete(s.Access, e.RemoveAddress)
+	case *types.EventMarkerAccessExpired:
+		if e.Denom != s.Denom {
+			return false, nil
+		}
+		delete(s.Access, e.Address)
+	case *types.EventMarkerFinalize:
+		return s.setStatus(e.Denom, types.StatusFinalized), nil
+	case *types.EventMarkerActivate:
+		return s.setStatus(e.Denom, types.StatusActive), nil
+	case *types.EventMarkerCancel:
+		return s.setStatus(e.Denom, types.StatusCancelled), nil
+	case *types.EventMarkerDelete:
+		return s.setStatus(e.Denom, types.StatusDestroyed), nil
+	case *types.EventMarkerRemoved:
+		if e.Denom != s.Denom {
+			return false, nil
+		}
+		*s = *NewState(s.Denom)
+	case *types.EventMarkerMint:
+		return s.adjustSupply(e.Denom, e.Amount, false)
+	case *types.EventMarkerBurn:
+		return s.adjustSupply(e.Denom, e.Amount, true)
+	case *types.EventMarkerBurnFrom:
+		return s.adjustSupply(e.Denom, e.Amount, true)
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// grant merges the permissions into those of the address, as granting access to a marker does.
+func (s *State) grant(address string, permissions []string) {
+	merged := append([]string{}, s.Access[address]...)
+	for _, p := range permissions {
+		if !contains(merged, p) {
+			merged = append(merged, p)
+		}
+	}
+	sort.Strings(merged)
+	s.Access[address] = merged
+}
+
+func (s *State) setStatus(denom string, status types.MarkerStatus) bool {
+	if denom != s.Denom {
+		return false
+	}
+	s.Status = status.String()
+	return true
+}
+
+func (s *State) adjustSupply(denom, amount string, decrease bool) (bool, error) {
+	if denom != s.Denom {
+		return false, nil
+	}
+	amt, ok := sdk.NewIntFromString(amount)
+	if !ok {
+		return false, fmt.Errorf("invalid amount %q", amount)
+	}
+	if decrease {
+		amt = amt.Neg()
+	}
+	s.Supply = s.Supply.Add(amt)
+	return true, nil
+}
+
+// Difference is a field whose reconstructed value differs from the value in the store.
+type Difference struct {
+	Field         string
+	Reconstructed string
+	Stored        string
+}
+
+// String implements stringer interface
+func (d Difference) String() string {
+	return fmt.Sprintf("%s: reconstructed %q, stored %q", d.Field, d.Reconstructed, d.Stored)
+}
+
+// Diff returns the differences between the reconstructed and the stored state of a marker.
+func Diff(reconstructed, stored *State) []Difference {
+	var diffs []Difference
+	add := func(field, r, s string) {
+		if r != s {
+			diffs = append(diffs, Difference{Field: field, Reconstructed: r, Stored: s})
+		}
+	}
+	add("exists", fmt.Sprint(reconstructed.Exists), fmt.Sprint(stored.Exists))
+	if !reconstructed.Exists || !stored.Exists {
+		return diffs
+	}
+	add("status", reconstructed.Status, stored.Status)
+	add("marker_type", reconstructed.MarkerType, stored.MarkerType)
+	// The escrow of a destroyed marker is burned without an event.
+	if reconstructed.Status != types.StatusDestroyed.String() && stored.Status != types.StatusDestroyed.String() {
+		add("supply", reconstructed.Supply.String(), stored.Supply.String())
+	}
+	addresses := map[string]bool{}
+	for addr := range reconstructed.Access {
+		addresses[addr] = true
+	}
+	for addr := range stored.Access {
+		addresses[addr] = true
+	}
+	sorted := make([]string, 0, len(addresses))
+	for addr := range addresses {
+		sorted = append(sorted, addr)
+	}
+	sort.Strings(sorted)
+	for _, addr := range sorted {
+		add("access "+addr, strings.Join(reconstructed.Access[addr], ","), strings.Join(stored.Access[addr], ","))
+	}
+	return diffs
+}
+
+func accessNames(list types.AccessList) []string {
+	names := make([]string, len(list))
+	for i, a := range list {
+		names[i] = a.String()
+	}
+	return names
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package markerreplay
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func typedEvents(t *testing.T, msgs ...proto.Message) []abci.Event {
+	events := make([]abci.Event, len(msgs))
+	for i, msg := range msgs {
+		event, err := sdk.TypedEventToEvent(msg)
+		require.NoError(t, err)
+		events[i] = abci.Event(event)
+	}
+	return events
+}
+
+func TestReplay(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	user := sdk.AccAddress("user________________")
+	grant := types.NewAccessGrant(admin, []types.Access{types.Access_Mint, types.Access_Admin})
+
+	replayer := NewReplayer(NewState("testcoin"))
+	require.NoError(t, replayer.ApplyEvents(1, typedEvents(t,
+		types.NewEventMarkerAdd("testcoin", "1000", types.StatusProposed.String(), admin.String(), types.MarkerType_Coin.String()),
+		types.NewEventMarkerAddAccess(grant, "testcoin", admin.String()),
+		types.NewEventMarkerAddAccess(types.NewAccessGrant(user, []types.Access{types.Access_Burn}), "testcoin", admin.String()),
+		types.NewEventMarkerAdd("othercoin", "5", types.StatusProposed.String(), admin.String(), types.MarkerType_Coin.String()),
+	)))
+	require.NoError(t, replayer.ApplyEvents(2, append(typedEvents(t,
+		types.NewEventMarkerFinalize("testcoin", admin.String(), nil),
+		types.NewEventMarkerActivate("testcoin", admin.String()),
+		types.NewEventMarkerMint("500", "testcoin", admin.String()),
+		types.NewEventMarkerBurn("200", "testcoin", admin.String()),
+		types.NewEventMarkerDeleteAccess(user.String(), "testcoin", admin.String()),
+		types.NewEventMarkerMint("7", "othercoin", admin.String()),
+	), abci.Event{Type: "message"})))
+	require.Equal(t, 8, replayer.Applied, "events of other markers and other types are not applied")
+
+	state := replayer.State()
+	require.True(t, state.Exists)
+	require.Equal(t, types.StatusActive.String(), state.Status)
+	require.Equal(t, sdk.NewInt(1300), state.Supply)
+	require.Equal(t, map[string][]string{admin.String(): {"ACCESS_ADMIN", "ACCESS_MINT"}}, state.Access)
+
+	marker := types.NewEmptyMarkerAccount("testcoin", admin.String(), []types.AccessGrant{*grant})
+	marker.Status = types.StatusActive
+	require.NoError(t, marker.SetSupply(sdk.NewInt64Coin("testcoin", 1300)))
+	require.Empty(t, Diff(state, StateFromMarker("testcoin", marker, sdk.NewInt(1300), time.Now())))
+
+	// a change made without an event is reported
+	require.NoError(t, marker.GrantAccess(types.NewAccessGrant(user, []types.Access{types.Access_Withdraw})))
+	require.Equal(t, []Difference{
+		{Field: "supply", Reconstructed: "1300", Stored: "1400"},
+		{Field: "access " + user.String(), Reconstructed: "", Stored: "ACCESS_WITHDRAW"},
+	}, Diff(state, StateFromMarker("testcoin", marker, sdk.NewInt(1400), time.Now())))
+
+	require.NoError(t, replayer.ApplyEvents(3, typedEvents(t, types.NewEventMarkerRemoved("testcoin"))))
+	require.Equal(t, []Difference{{Field: "exists", Reconstructed: "false", Stored: "true"}},
+		Diff(replayer.State(), StateFromMarker("testcoin", marker, sdk.NewInt(1300), time.Now())))
+	require.Empty(t, Diff(replayer.State(), StateFromMarker("testcoin", nil, sdk.ZeroInt(), time.Now())))
+
+	err := replayer.ApplyEvents(4, []abci.Event{{Type: "provenance.marker.v1.EventMarkerMint",
+		Attributes: []abci.EventAttribute{{Key: []byte("amount"), Value: []byte(`"lots"`)}, {Key: []byte("denom"), Value: []byte(`"testcoin"`)}}}})
+	require.EqualError(t, err, `could not apply provenance.marker.v1.EventMarkerMint event at height 4: invalid amount "lots"`)
+}
@@ -0,0 +1,148 @@
+package psql
+
+import (
+	"bytes"
+	"compress/gzip"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+
+	_ "github.com/lib/pq" // provide the psql db driver
+
+	_ "github.com/provenance-io/provenance/x/attribute/types" // registers the attribute events
+	_ "github.com/provenance-io/provenance/x/marker/types"    // registers the marker events
+	_ "github.com/provenance-io/provenance/x/metadata/types"  // registers the metadata events
+	_ "github.com/provenance-io/provenance/x/name/types"      // registers the name events
+)
+
+// EventFiles are the proto files with the provenance typed events that get views in the tendermint psql event sink.
+var EventFiles = []string{
+	"provenance/attribute/v1/attribute.proto",
+	"provenance/marker/v1/marker.proto",
+	"provenance/metadata/v1/events.proto",
+	"provenance/name/v1/name.proto",
+}
+
+// EventView is a view of one typed event with a column for each event field.
+type EventView struct {
+	// Name is the full proto name of the event, which is also the event type and the name of the view.
+	Name string
+	// Fields are the proto field names of the event, which are also the event attribute keys.
+	Fields []string
+	// JSONFields are the fields holding messages or lists, which are kept as jsonb rather than unwrapped to text.
+	JSONFields map[string]bool
+}
+
+// GetEventViews returns a view for each typed event defined in the given proto files.  Messages named like events that
+// are only used as fields of other events are skipped.
+func GetEventViews(files ...string) ([]EventView, error) {
+	var views []EventView
+	for _, file := range files {
+		fd, err := fileDescriptor(file)
+		if err != nil {
+			return nil, err
+		}
+		nested := make(map[string]bool)
+		for _, msg := range fd.MessageType {
+			for _, field := range msg.Field {
+				if field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+					nested[field.GetTypeName()] = true
+				}
+			}
+		}
+		for _, msg := range fd.MessageType {
+			name := fd.GetPackage() + "." + msg.GetName()
+			if !strings.HasPrefix(msg.GetName(), "Event") || nested["."+name] {
+				continue
+			}
+			view := EventView{Name: name, JSONFields: make(map[string]bool)}
+			for _, field := range msg.Field {
+				view.Fields = append(view.Fields, field.GetName())
+				if field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE ||
+					field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+					view.JSONFields[field.GetName()] = true
+				}
+			}
+			views = append(views, view)
+		}
+	}
+	return views, nil
+}
+
+func fileDescriptor(file string) (*descriptor.FileDescriptorProto, error) {
+	gz := proto.FileDescriptor(file)
+	if gz == nil {
+		return nil, fmt.Errorf("proto file %s is not registered", file)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, fmt.Errorf("could not read proto file %s: %w", file, err)
+	}
+	bz, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not read proto file %s: %w", file, err)
+	}
+	fd := &descriptor.FileDescriptorProto{}
+	if err = proto.Unmarshal(bz, fd); err != nil {
+		return nil, fmt.Errorf("could not read proto file %s: %w", file, err)
+	}
+	return fd, nil
+}
+
+// SQL returns the statements that (re)create the view.  Typed event attribute values are json encoded, so scalar
+// values are unwrapped to text and messages and lists are kept as jsonb.
+func (v EventView) SQL() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("DROP VIEW IF EXISTS %q;\n", v.Name))
+	sb.WriteString(fmt.Sprintf("CREATE VIEW %q AS\n", v.Name))
+	sb.WriteString("  SELECT events.rowid AS event_id, blocks.height, blocks.chain_id, tx_results.tx_hash")
+	for _, field := range v.Fields {
+		value := fmt.Sprintf("(MAX(attributes.value) FILTER (WHERE attributes.key = '%s'))::jsonb", field)
+		if !v.JSONFields[field] {
+			value += " #>> '{}'"
+		}
+		sb.WriteString(fmt.Sprintf(",\n    %s AS %q", value, field))
+	}
+	sb.WriteString("\n  FROM events\n")
+	sb.WriteString("  JOIN blocks ON (blocks.rowid = events.block_id)\n")
+	sb.WriteString("  LEFT JOIN tx_results ON (tx_results.rowid = events.tx_id)\n")
+	sb.WriteString("  LEFT JOIN attributes ON (attributes.event_id = events.rowid)\n")
+	sb.WriteString(fmt.Sprintf("  WHERE events.type = '%s'\n", v.Name))
+	sb.WriteString("  GROUP BY events.rowid, blocks.height, blocks.chain_id, tx_results.tx_hash;\n")
+	return sb.String()
+}
+
+// ViewsSQL returns the statements that (re)create all of the views.
+func ViewsSQL(views []EventView) string {
+	sqls := make([]string, len(views))
+	for i, view := range views {
+		sqls[i] = view.SQL()
+	}
+	return strings.Join(sqls, "\n")
+}
+
+// Migrate (re)creates all of the views in the database of the tendermint psql event sink in a single transaction.  The
+// tendermint event sink schema must already be installed.
+func Migrate(conn string, views []EventView) error {
+	db, err := sql.Open("postgres", conn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	dbtx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, view := range views {
+		if _, err = dbtx.Exec(view.SQL()); err != nil {
+			_ = dbtx.Rollback()
+			return fmt.Errorf("could not create view %s: %w", view.Name, err)
+		}
+	}
+	return dbtx.Commit()
+}
@@ -0,0 +1,60 @@
+package psql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEventViews(t *testing.T) {
+	views, err := GetEventViews(EventFiles...)
+	require.NoError(t, err)
+
+	byName := make(map[string]EventView, len(views))
+	for _, view := range views {
+		// postgres truncates identifiers longer than 63 bytes
+		assert.LessOrEqual(t, len(view.Name), 63, "view name %s", view.Name)
+		byName[view.Name] = view
+	}
+
+	for _, name := range []string{
+		"provenance.attribute.v1.EventAttributeAdd",
+		"provenance.marker.v1.EventMarkerFinalize",
+		"provenance.metadata.v1.EventScopeCreated",
+		"provenance.name.v1.EventNameBound",
+	} {
+		assert.Contains(t, byName, name)
+	}
+	assert.NotContains(t, byName, "provenance.marker.v1.EventDenomUnit", "nested event message")
+	assert.NotContains(t, byName, "provenance.marker.v1.MarkerAccount", "non-event message")
+
+	finalize := byName["provenance.marker.v1.EventMarkerFinalize"]
+	assert.Equal(t, []string{"denom", "administrator", "summary"}, finalize.Fields)
+	assert.Equal(t, map[string]bool{"summary": true}, finalize.JSONFields)
+
+	_, err = GetEventViews("provenance/unknown/v1/unknown.proto")
+	assert.EqualError(t, err, "proto file provenance/unknown/v1/unknown.proto is not registered")
+}
+
+func TestEventViewSQL(t *testing.T) {
+	view := EventView{
+		Name:       "provenance.marker.v1.EventMarkerAddAccess",
+		Fields:     []string{"access", "denom"},
+		JSONFields: map[string]bool{"access": true},
+	}
+	expected := `DROP VIEW IF EXISTS "provenance.marker.v1.EventMarkerAddAccess";
+CREATE VIEW "provenance.marker.v1.EventMarkerAddAccess" AS
+  SELECT events.rowid AS event_id, blocks.height, blocks.chain_id, tx_results.tx_hash,
+    (MAX(attributes.value) FILTER (WHERE attributes.key = 'access'))::jsonb AS "access",
+    (MAX(attributes.value) FILTER (WHERE attributes.key = 'denom'))::jsonb #>> '{}' AS "denom"
+  FROM events
+  JOIN blocks ON (blocks.rowid = events.block_id)
+  LEFT JOIN tx_results ON (tx_results.rowid = events.tx_id)
+  LEFT JOIN attributes ON (attributes.event_id = events.rowid)
+  WHERE events.type = 'provenance.marker.v1.EventMarkerAddAccess'
+  GROUP BY events.rowid, blocks.height, blocks.chain_id, tx_results.tx_hash;
+`
+	assert.Equal(t, expected, view.SQL())
+	assert.Equal(t, expected+"\n"+expected, ViewsSQL([]EventView{view, view}))
+}
@@ -0,0 +1,207 @@
+package querythrottle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/spf13/cast"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const (
+	// FlagInFlight is the number of in-flight provenance queries at which the node enters degraded query mode.
+	FlagInFlight = "query-degrade-in-flight"
+	// FlagPageLimit is the largest page of results served while the node is in degraded query mode.
+	FlagPageLimit = "query-degrade-page-limit"
+	// FlagRetryAfter is the time rejected clients are told to wait before retrying.
+	FlagRetryAfter = "query-degrade-retry-after"
+
+	// DefaultPageLimit is the default largest page of results served while the node is in degraded query mode.
+	DefaultPageLimit = 50
+	// DefaultRetryAfter is the default time rejected clients are told to wait before retrying.
+	DefaultRetryAfter = 5 * time.Second
+
+	// RetryAfterHeader is the grpc header holding the number of seconds a rejected client should wait before retrying.
+	RetryAfterHeader = "retry-after"
+	// ServicePrefix is the prefix of the names of the query services the throttle applies to.
+	ServicePrefix = "provenance."
+)
+
+// Config is the node configuration of degraded query mode.
+type Config struct {
+	// InFlight is the number of in-flight queries at which degraded mode starts, zero to disable it.  Degraded mode
+	// ends once the number in flight drops to half of it.
+	InFlight int
+	// PageLimit is the largest page of results served in degraded mode.
+	PageLimit uint64
+	// RetryAfter is the time rejected clients are told to wait before retrying.
+	RetryAfter time.Duration
+}
+
+// ConfigFromAppOptions reads the degraded query mode configuration from the node options.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	cfg := Config{
+		InFlight:   cast.ToInt(appOpts.Get(FlagInFlight)),
+		PageLimit:  cast.ToUint64(appOpts.Get(FlagPageLimit)),
+		RetryAfter: cast.ToDuration(appOpts.Get(FlagRetryAfter)),
+	}
+	if cfg.PageLimit == 0 {
+		cfg.PageLimit = DefaultPageLimit
+	}
+	if cfg.RetryAfter <= 0 {
+		cfg.RetryAfter = DefaultRetryAfter
+	}
+	return cfg
+}
+
+// Throttle counts the provenance queries in flight and, while the count is high, rejects the expensive ones with a
+// retry hint so that cheap lookups keep being served.  A query is expensive when it pages through results with a page
+// larger than the degraded page limit, the default page size included, or asks for a total count or an offset, all
+// of which scan the store.
+type Throttle struct {
+	logger log.Logger
+	cfg    Config
+
+	mu       sync.Mutex
+	inFlight int
+	degraded bool
+}
+
+// New creates a throttle with the given configuration, nil when degraded query mode is disabled.
+func New(logger log.Logger, cfg Config) *Throttle {
+	if cfg.InFlight <= 0 {
+		return nil
+	}
+	return &Throttle{logger: logger.With("module", "querythrottle"), cfg: cfg}
+}
+
+// Degraded returns true while the node is in degraded query mode.
+func (t *Throttle) Degraded() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.degraded
+}
+
+// WrapServer returns a grpc server that registers provenance query services with their method handlers throttled.
+// It is meant for the external grpc server of a node only, never for the query router of the app, which also serves
+// queries made while executing txs where results must not depend on the load of the node.  The server is returned
+// unchanged when the throttle is nil.
+func (t *Throttle) WrapServer(server gogogrpc.Server) gogogrpc.Server {
+	if t == nil {
+		return server
+	}
+	return &throttledServer{Server: server, throttle: t}
+}
+
+// Intercept runs a query handler unless the node is degraded and the query is expensive.  It has the signature of a
+// grpc unary server interceptor.
+func (t *Throttle) Intercept(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	degraded := t.enter()
+	defer t.exit()
+	if err := t.check(ctx, degraded, req, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// check returns an error with a retry hint if the node is degraded and the query is expensive.
+func (t *Throttle) check(ctx context.Context, degraded bool, req interface{}, fullMethod string) error {
+	if !degraded || !t.isExpensive(req) {
+		return nil
+	}
+	telemetry.IncrCounter(1, "query", "degraded", "rejected")
+	// Headers can only be set on queries served over grpc, so the error of other paths is ignored.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, fmt.Sprintf("%d", int64(t.cfg.RetryAfter.Seconds()))))
+	return status.Errorf(codes.Unavailable,
+		"node is in degraded query mode, %s is rejected as an expensive query: use a page limit of at most %d or retry after %s",
+		fullMethod, t.cfg.PageLimit, t.cfg.RetryAfter)
+}
+
+// enter counts a query as in flight and returns whether the node is degraded.
+func (t *Throttle) enter() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inFlight++
+	if !t.degraded && t.inFlight >= t.cfg.InFlight {
+		t.degraded = true
+		t.logger.Info("entering degraded query mode", "in_flight", t.inFlight)
+		telemetry.SetGauge(1, "query", "degraded")
+	}
+	return t.degraded
+}
+
+// exit counts a query as done, leaving degraded mode once half of the threshold or fewer are in flight.
+func (t *Throttle) exit() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inFlight--
+	if t.degraded && t.inFlight <= t.cfg.InFlight/2 {
+		t.degraded = false
+		t.logger.Info("leaving degraded query mode", "in_flight", t.inFlight)
+		telemetry.SetGauge(0, "query", "degraded")
+	}
+}
+
+// isExpensive returns true if the request pages through results in a way that scans more than the page limit.
+func (t *Throttle) isExpensive(req interface{}) bool {
+	paginated, ok := req.(interface{ GetPagination() *query.PageRequest })
+	if !ok {
+		return false
+	}
+	page := paginated.GetPagination()
+	if page == nil {
+		return query.DefaultLimit > t.cfg.PageLimit
+	}
+	limit := page.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	return limit > t.cfg.PageLimit || page.CountTotal || page.Offset > 0
+}
+
+// throttledServer registers the query services of provenance modules with the throttle intercepting their methods.
+type throttledServer struct {
+	gogogrpc.Server
+	throttle *Throttle
+}
+
+// RegisterService registers a service, throttling its provenance methods.  The throttle runs once a request is decoded,
+// ahead of any interceptor, since the handlers registered by the app replace the interceptor given by the server.
+func (s *throttledServer) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	if !strings.HasPrefix(sd.ServiceName, ServicePrefix) {
+		s.Server.RegisterService(sd, ss)
+		return
+	}
+	throttled := *sd
+	throttled.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		methodHandler := method.Handler
+		fullMethod := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
+		throttled.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				degraded := s.throttle.enter()
+				defer s.throttle.exit()
+				return methodHandler(srv, ctx, func(req interface{}) error {
+					if err := dec(req); err != nil {
+						return err
+					}
+					return s.throttle.check(ctx, degraded, req, fullMethod)
+				}, interceptor)
+			},
+		}
+	}
+	s.Server.RegisterService(&throttled, ss)
+}
@@ -0,0 +1,137 @@
+package querythrottle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+type registrar struct {
+	descs []*grpc.ServiceDesc
+}
+
+func (r *registrar) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	r.descs = append(r.descs, sd)
+}
+
+func TestNew(t *testing.T) {
+	require.Nil(t, New(log.NewNopLogger(), Config{}), "disabled without an in-flight threshold")
+	server := &registrar{}
+	var throttle *Throttle
+	require.Same(t, server, throttle.WrapServer(server), "a disabled throttle does not wrap the server")
+}
+
+func TestIntercept(t *testing.T) {
+	throttle := New(log.NewNopLogger(), Config{InFlight: 2, PageLimit: 10, RetryAfter: DefaultRetryAfter})
+	info := &grpc.UnaryServerInfo{FullMethod: "/provenance.marker.v1.Query/AllMarkers"}
+	cheap := &markertypes.QueryMarkerRequest{Id: "nhash"}
+	smallPage := &markertypes.QueryAllMarkersRequest{Pagination: &query.PageRequest{Limit: 10}}
+	expensive := []interface{}{
+		&markertypes.QueryAllMarkersRequest{},
+		&markertypes.QueryAllMarkersRequest{Pagination: &query.PageRequest{Limit: 11}},
+		&markertypes.QueryAllMarkersRequest{Pagination: &query.PageRequest{Limit: 5, CountTotal: true}},
+		&markertypes.QueryAllMarkersRequest{Pagination: &query.PageRequest{Limit: 5, Offset: 20}},
+	}
+	served := func(context.Context, interface{}) (interface{}, error) { return "served", nil }
+
+	// everything is served below the threshold
+	for _, req := range append(expensive, cheap, smallPage) {
+		res, err := throttle.Intercept(context.Background(), req, info, served)
+		require.NoError(t, err)
+		require.Equal(t, "served", res)
+	}
+	require.False(t, throttle.Degraded())
+
+	// a query that is in flight when the threshold is reached puts the node in degraded mode
+	var results []error
+	_, err := throttle.Intercept(context.Background(), cheap, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		require.False(t, throttle.Degraded())
+		return throttle.Intercept(ctx, cheap, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			require.True(t, throttle.Degraded())
+			for _, r := range append(expensive, cheap, smallPage) {
+				_, err := throttle.Intercept(ctx, r, info, served)
+				results = append(results, err)
+			}
+			return nil, nil
+		})
+	})
+	require.NoError(t, err)
+	for i := range expensive {
+		require.Equal(t, codes.Unavailable, status.Code(results[i]), "expensive query %d", i)
+		require.Contains(t, results[i].Error(), "retry after 5s")
+	}
+	require.NoError(t, results[len(expensive)], "cheap lookups are served")
+	require.NoError(t, results[len(expensive)+1], "small pages are served")
+	require.False(t, throttle.Degraded(), "degraded mode ends once the queries finish")
+}
+
+// queryDesc returns a service description whose method decodes an all markers request like generated handlers do.
+func queryDesc(serviceName string) *grpc.ServiceDesc {
+	return &grpc.ServiceDesc{
+		ServiceName: serviceName,
+		Methods: []grpc.MethodDesc{{
+			MethodName: "AllMarkers",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(markertypes.QueryAllMarkersRequest)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "served", nil }
+				if interceptor == nil {
+					return handler(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + serviceName + "/AllMarkers"}
+				return interceptor(ctx, in, info, handler)
+			},
+		}},
+	}
+}
+
+func TestWrapServer(t *testing.T) {
+	throttle := New(log.NewNopLogger(), Config{InFlight: 1, PageLimit: 10, RetryAfter: DefaultRetryAfter})
+	server := &registrar{}
+	wrapped := throttle.WrapServer(server)
+	provenanceDesc, otherDesc := queryDesc("provenance.marker.v1.Query"), queryDesc("cosmos.bank.v1beta1.Query")
+	wrapped.RegisterService(provenanceDesc, nil)
+	wrapped.RegisterService(otherDesc, nil)
+	require.Len(t, server.descs, 2)
+	require.NotSame(t, provenanceDesc, server.descs[0], "provenance services are throttled")
+	require.Equal(t, provenanceDesc.ServiceName, server.descs[0].ServiceName)
+	require.Same(t, otherDesc, server.descs[1], "other services are not")
+
+	// with a threshold of one every query runs degraded, so unpaged scans are rejected on both query paths
+	decode := func(interface{}) error { return nil }
+	_, err := server.descs[0].Methods[0].Handler(nil, context.Background(), decode, nil)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	called := false
+	_, err = server.descs[0].Methods[0].Handler(nil, context.Background(), decode,
+		func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, h grpc.UnaryHandler) (interface{}, error) {
+			called = true
+			return h(ctx, req)
+		})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.False(t, called, "the throttle runs before the interceptor given to the handler")
+	res, err := server.descs[1].Methods[0].Handler(nil, context.Background(), decode, nil)
+	require.NoError(t, err)
+	require.Equal(t, "served", res)
+
+	// queries with small pages are served through the given interceptor
+	_, err = server.descs[0].Methods[0].Handler(nil, context.Background(), func(in interface{}) error {
+		in.(*markertypes.QueryAllMarkersRequest).Pagination = &query.PageRequest{Limit: 10}
+		return nil
+	}, func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, h grpc.UnaryHandler) (interface{}, error) {
+		called = true
+		return h(ctx, req)
+	})
+	require.NoError(t, err)
+	require.True(t, called)
+}
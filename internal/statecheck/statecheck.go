@@ -0,0 +1,180 @@
+package statecheck
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// MultiStore is the committed state the worker checks.  It is satisfied by the root multi store.
+type MultiStore interface {
+	storetypes.Queryable
+	LastCommitID() storetypes.CommitID
+	CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error)
+}
+
+// Mismatch describes a store key whose value could not be proven against the committed root hash.
+type Mismatch struct {
+	// Store is the name of the module store.
+	Store string
+	// Key is the store key that was checked.
+	Key []byte
+	// Height is the committed version the key was checked at.
+	Height int64
+	// Err is the reason the check failed.
+	Err error
+}
+
+func (m Mismatch) String() string {
+	return fmt.Sprintf("store %s key %X at height %d: %v", m.Store, m.Key, m.Height, m.Err)
+}
+
+// Worker periodically spot checks random keys of module stores by querying them with merkle proofs and verifying the
+// proofs against the root hash of the last commit.  A proof that does not verify means a value or tree node read from
+// the local database differs from what was committed, which is an early sign of database corruption.
+type Worker struct {
+	logger   log.Logger
+	store    MultiStore
+	keys     []storetypes.StoreKey
+	interval time.Duration
+	samples  int
+	rand     *rand.Rand
+
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+// NewWorker creates a worker that checks the given number of random keys of the given stores every interval.
+func NewWorker(
+	logger log.Logger, store MultiStore, keys []storetypes.StoreKey, interval time.Duration, samples int,
+) *Worker {
+	return &Worker{
+		logger:   logger.With("module", "statecheck"),
+		store:    store,
+		keys:     keys,
+		interval: interval,
+		samples:  samples,
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())), // nolint:gosec // key sampling is not security sensitive
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the checks in the background until Stop is called.
+func (w *Worker) Start() {
+	w.logger.Info("starting state verification", "interval", w.interval, "samples", w.samples)
+	go func() {
+		defer close(w.done)
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.stop:
+				return
+			case <-ticker.C:
+				w.Check()
+			}
+		}
+	}()
+}
+
+// Stop ends the background checks and waits for a running check to finish.
+func (w *Worker) Stop() {
+	w.once.Do(func() {
+		close(w.stop)
+		<-w.done
+	})
+}
+
+// Check verifies random keys against the last commit, logging and counting every mismatch found.
+func (w *Worker) Check() []Mismatch {
+	cid := w.store.LastCommitID()
+	if cid.Version == 0 {
+		return nil
+	}
+	mismatches := w.check(cid)
+	for _, m := range mismatches {
+		w.logger.Error("state verification failed, the local database may be corrupt",
+			"store", m.Store, "key", fmt.Sprintf("%X", m.Key), "height", m.Height, "err", m.Err)
+		telemetry.IncrCounterWithLabels([]string{"statecheck", "mismatch"}, 1,
+			[]metrics.Label{telemetry.NewLabel("store", m.Store)})
+	}
+	return mismatches
+}
+
+// check verifies random keys at the version of the commit against its hash.
+func (w *Worker) check(cid storetypes.CommitID) []Mismatch {
+	if len(w.keys) == 0 {
+		return nil
+	}
+	cms, err := w.store.CacheMultiStoreWithVersion(cid.Version)
+	if err != nil {
+		w.logger.Error("could not load state to verify", "height", cid.Version, "err", err)
+		return nil
+	}
+
+	var mismatches []Mismatch
+	prt := rootmulti.DefaultProofRuntime()
+	for i := 0; i < w.samples; i++ {
+		storeKey := w.keys[w.rand.Intn(len(w.keys))]
+		key, value, found := w.randomEntry(cms.GetKVStore(storeKey))
+		if !found {
+			continue
+		}
+		telemetry.IncrCounterWithLabels([]string{"statecheck", "check"}, 1,
+			[]metrics.Label{telemetry.NewLabel("store", storeKey.Name())})
+		if err = w.verify(prt, cid, storeKey.Name(), key, value); err != nil {
+			mismatches = append(mismatches, Mismatch{Store: storeKey.Name(), Key: key, Height: cid.Version, Err: err})
+		}
+	}
+	return mismatches
+}
+
+// randomEntry returns the first entry of the store at or after a random key, wrapping around to the first entry.
+func (w *Worker) randomEntry(store storetypes.KVStore) ([]byte, []byte, bool) {
+	start := make([]byte, 4)
+	w.rand.Read(start)
+	for _, from := range [][]byte{start, nil} {
+		it := store.Iterator(from, nil)
+		if it.Valid() {
+			key, value := it.Key(), it.Value()
+			it.Close()
+			return key, value, true
+		}
+		it.Close()
+	}
+	return nil, nil, false
+}
+
+// verify queries a key with a proof at the version of the commit and checks the proof against its hash and the value
+// read from the store.
+func (w *Worker) verify(prt *merkle.ProofRuntime, cid storetypes.CommitID, storeName string, key, value []byte) error {
+	res := w.store.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", storeName),
+		Data:   key,
+		Height: cid.Version,
+		Prove:  true,
+	})
+	if !res.IsOK() {
+		return fmt.Errorf("proof query failed: %s", res.Log)
+	}
+	keyPath := merkle.KeyPath{}.AppendKey([]byte(storeName), merkle.KeyEncodingURL).AppendKey(key, merkle.KeyEncodingHex)
+	if err := prt.VerifyValue(res.ProofOps, cid.Hash, keyPath.String(), res.Value); err != nil {
+		return fmt.Errorf("proof does not match committed root hash %X: %w", cid.Hash, err)
+	}
+	if string(res.Value) != string(value) {
+		return fmt.Errorf("proven value %X does not match stored value %X", res.Value, value)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package statecheck
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestCheck(t *testing.T) {
+	cms := rootmulti.NewStore(dbm.NewMemDB())
+	markerKey := storetypes.NewKVStoreKey("marker")
+	nameKey := storetypes.NewKVStoreKey("name")
+	emptyKey := storetypes.NewKVStoreKey("empty")
+	for _, key := range []storetypes.StoreKey{markerKey, nameKey, emptyKey} {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+
+	worker := NewWorker(log.NewNopLogger(), cms, []storetypes.StoreKey{markerKey, nameKey, emptyKey}, time.Minute, 50)
+	require.Empty(t, worker.Check(), "nothing is checked before the first commit")
+
+	for i := 0; i < 100; i++ {
+		cms.GetKVStore(markerKey).Set([]byte{0x01, byte(i)}, []byte(fmt.Sprintf("marker%d", i)))
+		cms.GetKVStore(nameKey).Set([]byte{byte(i), 0xFF}, []byte(fmt.Sprintf("name%d", i)))
+	}
+	cid := cms.Commit()
+	require.Empty(t, worker.Check(), "committed state verifies")
+
+	// a root hash that differs from the one the proofs are built from is reported for every checked key
+	bad := storetypes.CommitID{Version: cid.Version, Hash: append([]byte{}, cid.Hash...)}
+	bad.Hash[0] ^= 0xFF
+	mismatches := worker.check(bad)
+	require.NotEmpty(t, mismatches)
+	for _, m := range mismatches {
+		require.Contains(t, []string{"marker", "name"}, m.Store, "empty stores are not checked")
+		require.Equal(t, cid.Version, m.Height)
+		require.Error(t, m.Err)
+		require.Contains(t, m.Err.Error(), "proof does not match committed root hash")
+	}
+
+	worker.Start()
+	worker.Stop()
+	worker.Stop()
+}
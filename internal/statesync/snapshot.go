@@ -0,0 +1,307 @@
+package statesync
+
+import (
+	"bufio"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strings"
+
+	protoio "github.com/gogo/protobuf/io"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	// extensionPrefix marks the snapshot store items that start the data of an extension.
+	extensionPrefix = "extension/"
+
+	// The chunking and compression of the root multi store snapshots is used for the extended snapshots.
+	chunkSize        = uint64(10e6)
+	bufferSize       = int(chunkSize)
+	maxItemSize      = int(64e6)
+	compressionLevel = 7
+)
+
+// Extension adds data that is not kept in the IAVL stores, and so is not in the root multi store snapshots, to state
+// sync snapshots.
+type Extension interface {
+	// Name identifies the data of the extension in a snapshot.
+	Name() string
+	// Export writes the data of the extension for the state at a height.
+	Export(height uint64, write func(key, value []byte) error) error
+	// Import restores the data of the extension after the stores have been restored, reading items until io.EOF.
+	Import(height uint64, read func() (key, value []byte, err error)) error
+}
+
+// Snapshotter extends the snapshots of a target snapshotter, normally the root multi store, with the data of the
+// registered extensions.  The extension data follows the store data in the snapshot stream, each extension starting
+// with a store item named for the extension followed by its key value items.
+type Snapshotter struct {
+	target     snapshottypes.Snapshotter
+	extensions []Extension
+}
+
+var _ snapshottypes.Snapshotter = &Snapshotter{}
+
+// NewSnapshotter creates a snapshotter that extends the snapshots of the target.
+func NewSnapshotter(target snapshottypes.Snapshotter) *Snapshotter {
+	return &Snapshotter{target: target}
+}
+
+// RegisterExtensions adds extensions to the snapshots.  Extensions must be registered before snapshots are taken or
+// restored and every node must register the same extensions.
+func (s *Snapshotter) RegisterExtensions(extensions ...Extension) {
+	s.extensions = append(s.extensions, extensions...)
+}
+
+// Snapshot implements snapshottypes.Snapshotter.
+func (s *Snapshotter) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	chunks, err := s.target.Snapshot(height, format)
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan io.ReadCloser)
+	go func() {
+		items := newItemReader(chunks)
+		defer items.close()
+		writer := newItemWriter(ch)
+		if err := s.snapshot(height, items, writer); err != nil {
+			writer.closeWithError(err)
+			return
+		}
+		if err := writer.close(); err != nil {
+			writer.closeWithError(err)
+		}
+	}()
+	return ch, nil
+}
+
+// snapshot copies the target snapshot items and adds the extension items.
+func (s *Snapshotter) snapshot(height uint64, items *itemReader, writer *itemWriter) error {
+	for items.next(); items.err == nil; items.next() {
+		if err := writer.write(items.item); err != nil {
+			return err
+		}
+	}
+	if items.err != io.EOF {
+		return items.err
+	}
+	for _, extension := range s.extensions {
+		if err := writer.write(extensionItem(extension.Name())); err != nil {
+			return err
+		}
+		err := extension.Export(height, func(key, value []byte) error {
+			return writer.write(&storetypes.SnapshotItem{
+				Item: &storetypes.SnapshotItem_IAVL{
+					IAVL: &storetypes.SnapshotIAVLItem{Key: key, Value: value, Version: int64(height)},
+				},
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("snapshot extension %s failed: %w", extension.Name(), err)
+		}
+	}
+	return nil
+}
+
+// Restore implements snapshottypes.Snapshotter.
+func (s *Snapshotter) Restore(height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{}) error {
+	// Signal readiness before reading, the zlib reader reads from the stream when it is created.
+	if ready != nil {
+		close(ready)
+	}
+	items := newItemReader(chunks)
+	defer items.close()
+
+	// Store items are passed on to the target until the first extension.
+	targetChunks := make(chan io.ReadCloser)
+	targetDone := make(chan error, 1)
+	go func() {
+		targetDone <- s.target.Restore(height, format, targetChunks, nil)
+	}()
+	writer := newItemWriter(targetChunks)
+	for items.next(); items.err == nil && extensionName(items.item) == ""; items.next() {
+		if err := writer.write(items.item); err != nil {
+			items.err = err
+		}
+	}
+	if items.err != nil && items.err != io.EOF {
+		writer.closeWithError(items.err)
+		if err := <-targetDone; err != nil {
+			return err
+		}
+		return items.err
+	}
+	if err := writer.close(); err != nil {
+		writer.closeWithError(err)
+		<-targetDone
+		return err
+	}
+	if err := <-targetDone; err != nil {
+		return err
+	}
+
+	for items.err == nil {
+		name := extensionName(items.item)
+		extension := s.extension(name)
+		if extension == nil {
+			return fmt.Errorf("unknown snapshot extension %q", name)
+		}
+		items.next()
+		err := extension.Import(height, func() ([]byte, []byte, error) {
+			if items.err != nil {
+				return nil, nil, items.err
+			}
+			node := items.item.GetIAVL()
+			if node == nil {
+				return nil, nil, io.EOF
+			}
+			items.next()
+			return node.Key, node.Value, nil
+		})
+		if err != nil {
+			return fmt.Errorf("snapshot extension %s restore failed: %w", name, err)
+		}
+		// Skip anything the extension did not read.
+		for items.err == nil && items.item.GetIAVL() != nil {
+			items.next()
+		}
+	}
+	if items.err != io.EOF {
+		return items.err
+	}
+	return nil
+}
+
+// extension returns the registered extension with a name, or nil if there is not one.
+func (s *Snapshotter) extension(name string) Extension {
+	for _, extension := range s.extensions {
+		if extension.Name() == name {
+			return extension
+		}
+	}
+	return nil
+}
+
+// extensionItem returns the store item that starts the data of an extension.
+func extensionItem(name string) *storetypes.SnapshotItem {
+	return &storetypes.SnapshotItem{
+		Item: &storetypes.SnapshotItem_Store{
+			Store: &storetypes.SnapshotStoreItem{Name: extensionPrefix + name},
+		},
+	}
+}
+
+// extensionName returns the name of the extension an item starts, or an empty string if it does not start one.
+func extensionName(item *storetypes.SnapshotItem) string {
+	store := item.GetStore()
+	if store == nil || !strings.HasPrefix(store.Name, extensionPrefix) {
+		return ""
+	}
+	return strings.TrimPrefix(store.Name, extensionPrefix)
+}
+
+// itemReader reads snapshot items from a stream of chunks.
+type itemReader struct {
+	chunks *snapshots.ChunkReader
+	proto  protoio.ReadCloser
+	item   *storetypes.SnapshotItem
+	err    error
+}
+
+func newItemReader(ch <-chan io.ReadCloser) *itemReader {
+	return &itemReader{chunks: snapshots.NewChunkReader(ch)}
+}
+
+// next reads the next item, setting err to io.EOF at the end of the stream.
+func (r *itemReader) next() {
+	if r.err != nil {
+		return
+	}
+	if r.proto == nil {
+		zReader, err := zlib.NewReader(r.chunks)
+		if err != nil {
+			r.err = fmt.Errorf("zlib failure: %w", err)
+			return
+		}
+		r.proto = protoio.NewDelimitedReader(zReader, maxItemSize)
+	}
+	r.item = &storetypes.SnapshotItem{}
+	r.err = r.proto.ReadMsg(r.item)
+}
+
+// close drains any remaining chunks.
+func (r *itemReader) close() {
+	if r.proto != nil {
+		r.proto.Close()
+	}
+	r.chunks.Close()
+}
+
+// itemWriter writes snapshot items to a stream of chunks.
+type itemWriter struct {
+	chunks *snapshots.ChunkWriter
+	buf    *bufio.Writer
+	proto  protoio.WriteCloser
+}
+
+func newItemWriter(ch chan<- io.ReadCloser) *itemWriter {
+	chunks := snapshots.NewChunkWriter(ch, chunkSize)
+	buf := bufio.NewWriterSize(chunks, bufferSize)
+	// The level is valid so there is no error.
+	zWriter, _ := zlib.NewWriterLevel(buf, compressionLevel)
+	return &itemWriter{chunks: chunks, buf: buf, proto: protoio.NewDelimitedWriter(zWriter)}
+}
+
+func (w *itemWriter) write(item *storetypes.SnapshotItem) error {
+	return w.proto.WriteMsg(item)
+}
+
+// close flushes the items written and ends the stream.
+func (w *itemWriter) close() error {
+	if err := w.proto.Close(); err != nil {
+		return err
+	}
+	if err := w.buf.Flush(); err != nil {
+		return err
+	}
+	return w.chunks.Close()
+}
+
+// closeWithError ends the stream with an error for the reader.
+func (w *itemWriter) closeWithError(err error) {
+	w.chunks.CloseWithError(err)
+}
+
+// SetSnapshotStore returns a base app option that sets the snapshot store with the snapshotter as the target of the
+// snapshot manager.  The base app creates the manager for its multi store, which must otherwise be the root multi
+// store, so the multi store is only swapped for one snapshotting through the snapshotter while the manager is created.
+// The option must be applied after the multi store of the app is set.
+func SetSnapshotStore(cms *rootmulti.Store, snapshotter *Snapshotter, store *snapshots.Store) func(*baseapp.BaseApp) {
+	return func(bApp *baseapp.BaseApp) {
+		bApp.SetCMS(snapshotMultiStore{Store: cms, snapshotter: snapshotter})
+		bApp.SetSnapshotStore(store)
+		bApp.SetCMS(cms)
+	}
+}
+
+// snapshotMultiStore is a root multi store that takes and restores snapshots with a snapshotter.
+type snapshotMultiStore struct {
+	*rootmulti.Store
+	snapshotter *Snapshotter
+}
+
+// Snapshot implements snapshottypes.Snapshotter.
+func (s snapshotMultiStore) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	return s.snapshotter.Snapshot(height, format)
+}
+
+// Restore implements snapshottypes.Snapshotter.
+func (s snapshotMultiStore) Restore(height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{}) error {
+	return s.snapshotter.Restore(height, format, chunks, ready)
+}
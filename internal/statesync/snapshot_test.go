@@ -0,0 +1,122 @@
+package statesync
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// testExtension exports its items and records the items it imports.
+type testExtension struct {
+	name     string
+	items    [][2]string
+	imported [][2]string
+}
+
+func (e *testExtension) Name() string { return e.name }
+
+func (e *testExtension) Export(_ uint64, write func(key, value []byte) error) error {
+	for _, item := range e.items {
+		if err := write([]byte(item[0]), []byte(item[1])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *testExtension) Import(_ uint64, read func() ([]byte, []byte, error)) error {
+	for {
+		key, value, err := read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		e.imported = append(e.imported, [2]string{string(key), string(value)})
+	}
+}
+
+func newStore(t *testing.T, key storetypes.StoreKey) *rootmulti.Store {
+	cms := rootmulti.NewStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	return cms
+}
+
+// readChunks reads a snapshot into memory.
+func readChunks(t *testing.T, ch <-chan io.ReadCloser) [][]byte {
+	var chunks [][]byte
+	for chunk := range ch {
+		bz, err := ioutil.ReadAll(chunk)
+		require.NoError(t, err)
+		require.NoError(t, chunk.Close())
+		chunks = append(chunks, bz)
+	}
+	return chunks
+}
+
+func restore(snapshotter *Snapshotter, height uint64, chunks [][]byte) error {
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- ioutil.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	return snapshotter.Restore(height, snapshottypes.CurrentFormat, ch, nil)
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	key := storetypes.NewKVStoreKey("data")
+	source := newStore(t, key)
+	for _, k := range []string{"a", "b", "c"} {
+		source.GetKVStore(key).Set([]byte(k), []byte("value "+k))
+	}
+	cid := source.Commit()
+
+	snapshotter := NewSnapshotter(source)
+	snapshotter.RegisterExtensions(
+		&testExtension{name: "first", items: [][2]string{{"x", "1"}, {"y", "2"}}},
+		&testExtension{name: "second", items: [][2]string{{"z", "3"}}},
+	)
+	ch, err := snapshotter.Snapshot(uint64(cid.Version), snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	chunks := readChunks(t, ch)
+	require.NotEmpty(t, chunks)
+
+	t.Run("stores and extensions are restored", func(t *testing.T) {
+		target := newStore(t, key)
+		first, second := &testExtension{name: "first"}, &testExtension{name: "second"}
+		restorer := NewSnapshotter(target)
+		restorer.RegisterExtensions(second, first)
+		require.NoError(t, restore(restorer, uint64(cid.Version), chunks))
+
+		require.Equal(t, cid, target.LastCommitID())
+		require.Equal(t, []byte("value b"), target.GetKVStore(key).Get([]byte("b")))
+		require.Equal(t, [][2]string{{"x", "1"}, {"y", "2"}}, first.imported)
+		require.Equal(t, [][2]string{{"z", "3"}}, second.imported)
+	})
+
+	t.Run("unknown extensions fail", func(t *testing.T) {
+		restorer := NewSnapshotter(newStore(t, key))
+		restorer.RegisterExtensions(&testExtension{name: "first"})
+		err := restore(restorer, uint64(cid.Version), chunks)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `unknown snapshot extension "second"`)
+	})
+
+	t.Run("snapshots without extensions restore", func(t *testing.T) {
+		ch, err := NewSnapshotter(source).Snapshot(uint64(cid.Version), snapshottypes.CurrentFormat)
+		require.NoError(t, err)
+		target := newStore(t, key)
+		require.NoError(t, restore(NewSnapshotter(target), uint64(cid.Version), readChunks(t, ch)))
+		require.Equal(t, cid, target.LastCommitID())
+	})
+}
@@ -2,7 +2,10 @@ package cli_test
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"sort"
 	"strings"
 	"testing"
@@ -310,7 +313,7 @@ func (s *IntegrationTestSuite) TestGetAccountAttributeCmd() {
 		{
 			"should get attribute by name with json output",
 			[]string{s.account1Addr.String(), "example.attribute", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s"}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String(), s.account1Addr.String()),
+			fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s"}],"pagination":{"next_key":null,"total":"1"}}`, s.account1Addr.String(), s.account1Addr.String()),
 		},
 		{
 			"should get attribute by name with text output",
@@ -323,7 +326,7 @@ attributes:
   value: ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n
 pagination:
   next_key: null
-  total: "0"`, s.account1Addr.String(), s.account1Addr.String()),
+  total: "1"`, s.account1Addr.String(), s.account1Addr.String()),
 		},
 		{
 			"should fail to find unknown attribute output",
@@ -355,6 +358,60 @@ pagination:
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetAttributeProofCmd() {
+	s.Run("should get verifiable proof of attribute", func() {
+		cmd := cli.GetAttributeProofCmd()
+		clientCtx := s.testnet.Validators[0].ClientCtx
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd,
+			[]string{s.account1Addr.String(), "example.attribute", fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+		s.Require().NoError(err)
+
+		var proofs attributetypes.AttributeProofs
+		s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &proofs))
+		s.Require().Len(proofs.Proofs, 1)
+		proof := proofs.Proofs[0]
+		s.Assert().Equal("example.attribute", proof.Attribute.Name)
+		s.Assert().Equal([]byte("example attribute value string"), proof.Attribute.Value)
+		s.Assert().Positive(proof.Height)
+		s.Assert().False(proof.Time.IsZero())
+		s.Require().NoError(proof.Verify())
+
+		proof.Attribute.Value = []byte("another value")
+		proof.Key = attributetypes.AccountAttributeKey(s.account1Addr, proof.Attribute)
+		s.Require().Error(proof.Verify())
+	})
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectedErr string
+	}{
+		{
+			"should fail to prove unknown attribute",
+			[]string{s.account1Addr.String(), "example.none"},
+			"no attributes named example.none found on " + s.account1Addr.String(),
+		},
+		{
+			"should fail with invalid address",
+			[]string{"invalid", "example.attribute"},
+			"invalid address invalid",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetAttributeProofCmd()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			_, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().Error(err)
+			s.Assert().Contains(err.Error(), tc.expectedErr)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestScanAccountAttributesCmd() {
 	testCases := []struct {
 		name           string
@@ -418,7 +475,7 @@ func (s *IntegrationTestSuite) TestListAccountAttributesCmd() {
 		{
 			"should list all attributes for account with json output",
 			[]string{s.account1Addr.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute.count","value":"Mg==","attribute_type":"ATTRIBUTE_TYPE_INT","address":"%s"},{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s"}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String(), s.account1Addr.String(), s.account1Addr.String()),
+			fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute.count","value":"Mg==","attribute_type":"ATTRIBUTE_TYPE_INT","address":"%s"},{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s"}],"pagination":{"next_key":null,"total":"2"}}`, s.account1Addr.String(), s.account1Addr.String(), s.account1Addr.String()),
 		},
 		{
 			"should list all attributes for account text output",
@@ -435,7 +492,48 @@ attributes:
   value: ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n
 pagination:
   next_key: null
-  total: "0"`, s.account1Addr.String(), s.account1Addr.String(), s.account1Addr.String()),
+  total: "2"`, s.account1Addr.String(), s.account1Addr.String(), s.account1Addr.String()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.ListAccountAttributesCmd()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestListAccountAttributesFilterCmd() {
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedOutput string
+		expectedErr    string
+	}{
+		{
+			"should list attributes of a type",
+			[]string{s.account1Addr.String(), "--type=int", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute.count","value":"Mg==","attribute_type":"ATTRIBUTE_TYPE_INT","address":"%s"}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String(), s.account1Addr.String()),
+			"",
+		},
+		{
+			"should list attributes with a name prefix",
+			[]string{s.account1Addr.String(), "--name-prefix=example.attribute.", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute.count","value":"Mg==","attribute_type":"ATTRIBUTE_TYPE_INT","address":"%s"}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String(), s.account1Addr.String()),
+			"",
+		},
+		{
+			"should fail with an invalid type",
+			[]string{s.account1Addr.String(), "--type=bad"},
+			"",
+			"'ATTRIBUTE_TYPE_BAD' is not a valid attribute type option",
 		},
 	}
 
@@ -446,6 +544,51 @@ pagination:
 			cmd := cli.ListAccountAttributesCmd()
 			clientCtx := s.testnet.Validators[0].ClientCtx
 
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if len(tc.expectedErr) > 0 {
+				s.Require().EqualError(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetAttributeAccountsCmd() {
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedOutput string
+	}{
+		{
+			"should get accounts with attribute name with json output",
+			[]string{"example.attribute.overload", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			fmt.Sprintf(`{"accounts":["%s"],"pagination":{"next_key":null,"total":"1"}}`, s.account4Addr.String()),
+		},
+		{
+			"should get accounts with attribute name with text output",
+			[]string{"example.attribute.count", fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
+			fmt.Sprintf(`accounts:
+- %s
+pagination:
+  next_key: null
+  total: "1"`, s.account1Addr.String()),
+		},
+		{
+			"should get no accounts for unknown attribute name",
+			[]string{"example.none", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			`{"accounts":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetAttributeAccountsCmd()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			s.Require().NoError(err)
 			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
@@ -462,12 +605,12 @@ func (s *IntegrationTestSuite) TestGetAttributeParamsCmd() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			"{\"max_value_length\":128}",
+			"{\"max_value_length\":128,\"deletion_grace_period\":\"0s\"}",
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
-			"max_value_length: 128",
+			"deletion_grace_period: 0s\nmax_value_length: 128",
 		},
 	}
 
@@ -1121,3 +1264,75 @@ func (s *IntegrationTestSuite) TestPaginationWithPageKey() {
 		}
 	})
 }
+
+func (s *IntegrationTestSuite) TestExportImportAccountAttributesCmd() {
+	clientCtx := s.testnet.Validators[0].ClientCtx
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	exportAttributes := func(address string) cli.AttributeExport {
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.ExportAccountAttributesCmd(), []string{address})
+		s.Require().NoError(err)
+		var export cli.AttributeExport
+		s.Require().NoError(json.Unmarshal(out.Bytes(), &export), out.String())
+		return export
+	}
+
+	s.Run("export account attributes", func() {
+		export := exportAttributes(s.account1Str)
+		s.Assert().Equal(s.account1Str, export.Account)
+		s.Assert().Positive(export.Height)
+		s.Assert().ElementsMatch([]cli.AttributeRecord{
+			{Name: "example.attribute", Type: "string", Value: "example attribute value string"},
+			{Name: "example.attribute.count", Type: "int", Value: "2"},
+		}, export.Attributes)
+	})
+
+	s.Run("export all pages of account attributes", func() {
+		s.Assert().Len(exportAttributes(s.account3Str).Attributes, s.accAttrCount)
+	})
+
+	s.Run("export invalid address", func() {
+		_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.ExportAccountAttributesCmd(), []string{"invalidbech32"})
+		s.Require().Error(err)
+	})
+
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, namecli.GetBindNameCmd(),
+		append([]string{"bulk", s.testnet.Validators[0].Address.String(), "attribute"}, txFlags...))
+	s.Require().NoError(err)
+
+	records := []cli.AttributeRecord{
+		{Name: "bulk.attribute", Type: "string", Value: "first value"},
+		{Name: "bulk.attribute", Type: "int", Value: "42"},
+		{Name: "bulk.attribute", Type: "bytes", Value: base64.StdEncoding.EncodeToString([]byte{0, 1, 2})},
+	}
+	writeImport := func(export cli.AttributeExport) string {
+		bz, err := json.Marshal(export)
+		s.Require().NoError(err)
+		file := filepath.Join(s.T().TempDir(), "attrs.json")
+		s.Require().NoError(ioutil.WriteFile(file, bz, 0600))
+		return file
+	}
+	importAddr := sdk.AccAddress("import_test_address_").String()
+
+	s.Run("import fails validation before broadcasting", func() {
+		invalid := append([]cli.AttributeRecord{}, records...)
+		invalid = append(invalid, cli.AttributeRecord{Name: "bulk.attribute", Type: "blah", Value: "x"})
+		file := writeImport(cli.AttributeExport{Account: importAddr, Attributes: invalid})
+		_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewImportAccountAttributesCmd(),
+			append([]string{"--file", file}, txFlags...))
+		s.Require().Error(err)
+		s.Assert().Empty(exportAttributes(importAddr).Attributes)
+	})
+
+	s.Run("import to another account in batches", func() {
+		file := writeImport(cli.AttributeExport{Account: s.account1Str, Attributes: records})
+		_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewImportAccountAttributesCmd(),
+			append([]string{"--file", file, "--account", importAddr, "--batch-size", "2"}, txFlags...))
+		s.Require().NoError(err)
+		s.Assert().ElementsMatch(records, exportAttributes(importAddr).Attributes)
+	})
+}
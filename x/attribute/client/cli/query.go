@@ -3,19 +3,35 @@ package cli
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/provenance-io/provenance/x/attribute/types"
 )
 
+const (
+	// FlagNamePrefix is the flag for limiting listed attributes to names starting with a prefix.
+	FlagNamePrefix = "name-prefix"
+	// FlagType is the flag for limiting queried attributes to a type.
+	FlagType = "type"
+)
+
 // GetQueryCmd is the top-level command for attribute CLI queries.
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
@@ -31,6 +47,10 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountAttributeCmd(),
 		ListAccountAttributesCmd(),
 		ScanAccountAttributesCmd(),
+		GetAttributeValidatorCmd(),
+		GetAttributeProofCmd(),
+		GetAttributeAccountsCmd(),
+		ExportAccountAttributesCmd(),
 	)
 
 	return queryCmd
@@ -64,6 +84,34 @@ func GetAttributeParamsCmd() *cobra.Command {
 	return cmd
 }
 
+// GetAttributeValidatorCmd returns the command handler for querying the validator of an attribute name.
+func GetAttributeValidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "validator [name]",
+		Short:   "Query the smart contract registered to validate values of an attribute",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %s query attribute validator "attr1.pb"`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AttributeValidator(context.Background(), &types.QueryAttributeValidatorRequest{Name: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Validator)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetAccountAttributeCmd gets all account attributes by name.
 func GetAccountAttributeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -73,6 +121,7 @@ func GetAccountAttributeCmd() *cobra.Command {
 			fmt.Sprintf(`
 				$ %[1]s query attribute get pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk attrib.name
 				$ %[1]s query attribute get pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk attrib.name --page=2 --limit=100
+				$ %[1]s query attribute get pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk attrib.name --type=string
 				`,
 				version.AppName,
 			)),
@@ -89,13 +138,18 @@ func GetAccountAttributeCmd() *cobra.Command {
 				return err
 			}
 
+			attrType, err := readAttributeTypeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			address := strings.ToLower(strings.TrimSpace(args[0]))
 			name := strings.ToLower(strings.TrimSpace(args[1]))
 
 			var response *types.QueryAttributeResponse
 			if response, err = queryClient.Attribute(
 				context.Background(),
-				&types.QueryAttributeRequest{Account: address, Name: name, Pagination: pageReq},
+				&types.QueryAttributeRequest{Account: address, Name: name, Pagination: pageReq, AttributeType: attrType},
 			); err != nil {
 				fmt.Printf("failed to query account \"%s\" attributes for name \"%s\": %v\n", address, name, err)
 				return nil
@@ -104,6 +158,7 @@ func GetAccountAttributeCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagType, "", "only get attributes of this type")
 	flags.AddPaginationFlagsToCmd(cmd, "get")
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -119,6 +174,7 @@ func ListAccountAttributesCmd() *cobra.Command {
 			fmt.Sprintf(`
 				$ %[1]s query attribute list pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 				$ %[1]s query attribute list pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --page=2 --limit=100
+				$ %[1]s query attribute list pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --name-prefix=attrib --type=json
 				`,
 				version.AppName,
 			)),
@@ -135,11 +191,25 @@ func ListAccountAttributesCmd() *cobra.Command {
 				return err
 			}
 
+			attrType, err := readAttributeTypeFlag(cmd)
+			if err != nil {
+				return err
+			}
+			namePrefix, err := cmd.Flags().GetString(FlagNamePrefix)
+			if err != nil {
+				return err
+			}
+
 			address := strings.ToLower(strings.TrimSpace(args[0]))
 			var response *types.QueryAttributesResponse
 			if response, err = queryClient.Attributes(
 				context.Background(),
-				&types.QueryAttributesRequest{Account: address, Pagination: pageReq},
+				&types.QueryAttributesRequest{
+					Account:       address,
+					Pagination:    pageReq,
+					NamePrefix:    strings.ToLower(strings.TrimSpace(namePrefix)),
+					AttributeType: attrType,
+				},
 			); err != nil {
 				fmt.Printf("failed to query account \"%s\" attributes: %v\n", address, err)
 				return nil
@@ -148,6 +218,8 @@ func ListAccountAttributesCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagNamePrefix, "", "only list attributes with names starting with this prefix")
+	cmd.Flags().String(FlagType, "", "only list attributes of this type")
 	flags.AddPaginationFlagsToCmd(cmd, "list")
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -163,6 +235,7 @@ func ScanAccountAttributesCmd() *cobra.Command {
 			fmt.Sprintf(`
 				$ %[1]s query attribute scan pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk name.suffix
 				$ %[1]s query attribute scan pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk name.suffix --page=2 --limit=100
+				$ %[1]s query attribute scan pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk name.suffix --type=uuid
 				`,
 				version.AppName,
 			)),
@@ -178,13 +251,17 @@ func ScanAccountAttributesCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			attrType, err := readAttributeTypeFlag(cmd)
+			if err != nil {
+				return err
+			}
 			address := strings.ToLower(strings.TrimSpace(args[0]))
 			suffix := strings.ToLower(strings.TrimSpace(args[1]))
 
 			var response *types.QueryScanResponse
 			if response, err = queryClient.Scan(
 				context.Background(),
-				&types.QueryScanRequest{Account: address, Suffix: suffix, Pagination: pageReq},
+				&types.QueryScanRequest{Account: address, Suffix: suffix, Pagination: pageReq, AttributeType: attrType},
 			); err != nil {
 				fmt.Printf("failed to query account \"%s\" attributes for suffix \"%s\": %v\n", address, suffix, err)
 				return nil
@@ -193,12 +270,264 @@ func ScanAccountAttributesCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagType, "", "only scan attributes of this type")
 	flags.AddPaginationFlagsToCmd(cmd, "scan")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// GetAttributeAccountsCmd gets the accounts that have attributes with a name.
+func GetAttributeAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts [name]",
+		Short: "Get the accounts that have attributes with a name",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+				$ %[1]s query attribute accounts attrib.name
+				$ %[1]s query attribute accounts attrib.name --page=2 --limit=100
+				`,
+				version.AppName,
+			)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			name := strings.ToLower(strings.TrimSpace(args[0]))
+
+			response, err := queryClient.AttributeAccountsByName(
+				context.Background(),
+				&types.QueryAttributeAccountsByNameRequest{Name: name, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "accounts")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readAttributeTypeFlag reads the optional attribute type filter flag.
+func readAttributeTypeFlag(cmd *cobra.Command) (types.AttributeType, error) {
+	value, err := cmd.Flags().GetString(FlagType)
+	if err != nil || len(strings.TrimSpace(value)) == 0 {
+		return types.AttributeType_Unspecified, err
+	}
+	return types.AttributeTypeFromString(strings.TrimSpace(value))
+}
+
+// GetAttributeProofCmd gets merkle proofs of account attributes by name.
+func GetAttributeProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof [address] [name]",
+		Short: "Get merkle proofs that account attributes existed at a height",
+		Long: strings.TrimSpace(`Get a merkle proof of each account attribute with the given name against the app hash of the
+state at a height.  Each proof includes the time of the block at the height and the app hash committed in the header
+of the next block so that it can be verified off-chain.  Proofs are verified before they are output.  When no height is
+given, the latest height with a committed app hash is used.`),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+				$ %[1]s query attribute proof pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk attrib.name
+				$ %[1]s query attribute proof pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk attrib.name --height=1000
+				`,
+				version.AppName,
+			)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address := strings.ToLower(strings.TrimSpace(args[0]))
+			name := strings.ToLower(strings.TrimSpace(args[1]))
+			acc, err := sdk.AccAddressFromBech32(address)
+			if err != nil {
+				return fmt.Errorf("invalid address %s: %w", address, err)
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			height := clientCtx.Height
+			if height == 0 {
+				status, err := node.Status(context.Background())
+				if err != nil {
+					return err
+				}
+				height = status.SyncInfo.LatestBlockHeight - 1
+			}
+			block, err := node.Commit(context.Background(), &height)
+			if err != nil {
+				return err
+			}
+			// The app hash of the state at a height is committed in the header of the next block.
+			nextHeight := height + 1
+			next, err := node.Commit(context.Background(), &nextHeight)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(height)
+			queryClient := types.NewQueryClient(clientCtx)
+			proofs := types.AttributeProofs{}
+			var pageKey []byte
+			for {
+				res, err := queryClient.Attribute(
+					context.Background(),
+					&types.QueryAttributeRequest{Account: address, Name: name, Pagination: &query.PageRequest{Key: pageKey}},
+				)
+				if err != nil {
+					return err
+				}
+				for _, attr := range res.Attributes {
+					key := types.AccountAttributeKey(acc, attr)
+					proofRes, err := clientCtx.QueryABCI(abci.RequestQuery{
+						Path:   fmt.Sprintf("/store/%s/key", types.StoreKey),
+						Data:   key,
+						Height: height,
+						Prove:  true,
+					})
+					if err != nil {
+						return err
+					}
+					if proofRes.ProofOps == nil {
+						return fmt.Errorf("no proof returned for attribute %s on %s", attr.Name, address)
+					}
+					proof := types.AttributeProof{
+						Attribute: attr,
+						Height:    proofRes.Height,
+						Time:      block.Header.Time,
+						AppHash:   next.Header.AppHash,
+						Key:       key,
+						Proof:     *proofRes.ProofOps,
+					}
+					if err = proof.Verify(); err != nil {
+						return err
+					}
+					proofs.Proofs = append(proofs.Proofs, proof)
+				}
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageKey = res.Pagination.NextKey
+			}
+			if len(proofs.Proofs) == 0 {
+				return fmt.Errorf("no attributes named %s found on %s at height %d", name, address, height)
+			}
+			return clientCtx.PrintProto(&proofs)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// exportPageSize is the number of attributes requested per page when exporting the attributes of an account.
+const exportPageSize = 1000
+
+// AttributeExport is the attributes of an account as written by the export command and read by the import command.
+type AttributeExport struct {
+	Account    string            `json:"account"`
+	Height     int64             `json:"height,omitempty"`
+	Attributes []AttributeRecord `json:"attributes"`
+}
+
+// AttributeRecord is a single attribute in an attribute export.  The type and value are given as they are to the add
+// command: bytes and proto values are base64 encoded.
+type AttributeRecord struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// NewAttributeRecord creates the attribute export record of an attribute.
+func NewAttributeRecord(attr types.Attribute) AttributeRecord {
+	return AttributeRecord{
+		Name:  attr.Name,
+		Type:  strings.ToLower(strings.TrimPrefix(attr.AttributeType.String(), "ATTRIBUTE_TYPE_")),
+		Value: decodeAttributeValue(attr.Value, attr.AttributeType),
+	}
+}
+
+// ExportAccountAttributesCmd is the CLI command for exporting all attributes of an account as JSON.
+func ExportAccountAttributesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [address]",
+		Short: "Export all attributes of an account as JSON for the attribute import command",
+		Long: strings.TrimSpace(`Export all attributes of an account as JSON in the format read by the attribute import command.
+All pages are read at the same block height, the --height given or the latest height when the first page is read.`),
+		Example: fmt.Sprintf(`$ %s query attribute export pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk > attrs.json`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			address := strings.ToLower(strings.TrimSpace(args[0]))
+			if _, err = sdk.AccAddressFromBech32(address); err != nil {
+				return fmt.Errorf("invalid address %s: %w", address, err)
+			}
+
+			export := AttributeExport{Account: address, Height: clientCtx.Height, Attributes: []AttributeRecord{}}
+			var nextKey []byte
+			for {
+				var header metadata.MD
+				res, err := types.NewQueryClient(clientCtx).Attributes(
+					context.Background(),
+					&types.QueryAttributesRequest{Account: address, Pagination: &query.PageRequest{Key: nextKey, Limit: exportPageSize}},
+					grpc.Header(&header),
+				)
+				if err != nil {
+					return err
+				}
+				if export.Height == 0 {
+					// Pin the remaining pages to the height of the first one.
+					if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+						if export.Height, err = strconv.ParseInt(heights[0], 10, 64); err != nil {
+							return err
+						}
+						clientCtx = clientCtx.WithHeight(export.Height)
+					}
+				}
+				for _, attr := range res.Attributes {
+					export.Attributes = append(export.Attributes, NewAttributeRecord(attr))
+				}
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				nextKey = res.Pagination.NextKey
+			}
+
+			bz, err := json.MarshalIndent(export, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
 func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	encoded, err := flagSet.GetString(flags.FlagPageKey)
@@ -1,9 +1,14 @@
 package cli
 
 import (
+	"bufio"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/version"
 
@@ -11,12 +16,42 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/provenance-io/provenance/x/attribute/types"
 )
 
+const (
+	// The flag for binding the attribute name to the owner if it is not bound yet
+	flagBindIfMissing = "bind-if-missing"
+	// The flag for the file of attributes to import
+	flagFile = "file"
+	// The flag for the account to import attributes to instead of the account in the file
+	flagAccount = "account"
+	// The flag for the number of attributes added in each import transaction
+	flagBatchSize = "batch-size"
+	// The flag for the number of times a failed import transaction is retried
+	flagMaxRetries = "max-retries"
+	// The flag for the number of attributes in the file to skip, to resume an interrupted import
+	flagSkip = "skip"
+	// The flag for the attribute msgs granted or revoked
+	flagMsgs = "msgs"
+	// The flag for the accounts a grantee can change the attributes of
+	flagAllowedAccounts = "allowed-accounts"
+	// The flag for the largest attribute value a grantee can set
+	flagMaxValueLength = "max-value-length"
+	// The flag for the number of msgs a grantee can execute
+	flagUses = "uses"
+	// The flag for the unix timestamp when a grant expires
+	flagExpiration = "expiration"
+)
+
+// importRetryDelay is the time waited before retrying a failed import transaction, about one block.
+const importRetryDelay = 5 * time.Second
+
 // NewTxCmd is the top-level command for attribute CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -32,6 +67,11 @@ func NewTxCmd() *cobra.Command {
 		NewUpdateAccountAttributeCmd(),
 		NewDeleteDistinctAccountAttributeCmd(),
 		NewDeleteAccountAttributeCmd(),
+		NewSetAttributeValidatorCmd(),
+		NewRestoreAccountAttributeCmd(),
+		NewImportAccountAttributesCmd(),
+		NewGrantAttributeAuthorizationCmd(),
+		NewRevokeAttributeAuthorizationCmd(),
 	)
 	return txCmd
 }
@@ -42,8 +82,9 @@ func NewAddAccountAttributeCmd() *cobra.Command {
 		Use:     "add [name] [address] [type] [value]",
 		Aliases: []string{"a"},
 		Short:   "Add an account attribute to the provenance blockchain",
-		Long: fmt.Sprintf(`Note: the attribute name must have already been created through the name module.  
-Refer to %s tx name bind --help for more information on how to do this.`, version.AppName),
+		Long: fmt.Sprintf(`Note: the attribute name must have already been created through the name module, unless
+--%s is used to bind it to the from address in the same transaction.
+Refer to %s tx name bind --help for more information on how to do this.`, flagBindIfMissing, version.AppName),
 		Args:    cobra.ExactArgs(4),
 		Example: fmt.Sprintf(`$ %s tx attribute add "attr1.pb" tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx "string" "test value"`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -74,10 +115,14 @@ Refer to %s tx name bind --help for more information on how to do this.`, versio
 				attributeType,
 				value,
 			)
+			if msg.BindIfMissing, err = cmd.Flags().GetBool(flagBindIfMissing); err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Bool(flagBindIfMissing, false, "Bind the attribute name to the from address first if it is not bound yet")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -139,6 +184,14 @@ func NewUpdateAccountAttributeCmd() *cobra.Command {
 	return cmd
 }
 
+// decodeAttributeValue is the inverse of encodeAttributeValue.
+func decodeAttributeValue(value []byte, attrType types.AttributeType) string {
+	if attrType == types.AttributeType_Bytes || attrType == types.AttributeType_Proto {
+		return base64.StdEncoding.EncodeToString(value)
+	}
+	return string(value)
+}
+
 func encodeAttributeValue(value string, attrType types.AttributeType) ([]byte, error) {
 	var encodedValue []byte
 	if attrType == types.AttributeType_Bytes || attrType == types.AttributeType_Proto {
@@ -219,3 +272,388 @@ func NewDeleteAccountAttributeCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewSetAttributeValidatorCmd creates a command for registering the smart contract that validates attribute values.
+func NewSetAttributeValidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-validator [name] [contract-address]",
+		Aliases: []string{"sv"},
+		Short:   "Register a smart contract that must accept values of an attribute, or remove it if no contract is given",
+		Example: fmt.Sprintf(`$ %s tx attribute set-validator "attr1.pb" tp14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s96lrg8`, version.AppName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddress := ""
+			if len(args) > 1 {
+				contractAddress = args[1]
+			}
+			msg := types.NewMsgSetAttributeValidatorRequest(
+				args[0],
+				contractAddress,
+				clientCtx.GetFromAddress(),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRestoreAccountAttributeCmd creates a command for restoring deleted account attributes.
+func NewRestoreAccountAttributeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "restore [name] [address] [[type] [value]]",
+		Aliases: []string{"r"},
+		Short:   "Restore deleted account attributes with a name, or only the one with a type and value, to the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx attribute restore "attr1.pb" tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx
+$ %[1]s tx attribute restore "attr1.pb" tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx "string" "test value"`, version.AppName),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 && len(args) != 4 {
+				return fmt.Errorf("accepts 2 or 4 arg(s), received %d", len(args))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			account, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("account address must be a Bech32 string: %w", err)
+			}
+			var value []byte
+			if len(args) == 4 {
+				attributeType, err := types.AttributeTypeFromString(strings.TrimSpace(args[2]))
+				if err != nil {
+					return fmt.Errorf("account attribute type is invalid: %w", err)
+				}
+				value, err = encodeAttributeValue(strings.TrimSpace(args[3]), attributeType)
+				if err != nil {
+					return fmt.Errorf("error encoding value %s to type %s : %v", args[3], attributeType.String(), err)
+				}
+			}
+			msg := types.NewMsgRestoreAttributeRequest(account, clientCtx.GetFromAddress(), args[0], value)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewGrantAttributeAuthorizationCmd creates a command for delegating the setting of attributes with a name to another account.
+func NewGrantAttributeAuthorizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-authz [grantee] [name]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Grant an account the right to set and delete attributes with a name owned by the from address",
+		Long: strings.TrimSpace(fmt.Sprintf(`Grant an account a set attribute authorization for each of the attribute msgs given, so it can add,
+update, or delete attributes with a name the from address owns, e.g. from an operational key.  The grants can be limited
+to some accounts, a value length, and a number of uses.  Msgs: %s`, strings.Join(types.SetAttributeMsgNames, ", "))),
+		Example: fmt.Sprintf(`$ %[1]s tx attribute grant-authz tp1skjw.. "kyc.pb" --%[2]s=add,update --%[3]s=1000 --%[4]s=1700000000`,
+			version.AppName, flagMsgs, flagUses, flagExpiration),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			msgNames, err := cmd.Flags().GetStringSlice(flagMsgs)
+			if err != nil {
+				return err
+			}
+			allowedAccounts, err := cmd.Flags().GetStringSlice(flagAllowedAccounts)
+			if err != nil {
+				return err
+			}
+			allowed := make([]sdk.AccAddress, len(allowedAccounts))
+			for i, addr := range allowedAccounts {
+				if allowed[i], err = sdk.AccAddressFromBech32(addr); err != nil {
+					return err
+				}
+			}
+			maxValueLength, err := cmd.Flags().GetUint32(flagMaxValueLength)
+			if err != nil {
+				return err
+			}
+			uses, err := cmd.Flags().GetUint32(flagUses)
+			if err != nil {
+				return err
+			}
+			exp, err := cmd.Flags().GetInt64(flagExpiration)
+			if err != nil {
+				return err
+			}
+
+			name := strings.ToLower(strings.TrimSpace(args[1]))
+			authorizations, err := types.NewSetAttributeAuthorizations(msgNames, name, allowed, maxValueLength, uses)
+			if err != nil {
+				return err
+			}
+			msgs := make([]sdk.Msg, len(authorizations))
+			for i, authorization := range authorizations {
+				if msgs[i], err = authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(exp, 0)); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagMsgs, types.SetAttributeMsgNames, "The attribute msgs to grant separated by ,")
+	cmd.Flags().StringSlice(flagAllowedAccounts, []string{}, "The accounts the grantee can change attributes of separated by ,")
+	cmd.Flags().Uint32(flagMaxValueLength, 0, "The largest attribute value in bytes the grantee can set")
+	cmd.Flags().Uint32(flagUses, 0, "The number of msgs the grantee can execute with each grant")
+	cmd.Flags().Int64(flagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRevokeAttributeAuthorizationCmd creates a command for revoking set attribute authorizations.
+func NewRevokeAttributeAuthorizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-authz [grantee]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Revoke the set attribute authorizations granted to an account by the from address",
+		Example: fmt.Sprintf(`$ %[1]s tx attribute revoke-authz tp1skjw.. --%[2]s=delete,delete-distinct`,
+			version.AppName, flagMsgs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			msgNames, err := cmd.Flags().GetStringSlice(flagMsgs)
+			if err != nil {
+				return err
+			}
+
+			msgs := make([]sdk.Msg, len(msgNames))
+			for i, msgName := range msgNames {
+				attrMsg, found := types.SetAttributeMsgs[msgName]
+				if !found {
+					return fmt.Errorf("unknown attribute msg %s, expected one of: %s",
+						msgName, strings.Join(types.SetAttributeMsgNames, ", "))
+				}
+				revoke := authz.NewMsgRevoke(clientCtx.GetFromAddress(), grantee, sdk.MsgTypeURL(attrMsg))
+				msgs[i] = &revoke
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagMsgs, types.SetAttributeMsgNames, "The attribute msgs to revoke separated by ,")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewImportAccountAttributesCmd creates a command for adding the attributes of an export file in batched transactions.
+func NewImportAccountAttributesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Add the attributes of an attribute export file to an account in batched transactions",
+		Long: strings.TrimSpace(fmt.Sprintf(`Add the attributes of a file written by the attribute export query to the account in the file, or the
+--%[1]s given.  The attributes are added in transactions of --%[2]s add msgs signed by the from address, which
+must own the attribute names.  A transaction that fails is retried up to --%[3]s times with the account sequence
+queried again.  Progress is written to stderr; an interrupted import can be resumed with --%[4]s set to the number of
+attributes already imported.`, flagAccount, flagBatchSize, flagMaxRetries, flagSkip)),
+		Example: fmt.Sprintf(`$ %[1]s tx attribute import --file attrs.json --from mykey
+$ %[1]s tx attribute import --file attrs.json --account tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx --from mykey`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msgs, err := readAttributeImport(cmd, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			batchSize, err := cmd.Flags().GetInt(flagBatchSize)
+			if err != nil {
+				return err
+			}
+			if batchSize <= 0 {
+				return fmt.Errorf("--%s must be greater than zero", flagBatchSize)
+			}
+			maxRetries, err := cmd.Flags().GetInt(flagMaxRetries)
+			if err != nil {
+				return err
+			}
+			// Progress is reported with the positions of the attributes in the file.
+			skip, err := cmd.Flags().GetInt(flagSkip)
+			if err != nil {
+				return err
+			}
+			if len(msgs) == 0 {
+				return fmt.Errorf("no attributes to import")
+			}
+			batches := (len(msgs) + batchSize - 1) / batchSize
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if clientCtx.GenerateOnly {
+				for i := 0; i < len(msgs); i += batchSize {
+					if err = tx.GenerateTx(clientCtx, txf, msgs[i:minInt(i+batchSize, len(msgs))]...); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			if !clientCtx.SkipConfirm {
+				ok, err := input.GetConfirmation(
+					fmt.Sprintf("add %d attributes in %d transactions", len(msgs), batches), bufio.NewReader(os.Stdin), os.Stderr)
+				if err != nil || !ok {
+					_, _ = fmt.Fprintln(os.Stderr, "cancelled import")
+					return err
+				}
+			}
+
+			var sequence uint64
+			for i, batch := 0, 0; i < len(msgs); i, batch = i+batchSize, batch+1 {
+				end := minInt(i+batchSize, len(msgs))
+				var res *sdk.TxResponse
+				for attempt := 0; ; attempt++ {
+					if attempt > 0 || i == 0 {
+						// The sequence is queried again after a failure in case the failed tx was accepted or another
+						// tx was signed by the from address.
+						var accNum uint64
+						if accNum, sequence, err = txf.AccountRetriever().GetAccountNumberSequence(clientCtx, clientCtx.GetFromAddress()); err != nil {
+							return err
+						}
+						txf = txf.WithAccountNumber(accNum)
+					}
+					if res, err = broadcastBatch(clientCtx, txf.WithSequence(sequence), msgs[i:end]); err == nil {
+						break
+					}
+					if attempt >= maxRetries {
+						return fmt.Errorf("batch %d of %d failed, resume with --%s %d: %w", batch+1, batches, flagSkip, skip+i, err)
+					}
+					_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "batch %d of %d failed, retrying: %v\n", batch+1, batches, err)
+					time.Sleep(importRetryDelay)
+				}
+				sequence++
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "batch %d of %d: added attributes %d to %d of %d in tx %s\n",
+					batch+1, batches, skip+i+1, skip+end, skip+len(msgs), res.TxHash)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagFile, "", "the attribute export file to import")
+	cmd.Flags().String(flagAccount, "", "the account to add the attributes to instead of the account in the file")
+	cmd.Flags().Int(flagBatchSize, 50, "the number of attributes added in each transaction")
+	cmd.Flags().Int(flagMaxRetries, 3, "the number of times a failed transaction is retried")
+	cmd.Flags().Int(flagSkip, 0, "the number of attributes in the file to skip")
+	_ = cmd.MarkFlagRequired(flagFile)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readAttributeImport reads the attribute export file of an import command into add attribute msgs owned by the
+// given address.  All msgs are validated before any are broadcast.
+func readAttributeImport(cmd *cobra.Command, owner sdk.AccAddress) ([]sdk.Msg, error) {
+	file, err := cmd.Flags().GetString(flagFile)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var export AttributeExport
+	if err = json.Unmarshal(bz, &export); err != nil {
+		return nil, fmt.Errorf("invalid attribute export file %s: %w", file, err)
+	}
+	if accountFlag, _ := cmd.Flags().GetString(flagAccount); len(accountFlag) > 0 {
+		export.Account = accountFlag
+	}
+	account, err := sdk.AccAddressFromBech32(export.Account)
+	if err != nil {
+		return nil, fmt.Errorf("account address must be a Bech32 string: %w", err)
+	}
+	skip, err := cmd.Flags().GetInt(flagSkip)
+	if err != nil {
+		return nil, err
+	}
+	if skip < 0 || skip > len(export.Attributes) {
+		return nil, fmt.Errorf("cannot skip %d of %d attributes", skip, len(export.Attributes))
+	}
+
+	msgs := make([]sdk.Msg, 0, len(export.Attributes)-skip)
+	for i, record := range export.Attributes[skip:] {
+		attributeType, err := types.AttributeTypeFromString(strings.TrimSpace(record.Type))
+		if err != nil {
+			return nil, fmt.Errorf("attribute %d %s type is invalid: %w", skip+i, record.Name, err)
+		}
+		value, err := encodeAttributeValue(record.Value, attributeType)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding attribute %d %s value to type %s : %v", skip+i, record.Name, attributeType.String(), err)
+		}
+		msg := types.NewMsgAddAttributeRequest(account, owner, record.Name, attributeType, value)
+		if err = msg.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("attribute %d %s is invalid: %w", skip+i, record.Name, err)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// broadcastBatch signs and broadcasts a tx of msgs, returning an error if the tx was not accepted.
+func broadcastBatch(clientCtx client.Context, txf tx.Factory, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+	txb, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	txb.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+	if err = tx.Sign(txf, clientCtx.GetFromName(), txb, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return res, fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	return res, nil
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -117,6 +117,65 @@ func (s HandlerTestSuite) TestMsgAddAttributeRequest() {
 	s.runTests(cases)
 }
 
+func (s HandlerTestSuite) TestMsgAddAttributeRequestBindIfMissing() {
+	bindMsg := func(name string) *types.MsgAddAttributeRequest {
+		msg := types.NewMsgAddAttributeRequest(s.user1Addr, s.user1Addr, name, types.AttributeType_String, []byte("value"))
+		msg.BindIfMissing = true
+		return msg
+	}
+	cases := []CommonTest{
+		{
+			"should fail to add attribute for unbound name",
+			types.NewMsgAddAttributeRequest(s.user1Addr,
+				s.user1Addr, "unbound.example.name", types.AttributeType_String, []byte("value")),
+			[]string{s.user1},
+			fmt.Sprintf("\"unbound.example.name\" does not resolve to address %q", s.user1),
+			nil,
+		},
+		{
+			"should fail to bind name with missing parent",
+			bindMsg("new.missing.name"),
+			[]string{s.user1},
+			"no address bound to name",
+			nil,
+		},
+		{
+			"should bind missing name and add attribute",
+			bindMsg("new.example.name"),
+			[]string{s.user1},
+			"",
+			types.NewEventAttributeAdd(
+				types.Attribute{
+					Address:       s.user1,
+					Name:          "new.example.name",
+					Value:         []byte("value"),
+					AttributeType: types.AttributeType_String,
+				},
+				s.user1),
+		},
+		{
+			"should add attribute for already bound name",
+			bindMsg("example.name"),
+			[]string{s.user1},
+			"",
+			types.NewEventAttributeAdd(
+				types.Attribute{
+					Address:       s.user1,
+					Name:          "example.name",
+					Value:         []byte("value"),
+					AttributeType: types.AttributeType_String,
+				},
+				s.user1),
+		},
+	}
+	s.runTests(cases)
+
+	record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "new.example.name")
+	s.Require().NoError(err)
+	s.Equal(s.user1, record.Address)
+	s.True(record.Restricted)
+}
+
 func (s HandlerTestSuite) TestMsgUpdateAttributeRequest() {
 	testAttr := types.Attribute{
 		Address:       s.user1,
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// GetDeletedAttributes gets all deleted attributes with the given name on an account that can still be restored.
+func (k Keeper) GetDeletedAttributes(ctx sdk.Context, acc sdk.AccAddress, name string) ([]types.DeletedAttribute, error) {
+	deleted := []types.DeletedAttribute{}
+	err := k.iterateDeletedAttributes(ctx, types.DeletedAttributesNameKeyPrefix(acc, name), func(d types.DeletedAttribute) error {
+		if d.Attribute.Name == name {
+			deleted = append(deleted, d)
+		}
+		return nil
+	})
+	return deleted, err
+}
+
+// GetAllDeletedAttributes gets all deleted attributes that can still be restored.
+func (k Keeper) GetAllDeletedAttributes(ctx sdk.Context) ([]types.DeletedAttribute, error) {
+	deleted := []types.DeletedAttribute{}
+	err := k.iterateDeletedAttributes(ctx, types.DeletedAttributeKeyPrefix, func(d types.DeletedAttribute) error {
+		deleted = append(deleted, d)
+		return nil
+	})
+	return deleted, err
+}
+
+// RestoreAttribute moves deleted attributes with the given name, and value if not nil, back onto an account.  The
+// attribute name must resolve to the given owner address.
+func (k Keeper) RestoreAttribute(ctx sdk.Context, acc sdk.AccAddress, name string, value *[]byte, owner sdk.AccAddress) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "keeper_method", "restore")
+
+	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
+		return fmt.Errorf("no account found for owner address \"%s\"", owner.String())
+	}
+	if !k.nameKeeper.ResolvesTo(ctx, name, owner) {
+		return fmt.Errorf("\"%s\" does not resolve to address \"%s\"", name, owner.String())
+	}
+
+	deleted, err := k.GetDeletedAttributes(ctx, acc, name)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	var count int
+	for _, d := range deleted {
+		if value != nil && !bytes.Equal(*value, d.Attribute.Value) {
+			continue
+		}
+		count++
+		k.removeDeletedAttribute(ctx, acc, d)
+
+		bz, err := k.cdc.Marshal(&d.Attribute)
+		if err != nil {
+			return err
+		}
+		key := types.AccountAttributeKey(acc, d.Attribute)
+		if !store.Has(key) {
+			k.incAttrNameAddressLookup(ctx, d.Attribute.Name, acc)
+		}
+		store.Set(key, bz)
+
+		restoreEvent := types.NewEventAttributeRestore(d.Attribute, owner.String())
+		if err := ctx.EventManager().EmitTypedEvent(restoreEvent); err != nil {
+			return err
+		}
+	}
+	if count == 0 {
+		errm := "no deleted attributes restored"
+		ctx.Logger().Error(errm, "name", name)
+		return fmt.Errorf("%s with name %s", errm, name)
+	}
+	return nil
+}
+
+// PurgeDeletedAttributes permanently removes the deleted attributes whose grace period has ended.
+func (k Keeper) PurgeDeletedAttributes(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.DeletedAttributeQueueTimeKeyPrefix(ctx.BlockTime()))
+	it := store.Iterator(types.DeletedAttributeQueueKeyPrefix, end)
+	var queueKeys [][]byte
+	for ; it.Valid(); it.Next() {
+		queueKeys = append(queueKeys, it.Key())
+	}
+	it.Close()
+
+	for _, queueKey := range queueKeys {
+		store.Delete(queueKey)
+		key, err := types.GetDeletedAttributeKeyFromQueueKey(queueKey)
+		if err != nil {
+			return err
+		}
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+		store.Delete(key)
+		var d types.DeletedAttribute
+		if err = k.cdc.Unmarshal(bz, &d); err != nil {
+			return err
+		}
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventAttributePurge(d.Attribute)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setDeletedAttribute stores a deleted attribute and queues it to be purged, replacing any earlier deletion of the
+// same attribute.
+func (k Keeper) setDeletedAttribute(ctx sdk.Context, acc sdk.AccAddress, deleted types.DeletedAttribute) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.DeletedAttributeKey(acc, deleted.Attribute)
+	if bz := store.Get(key); bz != nil {
+		var existing types.DeletedAttribute
+		if err := k.cdc.Unmarshal(bz, &existing); err != nil {
+			return err
+		}
+		store.Delete(types.DeletedAttributeQueueKey(existing.PurgeTime, acc, existing.Attribute))
+	}
+	bz, err := k.cdc.Marshal(&deleted)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	store.Set(types.DeletedAttributeQueueKey(deleted.PurgeTime, acc, deleted.Attribute), []byte{})
+	return nil
+}
+
+// removeDeletedAttribute removes a deleted attribute and its purge queue entry.
+func (k Keeper) removeDeletedAttribute(ctx sdk.Context, acc sdk.AccAddress, deleted types.DeletedAttribute) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DeletedAttributeKey(acc, deleted.Attribute))
+	store.Delete(types.DeletedAttributeQueueKey(deleted.PurgeTime, acc, deleted.Attribute))
+}
+
+// iterateDeletedAttributes passes each deleted attribute stored under prefix to a callback function.
+func (k Keeper) iterateDeletedAttributes(ctx sdk.Context, prefix []byte, handle func(types.DeletedAttribute) error) error {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var d types.DeletedAttribute
+		if err := k.cdc.Unmarshal(it.Value(), &d); err != nil {
+			return err
+		}
+		if err := handle(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+func (s *KeeperTestSuite) TestDeletionGracePeriod() {
+	attr := func(value string) types.Attribute {
+		return types.Attribute{
+			Name:          "example.attribute",
+			Value:         []byte(value),
+			Address:       s.user1,
+			AttributeType: types.AttributeType_String,
+		}
+	}
+	blockTime := time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockTime(blockTime)
+	params := s.app.AttributeKeeper.GetParams(ctx)
+	params.DeletionGracePeriod = time.Hour
+	s.app.AttributeKeeper.SetParams(ctx, params)
+
+	for _, value := range []string{"first", "second", "third"} {
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(ctx, attr(value), s.user1Addr))
+	}
+	s.Require().NoError(s.app.AttributeKeeper.DeleteAttribute(ctx, s.user1Addr, "example.attribute", nil, s.user1Addr))
+	attrs, err := s.app.AttributeKeeper.GetAttributes(ctx, s.user1Addr, "example.attribute")
+	s.Require().NoError(err)
+	s.Assert().Len(attrs, 0, "attributes after delete")
+	deleted, err := s.app.AttributeKeeper.GetDeletedAttributes(ctx, s.user1Addr, "example.attribute")
+	s.Require().NoError(err)
+	s.Require().Len(deleted, 3, "deleted attributes after delete")
+	for _, d := range deleted {
+		s.Assert().Equal(blockTime.Add(time.Hour), d.PurgeTime, "purge time of %s", d.Attribute.Value)
+		s.Assert().Equal(s.user1, d.DeletedBy, "deleted by of %s", d.Attribute.Value)
+	}
+
+	s.Run("restore requires an owner account", func() {
+		err := s.app.AttributeKeeper.RestoreAttribute(ctx, s.user1Addr, "example.attribute", nil, s.user2Addr)
+		s.Assert().EqualError(err, fmt.Sprintf("no account found for owner address \"%s\"", s.user2))
+	})
+	s.Run("restore a missing value", func() {
+		value := []byte("fourth")
+		err := s.app.AttributeKeeper.RestoreAttribute(ctx, s.user1Addr, "example.attribute", &value, s.user1Addr)
+		s.Assert().EqualError(err, "no deleted attributes restored with name example.attribute")
+	})
+	s.Run("restore a single value", func() {
+		value := []byte("first")
+		s.Require().NoError(s.app.AttributeKeeper.RestoreAttribute(ctx, s.user1Addr, "example.attribute", &value, s.user1Addr))
+		attrs, err := s.app.AttributeKeeper.GetAttributes(ctx, s.user1Addr, "example.attribute")
+		s.Require().NoError(err)
+		s.Assert().Equal([]types.Attribute{attr("first")}, attrs)
+		deleted, err := s.app.AttributeKeeper.GetDeletedAttributes(ctx, s.user1Addr, "example.attribute")
+		s.Require().NoError(err)
+		s.Assert().Len(deleted, 2, "deleted attributes after restore")
+		accounts, err := s.app.AttributeKeeper.GetAccountsByAttributeName(ctx, "example.attribute")
+		s.Require().NoError(err)
+		s.Assert().Len(accounts, 1, "accounts with restored attribute name")
+	})
+	s.Run("deleted attributes are exported and imported", func() {
+		genesis := s.app.AttributeKeeper.ExportGenesis(ctx)
+		s.Require().NoError(genesis.ValidateBasic())
+		s.Assert().Len(genesis.DeletedAttributes, 2)
+		s.app.AttributeKeeper.InitGenesis(ctx, genesis)
+		s.Assert().Equal(genesis.DeletedAttributes, s.app.AttributeKeeper.ExportGenesis(ctx).DeletedAttributes)
+	})
+	s.Run("deleted attributes are not purged before the grace period ends", func() {
+		s.Require().NoError(s.app.AttributeKeeper.PurgeDeletedAttributes(ctx.WithBlockTime(blockTime.Add(time.Hour - time.Second))))
+		deleted, err := s.app.AttributeKeeper.GetDeletedAttributes(ctx, s.user1Addr, "example.attribute")
+		s.Require().NoError(err)
+		s.Assert().Len(deleted, 2)
+	})
+	s.Run("deleted attributes are purged when the grace period ends", func() {
+		s.Require().NoError(s.app.AttributeKeeper.PurgeDeletedAttributes(ctx.WithBlockTime(blockTime.Add(time.Hour))))
+		deleted, err := s.app.AttributeKeeper.GetDeletedAttributes(ctx, s.user1Addr, "example.attribute")
+		s.Require().NoError(err)
+		s.Assert().Len(deleted, 0)
+		all, err := s.app.AttributeKeeper.GetAllDeletedAttributes(ctx)
+		s.Require().NoError(err)
+		s.Assert().Len(all, 0)
+		err = s.app.AttributeKeeper.RestoreAttribute(ctx, s.user1Addr, "example.attribute", nil, s.user1Addr)
+		s.Assert().EqualError(err, "no deleted attributes restored with name example.attribute")
+	})
+	s.Run("attributes are deleted immediately without a grace period", func() {
+		params.DeletionGracePeriod = 0
+		s.app.AttributeKeeper.SetParams(ctx, params)
+		value := []byte("first")
+		s.Require().NoError(s.app.AttributeKeeper.DeleteAttribute(ctx, s.user1Addr, "example.attribute", &value, s.user1Addr))
+		deleted, err := s.app.AttributeKeeper.GetDeletedAttributes(ctx, s.user1Addr, "example.attribute")
+		s.Require().NoError(err)
+		s.Assert().Len(deleted, 0)
+	})
+}
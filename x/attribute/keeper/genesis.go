@@ -17,6 +17,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, validator := range data.Validators {
+		k.setAttributeValidator(ctx, validator)
+	}
+	for _, deleted := range data.DeletedAttributes {
+		if err := k.importDeletedAttribute(ctx, deleted); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis exports the current keeper state of the attribute module.
@@ -33,5 +41,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, attrs)
+	genesis := types.NewGenesisState(params, attrs)
+	genesis.Validators = k.GetAllAttributeValidators(ctx)
+	deleted, err := k.GetAllDeletedAttributes(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.DeletedAttributes = deleted
+	return genesis
 }
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// The name of the attribute name binding invariant
+const nameBindingInvariantName = "name-binding"
+
+// RegisterInvariants registers module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, nameBindingInvariantName, NameBindingInvariant(k))
+}
+
+// AllInvariants runs all invariants of the attribute module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return NameBindingInvariant(k)(ctx)
+	}
+}
+
+// NameBindingInvariant checks that the name of every attribute is bound in the name module.
+func NameBindingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var problems []string
+		checked := map[string]bool{}
+		err := k.IterateRecords(ctx, types.AttributeKeyPrefix, func(attr types.Attribute) error {
+			exists, seen := checked[attr.Name]
+			if !seen {
+				exists = k.nameKeeper.NameExists(ctx, attr.Name)
+				checked[attr.Name] = exists
+			}
+			if !exists {
+				problems = append(problems, fmt.Sprintf("attribute %s on %s has an unbound name", attr.Name, attr.Address))
+			}
+			return nil
+		})
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("error iterating attributes: %v", err))
+		}
+
+		broken := len(problems) > 0
+		msg := fmt.Sprintf("found %d attributes without a bound name\n", len(problems))
+		for _, problem := range problems {
+			msg += fmt.Sprintf("\t%s\n", problem)
+		}
+		return sdk.FormatInvariant(types.ModuleName, nameBindingInvariantName, msg), broken
+	}
+}
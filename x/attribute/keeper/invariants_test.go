@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+
+	attributekeeper "github.com/provenance-io/provenance/x/attribute/keeper"
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+func TestNameBindingInvariant(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	user := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, user))
+
+	// Get a reference to our invariant checks
+	invariantChecks := attributekeeper.AllInvariants(app.AttributeKeeper)
+	require.NotNil(t, invariantChecks)
+
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "invariant", user, false))
+	attr := types.NewAttribute("invariant", user, types.AttributeType_String, []byte("value"))
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx, attr, user))
+
+	// all attribute names are bound
+	_, isBroken := invariantChecks(ctx)
+	require.False(t, isBroken)
+
+	// removing the name out from under the attribute breaks the invariant
+	require.NoError(t, app.NameKeeper.DeleteRecord(ctx, "invariant"))
+	msg, isBroken := invariantChecks(ctx)
+	require.True(t, isBroken)
+	require.Contains(t, msg, "attribute invariant on "+user.String()+" has an unbound name")
+}
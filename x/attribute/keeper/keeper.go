@@ -28,6 +28,8 @@ type Keeper struct {
 	authKeeper types.AccountKeeper
 	// The keeper used for ensuring names resolve to owners.
 	nameKeeper types.NameKeeper
+	// Used to query smart contracts registered to validate attribute values.
+	wasmKeeper types.WasmKeeper
 
 	// Key to access the key-value store from sdk.Context.
 	storeKey sdk.StoreKey
@@ -44,7 +46,7 @@ type Keeper struct {
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
-	authKeeper types.AccountKeeper, nameKeeper types.NameKeeper,
+	authKeeper types.AccountKeeper, nameKeeper types.NameKeeper, wasmKeeper types.WasmKeeper,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -55,6 +57,7 @@ func NewKeeper(
 		paramSpace: paramSpace,
 		authKeeper: authKeeper,
 		nameKeeper: nameKeeper,
+		wasmKeeper: wasmKeeper,
 		cdc:        cdc,
 	}
 }
@@ -140,6 +143,9 @@ func (k Keeper) SetAttribute(
 	if !k.nameKeeper.ResolvesTo(ctx, attr.Name, owner) {
 		return fmt.Errorf("\"%s\" does not resolve to address \"%s\"", attr.Name, owner.String())
 	}
+	if err = k.validateWithContract(ctx, attr); err != nil {
+		return err
+	}
 	// Store the sanitized account attribute
 	bz, err := k.cdc.Marshal(&attr)
 	if err != nil {
@@ -153,6 +159,9 @@ func (k Keeper) SetAttribute(
 	key := types.AccountAttributeKey(addr, attr)
 
 	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		k.incAttrNameAddressLookup(ctx, attr.Name, addr)
+	}
 	store.Set(key, bz)
 
 	attributeAddEvent := types.NewEventAttributeAdd(attr, owner.String())
@@ -208,6 +217,9 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 	if !k.nameKeeper.ResolvesTo(ctx, updateAttribute.Name, owner) {
 		return fmt.Errorf("\"%s\" does not resolve to address \"%s\"", updateAttribute.Name, owner.String())
 	}
+	if err = k.validateWithContract(ctx, updateAttribute); err != nil {
+		return err
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	it := sdk.KVStorePrefixIterator(store, types.AccountAttributesNameKeyPrefix(accountAddress, normalizedOrigName))
@@ -221,12 +233,16 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 		if attr.Name == updateAttribute.Name && bytes.Equal(attr.Value, originalAttribute.Value) && attr.AttributeType == originalAttribute.AttributeType {
 			found = true
 			store.Delete(it.Key())
+			k.decAttrNameAddressLookup(ctx, attr.Name, accountAddress)
 
 			bz, err := k.cdc.Marshal(&updateAttribute)
 			if err != nil {
 				return err
 			}
 			updatedKey := types.AccountAttributeKey(accountAddress, updateAttribute)
+			if !store.Has(updatedKey) {
+				k.incAttrNameAddressLookup(ctx, updateAttribute.Name, accountAddress)
+			}
 			store.Set(updatedKey, bz)
 
 			attributeUpdateEvent := types.NewEventAttributeUpdate(originalAttribute, updateAttribute, owner.String())
@@ -264,6 +280,7 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, acc sdk.AccAddress, name string
 		// else name does not exist (anymore) so we can't enforce permission check on delete here, proceed.
 	}
 
+	gracePeriod := k.GetDeletionGracePeriod(ctx)
 	store := ctx.KVStore(k.storeKey)
 	it := sdk.KVStorePrefixIterator(store, types.AccountAttributesNameKeyPrefix(acc, name))
 	var count int
@@ -276,6 +293,14 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, acc sdk.AccAddress, name string
 		if attr.Name == name && (!deleteDistinct || bytes.Equal(*value, attr.Value)) {
 			count++
 			store.Delete(it.Key())
+			k.decAttrNameAddressLookup(ctx, attr.Name, acc)
+			// Keep the attribute around so it can be restored until the grace period ends.
+			if gracePeriod > 0 {
+				deleted := types.NewDeletedAttribute(attr, ctx.BlockTime().Add(gracePeriod), owner.String())
+				if err := k.setDeletedAttribute(ctx, acc, deleted); err != nil {
+					return err
+				}
+			}
 
 			if !deleteDistinct {
 				deleteEvent := types.NewEventAttributeDelete(name, acc.String(), owner.String())
@@ -346,6 +371,87 @@ func (k Keeper) importAttribute(ctx sdk.Context, attr types.Attribute) error {
 	}
 	key := types.AccountAttributeKey(acc, attr)
 	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		k.incAttrNameAddressLookup(ctx, attr.Name, acc)
+	}
 	store.Set(key, bz)
 	return nil
 }
+
+// A genesis helper that imports deleted attribute state without owner checks.
+func (k Keeper) importDeletedAttribute(ctx sdk.Context, deleted types.DeletedAttribute) error {
+	if err := deleted.ValidateBasic(); err != nil {
+		return err
+	}
+	acc, err := sdk.AccAddressFromBech32(deleted.Attribute.Address)
+	if err != nil {
+		return err
+	}
+	attrNameOrig := deleted.Attribute.Name
+	if deleted.Attribute.Name, err = k.nameKeeper.Normalize(ctx, deleted.Attribute.Name); err != nil {
+		return fmt.Errorf("unable to normalize attribute name \"%s\": %w", attrNameOrig, err)
+	}
+	return k.setDeletedAttribute(ctx, acc, deleted)
+}
+
+// PopulateAddressAttributeNameTable adds every stored attribute to the lookup of accounts by attribute name.
+func (k Keeper) PopulateAddressAttributeNameTable(ctx sdk.Context) error {
+	var attrs []types.Attribute
+	if err := k.IterateRecords(ctx, types.AttributeKeyPrefix, func(attr types.Attribute) error {
+		attrs = append(attrs, attr)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, attr := range attrs {
+		acc, err := sdk.AccAddressFromBech32(attr.Address)
+		if err != nil {
+			return fmt.Errorf("invalid address for attribute %s: %w", attr.Name, err)
+		}
+		k.incAttrNameAddressLookup(ctx, attr.Name, acc)
+	}
+	return nil
+}
+
+// GetAccountsByAttributeName gets the addresses of all accounts that have attributes with the given name.
+func (k Keeper) GetAccountsByAttributeName(ctx sdk.Context, name string) ([]sdk.AccAddress, error) {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.AttributeNameKeyPrefix(name))
+	defer it.Close()
+	var accounts []sdk.AccAddress
+	for ; it.Valid(); it.Next() {
+		acc, err := types.GetAddressFromNameAddrKey(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, acc)
+	}
+	return accounts, nil
+}
+
+// getAttrNameAddressLookupCount gets the number of attributes an account has with a name.
+func (k Keeper) getAttrNameAddressLookupCount(ctx sdk.Context, name string, acc sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.AttributeNameAddrKey(name, acc))
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// incAttrNameAddressLookup records that an account has one more attribute with a name.
+func (k Keeper) incAttrNameAddressLookup(ctx sdk.Context, name string, acc sdk.AccAddress) {
+	count := k.getAttrNameAddressLookupCount(ctx, name, acc) + 1
+	ctx.KVStore(k.storeKey).Set(types.AttributeNameAddrKey(name, acc), sdk.Uint64ToBigEndian(count))
+}
+
+// decAttrNameAddressLookup records that an account has one less attribute with a name, removing the account from the
+// lookup when it has none left.
+func (k Keeper) decAttrNameAddressLookup(ctx sdk.Context, name string, acc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	count := k.getAttrNameAddressLookupCount(ctx, name, acc)
+	if count <= 1 {
+		store.Delete(types.AttributeNameAddrKey(name, acc))
+		return
+	}
+	store.Set(types.AttributeNameAddrKey(name, acc), sdk.Uint64ToBigEndian(count-1))
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -14,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/provenance-io/provenance/x/attribute/keeper"
 	"github.com/provenance-io/provenance/x/attribute/types"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
@@ -587,3 +589,160 @@ func (s *KeeperTestSuite) TestIterateRecord() {
 	})
 
 }
+
+func (s *KeeperTestSuite) TestAttributeAccountsLookup() {
+	attr := func(acc string, value string) types.Attribute {
+		return types.Attribute{
+			Name:          "example.attribute",
+			Value:         []byte(value),
+			Address:       acc,
+			AttributeType: types.AttributeType_String,
+		}
+	}
+	accounts := func() []sdk.AccAddress {
+		accs, err := s.app.AttributeKeeper.GetAccountsByAttributeName(s.ctx, "example.attribute")
+		s.Require().NoError(err)
+		return accs
+	}
+
+	s.Require().Empty(accounts())
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr(s.user1, "one"), s.user1Addr))
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr(s.user1, "two"), s.user1Addr))
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr(s.user1, "two"), s.user1Addr), "setting an existing attribute again")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr(s.user2, "one"), s.user1Addr))
+	s.Require().ElementsMatch([]sdk.AccAddress{s.user1Addr, s.user2Addr}, accounts())
+
+	res, err := s.app.AttributeKeeper.AttributeAccountsByName(sdk.WrapSDKContext(s.ctx),
+		&types.QueryAttributeAccountsByNameRequest{Name: "Example.Attribute"})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]string{s.user1, s.user2}, res.Accounts)
+	s.Require().Equal(uint64(2), res.Pagination.Total)
+
+	s.Require().NoError(s.app.AttributeKeeper.UpdateAttribute(s.ctx, attr(s.user2, "one"), attr(s.user2, "three"), s.user1Addr))
+	s.Require().ElementsMatch([]sdk.AccAddress{s.user1Addr, s.user2Addr}, accounts(), "after update")
+
+	value := []byte("one")
+	s.Require().NoError(s.app.AttributeKeeper.DeleteAttribute(s.ctx, s.user1Addr, "example.attribute", &value, s.user1Addr))
+	s.Require().ElementsMatch([]sdk.AccAddress{s.user1Addr, s.user2Addr}, accounts(), "after deleting one of two attributes")
+	s.Require().NoError(s.app.AttributeKeeper.DeleteAttribute(s.ctx, s.user2Addr, "example.attribute", nil, s.user1Addr))
+	s.Require().Equal([]sdk.AccAddress{s.user1Addr}, accounts(), "after deleting all attributes of an account")
+
+	_, err = s.app.AttributeKeeper.AttributeAccountsByName(sdk.WrapSDKContext(s.ctx), &types.QueryAttributeAccountsByNameRequest{})
+	s.Require().EqualError(err, "rpc error: code = InvalidArgument desc = empty attribute name")
+}
+
+func (s *KeeperTestSuite) TestPopulateAddressAttributeNameTable() {
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	for _, acc := range []sdk.AccAddress{s.user1Addr, s.user2Addr} {
+		attr := types.NewAttribute("example.attribute", acc, types.AttributeType_String, []byte("value"))
+		bz, err := s.app.AppCodec().Marshal(&attr)
+		s.Require().NoError(err)
+		store.Set(types.AccountAttributeKey(acc, attr), bz)
+	}
+	accs, err := s.app.AttributeKeeper.GetAccountsByAttributeName(s.ctx, "example.attribute")
+	s.Require().NoError(err)
+	s.Require().Empty(accs, "attributes stored without the lookup")
+
+	s.Require().NoError(s.app.AttributeKeeper.PopulateAddressAttributeNameTable(s.ctx))
+	accs, err = s.app.AttributeKeeper.GetAccountsByAttributeName(s.ctx, "example.attribute")
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]sdk.AccAddress{s.user1Addr, s.user2Addr}, accs)
+}
+
+func (s *KeeperTestSuite) TestAttributesQueryFilters() {
+	for _, attr := range []types.Attribute{
+		types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte("string")),
+		types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_Int, []byte("1")),
+		types.NewAttribute("attribute", s.user1Addr, types.AttributeType_String, []byte("root")),
+	} {
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr))
+	}
+
+	cases := []struct {
+		name     string
+		req      types.QueryAttributesRequest
+		expected []string
+		total    uint64
+	}{
+		{"no filters", types.QueryAttributesRequest{Account: s.user1}, []string{"string", "1", "root"}, 3},
+		{"name prefix", types.QueryAttributesRequest{Account: s.user1, NamePrefix: "example"}, []string{"string", "1"}, 2},
+		{"attribute type", types.QueryAttributesRequest{Account: s.user1, AttributeType: types.AttributeType_String}, []string{"string", "root"}, 2},
+		{
+			"name prefix and attribute type",
+			types.QueryAttributesRequest{Account: s.user1, NamePrefix: "example", AttributeType: types.AttributeType_Int},
+			[]string{"1"}, 1,
+		},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			res, err := s.app.AttributeKeeper.Attributes(sdk.WrapSDKContext(s.ctx), &tc.req)
+			s.Require().NoError(err)
+			values := make([]string, len(res.Attributes))
+			for i, attr := range res.Attributes {
+				values[i] = string(attr.Value)
+			}
+			s.Assert().ElementsMatch(tc.expected, values)
+			s.Assert().Equal(tc.total, res.Pagination.Total)
+		})
+	}
+
+	res, err := s.app.AttributeKeeper.Attribute(sdk.WrapSDKContext(s.ctx),
+		&types.QueryAttributeRequest{Account: s.user1, Name: "example.attribute", AttributeType: types.AttributeType_Int})
+	s.Require().NoError(err)
+	s.Require().Len(res.Attributes, 1)
+	s.Assert().Equal([]byte("1"), res.Attributes[0].Value)
+}
+
+func (s *KeeperTestSuite) TestSetAttributeAuthorization() {
+	ctx := s.ctx.WithBlockTime(time.Now())
+	account := sdk.AccAddress("account_____________")
+	grant := types.NewSetAttributeAuthorization(sdk.MsgTypeURL(&types.MsgAddAttributeRequest{}), "example.attribute",
+		[]sdk.AccAddress{account}, 8, 1)
+	s.Require().NoError(s.app.AuthzKeeper.SaveGrant(ctx, s.user2Addr, s.user1Addr, grant, ctx.BlockTime().Add(time.Hour)))
+
+	tooLong := types.NewMsgAddAttributeRequest(account, s.user1Addr, "example.attribute", types.AttributeType_String, []byte("verified!"))
+	_, err := s.app.AuthzKeeper.DispatchActions(ctx, s.user2Addr, []sdk.Msg{tooLong})
+	s.Require().EqualError(err, "attribute value length 9 is more than the allowed 8: unauthorized")
+
+	msg := types.NewMsgAddAttributeRequest(account, s.user1Addr, "example.attribute", types.AttributeType_String, []byte("verified"))
+	_, err = s.app.AuthzKeeper.DispatchActions(ctx, s.user2Addr, []sdk.Msg{msg})
+	s.Require().NoError(err)
+	attrs, err := s.app.AttributeKeeper.GetAttributes(ctx, account, "example.attribute")
+	s.Require().NoError(err)
+	s.Require().Len(attrs, 1)
+	s.Assert().Equal([]byte("verified"), attrs[0].Value)
+
+	// The only use of the grant is gone.
+	_, err = s.app.AuthzKeeper.DispatchActions(ctx, s.user2Addr, []sdk.Msg{msg})
+	s.Require().EqualError(err, "authorization not found: unauthorized")
+}
+
+func (s *KeeperTestSuite) TestAddAttributeBindIfMissingDeposit() {
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	params.AllowUnrestrictedNames = true
+	params.BindingDeposit = sdk.NewInt64Coin("hash", 100)
+	params.DepositHoldingSeconds = 3600
+	s.app.NameKeeper.SetParams(s.ctx, params)
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user2Addr))
+	s.Require().NoError(simapp.FundAccount(s.app, s.ctx, s.user2Addr, sdk.NewCoins(sdk.NewInt64Coin("hash", 150))))
+	msgServer := keeper.NewMsgServerImpl(s.app.AttributeKeeper)
+	addAttribute := func(name string) error {
+		msg := types.NewMsgAddAttributeRequest(s.user2Addr, s.user2Addr, name, types.AttributeType_String, []byte("value"))
+		msg.BindIfMissing = true
+		_, err := msgServer.AddAttribute(sdk.WrapSDKContext(s.ctx), msg)
+		return err
+	}
+
+	s.Require().NoError(addAttribute("squat.attribute"))
+	deposit, err := s.app.NameKeeper.GetDeposit(s.ctx, "squat.attribute")
+	s.Require().NoError(err)
+	s.Require().NotNil(deposit, "binding under an open root takes the deposit")
+	s.Require().Equal(s.user2, deposit.Depositor)
+	s.Require().Equal("50hash", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, "hash").String())
+
+	err = addAttribute("broke.attribute")
+	s.Require().Error(err, "binding fails when the owner cannot pay the deposit")
+	s.Require().Contains(err.Error(), "insufficient funds")
+	s.Require().False(s.app.NameKeeper.NameExists(s.ctx, "broke.attribute"))
+	s.Require().Equal("50hash", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, "hash").String())
+}
@@ -23,3 +23,11 @@ func (m *Migrator) Migrate1to2(ctx sdk.Context) error {
 	ctx.Logger().Info("Finished Migrating Attribute Module from Version 1 to 2")
 	return err
 }
+
+// Migrate2to3 migrates from version 2 to 3 to add the lookup of accounts by attribute name
+func (m *Migrator) Migrate2to3(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Attribute Module from Version 2 to 3 (1/1)")
+	err := m.keeper.PopulateAddressAttributeNameTable(ctx)
+	ctx.Logger().Info("Finished Migrating Attribute Module from Version 2 to 3")
+	return err
+}
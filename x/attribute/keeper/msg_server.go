@@ -37,6 +37,12 @@ func (k msgServer) AddAttribute(goCtx context.Context, msg *types.MsgAddAttribut
 		return nil, err
 	}
 
+	if msg.BindIfMissing {
+		if err = k.nameKeeper.BindNameIfMissing(ctx, msg.Name, ownerAddr); err != nil {
+			return nil, err
+		}
+	}
+
 	err = k.Keeper.SetAttribute(ctx, attrib, ownerAddr)
 	if err != nil {
 		return nil, err
@@ -202,3 +208,64 @@ func (k msgServer) DeleteDistinctAttribute(goCtx context.Context, msg *types.Msg
 
 	return &types.MsgDeleteDistinctAttributeResponse{}, nil
 }
+
+func (k msgServer) SetAttributeValidator(goCtx context.Context, msg *types.MsgSetAttributeValidatorRequest) (*types.MsgSetAttributeValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.SetAttributeValidator(ctx, msg.Name, msg.ContractAddress, ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAttributeValidatorResponse{}, nil
+}
+
+func (k msgServer) RestoreAttribute(goCtx context.Context, msg *types.MsgRestoreAttributeRequest) (*types.MsgRestoreAttributeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accountAddr, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var value *[]byte
+	if len(msg.Value) > 0 {
+		value = &msg.Value
+	}
+	err = k.Keeper.RestoreAttribute(ctx, accountAddr, msg.Name, value, ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.EventTelemetryKeyRestore},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(types.EventTelemetryLabelName, msg.Name),
+				telemetry.NewLabel(types.EventTelemetryLabelAccount, msg.Account),
+				telemetry.NewLabel(types.EventTelemetryLabelOwner, msg.Owner),
+			},
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttributeRestored,
+			sdk.NewAttribute(types.AttributeKeyNameAttribute, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyAccountAddress, msg.Account),
+		),
+	)
+
+	return &types.MsgRestoreAttributeResponse{}, nil
+}
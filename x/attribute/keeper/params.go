@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/attribute/types"
@@ -9,7 +11,8 @@ import (
 // GetParams returns the total set of account parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return types.Params{
-		MaxValueLength: k.GetMaxValueLength(ctx),
+		MaxValueLength:      k.GetMaxValueLength(ctx),
+		DeletionGracePeriod: k.GetDeletionGracePeriod(ctx),
 	}
 }
 
@@ -26,3 +29,13 @@ func (k Keeper) GetMaxValueLength(ctx sdk.Context) (maxValueLength uint32) {
 	}
 	return
 }
+
+// GetDeletionGracePeriod returns how long deleted attributes can be restored before they are purged (or default if
+// unset).  A zero grace period deletes attributes immediately.
+func (k Keeper) GetDeletionGracePeriod(ctx sdk.Context) (gracePeriod time.Duration) {
+	gracePeriod = types.DefaultDeletionGracePeriod
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyDeletionGracePeriod) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyDeletionGracePeriod, &gracePeriod)
+	}
+	return
+}
@@ -37,22 +37,17 @@ func (k Keeper) Attribute(c context.Context, req *types.QueryAttributeRequest) (
 		return nil, status.Error(codes.InvalidArgument, "empty attribute name")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
-	attributes := make([]types.Attribute, 0)
 	store := ctx.KVStore(k.storeKey)
 	accAddr, err := sdk.AccAddressFromBech32(req.Account)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid account address")
 	}
 	attributeStore := prefix.NewStore(store, types.AccountAttributesNameKeyPrefix(accAddr, req.Name))
-	pageRes, err := query.Paginate(attributeStore, req.Pagination, func(key []byte, value []byte) error {
-		var result types.Attribute
-		err = k.cdc.Unmarshal(value, &result)
-		if err != nil {
-			return err
-		}
-		attributes = append(attributes, result)
-		return nil
-	})
+	var filter func(types.Attribute) bool
+	if req.AttributeType != types.AttributeType_Unspecified {
+		filter = func(attr types.Attribute) bool { return attr.AttributeType == req.AttributeType }
+	}
+	attributes, pageRes, err := k.paginateAttributes(attributeStore, req.Pagination, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -68,23 +63,19 @@ func (k Keeper) Attributes(c context.Context, req *types.QueryAttributesRequest)
 		return nil, status.Error(codes.InvalidArgument, "empty account address")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
-	attributes := make([]types.Attribute, 0)
 	store := ctx.KVStore(k.storeKey)
 	accAddr, err := sdk.AccAddressFromBech32(req.Account)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid account address")
 	}
 	attributeStore := prefix.NewStore(store, types.AccountAttributesKeyPrefix(accAddr))
-
-	pageRes, err := query.Paginate(attributeStore, req.Pagination, func(key []byte, value []byte) error {
-		var result types.Attribute
-		err = k.cdc.Unmarshal(value, &result)
-		if err != nil {
-			return err
+	var filter func(types.Attribute) bool
+	if req.NamePrefix != "" || req.AttributeType != types.AttributeType_Unspecified {
+		filter = func(attr types.Attribute) bool {
+			return strings.HasPrefix(attr.Name, req.NamePrefix) && matchesAttributeType(attr, req.AttributeType)
 		}
-		attributes = append(attributes, result)
-		return nil
-	})
+	}
+	attributes, pageRes, err := k.paginateAttributes(attributeStore, req.Pagination, filter)
 
 	if err != nil {
 		return nil, err
@@ -105,21 +96,89 @@ func (k Keeper) Scan(c context.Context, req *types.QueryScanRequest) (*types.Que
 		return nil, status.Error(codes.InvalidArgument, "empty attribute name suffix")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
-	attributes := make([]types.Attribute, 0)
 	store := ctx.KVStore(k.storeKey)
 	accAddr, err := sdk.AccAddressFromBech32(req.Account)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid account address")
 	}
 	attributeStore := prefix.NewStore(store, types.AccountAttributesKeyPrefix(accAddr))
+	attributes, pageRes, err := k.paginateAttributes(attributeStore, req.Pagination, func(attr types.Attribute) bool {
+		return strings.HasSuffix(attr.Name, req.Suffix) && matchesAttributeType(attr, req.AttributeType)
+	})
 
-	pageRes, err := query.FilteredPaginate(attributeStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		var result types.Attribute
-		err = k.cdc.Unmarshal(value, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryScanResponse{Account: accAddr.String(), Attributes: attributes, Pagination: pageRes}, nil
+}
+
+// AttributeAccountsByName queries for the accounts that have attributes with a given name
+func (k Keeper) AttributeAccountsByName(c context.Context, req *types.QueryAttributeAccountsByNameRequest) (*types.QueryAttributeAccountsByNameResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty attribute name")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	accounts := make([]string, 0)
+	lookupStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AttributeNameKeyPrefix(req.Name))
+	pageRes, err := query.Paginate(lookupStore, withCountTotal(req.Pagination), func(key []byte, value []byte) error {
+		acc, err := types.GetAddressFromNameAddrKey(key)
 		if err != nil {
+			return err
+		}
+		accounts = append(accounts, acc.String())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAttributeAccountsByNameResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// AttributeValidator queries for the smart contract registered to validate values of an attribute name
+func (k Keeper) AttributeValidator(c context.Context, req *types.QueryAttributeValidatorRequest) (*types.QueryAttributeValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty attribute name")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	validator := k.GetAttributeValidator(ctx, req.Name)
+	if validator == nil {
+		return nil, status.Errorf(codes.NotFound, "no validator registered for %s", req.Name)
+	}
+	return &types.QueryAttributeValidatorResponse{Validator: *validator}, nil
+}
+
+// paginateAttributes gets a page of the attributes in a store that pass the filter.  Without a filter, the total
+// number of attributes is always counted when paginating by offset.  With a filter, the total is only counted when
+// requested since counting it moves the next key of the filtered pagination past any unmatched attributes that follow
+// the page.
+func (k Keeper) paginateAttributes(
+	store prefix.Store, pageReq *query.PageRequest, filter func(types.Attribute) bool,
+) ([]types.Attribute, *query.PageResponse, error) {
+	attributes := make([]types.Attribute, 0)
+	if filter == nil {
+		pageRes, err := query.Paginate(store, withCountTotal(pageReq), func(key []byte, value []byte) error {
+			var result types.Attribute
+			if err := k.cdc.Unmarshal(value, &result); err != nil {
+				return err
+			}
+			attributes = append(attributes, result)
+			return nil
+		})
+		return attributes, pageRes, err
+	}
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var result types.Attribute
+		if err := k.cdc.Unmarshal(value, &result); err != nil {
 			return false, err
 		}
-		if !strings.HasSuffix(result.Name, req.Suffix) {
+		if !filter(result) {
 			return false, nil
 		}
 		if accumulate {
@@ -127,10 +186,24 @@ func (k Keeper) Scan(c context.Context, req *types.QueryScanRequest) (*types.Que
 		}
 		return true, nil
 	})
+	return attributes, pageRes, err
+}
 
-	if err != nil {
-		return nil, err
+// withCountTotal returns a page request that also counts the total results unless paginating by key, where the total
+// is not available.
+func withCountTotal(pageReq *query.PageRequest) *query.PageRequest {
+	if pageReq == nil {
+		return &query.PageRequest{CountTotal: true}
 	}
+	if len(pageReq.Key) > 0 {
+		return pageReq
+	}
+	counted := *pageReq
+	counted.CountTotal = true
+	return &counted
+}
 
-	return &types.QueryScanResponse{Account: accAddr.String(), Attributes: attributes, Pagination: pageRes}, nil
+// matchesAttributeType returns true if the attribute has the type, or the type is unspecified.
+func matchesAttributeType(attr types.Attribute, attrType types.AttributeType) bool {
+	return attrType == types.AttributeType_Unspecified || attr.AttributeType == attrType
 }
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// GetAttributeValidator returns the smart contract registered to validate values of an attribute name, or nil if the
+// name does not have a validator.
+func (k Keeper) GetAttributeValidator(ctx sdk.Context, name string) *types.AttributeValidator {
+	bz := ctx.KVStore(k.storeKey).Get(types.AttributeValidatorKey(name))
+	if bz == nil {
+		return nil
+	}
+	validator := &types.AttributeValidator{}
+	k.cdc.MustUnmarshal(bz, validator)
+	return validator
+}
+
+// SetAttributeValidator registers a smart contract that must accept values of an attribute name before they are added
+// or updated.  An empty contract address removes the validator.  The attribute name must resolve to the owner address.
+func (k Keeper) SetAttributeValidator(ctx sdk.Context, name string, contractAddress string, owner sdk.AccAddress) error {
+	normalizedName, err := k.nameKeeper.Normalize(ctx, name)
+	if err != nil {
+		return fmt.Errorf("unable to normalize attribute name \"%s\": %w", name, err)
+	}
+	if !k.nameKeeper.ResolvesTo(ctx, normalizedName, owner) {
+		return fmt.Errorf("\"%s\" does not resolve to address \"%s\"", normalizedName, owner.String())
+	}
+
+	if len(contractAddress) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.AttributeValidatorKey(normalizedName))
+	} else {
+		contract, err := sdk.AccAddressFromBech32(contractAddress)
+		if err != nil {
+			return fmt.Errorf("invalid contract address \"%s\": %w", contractAddress, err)
+		}
+		if k.wasmKeeper == nil || !k.wasmKeeper.HasContractInfo(ctx, contract) {
+			return fmt.Errorf("no contract found at address \"%s\"", contractAddress)
+		}
+		k.setAttributeValidator(ctx, *types.NewAttributeValidator(normalizedName, contractAddress))
+	}
+
+	validatorEvent := types.NewEventAttributeValidatorUpdate(normalizedName, contractAddress, owner.String())
+	return ctx.EventManager().EmitTypedEvent(validatorEvent)
+}
+
+// setAttributeValidator stores the smart contract registered to validate values of an attribute name.
+func (k Keeper) setAttributeValidator(ctx sdk.Context, validator types.AttributeValidator) {
+	ctx.KVStore(k.storeKey).Set(types.AttributeValidatorKey(validator.Name), k.cdc.MustMarshal(&validator))
+}
+
+// GetAllAttributeValidators returns all registered attribute validators.
+func (k Keeper) GetAllAttributeValidators(ctx sdk.Context) []types.AttributeValidator {
+	validators := []types.AttributeValidator{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AttributeValidatorKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var validator types.AttributeValidator
+		k.cdc.MustUnmarshal(iterator.Value(), &validator)
+		validators = append(validators, validator)
+	}
+	return validators
+}
+
+// validateWithContract queries the smart contract registered for the attribute name (if any) and returns an error if
+// the contract does not accept the attribute.
+func (k Keeper) validateWithContract(ctx sdk.Context, attr types.Attribute) error {
+	validator := k.GetAttributeValidator(ctx, attr.Name)
+	if validator == nil {
+		return nil
+	}
+	contract, err := sdk.AccAddressFromBech32(validator.ContractAddress)
+	if err != nil {
+		return err
+	}
+	if k.wasmKeeper == nil {
+		return fmt.Errorf("unable to query attribute validator \"%s\" for \"%s\"", validator.ContractAddress, attr.Name)
+	}
+	req, err := json.Marshal(types.NewValidateAttributeQuery(attr))
+	if err != nil {
+		return err
+	}
+	bz, err := k.wasmKeeper.QuerySmart(ctx, contract, req)
+	if err != nil {
+		return fmt.Errorf("attribute validator \"%s\" query failed for \"%s\": %w", validator.ContractAddress, attr.Name, err)
+	}
+	var res types.ValidateAttributeResponse
+	if err = json.Unmarshal(bz, &res); err != nil {
+		return fmt.Errorf("invalid attribute validator \"%s\" response: %w", validator.ContractAddress, err)
+	}
+	if !res.Valid {
+		return fmt.Errorf("attribute \"%s\" value rejected by validator \"%s\": %s", attr.Name, validator.ContractAddress, res.Reason)
+	}
+	return nil
+}
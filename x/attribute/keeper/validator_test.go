@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/keeper"
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// mockWasmKeeper accepts attribute values that match the expected value of a single contract.
+type mockWasmKeeper struct {
+	contract sdk.AccAddress
+	expected string
+	queries  []types.ValidateAttributeQuery
+}
+
+func (m *mockWasmKeeper) QuerySmart(_ sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	if !contractAddr.Equals(m.contract) {
+		return nil, fmt.Errorf("unknown contract")
+	}
+	var query types.ValidateAttributeQuery
+	if err := json.Unmarshal(req, &query); err != nil {
+		return nil, err
+	}
+	m.queries = append(m.queries, query)
+	res := types.ValidateAttributeResponse{Valid: string(query.ValidateAttribute.Value) == m.expected}
+	if !res.Valid {
+		res.Reason = "unexpected value"
+	}
+	return json.Marshal(res)
+}
+
+func (m *mockWasmKeeper) HasContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) bool {
+	return contractAddress.Equals(m.contract)
+}
+
+func (s *KeeperTestSuite) TestAttributeValidator() {
+	contract := sdk.AccAddress("validator_contract__")
+	wasm := &mockWasmKeeper{contract: contract, expected: `{"a":1}`}
+	attrKeeper := keeper.NewKeeper(
+		s.app.AppCodec(), s.app.GetKey(types.StoreKey), s.app.GetSubspace(types.ModuleName),
+		s.app.AccountKeeper, s.app.NameKeeper, wasm,
+	)
+	attr := types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_JSON, []byte(`{"a":1}`))
+
+	s.Run("only the name owner can set a validator", func() {
+		err := attrKeeper.SetAttributeValidator(s.ctx, "example.attribute", contract.String(), s.user2Addr)
+		s.Require().EqualError(err, fmt.Sprintf("\"example.attribute\" does not resolve to address \"%s\"", s.user2))
+	})
+	s.Run("validators must be contracts", func() {
+		err := attrKeeper.SetAttributeValidator(s.ctx, "example.attribute", s.user2, s.user1Addr)
+		s.Require().EqualError(err, fmt.Sprintf("no contract found at address \"%s\"", s.user2))
+	})
+	s.Run("validator is stored", func() {
+		s.Require().NoError(attrKeeper.SetAttributeValidator(s.ctx, "example.attribute", contract.String(), s.user1Addr))
+		em := s.ctx.EventManager().ABCIEvents()
+		event, err := sdk.ParseTypedEvent(em[len(em)-1])
+		s.Require().NoError(err)
+		s.Require().Equal(types.NewEventAttributeValidatorUpdate("example.attribute", contract.String(), s.user1), event)
+		s.Require().Equal([]types.AttributeValidator{*types.NewAttributeValidator("example.attribute", contract.String())},
+			attrKeeper.GetAllAttributeValidators(s.ctx))
+	})
+	s.Run("values accepted by the contract are stored", func() {
+		s.Require().NoError(attrKeeper.SetAttribute(s.ctx, attr, s.user1Addr))
+		s.Require().Equal([]types.ValidateAttributeQuery{types.NewValidateAttributeQuery(attr)}, wasm.queries)
+	})
+	s.Run("values rejected by the contract are not stored", func() {
+		rejected := types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_JSON, []byte(`{"b":2}`))
+		err := attrKeeper.SetAttribute(s.ctx, rejected, s.user1Addr)
+		s.Require().EqualError(err, fmt.Sprintf("attribute \"example.attribute\" value rejected by validator \"%s\": unexpected value", contract))
+		err = attrKeeper.UpdateAttribute(s.ctx, attr, rejected, s.user1Addr)
+		s.Require().EqualError(err, fmt.Sprintf("attribute \"example.attribute\" value rejected by validator \"%s\": unexpected value", contract))
+	})
+	s.Run("validators are exported", func() {
+		genesis := attrKeeper.ExportGenesis(s.ctx)
+		s.Require().Equal([]types.AttributeValidator{*types.NewAttributeValidator("example.attribute", contract.String())}, genesis.Validators)
+	})
+	s.Run("validators are removed with an empty contract address", func() {
+		s.Require().NoError(attrKeeper.SetAttributeValidator(s.ctx, "example.attribute", "", s.user1Addr))
+		s.Require().Nil(attrKeeper.GetAttributeValidator(s.ctx, "example.attribute"))
+		_, err := attrKeeper.AttributeValidator(sdk.WrapSDKContext(s.ctx), &types.QueryAttributeValidatorRequest{Name: "example.attribute"})
+		s.Require().Error(err)
+	})
+}
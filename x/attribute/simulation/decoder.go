@@ -22,6 +22,13 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &attribB)
 
 			return fmt.Sprintf("%v\n%v", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.DeletedAttributeKeyPrefix):
+			var deletedA, deletedB types.DeletedAttribute
+
+			cdc.MustUnmarshal(kvA.Value, &deletedA)
+			cdc.MustUnmarshal(kvB.Value, &deletedB)
+
+			return fmt.Sprintf("%v\n%v", deletedA, deletedB)
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -19,10 +20,12 @@ func TestDecodeStore(t *testing.T) {
 	dec := simulation.NewDecodeStore(cdc)
 
 	testAttributeRecord := types.NewAttribute("test", sdk.AccAddress{}, types.AttributeType_Int, []byte{1})
+	testDeletedAttribute := types.NewDeletedAttribute(testAttributeRecord, time.Unix(1000, 0).UTC(), "")
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.AttributeKeyPrefix, Value: cdc.MustMarshal(&testAttributeRecord)},
+			{Key: types.DeletedAttributeKeyPrefix, Value: cdc.MustMarshal(&testDeletedAttribute)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -32,6 +35,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Attribute Record", fmt.Sprintf("%v\n%v", testAttributeRecord, testAttributeRecord)},
+		{"Deleted Attribute", fmt.Sprintf("%v\n%v", testDeletedAttribute, testDeletedAttribute)},
 		{"other", ""},
 	}
 
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/module"
 
@@ -14,7 +15,8 @@ import (
 
 // Simulation parameter constants
 const (
-	MaxValueLength = "max_value_length"
+	MaxValueLength      = "max_value_length"
+	DeletionGracePeriod = "deletion_grace_period"
 )
 
 // GenMaxValueLength randomized MaxValueLength
@@ -22,6 +24,14 @@ func GenMaxValueLength(r *rand.Rand) uint32 {
 	return r.Uint32()
 }
 
+// GenDeletionGracePeriod randomized DeletionGracePeriod, zero about half of the time
+func GenDeletionGracePeriod(r *rand.Rand) time.Duration {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return time.Duration(r.Intn(60*60*24*30)) * time.Second
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var maxValueLength uint32
@@ -30,9 +40,16 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { maxValueLength = GenMaxValueLength(r) },
 	)
 
+	var deletionGracePeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DeletionGracePeriod, &deletionGracePeriod, simState.Rand,
+		func(r *rand.Rand) { deletionGracePeriod = GenDeletionGracePeriod(r) },
+	)
+
 	attributeGenesis := types.GenesisState{
 		Params: types.Params{
-			MaxValueLength: maxValueLength,
+			MaxValueLength:      maxValueLength,
+			DeletionGracePeriod: deletionGracePeriod,
 		},
 		Attributes: []types.Attribute{},
 	}
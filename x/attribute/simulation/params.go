@@ -14,7 +14,8 @@ import (
 )
 
 const (
-	keyMaxValueLength = "MaxValueLength"
+	keyMaxValueLength      = "MaxValueLength"
+	keyDeletionGracePeriod = "DeletionGracePeriod"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -26,5 +27,10 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("%d", GenMaxValueLength(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyDeletionGracePeriod,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenDeletionGracePeriod(r))
+			},
+		),
 	}
 }
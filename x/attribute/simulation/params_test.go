@@ -20,11 +20,12 @@ func TestParamChanges(t *testing.T) {
 		subspace    string
 	}{
 		{"attribute/MaxValueLength", "MaxValueLength", "2596996162", "attribute"},
+		{"attribute/DeletionGracePeriod", "DeletionGracePeriod", "\"1531847000000000\"", "attribute"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
 
-	require.Len(t, paramChanges, 1)
+	require.Len(t, paramChanges, 2)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
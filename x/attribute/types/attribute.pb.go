@@ -7,15 +7,21 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	crypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -83,6 +89,8 @@ func (AttributeType) EnumDescriptor() ([]byte, []int) {
 type Params struct {
 	// maximum length of data to allow in an attribute value
 	MaxValueLength uint32 `protobuf:"varint,1,opt,name=max_value_length,json=maxValueLength,proto3" json:"max_value_length,omitempty"`
+	// how long deleted attributes can be restored before they are purged, zero deletes attributes immediately
+	DeletionGracePeriod time.Duration `protobuf:"bytes,2,opt,name=deletion_grace_period,json=deletionGracePeriod,proto3,stdduration" json:"deletion_grace_period" yaml:"deletion_grace_period"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -124,6 +132,13 @@ func (m *Params) GetMaxValueLength() uint32 {
 	return 0
 }
 
+func (m *Params) GetDeletionGracePeriod() time.Duration {
+	if m != nil {
+		return m.DeletionGracePeriod
+	}
+	return 0
+}
+
 // Attribute holds a typed key/value structure for data associated with an account
 type Attribute struct {
 	// The attribute name.
@@ -196,6 +211,262 @@ func (m *Attribute) GetAddress() string {
 	return ""
 }
 
+// AttributeValidator registers a smart contract that must accept values of an attribute before they are stored.
+type AttributeValidator struct {
+	// The attribute name the contract validates values for.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The bech32 address of the validating smart contract.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+}
+
+func (m *AttributeValidator) Reset()         { *m = AttributeValidator{} }
+func (m *AttributeValidator) String() string { return proto.CompactTextString(m) }
+func (*AttributeValidator) ProtoMessage()    {}
+func (*AttributeValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{2}
+}
+func (m *AttributeValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeValidator.Merge(m, src)
+}
+func (m *AttributeValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeValidator proto.InternalMessageInfo
+
+func (m *AttributeValidator) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AttributeValidator) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// DeletedAttribute is an attribute that has been deleted and can be restored until its purge time.
+type DeletedAttribute struct {
+	// The attribute that was deleted.
+	Attribute Attribute `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute"`
+	// The time after which the attribute is purged and can no longer be restored.
+	PurgeTime time.Time `protobuf:"bytes,2,opt,name=purge_time,json=purgeTime,proto3,stdtime" json:"purge_time" yaml:"purge_time"`
+	// The address that deleted the attribute.
+	DeletedBy string `protobuf:"bytes,3,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty" yaml:"deleted_by"`
+}
+
+func (m *DeletedAttribute) Reset()         { *m = DeletedAttribute{} }
+func (m *DeletedAttribute) String() string { return proto.CompactTextString(m) }
+func (*DeletedAttribute) ProtoMessage()    {}
+func (*DeletedAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{3}
+}
+func (m *DeletedAttribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletedAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletedAttribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeletedAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletedAttribute.Merge(m, src)
+}
+func (m *DeletedAttribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletedAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletedAttribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletedAttribute proto.InternalMessageInfo
+
+func (m *DeletedAttribute) GetAttribute() Attribute {
+	if m != nil {
+		return m.Attribute
+	}
+	return Attribute{}
+}
+
+func (m *DeletedAttribute) GetPurgeTime() time.Time {
+	if m != nil {
+		return m.PurgeTime
+	}
+	return time.Time{}
+}
+
+func (m *DeletedAttribute) GetDeletedBy() string {
+	if m != nil {
+		return m.DeletedBy
+	}
+	return ""
+}
+
+// AttributeProof is a merkle proof that an attribute was in state at a height, packaged with the block time so the
+// attribute can be shown to have existed at that time without trusting the node that produced the proof.
+type AttributeProof struct {
+	// The attribute that was proven.
+	Attribute Attribute `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute"`
+	// The height of the state the attribute was proven against.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The time of the block at height.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// The app hash of the state at height, committed in the header of the block at height + 1.
+	AppHash []byte `protobuf:"bytes,4,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty" yaml:"app_hash"`
+	// The key of the attribute in the attribute module store.
+	Key []byte `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	// The merkle proof of the attribute store entry against the app hash.
+	Proof crypto.ProofOps `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof"`
+}
+
+func (m *AttributeProof) Reset()         { *m = AttributeProof{} }
+func (m *AttributeProof) String() string { return proto.CompactTextString(m) }
+func (*AttributeProof) ProtoMessage()    {}
+func (*AttributeProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{4}
+}
+func (m *AttributeProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeProof.Merge(m, src)
+}
+func (m *AttributeProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeProof proto.InternalMessageInfo
+
+func (m *AttributeProof) GetAttribute() Attribute {
+	if m != nil {
+		return m.Attribute
+	}
+	return Attribute{}
+}
+
+func (m *AttributeProof) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AttributeProof) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *AttributeProof) GetAppHash() []byte {
+	if m != nil {
+		return m.AppHash
+	}
+	return nil
+}
+
+func (m *AttributeProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *AttributeProof) GetProof() crypto.ProofOps {
+	if m != nil {
+		return m.Proof
+	}
+	return crypto.ProofOps{}
+}
+
+// AttributeProofs is a set of attribute proofs.
+type AttributeProofs struct {
+	Proofs []AttributeProof `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs"`
+}
+
+func (m *AttributeProofs) Reset()         { *m = AttributeProofs{} }
+func (m *AttributeProofs) String() string { return proto.CompactTextString(m) }
+func (*AttributeProofs) ProtoMessage()    {}
+func (*AttributeProofs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{5}
+}
+func (m *AttributeProofs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeProofs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeProofs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeProofs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeProofs.Merge(m, src)
+}
+func (m *AttributeProofs) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeProofs) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeProofs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeProofs proto.InternalMessageInfo
+
+func (m *AttributeProofs) GetProofs() []AttributeProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
 // EventAttributeAdd event emitted when attribute is added
 type EventAttributeAdd struct {
 	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -209,7 +480,7 @@ func (m *EventAttributeAdd) Reset()         { *m = EventAttributeAdd{} }
 func (m *EventAttributeAdd) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeAdd) ProtoMessage()    {}
 func (*EventAttributeAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{2}
+	return fileDescriptor_14fe7eb43c711f5e, []int{6}
 }
 func (m *EventAttributeAdd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,7 +559,7 @@ func (m *EventAttributeUpdate) Reset()         { *m = EventAttributeUpdate{} }
 func (m *EventAttributeUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeUpdate) ProtoMessage()    {}
 func (*EventAttributeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{3}
+	return fileDescriptor_14fe7eb43c711f5e, []int{7}
 }
 func (m *EventAttributeUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,7 +648,7 @@ func (m *EventAttributeDelete) Reset()         { *m = EventAttributeDelete{} }
 func (m *EventAttributeDelete) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeDelete) ProtoMessage()    {}
 func (*EventAttributeDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{4}
+	return fileDescriptor_14fe7eb43c711f5e, []int{8}
 }
 func (m *EventAttributeDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,7 +711,7 @@ func (m *EventAttributeDistinctDelete) Reset()         { *m = EventAttributeDist
 func (m *EventAttributeDistinctDelete) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeDistinctDelete) ProtoMessage()    {}
 func (*EventAttributeDistinctDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{5}
+	return fileDescriptor_14fe7eb43c711f5e, []int{9}
 }
 func (m *EventAttributeDistinctDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -504,112 +775,362 @@ func (m *EventAttributeDistinctDelete) GetOwner() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("provenance.attribute.v1.AttributeType", AttributeType_name, AttributeType_value)
-	proto.RegisterType((*Params)(nil), "provenance.attribute.v1.Params")
-	proto.RegisterType((*Attribute)(nil), "provenance.attribute.v1.Attribute")
-	proto.RegisterType((*EventAttributeAdd)(nil), "provenance.attribute.v1.EventAttributeAdd")
-	proto.RegisterType((*EventAttributeUpdate)(nil), "provenance.attribute.v1.EventAttributeUpdate")
-	proto.RegisterType((*EventAttributeDelete)(nil), "provenance.attribute.v1.EventAttributeDelete")
-	proto.RegisterType((*EventAttributeDistinctDelete)(nil), "provenance.attribute.v1.EventAttributeDistinctDelete")
+// EventAttributeValidatorUpdate event emitted when the validating contract of an attribute name is set or removed
+type EventAttributeValidatorUpdate struct {
+	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Owner           string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("provenance/attribute/v1/attribute.proto", fileDescriptor_14fe7eb43c711f5e)
+func (m *EventAttributeValidatorUpdate) Reset()         { *m = EventAttributeValidatorUpdate{} }
+func (m *EventAttributeValidatorUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeValidatorUpdate) ProtoMessage()    {}
+func (*EventAttributeValidatorUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{10}
+}
+func (m *EventAttributeValidatorUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeValidatorUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeValidatorUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttributeValidatorUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeValidatorUpdate.Merge(m, src)
+}
+func (m *EventAttributeValidatorUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeValidatorUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeValidatorUpdate.DiscardUnknown(m)
 }
 
-var fileDescriptor_14fe7eb43c711f5e = []byte{
-	// 646 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6f, 0x12, 0x41,
-	0x14, 0x66, 0xf8, 0x59, 0x5e, 0x0b, 0xae, 0x23, 0x46, 0xb2, 0x31, 0x74, 0xdb, 0xa6, 0x4a, 0x4c,
-	0x84, 0x54, 0x63, 0x62, 0xbc, 0x81, 0xa5, 0x66, 0x4d, 0x05, 0xb2, 0x2c, 0x26, 0xed, 0x65, 0x33,
-	0x85, 0x91, 0x6e, 0x02, 0x3b, 0x64, 0x77, 0xc0, 0xf6, 0xe8, 0x95, 0x93, 0x47, 0x2f, 0x44, 0x0f,
-	0xfe, 0x31, 0x1e, 0x7b, 0xf4, 0x64, 0x4c, 0x7b, 0xf3, 0xaf, 0x30, 0xcc, 0x14, 0xd8, 0xae, 0xac,
-	0xc6, 0xdb, 0xfb, 0xde, 0x7e, 0xfb, 0x7d, 0xdf, 0x9b, 0x99, 0x3c, 0x78, 0x38, 0x74, 0xd9, 0x98,
-	0x3a, 0xc4, 0xe9, 0xd0, 0x32, 0xe1, 0xdc, 0xb5, 0x4f, 0x46, 0x9c, 0x96, 0xc7, 0x7b, 0x4b, 0x50,
-	0x1a, 0xba, 0x8c, 0x33, 0x7c, 0x6f, 0x49, 0x2c, 0x2d, 0xbf, 0x8d, 0xf7, 0xd4, 0x5c, 0x8f, 0xf5,
-	0x98, 0xe0, 0x94, 0x67, 0x95, 0xa4, 0x6f, 0x3f, 0x87, 0x64, 0x93, 0xb8, 0x64, 0xe0, 0xe1, 0x22,
-	0x28, 0x03, 0x72, 0x66, 0x8d, 0x49, 0x7f, 0x44, 0xad, 0x3e, 0x75, 0x7a, 0xfc, 0x34, 0x8f, 0x34,
-	0x54, 0xcc, 0x18, 0xd9, 0x01, 0x39, 0x7b, 0x3b, 0x6b, 0x1f, 0x8a, 0xee, 0x8b, 0xf8, 0xa7, 0x2f,
-	0x9b, 0x91, 0xed, 0xaf, 0x08, 0xd2, 0x95, 0xb9, 0x01, 0xc6, 0x10, 0x77, 0xc8, 0x80, 0x8a, 0x3f,
-	0xd2, 0x86, 0xa8, 0x71, 0x0e, 0x12, 0x42, 0x2d, 0x1f, 0xd5, 0x50, 0x71, 0xc3, 0x90, 0x00, 0xbf,
-	0x81, 0xec, 0x22, 0x97, 0xc5, 0xcf, 0x87, 0x34, 0x1f, 0xd3, 0x50, 0x31, 0xfb, 0xe4, 0x41, 0x29,
-	0x24, 0x79, 0x69, 0xe1, 0x62, 0x9e, 0x0f, 0xa9, 0x91, 0x21, 0x7e, 0x88, 0xf3, 0x90, 0x22, 0xdd,
-	0xae, 0x4b, 0x3d, 0x2f, 0x1f, 0x17, 0xde, 0x73, 0x78, 0x1d, 0xf3, 0x03, 0x82, 0xdb, 0xb5, 0x31,
-	0x75, 0xf8, 0x42, 0xa5, 0xd2, 0xed, 0xfe, 0x3b, 0x6e, 0x7a, 0x1e, 0x17, 0x43, 0x7c, 0x11, 0x32,
-	0x6d, 0x88, 0x5a, 0x78, 0x76, 0x3a, 0x6c, 0xe4, 0xf0, 0x85, 0xa7, 0x84, 0x33, 0x0d, 0xf6, 0xde,
-	0xa1, 0x6e, 0x3e, 0x21, 0x35, 0x04, 0xd8, 0xfe, 0x85, 0x20, 0x77, 0x33, 0x43, 0x7b, 0xd8, 0x25,
-	0x21, 0xa7, 0xb6, 0x0b, 0x59, 0xe6, 0xda, 0x3d, 0xdb, 0x21, 0x7d, 0xcb, 0x9f, 0x27, 0x33, 0xef,
-	0x8a, 0xab, 0xc0, 0x3b, 0xb0, 0x68, 0x58, 0xbe, 0x80, 0x1b, 0xf3, 0xa6, 0x38, 0x9c, 0x2d, 0xd8,
-	0x18, 0x09, 0xa7, 0x6b, 0x25, 0x99, 0x76, 0x5d, 0xf6, 0xa4, 0xce, 0x26, 0x5c, 0x43, 0xa9, 0x22,
-	0x73, 0x83, 0x6c, 0x99, 0x81, 0x61, 0x93, 0x21, 0xc3, 0xa6, 0xfc, 0xc3, 0x1e, 0x07, 0x67, 0xdd,
-	0xa7, 0x7d, 0x1a, 0x32, 0xab, 0x4f, 0x3b, 0x1a, 0xa2, 0x1d, 0xf3, 0x6b, 0x7f, 0x46, 0x70, 0x3f,
-	0x20, 0x6e, 0x7b, 0xdc, 0x76, 0x3a, 0xfc, 0x2f, 0x26, 0xab, 0xef, 0x75, 0x77, 0xe5, 0x33, 0x4c,
-	0xaf, 0x7a, 0x5e, 0xff, 0x71, 0xd5, 0x8f, 0x7e, 0x44, 0x21, 0x73, 0xe3, 0xbd, 0xe2, 0x32, 0xa8,
-	0x15, 0xd3, 0x34, 0xf4, 0x6a, 0xdb, 0xac, 0x59, 0xe6, 0x51, 0xb3, 0x66, 0xb5, 0xeb, 0xad, 0x66,
-	0xed, 0xa5, 0x7e, 0xa0, 0xd7, 0xf6, 0x95, 0x88, 0x7a, 0x6b, 0x32, 0xd5, 0xd6, 0xdb, 0x8e, 0x37,
-	0xa4, 0x1d, 0xfb, 0x9d, 0x4d, 0xbb, 0x78, 0x0b, 0xee, 0x04, 0x7f, 0x68, 0xeb, 0xfb, 0x0a, 0x52,
-	0xd7, 0x26, 0x53, 0x2d, 0x3e, 0xab, 0x57, 0x50, 0x5e, 0xb7, 0x1a, 0x75, 0x25, 0x2a, 0x29, 0xb3,
-	0x1a, 0xef, 0xc2, 0xdd, 0x00, 0xa5, 0x65, 0x1a, 0x7a, 0xfd, 0x95, 0x12, 0x53, 0x61, 0x32, 0xd5,
-	0x92, 0x2d, 0xee, 0xda, 0x4e, 0x0f, 0x6f, 0x02, 0x0e, 0x9a, 0x19, 0xba, 0x12, 0x57, 0x53, 0x93,
-	0xa9, 0x16, 0x6b, 0xbb, 0xf6, 0x0a, 0x82, 0x5e, 0x37, 0x95, 0x84, 0x24, 0xe8, 0x0e, 0xc7, 0x3b,
-	0x90, 0x0b, 0x10, 0x0e, 0x0e, 0x1b, 0x15, 0x53, 0x49, 0xaa, 0xe9, 0xc9, 0x54, 0x4b, 0x1c, 0xf4,
-	0x19, 0x59, 0x45, 0x6a, 0x1a, 0x0d, 0xb3, 0xa1, 0xa4, 0x24, 0xa9, 0x29, 0x56, 0xd7, 0x9f, 0xa4,
-	0xea, 0x91, 0x59, 0x6b, 0x29, 0x6b, 0x92, 0x54, 0x3d, 0xe7, 0xd4, 0xab, 0x0e, 0xbe, 0x5d, 0x16,
-	0xd0, 0xc5, 0x65, 0x01, 0xfd, 0xbc, 0x2c, 0xa0, 0x8f, 0x57, 0x85, 0xc8, 0xc5, 0x55, 0x21, 0xf2,
-	0xfd, 0xaa, 0x10, 0x01, 0xd5, 0x66, 0x61, 0x2b, 0xa4, 0x89, 0x8e, 0x9f, 0xf5, 0x6c, 0x7e, 0x3a,
-	0x3a, 0x29, 0x75, 0xd8, 0xa0, 0xbc, 0x64, 0x3d, 0xb6, 0x99, 0x0f, 0x95, 0xcf, 0x7c, 0xbb, 0x75,
-	0xf6, 0x26, 0xbc, 0x93, 0xa4, 0x58, 0x93, 0x4f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x6c,
-	0x88, 0xaf, 0x80, 0x05, 0x00, 0x00,
+var xxx_messageInfo_EventAttributeValidatorUpdate proto.InternalMessageInfo
+
+func (m *EventAttributeValidatorUpdate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAttributeValidatorUpdate) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventAttributeValidatorUpdate) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxValueLength != 0 {
-		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxValueLength))
-		i--
-		dAtA[i] = 0x8
+// EventAttributeRestore event emitted when a deleted attribute is restored
+type EventAttributeRestore struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Type    string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Account string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Owner   string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *EventAttributeRestore) Reset()         { *m = EventAttributeRestore{} }
+func (m *EventAttributeRestore) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeRestore) ProtoMessage()    {}
+func (*EventAttributeRestore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{11}
+}
+func (m *EventAttributeRestore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeRestore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeRestore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventAttributeRestore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeRestore.Merge(m, src)
+}
+func (m *EventAttributeRestore) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeRestore) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeRestore.DiscardUnknown(m)
 }
 
-func (m *Attribute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventAttributeRestore proto.InternalMessageInfo
+
+func (m *EventAttributeRestore) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Attribute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventAttributeRestore) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
 
-func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
+func (m *EventAttributeRestore) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EventAttributeRestore) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventAttributeRestore) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// EventAttributePurge event emitted when a deleted attribute is purged at the end of its grace period
+type EventAttributePurge struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Type    string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Account string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *EventAttributePurge) Reset()         { *m = EventAttributePurge{} }
+func (m *EventAttributePurge) String() string { return proto.CompactTextString(m) }
+func (*EventAttributePurge) ProtoMessage()    {}
+func (*EventAttributePurge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{12}
+}
+func (m *EventAttributePurge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributePurge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributePurge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttributePurge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributePurge.Merge(m, src)
+}
+func (m *EventAttributePurge) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributePurge) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributePurge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributePurge proto.InternalMessageInfo
+
+func (m *EventAttributePurge) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventAttributePurge) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *EventAttributePurge) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EventAttributePurge) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("provenance.attribute.v1.AttributeType", AttributeType_name, AttributeType_value)
+	proto.RegisterType((*Params)(nil), "provenance.attribute.v1.Params")
+	proto.RegisterType((*Attribute)(nil), "provenance.attribute.v1.Attribute")
+	proto.RegisterType((*AttributeValidator)(nil), "provenance.attribute.v1.AttributeValidator")
+	proto.RegisterType((*DeletedAttribute)(nil), "provenance.attribute.v1.DeletedAttribute")
+	proto.RegisterType((*AttributeProof)(nil), "provenance.attribute.v1.AttributeProof")
+	proto.RegisterType((*AttributeProofs)(nil), "provenance.attribute.v1.AttributeProofs")
+	proto.RegisterType((*EventAttributeAdd)(nil), "provenance.attribute.v1.EventAttributeAdd")
+	proto.RegisterType((*EventAttributeUpdate)(nil), "provenance.attribute.v1.EventAttributeUpdate")
+	proto.RegisterType((*EventAttributeDelete)(nil), "provenance.attribute.v1.EventAttributeDelete")
+	proto.RegisterType((*EventAttributeDistinctDelete)(nil), "provenance.attribute.v1.EventAttributeDistinctDelete")
+	proto.RegisterType((*EventAttributeValidatorUpdate)(nil), "provenance.attribute.v1.EventAttributeValidatorUpdate")
+	proto.RegisterType((*EventAttributeRestore)(nil), "provenance.attribute.v1.EventAttributeRestore")
+	proto.RegisterType((*EventAttributePurge)(nil), "provenance.attribute.v1.EventAttributePurge")
+}
+
+func init() {
+	proto.RegisterFile("provenance/attribute/v1/attribute.proto", fileDescriptor_14fe7eb43c711f5e)
+}
+
+var fileDescriptor_14fe7eb43c711f5e = []byte{
+	// 1091 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x2d, 0x59, 0x36, 0xc7, 0x7f, 0xcc, 0xda, 0x6e, 0x54, 0x25, 0x96, 0x14, 0x06, 0x6e,
+	0xd4, 0x02, 0xa5, 0x10, 0xb7, 0x45, 0x8b, 0xdc, 0xcc, 0x5a, 0x4e, 0x55, 0xa4, 0xb6, 0x40, 0x53,
+	0x41, 0x92, 0x0b, 0xb1, 0x16, 0xd7, 0x14, 0x51, 0xf1, 0x07, 0xe4, 0x4a, 0xb1, 0x8e, 0x3d, 0xf4,
+	0xe2, 0x53, 0x8e, 0xb9, 0x18, 0xed, 0xa1, 0x7d, 0x80, 0xbe, 0x45, 0x8e, 0x39, 0xf6, 0xa4, 0x16,
+	0xf6, 0xa5, 0xe8, 0xa9, 0xf0, 0x13, 0x14, 0xdc, 0x25, 0xf5, 0x67, 0x29, 0x46, 0x81, 0xb6, 0xb7,
+	0x9d, 0x99, 0x6f, 0x67, 0xbe, 0x99, 0xd9, 0x19, 0x12, 0x1e, 0xf8, 0x81, 0xd7, 0x25, 0x2e, 0x76,
+	0x9b, 0xa4, 0x82, 0x29, 0x0d, 0xec, 0xe3, 0x0e, 0x25, 0x95, 0xee, 0xc3, 0xa1, 0xa0, 0xf8, 0x81,
+	0x47, 0x3d, 0x74, 0x7b, 0x08, 0x54, 0x86, 0xb6, 0xee, 0xc3, 0xfc, 0x86, 0xe5, 0x59, 0x1e, 0xc3,
+	0x54, 0xa2, 0x13, 0x87, 0xe7, 0x0b, 0x96, 0xe7, 0x59, 0x6d, 0x52, 0x61, 0xd2, 0x71, 0xe7, 0xa4,
+	0x62, 0x76, 0x02, 0x4c, 0x6d, 0xcf, 0x8d, 0xed, 0xc5, 0x49, 0x3b, 0xb5, 0x1d, 0x12, 0x52, 0xec,
+	0xf8, 0x31, 0x60, 0x8b, 0x12, 0xd7, 0x24, 0x81, 0x63, 0xbb, 0xb4, 0xd2, 0x0c, 0x7a, 0x3e, 0xf5,
+	0x22, 0xac, 0x77, 0xc2, 0xcd, 0xf2, 0x2f, 0x02, 0x64, 0xeb, 0x38, 0xc0, 0x4e, 0x88, 0xca, 0x20,
+	0x39, 0xf8, 0xd4, 0xe8, 0xe2, 0x76, 0x87, 0x18, 0x6d, 0xe2, 0x5a, 0xb4, 0x95, 0x13, 0x4a, 0x42,
+	0x79, 0x45, 0x5b, 0x75, 0xf0, 0xe9, 0xd3, 0x48, 0xfd, 0x84, 0x69, 0xd1, 0x4b, 0xd8, 0x34, 0x49,
+	0x9b, 0x44, 0x34, 0x0c, 0x2b, 0xc0, 0x4d, 0x62, 0xf8, 0x24, 0xb0, 0x3d, 0x33, 0x37, 0x57, 0x12,
+	0xca, 0x4b, 0x3b, 0xef, 0x2b, 0x9c, 0x94, 0x92, 0x90, 0x52, 0xf6, 0x62, 0xd2, 0x6a, 0xf9, 0x4d,
+	0xbf, 0x98, 0xba, 0xea, 0x17, 0xef, 0xf6, 0xb0, 0xd3, 0x7e, 0x24, 0x4f, 0xf5, 0x22, 0xbf, 0xfe,
+	0xad, 0x28, 0x68, 0xeb, 0x89, 0xed, 0x71, 0x64, 0xaa, 0x33, 0xcb, 0xa3, 0xcc, 0xeb, 0x1f, 0x8b,
+	0x29, 0xf9, 0x27, 0x01, 0xc4, 0xdd, 0xa4, 0x74, 0x08, 0x41, 0xc6, 0xc5, 0x0e, 0x61, 0x54, 0x45,
+	0x8d, 0x9d, 0xd1, 0x06, 0xcc, 0xb3, 0x34, 0x18, 0xa1, 0x65, 0x8d, 0x0b, 0xe8, 0x1b, 0x58, 0x1d,
+	0x54, 0xdc, 0xa0, 0x3d, 0x9f, 0xe4, 0xd2, 0x25, 0xa1, 0xbc, 0xba, 0xf3, 0x81, 0x32, 0xa3, 0x27,
+	0xca, 0x20, 0x8a, 0xde, 0xf3, 0x89, 0xb6, 0x82, 0x47, 0x45, 0x94, 0x83, 0x05, 0x6c, 0x9a, 0x01,
+	0x09, 0xc3, 0x5c, 0x86, 0xc5, 0x4e, 0xc4, 0x98, 0x66, 0x17, 0xd0, 0xe0, 0xfe, 0x53, 0xdc, 0xb6,
+	0x4d, 0x4c, 0xbd, 0x60, 0x2a, 0xdd, 0x7d, 0x90, 0x9a, 0x9e, 0x4b, 0x03, 0xdc, 0xa4, 0x46, 0xe2,
+	0x32, 0x62, 0x2e, 0xaa, 0x77, 0xae, 0xfa, 0xc5, 0xdb, 0xbc, 0x56, 0x93, 0x08, 0x59, 0x5b, 0x4b,
+	0x54, 0xbb, 0x49, 0xdc, 0x3f, 0xa2, 0xb8, 0x7f, 0x09, 0x20, 0xed, 0x45, 0xc5, 0x23, 0xe6, 0xb0,
+	0x4a, 0xfb, 0x20, 0x0e, 0xd8, 0xb3, 0xd8, 0x4b, 0x3b, 0xf2, 0xcd, 0x69, 0xab, 0x99, 0xa8, 0x5f,
+	0xda, 0xf0, 0x2a, 0x7a, 0x06, 0xe0, 0x77, 0x02, 0x8b, 0x18, 0xd1, 0x3b, 0x8b, 0xfb, 0x9d, 0xbf,
+	0xd6, 0x6f, 0x3d, 0x79, 0x84, 0xea, 0x56, 0xdc, 0xf0, 0x5b, 0x3c, 0x89, 0xe1, 0x5d, 0xf9, 0x55,
+	0xd4, 0x65, 0x91, 0x29, 0x22, 0x38, 0xfa, 0x14, 0xc0, 0xe4, 0xac, 0x8d, 0xe3, 0x1e, 0xeb, 0x8c,
+	0xa8, 0x6e, 0x0e, 0x6f, 0x0e, 0x6d, 0xb2, 0x26, 0xc6, 0x82, 0xda, 0x8b, 0x53, 0xfe, 0x79, 0x0e,
+	0x56, 0x07, 0xa4, 0xeb, 0xd1, 0xf3, 0xfe, 0xd7, 0x12, 0x7e, 0x0f, 0xb2, 0x2d, 0x62, 0x5b, 0x2d,
+	0xca, 0x92, 0x4d, 0x6b, 0xb1, 0x84, 0xbe, 0x80, 0x0c, 0x2b, 0x41, 0xfa, 0xc6, 0x12, 0x2c, 0x46,
+	0x2e, 0x59, 0xb6, 0xec, 0x06, 0x52, 0x60, 0x11, 0xfb, 0xbe, 0xd1, 0xc2, 0x61, 0x8b, 0x3d, 0x9c,
+	0x65, 0x75, 0xfd, 0xaa, 0x5f, 0x5c, 0xe3, 0x69, 0x26, 0x16, 0x59, 0x5b, 0xc0, 0xbe, 0xff, 0x15,
+	0x0e, 0x5b, 0x48, 0x82, 0xf4, 0xb7, 0xa4, 0x97, 0x9b, 0x67, 0x4f, 0x39, 0x3a, 0xa2, 0xcf, 0x61,
+	0x9e, 0xcd, 0x70, 0x2e, 0xcb, 0x82, 0xdf, 0x51, 0x86, 0x33, 0xae, 0xf0, 0x19, 0x57, 0x58, 0x11,
+	0x0e, 0xfd, 0x30, 0x4e, 0x88, 0xe3, 0xe5, 0x67, 0xb0, 0x36, 0x5e, 0xa6, 0x10, 0x55, 0x21, 0xcb,
+	0x6c, 0x61, 0x4e, 0x28, 0xa5, 0xcb, 0x4b, 0x3b, 0x0f, 0x6e, 0x2e, 0x12, 0xbb, 0x19, 0x3b, 0x8e,
+	0x2f, 0xcb, 0xdf, 0x09, 0x70, 0xab, 0xda, 0x25, 0x2e, 0x1d, 0xa0, 0x76, 0x4d, 0xf3, 0xe6, 0xd9,
+	0x14, 0x93, 0xd9, 0x44, 0x90, 0x19, 0x4c, 0xa4, 0xa8, 0x65, 0x68, 0x32, 0x60, 0xcd, 0xa6, 0xd7,
+	0x71, 0xe9, 0x60, 0xc0, 0xb8, 0x18, 0xf9, 0xf0, 0x5e, 0xba, 0x24, 0x60, 0x45, 0x11, 0x35, 0x2e,
+	0xc8, 0x7f, 0x0a, 0xb0, 0x31, 0xce, 0xa1, 0xe1, 0x9b, 0x78, 0xc6, 0x8a, 0xd8, 0x86, 0x55, 0x2f,
+	0xb0, 0x2d, 0xdb, 0xc5, 0x6d, 0x63, 0x94, 0xcf, 0x4a, 0xa2, 0x65, 0x0b, 0x0f, 0xdd, 0x87, 0x81,
+	0xc2, 0x18, 0x21, 0xb8, 0x9c, 0x28, 0xd9, 0x26, 0xb8, 0x07, 0xcb, 0x1d, 0x16, 0x29, 0xf6, 0xc4,
+	0xd9, 0x2e, 0x71, 0x1d, 0xf7, 0x53, 0x84, 0x58, 0xe4, 0x5e, 0x38, 0x6f, 0xe0, 0x2a, 0x7d, 0x22,
+	0xd9, 0xec, 0x8c, 0x64, 0x17, 0x46, 0x93, 0x7d, 0x31, 0x99, 0x2b, 0x1f, 0xf9, 0xa9, 0xb9, 0x8e,
+	0xf8, 0x9e, 0x9b, 0xe1, 0x3b, 0x3d, 0xea, 0xfb, 0x07, 0x01, 0xee, 0x4e, 0x38, 0xb7, 0x43, 0x6a,
+	0xbb, 0x4d, 0xfa, 0x8e, 0x20, 0xd3, 0xfb, 0xba, 0x3d, 0x75, 0xe7, 0x8a, 0xd3, 0x76, 0xe9, 0x3f,
+	0x69, 0x35, 0x85, 0xad, 0x71, 0x82, 0x83, 0x05, 0xfb, 0x8e, 0x96, 0x7f, 0x38, 0x6b, 0xcd, 0x5e,
+	0xdb, 0xa4, 0x33, 0xea, 0xf2, 0xbd, 0x00, 0x9b, 0xe3, 0x61, 0x35, 0x12, 0x52, 0x2f, 0x20, 0xff,
+	0xf3, 0x43, 0x77, 0x60, 0x7d, 0x9c, 0x46, 0x3d, 0xda, 0xa2, 0xff, 0x15, 0x89, 0x8f, 0xfa, 0x73,
+	0xb0, 0x32, 0xf6, 0x25, 0x44, 0x15, 0xc8, 0xef, 0xea, 0xba, 0x56, 0x53, 0x1b, 0x7a, 0xd5, 0xd0,
+	0x9f, 0xd7, 0xab, 0x46, 0xe3, 0xe0, 0xa8, 0x5e, 0xfd, 0xb2, 0xb6, 0x5f, 0xab, 0xee, 0x49, 0xa9,
+	0xfc, 0xda, 0xd9, 0x79, 0x69, 0xa9, 0xe1, 0x86, 0x3e, 0x69, 0xda, 0x27, 0x36, 0x31, 0xd1, 0x3d,
+	0x58, 0x9f, 0xbc, 0xd0, 0xa8, 0xed, 0x49, 0x42, 0x7e, 0xf1, 0xec, 0xbc, 0x94, 0x89, 0xce, 0x53,
+	0x20, 0x5f, 0x1f, 0x1d, 0x1e, 0x48, 0x73, 0x1c, 0x12, 0x9d, 0xd1, 0x36, 0x6c, 0x4e, 0x40, 0x8e,
+	0x74, 0xad, 0x76, 0xf0, 0x58, 0x4a, 0xe7, 0xe1, 0xec, 0xbc, 0x94, 0x3d, 0xa2, 0x81, 0xed, 0x5a,
+	0xa8, 0x08, 0x68, 0x32, 0x98, 0x56, 0x93, 0x32, 0xf9, 0x85, 0xb3, 0xf3, 0x52, 0xba, 0x11, 0xd8,
+	0x53, 0x00, 0xb5, 0x03, 0x5d, 0x9a, 0xe7, 0x80, 0x9a, 0x4b, 0xd1, 0x7d, 0xd8, 0x98, 0x00, 0xec,
+	0x3f, 0x39, 0xdc, 0xd5, 0xa5, 0x6c, 0x5e, 0x3c, 0x3b, 0x2f, 0xcd, 0xef, 0xb7, 0x3d, 0x3c, 0x0d,
+	0x54, 0xd7, 0x0e, 0xf5, 0x43, 0x69, 0x81, 0x83, 0xea, 0xec, 0x77, 0xef, 0x3a, 0x48, 0x7d, 0xae,
+	0x57, 0x8f, 0xa4, 0x45, 0x0e, 0x52, 0x7b, 0x94, 0x84, 0xaa, 0xf3, 0xe6, 0xa2, 0x20, 0xbc, 0xbd,
+	0x28, 0x08, 0xbf, 0x5f, 0x14, 0x84, 0x57, 0x97, 0x85, 0xd4, 0xdb, 0xcb, 0x42, 0xea, 0xd7, 0xcb,
+	0x42, 0x0a, 0xf2, 0xb6, 0x37, 0x6b, 0x1f, 0xd7, 0x85, 0x17, 0x9f, 0x59, 0x36, 0x6d, 0x75, 0x8e,
+	0x95, 0xa6, 0xe7, 0x54, 0x86, 0xa8, 0x8f, 0x6d, 0x6f, 0x44, 0xaa, 0x9c, 0x8e, 0xfc, 0x8f, 0x46,
+	0x8d, 0x0e, 0x8f, 0xb3, 0xec, 0x23, 0xf5, 0xc9, 0xdf, 0x03, 0x00, 0x31, 0x9b, 0xcd, 0xfe, 0xb4,
+	0x0a, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DeletionGracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DeletionGracePeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAttribute(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxValueLength != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxValueLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Attribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
@@ -640,6 +1161,198 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttributeValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeletedAttribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletedAttribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletedAttribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeletedBy) > 0 {
+		i -= len(m.DeletedBy)
+		copy(dAtA[i:], m.DeletedBy)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.DeletedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PurgeTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PurgeTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAttribute(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Attribute.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttribute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttribute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintAttribute(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Attribute.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttribute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeProofs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeProofs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeProofs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttribute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventAttributeAdd) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -872,14 +1585,167 @@ func (m *EventAttributeDistinctDelete) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAttribute(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAttribute(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
+func (m *EventAttributeValidatorUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeValidatorUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeValidatorUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeRestore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeRestore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeRestore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributePurge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributePurge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributePurge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttribute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttribute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
 	dAtA[offset] = uint8(v)
 	return base
 }
@@ -892,6 +1758,8 @@ func (m *Params) Size() (n int) {
 	if m.MaxValueLength != 0 {
 		n += 1 + sovAttribute(uint64(m.MaxValueLength))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DeletionGracePeriod)
+	n += 1 + l + sovAttribute(uint64(l))
 	return n
 }
 
@@ -919,6 +1787,81 @@ func (m *Attribute) Size() (n int) {
 	return n
 }
 
+func (m *AttributeValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *DeletedAttribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Attribute.Size()
+	n += 1 + l + sovAttribute(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PurgeTime)
+	n += 1 + l + sovAttribute(uint64(l))
+	l = len(m.DeletedBy)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *AttributeProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Attribute.Size()
+	n += 1 + l + sovAttribute(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovAttribute(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAttribute(uint64(l))
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovAttribute(uint64(l))
+	return n
+}
+
+func (m *AttributeProofs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovAttribute(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EventAttributeAdd) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1035,21 +1978,96 @@ func (m *EventAttributeDistinctDelete) Size() (n int) {
 	return n
 }
 
-func sovAttribute(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventAttributeValidatorUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
 }
-func sozAttribute(x uint64) (n int) {
-	return sovAttribute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EventAttributeRestore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAttribute
+
+func (m *EventAttributePurge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func sovAttribute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttribute(x uint64) (n int) {
+	return sovAttribute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -1089,6 +2107,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DeletionGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttribute(dAtA[iNdEx:])
@@ -1205,11 +2256,1210 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			m.AttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletedAttribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletedAttribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletedAttribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attribute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Attribute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurgeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PurgeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attribute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Attribute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeProofs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeProofs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeProofs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, AttributeProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeAdd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeDelete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			m.AttributeType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -1219,14 +3469,27 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AttributeType |= AttributeType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1254,7 +3517,7 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1277,7 +3540,7 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1300,10 +3563,10 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeAdd: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeDistinctDelete: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeDistinctDelete: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1372,7 +3635,7 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1400,7 +3663,7 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.AttributeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -1487,7 +3750,7 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeValidatorUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1510,10 +3773,10 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeValidatorUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeValidatorUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1550,7 +3813,7 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1578,11 +3841,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalValue = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1610,75 +3873,61 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalType = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttribute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttribute
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAttribute
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttribute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttribute
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeRestore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeRestore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeRestore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1706,11 +3955,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1738,61 +3987,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAttribute(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAttribute
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeDelete: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeDelete: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1820,9 +4019,9 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -1854,7 +4053,7 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -1907,7 +4106,7 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
+func (m *EventAttributePurge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1930,10 +4129,10 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeDistinctDelete: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributePurge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeDistinctDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributePurge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2002,7 +4201,7 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2030,7 +4229,7 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttributeType = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -2064,38 +4263,6 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttribute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttribute(dAtA[iNdEx:])
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authz "github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	_ authz.Authorization = &SetAttributeAuthorization{}
+)
+
+// SetAttributeMsgs are the attribute msgs that can be granted with a SetAttributeAuthorization, by short name.
+var SetAttributeMsgs = map[string]sdk.Msg{
+	"add":             &MsgAddAttributeRequest{},
+	"update":          &MsgUpdateAttributeRequest{},
+	"delete":          &MsgDeleteAttributeRequest{},
+	"delete-distinct": &MsgDeleteDistinctAttributeRequest{},
+}
+
+// SetAttributeMsgNames are the short names of the attribute msgs that can be granted, in the order they are granted.
+var SetAttributeMsgNames = []string{"add", "update", "delete", "delete-distinct"}
+
+// NewSetAttributeAuthorization creates a new SetAttributeAuthorization object.
+func NewSetAttributeAuthorization(
+	msgTypeURL string,
+	name string,
+	allowed []sdk.AccAddress,
+	maxValueLength uint32,
+	remainingUses uint32,
+) *SetAttributeAuthorization {
+	allowedAddrs := make([]string, len(allowed))
+	for i, addr := range allowed {
+		allowedAddrs[i] = addr.String()
+	}
+	return &SetAttributeAuthorization{
+		MsgTypeUrl:      msgTypeURL,
+		Name:            name,
+		AllowedAccounts: allowedAddrs,
+		MaxValueLength:  maxValueLength,
+		RemainingUses:   remainingUses,
+	}
+}
+
+// NewSetAttributeAuthorizations creates a SetAttributeAuthorization for each of the named attribute msgs.
+func NewSetAttributeAuthorizations(
+	msgNames []string,
+	name string,
+	allowed []sdk.AccAddress,
+	maxValueLength uint32,
+	remainingUses uint32,
+) ([]authz.Authorization, error) {
+	authorizations := make([]authz.Authorization, len(msgNames))
+	for i, msgName := range msgNames {
+		msg, found := SetAttributeMsgs[msgName]
+		if !found {
+			return nil, fmt.Errorf("unknown attribute msg %s, expected one of: %s",
+				msgName, strings.Join(SetAttributeMsgNames, ", "))
+		}
+		authorizations[i] = NewSetAttributeAuthorization(sdk.MsgTypeURL(msg), name, allowed, maxValueLength, remainingUses)
+	}
+	return authorizations, nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a SetAttributeAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements Authorization.Accept.
+func (a SetAttributeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var name, account string
+	var value []byte
+	switch msg := msg.(type) {
+	case *MsgAddAttributeRequest:
+		name, account, value = msg.Name, msg.Account, msg.Value
+	case *MsgUpdateAttributeRequest:
+		name, account, value = msg.Name, msg.Account, msg.UpdateValue
+	case *MsgDeleteAttributeRequest:
+		name, account = msg.Name, msg.Account
+	case *MsgDeleteDistinctAttributeRequest:
+		name, account = msg.Name, msg.Account
+	default:
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+	if sdk.MsgTypeURL(msg) != a.MsgTypeUrl {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+	if !strings.EqualFold(strings.TrimSpace(name), a.Name) {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot change attributes named %s", name)
+	}
+	if !a.IsAllowedAccount(account) {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot change attributes of %s", account)
+	}
+	if a.MaxValueLength > 0 && len(value) > int(a.MaxValueLength) {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized,
+			"attribute value length %d is more than the allowed %d", len(value), a.MaxValueLength)
+	}
+	if a.RemainingUses == 0 {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+	updated := a
+	updated.RemainingUses--
+	return authz.AcceptResponse{Accept: true, Delete: updated.RemainingUses == 0, Updated: &updated}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a SetAttributeAuthorization) ValidateBasic() error {
+	isAttributeMsg := false
+	for _, msg := range SetAttributeMsgs {
+		if sdk.MsgTypeURL(msg) == a.MsgTypeUrl {
+			isAttributeMsg = true
+			break
+		}
+	}
+	if !isAttributeMsg {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot grant %s with a set attribute authorization", a.MsgTypeUrl)
+	}
+	if len(strings.TrimSpace(a.Name)) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "attribute name cannot be empty")
+	}
+	if a.Name != strings.ToLower(strings.TrimSpace(a.Name)) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "attribute name %s must be lower case without surrounding spaces", a.Name)
+	}
+	found := make(map[string]bool, len(a.AllowedAccounts))
+	for _, addr := range a.AllowedAccounts {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowed account %s: %s", addr, err)
+		}
+		if found[addr] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "duplicate allowed account %s", addr)
+		}
+		found[addr] = true
+	}
+	return nil
+}
+
+// IsAllowedAccount returns true if the allowed accounts list is empty or contains the address.
+func (a SetAttributeAuthorization) IsAllowedAccount(addr string) bool {
+	if len(a.AllowedAccounts) == 0 {
+		return true
+	}
+	for _, allowed := range a.AllowedAccounts {
+		if allowed == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,515 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/attribute/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SetAttributeAuthorization gives the grantee permission to add, update, or delete attributes with a name on behalf of
+// the granter, the account the name resolves to.  A grant is made for each attribute msg type allowed.
+type SetAttributeAuthorization struct {
+	// msg_type_url is the type url of the attribute msg allowed, one of MsgAddAttributeRequest,
+	// MsgUpdateAttributeRequest, MsgDeleteAttributeRequest, or MsgDeleteDistinctAttributeRequest.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// name is the attribute name the grantee can set or delete attributes with.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// allowed_accounts specifies an optional list of accounts whose attributes the grantee can change. If omitted, the
+	// attributes of any account can be changed.
+	AllowedAccounts []string `protobuf:"bytes,3,rep,name=allowed_accounts,json=allowedAccounts,proto3" json:"allowed_accounts,omitempty"`
+	// max_value_length is the optional largest attribute value, in bytes, the grantee can add or update to.
+	// If zero, the attribute module value length limit applies.
+	MaxValueLength uint32 `protobuf:"varint,4,opt,name=max_value_length,json=maxValueLength,proto3" json:"max_value_length,omitempty"`
+	// remaining_uses is the optional number of msgs the grantee can still execute. The grant is removed when it is used
+	// up. If zero, the grant can be used until it expires or is revoked.
+	RemainingUses uint32 `protobuf:"varint,5,opt,name=remaining_uses,json=remainingUses,proto3" json:"remaining_uses,omitempty"`
+}
+
+func (m *SetAttributeAuthorization) Reset()         { *m = SetAttributeAuthorization{} }
+func (m *SetAttributeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*SetAttributeAuthorization) ProtoMessage()    {}
+func (*SetAttributeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b9c47f543c445c7, []int{0}
+}
+func (m *SetAttributeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAttributeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAttributeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetAttributeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAttributeAuthorization.Merge(m, src)
+}
+func (m *SetAttributeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAttributeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAttributeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAttributeAuthorization proto.InternalMessageInfo
+
+func (m *SetAttributeAuthorization) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *SetAttributeAuthorization) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SetAttributeAuthorization) GetAllowedAccounts() []string {
+	if m != nil {
+		return m.AllowedAccounts
+	}
+	return nil
+}
+
+func (m *SetAttributeAuthorization) GetMaxValueLength() uint32 {
+	if m != nil {
+		return m.MaxValueLength
+	}
+	return 0
+}
+
+func (m *SetAttributeAuthorization) GetRemainingUses() uint32 {
+	if m != nil {
+		return m.RemainingUses
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SetAttributeAuthorization)(nil), "provenance.attribute.v1.SetAttributeAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("provenance/attribute/v1/authz.proto", fileDescriptor_5b9c47f543c445c7)
+}
+
+var fileDescriptor_5b9c47f543c445c7 = []byte{
+	// 321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x3f, 0x4f, 0xc2, 0x40,
+	0x18, 0xc6, 0x39, 0x41, 0x13, 0x2e, 0x82, 0xd8, 0xc5, 0xc2, 0xd0, 0x34, 0x1a, 0x93, 0x3a, 0xd0,
+	0x86, 0x18, 0x17, 0x37, 0x9c, 0x1d, 0x0c, 0x8a, 0x83, 0xcb, 0xe5, 0xa8, 0x6f, 0xda, 0x4b, 0x7a,
+	0x77, 0xcd, 0xfd, 0xa9, 0xc0, 0xa7, 0xf0, 0xc3, 0xf8, 0x21, 0x1c, 0x89, 0x93, 0x23, 0x81, 0x2f,
+	0x62, 0x28, 0x08, 0x38, 0xb8, 0xdd, 0xf3, 0xbb, 0xdf, 0x3b, 0xbc, 0xcf, 0x8b, 0x2f, 0x72, 0x25,
+	0x0b, 0x10, 0x54, 0xc4, 0x10, 0x51, 0x63, 0x14, 0x1b, 0x59, 0x03, 0x51, 0xd1, 0x8b, 0xa8, 0x35,
+	0xe9, 0x34, 0xcc, 0x95, 0x34, 0xd2, 0x39, 0xdb, 0x49, 0xe1, 0x56, 0x0a, 0x8b, 0x5e, 0xa7, 0x1d,
+	0x4b, 0xcd, 0xa5, 0x26, 0xa5, 0x16, 0xad, 0xc3, 0x7a, 0xe6, 0x7c, 0x8e, 0x70, 0xfb, 0x11, 0x4c,
+	0xff, 0x57, 0xef, 0x5b, 0x93, 0x4a, 0xc5, 0xa6, 0xd4, 0x30, 0x29, 0x1c, 0x1f, 0x1f, 0x73, 0x9d,
+	0x10, 0x33, 0xc9, 0x81, 0x58, 0x95, 0xb9, 0xc8, 0x47, 0x41, 0x7d, 0x80, 0xb9, 0x4e, 0x9e, 0x26,
+	0x39, 0x0c, 0x55, 0xe6, 0x38, 0xb8, 0x26, 0x28, 0x07, 0xf7, 0xa0, 0xfc, 0x29, 0xdf, 0xce, 0x15,
+	0x6e, 0xd1, 0x2c, 0x93, 0x6f, 0xf0, 0x4a, 0x68, 0x1c, 0x4b, 0x2b, 0x8c, 0x76, 0xab, 0x7e, 0x35,
+	0xa8, 0x0f, 0x4e, 0x36, 0xbc, 0xbf, 0xc1, 0x4e, 0x80, 0x5b, 0x9c, 0x8e, 0x49, 0x41, 0x33, 0x0b,
+	0x24, 0x03, 0x91, 0x98, 0xd4, 0xad, 0xf9, 0x28, 0x68, 0x0c, 0x9a, 0x9c, 0x8e, 0x9f, 0x57, 0xf8,
+	0xbe, 0xa4, 0xce, 0x25, 0x6e, 0x2a, 0xe0, 0x94, 0x09, 0x26, 0x12, 0x62, 0x35, 0x68, 0xf7, 0xb0,
+	0xf4, 0x1a, 0x5b, 0x3a, 0xd4, 0xa0, 0x6f, 0x4f, 0xbf, 0x3e, 0xba, 0x8d, 0x3f, 0x4b, 0xdc, 0xf1,
+	0xcf, 0x85, 0x87, 0x66, 0x0b, 0x0f, 0xcd, 0x17, 0x1e, 0x7a, 0x5f, 0x7a, 0x95, 0xd9, 0xd2, 0xab,
+	0x7c, 0x2f, 0xbd, 0x0a, 0xee, 0x30, 0x19, 0xfe, 0xd3, 0xd9, 0x03, 0x7a, 0xb9, 0x49, 0x98, 0x49,
+	0xed, 0x28, 0x8c, 0x25, 0x8f, 0x76, 0x56, 0x97, 0xc9, 0xbd, 0x14, 0x8d, 0xf7, 0xce, 0xb1, 0xaa,
+	0x49, 0x8f, 0x8e, 0xca, 0x62, 0xaf, 0x7f, 0x06, 0x00, 0x67, 0xdb, 0xdc, 0xa9, 0xb3, 0x01, 0x00,
+	0x00,
+}
+
+func (m *SetAttributeAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAttributeAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAttributeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingUses != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.RemainingUses))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxValueLength != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxValueLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AllowedAccounts) > 0 {
+		for iNdEx := len(m.AllowedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAccounts[iNdEx])
+			copy(dAtA[i:], m.AllowedAccounts[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SetAttributeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.AllowedAccounts) > 0 {
+		for _, s := range m.AllowedAccounts {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MaxValueLength != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxValueLength))
+	}
+	if m.RemainingUses != 0 {
+		n += 1 + sovAuthz(uint64(m.RemainingUses))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SetAttributeAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetAttributeAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetAttributeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAccounts = append(m.AllowedAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueLength", wireType)
+			}
+			m.MaxValueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValueLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingUses", wireType)
+			}
+			m.RemainingUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingUses |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
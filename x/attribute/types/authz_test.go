@@ -0,0 +1,140 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestSetAttributeAuthorizationValidateBasic(t *testing.T) {
+	account := sdk.AccAddress("account_____________")
+	addURL := sdk.MsgTypeURL(&MsgAddAttributeRequest{})
+
+	cases := []struct {
+		name   string
+		auth   *SetAttributeAuthorization
+		errMsg string
+	}{
+		{"any account", NewSetAttributeAuthorization(addURL, "kyc.pb", nil, 0, 0), ""},
+		{"allowed accounts and limits", NewSetAttributeAuthorization(addURL, "kyc.pb", []sdk.AccAddress{account}, 32, 5), ""},
+		{"delete distinct", NewSetAttributeAuthorization(sdk.MsgTypeURL(&MsgDeleteDistinctAttributeRequest{}), "kyc.pb", nil, 0, 0), ""},
+		{
+			"not an attribute set msg",
+			NewSetAttributeAuthorization(sdk.MsgTypeURL(&MsgSetAttributeValidatorRequest{}), "kyc.pb", nil, 0, 0),
+			"cannot grant /provenance.attribute.v1.MsgSetAttributeValidatorRequest with a set attribute authorization: invalid type",
+		},
+		{"empty name", NewSetAttributeAuthorization(addURL, " ", nil, 0, 0), "attribute name cannot be empty: invalid request"},
+		{
+			"unnormalized name",
+			NewSetAttributeAuthorization(addURL, "KYC.pb", nil, 0, 0),
+			"attribute name KYC.pb must be lower case without surrounding spaces: invalid request",
+		},
+		{
+			"invalid allowed account",
+			&SetAttributeAuthorization{MsgTypeUrl: addURL, Name: "kyc.pb", AllowedAccounts: []string{"bad"}},
+			"invalid allowed account bad: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			"duplicate allowed account",
+			NewSetAttributeAuthorization(addURL, "kyc.pb", []sdk.AccAddress{account, account}, 0, 0),
+			"duplicate allowed account " + account.String() + ": invalid address",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if len(tc.errMsg) > 0 {
+				require.EqualError(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSetAttributeAuthorizationAccept(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{})
+	owner := sdk.AccAddress("owner_______________")
+	account := sdk.AccAddress("account_____________")
+	other := sdk.AccAddress("other_______________")
+	addURL := sdk.MsgTypeURL(&MsgAddAttributeRequest{})
+	add := func(acc sdk.AccAddress, name string, value string) sdk.Msg {
+		return NewMsgAddAttributeRequest(acc, owner, name, AttributeType_String, []byte(value))
+	}
+
+	t.Run("unlimited", func(t *testing.T) {
+		resp, err := NewSetAttributeAuthorization(addURL, "kyc.pb", nil, 0, 0).Accept(ctx, add(other, "KYC.pb", "verified"))
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+		require.False(t, resp.Delete)
+		require.Nil(t, resp.Updated)
+	})
+
+	t.Run("uses are counted down", func(t *testing.T) {
+		auth := NewSetAttributeAuthorization(addURL, "kyc.pb", []sdk.AccAddress{account}, 0, 2)
+		resp, err := auth.Accept(ctx, add(account, "kyc.pb", "verified"))
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+		require.False(t, resp.Delete)
+		updated, ok := resp.Updated.(*SetAttributeAuthorization)
+		require.True(t, ok)
+		require.Equal(t, uint32(1), updated.RemainingUses)
+		require.Equal(t, []string{account.String()}, updated.AllowedAccounts)
+
+		resp, err = updated.Accept(ctx, add(account, "kyc.pb", "verified"))
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+		require.True(t, resp.Delete)
+	})
+
+	t.Run("update value length", func(t *testing.T) {
+		auth := NewSetAttributeAuthorization(sdk.MsgTypeURL(&MsgUpdateAttributeRequest{}), "kyc.pb", nil, 4, 0)
+		msg := NewMsgUpdateAttributeRequest(account, owner, "kyc.pb", []byte("no"), []byte("maybe"), AttributeType_String, AttributeType_String)
+		_, err := auth.Accept(ctx, msg)
+		require.EqualError(t, err, "attribute value length 5 is more than the allowed 4: unauthorized")
+		msg.UpdateValue = []byte("yes")
+		resp, err := auth.Accept(ctx, msg)
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+	})
+
+	t.Run("delete ignores value length", func(t *testing.T) {
+		auth := NewSetAttributeAuthorization(sdk.MsgTypeURL(&MsgDeleteDistinctAttributeRequest{}), "kyc.pb", nil, 1, 0)
+		resp, err := auth.Accept(ctx, NewMsgDeleteDistinctAttributeRequest(account, owner, "kyc.pb", []byte("verified")))
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+	})
+
+	t.Run("other name", func(t *testing.T) {
+		_, err := NewSetAttributeAuthorization(addURL, "kyc.pb", nil, 0, 0).Accept(ctx, add(account, "aml.pb", "verified"))
+		require.EqualError(t, err, "cannot change attributes named aml.pb: unauthorized")
+	})
+
+	t.Run("account not allowed", func(t *testing.T) {
+		auth := NewSetAttributeAuthorization(addURL, "kyc.pb", []sdk.AccAddress{account}, 0, 0)
+		_, err := auth.Accept(ctx, add(other, "kyc.pb", "verified"))
+		require.EqualError(t, err, "cannot change attributes of "+other.String()+": unauthorized")
+	})
+
+	t.Run("msg type of another grant", func(t *testing.T) {
+		_, err := NewSetAttributeAuthorization(addURL, "kyc.pb", nil, 0, 0).
+			Accept(ctx, NewMsgDeleteAttributeRequest(account, owner, "kyc.pb"))
+		require.EqualError(t, err, "type mismatch: invalid type")
+	})
+}
+
+func TestNewSetAttributeAuthorizations(t *testing.T) {
+	auths, err := NewSetAttributeAuthorizations(SetAttributeMsgNames, "kyc.pb", nil, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, auths, 4)
+	for i, auth := range auths {
+		require.Equal(t, sdk.MsgTypeURL(SetAttributeMsgs[SetAttributeMsgNames[i]]), auth.MsgTypeURL())
+		require.NoError(t, auth.ValidateBasic())
+	}
+
+	_, err = NewSetAttributeAuthorizations([]string{"add", "restore"}, "kyc.pb", nil, 0, 0)
+	require.EqualError(t, err, "unknown attribute msg restore, expected one of: add, update, delete, delete-distinct")
+}
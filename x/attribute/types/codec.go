@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // RegisterLegacyAminoCodec registers all the necessary types and interfaces for the
@@ -15,6 +16,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateAttributeRequest{}, "provenance/attribute/MsgUpdateAttributeRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteAttributeRequest{}, "provenance/attribute/MsgDeleteAttributeRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteDistinctAttributeRequest{}, "provenance/attribute/MsgDeleteDistinctAttributeRequest", nil)
+	cdc.RegisterConcrete(&MsgSetAttributeValidatorRequest{}, "provenance/attribute/MsgSetAttributeValidatorRequest", nil)
+	cdc.RegisterConcrete(&MsgRestoreAttributeRequest{}, "provenance/attribute/MsgRestoreAttributeRequest", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -23,6 +26,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateAttributeRequest{},
 		&MsgDeleteAttributeRequest{},
 		&MsgDeleteDistinctAttributeRequest{},
+		&MsgSetAttributeValidatorRequest{},
+		&MsgRestoreAttributeRequest{},
+	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&SetAttributeAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDeletedAttribute creates a new deleted attribute that can be restored until the purge time
+func NewDeletedAttribute(attr Attribute, purgeTime time.Time, deletedBy string) DeletedAttribute {
+	return DeletedAttribute{
+		Attribute: attr,
+		PurgeTime: purgeTime,
+		DeletedBy: deletedBy,
+	}
+}
+
+// ValidateBasic ensures the deleted attribute is valid and has a purge time.
+func (d DeletedAttribute) ValidateBasic() error {
+	if err := d.Attribute.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid deleted attribute: %w", err)
+	}
+	if d.PurgeTime.IsZero() {
+		return fmt.Errorf("invalid deleted attribute: missing purge time")
+	}
+	if len(d.DeletedBy) > 0 {
+		if _, err := sdk.AccAddressFromBech32(d.DeletedBy); err != nil {
+			return fmt.Errorf("invalid deleted attribute deleted by address: %w", err)
+		}
+	}
+	return nil
+}
@@ -11,6 +11,8 @@ const (
 	EventTypeAttributeDeleted string = "account_attribute_deleted"
 	// The type of event generated when a distinct account attribute is deleted.
 	EventTypeAttributeDistinctDeleted string = "account_attribute_distinct_deleted"
+	// The type of event generated when deleted account attributes are restored.
+	EventTypeAttributeRestored string = "account_attribute_restored"
 
 	AttributeKeyAttribute      string = "attribute"
 	AttributeKeyNameAttribute  string = "attribute_name"
@@ -24,6 +26,8 @@ const (
 	EventTelemetryKeyDelete string = "delete"
 	// EventTelemetryKeyDistinctDelete delete telemetry metrics key
 	EventTelemetryKeyDistinctDelete string = "distinct_delete"
+	// EventTelemetryKeyRestore restore telemetry metrics key
+	EventTelemetryKeyRestore string = "restore"
 	// EventTelemetryLabelName name telemetry metrics label
 	EventTelemetryLabelName string = "name"
 	// EventTelemetryLabelName name telemetry metrics label
@@ -76,3 +80,30 @@ func NewEventDistinctAttributeDelete(name string, value string, account string,
 		Account: account,
 	}
 }
+
+func NewEventAttributeValidatorUpdate(name string, contractAddress string, owner string) *EventAttributeValidatorUpdate {
+	return &EventAttributeValidatorUpdate{
+		Name:            name,
+		ContractAddress: contractAddress,
+		Owner:           owner,
+	}
+}
+
+func NewEventAttributeRestore(attribute Attribute, owner string) *EventAttributeRestore {
+	return &EventAttributeRestore{
+		Name:    attribute.Name,
+		Value:   base64.StdEncoding.EncodeToString(attribute.GetValue()),
+		Type:    attribute.AttributeType.String(),
+		Account: attribute.Address,
+		Owner:   owner,
+	}
+}
+
+func NewEventAttributePurge(attribute Attribute) *EventAttributePurge {
+	return &EventAttributePurge{
+		Name:    attribute.Name,
+		Value:   base64.StdEncoding.EncodeToString(attribute.GetValue()),
+		Type:    attribute.AttributeType.String(),
+		Account: attribute.Address,
+	}
+}
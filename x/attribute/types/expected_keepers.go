@@ -18,4 +18,11 @@ type NameKeeper interface {
 	Normalize(ctx sdk.Context, name string) (string, error)
 	GetRecordByName(ctx sdk.Context, name string) (record *nametypes.NameRecord, err error)
 	NameExists(ctx sdk.Context, name string) bool
+	BindNameIfMissing(ctx sdk.Context, name string, owner sdk.AccAddress) error
+}
+
+// WasmKeeper defines the expected wasm keeper used to query attribute validator smart contracts (noalias)
+type WasmKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+	HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool
 }
@@ -15,13 +15,25 @@ func (state GenesisState) ValidateBasic() error {
 			return err
 		}
 	}
+	for _, v := range state.Validators {
+		if err := v.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, d := range state.DeletedAttributes {
+		if err := d.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // DefaultGenesisState returns the default module state at genesis.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:     DefaultParams(),
-		Attributes: []Attribute{},
+		Params:            DefaultParams(),
+		Attributes:        []Attribute{},
+		Validators:        []AttributeValidator{},
+		DeletedAttributes: []DeletedAttribute{},
 	}
 }
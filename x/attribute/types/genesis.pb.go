@@ -29,6 +29,10 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// deposits defines all the deposits present at genesis.
 	Attributes []Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes"`
+	// validators defines the smart contracts registered to validate attribute values.
+	Validators []AttributeValidator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
+	// deleted_attributes defines the deleted attributes that can still be restored.
+	DeletedAttributes []DeletedAttribute `protobuf:"bytes,4,rep,name=deleted_attributes,json=deletedAttributes,proto3" json:"deleted_attributes" yaml:"deleted_attributes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -73,23 +77,28 @@ func init() {
 }
 
 var fileDescriptor_7690f9b78d391c2d = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0x4f, 0x2c, 0x29, 0x29, 0xca, 0x4c, 0x2a, 0x2d, 0x49,
-	0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x47, 0x28, 0xd3, 0x83, 0x2b, 0xd3, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x71, 0x99, 0x8a, 0xd0, 0x0b, 0x56,
-	0xa8, 0xb4, 0x90, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x53, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x2d,
-	0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xbc,
-	0x1e, 0x0e, 0x9b, 0xf5, 0x02, 0xc0, 0xca, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x6a,
-	0x12, 0xf2, 0xe0, 0xe2, 0x82, 0x2b, 0x2a, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc2,
-	0x69, 0x84, 0x23, 0x8c, 0x03, 0x35, 0x05, 0x49, 0xaf, 0x15, 0x47, 0xc7, 0x02, 0x79, 0x86, 0x17,
-	0x0b, 0xe4, 0x19, 0x9c, 0x72, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x81, 0x4b,
-	0x2a, 0x33, 0x1f, 0x97, 0xd9, 0x01, 0x8c, 0x51, 0xa6, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x08, 0x55, 0xba, 0x99, 0xf9, 0x48, 0x3c, 0xfd, 0x0a, 0xa4, 0xf0, 0x29,
-	0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x87, 0x8c, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x2c,
-	0xfc, 0x9c, 0x6e, 0x9a, 0x01, 0x00, 0x00,
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0x02, 0x41,
+	0x18, 0x80, 0x77, 0x54, 0x24, 0xc6, 0x2e, 0x0d, 0x41, 0xe6, 0x61, 0xd6, 0x16, 0x22, 0x23, 0x9a,
+	0x41, 0xa3, 0x8b, 0xd0, 0x21, 0x09, 0xea, 0x68, 0x06, 0x1d, 0xba, 0xc4, 0xe8, 0x0e, 0xdb, 0x80,
+	0xeb, 0x2c, 0x3b, 0xe3, 0x92, 0xf4, 0x02, 0x1d, 0x7b, 0x04, 0x1f, 0xc7, 0xa3, 0xc7, 0x4e, 0x12,
+	0x7a, 0xe9, 0x5a, 0x4f, 0x10, 0x8e, 0xae, 0xbb, 0x14, 0x4b, 0xb7, 0x19, 0xf8, 0xbe, 0xef, 0xff,
+	0xe1, 0x87, 0x87, 0x41, 0x28, 0x23, 0x3e, 0x60, 0x83, 0x1e, 0xa7, 0x4c, 0xeb, 0x50, 0x74, 0x87,
+	0x9a, 0xd3, 0xa8, 0x4e, 0x3d, 0x3e, 0xe0, 0x4a, 0x28, 0x12, 0x84, 0x52, 0x4b, 0xb4, 0x97, 0x60,
+	0x64, 0x83, 0x91, 0xa8, 0x5e, 0xd9, 0xf5, 0xa4, 0x27, 0x0d, 0x43, 0x97, 0xaf, 0x15, 0x5e, 0x39,
+	0xca, 0xaa, 0x26, 0xae, 0x01, 0x9d, 0xaf, 0x1c, 0xdc, 0xbe, 0x5e, 0x4d, 0xba, 0xd3, 0x4c, 0x73,
+	0x74, 0x01, 0x8b, 0x01, 0x0b, 0x99, 0xaf, 0xca, 0xa0, 0x0a, 0x6a, 0xa5, 0x86, 0x4d, 0x32, 0x26,
+	0x93, 0xb6, 0xc1, 0x5a, 0x85, 0xc9, 0xcc, 0xb6, 0x3a, 0x6b, 0x09, 0xdd, 0x40, 0xb8, 0x81, 0x54,
+	0x39, 0x57, 0xcd, 0xd7, 0x4a, 0x0d, 0x27, 0x33, 0x71, 0x19, 0x7f, 0xd6, 0x95, 0x94, 0x8b, 0x6e,
+	0x21, 0x8c, 0x58, 0x5f, 0xb8, 0x4c, 0xcb, 0x50, 0x95, 0xf3, 0xa6, 0x74, 0xf2, 0x7f, 0xe9, 0x3e,
+	0x76, 0xe2, 0x64, 0x12, 0x41, 0x2f, 0x10, 0xb9, 0xbc, 0xcf, 0x35, 0x77, 0x1f, 0x53, 0x4b, 0x16,
+	0x4c, 0xfa, 0x38, 0x33, 0x7d, 0xb5, 0x52, 0x92, 0x5d, 0x0f, 0x96, 0xe1, 0xef, 0x99, 0xbd, 0x3f,
+	0x62, 0x7e, 0xbf, 0xe9, 0xfc, 0x4d, 0x3a, 0x9d, 0x1d, 0xf7, 0x97, 0xa4, 0x9a, 0x5b, 0xaf, 0x63,
+	0xdb, 0xfa, 0x1c, 0xdb, 0x56, 0xcb, 0x9f, 0xcc, 0x31, 0x98, 0xce, 0x31, 0xf8, 0x98, 0x63, 0xf0,
+	0xb6, 0xc0, 0xd6, 0x74, 0x81, 0xad, 0xf7, 0x05, 0xb6, 0x60, 0x45, 0xc8, 0xac, 0x35, 0xda, 0xe0,
+	0xe1, 0xdc, 0x13, 0xfa, 0x69, 0xd8, 0x25, 0x3d, 0xe9, 0xd3, 0x84, 0x3a, 0x15, 0x32, 0xf5, 0xa3,
+	0xcf, 0xa9, 0x7b, 0xeb, 0x51, 0xc0, 0x55, 0xb7, 0x68, 0x2e, 0x7d, 0xf6, 0x33, 0x00, 0x17, 0xc5,
+	0x79, 0xc8, 0x6a, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +121,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeletedAttributes) > 0 {
+		for iNdEx := len(m.DeletedAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeletedAttributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Attributes) > 0 {
 		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -164,6 +201,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeletedAttributes) > 0 {
+		for _, e := range m.DeletedAttributes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -269,6 +318,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, AttributeValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAttributes = append(m.DeletedAttributes, DeletedAttribute{})
+			if err := m.DeletedAttributes[len(m.DeletedAttributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,9 +1,11 @@
 package types
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -27,8 +29,21 @@ var (
 	// Legacy amino encoded objects use this key prefix
 	AttributeKeyPrefixAmino = []byte{0x00}
 	AttributeKeyPrefix      = []byte{0x02}
+	// AttributeValidatorKeyPrefix is the key for the smart contract registered to validate values of an attribute name
+	AttributeValidatorKeyPrefix = []byte{0x03}
+	// AttributeAddrLookupKeyPrefix is the key for looking up the accounts that have attributes with a name
+	AttributeAddrLookupKeyPrefix = []byte{0x04}
+	// DeletedAttributeKeyPrefix is the key for attributes that have been deleted but can still be restored
+	DeletedAttributeKeyPrefix = []byte{0x05}
+	// DeletedAttributeQueueKeyPrefix is the key for the queue of deleted attributes ordered by purge time
+	DeletedAttributeQueueKeyPrefix = []byte{0x06}
 )
 
+// AttributeValidatorKey creates a key for the validator of an attribute name
+func AttributeValidatorKey(name string) []byte {
+	return append(AttributeValidatorKeyPrefix, GetNameKeyBytes(name)...)
+}
+
 // AccountAttributeKey creates a key for an account attribute
 func AccountAttributeKey(acc sdk.AccAddress, attr Attribute) []byte {
 	key := append(AttributeKeyPrefix, address.MustLengthPrefix(acc.Bytes())...)
@@ -47,6 +62,58 @@ func AccountAttributesNameKeyPrefix(acc sdk.AccAddress, attributeName string) []
 	return append(key, GetNameKeyBytes(attributeName)...)
 }
 
+// DeletedAttributeKey creates a key for a deleted account attribute
+func DeletedAttributeKey(acc sdk.AccAddress, attr Attribute) []byte {
+	return append(DeletedAttributeKeyPrefix, AccountAttributeKey(acc, attr)[len(AttributeKeyPrefix):]...)
+}
+
+// DeletedAttributesNameKeyPrefix returns a prefix key for all deleted attributes with a given name on an account
+func DeletedAttributesNameKeyPrefix(acc sdk.AccAddress, attributeName string) []byte {
+	return append(DeletedAttributeKeyPrefix, AccountAttributesNameKeyPrefix(acc, attributeName)[len(AttributeKeyPrefix):]...)
+}
+
+// DeletedAttributeQueueKey creates a key for the queue entry of a deleted account attribute purged at purgeTime
+func DeletedAttributeQueueKey(purgeTime time.Time, acc sdk.AccAddress, attr Attribute) []byte {
+	return append(DeletedAttributeQueueTimeKeyPrefix(purgeTime), DeletedAttributeKey(acc, attr)[len(DeletedAttributeKeyPrefix):]...)
+}
+
+// DeletedAttributeQueueTimeKeyPrefix returns a prefix key for the queue entries of deleted attributes purged at purgeTime
+func DeletedAttributeQueueTimeKeyPrefix(purgeTime time.Time) []byte {
+	return append(DeletedAttributeQueueKeyPrefix, sdk.FormatTimeBytes(purgeTime)...)
+}
+
+// GetDeletedAttributeKeyFromQueueKey returns the deleted attribute key from a key created by DeletedAttributeQueueKey
+func GetDeletedAttributeKeyFromQueueKey(key []byte) ([]byte, error) {
+	prefixLen := len(DeletedAttributeQueueKeyPrefix) + len(sdk.FormatTimeBytes(time.Time{}))
+	if len(key) <= prefixLen || !bytes.Equal(key[:len(DeletedAttributeQueueKeyPrefix)], DeletedAttributeQueueKeyPrefix) {
+		return nil, fmt.Errorf("invalid deleted attribute queue key %X", key)
+	}
+	return append(DeletedAttributeKeyPrefix, key[prefixLen:]...), nil
+}
+
+// AttributeNameAddrKey creates a key for looking up an account that has attributes with a name
+func AttributeNameAddrKey(name string, acc sdk.AccAddress) []byte {
+	return append(AttributeNameKeyPrefix(name), address.MustLengthPrefix(acc.Bytes())...)
+}
+
+// AttributeNameKeyPrefix returns a prefix key for looking up all accounts that have attributes with a name
+func AttributeNameKeyPrefix(name string) []byte {
+	return append(AttributeAddrLookupKeyPrefix, GetNameKeyBytes(name)...)
+}
+
+// GetAddressFromNameAddrKey returns the account address from a key created by AttributeNameAddrKey, with or without
+// the name prefix.
+func GetAddressFromNameAddrKey(key []byte) (sdk.AccAddress, error) {
+	prefixLen := len(AttributeAddrLookupKeyPrefix) + sha256.Size
+	if len(key) > prefixLen && bytes.Equal(key[:len(AttributeAddrLookupKeyPrefix)], AttributeAddrLookupKeyPrefix) {
+		key = key[prefixLen:]
+	}
+	if len(key) == 0 || int(key[0]) != len(key)-1 {
+		return nil, fmt.Errorf("invalid attribute name address lookup key %X", key)
+	}
+	return key[1:], nil
+}
+
 // GetNameKeyBytes returns a set of bytes that uniquely identifies the given name
 func GetNameKeyBytes(name string) []byte {
 	attrName := strings.ToLower(strings.TrimSpace(name))
@@ -1,9 +1,12 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestAttributeNameProcessing(t *testing.T) {
@@ -12,3 +15,22 @@ func TestAttributeNameProcessing(t *testing.T) {
 	require.Equal(t, "root", reverse("root"), "a root name reversed is a root name")
 	require.Equal(t, "root.domain.sub", reverse("sub.domain.root"), "a domain name can be reversed correctly")
 }
+
+func TestAttributeNameAddrKey(t *testing.T) {
+	acc := sdk.AccAddress("attribute_address___")
+	key := AttributeNameAddrKey("Example.Attribute", acc)
+	require.Equal(t, AttributeAddrLookupKeyPrefix, key[0:1], "key prefix")
+	require.Equal(t, GetNameKeyBytes("example.attribute"), key[1:33], "name is normalized and hashed")
+	require.Equal(t, AttributeNameKeyPrefix("example.attribute"), key[:33], "name key prefix")
+
+	addr, err := GetAddressFromNameAddrKey(key)
+	require.NoError(t, err)
+	require.Equal(t, acc, addr, "address from full key")
+
+	addr, err = GetAddressFromNameAddrKey(key[33:])
+	require.NoError(t, err)
+	require.Equal(t, acc, addr, "address from key without the name prefix")
+
+	_, err = GetAddressFromNameAddrKey(key[33 : len(key)-1])
+	require.EqualError(t, err, fmt.Sprintf("invalid attribute name address lookup key %X", key[33:len(key)-1]))
+}
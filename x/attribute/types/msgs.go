@@ -13,6 +13,8 @@ const (
 	TypeMsgUpdateAttribute         = "update_attribute"
 	TypeMsgDeleteAttribute         = "delete_attribute"
 	TypeMsgDeleteDistinctAttribute = "delete_distinct_attribute"
+	TypeMsgSetAttributeValidator   = "set_attribute_validator"
+	TypeMsgRestoreAttribute        = "restore_attribute"
 )
 
 // Compile time interface checks.
@@ -21,6 +23,8 @@ var (
 	_ sdk.Msg = &MsgUpdateAttributeRequest{}
 	_ sdk.Msg = &MsgDeleteAttributeRequest{}
 	_ sdk.Msg = &MsgDeleteDistinctAttributeRequest{}
+	_ sdk.Msg = &MsgSetAttributeValidatorRequest{}
+	_ sdk.Msg = &MsgRestoreAttributeRequest{}
 )
 
 // NewMsgAddAttributeRequest creates a new add attribute message
@@ -252,3 +256,119 @@ func (msg MsgDeleteDistinctAttributeRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgSetAttributeValidatorRequest registers a smart contract to validate values of an attribute name
+func NewMsgSetAttributeValidatorRequest(name string, contractAddress string, owner sdk.AccAddress) *MsgSetAttributeValidatorRequest { // nolint:interfacer
+	return &MsgSetAttributeValidatorRequest{
+		Name:            strings.ToLower(strings.TrimSpace(name)),
+		ContractAddress: contractAddress,
+		Owner:           owner.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetAttributeValidatorRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the message action.
+func (msg MsgSetAttributeValidatorRequest) Type() string { return TypeMsgSetAttributeValidator }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetAttributeValidatorRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("empty name")
+	}
+	if len(msg.ContractAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+			return fmt.Errorf("invalid contract address: %w", err)
+		}
+	}
+	if len(msg.Owner) == 0 {
+		return fmt.Errorf("empty owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	return nil
+}
+
+// String implements stringer interface
+func (msg MsgSetAttributeValidatorRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetAttributeValidatorRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the name owner.
+func (msg MsgSetAttributeValidatorRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(fmt.Errorf("invalid owner value on message: %w", err))
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRestoreAttributeRequest restores deleted attributes with a name, and the value if not empty, to an account
+func NewMsgRestoreAttributeRequest(account sdk.AccAddress, owner sdk.AccAddress, name string, value []byte) *MsgRestoreAttributeRequest { // nolint:interfacer
+	return &MsgRestoreAttributeRequest{
+		Account: account.String(),
+		Name:    strings.ToLower(strings.TrimSpace(name)),
+		Owner:   owner.String(),
+		Value:   value,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgRestoreAttributeRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the message action.
+func (msg MsgRestoreAttributeRequest) Type() string { return TypeMsgRestoreAttribute }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRestoreAttributeRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("empty name")
+	}
+	if len(msg.Account) == 0 {
+		return fmt.Errorf("empty account address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return err
+	}
+	if len(msg.Owner) == 0 {
+		return fmt.Errorf("empty owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	return nil
+}
+
+// String implements stringer interface
+func (msg MsgRestoreAttributeRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRestoreAttributeRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the name owner.
+func (msg MsgRestoreAttributeRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(fmt.Errorf("invalid owner value on message: %w", err))
+	}
+	return []sdk.AccAddress{addr}
+}
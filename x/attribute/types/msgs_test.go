@@ -103,3 +103,29 @@ func TestMsgDeleteDistinctAttribute(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for TestMsgRestoreAttribute
+func TestMsgRestoreAttribute(t *testing.T) {
+	tests := []struct {
+		account, owner sdk.AccAddress
+		name           string
+		value          []byte
+		expectPass     bool
+	}{
+		{addrs[0], addrs[1], "example", []byte("original"), true},
+		{addrs[0], addrs[1], "example", nil, true},
+		{addrs[0], addrs[1], " ", nil, false},
+		{nil, addrs[1], "example", []byte("original"), false},
+		{addrs[0], nil, "example", nil, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRestoreAttributeRequest(tc.account, tc.owner, tc.name, tc.value)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc)
+		}
+	}
+}
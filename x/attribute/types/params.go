@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
@@ -10,11 +11,14 @@ import (
 // Default parameter namespace
 const (
 	DefaultMaxValueLength = 10000
+	// DefaultDeletionGracePeriod of zero deletes attributes immediately
+	DefaultDeletionGracePeriod time.Duration = 0
 )
 
 // Parameter store keys
 var (
-	ParamStoreKeyMaxValueLength = []byte("MaxValueLength")
+	ParamStoreKeyMaxValueLength      = []byte("MaxValueLength")
+	ParamStoreKeyDeletionGracePeriod = []byte("DeletionGracePeriod")
 )
 
 // String implements stringer interface
@@ -31,9 +35,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams create a new Params object
 func NewParams(
 	maxValueLength uint32,
+	deletionGracePeriod time.Duration,
 ) Params {
 	return Params{
-		MaxValueLength: maxValueLength,
+		MaxValueLength:      maxValueLength,
+		DeletionGracePeriod: deletionGracePeriod,
 	}
 }
 
@@ -41,6 +47,7 @@ func NewParams(
 func (params *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxValueLength, &params.MaxValueLength, validateMaxValueLength),
+		paramtypes.NewParamSetPair(ParamStoreKeyDeletionGracePeriod, &params.DeletionGracePeriod, validateDeletionGracePeriod),
 	}
 }
 
@@ -48,6 +55,7 @@ func (params *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func DefaultParams() Params {
 	return NewParams(
 		DefaultMaxValueLength,
+		DefaultDeletionGracePeriod,
 	)
 }
 
@@ -59,3 +67,15 @@ func validateMaxValueLength(i interface{}) error {
 
 	return nil
 }
+
+func validateDeletionGracePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("deletion grace period must not be negative: %s", v)
+	}
+
+	return nil
+}
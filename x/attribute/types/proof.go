@@ -0,0 +1,38 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AttributeProofKeyPath returns the merkle key path of an attribute store key within the app state.
+func AttributeProofKeyPath(key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(StoreKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+}
+
+// Verify checks that the proof shows the attribute stored under its key in the state with the app hash.
+func (p AttributeProof) Verify() error {
+	acc, err := sdk.AccAddressFromBech32(p.Attribute.Address)
+	if err != nil {
+		return fmt.Errorf("invalid attribute address: %w", err)
+	}
+	if !bytes.Equal(p.Key, AccountAttributeKey(acc, p.Attribute)) {
+		return fmt.Errorf("key %X does not match attribute %s on %s", p.Key, p.Attribute.Name, p.Attribute.Address)
+	}
+	value, err := p.Attribute.Marshal()
+	if err != nil {
+		return err
+	}
+	if err = rootmulti.DefaultProofRuntime().VerifyValue(&p.Proof, p.AppHash, AttributeProofKeyPath(p.Key), value); err != nil {
+		return fmt.Errorf("invalid attribute proof: %w", err)
+	}
+	return nil
+}
@@ -121,6 +121,8 @@ type QueryAttributeRequest struct {
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// attribute_type is an optional filter limiting results to attributes of this type.
+	AttributeType AttributeType `protobuf:"varint,4,opt,name=attribute_type,json=attributeType,proto3,enum=provenance.attribute.v1.AttributeType" json:"attribute_type,omitempty" yaml:"attribute_type"`
 }
 
 func (m *QueryAttributeRequest) Reset()         { *m = QueryAttributeRequest{} }
@@ -226,6 +228,10 @@ type QueryAttributesRequest struct {
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// name_prefix is an optional filter limiting results to attributes with names starting with this prefix.
+	NamePrefix string `protobuf:"bytes,3,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty" yaml:"name_prefix"`
+	// attribute_type is an optional filter limiting results to attributes of this type.
+	AttributeType AttributeType `protobuf:"varint,4,opt,name=attribute_type,json=attributeType,proto3,enum=provenance.attribute.v1.AttributeType" json:"attribute_type,omitempty" yaml:"attribute_type"`
 }
 
 func (m *QueryAttributesRequest) Reset()         { *m = QueryAttributesRequest{} }
@@ -333,6 +339,8 @@ type QueryScanRequest struct {
 	Suffix string `protobuf:"bytes,2,opt,name=suffix,proto3" json:"suffix,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// attribute_type is an optional filter limiting results to attributes of this type.
+	AttributeType AttributeType `protobuf:"varint,4,opt,name=attribute_type,json=attributeType,proto3,enum=provenance.attribute.v1.AttributeType" json:"attribute_type,omitempty" yaml:"attribute_type"`
 }
 
 func (m *QueryScanRequest) Reset()         { *m = QueryScanRequest{} }
@@ -432,6 +440,208 @@ func (m *QueryScanResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAttributeAccountsByNameRequest is the request type for the Query/AttributeAccountsByName method.
+type QueryAttributeAccountsByNameRequest struct {
+	// name is the attribute name to query for
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttributeAccountsByNameRequest) Reset()         { *m = QueryAttributeAccountsByNameRequest{} }
+func (m *QueryAttributeAccountsByNameRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeAccountsByNameRequest) ProtoMessage()    {}
+func (*QueryAttributeAccountsByNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{8}
+}
+func (m *QueryAttributeAccountsByNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeAccountsByNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeAccountsByNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeAccountsByNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeAccountsByNameRequest.Merge(m, src)
+}
+func (m *QueryAttributeAccountsByNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeAccountsByNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeAccountsByNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeAccountsByNameRequest proto.InternalMessageInfo
+
+func (m *QueryAttributeAccountsByNameRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryAttributeAccountsByNameRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAttributeAccountsByNameResponse is the response type for the Query/AttributeAccountsByName method.
+type QueryAttributeAccountsByNameResponse struct {
+	// accounts are the addresses of the accounts that have attributes with the name
+	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttributeAccountsByNameResponse) Reset()         { *m = QueryAttributeAccountsByNameResponse{} }
+func (m *QueryAttributeAccountsByNameResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeAccountsByNameResponse) ProtoMessage()    {}
+func (*QueryAttributeAccountsByNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{9}
+}
+func (m *QueryAttributeAccountsByNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeAccountsByNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeAccountsByNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeAccountsByNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeAccountsByNameResponse.Merge(m, src)
+}
+func (m *QueryAttributeAccountsByNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeAccountsByNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeAccountsByNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeAccountsByNameResponse proto.InternalMessageInfo
+
+func (m *QueryAttributeAccountsByNameResponse) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryAttributeAccountsByNameResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAttributeValidatorRequest is the request type for the Query/AttributeValidator method.
+type QueryAttributeValidatorRequest struct {
+	// name is the attribute name to query for
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryAttributeValidatorRequest) Reset()         { *m = QueryAttributeValidatorRequest{} }
+func (m *QueryAttributeValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeValidatorRequest) ProtoMessage()    {}
+func (*QueryAttributeValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{10}
+}
+func (m *QueryAttributeValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeValidatorRequest.Merge(m, src)
+}
+func (m *QueryAttributeValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeValidatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeValidatorRequest proto.InternalMessageInfo
+
+func (m *QueryAttributeValidatorRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryAttributeValidatorResponse is the response type for the Query/AttributeValidator method.
+type QueryAttributeValidatorResponse struct {
+	// validator is the smart contract registered for the attribute name
+	Validator AttributeValidator `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator"`
+}
+
+func (m *QueryAttributeValidatorResponse) Reset()         { *m = QueryAttributeValidatorResponse{} }
+func (m *QueryAttributeValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeValidatorResponse) ProtoMessage()    {}
+func (*QueryAttributeValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{11}
+}
+func (m *QueryAttributeValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeValidatorResponse.Merge(m, src)
+}
+func (m *QueryAttributeValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeValidatorResponse proto.InternalMessageInfo
+
+func (m *QueryAttributeValidatorResponse) GetValidator() AttributeValidator {
+	if m != nil {
+		return m.Validator
+	}
+	return AttributeValidator{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.attribute.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.attribute.v1.QueryParamsResponse")
@@ -441,6 +651,10 @@ func init() {
 	proto.RegisterType((*QueryAttributesResponse)(nil), "provenance.attribute.v1.QueryAttributesResponse")
 	proto.RegisterType((*QueryScanRequest)(nil), "provenance.attribute.v1.QueryScanRequest")
 	proto.RegisterType((*QueryScanResponse)(nil), "provenance.attribute.v1.QueryScanResponse")
+	proto.RegisterType((*QueryAttributeAccountsByNameRequest)(nil), "provenance.attribute.v1.QueryAttributeAccountsByNameRequest")
+	proto.RegisterType((*QueryAttributeAccountsByNameResponse)(nil), "provenance.attribute.v1.QueryAttributeAccountsByNameResponse")
+	proto.RegisterType((*QueryAttributeValidatorRequest)(nil), "provenance.attribute.v1.QueryAttributeValidatorRequest")
+	proto.RegisterType((*QueryAttributeValidatorResponse)(nil), "provenance.attribute.v1.QueryAttributeValidatorResponse")
 }
 
 func init() {
@@ -448,46 +662,61 @@ func init() {
 }
 
 var fileDescriptor_79f9aff39a1796c1 = []byte{
-	// 620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x7d, 0x69, 0x9a, 0xdf, 0xaf, 0x4f, 0x17, 0x38, 0x4a, 0x1b, 0x59, 0xc8, 0x29, 0x46,
-	0x22, 0xa5, 0x50, 0x1f, 0x49, 0x95, 0xa5, 0xc0, 0x40, 0x06, 0x60, 0x0c, 0x81, 0x89, 0xed, 0x62,
-	0x5d, 0x8d, 0x25, 0xe2, 0x73, 0x73, 0xe7, 0xa8, 0x55, 0x94, 0x05, 0x31, 0x30, 0x20, 0x81, 0x84,
-	0x04, 0x6b, 0x59, 0x90, 0x78, 0x17, 0x2c, 0xa0, 0x8e, 0x95, 0x58, 0x98, 0x10, 0x4a, 0x18, 0x78,
-	0x19, 0x28, 0xe7, 0x4b, 0xe2, 0x36, 0xb8, 0x09, 0x12, 0x0c, 0xdd, 0xce, 0x97, 0xe7, 0xcf, 0xe7,
-	0xfb, 0xbd, 0xbb, 0x27, 0x70, 0x29, 0x6c, 0xf1, 0x36, 0x0b, 0x68, 0xe0, 0x32, 0x42, 0xa5, 0x6c,
-	0xf9, 0x8d, 0x48, 0x32, 0xd2, 0x2e, 0x91, 0x9d, 0x88, 0xb5, 0xf6, 0x9c, 0xb0, 0xc5, 0x25, 0xc7,
-	0x2b, 0xe3, 0x20, 0x67, 0x14, 0xe4, 0xb4, 0x4b, 0xe6, 0xba, 0xcb, 0x45, 0x93, 0x0b, 0xd2, 0xa0,
-	0x82, 0xc5, 0x19, 0xa4, 0x5d, 0x6a, 0x30, 0x49, 0x4b, 0x24, 0xa4, 0x9e, 0x1f, 0x50, 0xe9, 0xf3,
-	0x20, 0x2e, 0x62, 0x2e, 0x79, 0xdc, 0xe3, 0x6a, 0x49, 0x06, 0x2b, 0xbd, 0x7b, 0xc1, 0xe3, 0xdc,
-	0x7b, 0xc2, 0x08, 0x0d, 0x7d, 0x42, 0x83, 0x80, 0x4b, 0x95, 0x22, 0xf4, 0xaf, 0xc5, 0x34, 0xba,
-	0x31, 0x85, 0x0a, 0xb4, 0x97, 0x00, 0xdf, 0x1f, 0xb4, 0xaf, 0xd1, 0x16, 0x6d, 0x8a, 0x3a, 0xdb,
-	0x89, 0x98, 0x90, 0xf6, 0x43, 0x38, 0x77, 0x64, 0x57, 0x84, 0x3c, 0x10, 0x0c, 0xdf, 0x82, 0x5c,
-	0xa8, 0x76, 0xf2, 0x68, 0x15, 0xad, 0x2d, 0x96, 0x0b, 0x4e, 0x8a, 0x3e, 0x27, 0x4e, 0xac, 0x66,
-	0x0f, 0xbe, 0x15, 0x8c, 0xba, 0x4e, 0xb2, 0xdf, 0x22, 0x38, 0xaf, 0xca, 0xde, 0x1e, 0x86, 0xea,
-	0x7e, 0x38, 0x0f, 0xff, 0x51, 0xd7, 0xe5, 0x51, 0x20, 0x55, 0xe5, 0x85, 0xfa, 0xf0, 0x13, 0x63,
-	0xc8, 0x06, 0xb4, 0xc9, 0xf2, 0x19, 0xb5, 0xad, 0xd6, 0xf8, 0x0e, 0xc0, 0xd8, 0xa4, 0xfc, 0x9c,
-	0x42, 0xb9, 0xec, 0xc4, 0x8e, 0x3a, 0x03, 0x47, 0x9d, 0xf8, 0x0c, 0xb4, 0xa3, 0x4e, 0x8d, 0x7a,
-	0xc3, 0x4e, 0xf5, 0x44, 0xe6, 0xd6, 0xff, 0xcf, 0xf7, 0x0b, 0xc6, 0xcf, 0xfd, 0x82, 0x61, 0x7f,
-	0x42, 0xb0, 0x7c, 0x9c, 0x4c, 0x6b, 0x4e, 0x47, 0xbb, 0x07, 0x30, 0xd2, 0x2c, 0xf2, 0x99, 0xd5,
-	0xb9, 0xb5, 0xc5, 0xb2, 0x9d, 0xea, 0xc8, 0xa8, 0xb2, 0x36, 0x25, 0x91, 0x8b, 0xef, 0xfe, 0x46,
-	0x50, 0x71, 0xaa, 0xa0, 0x18, 0x30, 0xa9, 0xc8, 0x7e, 0x36, 0xa1, 0x43, 0x4c, 0xb7, 0xf8, 0xa8,
-	0x9d, 0x99, 0xbf, 0x60, 0xe7, 0x67, 0x04, 0x2b, 0x13, 0x18, 0xa7, 0xd1, 0xcf, 0x37, 0x08, 0xce,
-	0x28, 0x21, 0x0f, 0x5c, 0x1a, 0x4c, 0x77, 0x72, 0x19, 0x72, 0x22, 0xda, 0xde, 0xf6, 0x77, 0xf5,
-	0x75, 0xd5, 0x5f, 0xff, 0xe0, 0xc2, 0x7e, 0x44, 0x70, 0x36, 0x01, 0x76, 0x0a, 0xbd, 0x2d, 0xbf,
-	0x9c, 0x87, 0x79, 0x25, 0x01, 0xbf, 0x40, 0x90, 0x8b, 0x07, 0x06, 0xbe, 0x9a, 0xca, 0x34, 0x39,
-	0xa5, 0xcc, 0x6b, 0xb3, 0x05, 0xc7, 0xbd, 0xed, 0xe2, 0xd3, 0x2f, 0x3f, 0x5e, 0x67, 0x2e, 0xe2,
-	0x02, 0x49, 0x9b, 0x8d, 0xf1, 0x98, 0xc2, 0x1f, 0x10, 0x2c, 0x8c, 0x1c, 0xc0, 0xce, 0xc9, 0x4d,
-	0x8e, 0x8f, 0x32, 0x93, 0xcc, 0x1c, 0xaf, 0xb9, 0x6e, 0x28, 0xae, 0x0a, 0xde, 0x24, 0x53, 0x67,
-	0x36, 0xe9, 0xe8, 0xf3, 0xec, 0x92, 0xce, 0x60, 0x12, 0x76, 0xf1, 0x7b, 0x04, 0x30, 0x7e, 0x64,
-	0x78, 0xd6, 0xe6, 0x23, 0x0b, 0xaf, 0xcf, 0x9e, 0xa0, 0x71, 0x2b, 0x0a, 0x97, 0xe0, 0x8d, 0xe9,
-	0xb8, 0x62, 0xcc, 0x8b, 0xdf, 0x21, 0xc8, 0x0e, 0xee, 0x2a, 0xbe, 0x72, 0x72, 0xc7, 0xc4, 0x43,
-	0x33, 0xd7, 0x67, 0x09, 0xd5, 0x58, 0x55, 0x85, 0x75, 0x13, 0x6f, 0xfd, 0x91, 0x8b, 0xc2, 0xa5,
-	0x01, 0xe9, 0xc4, 0xaf, 0xb4, 0x5b, 0x6d, 0x1e, 0xf4, 0x2c, 0x74, 0xd8, 0xb3, 0xd0, 0xf7, 0x9e,
-	0x85, 0x5e, 0xf5, 0x2d, 0xe3, 0xb0, 0x6f, 0x19, 0x5f, 0xfb, 0x96, 0x01, 0xa6, 0xcf, 0xd3, 0x58,
-	0x6a, 0xe8, 0x51, 0xc5, 0xf3, 0xe5, 0xe3, 0xa8, 0xe1, 0xb8, 0xbc, 0x99, 0xe8, 0xbe, 0xe1, 0xf3,
-	0x24, 0xcb, 0x6e, 0x02, 0x40, 0xee, 0x85, 0x4c, 0x34, 0x72, 0xea, 0x1f, 0x78, 0xf3, 0x57, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x6f, 0x59, 0xc9, 0xa6, 0x4a, 0x08, 0x00, 0x00,
+	// 858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x18, 0xd7, 0xc5, 0x0f, 0x15, 0xb5, 0x53, 0x7e, 0xb8, 0xab, 0xca, 0x4b, 0x97, 0x0a,
+	0x0c, 0x94, 0x1d, 0x6c, 0x8a, 0xa8, 0x28, 0x1c, 0xf0, 0xa1, 0xf4, 0xd4, 0xba, 0x2e, 0xca, 0x21,
+	0x17, 0x34, 0x76, 0x16, 0xb3, 0x12, 0xde, 0x59, 0x76, 0xd7, 0x16, 0x16, 0x42, 0x0a, 0x39, 0x45,
+	0x4a, 0x0e, 0x91, 0x72, 0xc8, 0x95, 0x5c, 0x12, 0xe5, 0x5f, 0xc8, 0x29, 0x97, 0x44, 0x1c, 0x91,
+	0x72, 0xc9, 0x09, 0x45, 0x90, 0x43, 0xce, 0xfc, 0x05, 0xd1, 0xce, 0x8e, 0xd7, 0x6b, 0xc3, 0xda,
+	0xc6, 0x97, 0x88, 0xdb, 0xcc, 0xf8, 0xfd, 0xf8, 0xde, 0xf7, 0xcd, 0xbc, 0xb7, 0x86, 0x49, 0xd3,
+	0x62, 0x35, 0xcd, 0xa0, 0x46, 0x49, 0x23, 0xd4, 0x71, 0x2c, 0xbd, 0x58, 0x75, 0x34, 0x52, 0xcb,
+	0x90, 0xbd, 0xaa, 0x66, 0xd5, 0x55, 0xd3, 0x62, 0x0e, 0xc3, 0xe3, 0x4d, 0x23, 0xd5, 0x37, 0x52,
+	0x6b, 0x19, 0x69, 0xb6, 0xc4, 0xec, 0x0a, 0xb3, 0x49, 0x91, 0xda, 0x9a, 0xe7, 0x41, 0x6a, 0x99,
+	0xa2, 0xe6, 0xd0, 0x0c, 0x31, 0x69, 0x59, 0x37, 0xa8, 0xa3, 0x33, 0xc3, 0x0b, 0x22, 0x8d, 0x94,
+	0x59, 0x99, 0xf1, 0x25, 0x71, 0x57, 0xe2, 0xf4, 0xe7, 0x32, 0x63, 0xe5, 0x5d, 0x8d, 0x50, 0x53,
+	0x27, 0xd4, 0x30, 0x98, 0xc3, 0x5d, 0x6c, 0xf1, 0xeb, 0x74, 0x18, 0xba, 0x26, 0x0a, 0x6e, 0xa8,
+	0x8c, 0x00, 0xfe, 0xcf, 0x4d, 0x9f, 0xa7, 0x16, 0xad, 0xd8, 0x05, 0x6d, 0xaf, 0xaa, 0xd9, 0x8e,
+	0xb2, 0x09, 0x3f, 0xb6, 0x9c, 0xda, 0x26, 0x33, 0x6c, 0x0d, 0xaf, 0x41, 0xdc, 0xe4, 0x27, 0x49,
+	0x34, 0x81, 0xd2, 0x43, 0x59, 0x59, 0x0d, 0xa9, 0x4f, 0xf5, 0x1c, 0x73, 0xb1, 0x93, 0x33, 0x39,
+	0x52, 0x10, 0x4e, 0xca, 0x51, 0x14, 0x46, 0x79, 0xd8, 0xf5, 0x86, 0xa9, 0xc8, 0x87, 0x93, 0xf0,
+	0x2d, 0x2d, 0x95, 0x58, 0xd5, 0x70, 0x78, 0xe4, 0x44, 0xa1, 0xb1, 0xc5, 0x18, 0x62, 0x06, 0xad,
+	0x68, 0xc9, 0x28, 0x3f, 0xe6, 0x6b, 0xfc, 0x17, 0x40, 0x93, 0xa4, 0xe4, 0x00, 0x87, 0x32, 0xa5,
+	0x7a, 0x8c, 0xaa, 0x2e, 0xa3, 0xaa, 0xa7, 0x81, 0x60, 0x54, 0xcd, 0xd3, 0x72, 0x23, 0x53, 0x21,
+	0xe0, 0x89, 0x77, 0x60, 0xd8, 0x07, 0xbd, 0xe5, 0xd4, 0x4d, 0x2d, 0x19, 0x9b, 0x40, 0xe9, 0xe1,
+	0xec, 0x54, 0x68, 0x59, 0x3e, 0xf0, 0xcd, 0xba, 0xa9, 0xe5, 0x7e, 0xba, 0x3c, 0x93, 0x47, 0xeb,
+	0xb4, 0xb2, 0xbb, 0xa2, 0xb4, 0xc6, 0x51, 0x0a, 0xdf, 0xd1, 0xa0, 0xe5, 0xca, 0xe0, 0xc3, 0x63,
+	0x39, 0xf2, 0xf9, 0x58, 0x8e, 0x28, 0x6f, 0x11, 0x8c, 0xb5, 0x73, 0x20, 0xd8, 0x0d, 0x27, 0xe1,
+	0x6f, 0x00, 0x3f, 0x9e, 0x9d, 0x8c, 0x4e, 0x0c, 0xa4, 0x87, 0xb2, 0x4a, 0x77, 0x90, 0x82, 0xfe,
+	0x80, 0x2f, 0xde, 0xb8, 0x86, 0xba, 0xe9, 0xae, 0xd4, 0x79, 0x00, 0x83, 0xdc, 0x29, 0x2f, 0xa3,
+	0xed, 0x75, 0xd8, 0xdd, 0xc5, 0x6c, 0x15, 0x2e, 0xda, 0xb7, 0x70, 0xcb, 0x30, 0xe4, 0x5e, 0x84,
+	0x2d, 0xd3, 0xd2, 0xb6, 0xf5, 0x7d, 0x5e, 0x46, 0x22, 0x37, 0x76, 0x79, 0x26, 0x63, 0x4f, 0x8d,
+	0xc0, 0x8f, 0x4a, 0x01, 0xdc, 0x5d, 0x9e, 0x6f, 0xbe, 0x8a, 0xe2, 0xef, 0x10, 0x8c, 0x5f, 0x61,
+	0xea, 0x36, 0x4a, 0x7e, 0x3f, 0x0a, 0xdf, 0xf3, 0x42, 0xfe, 0x2f, 0x51, 0xa3, 0xbb, 0xd8, 0x63,
+	0x10, 0xb7, 0xab, 0xdb, 0xae, 0x3e, 0xde, 0xdb, 0x15, 0xbb, 0x5b, 0xfd, 0x7a, 0xdf, 0x20, 0xf8,
+	0x21, 0x40, 0xc1, 0x6d, 0x54, 0xf1, 0x08, 0xc1, 0x64, 0xeb, 0x75, 0x5c, 0xf7, 0xc0, 0xda, 0xb9,
+	0xfa, 0x3f, 0xb4, 0xe2, 0xb7, 0xe4, 0x46, 0xe3, 0x45, 0xa1, 0x8d, 0xb7, 0xef, 0xf7, 0xab, 0x3c,
+	0x42, 0xf0, 0x6b, 0x67, 0x0c, 0x82, 0x59, 0x09, 0x06, 0x05, 0x95, 0xee, 0xc8, 0x19, 0x48, 0x27,
+	0x0a, 0xfe, 0x1e, 0x6f, 0x5c, 0x03, 0xa6, 0x2f, 0x46, 0x7e, 0x87, 0x54, 0x2b, 0x98, 0x3b, 0x74,
+	0x57, 0xbf, 0x47, 0x1d, 0x66, 0x75, 0xe0, 0x42, 0xb1, 0x40, 0x0e, 0xf5, 0x12, 0xe8, 0xff, 0x85,
+	0x44, 0xad, 0x71, 0x28, 0x26, 0xe6, 0x5c, 0x77, 0xf1, 0xfd, 0x38, 0xe2, 0x16, 0x34, 0x63, 0x64,
+	0x9f, 0x0d, 0xc2, 0x37, 0x3c, 0x29, 0x7e, 0x8c, 0x20, 0xee, 0xcd, 0x58, 0x1c, 0x1e, 0xf2, 0xea,
+	0x60, 0x97, 0x7e, 0xeb, 0xcd, 0xd8, 0x2b, 0x40, 0x99, 0x7e, 0xf0, 0xfe, 0xd3, 0xd3, 0xe8, 0x2f,
+	0x58, 0x26, 0x61, 0x9f, 0x13, 0xde, 0x64, 0xc7, 0xaf, 0x10, 0x24, 0xfc, 0x02, 0xb0, 0xda, 0x39,
+	0x49, 0xfb, 0xf4, 0x97, 0x48, 0xcf, 0xf6, 0x02, 0xd7, 0x9f, 0x1c, 0xd7, 0x12, 0x5e, 0x24, 0x5d,
+	0x3f, 0x73, 0xc8, 0x81, 0xb8, 0x30, 0x87, 0xe4, 0xc0, 0xd5, 0xed, 0x10, 0xbf, 0x40, 0x00, 0xcd,
+	0x56, 0x8c, 0x7b, 0x4d, 0xee, 0x53, 0xb8, 0xd0, 0xbb, 0x83, 0x80, 0xbb, 0xc4, 0xe1, 0x12, 0x3c,
+	0xdf, 0x1d, 0xae, 0xdd, 0xc4, 0x8b, 0x9f, 0x23, 0x88, 0xb9, 0x7d, 0x06, 0xcf, 0x74, 0xce, 0x18,
+	0x68, 0xc7, 0xd2, 0x6c, 0x2f, 0xa6, 0x02, 0x56, 0x8e, 0xc3, 0x5a, 0xc5, 0x2b, 0x37, 0x62, 0xd1,
+	0x2e, 0x51, 0x83, 0x1c, 0x78, 0xbd, 0xfc, 0x10, 0x9f, 0x20, 0x18, 0x0f, 0x79, 0xc4, 0x78, 0xb5,
+	0x47, 0xa2, 0xae, 0xed, 0x3f, 0xd2, 0x5a, 0x9f, 0xde, 0xa2, 0xb8, 0x05, 0x5e, 0xdc, 0x2c, 0x4e,
+	0x87, 0x17, 0x27, 0x1c, 0x1b, 0xf7, 0xe2, 0x35, 0x02, 0x7c, 0xf5, 0x11, 0xe2, 0xe5, 0x1e, 0x71,
+	0xb4, 0x37, 0x0d, 0xe9, 0x8f, 0x9b, 0x3b, 0x0a, 0xec, 0x19, 0x8e, 0x7d, 0x0e, 0xcf, 0x84, 0x62,
+	0xf7, 0x5b, 0x82, 0x00, 0x9f, 0xab, 0x9c, 0x9c, 0xa7, 0xd0, 0xe9, 0x79, 0x0a, 0x7d, 0x3c, 0x4f,
+	0xa1, 0x27, 0x17, 0xa9, 0xc8, 0xe9, 0x45, 0x2a, 0xf2, 0xe1, 0x22, 0x15, 0x01, 0x49, 0x67, 0x61,
+	0x40, 0xf2, 0xe8, 0xee, 0x52, 0x59, 0x77, 0x76, 0xaa, 0x45, 0xb5, 0xc4, 0x2a, 0x81, 0x64, 0xf3,
+	0x3a, 0x0b, 0xa6, 0xde, 0x0f, 0x24, 0x77, 0x07, 0xa4, 0x5d, 0x8c, 0xf3, 0x3f, 0x0f, 0x8b, 0x5f,
+	0x06, 0x00, 0xa2, 0xe7, 0x7b, 0xd6, 0x05, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -510,6 +739,10 @@ type QueryClient interface {
 	Attributes(ctx context.Context, in *QueryAttributesRequest, opts ...grpc.CallOption) (*QueryAttributesResponse, error)
 	// Scan queries attributes on a given account (address) for any that match the provided suffix
 	Scan(ctx context.Context, in *QueryScanRequest, opts ...grpc.CallOption) (*QueryScanResponse, error)
+	// AttributeAccountsByName queries the accounts that have attributes with a given name
+	AttributeAccountsByName(ctx context.Context, in *QueryAttributeAccountsByNameRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsByNameResponse, error)
+	// AttributeValidator queries the smart contract registered to validate values of an attribute name
+	AttributeValidator(ctx context.Context, in *QueryAttributeValidatorRequest, opts ...grpc.CallOption) (*QueryAttributeValidatorResponse, error)
 }
 
 type queryClient struct {
@@ -556,6 +789,24 @@ func (c *queryClient) Scan(ctx context.Context, in *QueryScanRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) AttributeAccountsByName(ctx context.Context, in *QueryAttributeAccountsByNameRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsByNameResponse, error) {
+	out := new(QueryAttributeAccountsByNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeAccountsByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttributeValidator(ctx context.Context, in *QueryAttributeValidatorRequest, opts ...grpc.CallOption) (*QueryAttributeValidatorResponse, error) {
+	out := new(QueryAttributeValidatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the attribute module.
@@ -566,6 +817,10 @@ type QueryServer interface {
 	Attributes(context.Context, *QueryAttributesRequest) (*QueryAttributesResponse, error)
 	// Scan queries attributes on a given account (address) for any that match the provided suffix
 	Scan(context.Context, *QueryScanRequest) (*QueryScanResponse, error)
+	// AttributeAccountsByName queries the accounts that have attributes with a given name
+	AttributeAccountsByName(context.Context, *QueryAttributeAccountsByNameRequest) (*QueryAttributeAccountsByNameResponse, error)
+	// AttributeValidator queries the smart contract registered to validate values of an attribute name
+	AttributeValidator(context.Context, *QueryAttributeValidatorRequest) (*QueryAttributeValidatorResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -584,6 +839,12 @@ func (*UnimplementedQueryServer) Attributes(ctx context.Context, req *QueryAttri
 func (*UnimplementedQueryServer) Scan(ctx context.Context, req *QueryScanRequest) (*QueryScanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
 }
+func (*UnimplementedQueryServer) AttributeAccountsByName(ctx context.Context, req *QueryAttributeAccountsByNameRequest) (*QueryAttributeAccountsByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeAccountsByName not implemented")
+}
+func (*UnimplementedQueryServer) AttributeValidator(ctx context.Context, req *QueryAttributeValidatorRequest) (*QueryAttributeValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeValidator not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -661,6 +922,42 @@ func _Query_Scan_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AttributeAccountsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeAccountsByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeAccountsByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AttributeAccountsByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeAccountsByName(ctx, req.(*QueryAttributeAccountsByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttributeValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AttributeValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeValidator(ctx, req.(*QueryAttributeValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.attribute.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -681,6 +978,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Scan",
 			Handler:    _Query_Scan_Handler,
 		},
+		{
+			MethodName: "AttributeAccountsByName",
+			Handler:    _Query_AttributeAccountsByName_Handler,
+		},
+		{
+			MethodName: "AttributeValidator",
+			Handler:    _Query_AttributeValidator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/attribute/v1/query.proto",
@@ -762,6 +1067,11 @@ func (m *QueryAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AttributeType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AttributeType))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -867,6 +1177,18 @@ func (m *QueryAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.AttributeType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AttributeType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NamePrefix) > 0 {
+		i -= len(m.NamePrefix)
+		copy(dAtA[i:], m.NamePrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NamePrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -965,6 +1287,11 @@ func (m *QueryScanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AttributeType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AttributeType))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1050,67 +1377,219 @@ func (m *QueryScanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAttributeAccountsByNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAttributeAccountsByNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAttributeAccountsByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAttributeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAttributeAccountsByNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeAccountsByNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeAccountsByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AttributeType != 0 {
+		n += 1 + sovQuery(uint64(m.AttributeType))
+	}
+	return n
+}
+
+func (m *QueryAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	if len(m.Attributes) > 0 {
 		for _, e := range m.Attributes {
@@ -1139,6 +1618,13 @@ func (m *QueryAttributesRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.NamePrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AttributeType != 0 {
+		n += 1 + sovQuery(uint64(m.AttributeType))
+	}
 	return n
 }
 
@@ -1183,6 +1669,9 @@ func (m *QueryScanRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.AttributeType != 0 {
+		n += 1 + sovQuery(uint64(m.AttributeType))
+	}
 	return n
 }
 
@@ -1209,6 +1698,66 @@ func (m *QueryScanResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAttributeAccountsByNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeAccountsByNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1275,28 +1824,667 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			m.AttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			m.AttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1323,7 +2511,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1348,7 +2539,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1371,10 +2562,10 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1411,7 +2602,7 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1439,7 +2630,7 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Suffix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1477,6 +2668,25 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			m.AttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1498,7 +2708,7 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1521,10 +2731,10 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1650,7 +2860,7 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeAccountsByNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1673,15 +2883,15 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeAccountsByNameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeAccountsByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1709,7 +2919,7 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1768,7 +2978,7 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeAccountsByNameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1791,15 +3001,15 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeAccountsByNameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeAccountsByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1827,43 +3037,9 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1920,7 +3096,7 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeValidatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1943,47 +3119,15 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeValidatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2011,43 +3155,7 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Suffix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2070,7 +3178,7 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2093,81 +3201,15 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2194,10 +3236,7 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
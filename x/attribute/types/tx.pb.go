@@ -41,6 +41,8 @@ type MsgAddAttributeRequest struct {
 	Account string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
 	// The address that the name must resolve to.
 	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Whether to bind the name to the owner first if it is not bound yet (the parent name must allow the owner to bind).
+	BindIfMissing bool `protobuf:"varint,6,opt,name=bind_if_missing,json=bindIfMissing,proto3" json:"bind_if_missing,omitempty"`
 }
 
 func (m *MsgAddAttributeRequest) Reset()      { *m = MsgAddAttributeRequest{} }
@@ -362,6 +364,169 @@ func (m *MsgDeleteDistinctAttributeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteDistinctAttributeResponse proto.InternalMessageInfo
 
+// MsgSetAttributeValidatorRequest defines a message to register a smart contract that must accept values of an
+// attribute before they are added or updated.  An empty contract address removes the validator.  Validators may only be
+// set by the account that the attribute name resolves to.
+type MsgSetAttributeValidatorRequest struct {
+	// The attribute name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address of the validating smart contract, or empty to remove the validator.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The address that the name must resolve to.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgSetAttributeValidatorRequest) Reset()      { *m = MsgSetAttributeValidatorRequest{} }
+func (*MsgSetAttributeValidatorRequest) ProtoMessage() {}
+func (*MsgSetAttributeValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{8}
+}
+func (m *MsgSetAttributeValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttributeValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttributeValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttributeValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttributeValidatorRequest.Merge(m, src)
+}
+func (m *MsgSetAttributeValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttributeValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttributeValidatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttributeValidatorRequest proto.InternalMessageInfo
+
+// MsgSetAttributeValidatorResponse defines the Msg/SetAttributeValidator response type.
+type MsgSetAttributeValidatorResponse struct {
+}
+
+func (m *MsgSetAttributeValidatorResponse) Reset()         { *m = MsgSetAttributeValidatorResponse{} }
+func (m *MsgSetAttributeValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttributeValidatorResponse) ProtoMessage()    {}
+func (*MsgSetAttributeValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{9}
+}
+func (m *MsgSetAttributeValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttributeValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttributeValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttributeValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttributeValidatorResponse.Merge(m, src)
+}
+func (m *MsgSetAttributeValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttributeValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttributeValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttributeValidatorResponse proto.InternalMessageInfo
+
+// MsgRestoreAttributeRequest defines a message to restore deleted attributes with a name to an account before their
+// deletion grace period ends.  Attributes may only be restored by the account that the attribute name resolves to.
+type MsgRestoreAttributeRequest struct {
+	// The attribute name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The attribute value to restore, or empty to restore all deleted attributes with the name.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// The account to restore the attributes to.
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// The address that the name must resolve to.
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgRestoreAttributeRequest) Reset()      { *m = MsgRestoreAttributeRequest{} }
+func (*MsgRestoreAttributeRequest) ProtoMessage() {}
+func (*MsgRestoreAttributeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{10}
+}
+func (m *MsgRestoreAttributeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRestoreAttributeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRestoreAttributeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRestoreAttributeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestoreAttributeRequest.Merge(m, src)
+}
+func (m *MsgRestoreAttributeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRestoreAttributeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestoreAttributeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRestoreAttributeRequest proto.InternalMessageInfo
+
+// MsgRestoreAttributeResponse defines the Msg/RestoreAttribute response type.
+type MsgRestoreAttributeResponse struct {
+}
+
+func (m *MsgRestoreAttributeResponse) Reset()         { *m = MsgRestoreAttributeResponse{} }
+func (m *MsgRestoreAttributeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRestoreAttributeResponse) ProtoMessage()    {}
+func (*MsgRestoreAttributeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{11}
+}
+func (m *MsgRestoreAttributeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRestoreAttributeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRestoreAttributeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRestoreAttributeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestoreAttributeResponse.Merge(m, src)
+}
+func (m *MsgRestoreAttributeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRestoreAttributeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestoreAttributeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRestoreAttributeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddAttributeRequest)(nil), "provenance.attribute.v1.MsgAddAttributeRequest")
 	proto.RegisterType((*MsgAddAttributeResponse)(nil), "provenance.attribute.v1.MsgAddAttributeResponse")
@@ -371,47 +536,59 @@ func init() {
 	proto.RegisterType((*MsgDeleteAttributeResponse)(nil), "provenance.attribute.v1.MsgDeleteAttributeResponse")
 	proto.RegisterType((*MsgDeleteDistinctAttributeRequest)(nil), "provenance.attribute.v1.MsgDeleteDistinctAttributeRequest")
 	proto.RegisterType((*MsgDeleteDistinctAttributeResponse)(nil), "provenance.attribute.v1.MsgDeleteDistinctAttributeResponse")
+	proto.RegisterType((*MsgSetAttributeValidatorRequest)(nil), "provenance.attribute.v1.MsgSetAttributeValidatorRequest")
+	proto.RegisterType((*MsgSetAttributeValidatorResponse)(nil), "provenance.attribute.v1.MsgSetAttributeValidatorResponse")
+	proto.RegisterType((*MsgRestoreAttributeRequest)(nil), "provenance.attribute.v1.MsgRestoreAttributeRequest")
+	proto.RegisterType((*MsgRestoreAttributeResponse)(nil), "provenance.attribute.v1.MsgRestoreAttributeResponse")
 }
 
 func init() { proto.RegisterFile("provenance/attribute/v1/tx.proto", fileDescriptor_5de344c1a12714be) }
 
 var fileDescriptor_5de344c1a12714be = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xf5, 0x25, 0x4e, 0x2b, 0x3e, 0xd2, 0x80, 0x8e, 0x96, 0xb8, 0x16, 0x72, 0xdc, 0x88, 0x1f,
-	0x59, 0xb0, 0x69, 0x2a, 0x96, 0x32, 0x15, 0x75, 0x8d, 0x84, 0x22, 0x60, 0xe8, 0x40, 0xe5, 0x38,
-	0x27, 0x63, 0x29, 0xf1, 0x39, 0xf6, 0x39, 0xb4, 0x4c, 0x2c, 0x48, 0x6c, 0x20, 0x26, 0xc6, 0xfc,
-	0x39, 0x8c, 0x95, 0x58, 0x18, 0x18, 0x50, 0xb2, 0xf0, 0x1f, 0xb0, 0xa2, 0x9c, 0x13, 0xc7, 0x75,
-	0x73, 0x26, 0x81, 0xcd, 0xdf, 0xf9, 0xdd, 0x7b, 0xef, 0xbe, 0x77, 0x9f, 0x0d, 0xba, 0x1f, 0xd0,
-	0x21, 0xf1, 0x2c, 0xcf, 0x26, 0xa6, 0xc5, 0x58, 0xe0, 0x76, 0x22, 0x46, 0xcc, 0xe1, 0xbe, 0xc9,
-	0xce, 0x0c, 0x3f, 0xa0, 0x8c, 0xe2, 0xea, 0x02, 0x61, 0x24, 0x08, 0x63, 0xb8, 0xaf, 0x6e, 0x3b,
-	0xd4, 0xa1, 0x1c, 0x63, 0x4e, 0x9f, 0x62, 0xb8, 0xfa, 0x40, 0x44, 0xb8, 0xd8, 0xcb, 0x81, 0xf5,
-	0x6f, 0x08, 0x6e, 0xb7, 0x42, 0xe7, 0xa8, 0xdb, 0x3d, 0x9a, 0xbf, 0x69, 0x93, 0x41, 0x44, 0x42,
-	0x86, 0x31, 0xc8, 0x9e, 0xd5, 0x27, 0x0a, 0xd2, 0x51, 0xe3, 0x5a, 0x9b, 0x3f, 0xe3, 0x6d, 0x28,
-	0x0d, 0xad, 0x5e, 0x44, 0x94, 0x82, 0x8e, 0x1a, 0xe5, 0x76, 0x5c, 0xe0, 0x16, 0x54, 0x12, 0xde,
-	0x53, 0x76, 0xee, 0x13, 0xa5, 0xa8, 0xa3, 0x46, 0xa5, 0x79, 0xdf, 0x10, 0xb8, 0x36, 0x12, 0xb1,
-	0xe7, 0xe7, 0x3e, 0x69, 0x6f, 0x59, 0xe9, 0x12, 0x2b, 0xb0, 0x69, 0xd9, 0x36, 0x8d, 0x3c, 0xa6,
-	0xc8, 0x5c, 0x7b, 0x5e, 0x4e, 0xe5, 0xe9, 0x1b, 0x8f, 0x04, 0x4a, 0x89, 0xaf, 0xc7, 0xc5, 0xe1,
-	0xcd, 0x0f, 0xa3, 0x9a, 0xf4, 0x65, 0x54, 0x93, 0x7e, 0x8d, 0x6a, 0xd2, 0xbb, 0x1f, 0xba, 0x54,
-	0xdf, 0x85, 0xea, 0x95, 0x43, 0x85, 0x3e, 0xf5, 0x42, 0x52, 0xff, 0x5d, 0x80, 0xdd, 0x56, 0xe8,
-	0xbc, 0xf0, 0xbb, 0x16, 0x23, 0x2b, 0x9d, 0xf9, 0x1e, 0x54, 0x68, 0xe0, 0x3a, 0xae, 0x67, 0xf5,
-	0x4e, 0xd3, 0x87, 0xdf, 0x9a, 0xaf, 0xbe, 0xe4, 0x4d, 0xd8, 0x83, 0x72, 0xc4, 0x49, 0x67, 0xa0,
-	0x22, 0x07, 0x5d, 0x8f, 0xd7, 0x62, 0xc8, 0x2b, 0xa8, 0x26, 0x4c, 0x99, 0x86, 0xc9, 0x6b, 0x35,
-	0x6c, 0x67, 0x4e, 0x73, 0x69, 0x19, 0x9f, 0xc0, 0xce, 0xcc, 0x42, 0x86, 0xbd, 0xb4, 0x16, 0xfb,
-	0xad, 0xe8, 0x72, 0x73, 0xb2, 0xa1, 0x6c, 0x08, 0x42, 0xd9, 0xcc, 0x0f, 0xe5, 0x0e, 0xa8, 0xcb,
-	0x1a, 0x3f, 0xcb, 0x65, 0xc0, 0x63, 0x39, 0x26, 0x3d, 0xb2, 0x62, 0x2c, 0x29, 0x43, 0x05, 0x81,
-	0xa1, 0xe2, 0x2a, 0x86, 0xae, 0x48, 0xce, 0x0c, 0x7d, 0x44, 0xb0, 0x97, 0xbc, 0x3e, 0x76, 0x43,
-	0xe6, 0x7a, 0x36, 0xfb, 0x8f, 0x21, 0x49, 0xf9, 0x2d, 0x0a, 0xfc, 0xca, 0xf9, 0x7e, 0xef, 0x42,
-	0x3d, 0xcf, 0x50, 0xec, 0xbb, 0xf9, 0x5e, 0x86, 0x62, 0x2b, 0x74, 0xf0, 0x00, 0xca, 0xe9, 0x01,
-	0xc0, 0xa6, 0x30, 0xfd, 0xe5, 0xf3, 0xaf, 0x3e, 0x5a, 0x7d, 0x43, 0x2c, 0x8d, 0xdf, 0xc2, 0x8d,
-	0x4c, 0xbc, 0xb8, 0x99, 0x47, 0xb2, 0x7c, 0x08, 0xd5, 0x83, 0xb5, 0xf6, 0x2c, 0xb4, 0x33, 0x49,
-	0xe6, 0x6b, 0x2f, 0xbf, 0x69, 0xf9, 0xda, 0x82, 0xab, 0x82, 0x3f, 0x23, 0xa8, 0x0a, 0x62, 0xc1,
-	0x87, 0x7f, 0x27, 0x14, 0x5d, 0x2e, 0xf5, 0xc9, 0x3f, 0xed, 0x8d, 0x4d, 0x3d, 0xed, 0x7f, 0x1d,
-	0x6b, 0xe8, 0x62, 0xac, 0xa1, 0x9f, 0x63, 0x0d, 0x7d, 0x9a, 0x68, 0xd2, 0xc5, 0x44, 0x93, 0xbe,
-	0x4f, 0x34, 0x09, 0x54, 0x97, 0x8a, 0x88, 0x9f, 0xa1, 0x93, 0xc7, 0x8e, 0xcb, 0x5e, 0x47, 0x1d,
-	0xc3, 0xa6, 0x7d, 0x73, 0x81, 0x7a, 0xe8, 0xd2, 0x54, 0x65, 0x9e, 0xa5, 0xfe, 0x2a, 0xd3, 0x2f,
-	0x4c, 0xd8, 0xd9, 0xe0, 0xff, 0x93, 0x83, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x84, 0xf7, 0xbe,
-	0xa7, 0xcb, 0x06, 0x00, 0x00,
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x35, 0x69, 0x0a, 0x8f, 0xfe, 0xd2, 0xd1, 0x12, 0xd7, 0x40, 0xe2, 0x46, 0x50, 0xc2,
+	0x40, 0x4c, 0x5b, 0x90, 0xa0, 0x4c, 0x45, 0x5d, 0x18, 0x22, 0xa1, 0x00, 0x1d, 0x3a, 0x10, 0x39,
+	0xf6, 0xd5, 0x9c, 0x94, 0xf8, 0x52, 0xdf, 0x39, 0xb4, 0x48, 0x48, 0x8c, 0x1d, 0x90, 0x40, 0xb0,
+	0x30, 0xf6, 0xcf, 0x61, 0xec, 0xc8, 0xc0, 0x80, 0x9a, 0x85, 0xff, 0x80, 0x81, 0x05, 0xc5, 0x76,
+	0x12, 0xd7, 0xb5, 0x4d, 0x52, 0x06, 0x36, 0xbf, 0xe7, 0xf7, 0xbe, 0xef, 0xcb, 0xf7, 0xee, 0x5d,
+	0x0c, 0x6a, 0xdb, 0x61, 0x1d, 0x62, 0xeb, 0xb6, 0x41, 0x34, 0x5d, 0x08, 0x87, 0x36, 0x5c, 0x41,
+	0xb4, 0xce, 0xaa, 0x26, 0xf6, 0x2b, 0x6d, 0x87, 0x09, 0x86, 0xf3, 0xc3, 0x8a, 0xca, 0xa0, 0xa2,
+	0xd2, 0x59, 0x55, 0x16, 0x2c, 0x66, 0x31, 0xaf, 0x46, 0xeb, 0x3d, 0xf9, 0xe5, 0xca, 0xad, 0x24,
+	0xc0, 0x61, 0xaf, 0x57, 0x58, 0xfa, 0x8d, 0xe0, 0x4a, 0x95, 0x5b, 0x9b, 0xa6, 0xb9, 0xd9, 0x7f,
+	0x53, 0x23, 0x7b, 0x2e, 0xe1, 0x02, 0x63, 0xc8, 0xda, 0x7a, 0x8b, 0xc8, 0x48, 0x45, 0xe5, 0x8b,
+	0x35, 0xef, 0x19, 0x2f, 0xc0, 0x64, 0x47, 0x6f, 0xba, 0x44, 0x9e, 0x50, 0x51, 0x79, 0xba, 0xe6,
+	0x07, 0xb8, 0x0a, 0xb3, 0x03, 0xdc, 0xba, 0x38, 0x68, 0x13, 0x39, 0xa3, 0xa2, 0xf2, 0xec, 0xda,
+	0x4a, 0x25, 0x41, 0x75, 0x65, 0x40, 0xf6, 0xfc, 0xa0, 0x4d, 0x6a, 0x33, 0x7a, 0x38, 0xc4, 0x32,
+	0x4c, 0xe9, 0x86, 0xc1, 0x5c, 0x5b, 0xc8, 0x59, 0x8f, 0xbb, 0x1f, 0xf6, 0xe8, 0xd9, 0x6b, 0x9b,
+	0x38, 0xf2, 0xa4, 0x97, 0xf7, 0x03, 0xbc, 0x02, 0x73, 0x0d, 0x6a, 0x9b, 0x75, 0xba, 0x5b, 0x6f,
+	0x51, 0xce, 0xa9, 0x6d, 0xc9, 0x39, 0x15, 0x95, 0x2f, 0xd4, 0x66, 0x7a, 0xe9, 0x27, 0xbb, 0x55,
+	0x3f, 0xb9, 0x31, 0x7f, 0x78, 0x54, 0x94, 0xbe, 0x1c, 0x15, 0xa5, 0x9f, 0x47, 0x45, 0xe9, 0xdd,
+	0x77, 0x55, 0x2a, 0x2d, 0x41, 0xfe, 0xcc, 0x8f, 0xe7, 0x6d, 0x66, 0x73, 0x52, 0xfa, 0x35, 0x01,
+	0x4b, 0x55, 0x6e, 0xbd, 0x68, 0x9b, 0xba, 0x20, 0x23, 0x79, 0x73, 0x13, 0x66, 0x99, 0x43, 0x2d,
+	0x6a, 0xeb, 0xcd, 0x7a, 0xd8, 0xa4, 0x99, 0x7e, 0x76, 0xdb, 0x33, 0x6b, 0x19, 0xa6, 0x5d, 0x0f,
+	0x34, 0x28, 0xca, 0x78, 0x45, 0x97, 0xfc, 0x9c, 0x5f, 0xf2, 0x12, 0xf2, 0x03, 0xa4, 0x88, 0xb1,
+	0xd9, 0xb1, 0x8c, 0x5d, 0xec, 0xc3, 0x9c, 0x4a, 0xe3, 0x1d, 0x58, 0x0c, 0x24, 0x44, 0xd0, 0x27,
+	0xc7, 0x42, 0xbf, 0xec, 0x9e, 0x36, 0x27, 0x3a, 0xbc, 0x5c, 0xc2, 0xf0, 0xa6, 0x42, 0xc3, 0x8b,
+	0x19, 0xca, 0x35, 0x50, 0xe2, 0x8c, 0x0f, 0xe6, 0xb2, 0xe7, 0x8d, 0x65, 0x8b, 0x34, 0xc9, 0x88,
+	0x63, 0x09, 0x09, 0x9a, 0x48, 0x10, 0x94, 0x19, 0x45, 0xd0, 0x19, 0xca, 0x40, 0xd0, 0x07, 0x04,
+	0xcb, 0x83, 0xd7, 0x5b, 0x94, 0x0b, 0x6a, 0x1b, 0xe2, 0x1f, 0x96, 0x29, 0xa4, 0x37, 0x93, 0xa0,
+	0x37, 0x9b, 0xae, 0xf7, 0x06, 0x94, 0xd2, 0x04, 0x05, 0xba, 0x0f, 0x11, 0x14, 0xab, 0xdc, 0x7a,
+	0x46, 0x86, 0xef, 0xb6, 0xf5, 0x26, 0x35, 0x75, 0xc1, 0x9c, 0x34, 0xd5, 0xb7, 0x61, 0xde, 0x60,
+	0xb6, 0x70, 0x74, 0x43, 0xd4, 0x75, 0xd3, 0x74, 0x08, 0xe7, 0x81, 0xb1, 0x73, 0xfd, 0xfc, 0xa6,
+	0x9f, 0x1e, 0xd9, 0xe0, 0x12, 0xa8, 0xc9, 0x4a, 0x86, 0x72, 0x7b, 0x53, 0xa8, 0x11, 0x2e, 0x98,
+	0x43, 0xfe, 0xab, 0xbf, 0xd7, 0xe1, 0x6a, 0xac, 0x12, 0x5f, 0xe9, 0xda, 0xe7, 0x1c, 0x64, 0xaa,
+	0xdc, 0xc2, 0x7b, 0x30, 0x1d, 0xbe, 0x59, 0xb0, 0x96, 0xb8, 0x56, 0xf1, 0x17, 0xb0, 0x72, 0x77,
+	0xf4, 0x06, 0x9f, 0x1a, 0xbf, 0x81, 0xb9, 0xc8, 0xde, 0xe0, 0xb5, 0x34, 0x90, 0xf8, 0xdb, 0x4d,
+	0x59, 0x1f, 0xab, 0x67, 0xc8, 0x1d, 0x59, 0x91, 0x74, 0xee, 0xf8, 0x15, 0x56, 0xd6, 0xc7, 0xea,
+	0x09, 0xb8, 0x3f, 0x21, 0xc8, 0x27, 0x9c, 0x77, 0xbc, 0xf1, 0x77, 0xc0, 0xa4, 0xad, 0x55, 0x1e,
+	0x9d, 0xab, 0x37, 0x10, 0xf5, 0x1e, 0xc1, 0x62, 0xec, 0x99, 0xc6, 0x0f, 0xd2, 0x60, 0xd3, 0x16,
+	0x52, 0x79, 0x78, 0x8e, 0xce, 0x40, 0xce, 0x5b, 0x98, 0x8f, 0x1e, 0x59, 0x9c, 0x6a, 0x76, 0xc2,
+	0xaa, 0x29, 0xf7, 0xc6, 0x6b, 0xf2, 0xe9, 0x1f, 0xb7, 0xbe, 0x9e, 0x14, 0xd0, 0xf1, 0x49, 0x01,
+	0xfd, 0x38, 0x29, 0xa0, 0x8f, 0xdd, 0x82, 0x74, 0xdc, 0x2d, 0x48, 0xdf, 0xba, 0x05, 0x09, 0x14,
+	0xca, 0x92, 0x10, 0x9f, 0xa2, 0x9d, 0xfb, 0x16, 0x15, 0xaf, 0xdc, 0x46, 0xc5, 0x60, 0x2d, 0x6d,
+	0x58, 0x75, 0x87, 0xb2, 0x50, 0xa4, 0xed, 0x87, 0x3e, 0x72, 0x7a, 0x7f, 0x64, 0xbc, 0x91, 0xf3,
+	0x3e, 0x6f, 0xd6, 0xff, 0x0c, 0x00, 0xba, 0xc9, 0xcf, 0x59, 0x5a, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -434,6 +611,10 @@ type MsgClient interface {
 	DeleteAttribute(ctx context.Context, in *MsgDeleteAttributeRequest, opts ...grpc.CallOption) (*MsgDeleteAttributeResponse, error)
 	// DeleteDistinctAttribute defines a method to verify a particular invariance.
 	DeleteDistinctAttribute(ctx context.Context, in *MsgDeleteDistinctAttributeRequest, opts ...grpc.CallOption) (*MsgDeleteDistinctAttributeResponse, error)
+	// SetAttributeValidator defines a method to register the smart contract that validates values of an attribute.
+	SetAttributeValidator(ctx context.Context, in *MsgSetAttributeValidatorRequest, opts ...grpc.CallOption) (*MsgSetAttributeValidatorResponse, error)
+	// RestoreAttribute defines a method to restore deleted attributes that are still in their grace period.
+	RestoreAttribute(ctx context.Context, in *MsgRestoreAttributeRequest, opts ...grpc.CallOption) (*MsgRestoreAttributeResponse, error)
 }
 
 type msgClient struct {
@@ -480,6 +661,24 @@ func (c *msgClient) DeleteDistinctAttribute(ctx context.Context, in *MsgDeleteDi
 	return out, nil
 }
 
+func (c *msgClient) SetAttributeValidator(ctx context.Context, in *MsgSetAttributeValidatorRequest, opts ...grpc.CallOption) (*MsgSetAttributeValidatorResponse, error) {
+	out := new(MsgSetAttributeValidatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/SetAttributeValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RestoreAttribute(ctx context.Context, in *MsgRestoreAttributeRequest, opts ...grpc.CallOption) (*MsgRestoreAttributeResponse, error) {
+	out := new(MsgRestoreAttributeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/RestoreAttribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddAttribute defines a method to verify a particular invariance.
@@ -490,6 +689,10 @@ type MsgServer interface {
 	DeleteAttribute(context.Context, *MsgDeleteAttributeRequest) (*MsgDeleteAttributeResponse, error)
 	// DeleteDistinctAttribute defines a method to verify a particular invariance.
 	DeleteDistinctAttribute(context.Context, *MsgDeleteDistinctAttributeRequest) (*MsgDeleteDistinctAttributeResponse, error)
+	// SetAttributeValidator defines a method to register the smart contract that validates values of an attribute.
+	SetAttributeValidator(context.Context, *MsgSetAttributeValidatorRequest) (*MsgSetAttributeValidatorResponse, error)
+	// RestoreAttribute defines a method to restore deleted attributes that are still in their grace period.
+	RestoreAttribute(context.Context, *MsgRestoreAttributeRequest) (*MsgRestoreAttributeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -508,6 +711,12 @@ func (*UnimplementedMsgServer) DeleteAttribute(ctx context.Context, req *MsgDele
 func (*UnimplementedMsgServer) DeleteDistinctAttribute(ctx context.Context, req *MsgDeleteDistinctAttributeRequest) (*MsgDeleteDistinctAttributeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDistinctAttribute not implemented")
 }
+func (*UnimplementedMsgServer) SetAttributeValidator(ctx context.Context, req *MsgSetAttributeValidatorRequest) (*MsgSetAttributeValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttributeValidator not implemented")
+}
+func (*UnimplementedMsgServer) RestoreAttribute(ctx context.Context, req *MsgRestoreAttributeRequest) (*MsgRestoreAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAttribute not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -585,6 +794,42 @@ func _Msg_DeleteDistinctAttribute_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAttributeValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAttributeValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAttributeValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Msg/SetAttributeValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAttributeValidator(ctx, req.(*MsgSetAttributeValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RestoreAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRestoreAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RestoreAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Msg/RestoreAttribute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RestoreAttribute(ctx, req.(*MsgRestoreAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.attribute.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -605,6 +850,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDistinctAttribute",
 			Handler:    _Msg_DeleteDistinctAttribute_Handler,
 		},
+		{
+			MethodName: "SetAttributeValidator",
+			Handler:    _Msg_SetAttributeValidator_Handler,
+		},
+		{
+			MethodName: "RestoreAttribute",
+			Handler:    _Msg_RestoreAttribute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/attribute/v1/tx.proto",
@@ -630,6 +883,16 @@ func (m *MsgAddAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.BindIfMissing {
+		i--
+		if m.BindIfMissing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -921,70 +1184,214 @@ func (m *MsgDeleteDistinctAttributeResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetAttributeValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetAttributeValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttributeValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AttributeType != 0 {
-		n += 1 + sovTx(uint64(m.AttributeType))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddAttributeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAttributeValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAttributeValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttributeValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRestoreAttributeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestoreAttributeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestoreAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.OriginalValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.UpdateValue)
-	if l > 0 {
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRestoreAttributeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestoreAttributeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestoreAttributeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AttributeType != 0 {
+		n += 1 + sovTx(uint64(m.AttributeType))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BindIfMissing {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAddAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginalValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UpdateValue)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.OriginalAttributeType != 0 {
@@ -1077,6 +1484,70 @@ func (m *MsgDeleteDistinctAttributeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAttributeValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAttributeValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRestoreAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRestoreAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1146,7 +1617,310 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			m.AttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindIfMissing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BindIfMissing = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalValue = append(m.OriginalValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalValue == nil {
+				m.OriginalValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValue", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1173,16 +1947,16 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.UpdateValue = append(m.UpdateValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.UpdateValue == nil {
+				m.UpdateValue = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAttributeType", wireType)
 			}
-			m.AttributeType = 0
+			m.OriginalAttributeType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1192,12 +1966,31 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AttributeType |= AttributeType(b&0x7F) << shift
+				m.OriginalAttributeType |= AttributeType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAttributeType", wireType)
+			}
+			m.UpdateAttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateAttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -1229,7 +2022,7 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -1282,7 +2075,7 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1305,10 +2098,10 @@ func (m *MsgAddAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAttributeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1332,7 +2125,7 @@ func (m *MsgAddAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1355,10 +2148,10 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteAttributeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1395,9 +2188,9 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1407,31 +2200,29 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalValue = append(m.OriginalValue[:0], dAtA[iNdEx:postIndex]...)
-			if m.OriginalValue == nil {
-				m.OriginalValue = []byte{}
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1441,31 +2232,129 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateValue = append(m.UpdateValue[:0], dAtA[iNdEx:postIndex]...)
-			if m.UpdateValue == nil {
-				m.UpdateValue = []byte{}
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAttributeType", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.OriginalAttributeType = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1475,16 +2364,29 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OriginalAttributeType |= AttributeType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAttributeType", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.UpdateAttributeType = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1494,12 +2396,27 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateAttributeType |= AttributeType(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -1531,7 +2448,7 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -1584,7 +2501,7 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1607,10 +2524,10 @@ func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1634,7 +2551,7 @@ func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAttributeValidatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1657,10 +2574,10 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAttributeValidatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAttributeValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1697,7 +2614,7 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1725,7 +2642,7 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1780,7 +2697,7 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAttributeValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1803,10 +2720,10 @@ func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAttributeValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAttributeValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1830,7 +2747,7 @@ func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreAttributeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1853,10 +2770,10 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreAttributeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2010,7 +2927,7 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreAttributeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2033,10 +2950,10 @@ func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreAttributeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
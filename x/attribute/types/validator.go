@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAttributeValidator creates a new attribute validator registration
+func NewAttributeValidator(name string, contractAddress string) *AttributeValidator {
+	return &AttributeValidator{
+		Name:            strings.ToLower(strings.TrimSpace(name)),
+		ContractAddress: contractAddress,
+	}
+}
+
+// ValidateBasic ensures the attribute validator has a name and a valid contract address.
+func (v AttributeValidator) ValidateBasic() error {
+	if strings.TrimSpace(v.Name) == "" {
+		return fmt.Errorf("invalid attribute validator: missing name")
+	}
+	if _, err := sdk.AccAddressFromBech32(v.ContractAddress); err != nil {
+		return fmt.Errorf("invalid attribute validator contract address: %w", err)
+	}
+	return nil
+}
+
+// ValidateAttributeQuery is the smart query sent to an attribute validator contract before a value is stored.
+type ValidateAttributeQuery struct {
+	ValidateAttribute ValidateAttributeParams `json:"validate_attribute"`
+}
+
+// ValidateAttributeParams are the attribute details sent to a validator contract.  The value is base64 encoded.
+type ValidateAttributeParams struct {
+	Name          string `json:"name"`
+	Address       string `json:"address"`
+	AttributeType string `json:"attribute_type"`
+	Value         []byte `json:"value"`
+}
+
+// ValidateAttributeResponse is the response expected from an attribute validator contract.
+type ValidateAttributeResponse struct {
+	Valid  bool   `json:"valid"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// NewValidateAttributeQuery creates the validator contract query for an attribute
+func NewValidateAttributeQuery(attr Attribute) ValidateAttributeQuery {
+	return ValidateAttributeQuery{
+		ValidateAttribute: ValidateAttributeParams{
+			Name:          attr.Name,
+			Address:       attr.Address,
+			AttributeType: attr.AttributeType.String(),
+			Value:         attr.Value,
+		},
+	}
+}
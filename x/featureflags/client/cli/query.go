@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/featureflags/types"
+)
+
+// GetQueryCmd is the top-level command for featureflags CLI queries.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the featureflags module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetFeatureFlagsCmd(),
+		GetFeatureFlagCmd(),
+	)
+
+	return queryCmd
+}
+
+// GetFeatureFlagsCmd returns the command handler for querying all feature flags.
+func GetFeatureFlagsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Query all feature flags",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query featureflags list`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeatureFlags(context.Background(), &types.QueryFeatureFlagsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetFeatureFlagCmd returns the command handler for querying a feature flag.
+func GetFeatureFlagCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "flag [name]",
+		Short:   "Query a feature flag and whether it is active",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %s query featureflags flag marker.example-feature`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeatureFlag(context.Background(), &types.QueryFeatureFlagRequest{Name: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
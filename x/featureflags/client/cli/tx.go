@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/provenance-io/provenance/x/featureflags/types"
+)
+
+// NewTxCmd is the top-level command for featureflags CLI transactions.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Transaction commands for the featureflags module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdSetFeatureFlagProposal(),
+	)
+	return txCmd
+}
+
+// GetCmdSetFeatureFlagProposal is the CLI command for submitting a set feature flag governance proposal.
+func GetCmdSetFeatureFlagProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-proposal [name] [activation-height] [deposit]",
+		Short: "Submit a proposal to set the activation height of a feature flag",
+		Long: `Submit a proposal to set the activation height of a feature flag along with an initial deposit.
+The feature is active from the activation height onwards.  An activation height of 0 removes the flag.`,
+		Example: fmt.Sprintf(`$ %s tx featureflags set-proposal marker.example-feature 1500000 1000%s --title "Activate example feature" --description "..." --from mykey`,
+			version.AppName, sdk.DefaultBondDenom),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid activation height %s: %w", args[1], err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			proposal := types.NewSetFeatureFlagProposal(title, description, args[0], height)
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return fmt.Errorf("invalid governance proposal. Error: %s", err)
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of the proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of the proposal")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,22 @@
+package featureflags
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/provenance-io/provenance/x/featureflags/keeper"
+	"github.com/provenance-io/provenance/x/featureflags/types"
+)
+
+// NewProposalHandler returns a handler for featureflags governance proposals.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetFeatureFlagProposal:
+			return keeper.HandleSetFeatureFlagProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized featureflags proposal content type: %T", c)
+		}
+	}
+}
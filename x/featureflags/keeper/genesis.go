@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/featureflags/types"
+)
+
+// InitGenesis creates the initial genesis state for the featureflags module.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := data.ValidateBasic(); err != nil {
+		panic(err)
+	}
+	for _, flag := range data.Flags {
+		if err := k.SetFeatureFlag(ctx, flag); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis exports the current keeper state of the featureflags module.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
+	return types.NewGenesisState(k.GetAllFeatureFlags(ctx))
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/featureflags/types"
+)
+
+// Keeper defines the featureflags module Keeper
+type Keeper struct {
+	// Key to access the key-value store from sdk.Context.
+	storeKey sdk.StoreKey
+
+	// The codec codec for binary encoding/decoding.
+	cdc codec.BinaryCodec
+}
+
+// NewKeeper returns a featureflags keeper. It handles:
+// - storing the activation heights of feature flags set by governance
+// - reporting whether a feature is active to the keepers that consult it
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey) Keeper {
+	return Keeper{
+		storeKey: key,
+		cdc:      cdc,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetFeatureFlag returns a feature flag, or nil if it has not been set.
+func (k Keeper) GetFeatureFlag(ctx sdk.Context, name string) *types.FeatureFlag {
+	bz := ctx.KVStore(k.storeKey).Get(types.FeatureFlagKey(name))
+	if bz == nil {
+		return nil
+	}
+	var flag types.FeatureFlag
+	k.cdc.MustUnmarshal(bz, &flag)
+	return &flag
+}
+
+// SetFeatureFlag stores a feature flag, replacing any existing activation height.
+func (k Keeper) SetFeatureFlag(ctx sdk.Context, flag types.FeatureFlag) error {
+	if err := flag.ValidateBasic(); err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.FeatureFlagKey(flag.Name), k.cdc.MustMarshal(&flag))
+	return ctx.EventManager().EmitTypedEvent(types.NewEventFeatureFlagSet(flag))
+}
+
+// RemoveFeatureFlag deletes a feature flag, deactivating the feature.
+func (k Keeper) RemoveFeatureFlag(ctx sdk.Context, name string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FeatureFlagKey(name)
+	if !store.Has(key) {
+		return sdkerrors.Wrap(types.ErrFeatureFlagNotFound, name)
+	}
+	store.Delete(key)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventFeatureFlagRemoved(name))
+}
+
+// IterateFeatureFlags processes all feature flags, ordered by name, with the given handler function.
+func (k Keeper) IterateFeatureFlags(ctx sdk.Context, handler func(types.FeatureFlag) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FeatureFlagKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var flag types.FeatureFlag
+		k.cdc.MustUnmarshal(it.Value(), &flag)
+		if handler(flag) {
+			break
+		}
+	}
+}
+
+// GetAllFeatureFlags returns all feature flags, ordered by name.
+func (k Keeper) GetAllFeatureFlags(ctx sdk.Context) []types.FeatureFlag {
+	flags := []types.FeatureFlag{}
+	k.IterateFeatureFlags(ctx, func(flag types.FeatureFlag) bool {
+		flags = append(flags, flag)
+		return false
+	})
+	return flags
+}
+
+// IsFeatureActive returns true if the named feature has been activated by governance at or before the current block
+// height.  Features without a flag are not active.
+func (k Keeper) IsFeatureActive(ctx sdk.Context, name string) bool {
+	flag := k.GetFeatureFlag(ctx, name)
+	return flag != nil && flag.IsActive(ctx.BlockHeight())
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/featureflags/keeper"
+	"github.com/provenance-io/provenance/x/featureflags/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app    *simapp.App
+	ctx    sdk.Context
+	keeper keeper.Keeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = simapp.Setup(false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Height: 100})
+	s.keeper = s.app.FeatureFlagsKeeper
+}
+
+func (s *KeeperTestSuite) TestSetRemoveFeatureFlag() {
+	s.Run("invalid flags are not stored", func() {
+		s.Require().Error(s.keeper.SetFeatureFlag(s.ctx, types.NewFeatureFlag("nomodule", 10)))
+		s.Require().Error(s.keeper.SetFeatureFlag(s.ctx, types.NewFeatureFlag("marker.feature", 0)))
+		s.Require().Empty(s.keeper.GetAllFeatureFlags(s.ctx))
+	})
+	s.Run("flags are returned ordered by name", func() {
+		s.Require().NoError(s.keeper.SetFeatureFlag(s.ctx, types.NewFeatureFlag("metadata.feature", 200)))
+		s.Require().NoError(s.keeper.SetFeatureFlag(s.ctx, types.NewFeatureFlag("marker.feature", 50)))
+		flags := s.keeper.GetAllFeatureFlags(s.ctx)
+		s.Require().Len(flags, 2)
+		s.Require().Equal("marker.feature", flags[0].Name)
+		s.Require().Equal("metadata.feature", flags[1].Name)
+	})
+	s.Run("unknown flags cannot be removed", func() {
+		s.Require().ErrorIs(s.keeper.RemoveFeatureFlag(s.ctx, "marker.unknown"), types.ErrFeatureFlagNotFound)
+	})
+	s.Run("removed flags are gone", func() {
+		s.Require().NoError(s.keeper.RemoveFeatureFlag(s.ctx, "metadata.feature"))
+		s.Require().Nil(s.keeper.GetFeatureFlag(s.ctx, "metadata.feature"))
+	})
+}
+
+func (s *KeeperTestSuite) TestIsFeatureActive() {
+	s.Require().NoError(s.keeper.SetFeatureFlag(s.ctx, types.NewFeatureFlag("marker.active", 100)))
+	s.Require().NoError(s.keeper.SetFeatureFlag(s.ctx, types.NewFeatureFlag("metadata.pending", 101)))
+
+	s.Require().True(s.keeper.IsFeatureActive(s.ctx, "marker.active"))
+	s.Require().False(s.keeper.IsFeatureActive(s.ctx, "metadata.pending"))
+	s.Require().False(s.keeper.IsFeatureActive(s.ctx, "marker.unset"))
+	s.Require().True(s.keeper.IsFeatureActive(s.ctx.WithBlockHeight(101), "metadata.pending"))
+
+	s.Require().True(s.app.MarkerKeeper.IsFeatureActive(s.ctx, "marker.active"))
+	s.Require().False(s.app.MetadataKeeper.IsFeatureActive(s.ctx, "metadata.pending"))
+}
+
+func (s *KeeperTestSuite) TestHandleSetFeatureFlagProposal() {
+	proposal := types.NewSetFeatureFlagProposal("title", "description", "marker.feature", 150)
+	s.Require().NoError(keeper.HandleSetFeatureFlagProposal(s.ctx, s.keeper, proposal))
+	flag := s.keeper.GetFeatureFlag(s.ctx, "marker.feature")
+	s.Require().NotNil(flag)
+	s.Require().Equal(int64(150), flag.ActivationHeight)
+
+	proposal.ActivationHeight = 0
+	s.Require().NoError(keeper.HandleSetFeatureFlagProposal(s.ctx, s.keeper, proposal))
+	s.Require().Nil(s.keeper.GetFeatureFlag(s.ctx, "marker.feature"))
+	s.Require().ErrorIs(keeper.HandleSetFeatureFlagProposal(s.ctx, s.keeper, proposal), types.ErrFeatureFlagNotFound)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	genesis := types.NewGenesisState([]types.FeatureFlag{
+		types.NewFeatureFlag("marker.feature", 10),
+		types.NewFeatureFlag("metadata.feature", 20),
+	})
+	s.keeper.InitGenesis(s.ctx, genesis)
+	s.Require().Equal(genesis, s.keeper.ExportGenesis(s.ctx))
+
+	dupe := types.NewGenesisState([]types.FeatureFlag{
+		types.NewFeatureFlag("marker.feature", 10),
+		types.NewFeatureFlag("marker.feature", 20),
+	})
+	s.Require().Panics(func() { s.keeper.InitGenesis(s.ctx, dupe) })
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/featureflags/types"
+)
+
+// HandleSetFeatureFlagProposal is a handler for executing a passed set feature flag proposal
+func HandleSetFeatureFlagProposal(ctx sdk.Context, k Keeper, p *types.SetFeatureFlagProposal) error {
+	if p.ActivationHeight == 0 {
+		if err := k.RemoveFeatureFlag(ctx, p.Name); err != nil {
+			return err
+		}
+		k.Logger(ctx).Info(fmt.Sprintf("set feature flag proposal: removed %s", p.Name))
+		return nil
+	}
+	if err := k.SetFeatureFlag(ctx, types.NewFeatureFlag(p.Name, p.ActivationHeight)); err != nil {
+		return err
+	}
+	k.Logger(ctx).Info(fmt.Sprintf("set feature flag proposal: %s activates at height %d", p.Name, p.ActivationHeight))
+	return nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/featureflags/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// FeatureFlags queries for all feature flags
+func (k Keeper) FeatureFlags(c context.Context, _ *types.QueryFeatureFlagsRequest) (*types.QueryFeatureFlagsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryFeatureFlagsResponse{Flags: k.GetAllFeatureFlags(ctx)}, nil
+}
+
+// FeatureFlag queries for a feature flag and whether it is active at the current height
+func (k Keeper) FeatureFlag(c context.Context, req *types.QueryFeatureFlagRequest) (*types.QueryFeatureFlagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	flag := k.GetFeatureFlag(ctx, req.Name)
+	if flag == nil {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(types.ErrFeatureFlagNotFound, req.Name).Error())
+	}
+	return &types.QueryFeatureFlagResponse{Flag: *flag, Active: flag.IsActive(ctx.BlockHeight())}, nil
+}
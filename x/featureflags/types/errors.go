@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/featureflags module errors
+var (
+	// ErrInvalidFeatureFlag occurs when a feature flag is not valid
+	ErrInvalidFeatureFlag = sdkerrors.Register(ModuleName, 2, "invalid feature flag")
+	// ErrFeatureFlagNotFound occurs when a feature flag does not exist
+	ErrFeatureFlagNotFound = sdkerrors.Register(ModuleName, 3, "feature flag not found")
+)
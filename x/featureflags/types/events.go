@@ -0,0 +1,18 @@
+package types
+
+import (
+	"strconv"
+)
+
+func NewEventFeatureFlagSet(flag FeatureFlag) *EventFeatureFlagSet {
+	return &EventFeatureFlagSet{
+		Name:             flag.Name,
+		ActivationHeight: strconv.FormatInt(flag.ActivationHeight, 10),
+	}
+}
+
+func NewEventFeatureFlagRemoved(name string) *EventFeatureFlagRemoved {
+	return &EventFeatureFlagRemoved{
+		Name: name,
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// featureFlagNameRegex matches feature flag names: a module name and one or more dot separated lowercase segments.
+var featureFlagNameRegex = regexp.MustCompile(`^[a-z][a-z0-9]*(\.[a-z0-9][a-z0-9_-]*)+$`)
+
+// MaxFeatureFlagNameLength is the maximum length of a feature flag name.
+const MaxFeatureFlagNameLength = 64
+
+// NewFeatureFlag creates a new feature flag that is active from the given height.
+func NewFeatureFlag(name string, activationHeight int64) FeatureFlag {
+	return FeatureFlag{
+		Name:             name,
+		ActivationHeight: activationHeight,
+	}
+}
+
+// ValidateFeatureFlagName returns an error if the name is not a valid feature flag name.
+func ValidateFeatureFlagName(name string) error {
+	if len(name) > MaxFeatureFlagNameLength {
+		return fmt.Errorf("feature flag name %q exceeds %d characters", name, MaxFeatureFlagNameLength)
+	}
+	if !featureFlagNameRegex.MatchString(name) {
+		return fmt.Errorf("feature flag name %q must be a module name followed by dot separated lowercase segments", name)
+	}
+	return nil
+}
+
+// ValidateBasic performs basic stateless validity checks on a feature flag.
+func (f FeatureFlag) ValidateBasic() error {
+	if err := ValidateFeatureFlagName(f.Name); err != nil {
+		return err
+	}
+	if f.ActivationHeight <= 0 {
+		return fmt.Errorf("activation height of feature flag %s must be positive: %d", f.Name, f.ActivationHeight)
+	}
+	return nil
+}
+
+// IsActive returns true if the feature is active at the given block height.
+func (f FeatureFlag) IsActive(height int64) bool {
+	return height >= f.ActivationHeight
+}
@@ -0,0 +1,1039 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/featureflags/v1/featureflags.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeatureFlag is a named consensus behavior that is active from its activation height onwards.
+type FeatureFlag struct {
+	// The name of the feature, prefixed with the module that consults it, e.g. marker.example-feature
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The block height from which the feature is active
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *FeatureFlag) Reset()         { *m = FeatureFlag{} }
+func (m *FeatureFlag) String() string { return proto.CompactTextString(m) }
+func (*FeatureFlag) ProtoMessage()    {}
+func (*FeatureFlag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90cd81ed26732933, []int{0}
+}
+func (m *FeatureFlag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeatureFlag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeatureFlag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeatureFlag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureFlag.Merge(m, src)
+}
+func (m *FeatureFlag) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeatureFlag) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureFlag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureFlag proto.InternalMessageInfo
+
+func (m *FeatureFlag) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FeatureFlag) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+// SetFeatureFlagProposal details a proposal to set the activation height of a feature flag.  An activation height of
+// zero removes the flag, deactivating the feature.
+type SetFeatureFlagProposal struct {
+	Title            string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Name             string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ActivationHeight int64  `protobuf:"varint,4,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *SetFeatureFlagProposal) Reset()      { *m = SetFeatureFlagProposal{} }
+func (*SetFeatureFlagProposal) ProtoMessage() {}
+func (*SetFeatureFlagProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90cd81ed26732933, []int{1}
+}
+func (m *SetFeatureFlagProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetFeatureFlagProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetFeatureFlagProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetFeatureFlagProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFeatureFlagProposal.Merge(m, src)
+}
+func (m *SetFeatureFlagProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetFeatureFlagProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFeatureFlagProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFeatureFlagProposal proto.InternalMessageInfo
+
+// Event emitted when the activation height of a feature flag is set.
+type EventFeatureFlagSet struct {
+	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ActivationHeight string `protobuf:"bytes,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *EventFeatureFlagSet) Reset()         { *m = EventFeatureFlagSet{} }
+func (m *EventFeatureFlagSet) String() string { return proto.CompactTextString(m) }
+func (*EventFeatureFlagSet) ProtoMessage()    {}
+func (*EventFeatureFlagSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90cd81ed26732933, []int{2}
+}
+func (m *EventFeatureFlagSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeatureFlagSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeatureFlagSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeatureFlagSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeatureFlagSet.Merge(m, src)
+}
+func (m *EventFeatureFlagSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeatureFlagSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeatureFlagSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeatureFlagSet proto.InternalMessageInfo
+
+func (m *EventFeatureFlagSet) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventFeatureFlagSet) GetActivationHeight() string {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return ""
+}
+
+// Event emitted when a feature flag is removed.
+type EventFeatureFlagRemoved struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *EventFeatureFlagRemoved) Reset()         { *m = EventFeatureFlagRemoved{} }
+func (m *EventFeatureFlagRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventFeatureFlagRemoved) ProtoMessage()    {}
+func (*EventFeatureFlagRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90cd81ed26732933, []int{3}
+}
+func (m *EventFeatureFlagRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeatureFlagRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeatureFlagRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeatureFlagRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeatureFlagRemoved.Merge(m, src)
+}
+func (m *EventFeatureFlagRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeatureFlagRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeatureFlagRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeatureFlagRemoved proto.InternalMessageInfo
+
+func (m *EventFeatureFlagRemoved) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*FeatureFlag)(nil), "provenance.featureflags.v1.FeatureFlag")
+	proto.RegisterType((*SetFeatureFlagProposal)(nil), "provenance.featureflags.v1.SetFeatureFlagProposal")
+	proto.RegisterType((*EventFeatureFlagSet)(nil), "provenance.featureflags.v1.EventFeatureFlagSet")
+	proto.RegisterType((*EventFeatureFlagRemoved)(nil), "provenance.featureflags.v1.EventFeatureFlagRemoved")
+}
+
+func init() {
+	proto.RegisterFile("provenance/featureflags/v1/featureflags.proto", fileDescriptor_90cd81ed26732933)
+}
+
+var fileDescriptor_90cd81ed26732933 = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x4b, 0x02, 0x41,
+	0x14, 0xc7, 0x67, 0xd2, 0x02, 0xc7, 0x0e, 0x35, 0x49, 0x89, 0xd0, 0x28, 0x9e, 0x84, 0x70, 0x17,
+	0xe9, 0x12, 0x1d, 0x83, 0xa4, 0x53, 0xc8, 0x0a, 0x1d, 0xba, 0xc4, 0xb8, 0x3e, 0xc7, 0x81, 0x75,
+	0xdf, 0xb2, 0x3b, 0x0e, 0xf5, 0x0d, 0x3a, 0x76, 0xec, 0x96, 0x1f, 0xa7, 0xa3, 0xc7, 0x8e, 0xa1,
+	0x97, 0x3e, 0x46, 0xb8, 0x52, 0xae, 0x61, 0x44, 0xb7, 0xf7, 0x7f, 0xef, 0x3f, 0x3f, 0xfe, 0x8f,
+	0x37, 0xac, 0x19, 0xc5, 0x68, 0x21, 0x94, 0xa1, 0x0f, 0xee, 0x00, 0xa4, 0x19, 0xc7, 0x30, 0x08,
+	0xa4, 0x4a, 0x5c, 0xdb, 0x5a, 0xd3, 0x4e, 0x14, 0xa3, 0x41, 0x5e, 0x59, 0xd9, 0x9d, 0xb5, 0xb1,
+	0x6d, 0x55, 0x4a, 0x0a, 0x15, 0xa6, 0x36, 0x77, 0x51, 0x2d, 0x5f, 0xd4, 0xaf, 0x59, 0xb1, 0xbd,
+	0x34, 0xb6, 0x03, 0xa9, 0x38, 0x67, 0xf9, 0x50, 0x8e, 0xa0, 0x4c, 0x6b, 0xb4, 0x51, 0xf0, 0xd2,
+	0x9a, 0x9f, 0xb0, 0x7d, 0xe9, 0x1b, 0x6d, 0xa5, 0xd1, 0x18, 0xde, 0x0d, 0x41, 0xab, 0xa1, 0x29,
+	0x6f, 0xd5, 0x68, 0x23, 0xe7, 0xed, 0xad, 0x06, 0x57, 0x69, 0xbf, 0xfe, 0x42, 0xd9, 0x61, 0x17,
+	0x4c, 0x86, 0xd9, 0x89, 0x31, 0xc2, 0x44, 0x06, 0xbc, 0xc4, 0xb6, 0x8d, 0x36, 0xc1, 0x17, 0x7c,
+	0x29, 0x78, 0x8d, 0x15, 0xfb, 0x90, 0xf8, 0xb1, 0x8e, 0x16, 0x94, 0x94, 0x5b, 0xf0, 0xb2, 0xad,
+	0xef, 0x4c, 0xb9, 0xbf, 0x32, 0xe5, 0x37, 0x67, 0x3a, 0xdf, 0x7d, 0x9c, 0x54, 0xc9, 0xf3, 0xa4,
+	0x4a, 0x3e, 0x26, 0x55, 0x52, 0xbf, 0x61, 0x07, 0x97, 0x16, 0xc2, 0x6c, 0xc4, 0x2e, 0x98, 0xff,
+	0x6d, 0x5e, 0xd8, 0xb0, 0x79, 0x93, 0x1d, 0xfd, 0xe4, 0x7a, 0x30, 0x42, 0x0b, 0xfd, 0x4d, 0xec,
+	0x8b, 0xe4, 0x75, 0x26, 0xe8, 0x74, 0x26, 0xe8, 0xfb, 0x4c, 0xd0, 0xa7, 0xb9, 0x20, 0xd3, 0xb9,
+	0x20, 0x6f, 0x73, 0x41, 0xd8, 0xb1, 0x46, 0xe7, 0xf7, 0x3b, 0x76, 0xe8, 0xed, 0x99, 0xd2, 0x66,
+	0x38, 0xee, 0x39, 0x3e, 0x8e, 0xdc, 0x95, 0xb1, 0xa9, 0x31, 0xa3, 0xdc, 0xfb, 0xf5, 0xff, 0x62,
+	0x1e, 0x22, 0x48, 0x7a, 0x3b, 0xe9, 0xd1, 0x4f, 0x3f, 0x07, 0x00, 0x22, 0xec, 0xaa, 0x26, 0x57,
+	0x02, 0x00, 0x00,
+}
+
+func (m *FeatureFlag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeatureFlag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeatureFlag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintFeatureflags(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintFeatureflags(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetFeatureFlagProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetFeatureFlagProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetFeatureFlagProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintFeatureflags(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintFeatureflags(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintFeatureflags(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintFeatureflags(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeatureFlagSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeatureFlagSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeatureFlagSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActivationHeight) > 0 {
+		i -= len(m.ActivationHeight)
+		copy(dAtA[i:], m.ActivationHeight)
+		i = encodeVarintFeatureflags(dAtA, i, uint64(len(m.ActivationHeight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintFeatureflags(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeatureFlagRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeatureFlagRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeatureFlagRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintFeatureflags(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeatureflags(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeatureflags(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeatureFlag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFeatureflags(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovFeatureflags(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *SetFeatureFlagProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovFeatureflags(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovFeatureflags(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFeatureflags(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovFeatureflags(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *EventFeatureFlagSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFeatureflags(uint64(l))
+	}
+	l = len(m.ActivationHeight)
+	if l > 0 {
+		n += 1 + l + sovFeatureflags(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFeatureFlagRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFeatureflags(uint64(l))
+	}
+	return n
+}
+
+func sovFeatureflags(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeatureflags(x uint64) (n int) {
+	return sovFeatureflags(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeatureFlag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeatureflags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeatureFlag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeatureFlag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeatureflags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeatureflags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeatureflags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetFeatureFlagProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeatureflags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetFeatureFlagProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetFeatureFlagProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeatureflags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeatureflags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeatureflags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeatureflags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeatureflags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFeatureFlagSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeatureflags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeatureFlagSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeatureFlagSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeatureflags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeatureflags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivationHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeatureflags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFeatureFlagRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeatureflags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeatureFlagRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeatureFlagRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeatureflags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeatureflags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeatureflags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeatureflags(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeatureflags
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeatureflags
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeatureflags
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeatureflags
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeatureflags
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeatureflags
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeatureflags        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeatureflags          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeatureflags = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(flags []FeatureFlag) *GenesisState {
+	return &GenesisState{
+		Flags: flags,
+	}
+}
+
+// ValidateBasic ensures a genesis state is valid.
+func (state GenesisState) ValidateBasic() error {
+	seen := make(map[string]bool, len(state.Flags))
+	for _, f := range state.Flags {
+		if err := f.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[f.Name] {
+			return fmt.Errorf("duplicate feature flag %s", f.Name)
+		}
+		seen[f.Name] = true
+	}
+	return nil
+}
+
+// DefaultGenesisState returns the default module state at genesis.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Flags: []FeatureFlag{},
+	}
+}
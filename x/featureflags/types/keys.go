@@ -0,0 +1,25 @@
+package types
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "featureflags"
+
+	// StoreKey is the store key string for featureflags
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for featureflags
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for featureflags
+	QuerierRoute = ModuleName
+)
+
+var (
+	// FeatureFlagKeyPrefix is a prefix added to keys for storing feature flags.
+	FeatureFlagKeyPrefix = []byte{0x01}
+)
+
+// FeatureFlagKey returns the key for a feature flag.
+func FeatureFlagKey(name string) []byte {
+	return append(append([]byte{}, FeatureFlagKeyPrefix...), []byte(name)...)
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSetFeatureFlag defines the type for a SetFeatureFlagProposal
+	ProposalTypeSetFeatureFlag = "SetFeatureFlag"
+)
+
+// Assert SetFeatureFlagProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &SetFeatureFlagProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetFeatureFlag)
+	govtypes.RegisterProposalTypeCodec(&SetFeatureFlagProposal{}, "provenance/featureflags/SetFeatureFlagProposal")
+}
+
+// NewSetFeatureFlagProposal creates a new governance proposal to set the activation height of a feature flag
+func NewSetFeatureFlagProposal(title, description, name string, activationHeight int64) *SetFeatureFlagProposal {
+	return &SetFeatureFlagProposal{
+		Title:            title,
+		Description:      description,
+		Name:             name,
+		ActivationHeight: activationHeight,
+	}
+}
+
+// GetTitle returns the title of a set feature flag proposal.
+func (p SetFeatureFlagProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a set feature flag proposal.
+func (p SetFeatureFlagProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a set feature flag proposal.
+func (p SetFeatureFlagProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set feature flag proposal.
+func (p SetFeatureFlagProposal) ProposalType() string { return ProposalTypeSetFeatureFlag }
+
+// ValidateBasic runs basic stateless validity checks
+func (p SetFeatureFlagProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateFeatureFlagName(p.Name); err != nil {
+		return err
+	}
+	if p.ActivationHeight < 0 {
+		return fmt.Errorf("activation height cannot be negative: %d", p.ActivationHeight)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p SetFeatureFlagProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Feature Flag Proposal:
+  Title:             %s
+  Description:       %s
+  Name:              %s
+  Activation Height: %d
+`, p.Title, p.Description, p.Name, p.ActivationHeight))
+	return b.String()
+}
@@ -0,0 +1,959 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/featureflags/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryFeatureFlagsRequest is the request type for the Query/FeatureFlags method.
+type QueryFeatureFlagsRequest struct {
+}
+
+func (m *QueryFeatureFlagsRequest) Reset()         { *m = QueryFeatureFlagsRequest{} }
+func (m *QueryFeatureFlagsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeatureFlagsRequest) ProtoMessage()    {}
+func (*QueryFeatureFlagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc2f90ff74394461, []int{0}
+}
+func (m *QueryFeatureFlagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeatureFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeatureFlagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeatureFlagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeatureFlagsRequest.Merge(m, src)
+}
+func (m *QueryFeatureFlagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeatureFlagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeatureFlagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeatureFlagsRequest proto.InternalMessageInfo
+
+// QueryFeatureFlagsResponse is the response type for the Query/FeatureFlags method.
+type QueryFeatureFlagsResponse struct {
+	// the feature flags, ordered by name
+	Flags []FeatureFlag `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags"`
+}
+
+func (m *QueryFeatureFlagsResponse) Reset()         { *m = QueryFeatureFlagsResponse{} }
+func (m *QueryFeatureFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeatureFlagsResponse) ProtoMessage()    {}
+func (*QueryFeatureFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc2f90ff74394461, []int{1}
+}
+func (m *QueryFeatureFlagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeatureFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeatureFlagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeatureFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeatureFlagsResponse.Merge(m, src)
+}
+func (m *QueryFeatureFlagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeatureFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeatureFlagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeatureFlagsResponse proto.InternalMessageInfo
+
+func (m *QueryFeatureFlagsResponse) GetFlags() []FeatureFlag {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+// QueryFeatureFlagRequest is the request type for the Query/FeatureFlag method.
+type QueryFeatureFlagRequest struct {
+	// name of the feature flag
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryFeatureFlagRequest) Reset()         { *m = QueryFeatureFlagRequest{} }
+func (m *QueryFeatureFlagRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeatureFlagRequest) ProtoMessage()    {}
+func (*QueryFeatureFlagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc2f90ff74394461, []int{2}
+}
+func (m *QueryFeatureFlagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeatureFlagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeatureFlagRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeatureFlagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeatureFlagRequest.Merge(m, src)
+}
+func (m *QueryFeatureFlagRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeatureFlagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeatureFlagRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeatureFlagRequest proto.InternalMessageInfo
+
+// QueryFeatureFlagResponse is the response type for the Query/FeatureFlag method.
+type QueryFeatureFlagResponse struct {
+	// the feature flag
+	Flag FeatureFlag `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag"`
+	// whether the feature is active at the current height
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (m *QueryFeatureFlagResponse) Reset()         { *m = QueryFeatureFlagResponse{} }
+func (m *QueryFeatureFlagResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeatureFlagResponse) ProtoMessage()    {}
+func (*QueryFeatureFlagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc2f90ff74394461, []int{3}
+}
+func (m *QueryFeatureFlagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeatureFlagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeatureFlagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeatureFlagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeatureFlagResponse.Merge(m, src)
+}
+func (m *QueryFeatureFlagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeatureFlagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeatureFlagResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeatureFlagResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryFeatureFlagsRequest)(nil), "provenance.featureflags.v1.QueryFeatureFlagsRequest")
+	proto.RegisterType((*QueryFeatureFlagsResponse)(nil), "provenance.featureflags.v1.QueryFeatureFlagsResponse")
+	proto.RegisterType((*QueryFeatureFlagRequest)(nil), "provenance.featureflags.v1.QueryFeatureFlagRequest")
+	proto.RegisterType((*QueryFeatureFlagResponse)(nil), "provenance.featureflags.v1.QueryFeatureFlagResponse")
+}
+
+func init() {
+	proto.RegisterFile("provenance/featureflags/v1/query.proto", fileDescriptor_cc2f90ff74394461)
+}
+
+var fileDescriptor_cc2f90ff74394461 = []byte{
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3d, 0x6b, 0xdb, 0x40,
+	0x18, 0xc7, 0x75, 0xae, 0x6d, 0xdc, 0x73, 0xa7, 0xa3, 0xb4, 0xaa, 0x68, 0x65, 0x57, 0x85, 0x56,
+	0x2d, 0x58, 0x57, 0xbf, 0x94, 0x96, 0x6e, 0x75, 0xc1, 0x73, 0xab, 0xb1, 0x53, 0xcf, 0xe6, 0xac,
+	0x0a, 0x6c, 0x9d, 0x2c, 0x9d, 0x44, 0x4d, 0x29, 0x81, 0x4c, 0x19, 0x03, 0xf9, 0x02, 0x9e, 0x32,
+	0xe4, 0x93, 0x78, 0x34, 0x64, 0xc9, 0x92, 0x10, 0xec, 0x0c, 0xf9, 0x18, 0x41, 0x27, 0x25, 0x96,
+	0x93, 0x58, 0x41, 0xdb, 0x89, 0xe7, 0xff, 0xf2, 0xbb, 0x7b, 0x10, 0x7c, 0xeb, 0x7a, 0x2c, 0xa4,
+	0x0e, 0x71, 0x06, 0x14, 0x0f, 0x29, 0xe1, 0x81, 0x47, 0x87, 0x23, 0x62, 0xf9, 0x38, 0x6c, 0xe2,
+	0x49, 0x40, 0xbd, 0xa9, 0xe1, 0x7a, 0x8c, 0x33, 0xa4, 0xac, 0x75, 0x46, 0x5a, 0x67, 0x84, 0x4d,
+	0xe5, 0xa9, 0xc5, 0x2c, 0x26, 0x64, 0x38, 0x3a, 0xc5, 0x0e, 0xe5, 0xa5, 0xc5, 0x98, 0x35, 0xa2,
+	0x98, 0xb8, 0x36, 0x26, 0x8e, 0xc3, 0x38, 0xe1, 0x36, 0x73, 0xfc, 0x64, 0xda, 0xc8, 0xe8, 0xdd,
+	0xc8, 0x17, 0x72, 0x4d, 0x81, 0xf2, 0xcf, 0x88, 0xa6, 0x17, 0x8f, 0x7a, 0xd1, 0xc8, 0xa4, 0x93,
+	0x80, 0xfa, 0x5c, 0xfb, 0x0d, 0x5f, 0xdc, 0x33, 0xf3, 0x5d, 0xe6, 0xf8, 0x14, 0x7d, 0x87, 0x25,
+	0x91, 0x23, 0x83, 0xfa, 0x23, 0xbd, 0xda, 0x7a, 0x67, 0x6c, 0xbf, 0x87, 0x91, 0x0a, 0xe8, 0x16,
+	0xe7, 0x67, 0x35, 0xc9, 0x8c, 0xbd, 0xda, 0x67, 0xf8, 0xfc, 0x76, 0x43, 0x52, 0x8e, 0x10, 0x2c,
+	0x3a, 0x64, 0x4c, 0x65, 0x50, 0x07, 0xfa, 0x63, 0x53, 0x9c, 0xbf, 0x56, 0xf6, 0x66, 0x35, 0xe9,
+	0x72, 0x56, 0x93, 0xb4, 0x9d, 0xbb, 0xd8, 0x37, 0x64, 0xdf, 0x60, 0x31, 0x4a, 0x17, 0xce, 0xdc,
+	0x60, 0xc2, 0x8a, 0x9e, 0xc1, 0x32, 0x19, 0x70, 0x3b, 0xa4, 0x72, 0xa1, 0x0e, 0xf4, 0x8a, 0x99,
+	0x7c, 0xad, 0x01, 0x5a, 0xa7, 0x05, 0x58, 0x12, 0x04, 0xe8, 0x10, 0xc0, 0x27, 0xe9, 0x17, 0x42,
+	0x9d, 0xac, 0xc6, 0x6d, 0x8f, 0xad, 0x7c, 0xca, 0xe9, 0x8a, 0x2f, 0xab, 0xbd, 0xdf, 0x3d, 0xbe,
+	0x38, 0x28, 0xbc, 0x41, 0xaf, 0x71, 0xd6, 0xde, 0x05, 0xd7, 0x11, 0x80, 0xd5, 0x54, 0x06, 0x6a,
+	0xe7, 0x69, 0xbc, 0xc6, 0xec, 0xe4, 0x33, 0x25, 0x94, 0x1f, 0x05, 0xe5, 0x07, 0xa4, 0x3f, 0x48,
+	0x89, 0xff, 0x45, 0x9b, 0xfe, 0xdf, 0xf5, 0xe7, 0x4b, 0x15, 0x2c, 0x96, 0x2a, 0x38, 0x5f, 0xaa,
+	0x60, 0x7f, 0xa5, 0x4a, 0x8b, 0x95, 0x2a, 0x9d, 0xac, 0x54, 0x09, 0xbe, 0xb2, 0x59, 0x06, 0xc3,
+	0x0f, 0xf0, 0xeb, 0x8b, 0x65, 0xf3, 0x3f, 0x41, 0xdf, 0x18, 0xb0, 0x71, 0xaa, 0xae, 0x61, 0xb3,
+	0x74, 0xf9, 0xdf, 0xcd, 0x7a, 0x3e, 0x75, 0xa9, 0xdf, 0x2f, 0x8b, 0x7f, 0xa2, 0x7d, 0x35, 0x00,
+	0x27, 0x88, 0x09, 0x17, 0xbc, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// FeatureFlags queries for all feature flags.
+	FeatureFlags(ctx context.Context, in *QueryFeatureFlagsRequest, opts ...grpc.CallOption) (*QueryFeatureFlagsResponse, error)
+	// FeatureFlag queries for a feature flag and whether it is active at the current height.
+	FeatureFlag(ctx context.Context, in *QueryFeatureFlagRequest, opts ...grpc.CallOption) (*QueryFeatureFlagResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) FeatureFlags(ctx context.Context, in *QueryFeatureFlagsRequest, opts ...grpc.CallOption) (*QueryFeatureFlagsResponse, error) {
+	out := new(QueryFeatureFlagsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.featureflags.v1.Query/FeatureFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeatureFlag(ctx context.Context, in *QueryFeatureFlagRequest, opts ...grpc.CallOption) (*QueryFeatureFlagResponse, error) {
+	out := new(QueryFeatureFlagResponse)
+	err := c.cc.Invoke(ctx, "/provenance.featureflags.v1.Query/FeatureFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// FeatureFlags queries for all feature flags.
+	FeatureFlags(context.Context, *QueryFeatureFlagsRequest) (*QueryFeatureFlagsResponse, error)
+	// FeatureFlag queries for a feature flag and whether it is active at the current height.
+	FeatureFlag(context.Context, *QueryFeatureFlagRequest) (*QueryFeatureFlagResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) FeatureFlags(ctx context.Context, req *QueryFeatureFlagsRequest) (*QueryFeatureFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeatureFlags not implemented")
+}
+func (*UnimplementedQueryServer) FeatureFlag(ctx context.Context, req *QueryFeatureFlagRequest) (*QueryFeatureFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeatureFlag not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_FeatureFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeatureFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeatureFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.featureflags.v1.Query/FeatureFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeatureFlags(ctx, req.(*QueryFeatureFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeatureFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeatureFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.featureflags.v1.Query/FeatureFlag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeatureFlag(ctx, req.(*QueryFeatureFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.featureflags.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FeatureFlags",
+			Handler:    _Query_FeatureFlags_Handler,
+		},
+		{
+			MethodName: "FeatureFlag",
+			Handler:    _Query_FeatureFlag_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/featureflags/v1/query.proto",
+}
+
+func (m *QueryFeatureFlagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeatureFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeatureFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeatureFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeatureFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeatureFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Flags) > 0 {
+		for iNdEx := len(m.Flags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeatureFlagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeatureFlagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeatureFlagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeatureFlagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeatureFlagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeatureFlagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Flag.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFeatureFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeatureFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Flags) > 0 {
+		for _, e := range m.Flags {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeatureFlagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeatureFlagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Flag.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryFeatureFlagsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeatureFlagsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeatureFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeatureFlagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeatureFlagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeatureFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flags = append(m.Flags, FeatureFlag{})
+			if err := m.Flags[len(m.Flags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeatureFlagRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeatureFlagRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeatureFlagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeatureFlagResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeatureFlagResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeatureFlagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Flag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -19,7 +20,9 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper,
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	// Iterate through all marker accounts and check for supply above or below expected targets.
 	var err error
+	statusCounts := make(map[types.MarkerStatus]int)
 	k.IterateMarkers(ctx, func(record types.MarkerAccountI) bool {
+		statusCounts[record.GetStatus()]++
 		// Supply checks are only done against active markers with a fixed supply.
 		if record.GetStatus() == types.StatusActive && record.HasFixedSupply() {
 			requiredSupply := record.GetSupply()
@@ -37,7 +40,7 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper,
 		// Clear out markers that are in the destroyed status
 		if record.GetStatus() == types.StatusDestroyed {
 			k.RemoveMarker(ctx, record)
-			ctx.EventManager().EmitEvent(
+			k.EmitLegacyEvent(ctx,
 				sdk.NewEvent(
 					"beginblock",
 					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -45,6 +48,9 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper,
 					sdk.NewAttribute(types.EventAttributeDenomKey, record.GetDenom()),
 				),
 			)
+			if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRemoved(record.GetDenom())); err != nil {
+				return true
+			}
 		}
 		return err != nil
 	})
@@ -52,4 +58,28 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper,
 	if err != nil {
 		panic(err)
 	}
+
+	// Publish the number of markers in each status.
+	for status := types.StatusProposed; status <= types.StatusDestroyed; status++ {
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, types.EventTelemetryKeyMarkers},
+			float32(statusCounts[status]),
+			[]metrics.Label{telemetry.NewLabel(types.EventTelemetryLabelStatus, status.String())},
+		)
+	}
+
+	// Lift an expired pause of restricted marker transfers.
+	if pause := k.GetTransferPause(ctx); pause != nil && !pause.IsActive(ctx.BlockHeight()) {
+		k.RemoveTransferPause(ctx)
+		ctx.Logger().Info(fmt.Sprintf("restricted marker transfer pause expired at height %d", pause.ExpiryHeight))
+	}
+}
+
+// EndBlocker returns the end blocker for the marker module.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+	// Snapshot the holders of distributions scheduled at this height and close those whose claim window has ended.
+	k.ProcessDistributions(ctx)
+	// Mint the new denom to the next batch of holders of each denom migration in progress.
+	k.ProcessDenomMigrations(ctx)
 }
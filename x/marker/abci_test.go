@@ -67,3 +67,54 @@ func TestBeginBlocker(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, deleted)
 }
+
+func TestBeginBlockerRemovesExpiredTransferPause(t *testing.T) {
+	app := app.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(5)
+
+	app.MarkerKeeper.SetTransferPause(ctx, *types.NewTransferPause(nil, 10, false))
+
+	// The pause remains until the expiry height is reached.
+	marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+	require.NotNil(t, app.MarkerKeeper.GetTransferPause(ctx))
+
+	ctx = ctx.WithBlockHeight(10)
+	marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+	require.Nil(t, app.MarkerKeeper.GetTransferPause(ctx))
+}
+
+func TestBeginBlockerRemovedMarkerEvents(t *testing.T) {
+	for _, emitLegacy := range []bool{true, false} {
+		app := app.Setup(false)
+		ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithEventManager(sdk.NewEventManager())
+
+		params := app.MarkerKeeper.GetParams(ctx)
+		params.EmitLegacyEvents = emitLegacy
+		app.MarkerKeeper.SetParams(ctx, params)
+
+		app.MarkerKeeper.SetMarker(ctx, &types.MarkerAccount{
+			BaseAccount: &authtypes.BaseAccount{
+				AccountNumber: 1,
+				Address:       types.MustGetMarkerAddress("testremoved").String(),
+			},
+			Status: types.StatusDestroyed,
+			Denom:  "testremoved",
+			Supply: sdk.ZeroInt(),
+		})
+
+		marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+
+		events := ctx.EventManager().ABCIEvents()
+		var legacyFound bool
+		for _, e := range events {
+			if e.Type == "beginblock" {
+				legacyFound = true
+			}
+		}
+		require.Equal(t, emitLegacy, legacyFound, "legacy beginblock event emitted")
+		require.NotEmpty(t, events)
+		event, err := sdk.ParseTypedEvent(events[len(events)-1])
+		require.NoError(t, err)
+		require.Equal(t, types.NewEventMarkerRemoved("testremoved"), event)
+	}
+}
@@ -2,6 +2,7 @@ package cli_test
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -392,7 +393,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			[]string{
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":""}`,
+			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"","emit_legacy_events":false}`,
 		},
 		{
 			"get testcoin marker json",
@@ -401,7 +402,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"testcoin",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"11","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"11","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"created_height":"0","created_by":"","created_tx_hash":"","reference":false},"paused":false}`,
 		},
 		{
 			"get testcoin marker test",
@@ -419,12 +420,34 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
     address: cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq
     pub_key: null
     sequence: "0"
+  created_by: ""
+  created_height: "0"
+  created_tx_hash: ""
   denom: testcoin
   manager: ""
   marker_type: MARKER_TYPE_COIN
+  reference: false
   status: MARKER_STATUS_ACTIVE
   supply: "1000"
-  supply_fixed: true`,
+  supply_fixed: true
+paused: false`,
+		},
+		{
+			"get testcoin marker by address json",
+			markercli.MarkerByAddressCmd(),
+			[]string{
+				"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"11","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"created_height":"0","created_by":"","created_tx_hash":"","reference":false},"paused":false}`,
+		},
+		{
+			"query marker by denom instead of address",
+			markercli.MarkerByAddressCmd(),
+			[]string{
+				"testcoin",
+			},
+			"",
 		},
 		{
 			"query non existent marker",
@@ -441,7 +464,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"lockedcoin",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"12","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"12","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false,"created_height":"0","created_by":"","created_tx_hash":"","reference":false},"paused":false}`,
 		},
 		{
 			"query access",
@@ -459,6 +482,14 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			},
 			"escrow: []",
 		},
+		{
+			"query withdraw allowances",
+			markercli.WithdrawAllowancesCmd(),
+			[]string{
+				"testcoin",
+			},
+			"allowances: []\npagination:\n  next_key: null\n  total: \"0\"",
+		},
 		{
 			"query supply",
 			markercli.MarkerSupplyCmd(),
@@ -520,6 +551,19 @@ func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 			},
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"create a new reference marker",
+			markercli.GetCmdAddMarker(),
+			[]string{
+				"0refcoin",
+				fmt.Sprintf("--%s=%s", markercli.FlagManageSupply, "false"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
 		{
 			"fail to create add marker, incorrect allow governance value",
 			markercli.GetCmdAddMarker(),
@@ -751,7 +795,77 @@ func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 			false, &sdk.TxResponse{}, 0,
 		},
 		{
-			"remove access",
+			"set withdraw allowance, fail invalid period",
+			markercli.GetCmdSetWithdrawAllowance(),
+			[]string{
+				"hotdog",
+				s.accountAddresses[0].String(),
+				"100hotdog",
+				"daily",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"set withdraw allowance",
+			markercli.GetCmdSetWithdrawAllowance(),
+			[]string{
+				"hotdog",
+				s.accountAddresses[0].String(),
+				"100hotdog",
+				"24h",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"delete withdraw allowance",
+			markercli.GetCmdDeleteWithdrawAllowance(),
+			[]string{
+				"hotdog",
+				s.accountAddresses[0].String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"schedule distribution, fail invalid snapshot height",
+			markercli.GetCmdScheduleDistribution(),
+			[]string{
+				"hotdog",
+				"100stake",
+				"soon",
+				"100",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"claim distribution, fail invalid id",
+			markercli.GetCmdClaimDistribution(),
+			[]string{
+				"first",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"remove last admin access",
 			markercli.GetCmdDeleteAccess(),
 			[]string{
 				s.testnet.Validators[0].Address.String(),
@@ -761,6 +875,33 @@ func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 			},
+			false, &sdk.TxResponse{}, 4,
+		},
+		{
+			"add access for removal",
+			markercli.GetCmdAddAccess(),
+			[]string{
+				s.accountAddresses[0].String(),
+				"hotdog",
+				"admin",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"remove access",
+			markercli.GetCmdDeleteAccess(),
+			[]string{
+				s.accountAddresses[0].String(),
+				"hotdog",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
 			false, &sdk.TxResponse{}, 0,
 		},
 	}
@@ -904,6 +1045,90 @@ func (s *IntegrationTestSuite) TestMarkerAuthzTxCommands() {
 			},
 			false, &sdk.TxResponse{}, 1,
 		},
+		{
+			"grant authz transfer permissions with an invalid allow list address",
+			markercli.GetCmdGrantAuthorization(),
+			[]string{
+				s.accountAddresses[1].String(),
+				"transfer",
+				fmt.Sprintf("--%s=%s", markercli.FlagTransferLimit, "10authzhotdog"),
+				fmt.Sprintf("--%s=%s", markercli.FlagAllowList, "invalid"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[0].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"grant authz transfer permissions to account 1 for account 0 only to account 2",
+			markercli.GetCmdGrantAuthorization(),
+			[]string{
+				s.accountAddresses[1].String(),
+				"transfer",
+				fmt.Sprintf("--%s=%s", markercli.FlagTransferLimit, "10authzhotdog"),
+				fmt.Sprintf("--%s=%s", markercli.FlagAllowList, s.accountAddresses[2].String()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[0].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"marker transfer failed, recipient not in allow list",
+			markercli.GetNewTransferCmd(),
+			[]string{
+				s.accountAddresses[0].String(),
+				s.accountAddresses[1].String(),
+				"2authzhotdog",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[1].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 4,
+		},
+		{
+			"marker transfer successful, recipient in allow list",
+			markercli.GetNewTransferCmd(),
+			[]string{
+				s.accountAddresses[0].String(),
+				s.accountAddresses[2].String(),
+				"2authzhotdog",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[1].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"grant operator template with an unknown template",
+			markercli.GetCmdGrantOperator(),
+			[]string{
+				s.accountAddresses[2].String(),
+				"admin",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[0].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"grant operator supply template to account 2 for account 0",
+			markercli.GetCmdGrantOperator(),
+			[]string{
+				s.accountAddresses[2].String(),
+				"supply",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[0].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1042,12 +1267,72 @@ func (s *IntegrationTestSuite) TestMarkerGetTxCmd() {
 	s.Run("marker cli tx commands not nil", func() {
 		tx := markercli.NewTxCmd()
 		s.Require().NotNil(tx)
-		s.Require().Equal(len(tx.Commands()), 14)
+		s.Require().Equal(len(tx.Commands()), 28)
 		s.Require().Equal(tx.Use, markertypes.ModuleName)
 		s.Require().Equal(tx.Short, "Transaction commands for the marker module")
 	})
 }
 
+func (s *IntegrationTestSuite) TestHoldingSnapshotCmd() {
+	clientCtx := s.testnet.Validators[0].ClientCtx
+	height, err := s.testnet.LatestHeight()
+	s.Require().NoError(err)
+
+	s.Run("json snapshot at height", func() {
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.HoldingSnapshotCmd(),
+			[]string{s.holderDenom, fmt.Sprintf("--%s=%d", flags.FlagHeight, height)})
+		s.Require().NoError(err)
+		var snapshot markercli.HoldingSnapshot
+		s.Require().NoError(json.Unmarshal(out.Bytes(), &snapshot))
+		s.Require().Equal(s.holderDenom, snapshot.Denom)
+		s.Require().Equal(height, snapshot.Height)
+		s.Require().Len(snapshot.Holders, s.holderCount)
+		total := sdk.ZeroInt()
+		for _, holder := range snapshot.Holders {
+			total = total.Add(holder.Amount)
+		}
+		s.Require().Equal(sdk.NewInt(123+234+345+456), total)
+	})
+
+	s.Run("csv snapshot at latest height", func() {
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.HoldingSnapshotCmd(),
+			[]string{s.holderDenom, fmt.Sprintf("--%s=csv", markercli.FlagSnapshotFormat)})
+		s.Require().NoError(err)
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		s.Require().Len(lines, s.holderCount+1)
+		s.Require().Equal("address,denom,amount,height", lines[0])
+		s.Require().Contains(out.String(), fmt.Sprintf("%s,%s,123,", s.accountAddresses[0], s.holderDenom))
+	})
+
+	s.Run("invalid format", func() {
+		_, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.HoldingSnapshotCmd(),
+			[]string{s.holderDenom, fmt.Sprintf("--%s=xml", markercli.FlagSnapshotFormat)})
+		s.Require().EqualError(err, `invalid snapshot format "xml", must be json or csv`)
+	})
+}
+
+func (s *IntegrationTestSuite) TestHoldingAttestationCmd() {
+	clientCtx := s.testnet.Validators[0].ClientCtx
+	s.Require().NoError(s.testnet.WaitForNextBlock())
+
+	s.Run("holder balance is proven", func() {
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.HoldingAttestationCmd(),
+			[]string{s.holderDenom, s.accountAddresses[0].String()})
+		s.Require().NoError(err)
+		var attestation markertypes.HoldingAttestation
+		s.Require().NoError(json.Unmarshal(out.Bytes(), &attestation))
+		s.Require().Equal(sdk.NewInt(123), attestation.Amount)
+		s.Require().Equal(markertypes.StatusActive.String(), attestation.MarkerStatus)
+		s.Require().NoError(attestation.Verify(clientCtx.Codec, attestation.AppHash))
+	})
+
+	s.Run("unknown marker", func() {
+		_, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.HoldingAttestationCmd(),
+			[]string{"nosuchmarker", s.accountAddresses[0].String()})
+		s.Require().EqualError(err, "marker nosuchmarker not found")
+	})
+}
+
 func (s *IntegrationTestSuite) TestPaginationWithPageKey() {
 	asJson := fmt.Sprintf("--%s=json", tmcli.OutputFlag)
 
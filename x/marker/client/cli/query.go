@@ -3,15 +3,27 @@ package cli
 import (
 	"context"
 	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
 )
@@ -29,10 +41,29 @@ func GetQueryCmd() *cobra.Command {
 		QueryParamsCmd(),
 		AllMarkersCmd(),
 		AllHoldersCmd(),
+		HoldingSnapshotCmd(),
 		MarkerCmd(),
+		MarkerByAddressCmd(),
 		MarkerAccessCmd(),
 		MarkerEscrowCmd(),
+		MarkerEscrowDepositsCmd(),
+		PendingMarkersCmd(),
+		OrphanedMarkersCmd(),
+		MarkerAuthzGrantsCmd(),
 		MarkerSupplyCmd(),
+		TransferPauseCmd(),
+		IbcRateLimitsCmd(),
+		WithdrawAllowancesCmd(),
+		DistributionsCmd(),
+		DistributionEntitlementsCmd(),
+		IssuerDashboardCmd(),
+		IsTransferableCmd(),
+		DenomMigrationCmd(),
+		TransferFeeCmd(),
+		TermsCmd(),
+		ManagementHistoryCmd(),
+		DenyListCmd(),
+		HoldingAttestationCmd(),
 	)
 	return queryCmd
 }
@@ -152,6 +183,122 @@ func AllHoldersCmd() *cobra.Command {
 	return cmd
 }
 
+// snapshotPageSize is the number of balances requested per page when building a holding snapshot.
+const snapshotPageSize = 1000
+
+// HoldingSnapshot is the holders of a marker denom at a block height as written by the holding-snapshot command.
+type HoldingSnapshot struct {
+	Denom   string          `json:"denom"`
+	Height  int64           `json:"height"`
+	Holders []HolderBalance `json:"holders"`
+}
+
+// HolderBalance is the balance of a single holder in a holding snapshot.
+type HolderBalance struct {
+	Address string  `json:"address"`
+	Amount  sdk.Int `json:"amount"`
+}
+
+// HoldingSnapshotCmd is the CLI command for exporting all accounts holding a marker at a block height.
+func HoldingSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holding-snapshot [denom]",
+		Short: "Export the balances of all accounts holding the given marker at a block height as JSON or CSV",
+		Long: strings.TrimSpace(`Export the balances of all accounts holding the given marker, for dividend and airdrop calculations.
+All pages are read at the same block height, the --height given or the latest height when the first page is read.
+Heights that have been pruned can only be queried on an archive node.`),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %[1]s query marker holding-snapshot nhash --height 1000000 > holders.json
+$ %[1]s query marker holding-snapshot nhash --height 1000000 --%[2]s csv > holders.csv`, version.AppName, FlagSnapshotFormat)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString(FlagSnapshotFormat)
+			if err != nil {
+				return err
+			}
+			if format != "json" && format != "csv" {
+				return fmt.Errorf("invalid snapshot format %q, must be json or csv", format)
+			}
+			snapshot, err := queryHoldingSnapshot(clientCtx, strings.ToLower(strings.TrimSpace(args[0])))
+			if err != nil {
+				return err
+			}
+			return writeHoldingSnapshot(cmd, snapshot, format)
+		},
+	}
+	cmd.Flags().String(FlagSnapshotFormat, "json", "Format of the snapshot (json|csv)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// queryHoldingSnapshot reads all pages of the holders of a marker at a single block height.
+func queryHoldingSnapshot(clientCtx client.Context, id string) (*HoldingSnapshot, error) {
+	snapshot := &HoldingSnapshot{Height: clientCtx.Height, Holders: []HolderBalance{}}
+	var nextKey []byte
+	for {
+		var header metadata.MD
+		res, err := types.NewQueryClient(clientCtx).Holding(
+			context.Background(),
+			&types.QueryHoldingRequest{Id: id, Pagination: &query.PageRequest{Key: nextKey, Limit: snapshotPageSize}},
+			grpc.Header(&header),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if snapshot.Height == 0 {
+			// Pin the remaining pages to the height of the first one.
+			if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+				if snapshot.Height, err = strconv.ParseInt(heights[0], 10, 64); err != nil {
+					return nil, err
+				}
+				clientCtx = clientCtx.WithHeight(snapshot.Height)
+			}
+		}
+		for _, balance := range res.Balances {
+			for _, coin := range balance.Coins {
+				snapshot.Denom = coin.Denom
+				snapshot.Holders = append(snapshot.Holders, HolderBalance{Address: balance.Address, Amount: coin.Amount})
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = res.Pagination.NextKey
+	}
+	if len(snapshot.Denom) == 0 {
+		snapshot.Denom = id
+	}
+	return snapshot, nil
+}
+
+// writeHoldingSnapshot writes a holding snapshot to the command output in the json or csv format.
+func writeHoldingSnapshot(cmd *cobra.Command, snapshot *HoldingSnapshot, format string) error {
+	if format == "json" {
+		bz, err := json.MarshalIndent(snapshot, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+		return err
+	}
+	w := csv.NewWriter(cmd.OutOrStdout())
+	if err := w.Write([]string{"address", "denom", "amount", "height"}); err != nil {
+		return err
+	}
+	height := strconv.FormatInt(snapshot.Height, 10)
+	for _, holder := range snapshot.Holders {
+		if err := w.Write([]string{holder.Address, snapshot.Denom, holder.Amount.String(), height}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
 // MarkerCmd is the CLI command for querying marker module registrations.
 func MarkerCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -183,6 +330,36 @@ func MarkerCmd() *cobra.Command {
 	return cmd
 }
 
+// MarkerByAddressCmd is the CLI command for querying a marker by its marker account address.
+func MarkerByAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "address [address]",
+		Short:   "Get marker details by marker account address",
+		Example: fmt.Sprintf(`$ %s query marker address pb1h7ljfe46hyx8yejl4axkzsnv3v4uhz3xf0ssyn`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			address := strings.TrimSpace(args[0])
+
+			var response *types.QueryMarkerByAddressResponse
+			if response, err = queryClient.MarkerByAddress(
+				context.Background(),
+				&types.QueryMarkerByAddressRequest{Address: address},
+			); err != nil {
+				fmt.Printf("failed to query marker at address \"%s\": %v\n", address, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // MarkerAccessCmd is the CLI command for querying marker access list.
 func MarkerAccessCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -243,6 +420,136 @@ func MarkerEscrowCmd() *cobra.Command {
 	return cmd
 }
 
+// MarkerEscrowDepositsCmd is the CLI command for querying coin sent directly to a marker escrow account.
+func MarkerEscrowDepositsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-deposits [address|denom]",
+		Short:   "Get coins sent directly to a marker escrow account with bank sends",
+		Example: fmt.Sprintf(`$ %s query marker escrow-deposits "nhash"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryEscrowDepositsResponse
+			if response, err = queryClient.EscrowDeposits(
+				context.Background(),
+				&types.QueryEscrowDepositsRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for escrow deposits: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "escrow deposits")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// PendingMarkersCmd is the CLI command for listing markers that have not been activated after a number of blocks.
+func PendingMarkersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending [min-age-blocks]",
+		Short: "List proposed and finalized markers created at least the given number of blocks ago",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query marker pending 100000`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			minAge, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid min age %s: %w", args[0], err)
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingMarkers(
+				context.Background(),
+				&types.QueryPendingMarkersRequest{MinAge: minAge, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "pending markers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// OrphanedMarkersCmd is the CLI command for listing active markers that no address holds admin access on.
+func OrphanedMarkersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orphaned",
+		Short: "List active markers that no address holds an unexpired admin access grant on",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query marker orphaned`, version.AppName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OrphanedMarkers(
+				context.Background(),
+				&types.QueryOrphanedMarkersRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "orphaned markers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkerAuthzGrantsCmd is the CLI command for querying the authz grants of marker msgs from accounts with marker access.
+func MarkerAuthzGrantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "authz-grants [address|denom]",
+		Short:   "List authz grants of marker msgs from the manager and accounts with access to a marker",
+		Example: fmt.Sprintf(`$ %s query marker authz-grants "nhash"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+			res, err := queryClient.MarkerGrants(context.Background(), &types.QueryMarkerGrantsRequest{Id: id})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // MarkerSupplyCmd is the CLI command for querying marker module registrations.
 func MarkerSupplyCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -274,6 +581,35 @@ func MarkerSupplyCmd() *cobra.Command {
 }
 
 // sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
+// TransferPauseCmd is the CLI command for querying the pause of restricted marker transfers.
+func TransferPauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-pause",
+		Short:   "Get the governance controlled pause of restricted marker transfers",
+		Example: fmt.Sprintf(`$ %s query marker transfer-pause`, version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryTransferPauseResponse
+			if response, err = queryClient.TransferPause(
+				context.Background(),
+				&types.QueryTransferPauseRequest{},
+			); err != nil {
+				fmt.Printf("failed to query transfer pause: %v\n", err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	encoded, err := flagSet.GetString(flags.FlagPageKey)
 	if err != nil {
@@ -286,3 +622,519 @@ func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	_ = flagSet.Set(flags.FlagPageKey, string(raw))
 	return flagSet
 }
+
+// IbcRateLimitsCmd is the CLI command for querying the governance controlled quotas on the ibc transfer flow of markers.
+func IbcRateLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-rate-limits [denom]",
+		Short: "Get the ibc rate limits of all markers or of a marker denom with the transfer flow of their current period",
+		Example: fmt.Sprintf(`$ %[1]s query marker ibc-rate-limits
+$ %[1]s query marker ibc-rate-limits "nhash"`, version.AppName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			denom := ""
+			if len(args) > 0 {
+				denom = strings.TrimSpace(args[0])
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryIbcRateLimitsResponse
+			if response, err = queryClient.IbcRateLimits(
+				context.Background(),
+				&types.QueryIbcRateLimitsRequest{Denom: denom, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query ibc rate limits: %v\n", err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "ibc rate limits")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// WithdrawAllowancesCmd is the CLI command for querying the withdraw allowances on a marker.
+func WithdrawAllowancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-allowances [address|denom] [grantee]",
+		Short: "Get the withdraw allowances on a marker, or of a grantee, with the coins remaining in the current window",
+		Example: fmt.Sprintf(`$ %[1]s query marker withdraw-allowances "nhash"
+$ %[1]s query marker withdraw-allowances "nhash" pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+			grantee := ""
+			if len(args) > 1 {
+				grantee = strings.TrimSpace(args[1])
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryWithdrawAllowancesResponse
+			if response, err = queryClient.WithdrawAllowances(
+				context.Background(),
+				&types.QueryWithdrawAllowancesRequest{Id: id, Grantee: grantee, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for withdraw allowances: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "withdraw allowances")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DistributionsCmd is the CLI command for querying the distributions to the holders of a marker.
+func DistributionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "distributions [address|denom]",
+		Short:   "Get the scheduled and claimable distributions to the holders of a marker",
+		Example: fmt.Sprintf(`$ %s query marker distributions "nhash"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryDistributionsResponse
+			if response, err = queryClient.Distributions(
+				context.Background(),
+				&types.QueryDistributionsRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for distributions: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "distributions")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DistributionEntitlementsCmd is the CLI command for querying the unclaimed shares of a distribution.
+func DistributionEntitlementsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-entitlements [distribution-id] [address]",
+		Short: "Get the unclaimed shares of a distribution, or of a holder",
+		Example: fmt.Sprintf(`$ %[1]s query marker distribution-entitlements 1
+$ %[1]s query marker distribution-entitlements 1 pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid distribution id %s: %w", args[0], err)
+			}
+			address := ""
+			if len(args) > 1 {
+				address = strings.TrimSpace(args[1])
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryDistributionEntitlementsResponse
+			if response, err = queryClient.DistributionEntitlements(
+				context.Background(),
+				&types.QueryDistributionEntitlementsRequest{DistributionId: id, Address: address, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query distribution %d for entitlements: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "entitlements")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// IssuerDashboardCmd is the CLI command for querying a summary of each marker an address administers.
+func IssuerDashboardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dashboard [address]",
+		Short: "Get the status, supply, escrow, recent activity, pending proposals and expiring grants of each marker an address administers",
+		Example: fmt.Sprintf(`$ %[1]s query marker dashboard pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %[1]s query marker dashboard pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --%[2]s 1000 --%[3]s 72h`,
+			version.AppName, FlagActivityBlocks, FlagExpiringWithin),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			activityBlocks, err := cmd.Flags().GetInt64(FlagActivityBlocks)
+			if err != nil {
+				return err
+			}
+			expiringWithin, err := cmd.Flags().GetDuration(FlagExpiringWithin)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.IssuerDashboard(
+				context.Background(),
+				&types.QueryIssuerDashboardRequest{
+					Address:        strings.TrimSpace(args[0]),
+					ActivityBlocks: activityBlocks,
+					ExpiringWithin: expiringWithin,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Int64(FlagActivityBlocks, 0,
+		fmt.Sprintf("Number of recent blocks counted as activity (default %d)", types.DefaultDashboardActivityBlocks))
+	cmd.Flags().Duration(FlagExpiringWithin, 0,
+		fmt.Sprintf("Window within which grants are listed as expiring (default %s)", types.DefaultDashboardExpiringWithin))
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// IsTransferableCmd is the CLI command for checking whether a coin can be transferred between two accounts.
+func IsTransferableCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transferable [from-address] [to-address] [coin]",
+		Short: "Check whether a coin can be transferred between two accounts, and why not if it cannot",
+		Long: fmt.Sprintf(`Checks the marker status, bank send enabled, transfer pause, restricted coin transfer access and
+authorization, recipient, and spendable balance of a transfer.  Restricted coins are checked as transferred by the
+--%s address, or by the from address when it is not given.`, FlagAdmin),
+		Example: fmt.Sprintf(`$ %[1]s query marker transferable pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj pb1tg3ktger9ttlscehl3r5j4pqw7qzmvs4qr9vpm 100nhash
+$ %[1]s query marker transferable pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj pb1tg3ktger9ttlscehl3r5j4pqw7qzmvs4qr9vpm 100restricted --%[2]s pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`,
+			version.AppName, FlagAdmin),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			coin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid coin %s: %w", args[2], err)
+			}
+			admin, err := cmd.Flags().GetString(FlagAdmin)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.IsTransferable(
+				context.Background(),
+				&types.QueryIsTransferableRequest{
+					Denom:        coin.Denom,
+					FromAddress:  strings.TrimSpace(args[0]),
+					ToAddress:    strings.TrimSpace(args[1]),
+					Amount:       coin.Amount,
+					AdminAddress: strings.TrimSpace(admin),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(FlagAdmin, "", "Address brokering the transfer of a restricted coin (default the from address)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DenomMigrationCmd is the CLI command for querying the progress and reconciliation of a marker denom migration.
+func DenomMigrationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-migration [denom]",
+		Short:   "Get the progress of the migration of a marker denom to a new denom and its reconciliation once complete",
+		Example: fmt.Sprintf(`$ %s query marker denom-migration "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			denom := strings.TrimSpace(args[0])
+
+			var response *types.QueryDenomMigrationResponse
+			if response, err = queryClient.DenomMigration(
+				context.Background(),
+				&types.QueryDenomMigrationRequest{Denom: denom},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for denom migration: %v\n", denom, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// TransferFeeCmd is the CLI command for querying the fee charged on each transfer of a restricted marker.
+func TransferFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-fee [address|denom]",
+		Short:   "Get the fee charged on each transfer of a restricted marker",
+		Example: fmt.Sprintf(`$ %s query marker transfer-fee "restrictedcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryTransferFeeResponse
+			if response, err = queryClient.TransferFee(
+				context.Background(),
+				&types.QueryTransferFeeRequest{Id: id},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for transfer fee: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// TermsCmd is the CLI command for querying the terms document anchored to a marker and the timeline of its hashes.
+func TermsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "terms [address|denom]",
+		Short:   "Get the terms document hash anchored to a marker and its timeline",
+		Example: fmt.Sprintf(`$ %s query marker terms "restrictedcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryTermsResponse
+			if response, err = queryClient.Terms(
+				context.Background(),
+				&types.QueryTermsRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for terms: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "terms")
+	return cmd
+}
+
+// ManagementHistoryCmd is the CLI command for querying the timeline of the addresses that managed a marker.
+func ManagementHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "management-history [address|denom]",
+		Short:   "Get the timeline of the manager and admin addresses of a marker",
+		Example: fmt.Sprintf(`$ %s query marker management-history "nhash"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryManagementHistoryResponse
+			if response, err = queryClient.ManagementHistory(
+				context.Background(),
+				&types.QueryManagementHistoryRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for management history: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "management history")
+	return cmd
+}
+
+// DenyListCmd is the CLI command for querying the addresses denied transfers of restricted markers by governance.
+func DenyListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deny-list [(optional) address]",
+		Short: "Get the governance controlled deny list of restricted marker transfers",
+		Example: fmt.Sprintf(`$ %[1]s query marker deny-list
+$ %[1]s query marker deny-list pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryDenyListRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				req.Address = strings.TrimSpace(args[0])
+			}
+
+			var response *types.QueryDenyListResponse
+			if response, err = queryClient.DenyList(context.Background(), req); err != nil {
+				fmt.Printf("failed to query deny list: %v\n", err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deny-list")
+	return cmd
+}
+
+// HoldingAttestationCmd is the CLI command for getting a merkle proof of the balance of a marker held by an address.
+func HoldingAttestationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holding-attestation [denom] [address]",
+		Short: "Get a verifiable proof of the balance of a marker held by an address at a block height",
+		Long: strings.TrimSpace(`Get the balance of a marker held by an address and the marker status at a block height, along with
+the merkle proofs of both store entries and the app hash committing them.  The output can be checked against an app hash
+from a trusted source, e.g. a light client, using HoldingAttestation.Verify, without trusting the node it came from.
+The height defaults to the block before the latest one, since the app hash committing a height is in the next block.`),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %[1]s query marker holding-attestation nhash pb1h7ljfe46hyx8yejl4axkzsnv3v4uhz3xf0ssyn
+$ %[1]s query marker holding-attestation nhash pb1h7ljfe46hyx8yejl4axkzsnv3v4uhz3xf0ssyn --height 1000000`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			denom := strings.ToLower(strings.TrimSpace(args[0]))
+			addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(args[1]))
+			if err != nil {
+				return err
+			}
+			attestation, err := queryHoldingAttestation(clientCtx, denom, addr)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(attestation, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// queryHoldingAttestation gets the proven balance and marker entries for an attestation and checks them against the
+// app hash of the block committing them.
+func queryHoldingAttestation(clientCtx client.Context, denom string, addr sdk.AccAddress) (*types.HoldingAttestation, error) {
+	markerKey, err := types.HoldingMarkerKey(denom)
+	if err != nil {
+		return nil, err
+	}
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	height := clientCtx.Height
+	if height == 0 {
+		status, err := node.Status(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		height = status.SyncInfo.LatestBlockHeight - 1
+	}
+	// The app hash for the state at a height is in the header of the following block.
+	next := height + 1
+	block, err := node.Block(context.Background(), &next)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := queryStoreProof(clientCtx, banktypes.StoreKey, types.HoldingBalanceKey(addr, denom), height)
+	if err != nil {
+		return nil, err
+	}
+	marker, err := queryStoreProof(clientCtx, authtypes.StoreKey, markerKey, height)
+	if err != nil {
+		return nil, err
+	}
+	attestation, err := types.NewHoldingAttestation(clientCtx.Codec, addr.String(), denom, height,
+		block.Block.Header.AppHash, balance.Value, balance.ProofOps, marker.Value, marker.ProofOps)
+	if err != nil {
+		return nil, err
+	}
+	if err = attestation.Verify(clientCtx.Codec, block.Block.Header.AppHash); err != nil {
+		return nil, err
+	}
+	return attestation, nil
+}
+
+// queryStoreProof gets the value of a key in a module store at a height along with its merkle proof.
+func queryStoreProof(clientCtx client.Context, storeName string, key []byte, height int64) (abci.ResponseQuery, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", storeName),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return res, err
+	}
+	if res.ProofOps == nil {
+		return res, fmt.Errorf("no proof returned for %s store key %X at height %d", storeName, key, height)
+	}
+	return res, nil
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -28,6 +29,15 @@ const (
 	FlagAllowGovernanceControl = "allowGovernanceControl"
 	FlagTransferLimit          = "transfer-limit"
 	FlagExpiration             = "expiration"
+	FlagAllowList              = "allow-list"
+	FlagActivityBlocks         = "activity-blocks"
+	FlagExpiringWithin         = "expiring-within"
+	FlagSnapshotFormat         = "format"
+	FlagAdmin                  = "admin"
+	FlagTransferFee            = "transfer-fee"
+	FlagTransferFeeRecipient   = "transfer-fee-recipient"
+	FlagTermsFile              = "terms-file"
+	FlagManageSupply           = "manage-supply"
 )
 
 // NewTxCmd returns the top-level command for marker CLI transactions.
@@ -46,14 +56,28 @@ func NewTxCmd() *cobra.Command {
 		GetCmdDelete(),
 		GetCmdMint(),
 		GetCmdBurn(),
+		GetCmdBurnFrom(),
 		GetCmdAddAccess(),
 		GetCmdDeleteAccess(),
 		GetCmdWithdrawCoins(),
+		GetCmdSetWithdrawAllowance(),
+		GetCmdDeleteWithdrawAllowance(),
+		GetCmdScheduleDistribution(),
+		GetCmdClaimDistribution(),
+		GetCmdSetFaucetPolicy(),
+		GetCmdRemoveFaucetPolicy(),
+		GetCmdClaimFaucet(),
+		GetCmdSetTransferFee(),
+		GetCmdRemoveTransferFee(),
+		GetCmdSetTerms(),
+		GetCmdPause(),
+		GetCmdUnpause(),
 		GetNewTransferCmd(),
 		GetCmdAddMarker(),
 		GetCmdMarkerProposal(),
 		GetCmdGrantAuthorization(),
 		GetCmdRevokeAuthorization(),
+		GetCmdAuthz(),
 	)
 	return txCmd
 }
@@ -119,6 +143,46 @@ Valid Proposal Types (and associated parameters):
 			{"denom":"otherdenomunit","exponent":9,"aliases":[]}
 		]
 	}
+
+- PauseRestrictedTransfers
+	"denoms": ["restrictedcoin"], // optional, all restricted markers when empty
+	"expiry_height": "1000000", // required block height at which the pause is lifted
+	"halt_supply": true, // optional, also halt minting, burning, and withdrawing (of all markers when denoms is empty)
+	"global": true // optional, pause every marker including bank sends of coin markers (requires halt_supply, no denoms)
+
+- ResumeRestrictedTransfers
+	(no additional parameters)
+
+- SetIbcRateLimit
+	"channel_id": "channel-0", // the channel the marker is transferred over
+	"max_percent_send": 10, // maximum net outflow per period as a percentage of the marker supply (0 for none)
+	"max_percent_recv": 10, // maximum net inflow per period as a percentage of the marker supply (0 for none)
+	"duration_hours": "24" // the length of a period in hours
+
+- RemoveIbcRateLimit
+	"channel_id": "channel-0" // the channel the marker is transferred over
+
+- SetTransferFee
+	"transfer_fee": {
+		"denom": "restrictedcoin",
+		"flat_fee": {"denom":"nhash", "amount":"10"}, // a flat fee, or
+		"basis_points": 25, // basis points of the transferred amount (only one may be set)
+		"recipient": "pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk" // optional, the marker account when empty
+	}
+
+- RemoveTransferFee
+	(no additional parameters)
+
+- AddToDenyList
+	"addresses": ["pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk"], // addresses denied transfers of all restricted markers
+	"reason": "sanctioned" // optional
+
+- RemoveFromDenyList
+	"addresses": ["pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk"]
+
+- MigrateDenom
+	"new_denom": "newdenomstring", // the denom of the successor marker to create
+	"holders_per_block": 100 // the number of holders migrated to the new denom in each block
 `,
 		),
 		Example: fmt.Sprintf(`$ %s tx marker proposal AddMarker "path/to/proposal.json" 1000%s --from mykey`, version.AppName, sdk.DefaultBondDenom),
@@ -152,6 +216,24 @@ Valid Proposal Types (and associated parameters):
 				proposal = &types.WithdrawEscrowProposal{}
 			case types.ProposalTypeSetDenomMetadata:
 				proposal = &types.SetDenomMetadataProposal{}
+			case types.ProposalTypePauseRestrictedTransfers:
+				proposal = &types.PauseRestrictedTransfersProposal{}
+			case types.ProposalTypeResumeRestrictedTransfers:
+				proposal = &types.ResumeRestrictedTransfersProposal{}
+			case types.ProposalTypeSetIbcRateLimit:
+				proposal = &types.SetIbcRateLimitProposal{}
+			case types.ProposalTypeRemoveIbcRateLimit:
+				proposal = &types.RemoveIbcRateLimitProposal{}
+			case types.ProposalTypeMigrateDenom:
+				proposal = &types.MigrateDenomProposal{}
+			case types.ProposalTypeSetTransferFee:
+				proposal = &types.SetTransferFeeProposal{}
+			case types.ProposalTypeRemoveTransferFee:
+				proposal = &types.RemoveTransferFeeProposal{}
+			case types.ProposalTypeAddToDenyList:
+				proposal = &types.AddToDenyListProposal{}
+			case types.ProposalTypeRemoveFromDenyList:
+				proposal = &types.RemoveFromDenyListProposal{}
 			default:
 				return fmt.Errorf("unknown proposal type %s", args[0])
 			}
@@ -185,9 +267,12 @@ func GetCmdAddMarker() *cobra.Command {
 		Args:    cobra.ExactArgs(1),
 		Short:   "Create a new marker",
 		Long: strings.TrimSpace(`Creates a new marker in the Proposed state managed by the from address
-with the given supply amount and denomination provided in the coin argument
+with the given supply amount and denomination provided in the coin argument.  With --manage-supply=false a
+reference marker is created for a denom whose supply is managed elsewhere, its supply must be zero.
 `),
-		Example: fmt.Sprintf(`$ %s tx marker new 1000hotdogcoin --%s=false --%s=false --from=mykey`, FlagType, FlagSupplyFixed, FlagAllowGovernanceControl),
+		Example: fmt.Sprintf(`$ %[1]s tx marker new 1000hotdogcoin --%[2]s=false --%[3]s=false --from=mykey
+$ %[1]s tx marker new 0ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --%[4]s=false --from=mykey`,
+			version.AppName, FlagSupplyFixed, FlagAllowGovernanceControl, FlagManageSupply),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -218,7 +303,25 @@ with the given supply amount and denomination provided in the coin argument
 			if err != nil {
 				return fmt.Errorf("incorrect value for %s flag.  Accepted: true,false Error: %s", FlagAllowGovernanceControl, err)
 			}
+			manageSupply, err := cmd.Flags().GetBool(FlagManageSupply)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: true,false Error: %s", FlagManageSupply, err)
+			}
 			msg := types.NewMsgAddMarkerRequest(coin.Denom, coin.Amount, callerAddr, callerAddr, typeValue, supplyFixed, allowGovernanceControl)
+			msg.Reference = !manageSupply
+			transferFee, err := cmd.Flags().GetString(FlagTransferFee)
+			if err != nil {
+				return err
+			}
+			if len(transferFee) > 0 {
+				recipient, err := cmd.Flags().GetString(FlagTransferFeeRecipient)
+				if err != nil {
+					return err
+				}
+				if msg.TransferFee, err = parseTransferFee(coin.Denom, transferFee, recipient); err != nil {
+					return err
+				}
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -226,6 +329,9 @@ with the given supply amount and denomination provided in the coin argument
 	cmd.Flags().String(FlagType, "COIN", "a marker type to assign (default is COIN)")
 	cmd.Flags().Bool(FlagSupplyFixed, false, "a true or false value to denote if a supply is fixed (default is false)")
 	cmd.Flags().Bool(FlagAllowGovernanceControl, false, "a true or false value to denote if marker is allowed governance control (default is false)")
+	cmd.Flags().String(FlagTransferFee, "", "a fee charged on each transfer of a restricted marker, a flat coin (e.g. 10nhash) or basis points (e.g. 25bps)")
+	cmd.Flags().String(FlagTransferFeeRecipient, "", "the address transfer fees are paid to (default is the marker account)")
+	cmd.Flags().Bool(FlagManageSupply, true, "false to create a reference marker for a denom whose supply is managed elsewhere")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -291,6 +397,39 @@ for burning.  Marker must be in the active status to burn coin.`),
 	return cmd
 }
 
+// GetCmdBurnFrom implements the burn restricted coin from a holder account command.
+func GetCmdBurnFrom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-from [address] [coin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Burn restricted coins held by an account",
+		Long: strings.TrimSpace(`Burns the number of coins specified directly from the given holder account.
+Only coins of restricted markers in the active status may be burned this way.  Caller must possess
+the burn permission on the marker.`),
+		Example: fmt.Sprintf(`$ %s tx marker burn-from pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk 1000hotdogcoin --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			coin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid coin %s", args[1])
+			}
+			callerAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgBurnFromRequest(callerAddr, from, coin)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdFinalize implements the finalize marker command.
 func GetCmdFinalize() *cobra.Command {
 	cmd := &cobra.Command{
@@ -398,7 +537,8 @@ func GetCmdAddAccess() *cobra.Command {
 		Short:   "Grant access to a marker for the address coins from the marker",
 		Long: strings.TrimSpace(`Grant administrative access to a marker.  From Address must have appropriate
 existing access.  Permissions are appended to any existing access grant.  Valid permissions
-are one of [mint, burn, deposit, withdraw, delete, admin, transfer].`),
+are one of [mint, burn, deposit, withdraw, delete, admin, transfer].  An expiration applies to all
+of the permissions held by the address.`),
 		Example: fmt.Sprintf(`$ %s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom burn --from mykey`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -414,12 +554,21 @@ are one of [mint, burn, deposit, withdraw, delete, admin, transfer].`),
 			if err = grant.Validate(); err != nil {
 				return sdkErrors.Wrapf(err, "invalid access grant permission: %s", args[2])
 			}
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if exp > 0 {
+				expiration := time.Unix(exp, 0).UTC()
+				grant.Expiration = &expiration
+			}
 			callerAddr := clientCtx.GetFromAddress()
 			msg := types.NewMsgAddAccessRequest(args[1], callerAddr, *grant)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Int64(FlagExpiration, 0, "The Unix timestamp when the access expires. Default is no expiration.")
 	return cmd
 }
 
@@ -452,6 +601,383 @@ From Address must have appropriate existing access.`),
 	return cmd
 }
 
+// GetCmdSetWithdrawAllowance implements the set withdraw allowance command.
+func GetCmdSetWithdrawAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-withdraw-allowance [denom] [grantee] [max-amount] [period]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Limit the coins an address can withdraw from a marker in a rolling period",
+		Long: strings.TrimSpace(`Limit the coins an address with withdraw access can withdraw from the marker escrow account
+within any rolling window of the period.  Coins not in the max amount cannot be withdrawn by the grantee.  Withdrawals
+already made under an existing allowance count against the new one.  From Address must have admin access.`),
+		Example: fmt.Sprintf(`$ %s tx marker set-withdraw-allowance coindenom pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 1000coindenom 24h --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "withdraw allowance for invalid address %s", args[1])
+			}
+			maxAmount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid max amount %s", args[2])
+			}
+			period, err := time.ParseDuration(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid period %s: %w", args[3], err)
+			}
+			callerAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgSetWithdrawAllowanceRequest(args[0], callerAddr, grantee, maxAmount, period)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDeleteWithdrawAllowance implements the delete withdraw allowance command.
+func GetCmdDeleteWithdrawAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-withdraw-allowance [denom] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove the limit on the coins an address can withdraw from a marker",
+		Long: strings.TrimSpace(`Remove the withdraw allowance of an address on a marker.  The address keeps its access and is
+no longer limited.  From Address must have admin access.`),
+		Example: fmt.Sprintf(`$ %s tx marker delete-withdraw-allowance coindenom pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "withdraw allowance for invalid address %s", args[1])
+			}
+			callerAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgDeleteWithdrawAllowanceRequest(args[0], callerAddr, grantee)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdScheduleDistribution implements the schedule distribution command.
+func GetCmdScheduleDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-distribution [denom] [payout] [snapshot-height] [claim-window]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Deposit a payout to share among the holders of a marker at a snapshot height",
+		Long: strings.TrimSpace(`Deposit a payout to share among the holders of the marker in proportion to their balances at the
+end of the snapshot height.  Holders can claim their share for claim-window blocks after the snapshot height, after
+which the unclaimed payout is returned to the marker escrow account.  From Address must have admin access.`),
+		Example: fmt.Sprintf(`$ %s tx marker schedule-distribution coindenom 10000nhash 120000 100000 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payout, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid payout %s", args[1])
+			}
+			snapshotHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid snapshot height %s: %w", args[2], err)
+			}
+			claimWindow, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid claim window %s: %w", args[3], err)
+			}
+			callerAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgScheduleDistributionRequest(args[0], callerAddr, payout, snapshotHeight, claimWindow)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdClaimDistribution implements the claim distribution command.
+func GetCmdClaimDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-distribution [distribution-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Claim the share of a distribution owed to From Address",
+		Example: fmt.Sprintf(`$ %s tx marker claim-distribution 1 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid distribution id %s: %w", args[0], err)
+			}
+			msg := types.NewMsgClaimDistributionRequest(id, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetFaucetPolicy implements the set faucet policy command.
+func GetCmdSetFaucetPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-faucet-policy [amount] [required-attribute] [cooldown]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Let addresses with an attribute claim an amount of marker coin",
+		Long: strings.TrimSpace(`Let any address that has the required attribute claim the amount of marker coin once per cooldown.
+A cooldown of 0s allows a single claim per address.  Claims are withdrawn from the marker escrow account by From Address,
+which mints any shortfall first.  Claims made under an existing policy count against the new one.  From Address must
+have admin and withdraw access, and mint access if the escrow account will not hold enough coin.`),
+		Example: fmt.Sprintf(`$ %s tx marker set-faucet-policy 100coindenom kyc.provenance.io 24h --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid amount %s", args[0])
+			}
+			cooldown, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid cooldown %s: %w", args[2], err)
+			}
+			callerAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgSetFaucetPolicyRequest(amount.Denom, callerAddr, amount, args[1], cooldown)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRemoveFaucetPolicy implements the remove faucet policy command.
+func GetCmdRemoveFaucetPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-faucet-policy [denom]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Stop addresses from claiming coin from a marker faucet",
+		Long:    "Remove the faucet policy of a marker.  From Address must have admin access.",
+		Example: fmt.Sprintf(`$ %s tx marker remove-faucet-policy coindenom --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveFaucetPolicyRequest(args[0], clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetTransferFee implements the set transfer fee command.
+func GetCmdSetTransferFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-fee [denom] [fee] [(optional) recipient address]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Charge a fee on each transfer of a restricted marker",
+		Long: strings.TrimSpace(`Charge the sender of each transfer of a restricted marker a fee, either a flat coin (e.g. 10nhash)
+or basis points of the transferred amount in the marker denom (e.g. 25bps).  The fee is paid to the recipient address,
+or to the marker account when none is given.  From Address must have admin access.`),
+		Example: fmt.Sprintf(`$ %s tx marker set-transfer-fee restrictedcoin 25bps --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var recipient string
+			if len(args) == 3 {
+				recipient = args[2]
+			}
+			fee, err := parseTransferFee(args[0], args[1], recipient)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetTransferFeeRequest(clientCtx.GetFromAddress(), *fee)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRemoveTransferFee implements the remove transfer fee command.
+func GetCmdRemoveTransferFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-transfer-fee [denom]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Stop charging a fee on transfers of a restricted marker",
+		Long:    "Remove the transfer fee of a restricted marker.  From Address must have admin access.",
+		Example: fmt.Sprintf(`$ %s tx marker remove-transfer-fee restrictedcoin --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveTransferFeeRequest(args[0], clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPause implements the pause marker command.
+func GetCmdPause() *cobra.Command {
+	return markerPausedCmd("pause", "Halt minting, burning, withdrawing, and transferring a marker",
+		"Pause a marker.  Minting, burning, withdrawing, and transferring its coin are halted until it is unpaused, "+
+			"queries keep working.  From Address must have admin access.", true)
+}
+
+// GetCmdUnpause implements the unpause marker command.
+func GetCmdUnpause() *cobra.Command {
+	return markerPausedCmd("unpause", "Resume minting, burning, withdrawing, and transferring a paused marker",
+		"Unpause a marker paused by its administrators.  A marker stays paused while all markers are paused by "+
+			"governance.  From Address must have admin access.", false)
+}
+
+func markerPausedCmd(name, short, long string, paused bool) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     name + " [denom]",
+		Args:    cobra.ExactArgs(1),
+		Short:   short,
+		Long:    long,
+		Example: fmt.Sprintf(`$ %s tx marker %s nhash --from mykey`, version.AppName, name),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMarkerPausedRequest(args[0], paused, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseTransferFee parses a transfer fee given as a flat coin (e.g. 10nhash) or basis points (e.g. 25bps).
+func parseTransferFee(denom, fee, recipient string) (*types.TransferFee, error) {
+	if bps := strings.TrimSuffix(fee, "bps"); bps != fee {
+		basisPoints, err := strconv.ParseUint(bps, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transfer fee basis points %s: %w", fee, err)
+		}
+		return types.NewBasisPointsTransferFee(denom, uint32(basisPoints), recipient), nil
+	}
+	flatFee, err := sdk.ParseCoinNormalized(fee)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid transfer fee %s", fee)
+	}
+	return types.NewFlatTransferFee(denom, flatFee, recipient), nil
+}
+
+// GetCmdSetTerms implements the set terms command.
+func GetCmdSetTerms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-terms [denom] [(optional) terms hash] [(optional) uri]",
+		Args:  cobra.RangeArgs(1, 3),
+		Short: "Anchor the hash of the terms document of a marker",
+		Long: strings.TrimSpace(`Anchor the hex encoded sha256 hash of the terms document of a marker, and optionally the uri it can
+be retrieved from, to the marker.  Instead of the hash, the --terms-file flag can name a json terms document following the
+` + types.TermsSchemaV1 + ` schema; it is validated and the hash of its canonical json encoding anchored.  Giving
+neither a hash nor a file clears the terms.  Every change is kept in the terms timeline of the marker.  From Address must
+have admin access.`),
+		Example: strings.TrimSpace(fmt.Sprintf(`
+$ %[1]s tx marker set-terms restrictedcoin --terms-file terms.json --from mykey
+$ %[1]s tx marker set-terms restrictedcoin 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 https://example.com/terms.json --from mykey
+$ %[1]s tx marker set-terms restrictedcoin --from mykey`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var termsHash, uri string
+			termsFile, err := cmd.Flags().GetString(FlagTermsFile)
+			if err != nil {
+				return err
+			}
+			switch {
+			case len(termsFile) > 0:
+				if len(args) > 2 {
+					return fmt.Errorf("a terms hash cannot be given with --%s", FlagTermsFile)
+				}
+				if termsHash, err = hashTermsFile(termsFile, args[0]); err != nil {
+					return err
+				}
+				if len(args) == 2 {
+					uri = args[1]
+				}
+			case len(args) > 1:
+				termsHash = strings.ToLower(args[1])
+				if len(args) == 3 {
+					uri = args[2]
+				}
+			}
+			msg := types.NewMsgSetTermsRequest(args[0], termsHash, uri, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagTermsFile, "", "a json terms document to anchor the hash of, when given the second argument is the uri")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// hashTermsFile reads and validates the json terms document of a marker and returns its hash.
+func hashTermsFile(path, denom string) (string, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	doc, err := types.ParseTermsDocument(bz)
+	if err != nil {
+		return "", err
+	}
+	if doc.Denom != denom {
+		return "", fmt.Errorf("terms document denom %s does not match marker denom %s", doc.Denom, denom)
+	}
+	return doc.Hash()
+}
+
+// GetCmdClaimFaucet implements the claim faucet command.
+func GetCmdClaimFaucet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-faucet [denom]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Claim the faucet amount of a marker for From Address",
+		Example: fmt.Sprintf(`$ %s tx marker claim-faucet coindenom --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimFaucetRequest(args[0], clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdWithdrawCoins implements the withdraw coins from escrow command.
 func GetCmdWithdrawCoins() *cobra.Command {
 	cmd := &cobra.Command{
@@ -566,7 +1092,19 @@ func GetCmdGrantAuthorization() *cobra.Command {
 					return fmt.Errorf("transfer-limit should be greater than zero")
 				}
 
-				authorization = types.NewMarkerTransferAuthorization(spendLimit)
+				allowList, terr := cmd.Flags().GetStringSlice(FlagAllowList)
+				if terr != nil {
+					return terr
+				}
+
+				allowed := make([]sdk.AccAddress, len(allowList))
+				for i, addr := range allowList {
+					if allowed[i], terr = sdk.AccAddressFromBech32(addr); terr != nil {
+						return terr
+					}
+				}
+
+				authorization = types.NewMarkerTransferAuthorization(spendLimit, allowed)
 			default:
 				return fmt.Errorf("invalid authorization type, %s", args[1])
 			}
@@ -581,6 +1119,66 @@ func GetCmdGrantAuthorization() *cobra.Command {
 	}
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagTransferLimit, "", "The total amount an account is allowed to tranfer on granter's behalf")
+	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send restricted coins separated by ,")
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
+	return cmd
+}
+
+// GetCmdAuthz returns the marker authz transaction commands
+func GetCmdAuthz() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "authz",
+		Short:                      "Marker authz grant commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(GetCmdGrantOperator())
+	return cmd
+}
+
+// GetCmdGrantOperator returns a cmd for granting an operator template of marker msgs with generic authorizations
+func GetCmdGrantOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-operator [grantee] [template]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Grant an operator account a least-privilege set of marker msgs",
+		Long: strings.TrimSpace(fmt.Sprintf(`Grant an operator account generic authorizations for a template set of marker msgs.
+None of the templates allow changes to marker access grants.  The grantee can only act on markers the granter has
+access to.  Templates: %s`, strings.Join(types.OperatorGrantTemplateNames(), ", "))),
+		Example: fmt.Sprintf(`$ %s tx marker authz grant-operator tp1skjw.. mint --expiration=1700000000`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			authorizations, err := types.NewOperatorGrantAuthorizations(args[1])
+			if err != nil {
+				return err
+			}
+
+			msgs := make([]sdk.Msg, len(authorizations))
+			for i, authorization := range authorizations {
+				if msgs[i], err = authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(exp, 0)); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
 	return cmd
 }
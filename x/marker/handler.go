@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
 // NewHandler returns a handler for marker messages.
@@ -46,6 +47,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgBurnRequest:
 			res, err := msgServer.Burn(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBurnFromRequest:
+			res, err := msgServer.BurnFrom(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		case *types.MsgWithdrawRequest:
 			res, err := msgServer.Withdraw(sdk.WrapSDKContext(ctx), msg)
@@ -57,6 +61,39 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgSetDenomMetadataRequest:
 			res, err := msgServer.SetDenomMetadata(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetWithdrawAllowanceRequest:
+			res, err := msgServer.SetWithdrawAllowance(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDeleteWithdrawAllowanceRequest:
+			res, err := msgServer.DeleteWithdrawAllowance(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgScheduleDistributionRequest:
+			res, err := msgServer.ScheduleDistribution(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClaimDistributionRequest:
+			res, err := msgServer.ClaimDistribution(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetFaucetPolicyRequest:
+			res, err := msgServer.SetFaucetPolicy(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRemoveFaucetPolicyRequest:
+			res, err := msgServer.RemoveFaucetPolicy(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetTransferFeeRequest:
+			res, err := msgServer.SetTransferFee(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRemoveTransferFeeRequest:
+			res, err := msgServer.RemoveTransferFee(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetTermsRequest:
+			res, err := msgServer.SetTerms(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetMarkerPausedRequest:
+			res, err := msgServer.SetMarkerPaused(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClaimFaucetRequest:
+			res, err := msgServer.ClaimFaucet(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
@@ -83,8 +120,46 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return keeper.HandleWithdrawEscrowProposal(ctx, k, c)
 		case *types.SetDenomMetadataProposal:
 			return keeper.HandleSetDenomMetadataProposal(ctx, k, c)
+		case *types.PauseRestrictedTransfersProposal:
+			return keeper.HandlePauseRestrictedTransfersProposal(ctx, k, c)
+		case *types.ResumeRestrictedTransfersProposal:
+			return keeper.HandleResumeRestrictedTransfersProposal(ctx, k, c)
+		case *types.SetIbcRateLimitProposal:
+			return keeper.HandleSetIbcRateLimitProposal(ctx, k, c)
+		case *types.RemoveIbcRateLimitProposal:
+			return keeper.HandleRemoveIbcRateLimitProposal(ctx, k, c)
+		case *types.MigrateDenomProposal:
+			return keeper.HandleMigrateDenomProposal(ctx, k, c)
+		case *types.SetTransferFeeProposal:
+			return keeper.HandleSetTransferFeeProposal(ctx, k, c)
+		case *types.RemoveTransferFeeProposal:
+			return keeper.HandleRemoveTransferFeeProposal(ctx, k, c)
+		case *types.AddToDenyListProposal:
+			return keeper.HandleAddToDenyListProposal(ctx, k, c)
+		case *types.RemoveFromDenyListProposal:
+			return keeper.HandleRemoveFromDenyListProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized marker proposal content type: %T", c)
 		}
 	}
 }
+
+// NewParamChangeProposalHandler wraps the params module proposal handler so that a typed event with the updated marker
+// params is emitted whenever a parameter change proposal changes a marker param.
+func NewParamChangeProposalHandler(k keeper.Keeper, next govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := next(ctx, content); err != nil {
+			return err
+		}
+		c, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+		for _, change := range c.Changes {
+			if change.Subspace == types.ModuleName {
+				return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerParamsUpdated(k.GetParams(ctx)))
+			}
+		}
+		return nil
+	}
+}
@@ -19,11 +19,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	"github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/x/marker"
 	"github.com/provenance-io/provenance/x/marker/keeper"
 	"github.com/provenance-io/provenance/x/marker/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
 
 type HandlerTestSuite struct {
@@ -268,7 +271,13 @@ func (s HandlerTestSuite) TestMsgFinalizeMarkerRequest() {
 			types.NewMsgFinalizeRequest(hotdogDenom, s.user1Addr),
 			[]string{s.user1},
 			"",
-			types.NewEventMarkerFinalize(hotdogDenom, s.user1),
+			types.NewEventMarkerFinalize(hotdogDenom, s.user1, &types.FinalizeValidationSummary{
+				RequiredAccess:         []types.RequiredAccess{{Access: types.Access_Withdraw, Addresses: []string{}}},
+				Supply:                 sdk.NewInt64Coin(hotdogDenom, 100),
+				SupplyFixed:            true,
+				AllowGovernanceControl: true,
+				Warnings:               []string{"no address has been granted ACCESS_WITHDRAW", "denom metadata has not been set"},
+			}),
 		},
 	}
 	s.runTests(cases)
@@ -599,3 +608,50 @@ func (s HandlerTestSuite) TestMsgSetDenomMetadataRequest() {
 	}
 	s.runTests(cases)
 }
+
+func (s HandlerTestSuite) TestLegacyMessageEvents() {
+	for _, emitLegacy := range []bool{true, false} {
+		params := s.app.MarkerKeeper.GetParams(s.ctx)
+		params.EmitLegacyEvents = emitLegacy
+		s.app.MarkerKeeper.SetParams(s.ctx, params)
+
+		denom := fmt.Sprintf("legacy%t", emitLegacy)
+		result, err := s.handler(s.ctx, types.NewMsgAddMarkerRequest(denom, sdk.NewInt(100), s.user1Addr, s.user1Addr, types.MarkerType_Coin, true, true))
+		s.Require().NoError(err)
+
+		var legacyFound bool
+		for _, e := range result.GetEvents() {
+			if e.Type == sdk.EventTypeMessage {
+				legacyFound = true
+			}
+		}
+		s.Assert().Equal(emitLegacy, legacyFound, "legacy message event emitted")
+		s.Assert().True(s.containsMessage(result, types.NewEventMarkerAdd(denom, "100", types.StatusProposed.String(), s.user1, types.MarkerType_Coin.String())))
+	}
+}
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	app := app.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	handler := marker.NewParamChangeProposalHandler(app.MarkerKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.ParamStoreKeyEmitLegacyEvents), "false"),
+	})))
+	require.False(t, app.MarkerKeeper.GetEmitLegacyEvents(ctx))
+	events := ctx.EventManager().ABCIEvents()
+	require.NotEmpty(t, events)
+	event, err := sdk.ParseTypedEvent(events[len(events)-1])
+	require.NoError(t, err)
+	require.Equal(t, types.NewEventMarkerParamsUpdated(app.MarkerKeeper.GetParams(ctx)), event)
+
+	// Changes to other modules do not emit a marker event.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(nametypes.ModuleName, string(nametypes.ParamStoreKeyMaxNameLevels), "5"),
+	})))
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		require.NotEqual(t, "provenance.marker.v1.EventMarkerParamsUpdated", e.Type)
+	}
+}
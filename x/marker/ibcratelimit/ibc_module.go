@@ -0,0 +1,99 @@
+package ibcratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/provenance-io/provenance/x/marker/keeper"
+)
+
+// IBCMiddleware wraps the transfer module ibc callbacks so that packets received over a channel are checked against the
+// recv quota of the rate limit of their denom, and failed sends are removed from the outflow.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// NewIBCMiddleware creates ibc callbacks that rate limit the marker denoms received by the transfer module.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket rejects the packet with an error acknowledgement if the amount received would exceed the recv quota of
+// the rate limit of the denom over the destination channel.  The amount is recorded in the inflow once received.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	denom := receivedDenom(packet, data.Denom)
+	amount := sdk.NewIntFromUint64(data.Amount)
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := im.keeper.TrackIbcRecv(cacheCtx, packet.GetDestChannel(), denom, amount); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || ack.Success() {
+		writeCache()
+	}
+	return ack
+}
+
+// OnAcknowledgementPacket removes the amount of a send that failed on the counterparty from the outflow.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	res, err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	if err != nil {
+		return res, err
+	}
+	var ack channeltypes.Acknowledgement
+	if err = transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil && !ack.Success() {
+		im.revertSend(ctx, packet)
+	}
+	return res, nil
+}
+
+// OnTimeoutPacket removes the amount of a send that timed out from the outflow.
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) (*sdk.Result, error) {
+	res, err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer)
+	if err != nil {
+		return res, err
+	}
+	im.revertSend(ctx, packet)
+	return res, nil
+}
+
+func (im IBCMiddleware) revertSend(ctx sdk.Context, packet channeltypes.Packet) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	im.keeper.RevertIbcSend(ctx, packet.GetSourceChannel(), denom, sdk.NewIntFromUint64(data.Amount))
+}
+
+// receivedDenom returns the denom of the coin the transfer module gives the receiver of a packet: the unprefixed denom
+// of a coin returning to this chain, or the voucher denom of a coin from the counterparty chain.
+func receivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		prefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(denom[len(prefix):]).IBCDenom()
+	}
+	prefixed := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+}
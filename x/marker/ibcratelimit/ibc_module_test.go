@@ -0,0 +1,119 @@
+package ibcratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/modules/core/exported"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/ibcratelimit"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// mockTransferModule stands in for the transfer module callbacks wrapped by the middleware.
+type mockTransferModule struct {
+	porttypes.IBCModule
+
+	ack ibcexported.Acknowledgement
+}
+
+func (m mockTransferModule) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) ibcexported.Acknowledgement {
+	return m.ack
+}
+
+func (m mockTransferModule) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) (*sdk.Result, error) {
+	return &sdk.Result{}, nil
+}
+
+func (m mockTransferModule) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) (*sdk.Result, error) {
+	return &sdk.Result{}, nil
+}
+
+// mockChannelKeeper counts the packets sent through the wrapped channel keeper.
+type mockChannelKeeper struct {
+	transfertypes.ChannelKeeper
+
+	sent *int
+}
+
+func (m mockChannelKeeper) SendPacket(sdk.Context, *capabilitytypes.Capability, ibcexported.PacketI) error {
+	*m.sent++
+	return nil
+}
+
+func transferPacket(denom string, amount uint64, srcChannel, dstChannel string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, amount, "sender", "receiver")
+	return channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, srcChannel, transfertypes.PortID, dstChannel,
+		clienttypes.ZeroHeight(), 0)
+}
+
+func TestIbcRateLimitMiddleware(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	user := types.MustGetMarkerAddress("test")
+
+	mac := types.NewEmptyMarkerAccount("ratecoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("ratecoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "ratecoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "ratecoin"))
+	require.NoError(t, markerkeeper.HandleSetIbcRateLimitProposal(ctx, app.MarkerKeeper,
+		types.NewSetIbcRateLimitProposal("title", "description", "ratecoin", "channel-0", 10, 10, 24)))
+
+	sent := 0
+	wrapper := ibcratelimit.NewICS4Wrapper(mockChannelKeeper{sent: &sent}, app.MarkerKeeper)
+
+	// sends of the native marker over the channel are limited
+	require.NoError(t, wrapper.SendPacket(ctx, nil, transferPacket("ratecoin", 100, "channel-0", "channel-7")))
+	err := wrapper.SendPacket(ctx, nil, transferPacket("ratecoin", 1, "channel-0", "channel-7"))
+	require.ErrorIs(t, err, types.ErrIbcRateLimitExceeded)
+	require.NoError(t, wrapper.SendPacket(ctx, nil, transferPacket("ratecoin", 100, "channel-1", "channel-8")))
+	require.Equal(t, 2, sent)
+
+	// a timed out send is refunded and removed from the outflow
+	middleware := ibcratelimit.NewIBCMiddleware(mockTransferModule{ack: channeltypes.NewResultAcknowledgement([]byte{1})}, app.MarkerKeeper)
+	_, err = middleware.OnTimeoutPacket(ctx, transferPacket("ratecoin", 50, "channel-0", "channel-7"), nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(50), app.MarkerKeeper.GetIbcRateLimit(ctx, "ratecoin", "channel-0").Flow.Outflow)
+
+	// a send that failed on the counterparty is removed from the outflow, a successful one is not
+	errAck := channeltypes.NewErrorAcknowledgement("failed")
+	_, err = middleware.OnAcknowledgementPacket(ctx, transferPacket("ratecoin", 20, "channel-0", "channel-7"), errAck.Acknowledgement(), nil)
+	require.NoError(t, err)
+	okAck := channeltypes.NewResultAcknowledgement([]byte{1})
+	_, err = middleware.OnAcknowledgementPacket(ctx, transferPacket("ratecoin", 20, "channel-0", "channel-7"), okAck.Acknowledgement(), nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(30), app.MarkerKeeper.GetIbcRateLimit(ctx, "ratecoin", "channel-0").Flow.Outflow)
+
+	// nothing is recorded when the transfer module fails to receive the packet
+	failing := ibcratelimit.NewIBCMiddleware(mockTransferModule{ack: errAck}, app.MarkerKeeper)
+	ack := failing.OnRecvPacket(ctx, transferPacket("transfer/channel-7/ratecoin", 50, "channel-7", "channel-0"), nil)
+	require.False(t, ack.Success())
+	require.Equal(t, sdk.ZeroInt(), app.MarkerKeeper.GetIbcRateLimit(ctx, "ratecoin", "channel-0").Flow.Inflow)
+
+	// the marker returning from the counterparty is received as the native denom
+	ack = middleware.OnRecvPacket(ctx, transferPacket("transfer/channel-7/ratecoin", 130, "channel-7", "channel-0"), nil)
+	require.True(t, ack.Success())
+	require.Equal(t, sdk.NewInt(130), app.MarkerKeeper.GetIbcRateLimit(ctx, "ratecoin", "channel-0").Flow.Inflow)
+	ack = middleware.OnRecvPacket(ctx, transferPacket("transfer/channel-7/ratecoin", 1, "channel-7", "channel-0"), nil)
+	require.False(t, ack.Success())
+
+	// sends of markers are rejected while every marker is paused by governance
+	app.MarkerKeeper.SetTransferPause(ctx, *types.NewGlobalTransferPause(20))
+	err = wrapper.SendPacket(ctx, nil, transferPacket("ratecoin", 1, "channel-1", "channel-8"))
+	require.ErrorIs(t, err, types.ErrMarkerPaused)
+	require.NoError(t, wrapper.SendPacket(ctx, nil, transferPacket("transfer/channel-7/othercoin", 1, "channel-1", "channel-8")))
+	require.Equal(t, 3, sent)
+}
@@ -0,0 +1,44 @@
+package ibcratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/provenance-io/provenance/x/marker/keeper"
+)
+
+// ICS4Wrapper wraps the channel keeper given to the transfer keeper so that packets sent over a channel are checked
+// against the send quota of the rate limit of their denom and against a governance pause of every marker.
+type ICS4Wrapper struct {
+	transfertypes.ChannelKeeper
+
+	keeper keeper.Keeper
+}
+
+var _ transfertypes.ChannelKeeper = ICS4Wrapper{}
+
+// NewICS4Wrapper creates a channel keeper that rate limits the marker denoms sent by the transfer module.
+func NewICS4Wrapper(channelKeeper transfertypes.ChannelKeeper, k keeper.Keeper) ICS4Wrapper {
+	return ICS4Wrapper{
+		ChannelKeeper: channelKeeper,
+		keeper:        k,
+	}
+}
+
+// SendPacket records the amount sent in the outflow of the rate limit of the denom over the source channel, failing
+// the send if it would exceed the quota or if every marker is paused by governance.
+func (w ICS4Wrapper) SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err == nil {
+		denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+		if err = w.keeper.CheckSendPaused(ctx, sdk.Coins{{Denom: denom, Amount: sdk.NewIntFromUint64(data.Amount)}}); err != nil {
+			return err
+		}
+		if err = w.keeper.TrackIbcSend(ctx, packet.GetSourceChannel(), denom, sdk.NewIntFromUint64(data.Amount)); err != nil {
+			return err
+		}
+	}
+	return w.ChannelKeeper.SendPacket(ctx, channelCap, packet)
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetMarkerGrants returns the unexpired authz grants of marker msgs from the manager of a marker or any address with
+// access to it.  All grants are iterated so this should not be used in msg services.
+func (k Keeper) GetMarkerGrants(ctx sdk.Context, marker types.MarkerAccountI) []types.MarkerGrant {
+	granters := markerGranters(marker)
+	grantsByGranter := k.getMarkerGrantsByGranter(ctx, granters)
+	grants := []types.MarkerGrant{}
+	for _, granter := range granters {
+		grants = append(grants, grantsByGranter[granter]...)
+	}
+	return grants
+}
+
+// markerGranters returns the addresses whose authz grants of marker msgs are reported for a marker, its manager and
+// every address with access to it, in that order without duplicates.
+func markerGranters(marker types.MarkerAccountI) []string {
+	var granters []string
+	seen := make(map[string]bool)
+	add := func(addr string) {
+		if addr != "" && !seen[addr] {
+			seen[addr] = true
+			granters = append(granters, addr)
+		}
+	}
+	if manager := marker.GetManager(); !manager.Empty() {
+		add(manager.String())
+	}
+	for _, grant := range marker.GetAccessList() {
+		add(grant.Address)
+	}
+	return granters
+}
+
+// getMarkerGrantsByGranter returns the unexpired authz grants of marker msgs from any of the given granters, indexed
+// by granter, with a single pass over all grants.
+func (k Keeper) getMarkerGrantsByGranter(ctx sdk.Context, granterLists ...[]string) map[string][]types.MarkerGrant {
+	grants := make(map[string][]types.MarkerGrant)
+	for _, granters := range granterLists {
+		for _, granter := range granters {
+			grants[granter] = nil
+		}
+	}
+	if len(grants) == 0 {
+		return grants
+	}
+	k.authzKeeper.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant authz.Grant) bool {
+		key := granter.String()
+		if _, wanted := grants[key]; !wanted || grant.Expiration.Before(ctx.BlockTime()) {
+			return false
+		}
+		if authorization := grant.GetAuthorization(); authorization != nil && types.IsMarkerMsgTypeURL(authorization.MsgTypeURL()) {
+			grants[key] = append(grants[key], types.MarkerGrant{Granter: key, Grantee: grantee.String(), Grant: grant})
+		}
+		return false
+	})
+	return grants
+}
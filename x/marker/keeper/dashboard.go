@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetIssuerDashboard returns the dashboard summary of each marker the address manages or holds unexpired admin access
+// on.  All markers and authz grants are iterated once so this should not be used in msg services.
+func (k Keeper) GetIssuerDashboard(
+	ctx sdk.Context, addr sdk.AccAddress, activityBlocks int64, expiringWithin time.Duration,
+) []types.IssuerDashboardMarker {
+	proposalIDs := k.getPendingProposalIDsByDenom(ctx)
+	expiresBy := ctx.BlockTime().Add(expiringWithin)
+
+	markers := []types.IssuerDashboardMarker{}
+	var granters [][]string
+	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		if !marker.GetManager().Equals(addr) && !marker.AddressHasAccessAt(addr, types.Access_Admin, ctx.BlockTime()) {
+			return false
+		}
+		denom := marker.GetDenom()
+		entry := types.IssuerDashboardMarker{
+			Denom:                denom,
+			Status:               marker.GetStatus(),
+			Supply:               marker.GetSupply(),
+			Circulation:          sdk.NewCoin(denom, k.CurrentCirculation(ctx, marker)),
+			Escrow:               k.GetEscrow(ctx, marker),
+			RecentEscrowDeposits: k.countEscrowDepositsSince(ctx, marker.GetAddress(), ctx.BlockHeight()-activityBlocks+1),
+			RecentWithdrawals:    k.countCurrentWithdrawals(ctx, marker.GetAddress()),
+			PendingProposalIds:   proposalIDs[denom],
+		}
+		for _, grant := range marker.GetAccessList() {
+			if grant.Expiration != nil && !grant.IsExpired(ctx.BlockTime()) && !grant.Expiration.After(expiresBy) {
+				entry.ExpiringAccess = append(entry.ExpiringAccess, grant)
+			}
+		}
+		markers = append(markers, entry)
+		granters = append(granters, markerGranters(marker))
+		return false
+	})
+
+	grantsByGranter := k.getMarkerGrantsByGranter(ctx, granters...)
+	for i := range markers {
+		for _, granter := range granters[i] {
+			for _, grant := range grantsByGranter[granter] {
+				if !grant.Grant.Expiration.After(expiresBy) {
+					markers[i].ExpiringAuthzGrants = append(markers[i].ExpiringAuthzGrants, grant)
+				}
+			}
+		}
+	}
+	return markers
+}
+
+// countEscrowDepositsSince returns the number of escrow deposits to a marker recorded at or after the block height.
+func (k Keeper) countEscrowDepositsSince(ctx sdk.Context, markerAddr sdk.AccAddress, height int64) uint64 {
+	if height < 0 {
+		height = 0
+	}
+	prefix := types.EscrowDepositsPrefix(markerAddr)
+	it := ctx.KVStore(k.storeKey).Iterator(
+		append(prefix, sdk.Uint64ToBigEndian(uint64(height))...),
+		sdk.PrefixEndBytes(prefix),
+	)
+	defer it.Close()
+	var count uint64
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	return count
+}
+
+// countCurrentWithdrawals returns the number of withdrawals recorded within the current window of each withdraw
+// allowance on a marker.
+func (k Keeper) countCurrentWithdrawals(ctx sdk.Context, markerAddr sdk.AccAddress) uint64 {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.WithdrawAllowancesPrefix(markerAddr))
+	defer it.Close()
+	var count uint64
+	for ; it.Valid(); it.Next() {
+		var allowance types.WithdrawAllowance
+		k.cdc.MustUnmarshal(it.Value(), &allowance)
+		allowance.Prune(ctx.BlockTime())
+		count += uint64(len(allowance.Withdrawals))
+	}
+	return count
+}
+
+// getPendingProposalIDsByDenom returns the ids of the governance proposals in the deposit or voting period by the
+// marker denoms their content names.
+func (k Keeper) getPendingProposalIDsByDenom(ctx sdk.Context) map[string][]uint64 {
+	proposalIDs := make(map[string][]uint64)
+	addProposal := func(proposal govtypes.Proposal) bool {
+		for _, denom := range types.ProposalDenoms(proposal.GetContent()) {
+			proposalIDs[denom] = append(proposalIDs[denom], proposal.ProposalId)
+		}
+		return false
+	}
+	// Every queued proposal ends its period before the end of time.
+	endOfTime := time.Unix(253402300799, 0)
+	k.govKeeper.IterateInactiveProposalsQueue(ctx, endOfTime, addProposal)
+	k.govKeeper.IterateActiveProposalsQueue(ctx, endOfTime, addProposal)
+	return proposalIDs
+}
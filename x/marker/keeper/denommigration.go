@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetDenomMigration returns the migration of a marker denom if one has been started.
+func (k Keeper) GetDenomMigration(ctx sdk.Context, fromDenom string) *types.DenomMigration {
+	bz := ctx.KVStore(k.storeKey).Get(types.DenomMigrationKey(fromDenom))
+	if bz == nil {
+		return nil
+	}
+	var migration types.DenomMigration
+	k.cdc.MustUnmarshal(bz, &migration)
+	return &migration
+}
+
+// SetDenomMigration stores the migration of a marker denom.
+func (k Keeper) SetDenomMigration(ctx sdk.Context, migration types.DenomMigration) error {
+	if err := migration.Validate(); err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.DenomMigrationKey(migration.FromDenom), k.cdc.MustMarshal(&migration))
+	return nil
+}
+
+// IterateDenomMigrations processes all denom migrations with the given handler function.
+func (k Keeper) IterateDenomMigrations(ctx sdk.Context, handler func(types.DenomMigration) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DenomMigrationKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var migration types.DenomMigration
+		k.cdc.MustUnmarshal(it.Value(), &migration)
+		if handler(migration) {
+			break
+		}
+	}
+}
+
+// IsDenomMigrated returns true if the holders of a marker denom are being, or have been, migrated to a new denom.
+func (k Keeper) IsDenomMigrated(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DenomMigrationKey(denom))
+}
+
+// SetDenomMigrationHolder stores a holder waiting to be migrated to the new denom.
+func (k Keeper) SetDenomMigrationHolder(ctx sdk.Context, holder types.DenomMigrationHolder) error {
+	if err := holder.Validate(); err != nil {
+		return err
+	}
+	addr, err := sdk.AccAddressFromBech32(holder.Address)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.DenomMigrationHolderKey(holder.FromDenom, addr), k.cdc.MustMarshal(&holder))
+	return nil
+}
+
+// IterateDenomMigrationHolders processes the holders of a marker denom waiting to be migrated with the given handler
+// function.
+func (k Keeper) IterateDenomMigrationHolders(ctx sdk.Context, fromDenom string, handler func(types.DenomMigrationHolder) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DenomMigrationHoldersPrefix(fromDenom))
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var holder types.DenomMigrationHolder
+		k.cdc.MustUnmarshal(it.Value(), &holder)
+		if handler(holder) {
+			break
+		}
+	}
+}
+
+// StartDenomMigration creates a successor marker under the new denom and freezes the marker of the old denom.  Every
+// holder of the old denom is then recorded and minted a matching balance of the new denom over the following blocks.
+// The successor marker copies the access list, type and governance setting of the old marker.
+func (k Keeper) StartDenomMigration(ctx sdk.Context, fromDenom, toDenom string, holdersPerBlock uint32) (*types.DenomMigration, error) {
+	from, err := k.GetMarkerByDenom(ctx, fromDenom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %s", fromDenom, err)
+	}
+	if !from.HasGovernanceEnabled() {
+		return nil, fmt.Errorf("%s marker does not allow governance control", fromDenom)
+	}
+	if from.GetStatus() != types.StatusActive {
+		return nil, fmt.Errorf("cannot migrate a marker that is not in Active status")
+	}
+	if k.IsDenomMigrated(ctx, fromDenom) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomMigrated, "%s marker", fromDenom)
+	}
+	if from.IsReference() {
+		return nil, sdkerrors.Wrapf(types.ErrReferenceMarker, "cannot migrate %s", fromDenom)
+	}
+	toAddr, err := types.MarkerAddress(toDenom)
+	if err != nil {
+		return nil, err
+	}
+	if existing, _ := k.GetMarker(ctx, toAddr); existing != nil {
+		return nil, fmt.Errorf("%s marker already exists", toDenom)
+	}
+	if supply := k.bankKeeper.GetSupply(ctx, toDenom); !supply.IsZero() {
+		return nil, fmt.Errorf("%s already has a supply of %s", toDenom, supply)
+	}
+
+	migration := types.NewDenomMigration(fromDenom, toDenom, holdersPerBlock, ctx.BlockHeight())
+	to := types.NewEmptyMarkerAccount(toDenom, from.GetManager().String(), from.GetAccessList())
+	to.MarkerType = from.GetMarkerType()
+	to.SupplyFixed = from.HasFixedSupply()
+	to.AllowGovernanceControl = from.HasGovernanceEnabled()
+	to.CreatedBy = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	if err = to.SetStatus(types.StatusActive); err != nil {
+		return nil, err
+	}
+	if err = k.AddMarkerAccount(ctx, to); err != nil {
+		return nil, err
+	}
+	k.ensureSendEnabledStatus(ctx, toDenom, to.MarkerType != types.MarkerType_RestrictedCoin)
+	k.ensureSendEnabledStatus(ctx, fromDenom, false)
+
+	if err = k.SetDenomMigration(ctx, *migration); err != nil {
+		return nil, err
+	}
+	return migration, nil
+}
+
+// ProcessDenomMigrations records the holders of the old denom from the next batch of accounts of each migration taking
+// its snapshot, mints the new denom to the next batch of waiting holders of each migration in progress and completes
+// the migrations with no holders left.  A migration that cannot be processed is logged and tried again in the next
+// block.
+func (k Keeper) ProcessDenomMigrations(ctx sdk.Context) {
+	var due []types.DenomMigration
+	k.IterateDenomMigrations(ctx, func(m types.DenomMigration) bool {
+		if !m.IsComplete() {
+			due = append(due, m)
+		}
+		return false
+	})
+	for _, m := range due {
+		cacheCtx, writeCache := ctx.CacheContext()
+		process := k.migrateDenomHolders
+		if m.IsSnapshot() {
+			process = k.snapshotDenomHolders
+		}
+		if err := process(cacheCtx, m); err != nil {
+			k.Logger(ctx).Error("unable to process denom migration", "denom", m.FromDenom, "new denom", m.ToDenom, "err", err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// snapshotDenomHolders records the holders of the old denom among up to holders per block accounts, continuing from
+// where the snapshot of the previous block stopped.  The old denom is frozen so the balances cannot change while the
+// snapshot is taken.  Once every account is checked the migration of the recorded holders starts.
+func (k Keeper) snapshotDenomHolders(ctx sdk.Context, m types.DenomMigration) error {
+	page := &query.PageRequest{Key: m.SnapshotNextKey, Limit: uint64(m.HoldersPerBlock)}
+	res, err := k.authKeeper.Accounts(sdk.WrapSDKContext(ctx), &authtypes.QueryAccountsRequest{Pagination: page})
+	if err != nil {
+		return err
+	}
+	for _, accAny := range res.Accounts {
+		acc, ok := accAny.GetCachedValue().(authtypes.AccountI)
+		if !ok {
+			return fmt.Errorf("unable to read account %s", accAny.TypeUrl)
+		}
+		balance := k.bankKeeper.GetBalance(ctx, acc.GetAddress(), m.FromDenom)
+		if !balance.Amount.IsPositive() {
+			continue
+		}
+		holder := types.DenomMigrationHolder{FromDenom: m.FromDenom, Address: acc.GetAddress().String(), Amount: balance.Amount}
+		if err = k.SetDenomMigrationHolder(ctx, holder); err != nil {
+			return err
+		}
+		m.TotalHolders++
+		m.FromSupply = m.FromSupply.Add(balance.Amount)
+	}
+
+	m.SnapshotNextKey = res.Pagination.GetNextKey()
+	if len(m.SnapshotNextKey) == 0 {
+		m.Status = types.DenomMigrationStatusInProgress
+	}
+	if err = k.SetDenomMigration(ctx, m); err != nil {
+		return err
+	}
+	if m.Status == types.DenomMigrationStatusInProgress {
+		return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDenomMigrationStarted(m))
+	}
+	return nil
+}
+
+// migrateDenomHolders mints the new denom to up to holders per block waiting holders of a migration.  The balance
+// held by the old marker escrow is minted into the escrow of the successor marker.
+func (k Keeper) migrateDenomHolders(ctx sdk.Context, m types.DenomMigration) error {
+	var batch []types.DenomMigrationHolder
+	k.IterateDenomMigrationHolders(ctx, m.FromDenom, func(holder types.DenomMigrationHolder) bool {
+		batch = append(batch, holder)
+		return uint32(len(batch)) >= m.HoldersPerBlock
+	})
+
+	to, err := k.GetMarkerByDenom(ctx, m.ToDenom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", m.ToDenom, err)
+	}
+	fromAddr := types.MustGetMarkerAddress(m.FromDenom)
+	for _, holder := range batch {
+		addr, err := sdk.AccAddressFromBech32(holder.Address)
+		if err != nil {
+			return err
+		}
+		coin := sdk.NewCoin(m.ToDenom, holder.Amount)
+		if err = k.IncreaseSupply(ctx, to, coin); err != nil {
+			return err
+		}
+		recipient := to.GetAddress()
+		if !addr.Equals(fromAddr) {
+			recipient = addr
+			if err = k.bankKeeper.SendCoins(ctx, to.GetAddress(), addr, sdk.NewCoins(coin)); err != nil {
+				return err
+			}
+		}
+		ctx.KVStore(k.storeKey).Delete(types.DenomMigrationHolderKey(m.FromDenom, addr))
+		m.MigratedHolders++
+		m.MigratedAmount = m.MigratedAmount.Add(holder.Amount)
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDenomMigrated(m, recipient.String(), coin)); err != nil {
+			return err
+		}
+	}
+
+	if uint32(len(batch)) < m.HoldersPerBlock || m.MigratedHolders == m.TotalHolders {
+		m.Status = types.DenomMigrationStatusComplete
+		m.CompletedHeight = ctx.BlockHeight()
+	}
+	if err = k.SetDenomMigration(ctx, m); err != nil {
+		return err
+	}
+	if m.IsComplete() {
+		k.Logger(ctx).Info("denom migration complete", "denom", m.FromDenom, "new denom", m.ToDenom,
+			"holders", m.MigratedHolders, "amount", m.MigratedAmount.String())
+		return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDenomMigrationCompleted(m))
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetDenyListEntry returns the deny list entry of an address if it is denied transfers of restricted markers.
+func (k Keeper) GetDenyListEntry(ctx sdk.Context, addr sdk.AccAddress) *types.DenyListEntry {
+	bz := ctx.KVStore(k.storeKey).Get(types.DenyListKey(addr))
+	if bz == nil {
+		return nil
+	}
+	var entry types.DenyListEntry
+	k.cdc.MustUnmarshal(bz, &entry)
+	return &entry
+}
+
+// SetDenyListEntry stores the deny list entry of an address, replacing any existing entry.
+func (k Keeper) SetDenyListEntry(ctx sdk.Context, entry types.DenyListEntry) error {
+	addr, err := sdk.AccAddressFromBech32(entry.Address)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.DenyListKey(addr), k.cdc.MustMarshal(&entry))
+	return nil
+}
+
+// RemoveDenyListEntry removes an address from the deny list.
+func (k Keeper) RemoveDenyListEntry(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.DenyListKey(addr))
+}
+
+// IsDenyListed returns true if the address is denied transfers of restricted markers.
+func (k Keeper) IsDenyListed(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DenyListKey(addr))
+}
+
+// IterateDenyList processes all deny list entries with the given handler function.
+func (k Keeper) IterateDenyList(ctx sdk.Context, handler func(types.DenyListEntry) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DenyListKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var entry types.DenyListEntry
+		k.cdc.MustUnmarshal(it.Value(), &entry)
+		if handler(entry) {
+			break
+		}
+	}
+}
+
+// checkDenyList returns an error if any of the addresses is denied transfers of restricted markers.
+func (k Keeper) checkDenyList(ctx sdk.Context, addrs ...sdk.AccAddress) error {
+	for _, addr := range addrs {
+		if k.IsDenyListed(ctx, addr) {
+			return sdkerrors.Wrapf(types.ErrDenyListed, "%s", addr)
+		}
+	}
+	return nil
+}
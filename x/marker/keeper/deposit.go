@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// RecordEscrowDeposit records coin sent from an address directly to a marker escrow account and emits an
+// EventMarkerEscrowDeposit.  Sends to accounts that are not markers are ignored.  The from address is empty when
+// several inputs funded the send.  Only the most recent MaxEscrowDeposits deposits of each marker are kept.
+func (k Keeper) RecordEscrowDeposit(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	marker, ok := k.authKeeper.GetAccount(ctx, to).(types.MarkerAccountI)
+	if !ok || amount.IsZero() {
+		return nil
+	}
+	deposit := types.NewEscrowDeposit(marker.GetDenom(), "", amount, ctx.BlockHeight())
+	if !from.Empty() {
+		deposit.FromAddress = from.String()
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := types.EscrowDepositKey(to, deposit.Height, from)
+	// Multiple deposits from the same address in a block are combined.
+	if bz := store.Get(key); bz != nil {
+		var existing types.EscrowDeposit
+		k.cdc.MustUnmarshal(bz, &existing)
+		deposit.Amount = deposit.Amount.Add(existing.Amount...)
+	}
+	k.setEscrowDeposit(ctx, to, key, deposit)
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerEscrowDeposit(amount.String(), marker.GetDenom(), deposit.FromAddress))
+}
+
+// SetEscrowDeposit stores a record of coin sent directly to a marker escrow account, replacing any existing record
+// from the same address at the same height.  The oldest record of the marker is pruned when the new record would
+// exceed MaxEscrowDeposits.
+func (k Keeper) SetEscrowDeposit(ctx sdk.Context, deposit types.EscrowDeposit) error {
+	markerAddr, err := types.MarkerAddress(deposit.Denom)
+	if err != nil {
+		return err
+	}
+	var from sdk.AccAddress
+	if len(deposit.FromAddress) > 0 {
+		if from, err = sdk.AccAddressFromBech32(deposit.FromAddress); err != nil {
+			return err
+		}
+	}
+	k.setEscrowDeposit(ctx, markerAddr, types.EscrowDepositKey(markerAddr, deposit.Height, from), &deposit)
+	return nil
+}
+
+// setEscrowDeposit stores an escrow deposit record under its key.  A new record is counted against the marker and
+// the oldest record of the marker is pruned once there are more than MaxEscrowDeposits.
+func (k Keeper) setEscrowDeposit(ctx sdk.Context, markerAddr sdk.AccAddress, key []byte, deposit *types.EscrowDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	isNew := !store.Has(key)
+	store.Set(key, k.cdc.MustMarshal(deposit))
+	if !isNew {
+		return
+	}
+
+	countKey := types.EscrowDepositCountKey(markerAddr)
+	var count uint64
+	if bz := store.Get(countKey); bz != nil {
+		count = sdk.BigEndianToUint64(bz)
+	}
+	count++
+	if count > types.MaxEscrowDeposits {
+		iterator := sdk.KVStorePrefixIterator(store, types.EscrowDepositsPrefix(markerAddr))
+		oldest := iterator.Key()
+		iterator.Close()
+		store.Delete(oldest)
+		count--
+	}
+	store.Set(countKey, sdk.Uint64ToBigEndian(count))
+}
+
+// GetAllEscrowDeposits returns all records of coin sent directly to marker escrow accounts.
+func (k Keeper) GetAllEscrowDeposits(ctx sdk.Context) []types.EscrowDeposit {
+	deposits := []types.EscrowDeposit{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EscrowDepositKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.EscrowDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+	return deposits
+}
+
+// escrowDepositMsgServer wraps the bank module msg server to record coin sent to marker escrow accounts.
+type escrowDepositMsgServer struct {
+	banktypes.MsgServer
+	keeper Keeper
+}
+
+// NewEscrowDepositMsgServer returns a bank MsgServer that records successful sends to marker escrow accounts as
+// escrow deposits.  Marker operations move coin with the bank keeper directly so they are not recorded.  Sends of
+// marker coin are rejected while every marker is paused by governance.
+func NewEscrowDepositMsgServer(bankMsgServer banktypes.MsgServer, keeper Keeper) banktypes.MsgServer {
+	return &escrowDepositMsgServer{MsgServer: bankMsgServer, keeper: keeper}
+}
+
+var _ banktypes.MsgServer = escrowDepositMsgServer{}
+
+// Send sends coin with the bank module and records the send if the recipient is a marker
+func (s escrowDepositMsgServer) Send(goCtx context.Context, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	if err := s.keeper.CheckSendPaused(sdk.UnwrapSDKContext(goCtx), msg.Amount); err != nil {
+		return nil, err
+	}
+	res, err := s.MsgServer.Send(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+	// Addresses have been validated by the bank module.
+	from, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	to, _ := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err = s.keeper.RecordEscrowDeposit(sdk.UnwrapSDKContext(goCtx), from, to, msg.Amount); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MultiSend sends coin with the bank module and records each output to a marker
+func (s escrowDepositMsgServer) MultiSend(goCtx context.Context, msg *banktypes.MsgMultiSend) (*banktypes.MsgMultiSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, in := range msg.Inputs {
+		if err := s.keeper.CheckSendPaused(ctx, in.Coins); err != nil {
+			return nil, err
+		}
+	}
+	res, err := s.MsgServer.MultiSend(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+	// A deposit only has a sender when a single input funded the send.
+	var from sdk.AccAddress
+	if len(msg.Inputs) == 1 {
+		from, _ = sdk.AccAddressFromBech32(msg.Inputs[0].Address)
+	}
+	for _, out := range msg.Outputs {
+		to, _ := sdk.AccAddressFromBech32(out.Address)
+		if err = s.keeper.RecordEscrowDeposit(ctx, from, to, out.Coins); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
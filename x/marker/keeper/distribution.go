@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetDistribution returns the distribution with the given identifier if it exists.
+func (k Keeper) GetDistribution(ctx sdk.Context, id uint64) *types.Distribution {
+	bz := ctx.KVStore(k.storeKey).Get(types.DistributionKey(id))
+	if bz == nil {
+		return nil
+	}
+	var distribution types.Distribution
+	k.cdc.MustUnmarshal(bz, &distribution)
+	return &distribution
+}
+
+// SetDistribution stores a distribution, replacing any existing distribution with the same identifier.
+func (k Keeper) SetDistribution(ctx sdk.Context, distribution types.Distribution) {
+	ctx.KVStore(k.storeKey).Set(types.DistributionKey(distribution.Id), k.cdc.MustMarshal(&distribution))
+}
+
+// IterateDistributions processes all distributions in identifier order with the given handler function.
+func (k Keeper) IterateDistributions(ctx sdk.Context, handler func(types.Distribution) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DistributionKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var distribution types.Distribution
+		k.cdc.MustUnmarshal(it.Value(), &distribution)
+		if handler(distribution) {
+			break
+		}
+	}
+}
+
+// GetNextDistributionID returns the identifier the next scheduled distribution will be given.
+func (k Keeper) GetNextDistributionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextDistributionIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextDistributionID stores the identifier the next scheduled distribution will be given.
+func (k Keeper) SetNextDistributionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextDistributionIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetDistributionEntitlement returns the unclaimed share of a holder in a distribution if there is one.
+func (k Keeper) GetDistributionEntitlement(ctx sdk.Context, id uint64, holder sdk.AccAddress) *types.DistributionEntitlement {
+	bz := ctx.KVStore(k.storeKey).Get(types.DistributionEntitlementKey(id, holder))
+	if bz == nil {
+		return nil
+	}
+	var entitlement types.DistributionEntitlement
+	k.cdc.MustUnmarshal(bz, &entitlement)
+	return &entitlement
+}
+
+// SetDistributionEntitlement stores the unclaimed share of a holder in a distribution.
+func (k Keeper) SetDistributionEntitlement(ctx sdk.Context, entitlement types.DistributionEntitlement) error {
+	holder, err := sdk.AccAddressFromBech32(entitlement.Address)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.DistributionEntitlementKey(entitlement.DistributionId, holder), k.cdc.MustMarshal(&entitlement))
+	return nil
+}
+
+// IterateDistributionEntitlements processes all unclaimed shares of distributions with the given handler function.
+func (k Keeper) IterateDistributionEntitlements(ctx sdk.Context, handler func(types.DistributionEntitlement) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DistributionEntitlementKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var entitlement types.DistributionEntitlement
+		k.cdc.MustUnmarshal(it.Value(), &entitlement)
+		if handler(entitlement) {
+			break
+		}
+	}
+}
+
+// ScheduleDistribution deposits a payout from the caller into the marker module account to be shared among the
+// holders of the marker at the snapshot height.  The caller must have admin access on an active marker and the
+// payout denom must be send enabled.
+func (k Keeper) ScheduleDistribution(
+	ctx sdk.Context, caller sdk.AccAddress, denom string, payout sdk.Coin, snapshotHeight, claimWindow int64,
+) (uint64, error) {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return 0, fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	if !k.addressHasAccess(ctx, m, caller, types.Access_Admin) {
+		return 0, fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+	if m.GetStatus() != types.StatusActive {
+		return 0, fmt.Errorf("cannot schedule a distribution on a marker with status %s", m.GetStatus())
+	}
+	if snapshotHeight <= ctx.BlockHeight() {
+		return 0, fmt.Errorf("snapshot height %d must be after the current height %d", snapshotHeight, ctx.BlockHeight())
+	}
+	if !k.bankKeeper.IsSendEnabledCoin(ctx, payout) {
+		return 0, fmt.Errorf("payout denom %s is not send enabled", payout.Denom)
+	}
+
+	distribution := types.NewDistribution(k.GetNextDistributionID(ctx), denom, caller, payout, snapshotHeight, snapshotHeight+claimWindow)
+	if err = distribution.Validate(); err != nil {
+		return 0, err
+	}
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, caller, types.CoinPoolName, sdk.NewCoins(payout)); err != nil {
+		return 0, fmt.Errorf("could not deposit payout: %w", err)
+	}
+	k.SetDistribution(ctx, *distribution)
+	k.SetNextDistributionID(ctx, distribution.Id+1)
+	return distribution.Id, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDistributionScheduled(*distribution))
+}
+
+// ClaimDistribution pays the claimant their share of a claimable distribution from the marker module account.
+func (k Keeper) ClaimDistribution(ctx sdk.Context, id uint64, claimant sdk.AccAddress) (sdk.Coin, error) {
+	distribution := k.GetDistribution(ctx, id)
+	if distribution == nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrDistributionNotFound, "distribution %d", id)
+	}
+	if distribution.Status != types.DistributionStatusClaimable {
+		return sdk.Coin{}, fmt.Errorf("distribution %d cannot be claimed before its snapshot at height %d", id, distribution.SnapshotHeight)
+	}
+	entitlement := k.GetDistributionEntitlement(ctx, id, claimant)
+	if entitlement == nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrNoEntitlement, "%s has nothing to claim from distribution %d", claimant, id)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, claimant, sdk.NewCoins(entitlement.Amount)); err != nil {
+		return sdk.Coin{}, fmt.Errorf("could not pay distribution share: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.DistributionEntitlementKey(id, claimant))
+	distribution.Claimed = distribution.Claimed.Add(entitlement.Amount)
+	k.SetDistribution(ctx, *distribution)
+	return entitlement.Amount, ctx.EventManager().EmitTypedEvent(
+		types.NewEventMarkerDistributionClaimed(*distribution, claimant.String(), entitlement.Amount))
+}
+
+// ProcessDistributions takes the snapshots of the distributions scheduled at the current height and closes the
+// distributions whose claim window has ended.  A distribution that cannot be processed is logged and tried again in
+// the next block.
+func (k Keeper) ProcessDistributions(ctx sdk.Context) {
+	var due []types.Distribution
+	k.IterateDistributions(ctx, func(d types.Distribution) bool {
+		if (d.Status == types.DistributionStatusScheduled && d.SnapshotHeight <= ctx.BlockHeight()) ||
+			(d.Status == types.DistributionStatusClaimable && d.ClaimEndHeight <= ctx.BlockHeight()) {
+			due = append(due, d)
+		}
+		return false
+	})
+	for _, d := range due {
+		cacheCtx, writeCache := ctx.CacheContext()
+		var err error
+		if d.Status == types.DistributionStatusScheduled {
+			err = k.takeDistributionSnapshot(cacheCtx, d)
+		} else {
+			err = k.closeDistribution(cacheCtx, d)
+		}
+		if err != nil {
+			k.Logger(ctx).Error("unable to process distribution", "id", d.Id, "denom", d.Denom, "err", err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// takeDistributionSnapshot records the share of the payout owed to each holder of the marker denom.  The coin held
+// by the marker escrow and the marker module account is not counted as held.
+func (k Keeper) takeDistributionSnapshot(ctx sdk.Context, d types.Distribution) error {
+	excluded := map[string]bool{
+		types.MustGetMarkerAddress(d.Denom).String():               true,
+		k.authKeeper.GetModuleAddress(types.CoinPoolName).String(): true,
+	}
+	holdings := make(map[string]sdk.Int)
+	var holders []string
+	total := sdk.ZeroInt()
+	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if coin.Denom != d.Denom || !coin.Amount.IsPositive() || excluded[addr.String()] {
+			return false
+		}
+		holders = append(holders, addr.String())
+		holdings[addr.String()] = coin.Amount
+		total = total.Add(coin.Amount)
+		return false
+	})
+
+	d.Status = types.DistributionStatusClaimable
+	d.SnapshotTotal = total
+	for _, holder := range holders {
+		share := d.Share(holdings[holder])
+		if !share.IsPositive() {
+			continue
+		}
+		entitlement := types.DistributionEntitlement{DistributionId: d.Id, Address: holder, Balance: holdings[holder], Amount: share}
+		if err := k.SetDistributionEntitlement(ctx, entitlement); err != nil {
+			return err
+		}
+	}
+	k.SetDistribution(ctx, d)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDistributionSnapshot(d, uint64(len(holders))))
+}
+
+// closeDistribution returns the unclaimed payout of a distribution to the marker escrow, or to the administrator
+// if the marker no longer exists, and removes the distribution and its unclaimed shares.
+func (k Keeper) closeDistribution(ctx sdk.Context, d types.Distribution) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.DistributionEntitlementsPrefix(d.Id))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	store.Delete(types.DistributionKey(d.Id))
+
+	unclaimed := d.Unclaimed()
+	if unclaimed.IsPositive() {
+		recipient := types.MustGetMarkerAddress(d.Denom)
+		if m, err := k.GetMarker(ctx, recipient); err != nil || m == nil {
+			if recipient, err = sdk.AccAddressFromBech32(d.Administrator); err != nil {
+				return err
+			}
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, recipient, sdk.NewCoins(unclaimed)); err != nil {
+			return err
+		}
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDistributionClosed(d, unclaimed))
+}
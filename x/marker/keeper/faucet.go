@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetFaucetPolicy returns the faucet policy of a marker if one has been set.
+func (k Keeper) GetFaucetPolicy(ctx sdk.Context, markerAddr sdk.AccAddress) *types.FaucetPolicy {
+	bz := ctx.KVStore(k.storeKey).Get(types.FaucetPolicyKey(markerAddr))
+	if bz == nil {
+		return nil
+	}
+	var policy types.FaucetPolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+	return &policy
+}
+
+// SetFaucetPolicy stores the faucet policy of a marker, replacing any existing policy.
+func (k Keeper) SetFaucetPolicy(ctx sdk.Context, policy types.FaucetPolicy) error {
+	markerAddr, err := types.MarkerAddress(policy.Denom)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.FaucetPolicyKey(markerAddr), k.cdc.MustMarshal(&policy))
+	return nil
+}
+
+// IterateFaucetPolicies processes all faucet policies with the given handler function.
+func (k Keeper) IterateFaucetPolicies(ctx sdk.Context, handler func(types.FaucetPolicy) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FaucetPolicyKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var policy types.FaucetPolicy
+		k.cdc.MustUnmarshal(it.Value(), &policy)
+		if handler(policy) {
+			break
+		}
+	}
+}
+
+// GetFaucetClaim returns the last faucet claim of an address on a marker if it has claimed.
+func (k Keeper) GetFaucetClaim(ctx sdk.Context, markerAddr, claimant sdk.AccAddress) *types.FaucetClaim {
+	bz := ctx.KVStore(k.storeKey).Get(types.FaucetClaimKey(markerAddr, claimant))
+	if bz == nil {
+		return nil
+	}
+	var claim types.FaucetClaim
+	k.cdc.MustUnmarshal(bz, &claim)
+	return &claim
+}
+
+// SetFaucetClaim stores the last faucet claim of an address on a marker.
+func (k Keeper) SetFaucetClaim(ctx sdk.Context, claim types.FaucetClaim) error {
+	markerAddr, err := types.MarkerAddress(claim.Denom)
+	if err != nil {
+		return err
+	}
+	claimant, err := sdk.AccAddressFromBech32(claim.Address)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.FaucetClaimKey(markerAddr, claimant), k.cdc.MustMarshal(&claim))
+	return nil
+}
+
+// IterateFaucetClaims processes all faucet claims with the given handler function.
+func (k Keeper) IterateFaucetClaims(ctx sdk.Context, handler func(types.FaucetClaim) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FaucetClaimKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var claim types.FaucetClaim
+		k.cdc.MustUnmarshal(it.Value(), &claim)
+		if handler(claim) {
+			break
+		}
+	}
+}
+
+// AddFaucetPolicy sets the faucet policy of a marker.  The caller must have admin and withdraw access on the marker
+// and becomes the administrator whose access is used to pay claims.  Claims made under an existing policy still count
+// against the new one.
+func (k Keeper) AddFaucetPolicy(ctx sdk.Context, caller sdk.AccAddress, policy types.FaucetPolicy) error {
+	m, err := k.GetMarkerByDenom(ctx, policy.Denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", policy.Denom, err)
+	}
+	for _, access := range []types.Access{types.Access_Admin, types.Access_Withdraw} {
+		if !k.addressHasAccess(ctx, m, caller, access) {
+			return fmt.Errorf("%s does not have %s on %s markeraccount", caller, access, m.GetDenom())
+		}
+	}
+	policy.Administrator = caller.String()
+	if err = policy.Validate(); err != nil {
+		return err
+	}
+	if err = k.SetFaucetPolicy(ctx, policy); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerFaucetPolicySet(policy))
+}
+
+// RemoveFaucetPolicy removes the faucet policy of a marker.  The caller must have admin access on the marker.
+func (k Keeper) RemoveFaucetPolicy(ctx sdk.Context, caller sdk.AccAddress, denom string) error {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	if !k.addressHasAccess(ctx, m, caller, types.Access_Admin) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+	if k.GetFaucetPolicy(ctx, m.GetAddress()) == nil {
+		return sdkerrors.Wrapf(types.ErrFaucetPolicyNotFound, "%s markeraccount", m.GetDenom())
+	}
+	ctx.KVStore(k.storeKey).Delete(types.FaucetPolicyKey(m.GetAddress()))
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerFaucetPolicyRemoved(denom, caller.String()))
+}
+
+// ClaimFaucet pays the faucet amount of a marker to a claimant that has the required attribute and whose cooldown has
+// elapsed.  The amount is withdrawn from the marker escrow by the policy administrator, who first mints any shortfall.
+func (k Keeper) ClaimFaucet(ctx sdk.Context, denom string, claimant sdk.AccAddress) (sdk.Coin, error) {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	policy := k.GetFaucetPolicy(ctx, m.GetAddress())
+	if policy == nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrFaucetPolicyNotFound, "%s markeraccount", denom)
+	}
+
+	attributes, err := k.attrKeeper.GetAttributes(ctx, claimant, policy.RequiredAttribute)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("could not get %s attributes of %s: %w", policy.RequiredAttribute, claimant, err)
+	}
+	if len(attributes) == 0 {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrFaucetNotQualified, "%s does not have attribute %s", claimant, policy.RequiredAttribute)
+	}
+
+	if last := k.GetFaucetClaim(ctx, m.GetAddress(), claimant); last != nil {
+		next, repeatable := policy.NextClaimTime(last.LastClaimTime)
+		if !repeatable {
+			return sdk.Coin{}, sdkerrors.Wrapf(types.ErrFaucetCooldown, "%s already claimed from %s faucet", claimant, denom)
+		}
+		if ctx.BlockTime().Before(next) {
+			return sdk.Coin{}, sdkerrors.Wrapf(types.ErrFaucetCooldown, "%s cannot claim from %s faucet until %s", claimant, denom, next)
+		}
+	}
+
+	admin, err := sdk.AccAddressFromBech32(policy.Administrator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	escrow := k.bankKeeper.GetBalance(ctx, m.GetAddress(), denom)
+	if escrow.IsLT(policy.Amount) {
+		if err = k.MintCoin(ctx, admin, policy.Amount.Sub(escrow)); err != nil {
+			return sdk.Coin{}, fmt.Errorf("could not mint faucet amount: %w", err)
+		}
+	}
+	if err = k.WithdrawCoins(ctx, admin, claimant, denom, sdk.NewCoins(policy.Amount)); err != nil {
+		return sdk.Coin{}, fmt.Errorf("could not withdraw faucet amount: %w", err)
+	}
+
+	claim := types.FaucetClaim{Denom: denom, Address: claimant.String(), LastClaimTime: ctx.BlockTime()}
+	if err = k.SetFaucetClaim(ctx, claim); err != nil {
+		return sdk.Coin{}, err
+	}
+	return policy.Amount, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerFaucetClaimed(denom, claimant.String(), policy.Amount))
+}
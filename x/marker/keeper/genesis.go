@@ -25,6 +25,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			}
 		}
 	}
+	// pauses are set before the markers so saving an active coin marker leaves its bank sends disabled while paused
+	for _, denom := range data.PausedMarkers {
+		markerAddr, err := types.MarkerAddress(denom)
+		if err != nil {
+			panic(err)
+		}
+		k.SetMarkerPausedByAdmin(ctx, markerAddr, true)
+	}
+	// the management timelines are set before the markers so saving a marker does not repeat its latest entry
+	for _, entry := range data.ManagementHistory {
+		if err := k.SetManagementEntry(ctx, entry); err != nil {
+			panic(err)
+		}
+	}
+
 	// if any markers were included directly, add these as well.
 	if data.Markers != nil {
 		for i := range data.Markers {
@@ -43,6 +58,78 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			k.SetMarker(ctx, &data.Markers[i])
 		}
 	}
+
+	if data.TransferPause != nil {
+		k.SetTransferPause(ctx, *data.TransferPause)
+	}
+
+	for _, deposit := range data.EscrowDeposits {
+		if err := k.SetEscrowDeposit(ctx, deposit); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, limit := range data.IbcRateLimits {
+		k.SetIbcRateLimit(ctx, limit)
+	}
+
+	for _, allowance := range data.WithdrawAllowances {
+		if err := k.SetWithdrawAllowance(ctx, allowance); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, distribution := range data.Distributions {
+		k.SetDistribution(ctx, distribution)
+	}
+	for _, entitlement := range data.DistributionEntitlements {
+		if err := k.SetDistributionEntitlement(ctx, entitlement); err != nil {
+			panic(err)
+		}
+	}
+	if data.NextDistributionId > 0 {
+		k.SetNextDistributionID(ctx, data.NextDistributionId)
+	}
+
+	for _, policy := range data.FaucetPolicies {
+		if err := k.SetFaucetPolicy(ctx, policy); err != nil {
+			panic(err)
+		}
+	}
+	for _, claim := range data.FaucetClaims {
+		if err := k.SetFaucetClaim(ctx, claim); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, migration := range data.DenomMigrations {
+		if err := k.SetDenomMigration(ctx, migration); err != nil {
+			panic(err)
+		}
+	}
+	for _, holder := range data.DenomMigrationHolders {
+		if err := k.SetDenomMigrationHolder(ctx, holder); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, fee := range data.TransferFees {
+		if err := k.SetTransferFee(ctx, fee); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, entry := range data.TermsHistory {
+		if err := k.SetTermsEntry(ctx, entry); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, entry := range data.DenyList {
+		if err := k.SetDenyListEntry(ctx, entry); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis exports the current keeper state of the marker module.ExportGenesis
@@ -66,10 +153,72 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 			MarkerType:             marker.GetMarkerType(),
 			SupplyFixed:            marker.HasFixedSupply(),
 			AllowGovernanceControl: marker.HasGovernanceEnabled(),
+			CreatedHeight:          marker.GetCreatedHeight(),
+			CreatedBy:              marker.GetCreatedBy(),
+			CreatedTxHash:          marker.GetCreatedTxHash(),
+			Reference:              marker.IsReference(),
 		})
 		return false
 	}
 
 	k.IterateMarkers(ctx, appendToMarkers)
-	return types.NewGenesisState(params, markers)
+	genesis := types.NewGenesisState(params, markers)
+	genesis.TransferPause = k.GetTransferPause(ctx)
+	genesis.EscrowDeposits = k.GetAllEscrowDeposits(ctx)
+	k.IterateIbcRateLimits(ctx, func(limit types.IbcRateLimit) bool {
+		genesis.IbcRateLimits = append(genesis.IbcRateLimits, limit)
+		return false
+	})
+	k.IterateWithdrawAllowances(ctx, func(allowance types.WithdrawAllowance) bool {
+		genesis.WithdrawAllowances = append(genesis.WithdrawAllowances, allowance)
+		return false
+	})
+	k.IterateDistributions(ctx, func(distribution types.Distribution) bool {
+		genesis.Distributions = append(genesis.Distributions, distribution)
+		return false
+	})
+	k.IterateDistributionEntitlements(ctx, func(entitlement types.DistributionEntitlement) bool {
+		genesis.DistributionEntitlements = append(genesis.DistributionEntitlements, entitlement)
+		return false
+	})
+	genesis.NextDistributionId = k.GetNextDistributionID(ctx)
+	k.IterateFaucetPolicies(ctx, func(policy types.FaucetPolicy) bool {
+		genesis.FaucetPolicies = append(genesis.FaucetPolicies, policy)
+		return false
+	})
+	k.IterateFaucetClaims(ctx, func(claim types.FaucetClaim) bool {
+		genesis.FaucetClaims = append(genesis.FaucetClaims, claim)
+		return false
+	})
+	k.IterateDenomMigrations(ctx, func(migration types.DenomMigration) bool {
+		genesis.DenomMigrations = append(genesis.DenomMigrations, migration)
+		k.IterateDenomMigrationHolders(ctx, migration.FromDenom, func(holder types.DenomMigrationHolder) bool {
+			genesis.DenomMigrationHolders = append(genesis.DenomMigrationHolders, holder)
+			return false
+		})
+		return false
+	})
+	k.IterateTransferFees(ctx, func(fee types.TransferFee) bool {
+		genesis.TransferFees = append(genesis.TransferFees, fee)
+		return false
+	})
+	k.IterateAllTermsHistory(ctx, func(entry types.MarkerTermsEntry) bool {
+		genesis.TermsHistory = append(genesis.TermsHistory, entry)
+		return false
+	})
+	k.IterateAllManagementHistory(ctx, func(entry types.MarkerManagementEntry) bool {
+		genesis.ManagementHistory = append(genesis.ManagementHistory, entry)
+		return false
+	})
+	k.IterateDenyList(ctx, func(entry types.DenyListEntry) bool {
+		genesis.DenyList = append(genesis.DenyList, entry)
+		return false
+	})
+	k.IteratePausedMarkers(ctx, func(markerAddr sdk.AccAddress) bool {
+		if m, err := k.GetMarker(ctx, markerAddr); err == nil && m != nil {
+			genesis.PausedMarkers = append(genesis.PausedMarkers, m.GetDenom())
+		}
+		return false
+	})
+	return genesis
 }
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetIbcRateLimit returns the ibc rate limit of a marker denom over a channel if one has been set.
+func (k Keeper) GetIbcRateLimit(ctx sdk.Context, denom, channelID string) *types.IbcRateLimit {
+	bz := ctx.KVStore(k.storeKey).Get(types.IbcRateLimitKey(denom, channelID))
+	if bz == nil {
+		return nil
+	}
+	var limit types.IbcRateLimit
+	k.cdc.MustUnmarshal(bz, &limit)
+	return &limit
+}
+
+// SetIbcRateLimit stores the ibc rate limit of a marker denom over a channel, replacing any existing limit.
+func (k Keeper) SetIbcRateLimit(ctx sdk.Context, limit types.IbcRateLimit) {
+	ctx.KVStore(k.storeKey).Set(types.IbcRateLimitKey(limit.Denom, limit.ChannelId), k.cdc.MustMarshal(&limit))
+}
+
+// RemoveIbcRateLimit removes the ibc rate limit of a marker denom over a channel.
+func (k Keeper) RemoveIbcRateLimit(ctx sdk.Context, denom, channelID string) {
+	ctx.KVStore(k.storeKey).Delete(types.IbcRateLimitKey(denom, channelID))
+}
+
+// IterateIbcRateLimits processes all ibc rate limits with the given handler function.
+func (k Keeper) IterateIbcRateLimits(ctx sdk.Context, handler func(types.IbcRateLimit) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.IbcRateLimitKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var limit types.IbcRateLimit
+		k.cdc.MustUnmarshal(it.Value(), &limit)
+		if handler(limit) {
+			break
+		}
+	}
+}
+
+// TrackIbcSend records an amount of a denom sent over a channel, returning an error if it would exceed the send quota
+// of the rate limit.  Denoms without a rate limit on the channel are not tracked.
+func (k Keeper) TrackIbcSend(ctx sdk.Context, channelID, denom string, amount sdk.Int) error {
+	limit := k.currentIbcRateLimit(ctx, denom, channelID)
+	if limit == nil {
+		return nil
+	}
+	if err := limit.CheckSend(amount); err != nil {
+		return err
+	}
+	limit.Flow.Outflow = limit.Flow.Outflow.Add(amount)
+	k.SetIbcRateLimit(ctx, *limit)
+	return nil
+}
+
+// TrackIbcRecv records an amount of a denom received over a channel, returning an error if it would exceed the recv
+// quota of the rate limit.  Denoms without a rate limit on the channel are not tracked.
+func (k Keeper) TrackIbcRecv(ctx sdk.Context, channelID, denom string, amount sdk.Int) error {
+	limit := k.currentIbcRateLimit(ctx, denom, channelID)
+	if limit == nil {
+		return nil
+	}
+	if err := limit.CheckRecv(amount); err != nil {
+		return err
+	}
+	limit.Flow.Inflow = limit.Flow.Inflow.Add(amount)
+	k.SetIbcRateLimit(ctx, *limit)
+	return nil
+}
+
+// RevertIbcSend removes an amount of a denom that was refunded after a failed send over a channel from the outflow of
+// the current period.
+func (k Keeper) RevertIbcSend(ctx sdk.Context, channelID, denom string, amount sdk.Int) {
+	limit := k.currentIbcRateLimit(ctx, denom, channelID)
+	if limit == nil {
+		return
+	}
+	limit.Flow.Outflow = sdk.MaxInt(limit.Flow.Outflow.Sub(amount), sdk.ZeroInt())
+	k.SetIbcRateLimit(ctx, *limit)
+}
+
+// currentIbcRateLimit returns the rate limit of a denom over a channel with the flow reset if its period has ended.
+func (k Keeper) currentIbcRateLimit(ctx sdk.Context, denom, channelID string) *types.IbcRateLimit {
+	limit := k.GetIbcRateLimit(ctx, denom, channelID)
+	if limit != nil && limit.IsPeriodExpired(ctx.BlockTime()) {
+		limit.ResetFlow(k.bankKeeper.GetSupply(ctx, denom).Amount, ctx.BlockTime())
+	}
+	return limit
+}
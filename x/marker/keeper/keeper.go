@@ -51,9 +51,18 @@ type Keeper struct {
 	// To handle movement of coin between accounts and check total supply
 	bankKeeper bankkeeper.Keeper
 
+	// To check the attributes of faucet claimants.
+	attrKeeper types.AttributeKeeper
+
+	// To check whether governance has activated new consensus behaviors.
+	featureFlagKeeper types.FeatureFlagKeeper
+
 	// For access to bank keeper storage outside what their keeper provides.
 	bankKeeperStoreKey sdk.StoreKey
 
+	// To read the pending governance proposals naming a marker.
+	govKeeper types.GovKeeper
+
 	// Key to access the key-value store from sdk.Context.
 	storeKey sdk.StoreKey
 
@@ -74,6 +83,9 @@ func NewKeeper(
 	bankKeeper bankkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 	bankKey sdk.StoreKey,
+	govKeeper types.GovKeeper,
+	attrKeeper types.AttributeKeeper,
+	featureFlagKeeper types.FeatureFlagKeeper,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -84,8 +96,11 @@ func NewKeeper(
 		authKeeper:         authKeeper,
 		authzKeeper:        authzKeeper,
 		bankKeeper:         bankKeeper,
+		attrKeeper:         attrKeeper,
+		featureFlagKeeper:  featureFlagKeeper,
 		storeKey:           key,
 		bankKeeperStoreKey: bankKey,
+		govKeeper:          govKeeper,
 		cdc:                cdc,
 	}
 }
@@ -95,6 +110,18 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// EmitLegacyEvent emits a deprecated untyped event when the emit legacy events param is enabled.
+func (k Keeper) EmitLegacyEvent(ctx sdk.Context, event sdk.Event) {
+	if k.GetEmitLegacyEvents(ctx) {
+		ctx.EventManager().EmitEvent(event)
+	}
+}
+
+// IsFeatureActive returns true if governance has activated the named marker feature at the current block height.
+func (k Keeper) IsFeatureActive(ctx sdk.Context, name string) bool {
+	return k.featureFlagKeeper != nil && k.featureFlagKeeper.IsFeatureActive(ctx, name)
+}
+
 var _ MarkerKeeperI = &Keeper{}
 
 // NewMarker returns a new marker instance with the address and baseaccount assigned.  Does not save to auth store
@@ -120,15 +147,23 @@ func (k Keeper) GetMarker(ctx sdk.Context, address sdk.AccAddress) (types.Marker
 func (k Keeper) SetMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	store := ctx.KVStore(k.storeKey)
 
+	// Expired access grants are cleaned up whenever the marker is saved.
+	for _, grant := range marker.RemoveExpiredAccess(ctx.BlockTime()) {
+		k.emitAccessExpired(ctx, marker.GetDenom(), grant)
+	}
+
 	if err := marker.Validate(); err != nil {
 		panic(err)
 	}
 	k.authKeeper.SetAccount(ctx, marker)
 	store.Set(types.MarkerStoreKey(marker.GetAddress()), marker.GetAddress())
+	k.RecordManagement(ctx, marker)
 
-	// If Set Marker is called on an Active Marker then ensure the send_enabled configuration is also correct.
+	// If Set Marker is called on an Active Marker then ensure the send_enabled configuration is also correct.  The old
+	// denom of a migration stays frozen.
 	if marker.GetStatus() == types.StatusActive {
-		k.ensureSendEnabledStatus(ctx, marker.GetDenom(), marker.GetMarkerType() == types.MarkerType_Coin)
+		k.ensureSendEnabledStatus(ctx, marker.GetDenom(), marker.GetMarkerType() == types.MarkerType_Coin &&
+			!k.IsMarkerPausedByAdmin(ctx, marker.GetAddress()) && !k.IsDenomMigrated(ctx, marker.GetDenom()))
 	}
 }
 
@@ -139,6 +174,7 @@ func (k Keeper) RemoveMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	k.authKeeper.RemoveAccount(ctx, marker)
 
 	store.Delete(types.MarkerStoreKey(marker.GetAddress()))
+	store.Delete(types.MarkerPausedKey(marker.GetAddress()))
 }
 
 // IterateMarkers  iterates all markers with the given handler function.
@@ -159,6 +195,28 @@ func (k Keeper) IterateMarkers(ctx sdk.Context, cb func(marker types.MarkerAccou
 	}
 }
 
+// IsPendingMarker returns true if the marker is proposed or finalized and was created at least minAge blocks ago.
+func (k Keeper) IsPendingMarker(ctx sdk.Context, marker types.MarkerAccountI, minAge int64) bool {
+	return marker.IsPending() && ctx.BlockHeight()-marker.GetCreatedHeight() >= minAge
+}
+
+// GetPendingMarkers returns all markers that have not been activated within minAge blocks of being created.
+func (k Keeper) GetPendingMarkers(ctx sdk.Context, minAge int64) []types.MarkerAccountI {
+	var markers []types.MarkerAccountI
+	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		if k.IsPendingMarker(ctx, marker, minAge) {
+			markers = append(markers, marker)
+		}
+		return false
+	})
+	return markers
+}
+
+// IsOrphanedMarker returns true if the marker is active and no address holds an unexpired grant of admin access on it.
+func (k Keeper) IsOrphanedMarker(ctx sdk.Context, marker types.MarkerAccountI) bool {
+	return marker.GetStatus() == types.StatusActive && !accessHeldAt(marker.GetAccessList(), types.Access_Admin, ctx.BlockTime())
+}
+
 // GetEscrow returns the balances of all coins held in escrow in the marker
 func (k Keeper) GetEscrow(ctx sdk.Context, marker types.MarkerAccountI) sdk.Coins {
 	return k.bankKeeper.GetAllBalances(ctx, marker.GetAddress())
@@ -2,18 +2,27 @@ package keeper_test
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	simapp "github.com/provenance-io/provenance/app"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/stretchr/testify/require"
 
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
 	"github.com/provenance-io/provenance/x/marker/types"
 )
@@ -432,6 +441,53 @@ func TestAccountKeeperMintBurnCoins(t *testing.T) {
 	require.EqualValues(t, app.BankKeeper.GetSupply(ctx, "testcoin").Amount, sdk.ZeroInt())
 }
 
+func TestFinalizeValidationSummary(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+	user := testUserAddress("test")
+
+	mac := types.NewEmptyMarkerAccount("testcoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	mac.AllowGovernanceControl = false
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin("testcoin", 1000)))
+
+	summary := app.MarkerKeeper.GetFinalizeValidationSummary(ctx, mac)
+	require.Equal(t, []types.RequiredAccess{
+		{Access: types.Access_Withdraw, Addresses: []string{user.String()}},
+		{Access: types.Access_Transfer},
+		{Access: types.Access_Admin},
+	}, summary.RequiredAccess)
+	require.False(t, summary.HasDenomMetadata)
+	require.Equal(t, sdk.NewInt64Coin("testcoin", 1000), summary.Supply)
+	require.True(t, summary.SupplyFixed)
+	require.False(t, summary.AllowGovernanceControl)
+	require.Equal(t, []string{
+		"no address has been granted ACCESS_TRANSFER",
+		"no address has been granted ACCESS_ADMIN",
+		"denom metadata has not been set",
+	}, summary.Warnings)
+
+	// granting the missing access and setting metadata clears the warnings
+	require.NoError(t, mac.GrantAccess(types.NewAccessGrant(user, []types.Access{types.Access_Transfer, types.Access_Admin})))
+	app.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "testcoin",
+		Display:    "testcoin",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "testcoin"}},
+	})
+	summary = app.MarkerKeeper.GetFinalizeValidationSummary(ctx, mac)
+	require.True(t, summary.HasDenomMetadata)
+	require.Empty(t, summary.Warnings)
+
+	// a zero supply is reported and required access shrinks when governance can control the marker
+	mac.AllowGovernanceControl = true
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin("testcoin", 0)))
+	summary = app.MarkerKeeper.GetFinalizeValidationSummary(ctx, mac)
+	require.Len(t, summary.RequiredAccess, 2)
+	require.Equal(t, []string{"supply is zero so no coin will be minted on activation"}, summary.Warnings)
+}
+
 func TestAccountKeeperGetAll(t *testing.T) {
 	//app, ctx := createTestApp(true)
 	app := simapp.Setup(false)
@@ -470,6 +526,54 @@ func TestAccountKeeperGetAll(t *testing.T) {
 	// Could do more in-depth checking, but if both markers are returned that is the expected behavior
 }
 
+func TestMarkerByAddressAndDenomMetadataQueries(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	user := testUserAddress("test")
+	mac := types.NewEmptyMarkerAccount("testcoin",
+		user.String(),
+		[]types.AccessGrant{*types.NewAccessGrant(user, []types.Access{types.Access_Deposit})})
+	require.NoError(t, mac.SetManager(user))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	metadata := banktypes.Metadata{
+		Description: "a test coin",
+		Base:        "testcoin",
+		Display:     "testcoin",
+		Name:        "Test Coin",
+		Symbol:      "TEST",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: "testcoin", Exponent: 0}},
+	}
+	app.BankKeeper.SetDenomMetaData(ctx, metadata)
+
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// by marker account address
+	res, err := app.MarkerKeeper.MarkerByAddress(goCtx, &types.QueryMarkerByAddressRequest{Address: mac.GetAddress().String()})
+	require.NoError(t, err)
+	var m types.MarkerAccountI
+	require.NoError(t, app.InterfaceRegistry().UnpackAny(res.Marker, &m))
+	require.Equal(t, "testcoin", m.GetDenom())
+
+	// denom and non-marker account addresses are rejected
+	_, err = app.MarkerKeeper.MarkerByAddress(goCtx, &types.QueryMarkerByAddressRequest{Address: "testcoin"})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid address")
+	_, err = app.MarkerKeeper.MarkerByAddress(goCtx, &types.QueryMarkerByAddressRequest{Address: user.String()})
+	require.ErrorIs(t, err, types.ErrMarkerNotFound)
+
+	// denom metadata includes the marker when one exists
+	dmRes, err := app.MarkerKeeper.DenomMetadata(goCtx, &types.QueryDenomMetadataRequest{Denom: "testcoin"})
+	require.NoError(t, err)
+	require.Equal(t, metadata, dmRes.Metadata)
+	require.NotNil(t, dmRes.Marker)
+	require.NoError(t, app.InterfaceRegistry().UnpackAny(dmRes.Marker, &m))
+	require.Equal(t, mac.GetAddress(), m.GetAddress())
+
+	dmRes, err = app.MarkerKeeper.DenomMetadata(goCtx, &types.QueryDenomMetadataRequest{Denom: "nomarker"})
+	require.NoError(t, err)
+	require.Nil(t, dmRes.Marker)
+}
+
 func TestAccountInsufficientExisting(t *testing.T) {
 	//app, ctx := createTestApp(true)
 	app := simapp.Setup(false)
@@ -558,8 +662,1340 @@ func TestAccountImplictControl(t *testing.T) {
 	require.Error(t, app.MarkerKeeper.TransferCoin(ctx, user, user2, user, sdk.NewCoin("testcoin", sdk.NewInt(10))))
 }
 
+func TestTransferPause(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(10)
+	user := testUserAddress("test")
+	user2 := testUserAddress("test2")
+
+	mac := types.NewEmptyMarkerAccount("testcoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Transfer})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	require.NoError(t, mac.SetManager(user))
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("testcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "testcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "testcoin"))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, user, "testcoin",
+		sdk.NewCoins(sdk.NewInt64Coin("testcoin", 1000))))
+
+	require.Nil(t, app.MarkerKeeper.GetTransferPause(ctx))
+	require.False(t, app.MarkerKeeper.IsTransferPaused(ctx, "testcoin"))
+
+	// a pause of other markers does not affect this one
+	app.MarkerKeeper.SetTransferPause(ctx, *types.NewTransferPause([]string{"othercoin"}, 20, false))
+	require.False(t, app.MarkerKeeper.IsTransferPaused(ctx, "testcoin"))
+	require.NoError(t, app.MarkerKeeper.TransferCoin(ctx, user, user2, user, sdk.NewCoin("testcoin", sdk.NewInt(10))))
+
+	// a pause of all restricted markers blocks transfers until the expiry height
+	app.MarkerKeeper.SetTransferPause(ctx, *types.NewTransferPause(nil, 20, false))
+	require.True(t, app.MarkerKeeper.IsTransferPaused(ctx, "testcoin"))
+	err := app.MarkerKeeper.TransferCoin(ctx, user, user2, user, sdk.NewCoin("testcoin", sdk.NewInt(10)))
+	require.ErrorIs(t, err, types.ErrTransfersPaused)
+	require.EqualError(t, err, "transfers of testcoin are paused until height 20: restricted marker transfers are paused")
+
+	query, err := app.MarkerKeeper.TransferPause(sdk.WrapSDKContext(ctx), &types.QueryTransferPauseRequest{})
+	require.NoError(t, err)
+	require.True(t, query.Active)
+	require.Equal(t, types.NewTransferPause(nil, 20, false), query.Pause)
+
+	// transfers are allowed again once the pause expires
+	ctx = ctx.WithBlockHeight(20)
+	require.False(t, app.MarkerKeeper.IsTransferPaused(ctx, "testcoin"))
+	require.NoError(t, app.MarkerKeeper.TransferCoin(ctx, user, user2, user, sdk.NewCoin("testcoin", sdk.NewInt(10))))
+
+	// the expired pause is exported with the genesis state until it is removed
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.Equal(t, types.NewTransferPause(nil, 20, false), genesis.TransferPause)
+	app.MarkerKeeper.RemoveTransferPause(ctx)
+	require.Nil(t, app.MarkerKeeper.ExportGenesis(ctx).TransferPause)
+}
+
+func TestDenyList(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(10)
+	user := testUserAddress("test")
+	user2 := testUserAddress("test2")
+	sanctioned := testUserAddress("sanctioned")
+
+	mac := types.NewEmptyMarkerAccount("testcoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Transfer})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	require.NoError(t, mac.SetManager(user))
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("testcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "testcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "testcoin"))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, user, "testcoin",
+		sdk.NewCoins(sdk.NewInt64Coin("testcoin", 500))))
+	require.NoError(t, app.MarkerKeeper.TransferCoin(ctx, user, sanctioned, user, sdk.NewCoin("testcoin", sdk.NewInt(10))))
+
+	// a proposal adds addresses to the deny list
+	err := markerkeeper.HandleAddToDenyListProposal(ctx, app.MarkerKeeper, types.NewAddToDenyListProposal("title", "description",
+		[]string{sanctioned.String()}, "sanctioned"))
+	require.NoError(t, err)
+	require.True(t, app.MarkerKeeper.IsDenyListed(ctx, sanctioned))
+	require.Equal(t, types.NewDenyListEntry(sanctioned.String(), "sanctioned", 10), app.MarkerKeeper.GetDenyListEntry(ctx, sanctioned))
+	require.False(t, app.MarkerKeeper.IsDenyListed(ctx, user2))
+
+	// restricted coin cannot be transferred or withdrawn to or from a listed address regardless of marker settings
+	err = app.MarkerKeeper.TransferCoin(ctx, user, sanctioned, user, sdk.NewCoin("testcoin", sdk.NewInt(10)))
+	require.ErrorIs(t, err, types.ErrDenyListed)
+	err = app.MarkerKeeper.TransferCoin(ctx, sanctioned, user, user, sdk.NewCoin("testcoin", sdk.NewInt(10)))
+	require.ErrorIs(t, err, types.ErrDenyListed)
+	err = app.MarkerKeeper.WithdrawCoins(ctx, user, sanctioned, "testcoin", sdk.NewCoins(sdk.NewInt64Coin("testcoin", 10)))
+	require.ErrorIs(t, err, types.ErrDenyListed)
+	blocks := app.MarkerKeeper.GetTransferBlocks(ctx, user, sanctioned, user, sdk.NewCoin("testcoin", sdk.NewInt(10)))
+	require.Len(t, blocks, 1)
+	require.Equal(t, types.TransferBlockReason_TRANSFER_BLOCK_REASON_DENY_LISTED, blocks[0].Reason)
+	require.NoError(t, app.MarkerKeeper.TransferCoin(ctx, user, user2, user, sdk.NewCoin("testcoin", sdk.NewInt(10))))
+
+	query, err := app.MarkerKeeper.DenyList(sdk.WrapSDKContext(ctx), &types.QueryDenyListRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenyListEntry{*types.NewDenyListEntry(sanctioned.String(), "sanctioned", 10)}, query.Entries)
+	query, err = app.MarkerKeeper.DenyList(sdk.WrapSDKContext(ctx), &types.QueryDenyListRequest{Address: user2.String()})
+	require.NoError(t, err)
+	require.Empty(t, query.Entries)
+
+	require.Equal(t, []types.DenyListEntry{*types.NewDenyListEntry(sanctioned.String(), "sanctioned", 10)},
+		app.MarkerKeeper.ExportGenesis(ctx).DenyList)
+
+	// removing an address that is not listed fails without removing any of the others
+	err = markerkeeper.HandleRemoveFromDenyListProposal(ctx, app.MarkerKeeper, types.NewRemoveFromDenyListProposal("title", "description",
+		[]string{sanctioned.String(), user2.String()}))
+	require.EqualError(t, err, fmt.Sprintf("%s is not on the restricted marker deny list", user2))
+	require.True(t, app.MarkerKeeper.IsDenyListed(ctx, sanctioned))
+
+	require.NoError(t, markerkeeper.HandleRemoveFromDenyListProposal(ctx, app.MarkerKeeper, types.NewRemoveFromDenyListProposal("title", "description",
+		[]string{sanctioned.String()})))
+	require.False(t, app.MarkerKeeper.IsDenyListed(ctx, sanctioned))
+	require.NoError(t, app.MarkerKeeper.TransferCoin(ctx, user, sanctioned, user, sdk.NewCoin("testcoin", sdk.NewInt(10))))
+	require.Empty(t, app.MarkerKeeper.ExportGenesis(ctx).DenyList)
+}
+
+func TestMarkerPause(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	user := testUserAddress("test")
+	user2 := testUserAddress("test2")
+
+	for _, denom := range []string{"restrictedcoin", "opencoin"} {
+		access := []types.Access{types.Access_Mint, types.Access_Burn, types.Access_Withdraw, types.Access_Admin}
+		if denom == "restrictedcoin" {
+			access = append(access, types.Access_Transfer)
+		}
+		mac := types.NewEmptyMarkerAccount(denom, user.String(), []types.AccessGrant{*types.NewAccessGrant(user, access)})
+		if denom == "restrictedcoin" {
+			mac.MarkerType = types.MarkerType_RestrictedCoin
+		}
+		require.NoError(t, mac.SetManager(user))
+		require.NoError(t, mac.SetSupply(sdk.NewCoin(denom, sdk.NewInt(1000))))
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+		require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, denom))
+		require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, denom))
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, user, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 500))))
+	}
+	restricted := sdk.NewInt64Coin("restrictedcoin", 10)
+	requirePaused := func(paused bool, transferErr error) {
+		for _, err := range []error{
+			app.MarkerKeeper.MintCoin(ctx, user, restricted),
+			app.MarkerKeeper.BurnCoin(ctx, user, restricted),
+			app.MarkerKeeper.BurnCoinFrom(ctx, user, user, restricted),
+			app.MarkerKeeper.WithdrawCoins(ctx, user, user, "restrictedcoin", sdk.NewCoins(restricted)),
+		} {
+			if paused {
+				require.ErrorIs(t, err, types.ErrMarkerPaused)
+			} else {
+				require.NoError(t, err)
+			}
+		}
+		err := app.MarkerKeeper.TransferCoin(ctx, user, user2, user, restricted)
+		if transferErr != nil {
+			require.ErrorIs(t, err, transferErr)
+		} else {
+			require.NoError(t, err)
+		}
+		res, err := app.MarkerKeeper.Marker(sdk.WrapSDKContext(ctx), &types.QueryMarkerRequest{Id: "restrictedcoin"})
+		require.NoError(t, err, "queries keep working while paused")
+		require.Equal(t, paused, res.Paused)
+	}
+	requirePaused(false, nil)
+
+	// only an admin can pause a marker
+	require.EqualError(t, app.MarkerKeeper.SetMarkerPaused(ctx, user2, "restrictedcoin", true),
+		fmt.Sprintf("%s does not have ACCESS_ADMIN on restrictedcoin markeraccount", user2))
+	require.NoError(t, app.MarkerKeeper.SetMarkerPaused(ctx, user, "restrictedcoin", true))
+	require.EqualError(t, app.MarkerKeeper.SetMarkerPaused(ctx, user, "restrictedcoin", true),
+		"restrictedcoin markeraccount is already paused")
+	requirePaused(true, types.ErrMarkerPaused)
+	blocks := app.MarkerKeeper.GetTransferBlocks(ctx, user, user2, user, restricted)
+	require.Len(t, blocks, 1)
+	require.Equal(t, types.TransferBlockReason_TRANSFER_BLOCK_REASON_MARKER_PAUSED, blocks[0].Reason)
+	require.False(t, app.MarkerKeeper.IsMarkerPaused(ctx, "opencoin"), "other markers are not paused")
+	require.Equal(t, []string{"restrictedcoin"}, app.MarkerKeeper.ExportGenesis(ctx).PausedMarkers)
+
+	require.NoError(t, app.MarkerKeeper.SetMarkerPaused(ctx, user, "restrictedcoin", false))
+	requirePaused(false, nil)
+	require.Empty(t, app.MarkerKeeper.ExportGenesis(ctx).PausedMarkers)
+
+	// a governance transfer pause that halts supply pauses all markers without changing bank sends of coin markers
+	require.NoError(t, markerkeeper.HandlePauseRestrictedTransfersProposal(ctx, app.MarkerKeeper,
+		types.NewPauseRestrictedTransfersProposal("title", "description", nil, 20, true)))
+	requirePaused(true, types.ErrTransfersPaused)
+	require.True(t, app.MarkerKeeper.IsMarkerPaused(ctx, "opencoin"))
+	require.True(t, app.BankKeeper.IsSendEnabledCoin(ctx, sdk.NewInt64Coin("opencoin", 1)))
+	require.True(t, app.MarkerKeeper.ExportGenesis(ctx).TransferPause.HaltSupply)
+
+	// an administrator pause outlasts the governance pause
+	require.NoError(t, app.MarkerKeeper.SetMarkerPaused(ctx, user, "opencoin", true))
+	require.False(t, app.BankKeeper.IsSendEnabledCoin(ctx, sdk.NewInt64Coin("opencoin", 1)))
+	require.NoError(t, markerkeeper.HandleResumeRestrictedTransfersProposal(ctx, app.MarkerKeeper,
+		types.NewResumeRestrictedTransfersProposal("title", "description")))
+	requirePaused(false, nil)
+	require.True(t, app.MarkerKeeper.IsMarkerPaused(ctx, "opencoin"))
+	require.False(t, app.BankKeeper.IsSendEnabledCoin(ctx, sdk.NewInt64Coin("opencoin", 1)))
+	require.NoError(t, app.MarkerKeeper.SetMarkerPaused(ctx, user, "opencoin", false))
+	require.True(t, app.BankKeeper.IsSendEnabledCoin(ctx, sdk.NewInt64Coin("opencoin", 1)))
+}
+
+func TestGlobalMarkerPause(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 5})
+	user := testUserAddress("test")
+	user2 := testUserAddress("test2")
+
+	for _, denom := range []string{"restrictedcoin", "opencoin"} {
+		access := []types.Access{types.Access_Mint, types.Access_Withdraw}
+		if denom == "restrictedcoin" {
+			access = append(access, types.Access_Transfer)
+		}
+		mac := types.NewEmptyMarkerAccount(denom, user.String(), []types.AccessGrant{*types.NewAccessGrant(user, access)})
+		if denom == "restrictedcoin" {
+			mac.MarkerType = types.MarkerType_RestrictedCoin
+		}
+		require.NoError(t, mac.SetSupply(sdk.NewCoin(denom, sdk.NewInt(1000))))
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+		require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, denom))
+		require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, denom))
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, user, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 500))))
+	}
+	require.NoError(t, simapp.FundAccount(app, ctx, user, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))))
+	bankMsgServer := markerkeeper.NewEscrowDepositMsgServer(bankkeeper.NewMsgServerImpl(app.BankKeeper), app.MarkerKeeper)
+	send := func(coin sdk.Coin) error {
+		_, err := bankMsgServer.Send(sdk.WrapSDKContext(ctx), banktypes.NewMsgSend(user, user2, sdk.NewCoins(coin)))
+		return err
+	}
+	multiSend := func(coin sdk.Coin) error {
+		_, err := bankMsgServer.MultiSend(sdk.WrapSDKContext(ctx), banktypes.NewMsgMultiSend(
+			[]banktypes.Input{banktypes.NewInput(user, sdk.NewCoins(coin))},
+			[]banktypes.Output{banktypes.NewOutput(user2, sdk.NewCoins(coin))}))
+		return err
+	}
+	requirePaused := func(paused bool) {
+		for _, denom := range []string{"restrictedcoin", "opencoin"} {
+			coin := sdk.NewInt64Coin(denom, 1)
+			errs := []error{
+				app.MarkerKeeper.MintCoin(ctx, user, coin),
+				app.MarkerKeeper.WithdrawCoins(ctx, user, user, denom, sdk.NewCoins(coin)),
+			}
+			if denom == "restrictedcoin" {
+				errs = append(errs, app.MarkerKeeper.TransferCoin(ctx, user, user2, user, coin))
+			} else {
+				errs = append(errs, send(coin), multiSend(coin))
+			}
+			for _, err := range errs {
+				if paused {
+					require.Error(t, err, "%s operations are halted", denom)
+				} else {
+					require.NoError(t, err, "%s operations work", denom)
+				}
+			}
+			require.Equal(t, paused, app.MarkerKeeper.IsMarkerPaused(ctx, denom))
+		}
+		require.NoError(t, send(sdk.NewInt64Coin("nhash", 1)), "sends of coin without a marker are not paused")
+	}
+	requirePaused(false)
+
+	require.NoError(t, markerkeeper.HandlePauseRestrictedTransfersProposal(ctx, app.MarkerKeeper,
+		&types.PauseRestrictedTransfersProposal{Title: "title", Description: "description", ExpiryHeight: 20,
+			HaltSupply: true, Global: true}))
+	require.True(t, app.MarkerKeeper.IsGlobalPause(ctx))
+	require.Equal(t, types.NewGlobalTransferPause(20), app.MarkerKeeper.ExportGenesis(ctx).TransferPause)
+	requirePaused(true)
+	require.ErrorIs(t, send(sdk.NewInt64Coin("opencoin", 1)), types.ErrMarkerPaused)
+	require.ErrorIs(t, app.MarkerKeeper.TransferCoin(ctx, user, user2, user, sdk.NewInt64Coin("restrictedcoin", 1)),
+		types.ErrTransfersPaused)
+
+	// the pause ends at its expiry height
+	require.True(t, app.MarkerKeeper.IsGlobalPause(ctx.WithBlockHeight(19)))
+	require.False(t, app.MarkerKeeper.IsGlobalPause(ctx.WithBlockHeight(20)))
+
+	require.NoError(t, markerkeeper.HandleResumeRestrictedTransfersProposal(ctx, app.MarkerKeeper,
+		types.NewResumeRestrictedTransfersProposal("title", "description")))
+	require.False(t, app.MarkerKeeper.IsGlobalPause(ctx))
+	requirePaused(false)
+}
+
+func TestBurnCoinFrom(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	user := testUserAddress("test")
+	user2 := testUserAddress("test2")
+	holder := testUserAddress("holder")
+
+	mac := types.NewEmptyMarkerAccount("testcoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Burn, types.Access_Withdraw})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	require.NoError(t, mac.SetManager(user))
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("testcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+
+	// the marker must be active before coin can be burned from holders
+	require.EqualError(t, app.MarkerKeeper.BurnCoinFrom(ctx, user, holder, sdk.NewInt64Coin("testcoin", 100)),
+		"cannot burn coin from accounts for a marker that is not in Active status")
+
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "testcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "testcoin"))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, holder, "testcoin",
+		sdk.NewCoins(sdk.NewInt64Coin("testcoin", 400))))
+
+	// fails without burn access
+	require.EqualError(t, app.MarkerKeeper.BurnCoinFrom(ctx, user2, holder, sdk.NewInt64Coin("testcoin", 100)),
+		fmt.Sprintf("%s does not have ACCESS_BURN on testcoin markeraccount", user2))
+	// fails when the holder does not have enough coin
+	require.Error(t, app.MarkerKeeper.BurnCoinFrom(ctx, user, holder, sdk.NewInt64Coin("testcoin", 500)))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.MarkerKeeper.BurnCoinFrom(ctx, user, holder, sdk.NewInt64Coin("testcoin", 100)))
+	require.Equal(t, sdk.NewInt64Coin("testcoin", 300), app.BankKeeper.GetBalance(ctx, holder, "testcoin"))
+	require.Equal(t, sdk.NewInt64Coin("testcoin", 600), app.BankKeeper.GetBalance(ctx, mac.GetAddress(), "testcoin"))
+	require.Equal(t, sdk.NewInt64Coin("testcoin", 900), app.BankKeeper.GetSupply(ctx, "testcoin"))
+
+	events := ctx.EventManager().ABCIEvents()
+	burnFromEvent, err := sdk.ParseTypedEvent(events[len(events)-1])
+	require.NoError(t, err)
+	require.Equal(t, types.NewEventMarkerBurnFrom("100", "testcoin", user.String(), holder.String()), burnFromEvent)
+
+	// only restricted markers allow burning from holder accounts
+	unrestricted := types.NewEmptyMarkerAccount("opencoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Burn, types.Access_Withdraw})})
+	require.NoError(t, unrestricted.SetManager(user))
+	require.NoError(t, unrestricted.SetSupply(sdk.NewCoin("opencoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, unrestricted))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "opencoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "opencoin"))
+	require.EqualError(t, app.MarkerKeeper.BurnCoinFrom(ctx, user, holder, sdk.NewInt64Coin("opencoin", 1)),
+		"marker type is not restricted_coin, burn from holder accounts not supported")
+}
+
 // testUserAddress gives a quick way to make a valid test address (no keys though)
 func testUserAddress(name string) sdk.AccAddress {
 	addr := types.MustGetMarkerAddress(name)
 	return addr
 }
+
+func TestEscrowDeposits(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 5})
+	user := testUserAddress("test")
+	sender := testUserAddress("sender")
+	sender2 := testUserAddress("sender2")
+	other := testUserAddress("other")
+
+	mac := types.NewEmptyMarkerAccount("depositcoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("depositcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "depositcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "depositcoin"))
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))
+	require.NoError(t, simapp.FundAccount(app, ctx, sender, funds))
+	require.NoError(t, simapp.FundAccount(app, ctx, sender2, funds))
+
+	msgServer := markerkeeper.NewEscrowDepositMsgServer(bankkeeper.NewMsgServerImpl(app.BankKeeper), app.MarkerKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// sends to accounts that are not markers are not recorded
+	_, err := msgServer.Send(goCtx, banktypes.NewMsgSend(sender, other, sdk.NewCoins(sdk.NewInt64Coin("nhash", 10))))
+	require.NoError(t, err)
+	require.Empty(t, app.MarkerKeeper.GetAllEscrowDeposits(ctx))
+
+	// marker withdrawals are not recorded
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, other, "depositcoin", sdk.NewCoins(sdk.NewInt64Coin("depositcoin", 10))))
+	require.Empty(t, app.MarkerKeeper.GetAllEscrowDeposits(ctx))
+
+	// sends to the marker are recorded and combined per sender and block
+	_, err = msgServer.Send(goCtx, banktypes.NewMsgSend(sender, mac.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 10))))
+	require.NoError(t, err)
+	events := ctx.EventManager().ABCIEvents()
+	depositEvent, err := sdk.ParseTypedEvent(events[len(events)-1])
+	require.NoError(t, err)
+	require.Equal(t, types.NewEventMarkerEscrowDeposit("10nhash", "depositcoin", sender.String()), depositEvent)
+	_, err = msgServer.Send(goCtx, banktypes.NewMsgSend(sender, mac.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))))
+	require.NoError(t, err)
+
+	// multi-sends with several inputs are recorded without a sender
+	_, err = msgServer.MultiSend(goCtx, &banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{
+			banktypes.NewInput(sender, sdk.NewCoins(sdk.NewInt64Coin("nhash", 20))),
+			banktypes.NewInput(sender2, sdk.NewCoins(sdk.NewInt64Coin("nhash", 20))),
+		},
+		Outputs: []banktypes.Output{
+			banktypes.NewOutput(mac.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 30))),
+			banktypes.NewOutput(other, sdk.NewCoins(sdk.NewInt64Coin("nhash", 10))),
+		},
+	})
+	require.NoError(t, err)
+
+	// failed sends are not recorded
+	_, err = msgServer.Send(goCtx, banktypes.NewMsgSend(sender2, mac.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 5000))))
+	require.Error(t, err)
+
+	expected := []types.EscrowDeposit{
+		*types.NewEscrowDeposit("depositcoin", "", sdk.NewCoins(sdk.NewInt64Coin("nhash", 30)), 5),
+		*types.NewEscrowDeposit("depositcoin", sender.String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 15)), 5),
+	}
+	require.ElementsMatch(t, expected, app.MarkerKeeper.GetAllEscrowDeposits(ctx))
+
+	res, err := app.MarkerKeeper.EscrowDeposits(goCtx, &types.QueryEscrowDepositsRequest{Id: "depositcoin"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, expected, res.Deposits)
+
+	// deposits are exported with genesis
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.ElementsMatch(t, expected, genesis.EscrowDeposits)
+
+	// only the most recent deposits of a marker are kept
+	for height := int64(6); height < 6+types.MaxEscrowDeposits; height++ {
+		require.NoError(t, app.MarkerKeeper.SetEscrowDeposit(ctx,
+			*types.NewEscrowDeposit("depositcoin", sender2.String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1)), height)))
+	}
+	deposits := app.MarkerKeeper.GetAllEscrowDeposits(ctx)
+	require.Len(t, deposits, types.MaxEscrowDeposits)
+	for _, d := range deposits {
+		require.NotEqual(t, int64(5), d.Height, "deposits at the oldest height should be pruned")
+	}
+
+	// replacing an existing record does not prune
+	require.NoError(t, app.MarkerKeeper.SetEscrowDeposit(ctx,
+		*types.NewEscrowDeposit("depositcoin", sender2.String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 2)), 6)))
+	deposits = app.MarkerKeeper.GetAllEscrowDeposits(ctx)
+	require.Len(t, deposits, types.MaxEscrowDeposits)
+	require.Equal(t, int64(6), deposits[0].Height)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("nhash", 2)), deposits[0].Amount)
+}
+
+func TestAccessGrantExpiration(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	user := testUserAddress("test")
+	minter := testUserAddress("minter")
+
+	mac := types.NewEmptyMarkerAccount("expirecoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Admin})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("expirecoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "expirecoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "expirecoin"))
+
+	// grants that have already expired are rejected
+	grant := types.NewAccessGrant(minter, []types.Access{types.Access_Mint})
+	expiration := now
+	grant.Expiration = &expiration
+	require.ErrorIs(t, app.MarkerKeeper.AddAccess(ctx, user, "expirecoin", grant), types.ErrAccessExpired)
+
+	expiration = now.Add(time.Hour)
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, user, "expirecoin", grant))
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, minter, sdk.NewInt64Coin("expirecoin", 100)))
+
+	// expired grants are rejected at permission-check time without an event, since the failed tx discards it
+	ctx = ctx.WithBlockTime(expiration).WithEventManager(sdk.NewEventManager())
+	require.Error(t, app.MarkerKeeper.MintCoin(ctx, minter, sdk.NewInt64Coin("expirecoin", 100)))
+	require.Empty(t, ctx.EventManager().ABCIEvents())
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "expirecoin")
+	require.NoError(t, err)
+	require.Len(t, m.GetAccessList(), 2, "expired grants are only removed when the marker is saved")
+
+	// expired grants are removed the next time the marker is saved, which emits the event
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin("expirecoin", 100)))
+	m, err = app.MarkerKeeper.GetMarkerByDenom(ctx, "expirecoin")
+	require.NoError(t, err)
+	require.Equal(t, []types.AccessGrant{*types.NewAccessGrant(user, []types.Access{types.Access_Mint, types.Access_Admin})}, m.GetAccessList())
+	var expiredEvents []proto.Message
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type == "provenance.marker.v1.EventMarkerAccessExpired" {
+			expiredEvent, parseErr := sdk.ParseTypedEvent(event)
+			require.NoError(t, parseErr)
+			expiredEvents = append(expiredEvents, expiredEvent)
+		}
+	}
+	require.Equal(t, []proto.Message{types.NewEventMarkerAccessExpired("expirecoin", minter.String(),
+		expiration.Format(time.RFC3339))}, expiredEvents)
+}
+
+func TestPendingMarkers(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	user := testUserAddress("test")
+
+	for _, denom := range []string{"proposedcoin", "activecoin"} {
+		mac := types.NewEmptyMarkerAccount(denom, user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+			[]types.Access{types.Access_Mint, types.Access_Admin})})
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	}
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "activecoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "activecoin"))
+
+	ctx = ctx.WithBlockHeight(110)
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "proposedcoin")
+	require.NoError(t, err)
+	require.Equal(t, int64(10), m.GetCreatedHeight())
+
+	require.Empty(t, app.MarkerKeeper.GetPendingMarkers(ctx, 101))
+	pending := app.MarkerKeeper.GetPendingMarkers(ctx, 100)
+	require.Len(t, pending, 1)
+	require.Equal(t, "proposedcoin", pending[0].GetDenom())
+
+	res, err := app.MarkerKeeper.PendingMarkers(sdk.WrapSDKContext(ctx), &types.QueryPendingMarkersRequest{MinAge: 100})
+	require.NoError(t, err)
+	require.Len(t, res.Markers, 1)
+	require.Equal(t, uint64(1), res.Pagination.Total)
+
+	_, err = app.MarkerKeeper.PendingMarkers(sdk.WrapSDKContext(ctx), &types.QueryPendingMarkersRequest{MinAge: -1})
+	require.Error(t, err)
+}
+
+func TestMarkerGrants(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	manager := testUserAddress("manager")
+	admin := testUserAddress("admin")
+	operator := testUserAddress("operator")
+	other := testUserAddress("other")
+
+	mac := types.NewEmptyMarkerAccount("grantcoin", manager.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Admin})})
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+
+	expiration := ctx.BlockTime().Add(time.Hour)
+	authorizations, err := types.NewOperatorGrantAuthorizations("supply")
+	require.NoError(t, err)
+	for _, authorization := range authorizations {
+		require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, operator, admin, authorization, expiration))
+	}
+	// Grants of other msgs, from accounts without access, or that have expired are not included.
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, operator, admin, authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})), expiration))
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, operator, other, authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgMintRequest{})), expiration))
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, other, manager, authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgActivateRequest{})), ctx.BlockTime().Add(-time.Hour)))
+
+	res, err := app.MarkerKeeper.MarkerGrants(sdk.WrapSDKContext(ctx), &types.QueryMarkerGrantsRequest{Id: "grantcoin"})
+	require.NoError(t, err)
+	msgTypes := make([]string, len(res.Grants))
+	for i, grant := range res.Grants {
+		require.Equal(t, admin.String(), grant.Granter)
+		require.Equal(t, operator.String(), grant.Grantee)
+		msgTypes[i] = grant.Grant.GetAuthorization().MsgTypeURL()
+	}
+	require.ElementsMatch(t, []string{sdk.MsgTypeURL(&types.MsgMintRequest{}), sdk.MsgTypeURL(&types.MsgBurnRequest{})}, msgTypes)
+
+	_, err = app.MarkerKeeper.MarkerGrants(sdk.WrapSDKContext(ctx), &types.QueryMarkerGrantsRequest{Id: "nocoin"})
+	require.Error(t, err)
+}
+
+func TestIbcRateLimits(t *testing.T) {
+	app := simapp.Setup(false)
+	start := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: start})
+	user := testUserAddress("test")
+
+	mac := types.NewEmptyMarkerAccount("ratecoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("ratecoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "ratecoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "ratecoin"))
+
+	// transfers of denoms without a rate limit are not tracked
+	require.NoError(t, app.MarkerKeeper.TrackIbcSend(ctx, "channel-0", "ratecoin", sdk.NewInt(5000)))
+	require.Nil(t, app.MarkerKeeper.GetIbcRateLimit(ctx, "ratecoin", "channel-0"))
+
+	require.NoError(t, markerkeeper.HandleSetIbcRateLimitProposal(ctx, app.MarkerKeeper,
+		types.NewSetIbcRateLimitProposal("title", "description", "ratecoin", "channel-0", 10, 20, 24)))
+	limit := app.MarkerKeeper.GetIbcRateLimit(ctx, "ratecoin", "channel-0")
+	require.NotNil(t, limit)
+	require.Equal(t, types.NewIbcRateLimitFlow(sdk.NewInt(1000), start.Add(24*time.Hour)), limit.Flow)
+
+	// the send quota is 10% of the supply at the start of the period
+	require.NoError(t, app.MarkerKeeper.TrackIbcSend(ctx, "channel-0", "ratecoin", sdk.NewInt(60)))
+	err := app.MarkerKeeper.TrackIbcSend(ctx, "channel-0", "ratecoin", sdk.NewInt(50))
+	require.ErrorIs(t, err, types.ErrIbcRateLimitExceeded)
+	require.EqualError(t, err, "net send flow 110ratecoin over channel-0 would exceed the quota of 100 (10% of supply 1000): ibc rate limit exceeded")
+	require.NoError(t, app.MarkerKeeper.TrackIbcSend(ctx, "channel-1", "ratecoin", sdk.NewInt(50)))
+
+	// the quotas apply to the net flow of the period
+	require.NoError(t, app.MarkerKeeper.TrackIbcRecv(ctx, "channel-0", "ratecoin", sdk.NewInt(30)))
+	require.NoError(t, app.MarkerKeeper.TrackIbcSend(ctx, "channel-0", "ratecoin", sdk.NewInt(70)))
+	require.ErrorIs(t, app.MarkerKeeper.TrackIbcRecv(ctx, "channel-0", "ratecoin", sdk.NewInt(331)), types.ErrIbcRateLimitExceeded)
+	limit = app.MarkerKeeper.GetIbcRateLimit(ctx, "ratecoin", "channel-0")
+	require.Equal(t, sdk.NewInt(30), limit.Flow.Inflow)
+	require.Equal(t, sdk.NewInt(130), limit.Flow.Outflow)
+
+	// refunded sends are removed from the outflow
+	app.MarkerKeeper.RevertIbcSend(ctx, "channel-0", "ratecoin", sdk.NewInt(70))
+	require.Equal(t, sdk.NewInt(60), app.MarkerKeeper.GetIbcRateLimit(ctx, "ratecoin", "channel-0").Flow.Outflow)
+	app.MarkerKeeper.RevertIbcSend(ctx, "channel-0", "ratecoin", sdk.NewInt(500))
+	require.Equal(t, sdk.ZeroInt(), app.MarkerKeeper.GetIbcRateLimit(ctx, "ratecoin", "channel-0").Flow.Outflow)
+
+	query, err := app.MarkerKeeper.IbcRateLimits(sdk.WrapSDKContext(ctx), &types.QueryIbcRateLimitsRequest{Denom: "ratecoin"})
+	require.NoError(t, err)
+	require.Len(t, query.RateLimits, 1)
+	query, err = app.MarkerKeeper.IbcRateLimits(sdk.WrapSDKContext(ctx), &types.QueryIbcRateLimitsRequest{Denom: "othercoin"})
+	require.NoError(t, err)
+	require.Empty(t, query.RateLimits)
+	require.Len(t, app.MarkerKeeper.ExportGenesis(ctx).IbcRateLimits, 1)
+
+	// the flow and supply are reset once the period ends
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin("ratecoin", 1000)))
+	ctx = ctx.WithBlockTime(start.Add(24 * time.Hour))
+	require.NoError(t, app.MarkerKeeper.TrackIbcSend(ctx, "channel-0", "ratecoin", sdk.NewInt(200)))
+	limit = app.MarkerKeeper.GetIbcRateLimit(ctx, "ratecoin", "channel-0")
+	require.Equal(t, sdk.NewInt(2000), limit.Flow.Supply)
+	require.Equal(t, sdk.ZeroInt(), limit.Flow.Inflow)
+	require.Equal(t, sdk.NewInt(200), limit.Flow.Outflow)
+	require.Equal(t, start.Add(48*time.Hour), limit.Flow.PeriodEnd)
+
+	require.NoError(t, markerkeeper.HandleRemoveIbcRateLimitProposal(ctx, app.MarkerKeeper,
+		types.NewRemoveIbcRateLimitProposal("title", "description", "ratecoin", "channel-0")))
+	require.NoError(t, app.MarkerKeeper.TrackIbcSend(ctx, "channel-0", "ratecoin", sdk.NewInt(5000)))
+	require.Empty(t, app.MarkerKeeper.ExportGenesis(ctx).IbcRateLimits)
+}
+
+func TestWithdrawAllowances(t *testing.T) {
+	app := simapp.Setup(false)
+	start := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: start})
+	user := testUserAddress("test")
+	operator := testUserAddress("operator")
+	other := testUserAddress("other")
+
+	mac := types.NewEmptyMarkerAccount("limitcoin", user.String(), []types.AccessGrant{
+		*types.NewAccessGrant(user, []types.Access{types.Access_Mint, types.Access_Admin, types.Access_Withdraw}),
+		*types.NewAccessGrant(operator, []types.Access{types.Access_Withdraw}),
+	})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("limitcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "limitcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "limitcoin"))
+	require.NoError(t, simapp.FundAccount(app, ctx, mac.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))))
+
+	maxAmount := sdk.NewCoins(sdk.NewInt64Coin("limitcoin", 100))
+	allowance := types.NewWithdrawAllowance("limitcoin", operator, maxAmount, 24*time.Hour)
+
+	// only admins can set allowances
+	require.EqualError(t, app.MarkerKeeper.GrantWithdrawAllowance(ctx, operator, *allowance),
+		fmt.Sprintf("%s does not have ACCESS_ADMIN on limitcoin markeraccount", operator))
+	require.NoError(t, app.MarkerKeeper.GrantWithdrawAllowance(ctx, user, *allowance))
+	events := ctx.EventManager().ABCIEvents()
+	setEvent, err := sdk.ParseTypedEvent(events[len(events)-1])
+	require.NoError(t, err)
+	require.Equal(t, types.NewEventMarkerWithdrawAllowanceSet(*allowance, user.String()), setEvent)
+
+	// withdrawals are limited within the rolling window
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, operator, other, "limitcoin", sdk.NewCoins(sdk.NewInt64Coin("limitcoin", 60))))
+	ctx = ctx.WithBlockTime(start.Add(12 * time.Hour))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, operator, other, "limitcoin", sdk.NewCoins(sdk.NewInt64Coin("limitcoin", 30))))
+	err = app.MarkerKeeper.WithdrawCoins(ctx, operator, other, "limitcoin", sdk.NewCoins(sdk.NewInt64Coin("limitcoin", 20)))
+	require.ErrorIs(t, err, types.ErrWithdrawAllowance)
+	require.EqualError(t, err, fmt.Sprintf("%s cannot withdraw 20limitcoin from limitcoin marker, allowance of 100limitcoin per 24h0m0s has 10limitcoin remaining: withdraw allowance exceeded", operator))
+
+	// coin not in the allowance cannot be withdrawn by the grantee, others are not limited
+	require.ErrorIs(t, app.MarkerKeeper.WithdrawCoins(ctx, operator, other, "limitcoin", sdk.NewCoins(sdk.NewInt64Coin("nhash", 1))), types.ErrWithdrawAllowance)
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, other, "limitcoin", sdk.NewCoins(sdk.NewInt64Coin("limitcoin", 200))))
+
+	res, err := app.MarkerKeeper.WithdrawAllowances(sdk.WrapSDKContext(ctx), &types.QueryWithdrawAllowancesRequest{Id: "limitcoin"})
+	require.NoError(t, err)
+	require.Len(t, res.Allowances, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("limitcoin", 10)), res.Allowances[0].Remaining)
+	res, err = app.MarkerKeeper.WithdrawAllowances(sdk.WrapSDKContext(ctx), &types.QueryWithdrawAllowancesRequest{Id: "limitcoin", Grantee: other.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Allowances)
+
+	// withdrawals older than the period no longer count
+	ctx = ctx.WithBlockTime(start.Add(30 * time.Hour))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, operator, other, "limitcoin", sdk.NewCoins(sdk.NewInt64Coin("limitcoin", 70))))
+	require.ErrorIs(t, app.MarkerKeeper.WithdrawCoins(ctx, operator, other, "limitcoin", sdk.NewCoins(sdk.NewInt64Coin("limitcoin", 1))), types.ErrWithdrawAllowance)
+
+	// allowances are exported with genesis
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.WithdrawAllowances, 1)
+	require.Len(t, genesis.WithdrawAllowances[0].Withdrawals, 2)
+
+	// revoking the allowance removes the limit
+	require.EqualError(t, app.MarkerKeeper.RevokeWithdrawAllowance(ctx, user, "limitcoin", other),
+		fmt.Sprintf("%s has no withdraw allowance on limitcoin markeraccount", other))
+	require.NoError(t, app.MarkerKeeper.RevokeWithdrawAllowance(ctx, user, "limitcoin", operator))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, operator, other, "limitcoin", sdk.NewCoins(sdk.NewInt64Coin("limitcoin", 200))))
+	require.Empty(t, app.MarkerKeeper.ExportGenesis(ctx).WithdrawAllowances)
+}
+
+func TestOrphanedMarkers(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	user := testUserAddress("test")
+	other := testUserAddress("other")
+
+	mac := types.NewMarkerAccount(authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress("orphancoin")),
+		sdk.NewInt64Coin("orphancoin", 1000), user,
+		[]types.AccessGrant{
+			*types.NewAccessGrant(user, []types.Access{types.Access_Mint, types.Access_Admin, types.Access_Delete}),
+			*types.NewAccessGrant(other, []types.Access{types.Access_Admin}),
+		},
+		types.StatusActive, types.MarkerType_Coin)
+	mac.AllowGovernanceControl = true
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+
+	// the last holder of delete access on an active marker cannot be removed
+	require.ErrorIs(t, app.MarkerKeeper.RemoveAccess(ctx, other, "orphancoin", user), types.ErrOrphanedMarker)
+	// other admins can be removed while an admin remains
+	require.NoError(t, app.MarkerKeeper.RemoveAccess(ctx, user, "orphancoin", other))
+
+	// governance can only orphan a marker with the explicit override
+	removal := types.NewRemoveAdministratorProposal("title", "description", "orphancoin", []string{user.String()})
+	require.ErrorIs(t, markerkeeper.HandleRemoveAdministratorProposal(ctx, app.MarkerKeeper, removal), types.ErrOrphanedMarker)
+	res, err := app.MarkerKeeper.OrphanedMarkers(sdk.WrapSDKContext(ctx), &types.QueryOrphanedMarkersRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Markers)
+
+	removal.AllowOrphaned = true
+	require.NoError(t, markerkeeper.HandleRemoveAdministratorProposal(ctx, app.MarkerKeeper, removal))
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "orphancoin")
+	require.NoError(t, err)
+	require.Empty(t, m.GetAccessList())
+	require.True(t, app.MarkerKeeper.IsOrphanedMarker(ctx, m))
+
+	res, err = app.MarkerKeeper.OrphanedMarkers(sdk.WrapSDKContext(ctx), &types.QueryOrphanedMarkersRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Markers, 1)
+	require.Equal(t, uint64(1), res.Pagination.Total)
+}
+
+func TestIssuerDashboard(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 50, Time: now})
+	user := testUserAddress("test")
+	other := testUserAddress("other")
+	minter := testUserAddress("minter")
+	operator := testUserAddress("operator")
+
+	soon, later := now.Add(time.Hour), now.Add(30*24*time.Hour)
+	mintGrant := types.NewAccessGrant(minter, []types.Access{types.Access_Mint})
+	mintGrant.Expiration = &soon
+	burnGrant := types.NewAccessGrant(other, []types.Access{types.Access_Burn})
+	burnGrant.Expiration = &later
+	mac := types.NewMarkerAccount(authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress("dashcoin")),
+		sdk.NewInt64Coin("dashcoin", 1000), user,
+		[]types.AccessGrant{
+			*types.NewAccessGrant(user, []types.Access{types.Access_Mint, types.Access_Admin, types.Access_Delete}),
+			*mintGrant,
+			*burnGrant,
+		},
+		types.StatusActive, types.MarkerType_Coin)
+	mac.AllowGovernanceControl = true
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	managed := types.NewEmptyMarkerAccount("managedcoin", user.String(), nil)
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, managed))
+	otherMarker := types.NewEmptyMarkerAccount("othercoin", other.String(), []types.AccessGrant{*types.NewAccessGrant(other,
+		[]types.Access{types.Access_Admin})})
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, otherMarker))
+
+	// an old and a recent escrow deposit
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("nhash", 10))
+	require.NoError(t, app.MarkerKeeper.RecordEscrowDeposit(ctx, other, mac.GetAddress(), deposit))
+	ctx = ctx.WithBlockHeight(100)
+	require.NoError(t, simapp.FundAccount(app, ctx, mac.GetAddress(), deposit))
+	require.NoError(t, app.MarkerKeeper.RecordEscrowDeposit(ctx, other, mac.GetAddress(), deposit))
+
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, operator, user,
+		authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgMintRequest{})), now.Add(2*time.Hour)))
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, operator, user,
+		authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgBurnRequest{})), later))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, types.NewSetAdministratorProposal("title", "description", "dashcoin",
+		[]types.AccessGrant{*types.NewAccessGrant(operator, []types.Access{types.Access_Withdraw})}))
+	require.NoError(t, err)
+
+	res, err := app.MarkerKeeper.IssuerDashboard(sdk.WrapSDKContext(ctx),
+		&types.QueryIssuerDashboardRequest{Address: user.String(), ActivityBlocks: 10})
+	require.NoError(t, err)
+	require.Len(t, res.Markers, 2, "markers the address manages or administers")
+	denoms := []string{res.Markers[0].Denom, res.Markers[1].Denom}
+	require.ElementsMatch(t, []string{"dashcoin", "managedcoin"}, denoms)
+
+	dash := res.Markers[0]
+	if dash.Denom != "dashcoin" {
+		dash = res.Markers[1]
+	}
+	require.Equal(t, types.StatusActive, dash.Status)
+	require.Equal(t, sdk.NewInt64Coin("dashcoin", 1000), dash.Supply)
+	require.Equal(t, sdk.NewInt64Coin("dashcoin", 0), dash.Circulation)
+	require.Equal(t, deposit, dash.Escrow)
+	require.Equal(t, uint64(1), dash.RecentEscrowDeposits)
+	require.Equal(t, uint64(0), dash.RecentWithdrawals)
+	require.Equal(t, []uint64{proposal.ProposalId}, dash.PendingProposalIds)
+	require.Equal(t, []types.AccessGrant{*mintGrant}, dash.ExpiringAccess)
+	require.Len(t, dash.ExpiringAuthzGrants, 1)
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgMintRequest{}), dash.ExpiringAuthzGrants[0].Grant.GetAuthorization().MsgTypeURL())
+
+	// the windows widen to include all deposits and grants
+	res, err = app.MarkerKeeper.IssuerDashboard(sdk.WrapSDKContext(ctx), &types.QueryIssuerDashboardRequest{
+		Address: user.String(), ActivityBlocks: 100, ExpiringWithin: 60 * 24 * time.Hour,
+	})
+	require.NoError(t, err)
+	for _, m := range res.Markers {
+		if m.Denom == "dashcoin" {
+			require.Equal(t, uint64(2), m.RecentEscrowDeposits)
+			require.Len(t, m.ExpiringAccess, 2)
+			require.Len(t, m.ExpiringAuthzGrants, 2)
+		}
+	}
+
+	_, err = app.MarkerKeeper.IssuerDashboard(sdk.WrapSDKContext(ctx), &types.QueryIssuerDashboardRequest{Address: "invalid"})
+	require.Error(t, err)
+	_, err = app.MarkerKeeper.IssuerDashboard(sdk.WrapSDKContext(ctx),
+		&types.QueryIssuerDashboardRequest{Address: user.String(), ActivityBlocks: -1})
+	require.Error(t, err)
+}
+
+func TestIsTransferable(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(10)
+	user := testUserAddress("test")
+	user2 := testUserAddress("test2")
+	admin := testUserAddress("admin")
+
+	reasons := func(req *types.QueryIsTransferableRequest) []types.TransferBlockReason {
+		res, err := app.MarkerKeeper.IsTransferable(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err)
+		require.Equal(t, len(res.Blocks) == 0, res.Transferable)
+		var found []types.TransferBlockReason
+		for _, block := range res.Blocks {
+			require.NotEmpty(t, block.Message)
+			found = append(found, block.Reason)
+		}
+		return found
+	}
+	request := func(denom string, amount int64, adminAddr string) *types.QueryIsTransferableRequest {
+		return &types.QueryIsTransferableRequest{
+			Denom: denom, FromAddress: user.String(), ToAddress: user2.String(), Amount: sdk.NewInt(amount), AdminAddress: adminAddr,
+		}
+	}
+
+	_, err := app.MarkerKeeper.IsTransferable(sdk.WrapSDKContext(ctx), request("testcoin", 0, ""))
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = amount must be positive")
+
+	// plain bank denoms only need funds and send enabled
+	require.NoError(t, simapp.FundAccount(app, ctx, user, sdk.NewCoins(sdk.NewInt64Coin("plaincoin", 100))))
+	require.Empty(t, reasons(request("plaincoin", 100, "")))
+	require.Equal(t, []types.TransferBlockReason{types.TransferBlockReason_TRANSFER_BLOCK_REASON_INSUFFICIENT_FUNDS},
+		reasons(request("plaincoin", 101, "")))
+	require.Equal(t, []types.TransferBlockReason{types.TransferBlockReason_TRANSFER_BLOCK_REASON_BLOCKED_RECIPIENT},
+		reasons(&types.QueryIsTransferableRequest{
+			Denom: "plaincoin", FromAddress: user.String(), ToAddress: app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName).String(), Amount: sdk.NewInt(1),
+		}))
+
+	mac := types.NewEmptyMarkerAccount("testcoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Admin})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	require.NoError(t, mac.SetManager(user))
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("testcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "testcoin"))
+	require.Equal(t, []types.TransferBlockReason{
+		types.TransferBlockReason_TRANSFER_BLOCK_REASON_INSUFFICIENT_FUNDS,
+		types.TransferBlockReason_TRANSFER_BLOCK_REASON_MARKER_NOT_ACTIVE,
+		types.TransferBlockReason_TRANSFER_BLOCK_REASON_NO_TRANSFER_ACCESS,
+	}, reasons(request("testcoin", 10, "")))
+
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "testcoin"))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, user, "testcoin", sdk.NewCoins(sdk.NewInt64Coin("testcoin", 1000))))
+	require.Equal(t, []types.TransferBlockReason{types.TransferBlockReason_TRANSFER_BLOCK_REASON_NO_TRANSFER_ACCESS},
+		reasons(request("testcoin", 10, "")), "restricted coins are not checked against bank send enabled")
+
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, user, "testcoin",
+		types.NewAccessGrant(admin, []types.Access{types.Access_Transfer})))
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, user, "testcoin",
+		types.NewAccessGrant(user, []types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Admin, types.Access_Transfer})))
+	require.Empty(t, reasons(request("testcoin", 10, "")))
+	require.Equal(t, []types.TransferBlockReason{types.TransferBlockReason_TRANSFER_BLOCK_REASON_NO_TRANSFER_AUTHORIZATION},
+		reasons(request("testcoin", 10, admin.String())))
+
+	app.MarkerKeeper.SetTransferPause(ctx, *types.NewTransferPause(nil, 20, false))
+	require.Equal(t, []types.TransferBlockReason{types.TransferBlockReason_TRANSFER_BLOCK_REASON_TRANSFERS_PAUSED},
+		reasons(request("testcoin", 10, "")))
+
+	// the query matches the outcome of the transfer
+	ctx = ctx.WithBlockHeight(20)
+	require.Empty(t, reasons(request("testcoin", 10, "")))
+	require.NoError(t, app.MarkerKeeper.TransferCoin(ctx, user, user2, user, sdk.NewCoin("testcoin", sdk.NewInt(10))))
+}
+
+func TestDistributions(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(10)
+	user := testUserAddress("test")
+	holders := []sdk.AccAddress{testUserAddress("holder1"), testUserAddress("holder2"), testUserAddress("holder3")}
+
+	mac := types.NewEmptyMarkerAccount("divcoin", user.String(), []types.AccessGrant{
+		*types.NewAccessGrant(user, []types.Access{types.Access_Mint, types.Access_Admin, types.Access_Withdraw}),
+	})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("divcoin", sdk.NewInt(1100))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "divcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "divcoin"))
+	for i, amount := range []int64{300, 600, 100} {
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, holders[i], "divcoin", sdk.NewCoins(sdk.NewInt64Coin("divcoin", amount))))
+	}
+	require.NoError(t, simapp.FundAccount(app, ctx, user, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1001))))
+
+	payout := sdk.NewInt64Coin("nhash", 1001)
+	_, err := app.MarkerKeeper.ScheduleDistribution(ctx, holders[0], "divcoin", payout, 20, 10)
+	require.EqualError(t, err, fmt.Sprintf("%s does not have ACCESS_ADMIN on divcoin markeraccount", holders[0]))
+	_, err = app.MarkerKeeper.ScheduleDistribution(ctx, user, "divcoin", payout, 10, 10)
+	require.EqualError(t, err, "snapshot height 10 must be after the current height 10")
+	id, err := app.MarkerKeeper.ScheduleDistribution(ctx, user, "divcoin", payout, 20, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+	require.True(t, app.BankKeeper.GetBalance(ctx, user, "nhash").IsZero(), "payout deposited")
+
+	_, err = app.MarkerKeeper.ClaimDistribution(ctx, id, holders[0])
+	require.EqualError(t, err, "distribution 1 cannot be claimed before its snapshot at height 20")
+
+	// balances change before the snapshot is taken at the end of the snapshot height
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, holders[2], holders[0], sdk.NewCoins(sdk.NewInt64Coin("divcoin", 50))))
+	app.MarkerKeeper.ProcessDistributions(ctx.WithBlockHeight(19))
+	require.Equal(t, types.DistributionStatusScheduled, app.MarkerKeeper.GetDistribution(ctx, id).Status)
+	ctx = ctx.WithBlockHeight(20)
+	app.MarkerKeeper.ProcessDistributions(ctx)
+	distribution := app.MarkerKeeper.GetDistribution(ctx, id)
+	require.Equal(t, types.DistributionStatusClaimable, distribution.Status)
+	require.Equal(t, sdk.NewInt(1000), distribution.SnapshotTotal, "marker escrow is not a holder")
+
+	res, err := app.MarkerKeeper.DistributionEntitlements(sdk.WrapSDKContext(ctx), &types.QueryDistributionEntitlementsRequest{DistributionId: id})
+	require.NoError(t, err)
+	require.Len(t, res.Entitlements, 3)
+	res, err = app.MarkerKeeper.DistributionEntitlements(sdk.WrapSDKContext(ctx),
+		&types.QueryDistributionEntitlementsRequest{DistributionId: id, Address: holders[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.DistributionEntitlement{
+		{DistributionId: id, Address: holders[0].String(), Balance: sdk.NewInt(350), Amount: sdk.NewInt64Coin("nhash", 350)},
+	}, res.Entitlements)
+
+	amount, err := app.MarkerKeeper.ClaimDistribution(ctx.WithBlockHeight(25), id, holders[1])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("nhash", 600), amount)
+	require.Equal(t, sdk.NewInt64Coin("nhash", 600), app.BankKeeper.GetBalance(ctx, holders[1], "nhash"))
+	_, err = app.MarkerKeeper.ClaimDistribution(ctx, id, holders[1])
+	require.ErrorIs(t, err, types.ErrNoEntitlement)
+
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.Distributions, 1)
+	require.Len(t, genesis.DistributionEntitlements, 2)
+	require.Equal(t, uint64(2), genesis.NextDistributionId)
+
+	dres, err := app.MarkerKeeper.Distributions(sdk.WrapSDKContext(ctx), &types.QueryDistributionsRequest{Id: "divcoin"})
+	require.NoError(t, err)
+	require.Len(t, dres.Distributions, 1)
+	require.Equal(t, sdk.NewInt64Coin("nhash", 600), dres.Distributions[0].Claimed)
+
+	// unclaimed shares and the rounding remainder return to the marker escrow when the claim window ends
+	app.MarkerKeeper.ProcessDistributions(ctx.WithBlockHeight(30))
+	require.Nil(t, app.MarkerKeeper.GetDistribution(ctx, id))
+	_, err = app.MarkerKeeper.ClaimDistribution(ctx, id, holders[0])
+	require.ErrorIs(t, err, types.ErrDistributionNotFound)
+	require.Equal(t, sdk.NewInt64Coin("nhash", 401), app.BankKeeper.GetBalance(ctx, mac.GetAddress(), "nhash"))
+	genesis = app.MarkerKeeper.ExportGenesis(ctx)
+	require.Empty(t, genesis.Distributions)
+	require.Empty(t, genesis.DistributionEntitlements)
+}
+
+func TestFaucet(t *testing.T) {
+	app := simapp.Setup(false)
+	start := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: start})
+	user := testUserAddress("test")
+	operator := testUserAddress("operator")
+	qualified := testUserAddress("qualified")
+	other := testUserAddress("other")
+
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, user))
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "kyc.faucet", user, false))
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+		attrtypes.NewAttribute("kyc.faucet", qualified, attrtypes.AttributeType_String, []byte("passed")), user))
+
+	mac := types.NewEmptyMarkerAccount("faucetcoin", user.String(), []types.AccessGrant{
+		*types.NewAccessGrant(user, []types.Access{types.Access_Mint, types.Access_Admin, types.Access_Withdraw}),
+		*types.NewAccessGrant(operator, []types.Access{types.Access_Withdraw}),
+	})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("faucetcoin", sdk.NewInt(150))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "faucetcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "faucetcoin"))
+
+	amount := sdk.NewInt64Coin("faucetcoin", 100)
+	policy := types.NewFaucetPolicy("faucetcoin", user, amount, "kyc.faucet", 24*time.Hour)
+
+	// only admins with withdraw access can set faucet policies
+	require.EqualError(t, app.MarkerKeeper.AddFaucetPolicy(ctx, operator, *policy),
+		fmt.Sprintf("%s does not have ACCESS_ADMIN on faucetcoin markeraccount", operator))
+	_, err := app.MarkerKeeper.ClaimFaucet(ctx, "faucetcoin", qualified)
+	require.ErrorIs(t, err, types.ErrFaucetPolicyNotFound)
+	require.NoError(t, app.MarkerKeeper.AddFaucetPolicy(ctx, user, *policy))
+	events := ctx.EventManager().ABCIEvents()
+	setEvent, err := sdk.ParseTypedEvent(events[len(events)-1])
+	require.NoError(t, err)
+	require.Equal(t, types.NewEventMarkerFaucetPolicySet(*policy), setEvent)
+
+	// addresses without the attribute cannot claim
+	_, err = app.MarkerKeeper.ClaimFaucet(ctx, "faucetcoin", other)
+	require.ErrorIs(t, err, types.ErrFaucetNotQualified)
+
+	// the first claim is paid from the escrow, the claimant must wait for the cooldown to claim again
+	claimed, err := app.MarkerKeeper.ClaimFaucet(ctx, "faucetcoin", qualified)
+	require.NoError(t, err)
+	require.Equal(t, amount, claimed)
+	require.Equal(t, amount, app.BankKeeper.GetBalance(ctx, qualified, "faucetcoin"))
+	ctx = ctx.WithBlockTime(start.Add(12 * time.Hour))
+	_, err = app.MarkerKeeper.ClaimFaucet(ctx, "faucetcoin", qualified)
+	require.ErrorIs(t, err, types.ErrFaucetCooldown)
+
+	// the shortfall in the escrow is minted once the cooldown has elapsed
+	ctx = ctx.WithBlockTime(start.Add(24 * time.Hour))
+	_, err = app.MarkerKeeper.ClaimFaucet(ctx, "faucetcoin", qualified)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("faucetcoin", 200), app.BankKeeper.GetBalance(ctx, qualified, "faucetcoin"))
+	require.Equal(t, sdk.NewInt64Coin("faucetcoin", 0), app.BankKeeper.GetBalance(ctx, mac.GetAddress(), "faucetcoin"))
+	require.Equal(t, sdk.NewInt64Coin("faucetcoin", 200), app.BankKeeper.GetSupply(ctx, "faucetcoin"))
+
+	// policies and claims are exported with genesis
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.FaucetPolicies, 1)
+	require.Len(t, genesis.FaucetClaims, 1)
+	require.Equal(t, start.Add(24*time.Hour), genesis.FaucetClaims[0].LastClaimTime)
+
+	// a zero cooldown allows a single claim per address
+	policy.Cooldown = 0
+	require.NoError(t, app.MarkerKeeper.AddFaucetPolicy(ctx, user, *policy))
+	ctx = ctx.WithBlockTime(start.Add(72 * time.Hour))
+	_, err = app.MarkerKeeper.ClaimFaucet(ctx, "faucetcoin", qualified)
+	require.ErrorIs(t, err, types.ErrFaucetCooldown)
+
+	require.NoError(t, app.MarkerKeeper.RemoveFaucetPolicy(ctx, user, "faucetcoin"))
+	require.ErrorIs(t, app.MarkerKeeper.RemoveFaucetPolicy(ctx, user, "faucetcoin"), types.ErrFaucetPolicyNotFound)
+	require.Empty(t, app.MarkerKeeper.ExportGenesis(ctx).FaucetPolicies)
+}
+
+func TestDenomMigration(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(10)
+	user := testUserAddress("test")
+	holders := []sdk.AccAddress{testUserAddress("holder1"), testUserAddress("holder2"), testUserAddress("holder3")}
+
+	mac := types.NewEmptyMarkerAccount("oldcoin", user.String(), []types.AccessGrant{
+		*types.NewAccessGrant(user, []types.Access{types.Access_Mint, types.Access_Burn, types.Access_Admin, types.Access_Withdraw}),
+	})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("oldcoin", sdk.NewInt(1100))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "oldcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "oldcoin"))
+	for i, amount := range []int64{300, 600, 100} {
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, holders[i], "oldcoin", sdk.NewCoins(sdk.NewInt64Coin("oldcoin", amount))))
+	}
+
+	handler := func(c *types.MigrateDenomProposal) error {
+		return markerkeeper.HandleMigrateDenomProposal(ctx, app.MarkerKeeper, c)
+	}
+	require.EqualError(t, handler(types.NewMigrateDenomProposal("title", "description", "nocoin", "newcoin", 2)),
+		"marker not found for nocoin: marker nocoin not found for address: "+types.MustGetMarkerAddress("nocoin").String())
+	require.NoError(t, handler(types.NewMigrateDenomProposal("title", "description", "oldcoin", "newcoin", 2)))
+	require.ErrorIs(t, handler(types.NewMigrateDenomProposal("title", "description", "oldcoin", "othercoin", 2)), types.ErrDenomMigrated)
+
+	migration := app.MarkerKeeper.GetDenomMigration(ctx, "oldcoin")
+	require.NotNil(t, migration)
+	require.Equal(t, types.DenomMigrationStatusSnapshot, migration.Status)
+	require.Zero(t, migration.TotalHolders, "holders are recorded in the following blocks")
+
+	// the old marker is frozen while its holders are migrated
+	require.ErrorIs(t, app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin("oldcoin", 10)), types.ErrDenomMigrated)
+	require.ErrorIs(t, app.MarkerKeeper.BurnCoin(ctx, user, sdk.NewInt64Coin("oldcoin", 10)), types.ErrDenomMigrated)
+	require.ErrorIs(t, app.MarkerKeeper.WithdrawCoins(ctx, user, user, "oldcoin", sdk.NewCoins(sdk.NewInt64Coin("oldcoin", 10))), types.ErrDenomMigrated)
+	require.False(t, app.BankKeeper.IsSendEnabledCoin(ctx, sdk.NewInt64Coin("oldcoin", 10)))
+	oldMarker, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "oldcoin")
+	require.NoError(t, err)
+	app.MarkerKeeper.SetMarker(ctx, oldMarker)
+	require.False(t, app.BankKeeper.IsSendEnabledCoin(ctx, sdk.NewInt64Coin("oldcoin", 10)), "saving the old marker keeps it frozen")
+
+	newMarker, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "newcoin")
+	require.NoError(t, err)
+	require.Equal(t, types.StatusActive, newMarker.GetStatus())
+	require.Equal(t, mac.GetAccessList(), newMarker.GetAccessList())
+
+	// the snapshot checks holders per block accounts in each block
+	snapshotBlocks := 0
+	for ; app.MarkerKeeper.GetDenomMigration(ctx, "oldcoin").IsSnapshot(); snapshotBlocks++ {
+		require.Less(t, snapshotBlocks, 100, "snapshot blocks")
+		app.MarkerKeeper.ProcessDenomMigrations(ctx)
+	}
+	require.Greater(t, snapshotBlocks, 1, "snapshot blocks")
+	migration = app.MarkerKeeper.GetDenomMigration(ctx, "oldcoin")
+	require.Equal(t, types.DenomMigrationStatusInProgress, migration.Status)
+	require.Empty(t, migration.SnapshotNextKey)
+	require.Equal(t, uint64(4), migration.TotalHolders, "marker escrow is a holder")
+	require.Equal(t, sdk.NewInt(1100), migration.FromSupply)
+
+	app.MarkerKeeper.ProcessDenomMigrations(ctx)
+	res, err := app.MarkerKeeper.DenomMigration(sdk.WrapSDKContext(ctx), &types.QueryDenomMigrationRequest{Denom: "oldcoin"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.PendingHolders)
+	require.False(t, res.Reconciled)
+
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.DenomMigrations, 1)
+	require.Len(t, genesis.DenomMigrationHolders, 2)
+
+	app.MarkerKeeper.ProcessDenomMigrations(ctx.WithBlockHeight(11))
+	res, err = app.MarkerKeeper.DenomMigration(sdk.WrapSDKContext(ctx), &types.QueryDenomMigrationRequest{Denom: "oldcoin"})
+	require.NoError(t, err)
+	require.Equal(t, types.DenomMigrationStatusComplete, res.Migration.Status)
+	require.Equal(t, int64(11), res.Migration.CompletedHeight)
+	require.Equal(t, uint64(0), res.PendingHolders)
+	require.True(t, res.Reconciled)
+	require.Equal(t, sdk.NewInt64Coin("newcoin", 1100), res.ToSupply)
+	for i, amount := range []int64{300, 600, 100} {
+		require.Equal(t, sdk.NewInt64Coin("newcoin", amount), app.BankKeeper.GetBalance(ctx, holders[i], "newcoin"))
+	}
+	require.Equal(t, sdk.NewInt64Coin("newcoin", 100), app.BankKeeper.GetBalance(ctx, newMarker.GetAddress(), "newcoin"))
+
+	_, err = app.MarkerKeeper.DenomMigration(sdk.WrapSDKContext(ctx), &types.QueryDenomMigrationRequest{Denom: "newcoin"})
+	require.Error(t, err)
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin("newcoin", 10)))
+}
+
+func TestTransferFees(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(10)
+	user := testUserAddress("test")
+	user2 := testUserAddress("test2")
+	royalty := testUserAddress("royalty")
+
+	mac := types.NewEmptyMarkerAccount("feecoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Transfer, types.Access_Admin})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("feecoin", sdk.NewInt(10000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "feecoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "feecoin"))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, user, "feecoin", sdk.NewCoins(sdk.NewInt64Coin("feecoin", 5000))))
+
+	// only admins can set a fee and only on restricted markers
+	fee := types.NewBasisPointsTransferFee("feecoin", 250, "")
+	require.EqualError(t, app.MarkerKeeper.AddTransferFee(ctx, user2, *fee),
+		fmt.Sprintf("%s does not have ACCESS_ADMIN on feecoin markeraccount", user2))
+	require.EqualError(t, app.MarkerKeeper.AddTransferFee(ctx, user, *types.NewBasisPointsTransferFee("stake", 250, "")),
+		"marker not found for stake: marker stake not found for address: "+types.MustGetMarkerAddress("stake").String())
+	require.NoError(t, app.MarkerKeeper.AddTransferFee(ctx, user, *fee))
+
+	// basis point fees are paid in the marker denom into the marker account
+	require.NoError(t, app.MarkerKeeper.TransferCoin(ctx, user, user2, user, sdk.NewInt64Coin("feecoin", 1000)))
+	require.Equal(t, sdk.NewInt64Coin("feecoin", 1000), app.BankKeeper.GetBalance(ctx, user2, "feecoin"))
+	require.Equal(t, sdk.NewInt64Coin("feecoin", 3975), app.BankKeeper.GetBalance(ctx, user, "feecoin"))
+	require.Equal(t, sdk.NewInt64Coin("feecoin", 5025), app.BankKeeper.GetBalance(ctx, mac.GetAddress(), "feecoin"))
+
+	// flat fees are paid to the named recipient
+	require.NoError(t, app.MarkerKeeper.AddTransferFee(ctx, user, *types.NewFlatTransferFee("feecoin", sdk.NewInt64Coin("nhash", 10), royalty.String())))
+	err := app.MarkerKeeper.TransferCoin(ctx, user, user2, user, sdk.NewInt64Coin("feecoin", 100))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "could not pay transfer fee of 10nhash")
+	res, err := app.MarkerKeeper.IsTransferable(sdk.WrapSDKContext(ctx), &types.QueryIsTransferableRequest{
+		Denom: "feecoin", FromAddress: user.String(), ToAddress: user2.String(), Amount: sdk.NewInt(100),
+	})
+	require.NoError(t, err)
+	require.False(t, res.Transferable)
+	require.NoError(t, simapp.FundAccount(app, ctx, user, sdk.NewCoins(sdk.NewInt64Coin("nhash", 10))))
+	require.NoError(t, app.MarkerKeeper.TransferCoin(ctx, user, user2, user, sdk.NewInt64Coin("feecoin", 100)))
+	require.Equal(t, sdk.NewInt64Coin("nhash", 10), app.BankKeeper.GetBalance(ctx, royalty, "nhash"))
+
+	query, err := app.MarkerKeeper.TransferFee(sdk.WrapSDKContext(ctx), &types.QueryTransferFeeRequest{Id: "feecoin"})
+	require.NoError(t, err)
+	require.Equal(t, types.NewFlatTransferFee("feecoin", sdk.NewInt64Coin("nhash", 10), royalty.String()), query.TransferFee)
+
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.TransferFees, 1)
+
+	// governance can change the fee of markers that allow governance control
+	require.NoError(t, markerkeeper.HandleSetTransferFeeProposal(ctx, app.MarkerKeeper,
+		types.NewSetTransferFeeProposal("title", "description", *fee)))
+	require.True(t, fee.Equal(app.MarkerKeeper.GetTransferFee(ctx, mac.GetAddress())))
+	require.NoError(t, markerkeeper.HandleRemoveTransferFeeProposal(ctx, app.MarkerKeeper,
+		types.NewRemoveTransferFeeProposal("title", "description", "feecoin")))
+	require.ErrorIs(t, app.MarkerKeeper.DeleteTransferFee(ctx, user, "feecoin"), types.ErrTransferFeeNotFound)
+	require.NoError(t, app.MarkerKeeper.TransferCoin(ctx, user, user2, user, sdk.NewInt64Coin("feecoin", 100)))
+	require.Equal(t, sdk.NewInt64Coin("feecoin", 1200), app.BankKeeper.GetBalance(ctx, user2, "feecoin"))
+}
+
+func TestMarkerTerms(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(10)
+	user := testUserAddress("test")
+	user2 := testUserAddress("test2")
+	hash1 := strings.Repeat("a1", 32)
+	hash2 := strings.Repeat("b2", 32)
+
+	mac := types.NewEmptyMarkerAccount("termscoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Admin})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("termscoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+
+	// only admins can set terms and there must be terms to clear
+	_, err := app.MarkerKeeper.AnchorTerms(ctx, user2, "termscoin", hash1, "")
+	require.EqualError(t, err, fmt.Sprintf("%s does not have ACCESS_ADMIN on termscoin markeraccount", user2))
+	_, err = app.MarkerKeeper.AnchorTerms(ctx, user, "termscoin", "", "")
+	require.ErrorIs(t, err, types.ErrInvalidTerms)
+	require.Nil(t, app.MarkerKeeper.GetCurrentTerms(ctx, mac.GetAddress()))
+
+	entry, err := app.MarkerKeeper.AnchorTerms(ctx, user, "termscoin", hash1, "https://example.com/terms/1.json")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), entry.Sequence)
+	_, err = app.MarkerKeeper.AnchorTerms(ctx, user, "termscoin", hash1, "https://example.com/terms/1.json")
+	require.ErrorIs(t, err, types.ErrInvalidTerms)
+
+	ctx = ctx.WithBlockHeight(11)
+	entry, err = app.MarkerKeeper.AnchorTerms(ctx, user, "termscoin", hash2, "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), entry.Sequence)
+	require.Equal(t, hash2, app.MarkerKeeper.GetCurrentTerms(ctx, mac.GetAddress()).TermsHash)
+
+	// clearing the terms keeps the timeline
+	ctx = ctx.WithBlockHeight(12)
+	_, err = app.MarkerKeeper.AnchorTerms(ctx, user, "termscoin", "", "")
+	require.NoError(t, err)
+	require.Nil(t, app.MarkerKeeper.GetCurrentTerms(ctx, mac.GetAddress()))
+
+	query, err := app.MarkerKeeper.Terms(sdk.WrapSDKContext(ctx), &types.QueryTermsRequest{Id: "termscoin"})
+	require.NoError(t, err)
+	require.Nil(t, query.Current)
+	require.Len(t, query.History, 3)
+	require.Equal(t, []int64{10, 11, 12}, []int64{query.History[0].BlockHeight, query.History[1].BlockHeight, query.History[2].BlockHeight})
+	require.Equal(t, "https://example.com/terms/1.json", query.History[0].Uri)
+	require.True(t, query.History[2].IsCleared())
+
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.TermsHistory, 3)
+	genesis.TermsHistory[2].Sequence = 4
+	require.EqualError(t, genesis.Validate(), "terms entry 4 of termscoin is out of sequence")
+}
+
+func TestMarkerManagementHistory(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(10).WithTxBytes([]byte("add marker tx"))
+	server := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	user := testUserAddress("test")
+	user2 := testUserAddress("test2")
+
+	_, err := server.AddMarker(sdk.WrapSDKContext(ctx), types.NewMsgAddMarkerRequest("managedcoin", sdk.NewInt(1000), user, user, types.MarkerType_Coin, true, true))
+	require.NoError(t, err)
+	mac, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "managedcoin")
+	require.NoError(t, err)
+	require.Equal(t, user.String(), mac.GetCreatedBy(), "created by")
+	require.Equal(t, fmt.Sprintf("%X", tmhash.Sum([]byte("add marker tx"))), mac.GetCreatedTxHash(), "created tx hash")
+
+	entry := app.MarkerKeeper.GetLatestManagementEntry(ctx, mac.GetAddress())
+	require.NotNil(t, entry)
+	require.Equal(t, uint64(1), entry.Sequence)
+	require.Equal(t, []string{user.String()}, entry.ManagedBy)
+	require.Equal(t, mac.GetCreatedTxHash(), entry.TxHash)
+
+	// saving a marker without changing its manager or admins does not extend the timeline
+	ctx = ctx.WithBlockHeight(11).WithTxBytes(nil)
+	app.MarkerKeeper.SetMarker(ctx, mac)
+	require.Equal(t, uint64(1), app.MarkerKeeper.GetLatestManagementEntry(ctx, mac.GetAddress()).Sequence)
+
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, user, "managedcoin", types.NewAccessGrant(user2, []types.Access{types.Access_Admin})))
+	ctx = ctx.WithBlockHeight(13)
+	require.NoError(t, app.MarkerKeeper.RemoveAccess(ctx, user, "managedcoin", user2))
+
+	query, err := app.MarkerKeeper.ManagementHistory(sdk.WrapSDKContext(ctx), &types.QueryManagementHistoryRequest{Id: mac.GetAddress().String()})
+	require.NoError(t, err)
+	require.Len(t, query.History, 3)
+	require.Equal(t, []int64{10, 12, 13}, []int64{query.History[0].BlockHeight, query.History[1].BlockHeight, query.History[2].BlockHeight})
+	managers := []string{user.String(), user2.String()}
+	sort.Strings(managers)
+	require.Equal(t, managers, query.History[1].ManagedBy)
+	require.Equal(t, []string{user.String()}, query.History[2].ManagedBy)
+	require.Empty(t, query.History[2].TxHash, "changes outside of a tx have no tx hash")
+
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.ManagementHistory, 3)
+	genesis.ManagementHistory[1].Sequence = 3
+	require.EqualError(t, genesis.Validate(), "management entry 3 of managedcoin is out of sequence")
+}
+
+func TestReferenceMarkers(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	server := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	user := testUserAddress("test")
+	holder := testUserAddress("holder")
+
+	// the denom is minted outside of the marker module
+	require.NoError(t, simapp.FundAccount(app, ctx, holder, sdk.NewCoins(sdk.NewInt64Coin("extcoin", 1000))))
+
+	msg := types.NewMsgAddMarkerRequest("extcoin", sdk.NewInt(1000), user, user, types.MarkerType_Coin, false, true)
+	msg.Reference = true
+	_, err := server.AddMarker(sdk.WrapSDKContext(ctx), msg)
+	require.EqualError(t, err, "reference marker total supply must be zero: invalid request")
+	msg.Amount.Amount = sdk.ZeroInt()
+	msg.SupplyFixed = true
+	_, err = server.AddMarker(sdk.WrapSDKContext(ctx), msg)
+	require.EqualError(t, err, "reference marker cannot have a fixed supply: invalid request")
+	msg.SupplyFixed = false
+	msg.AccessList = []types.AccessGrant{*types.NewAccessGrant(user, []types.Access{types.Access_Mint, types.Access_Burn, types.Access_Delete, types.Access_Admin})}
+	_, err = server.AddMarker(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// finalizing and activating a reference marker keeps the existing supply
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "extcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "extcoin"))
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "extcoin")
+	require.NoError(t, err)
+	require.True(t, m.IsReference())
+	require.Equal(t, types.StatusActive, m.GetStatus())
+	require.Equal(t, sdk.NewInt64Coin("extcoin", 1000), app.BankKeeper.GetSupply(ctx, "extcoin"))
+	require.True(t, app.BankKeeper.GetBalance(ctx, m.GetAddress(), "extcoin").IsZero())
+
+	// the marker module never mints or burns the coin of reference markers
+	err = app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin("extcoin", 10))
+	require.ErrorIs(t, err, types.ErrReferenceMarker)
+	err = app.MarkerKeeper.BurnCoin(ctx, user, sdk.NewInt64Coin("extcoin", 10))
+	require.ErrorIs(t, err, types.ErrReferenceMarker)
+
+	require.NoError(t, app.MarkerKeeper.CancelMarker(ctx, user, "extcoin"))
+	require.NoError(t, app.MarkerKeeper.DeleteMarker(ctx, user, "extcoin"))
+	require.Equal(t, sdk.NewInt64Coin("extcoin", 1000), app.BankKeeper.GetSupply(ctx, "extcoin"))
+	require.Equal(t, sdk.NewInt64Coin("extcoin", 1000), app.BankKeeper.GetBalance(ctx, holder, "extcoin"))
+
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.True(t, genesis.Markers[0].Reference, "reference exported in genesis")
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetLatestManagementEntry returns the latest entry in the management timeline of a marker, nil if it has none.
+func (k Keeper) GetLatestManagementEntry(ctx sdk.Context, markerAddr sdk.AccAddress) *types.MarkerManagementEntry {
+	it := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.ManagementHistoryPrefix(markerAddr))
+	defer it.Close()
+	if !it.Valid() {
+		return nil
+	}
+	var entry types.MarkerManagementEntry
+	k.cdc.MustUnmarshal(it.Value(), &entry)
+	return &entry
+}
+
+// SetManagementEntry stores an entry in the management timeline of a marker.
+func (k Keeper) SetManagementEntry(ctx sdk.Context, entry types.MarkerManagementEntry) error {
+	markerAddr, err := types.MarkerAddress(entry.Denom)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ManagementKey(markerAddr, entry.Sequence), k.cdc.MustMarshal(&entry))
+	return nil
+}
+
+// IterateManagementHistory processes the management timeline of a marker, oldest first, with the given handler.
+func (k Keeper) IterateManagementHistory(ctx sdk.Context, markerAddr sdk.AccAddress, handler func(types.MarkerManagementEntry) (stop bool)) {
+	k.iterateManagementEntries(ctx, types.ManagementHistoryPrefix(markerAddr), handler)
+}
+
+// IterateAllManagementHistory processes the management timelines of all markers with the given handler function.
+func (k Keeper) IterateAllManagementHistory(ctx sdk.Context, handler func(types.MarkerManagementEntry) (stop bool)) {
+	k.iterateManagementEntries(ctx, types.ManagementKeyPrefix, handler)
+}
+
+func (k Keeper) iterateManagementEntries(ctx sdk.Context, keyPrefix []byte, handler func(types.MarkerManagementEntry) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var entry types.MarkerManagementEntry
+		k.cdc.MustUnmarshal(it.Value(), &entry)
+		if handler(entry) {
+			break
+		}
+	}
+}
+
+// RecordManagement appends an entry to the management timeline of a marker when its managing addresses differ from
+// the latest entry.  A marker without managing addresses gets no first entry.  The timeline outlives the marker so
+// the custody of a denom that is removed and added again stays in one timeline.
+func (k Keeper) RecordManagement(ctx sdk.Context, m types.MarkerAccountI) {
+	managers := types.ManagingAddresses(m)
+	latest := k.GetLatestManagementEntry(ctx, m.GetAddress())
+	switch {
+	case latest != nil && latest.IsManagedBy(managers):
+		return
+	case latest == nil && len(managers) == 0:
+		return
+	}
+	entry := types.MarkerManagementEntry{
+		Denom:       m.GetDenom(),
+		Sequence:    1,
+		ManagedBy:   managers,
+		BlockHeight: ctx.BlockHeight(),
+		TxHash:      txHash(ctx),
+	}
+	if latest != nil {
+		entry.Sequence = latest.Sequence + 1
+	}
+	if err := k.SetManagementEntry(ctx, entry); err != nil {
+		panic(err)
+	}
+}
+
+// txHash returns the hex encoded hash of the tx being processed, empty outside of a tx, e.g. in the end blocker that
+// executes governance proposals.
+func txHash(ctx sdk.Context) string {
+	if len(ctx.TxBytes()) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes()))
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
@@ -74,6 +76,8 @@ func (k Keeper) AddMarkerAccount(ctx sdk.Context, marker types.MarkerAccountI) e
 
 	// set base account number
 	marker = k.NewMarker(ctx, marker)
+	marker.SetCreatedHeight(ctx.BlockHeight())
+	marker.SetCreatedTxHash(txHash(ctx))
 
 	if err := marker.Validate(); err != nil {
 		return err
@@ -108,7 +112,7 @@ func (k Keeper) AddAccess(
 	switch m.GetStatus() {
 	// marker is fixed/active, assert permission to make changes by checking for Grant Permission
 	case types.StatusFinalized, types.StatusActive:
-		if !m.AddressHasAccess(caller, types.Access_Admin) && !k.accountControlsAllSupply(ctx, caller, m) {
+		if !k.addressHasAccess(ctx, m, caller, types.Access_Admin) && !k.accountControlsAllSupply(ctx, caller, m) {
 			return fmt.Errorf("%s is not authorized to make access list changes against active %s marker",
 				caller, m.GetDenom())
 		}
@@ -119,6 +123,10 @@ func (k Keeper) AddAccess(
 		if !mgr.Equals(caller) && m.GetStatus() == types.StatusProposed {
 			return fmt.Errorf("updates to pending marker %s can only be made by %s", m.GetDenom(), mgr)
 		}
+		if grant.IsExpired(ctx.BlockTime()) {
+			return sdkerrors.Wrapf(types.ErrAccessExpired, "access grant for %s expires before the current block time",
+				grant.GetAddress())
+		}
 		if err = m.GrantAccess(grant); err != nil {
 			return fmt.Errorf("access grant failed: %w", err)
 		}
@@ -151,7 +159,7 @@ func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	switch m.GetStatus() {
 	// marker is fixed/active, assert permission to make changes by checking for Grant Permission
 	case types.StatusFinalized, types.StatusActive:
-		if !m.AddressHasAccess(caller, types.Access_Admin) && !k.accountControlsAllSupply(ctx, caller, m) {
+		if !k.addressHasAccess(ctx, m, caller, types.Access_Admin) && !k.accountControlsAllSupply(ctx, caller, m) {
 			return fmt.Errorf("%s is not authorized to make access list changes against active %s marker",
 				caller, m.GetDenom())
 		}
@@ -162,9 +170,13 @@ func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom strin
 		if !mgr.Equals(caller) && m.GetStatus() == types.StatusProposed {
 			return fmt.Errorf("updates to pending marker %s can only be made by %s", m.GetDenom(), mgr.String())
 		}
+		before := append([]types.AccessGrant{}, m.GetAccessList()...)
 		if err = m.RevokeAccess(remove); err != nil {
 			return fmt.Errorf("access revoke failed: %w", err)
 		}
+		if err = validateAdministrativeAccessRemains(ctx, before, m); err != nil {
+			return err
+		}
 		if err := m.Validate(); err != nil {
 			return err
 		}
@@ -194,18 +206,35 @@ func (k Keeper) WithdrawCoins(
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %s", denom, err)
 	}
-	if !m.AddressHasAccess(caller, types.Access_Withdraw) {
+	if !k.addressHasAccess(ctx, m, caller, types.Access_Withdraw) {
 		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Withdraw, m.GetDenom())
 	}
+	if coins.AmountOf(denom).IsPositive() && k.IsDenomMigrated(ctx, denom) {
+		return sdkerrors.Wrapf(types.ErrDenomMigrated, "cannot withdraw %s", denom)
+	}
+	if coins.AmountOf(denom).IsPositive() {
+		if err := k.checkMarkerPaused(ctx, denom); err != nil {
+			return err
+		}
+	}
 	// check to see if marker is active (the coins created by a marker can only be withdrawn when it is active)
 	// any other coins that may be present (collateralized assets?) can be transferred
 	if m.GetStatus() != types.StatusActive {
 		return fmt.Errorf("cannot withdraw marker created coins from a marker that is not in Active status")
 	}
+	if err := k.useWithdrawAllowance(ctx, m, caller, coins); err != nil {
+		return err
+	}
 
 	if recipient.Empty() {
 		recipient = caller
 	}
+	// withdrawing restricted coin to an address is a transfer to it
+	if m.GetMarkerType() == types.MarkerType_RestrictedCoin && coins.AmountOf(denom).IsPositive() {
+		if err := k.checkDenyList(ctx, recipient); err != nil {
+			return err
+		}
+	}
 
 	if err := k.bankKeeper.InputOutputCoins(ctx, []banktypes.Input{banktypes.NewInput(m.GetAddress(), coins)},
 		[]banktypes.Output{banktypes.NewOutput(recipient, coins)}); err != nil {
@@ -231,9 +260,18 @@ func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %s", coin.Denom, err)
 	}
-	if !m.AddressHasAccess(caller, types.Access_Mint) {
+	if !k.addressHasAccess(ctx, m, caller, types.Access_Mint) {
 		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Mint, m.GetDenom())
 	}
+	if k.IsDenomMigrated(ctx, coin.Denom) {
+		return sdkerrors.Wrapf(types.ErrDenomMigrated, "cannot mint %s", coin.Denom)
+	}
+	if m.IsReference() {
+		return sdkerrors.Wrapf(types.ErrReferenceMarker, "cannot mint %s", coin.Denom)
+	}
+	if err := k.checkMarkerPaused(ctx, coin.Denom); err != nil {
+		return err
+	}
 
 	switch {
 	// For proposed, finalized accounts we allow adjusting the total_supply of the marker but we do not
@@ -274,9 +312,18 @@ func (k Keeper) BurnCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %s", coin.Denom, err)
 	}
-	if !m.AddressHasAccess(caller, types.Access_Burn) {
+	if !k.addressHasAccess(ctx, m, caller, types.Access_Burn) {
 		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Burn, m.GetDenom())
 	}
+	if k.IsDenomMigrated(ctx, coin.Denom) {
+		return sdkerrors.Wrapf(types.ErrDenomMigrated, "cannot burn %s", coin.Denom)
+	}
+	if m.IsReference() {
+		return sdkerrors.Wrapf(types.ErrReferenceMarker, "cannot burn %s", coin.Denom)
+	}
+	if err := k.checkMarkerPaused(ctx, coin.Denom); err != nil {
+		return err
+	}
 
 	switch {
 	// For proposed, finalized accounts we allow adjusting the total_supply of the marker but we do not
@@ -307,6 +354,51 @@ func (k Keeper) BurnCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	return nil
 }
 
+// BurnCoinFrom moves restricted marker coin from a holder account into the marker account and then burns it.  The
+// caller must have burn access on the marker and the marker must be an active restricted coin marker.
+func (k Keeper) BurnCoinFrom(ctx sdk.Context, caller, from sdk.AccAddress, coin sdk.Coin) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "burn_coin_from")
+
+	// (if marker does not exist then fail)
+	m, err := k.GetMarkerByDenom(ctx, coin.Denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", coin.Denom, err)
+	}
+	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return fmt.Errorf("marker type is not restricted_coin, burn from holder accounts not supported")
+	}
+	if !k.addressHasAccess(ctx, m, caller, types.Access_Burn) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Burn, m.GetDenom())
+	}
+	if k.IsDenomMigrated(ctx, coin.Denom) {
+		return sdkerrors.Wrapf(types.ErrDenomMigrated, "cannot burn %s", coin.Denom)
+	}
+	if m.IsReference() {
+		return sdkerrors.Wrapf(types.ErrReferenceMarker, "cannot burn %s", coin.Denom)
+	}
+	if err := k.checkMarkerPaused(ctx, coin.Denom); err != nil {
+		return err
+	}
+	if m.GetStatus() != types.StatusActive {
+		return fmt.Errorf("cannot burn coin from accounts for a marker that is not in Active status")
+	}
+
+	// move the coin into the marker account so the regular supply decrease burns it from escrow
+	if err = k.bankKeeper.SendCoins(ctx, from, m.GetAddress(), sdk.NewCoins(coin)); err != nil {
+		return err
+	}
+	if err = k.DecreaseSupply(ctx, m, coin); err != nil {
+		return err
+	}
+
+	markerBurnFromEvent := types.NewEventMarkerBurnFrom(coin.Amount.String(), coin.Denom, caller.String(), from.String())
+	if err := ctx.EventManager().EmitTypedEvent(markerBurnFromEvent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Returns the current supply in network according to the bank module for the given marker
 func (k Keeper) CurrentCirculation(ctx sdk.Context, marker types.MarkerAccountI) sdk.Int {
 	return k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount
@@ -317,10 +409,14 @@ func (k Keeper) CurrentEscrow(ctx sdk.Context, marker types.MarkerAccountI) sdk.
 	return k.bankKeeper.GetAllBalances(ctx, marker.GetAddress())
 }
 
-// AdjustCirculation will mint/burn coin if required to ensure desired supply matches amount in circulation
+// AdjustCirculation will mint/burn coin if required to ensure desired supply matches amount in circulation.  The
+// circulation of reference markers is managed elsewhere and never adjusted.
 func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI, desiredSupply sdk.Coin) error {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "adjust_circulation")
 
+	if marker.IsReference() {
+		return nil
+	}
 	currentSupply := k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount
 	if desiredSupply.Denom != marker.GetDenom() {
 		return fmt.Errorf("invalid denom for desired supply")
@@ -339,6 +435,7 @@ func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI,
 		); err != nil {
 			return err
 		}
+		incrSupplyCounter(types.EventTelemetryKeyMint, offset)
 	} else if desiredSupply.Amount.LT(currentSupply) { // too much coin in circulation, attempt to burn from marker account.
 		offset := sdk.NewCoin(marker.GetDenom(), currentSupply.Sub(desiredSupply.Amount))
 		ctx.Logger().Info(
@@ -353,14 +450,30 @@ func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI,
 		if err := k.bankKeeper.BurnCoins(ctx, types.CoinPoolName, sdk.NewCoins(offset)); err != nil {
 			return fmt.Errorf("could not burn coin %v %w", offset, err)
 		}
+		incrSupplyCounter(types.EventTelemetryKeyBurn, offset)
 	}
 	return nil
 }
 
+// incrSupplyCounter adds the amount of marker coin minted or burned to the supply telemetry counter for its denom.
+func incrSupplyCounter(key string, coin sdk.Coin) {
+	if !coin.Amount.IsInt64() {
+		return
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, types.EventTelemetryKeySupply, key},
+		float32(coin.Amount.Int64()),
+		[]metrics.Label{telemetry.NewLabel(types.EventTelemetryLabelDenom, coin.Denom)},
+	)
+}
+
 // IncreaseSupply will mint coins to the marker module coin pool account, then send these to the marker account
 func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) error {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "increase_supply")
 
+	if marker.IsReference() {
+		return sdkerrors.Wrapf(types.ErrReferenceMarker, "cannot increase supply of %s", marker.GetDenom())
+	}
 	inCirculation := sdk.NewCoin(marker.GetDenom(), k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount)
 	total := inCirculation.Add(coin)
 	maxAllowed := sdk.NewCoin(marker.GetDenom(), sdk.NewIntFromUint64(k.GetParams(ctx).MaxTotalSupply))
@@ -387,6 +500,9 @@ func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 func (k Keeper) DecreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) error {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "decrease_supply")
 
+	if marker.IsReference() {
+		return sdkerrors.Wrapf(types.ErrReferenceMarker, "cannot decrease supply of %s", marker.GetDenom())
+	}
 	inCirculation := sdk.NewCoin(marker.GetDenom(), k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount)
 
 	// Ensure the request will not send the total supply below zero
@@ -450,8 +566,9 @@ func (k Keeper) FinalizeMarker(ctx sdk.Context, caller sdk.Address, denom string
 	// Any pre-existing coin amounts for our denom need to be removed from our amount to mint
 	preexistingCoin := sdk.NewCoin(m.GetDenom(), k.bankKeeper.GetSupply(ctx, m.GetDenom()).Amount)
 
-	// If the requested total is less than the existing total, the supply invariant would halt the chain if activated
-	if supplyRequest.IsLT(preexistingCoin) {
+	// If the requested total is less than the existing total, the supply invariant would halt the chain if activated.
+	// Reference markers are added for denoms with an existing supply that is managed elsewhere.
+	if !m.IsReference() && supplyRequest.IsLT(preexistingCoin) {
 		return fmt.Errorf("marker supply %v has been defined as less than pre-existing"+
 			" supply %v, can not finalize marker", supplyRequest, preexistingCoin)
 	}
@@ -466,7 +583,7 @@ func (k Keeper) FinalizeMarker(ctx sdk.Context, caller sdk.Address, denom string
 	k.SetMarker(ctx, m)
 
 	// record status as finalized.
-	markerFinalizeEvent := types.NewEventMarkerFinalize(denom, caller.String())
+	markerFinalizeEvent := types.NewEventMarkerFinalize(denom, caller.String(), k.GetFinalizeValidationSummary(ctx, m))
 	if err := ctx.EventManager().EmitTypedEvent(markerFinalizeEvent); err != nil {
 		return err
 	}
@@ -474,6 +591,53 @@ func (k Keeper) FinalizeMarker(ctx sdk.Context, caller sdk.Address, denom string
 	return nil
 }
 
+// GetFinalizeValidationSummary checks the configuration of a marker for gaps that should be addressed before the
+// marker is activated.
+func (k Keeper) GetFinalizeValidationSummary(ctx sdk.Context, m types.MarkerAccountI) *types.FinalizeValidationSummary {
+	_, hasDenomMetadata := k.bankKeeper.GetDenomMetaData(ctx, m.GetDenom())
+	summary := &types.FinalizeValidationSummary{
+		HasDenomMetadata:       hasDenomMetadata,
+		Supply:                 m.GetSupply(),
+		SupplyFixed:            m.HasFixedSupply(),
+		AllowGovernanceControl: m.HasGovernanceEnabled(),
+	}
+	for _, access := range requiredAccess(m) {
+		required := types.RequiredAccess{Access: access}
+		for _, addr := range m.AddressListForPermission(access) {
+			required.Addresses = append(required.Addresses, addr.String())
+		}
+		if len(required.Addresses) == 0 {
+			summary.Warnings = append(summary.Warnings, fmt.Sprintf("no address has been granted %s", access))
+		}
+		summary.RequiredAccess = append(summary.RequiredAccess, required)
+	}
+	if !summary.HasDenomMetadata {
+		summary.Warnings = append(summary.Warnings, "denom metadata has not been set")
+	}
+	if summary.Supply.IsZero() && !m.IsReference() {
+		summary.Warnings = append(summary.Warnings, "supply is zero so no coin will be minted on activation")
+	}
+	return summary
+}
+
+// requiredAccess returns the access that must be granted for a marker to be usable once active.
+func requiredAccess(m types.MarkerAccountI) []types.Access {
+	var required []types.Access
+	// withdraw is needed to move the minted supply out of the marker account, reference markers mint no supply
+	if !m.IsReference() {
+		required = append(required, types.Access_Withdraw)
+	}
+	// restricted coin can only be moved between accounts by an address with transfer access
+	if m.GetMarkerType() == types.MarkerType_RestrictedCoin {
+		required = append(required, types.Access_Transfer)
+	}
+	// without governance control, access to an active marker can only be changed by an address with admin access
+	if !m.HasGovernanceEnabled() {
+		required = append(required, types.Access_Admin)
+	}
+	return required
+}
+
 // ActivateMarker transistions a marker into the active status, enforcing permissions, supply constraints, and minting
 // any supply as required.
 func (k Keeper) ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string) error {
@@ -496,7 +660,7 @@ func (k Keeper) ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string
 	// Verify the send_enabled status of this coin denom matches the marker types
 	switch m.GetMarkerType() {
 	case types.MarkerType_Coin:
-		k.ensureSendEnabledStatus(ctx, denom, true)
+		k.ensureSendEnabledStatus(ctx, denom, !k.IsMarkerPausedByAdmin(ctx, m.GetAddress()))
 	case types.MarkerType_RestrictedCoin:
 		k.ensureSendEnabledStatus(ctx, denom, false)
 	default:
@@ -509,8 +673,9 @@ func (k Keeper) ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string
 	// Any pre-existing coin amounts for our denom need to be removed from our amount to mint
 	preexistingCoin := sdk.NewCoin(m.GetDenom(), k.bankKeeper.GetSupply(ctx, m.GetDenom()).Amount)
 
-	// If the requested total is less than the existing total, the supply invariant would halt the chain if activated
-	if supplyRequest.IsLT(preexistingCoin) {
+	// If the requested total is less than the existing total, the supply invariant would halt the chain if activated.
+	// Reference markers are added for denoms with an existing supply that is managed elsewhere.
+	if !m.IsReference() && supplyRequest.IsLT(preexistingCoin) {
 		return fmt.Errorf("marker supply %v has been defined as less than pre-existing"+
 			" supply %v, can not finalize marker", supplyRequest, preexistingCoin)
 	}
@@ -551,20 +716,21 @@ func (k Keeper) CancelMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	switch m.GetStatus() {
 	case types.StatusFinalized, types.StatusActive:
 		// for active or finalized markers the caller must be assigned permission to perform this action.
-		if !m.AddressHasAccess(caller, types.Access_Delete) {
+		if !k.addressHasAccess(ctx, m, caller, types.Access_Delete) {
 			return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Delete, m.GetDenom())
 		}
 		// for finalized/active we need to ensure the full coin supply has been recalled as it will all be burned.
+		// the supply of reference markers is managed elsewhere and is not burned.
 		totalSupply := k.bankKeeper.GetSupply(ctx, m.GetDenom()).Amount
 		escrow := k.bankKeeper.GetBalance(ctx, m.GetAddress(), m.GetDenom())
 		inCirculation := totalSupply.Sub(escrow.Amount)
-		if inCirculation.GT(sdk.ZeroInt()) {
+		if !m.IsReference() && inCirculation.GT(sdk.ZeroInt()) {
 			return fmt.Errorf("cannot cancel marker with %d minted coin in circulation out of %d total."+
 				" ensure marker account holds the entire supply of %s", inCirculation, totalSupply, denom)
 		}
 	case types.StatusProposed:
 		// for a proposed marker either the manager or someone assigned `delete` can perform this action
-		if !(m.GetManager().Equals(caller) || m.AddressHasAccess(caller, types.Access_Delete)) {
+		if !(m.GetManager().Equals(caller) || k.addressHasAccess(ctx, m, caller, types.Access_Delete)) {
 			return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Delete, m.GetDenom())
 		}
 	case types.StatusCancelled:
@@ -599,7 +765,7 @@ func (k Keeper) DeleteMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	}
 
 	// either the manager [set if a proposed marker was cancelled] or someone assigned `delete` can perform this action
-	if !(m.GetManager().Equals(caller) || m.AddressHasAccess(caller, types.Access_Delete)) {
+	if !(m.GetManager().Equals(caller) || k.addressHasAccess(ctx, m, caller, types.Access_Delete)) {
 		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Delete, m.GetDenom())
 	}
 
@@ -608,21 +774,24 @@ func (k Keeper) DeleteMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 		return fmt.Errorf("can only delete markeraccounts in the Cancelled status")
 	}
 
-	// require full supply of coin for marker to be contained within the marker account (no outstanding delegations)
-	totalSupply := k.bankKeeper.GetSupply(ctx, denom).Amount
-	escrow := k.bankKeeper.GetAllBalances(ctx, m.GetAddress())
-	inCirculation := totalSupply.Sub(escrow.AmountOf(denom))
-	if inCirculation.GT(sdk.ZeroInt()) {
-		return fmt.Errorf("cannot delete marker with %d minted coin in circulation out of %d total."+
-			" ensure marker account holds the entire supply of %s", inCirculation, totalSupply, denom)
-	}
+	// the supply of reference markers is managed elsewhere and is not burned with the marker
+	if !m.IsReference() {
+		// require full supply of coin for marker to be contained within the marker account (no outstanding delegations)
+		totalSupply := k.bankKeeper.GetSupply(ctx, denom).Amount
+		escrow := k.bankKeeper.GetAllBalances(ctx, m.GetAddress())
+		inCirculation := totalSupply.Sub(escrow.AmountOf(denom))
+		if inCirculation.GT(sdk.ZeroInt()) {
+			return fmt.Errorf("cannot delete marker with %d minted coin in circulation out of %d total."+
+				" ensure marker account holds the entire supply of %s", inCirculation, totalSupply, denom)
+		}
 
-	err = k.DecreaseSupply(ctx, m, sdk.NewCoin(denom, totalSupply))
-	if err != nil {
-		return fmt.Errorf("could not decrease marker supply %s: %s", denom, err)
+		err = k.DecreaseSupply(ctx, m, sdk.NewCoin(denom, totalSupply))
+		if err != nil {
+			return fmt.Errorf("could not decrease marker supply %s: %s", denom, err)
+		}
 	}
 
-	escrow = k.bankKeeper.GetAllBalances(ctx, m.GetAddress())
+	escrow := k.bankKeeper.GetAllBalances(ctx, m.GetAddress())
 	if !escrow.IsZero() {
 		return fmt.Errorf("can not destroy marker due to balances in escrow: %s", escrow)
 	}
@@ -660,11 +829,24 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
 		return fmt.Errorf("marker type is not restricted_coin, brokered transfer not supported")
 	}
-	if !m.AddressHasAccess(admin, types.Access_Transfer) {
+	if k.IsDenomMigrated(ctx, amount.Denom) {
+		return sdkerrors.Wrapf(types.ErrDenomMigrated, "cannot transfer %s", amount.Denom)
+	}
+	if k.IsMarkerPausedByAdmin(ctx, m.GetAddress()) {
+		return sdkerrors.Wrapf(types.ErrMarkerPaused, "%s", amount.Denom)
+	}
+	if k.IsTransferPaused(ctx, amount.Denom) {
+		return sdkerrors.Wrapf(types.ErrTransfersPaused, "transfers of %s are paused until height %d",
+			amount.Denom, k.GetTransferPause(ctx).ExpiryHeight)
+	}
+	if err = k.checkDenyList(ctx, from, to); err != nil {
+		return err
+	}
+	if !k.addressHasAccess(ctx, m, admin, types.Access_Transfer) {
 		return fmt.Errorf("%s is not allowed to broker transfers", admin.String())
 	}
 	if !admin.Equals(from) {
-		err = k.authzHandler(ctx, admin, from, amount)
+		err = k.authzHandler(ctx, admin, from, to, amount)
 		if err != nil {
 			return err
 		}
@@ -673,6 +855,9 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 		return fmt.Errorf("%s is not allowed to receive funds", to)
 	}
 
+	if err = k.collectTransferFee(ctx, m, from, amount); err != nil {
+		return err
+	}
 	// send the coins between accounts (does not check send_enabled on coin denom)
 	if err = k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(amount)); err != nil {
 		return err
@@ -689,25 +874,34 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 		return err
 	}
 
+	if amount.Amount.IsInt64() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.EventTelemetryKeyTransfer, types.EventTelemetryKeyAmount},
+			float32(amount.Amount.Int64()),
+			[]metrics.Label{telemetry.NewLabel(types.EventTelemetryLabelDenom, amount.Denom)},
+		)
+	}
+
 	return nil
 }
 
-func (k Keeper) authzHandler(ctx sdk.Context, admin sdk.AccAddress, from sdk.AccAddress, amount sdk.Coin) error {
+func (k Keeper) authzHandler(ctx sdk.Context, admin sdk.AccAddress, from sdk.AccAddress, to sdk.AccAddress, amount sdk.Coin) error {
 	markerAuth := types.MarkerTransferAuthorization{}
 	authorization, expireTime := k.authzKeeper.GetCleanAuthorization(ctx, admin, from, markerAuth.MsgTypeURL())
 	if authorization == nil {
 		return fmt.Errorf("%s account has not been granted authority to withdraw from %s account", admin, from)
 	}
-	accept, err := authorization.Accept(ctx, &types.MsgTransferRequest{Amount: amount})
+	accept, err := authorization.Accept(ctx, &types.MsgTransferRequest{Amount: amount, FromAddress: from.String(), ToAddress: to.String()})
 	if err != nil {
 		return err
 	}
 	if accept.Accept {
-		limitLeft, _ := authorization.(*types.MarkerTransferAuthorization).DecreaseTransferLimit(amount)
+		transferAuth := authorization.(*types.MarkerTransferAuthorization)
+		limitLeft, _ := transferAuth.DecreaseTransferLimit(amount)
 		if limitLeft.IsZero() {
 			return k.authzKeeper.DeleteGrant(ctx, admin, from, markerAuth.MsgTypeURL())
 		}
-		return k.authzKeeper.SaveGrant(ctx, admin, from, &types.MarkerTransferAuthorization{TransferLimit: limitLeft}, expireTime)
+		return k.authzKeeper.SaveGrant(ctx, admin, from, &types.MarkerTransferAuthorization{TransferLimit: limitLeft, AllowList: transferAuth.AllowList}, expireTime)
 	}
 	return fmt.Errorf("authorization was not accepted for %s", admin)
 }
@@ -723,7 +917,7 @@ func (k Keeper) SetMarkerDenomMetadata(ctx sdk.Context, metadata banktypes.Metad
 	if markerErr != nil {
 		return fmt.Errorf("marker not found for %s: %w", metadata.Base, markerErr)
 	}
-	if !marker.GetManager().Equals(caller) && !marker.AddressHasAccess(caller, types.Access_Admin) {
+	if !marker.GetManager().Equals(caller) && !k.addressHasAccess(ctx, marker, caller, types.Access_Admin) {
 		return fmt.Errorf("%s is not allowed to manage marker metadata", caller.String())
 	}
 
@@ -766,6 +960,58 @@ func (k Keeper) getCurrentSendEnabledStatus(ctx sdk.Context, denom string) bool
 	return k.bankKeeper.IsSendEnabledCoin(ctx, sdk.NewCoin(denom, sdk.ZeroInt()))
 }
 
+// addressHasAccess returns true if the address holds an unexpired grant of the access on the marker.  Expired grants
+// are only logged here since the rejected tx discards its events; the EventMarkerAccessExpired is emitted when the
+// grant is removed as the marker is saved.
+func (k Keeper) addressHasAccess(ctx sdk.Context, m types.MarkerAccountI, addr sdk.AccAddress, role types.Access) bool {
+	if m.AddressHasAccessAt(addr, role, ctx.BlockTime()) {
+		return true
+	}
+	if m.AddressHasAccess(addr, role) {
+		k.Logger(ctx).Info("rejected expired access grant", "denom", m.GetDenom(), "address", addr.String(), "access", role.String())
+	}
+	return false
+}
+
+// administrativeAccess are the access types that an active marker must keep an unexpired holder of once granted, so
+// that the marker can still be managed and removed without governance.
+var administrativeAccess = []types.Access{types.Access_Admin, types.Access_Delete}
+
+// accessHeldAt returns true if any of the grants includes the access and has not expired at the given block time.
+func accessHeldAt(grants []types.AccessGrant, role types.Access, blockTime time.Time) bool {
+	for _, g := range grants {
+		if g.HasAccess(role) && !g.IsExpired(blockTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAdministrativeAccessRemains returns an error if a change to the access list of an active marker removed the
+// last unexpired holder of an administrative access that was held before the change.
+func validateAdministrativeAccessRemains(ctx sdk.Context, before []types.AccessGrant, m types.MarkerAccountI) error {
+	if m.GetStatus() != types.StatusActive {
+		return nil
+	}
+	for _, role := range administrativeAccess {
+		if accessHeldAt(before, role, ctx.BlockTime()) && !accessHeldAt(m.GetAccessList(), role, ctx.BlockTime()) {
+			return sdkerrors.Wrapf(types.ErrOrphanedMarker, "no address would hold %s on %s", role, m.GetDenom())
+		}
+	}
+	return nil
+}
+
+// emitAccessExpired emits an EventMarkerAccessExpired for an expired access grant removed from a marker.
+func (k Keeper) emitAccessExpired(ctx sdk.Context, denom string, grant types.AccessGrant) {
+	expiration := ""
+	if grant.Expiration != nil {
+		expiration = grant.Expiration.Format(time.RFC3339)
+	}
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerAccessExpired(denom, grant.Address, expiration)); err != nil {
+		k.Logger(ctx).Error("unable to emit access expired event", "err", err)
+	}
+}
+
 // ensureSendEnabledStatus checks to see if the configuration of SendEnabled for the current network matches
 // the requested value, sets
 func (k Keeper) ensureSendEnabledStatus(ctx sdk.Context, denom string, sendEnabled bool) {
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// IsMarkerPausedByAdmin returns true if the administrators of the marker have paused it.
+func (k Keeper) IsMarkerPausedByAdmin(ctx sdk.Context, markerAddr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MarkerPausedKey(markerAddr))
+}
+
+// SetMarkerPausedByAdmin records that the administrators of the marker have paused or resumed it.
+func (k Keeper) SetMarkerPausedByAdmin(ctx sdk.Context, markerAddr sdk.AccAddress, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if paused {
+		store.Set(types.MarkerPausedKey(markerAddr), []byte{0x01})
+	} else {
+		store.Delete(types.MarkerPausedKey(markerAddr))
+	}
+}
+
+// IsMarkerPaused returns true if minting, burning, and withdrawing the marker denom are halted, either by its
+// administrators or by a governance transfer pause that halts supply changes.
+func (k Keeper) IsMarkerPaused(ctx sdk.Context, denom string) bool {
+	if k.IsSupplyPaused(ctx, denom) {
+		return true
+	}
+	markerAddr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return false
+	}
+	return k.IsMarkerPausedByAdmin(ctx, markerAddr)
+}
+
+// IteratePausedMarkers processes the addresses of all markers paused by their administrators with the given handler.
+func (k Keeper) IteratePausedMarkers(ctx sdk.Context, handler func(markerAddr sdk.AccAddress) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.MarkerPausedKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		// the key is the prefix followed by the length prefixed marker address
+		if handler(sdk.AccAddress(it.Key()[len(types.MarkerPausedKeyPrefix)+1:])) {
+			break
+		}
+	}
+}
+
+// SetMarkerPaused pauses or resumes a marker for an address with admin access on it.
+func (k Keeper) SetMarkerPaused(ctx sdk.Context, caller sdk.AccAddress, denom string, paused bool) error {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	if !k.addressHasAccess(ctx, m, caller, types.Access_Admin) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+	if k.IsMarkerPausedByAdmin(ctx, m.GetAddress()) == paused {
+		if paused {
+			return fmt.Errorf("%s markeraccount is already paused", m.GetDenom())
+		}
+		return fmt.Errorf("%s markeraccount is not paused", m.GetDenom())
+	}
+
+	k.SetMarkerPausedByAdmin(ctx, m.GetAddress(), paused)
+	k.syncPausedSendEnabled(ctx, m)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerPausedSet(m.GetDenom(), paused, caller.String()))
+}
+
+// checkMarkerPaused returns an error if the marker denom is paused.
+func (k Keeper) checkMarkerPaused(ctx sdk.Context, denom string) error {
+	if k.IsMarkerPaused(ctx, denom) {
+		return sdkerrors.Wrapf(types.ErrMarkerPaused, "%s", denom)
+	}
+	return nil
+}
+
+// syncPausedSendEnabled disables bank sends of an active coin marker while its administrators have paused it and
+// enables them again once it is resumed.  Sends of restricted markers are always disabled and go through the marker
+// module instead.
+func (k Keeper) syncPausedSendEnabled(ctx sdk.Context, m types.MarkerAccountI) {
+	if m.GetStatus() != types.StatusActive || m.GetMarkerType() != types.MarkerType_Coin ||
+		k.IsDenomMigrated(ctx, m.GetDenom()) {
+		return
+	}
+	k.ensureSendEnabledStatus(ctx, m.GetDenom(), !k.IsMarkerPausedByAdmin(ctx, m.GetAddress()))
+}
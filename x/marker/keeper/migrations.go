@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v042 "github.com/provenance-io/provenance/x/marker/legacy/v042"
+	v044 "github.com/provenance-io/provenance/x/marker/legacy/v044"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -27,3 +28,18 @@ func (m *Migrator) Migrate1to2(ctx sdk.Context) error {
 	ctx.Logger().Info("Finished Migrating Marker Module from Version 1 to 2")
 	return err
 }
+
+// Migrate2to3 migrates from version 2 to 3.  Missing params are set to their defaults before any marker is saved, then
+// existing markers are given a created height and start their management timeline.
+func (m *Migrator) Migrate2to3(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Marker Module from Version 2 to 3")
+	if err := v044.MigrateMarkerParams(ctx, m.keeper.paramSpace); err != nil {
+		return err
+	}
+	if err := v044.MigrateMarkerCreatedHeight(ctx, m.keeper); err != nil {
+		return err
+	}
+	err := v044.MigrateMarkerManagementHistory(ctx, m.keeper)
+	ctx.Logger().Info("Finished Migrating Marker Module from Version 2 to 3")
+	return err
+}
@@ -62,6 +62,8 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 		msg.Status,
 		msg.MarkerType)
 	ma.SupplyFixed = msg.SupplyFixed
+	ma.Reference = msg.Reference
+	ma.CreatedBy = msg.FromAddress
 
 	if k.GetEnableGovernance(ctx) {
 		ma.AllowGovernanceControl = true
@@ -74,7 +76,16 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	ctx.EventManager().EmitEvent(
+	if msg.TransferFee != nil {
+		if err := k.Keeper.SetTransferFee(ctx, *msg.TransferFee); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTransferFeeSet(*msg.TransferFee, msg.FromAddress)); err != nil {
+			return nil, err
+		}
+	}
+
+	k.EmitLegacyEvent(ctx,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -101,7 +112,7 @@ func (k msgServer) AddAccess(goCtx context.Context, msg *types.MsgAddAccessReque
 		}
 	}
 
-	ctx.EventManager().EmitEvent(
+	k.EmitLegacyEvent(ctx,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -146,14 +157,19 @@ func (k msgServer) Finalize(goCtx context.Context, msg *types.MsgFinalizeRequest
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	ctx.EventManager().EmitEvent(
+	k.EmitLegacyEvent(ctx,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		),
 	)
 
-	return &types.MsgFinalizeResponse{}, nil
+	m, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgFinalizeResponse{Summary: k.GetFinalizeValidationSummary(ctx, m)}, nil
 }
 
 // Activate handles a message to activate a marker
@@ -169,7 +185,7 @@ func (k msgServer) Activate(goCtx context.Context, msg *types.MsgActivateRequest
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	ctx.EventManager().EmitEvent(
+	k.EmitLegacyEvent(ctx,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -192,7 +208,7 @@ func (k msgServer) Cancel(goCtx context.Context, msg *types.MsgCancelRequest) (*
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	ctx.EventManager().EmitEvent(
+	k.EmitLegacyEvent(ctx,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -215,7 +231,7 @@ func (k msgServer) Delete(goCtx context.Context, msg *types.MsgDeleteRequest) (*
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	ctx.EventManager().EmitEvent(
+	k.EmitLegacyEvent(ctx,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -238,7 +254,7 @@ func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMintRequest) (*type
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	ctx.EventManager().EmitEvent(
+	k.EmitLegacyEvent(ctx,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -279,7 +295,7 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurnRequest) (*type
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	ctx.EventManager().EmitEvent(
+	k.EmitLegacyEvent(ctx,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -307,6 +323,45 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurnRequest) (*type
 	return &types.MsgBurnResponse{}, nil
 }
 
+// BurnFrom handles a message to burn restricted coin held by another account.
+func (k msgServer) BurnFrom(goCtx context.Context, msg *types.MsgBurnFromRequest) (*types.MsgBurnFromResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := k.Keeper.BurnCoinFrom(ctx, msg.GetSigners()[0], from, msg.Amount); err != nil {
+		ctx.Logger().Error("unable to burn coin from account", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.EmitLegacyEvent(ctx,
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.EventTelemetryKeyBurn},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(types.EventTelemetryLabelDenom, msg.Amount.GetDenom()),
+				telemetry.NewLabel(types.EventTelemetryLabelAdministrator, msg.Administrator),
+				telemetry.NewLabel(types.EventTelemetryLabelFromAddress, msg.FromAddress),
+			},
+		)
+	}()
+
+	return &types.MsgBurnFromResponse{}, nil
+}
+
 // Withdraw handles a message to withdraw coins from the marker account.
 func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdrawRequest) (*types.MsgWithdrawResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -326,7 +381,7 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdrawRequest
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	ctx.EventManager().EmitEvent(
+	k.EmitLegacyEvent(ctx,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -385,7 +440,7 @@ func (k msgServer) Transfer(goCtx context.Context, msg *types.MsgTransferRequest
 		return nil, err
 	}
 
-	ctx.EventManager().EmitEvent(
+	k.EmitLegacyEvent(ctx,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -437,7 +492,7 @@ func (k msgServer) SetDenomMetadata(
 		return nil, err
 	}
 
-	ctx.EventManager().EmitEvent(
+	k.EmitLegacyEvent(ctx,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -446,3 +501,239 @@ func (k msgServer) SetDenomMetadata(
 
 	return &types.MsgSetDenomMetadataResponse{}, nil
 }
+
+// SetWithdrawAllowance handles a message to limit the coin an address can withdraw from a marker in a period.
+func (k msgServer) SetWithdrawAllowance(
+	goCtx context.Context,
+	msg *types.MsgSetWithdrawAllowanceRequest,
+) (*types.MsgSetWithdrawAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	allowance := types.NewWithdrawAllowance(msg.Denom, grantee, msg.MaxAmount, msg.Period)
+	if err := k.Keeper.GrantWithdrawAllowance(ctx, msg.GetSigners()[0], *allowance); err != nil {
+		ctx.Logger().Error("unable to set withdraw allowance on marker", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	return &types.MsgSetWithdrawAllowanceResponse{}, nil
+}
+
+// DeleteWithdrawAllowance handles a message to remove the withdraw allowance of an address on a marker.
+func (k msgServer) DeleteWithdrawAllowance(
+	goCtx context.Context,
+	msg *types.MsgDeleteWithdrawAllowanceRequest,
+) (*types.MsgDeleteWithdrawAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.Keeper.RevokeWithdrawAllowance(ctx, msg.GetSigners()[0], msg.Denom, grantee); err != nil {
+		ctx.Logger().Error("unable to remove withdraw allowance from marker", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	return &types.MsgDeleteWithdrawAllowanceResponse{}, nil
+}
+
+// ScheduleDistribution handles a message to deposit a payout for the holders of a marker at a snapshot height.
+func (k msgServer) ScheduleDistribution(
+	goCtx context.Context,
+	msg *types.MsgScheduleDistributionRequest,
+) (*types.MsgScheduleDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	id, err := k.Keeper.ScheduleDistribution(ctx, msg.GetSigners()[0], msg.Denom, msg.Payout, msg.SnapshotHeight, msg.ClaimWindow)
+	if err != nil {
+		ctx.Logger().Error("unable to schedule distribution on marker", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	return &types.MsgScheduleDistributionResponse{DistributionId: id}, nil
+}
+
+// ClaimDistribution handles a message to pay a holder their share of a distribution.
+func (k msgServer) ClaimDistribution(
+	goCtx context.Context,
+	msg *types.MsgClaimDistributionRequest,
+) (*types.MsgClaimDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	amount, err := k.Keeper.ClaimDistribution(ctx, msg.DistributionId, msg.GetSigners()[0])
+	if err != nil {
+		ctx.Logger().Error("unable to claim distribution", "err", err)
+		return nil, err
+	}
+
+	return &types.MsgClaimDistributionResponse{Amount: amount}, nil
+}
+
+// SetFaucetPolicy handles a message to let addresses with a required attribute claim marker coin.
+func (k msgServer) SetFaucetPolicy(
+	goCtx context.Context,
+	msg *types.MsgSetFaucetPolicyRequest,
+) (*types.MsgSetFaucetPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin := msg.GetSigners()[0]
+	policy := types.NewFaucetPolicy(msg.Denom, admin, msg.Amount, msg.RequiredAttribute, msg.Cooldown)
+	if err := k.Keeper.AddFaucetPolicy(ctx, admin, *policy); err != nil {
+		ctx.Logger().Error("unable to set faucet policy on marker", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	return &types.MsgSetFaucetPolicyResponse{}, nil
+}
+
+// RemoveFaucetPolicy handles a message to remove the faucet policy of a marker.
+func (k msgServer) RemoveFaucetPolicy(
+	goCtx context.Context,
+	msg *types.MsgRemoveFaucetPolicyRequest,
+) (*types.MsgRemoveFaucetPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.Keeper.RemoveFaucetPolicy(ctx, msg.GetSigners()[0], msg.Denom); err != nil {
+		ctx.Logger().Error("unable to remove faucet policy from marker", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	return &types.MsgRemoveFaucetPolicyResponse{}, nil
+}
+
+// SetTransferFee handles a message to set the fee charged on each transfer of a restricted marker.
+func (k msgServer) SetTransferFee(
+	goCtx context.Context,
+	msg *types.MsgSetTransferFeeRequest,
+) (*types.MsgSetTransferFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.Keeper.AddTransferFee(ctx, msg.GetSigners()[0], msg.TransferFee); err != nil {
+		ctx.Logger().Error("unable to set transfer fee on marker", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	return &types.MsgSetTransferFeeResponse{}, nil
+}
+
+// RemoveTransferFee handles a message to remove the transfer fee of a restricted marker.
+func (k msgServer) RemoveTransferFee(
+	goCtx context.Context,
+	msg *types.MsgRemoveTransferFeeRequest,
+) (*types.MsgRemoveTransferFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.Keeper.DeleteTransferFee(ctx, msg.GetSigners()[0], msg.Denom); err != nil {
+		ctx.Logger().Error("unable to remove transfer fee from marker", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	return &types.MsgRemoveTransferFeeResponse{}, nil
+}
+
+// SetTerms handles a message to anchor the hash and uri of the terms document of a marker, or clear them.
+func (k msgServer) SetTerms(
+	goCtx context.Context,
+	msg *types.MsgSetTermsRequest,
+) (*types.MsgSetTermsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	entry, err := k.Keeper.AnchorTerms(ctx, msg.GetSigners()[0], msg.Denom, msg.TermsHash, msg.Uri)
+	if err != nil {
+		ctx.Logger().Error("unable to set terms on marker", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	return &types.MsgSetTermsResponse{Sequence: entry.Sequence}, nil
+}
+
+// SetMarkerPaused handles a message to pause or resume the minting, burning, withdrawing, and transferring of a
+// marker.
+func (k msgServer) SetMarkerPaused(
+	goCtx context.Context,
+	msg *types.MsgSetMarkerPausedRequest,
+) (*types.MsgSetMarkerPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.Keeper.SetMarkerPaused(ctx, msg.GetSigners()[0], msg.Denom, msg.Paused); err != nil {
+		ctx.Logger().Error("unable to set marker paused", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	return &types.MsgSetMarkerPausedResponse{}, nil
+}
+
+// ClaimFaucet handles a message to pay a qualified address the faucet amount of a marker.
+func (k msgServer) ClaimFaucet(
+	goCtx context.Context,
+	msg *types.MsgClaimFaucetRequest,
+) (*types.MsgClaimFaucetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	amount, err := k.Keeper.ClaimFaucet(ctx, msg.Denom, msg.GetSigners()[0])
+	if err != nil {
+		ctx.Logger().Error("unable to claim from faucet", "err", err)
+		return nil, err
+	}
+
+	return &types.MsgClaimFaucetResponse{Amount: amount}, nil
+}
@@ -15,6 +15,7 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 		MaxTotalSupply:         k.GetMaxTotalSupply(ctx),
 		EnableGovernance:       k.GetEnableGovernance(ctx),
 		UnrestrictedDenomRegex: k.GetUnrestrictedDenomRegex(ctx),
+		EmitLegacyEvents:       k.GetEmitLegacyEvents(ctx),
 	}
 }
 
@@ -54,6 +55,15 @@ func (k Keeper) GetUnrestrictedDenomRegex(ctx sdk.Context) (regex string) {
 	return
 }
 
+// GetEmitLegacyEvents returns the current parameter value for emitting the deprecated untyped events (or default if unset)
+func (k Keeper) GetEmitLegacyEvents(ctx sdk.Context) (enabled bool) {
+	enabled = types.DefaultEmitLegacyEvents
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyEmitLegacyEvents) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyEmitLegacyEvents, &enabled)
+	}
+	return
+}
+
 // ValidateUnrestictedDenom checks if the supplied denom is valid based on the module params
 func (k Keeper) ValidateUnrestictedDenom(ctx sdk.Context, denom string) error {
 	// Anchors are enforced on the denom validation expression.  Similar to how the SDK does hits.
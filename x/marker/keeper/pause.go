@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetTransferPause returns the pause of restricted marker transfers if one has been set.
+func (k Keeper) GetTransferPause(ctx sdk.Context) *types.TransferPause {
+	bz := ctx.KVStore(k.storeKey).Get(types.TransferPauseKey)
+	if bz == nil {
+		return nil
+	}
+	var pause types.TransferPause
+	k.cdc.MustUnmarshal(bz, &pause)
+	return &pause
+}
+
+// SetTransferPause stores the pause of restricted marker transfers, replacing any existing pause.
+func (k Keeper) SetTransferPause(ctx sdk.Context, pause types.TransferPause) {
+	ctx.KVStore(k.storeKey).Set(types.TransferPauseKey, k.cdc.MustMarshal(&pause))
+}
+
+// RemoveTransferPause lifts the pause of restricted marker transfers.
+func (k Keeper) RemoveTransferPause(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete(types.TransferPauseKey)
+}
+
+// IsSupplyPaused returns true if a governance transfer pause halts minting, burning, and withdrawing of the given marker
+// denom at the current height.
+func (k Keeper) IsSupplyPaused(ctx sdk.Context, denom string) bool {
+	pause := k.GetTransferPause(ctx)
+	return pause != nil && pause.HaltSupply && pause.IsActive(ctx.BlockHeight()) && pause.AppliesTo(denom)
+}
+
+// IsTransferPaused returns true if transfers of the given restricted marker denom are paused at the current height.
+func (k Keeper) IsTransferPaused(ctx sdk.Context, denom string) bool {
+	pause := k.GetTransferPause(ctx)
+	return pause != nil && pause.IsActive(ctx.BlockHeight()) && pause.AppliesTo(denom)
+}
+
+// IsGlobalPause returns true if a governance transfer pause of every marker is in effect at the current height.
+func (k Keeper) IsGlobalPause(ctx sdk.Context) bool {
+	pause := k.GetTransferPause(ctx)
+	return pause != nil && pause.Global && pause.IsActive(ctx.BlockHeight())
+}
+
+// CheckSendPaused returns an error if the coins include a marker denom while every marker is paused by governance.
+// Bank sends and ibc transfers are checked with it so that coin markers, which the bank module moves without the
+// marker module, are halted too.
+func (k Keeper) CheckSendPaused(ctx sdk.Context, coins sdk.Coins) error {
+	if !k.IsGlobalPause(ctx) {
+		return nil
+	}
+	for _, coin := range coins {
+		markerAddr, err := types.MarkerAddress(coin.Denom)
+		if err != nil {
+			continue
+		}
+		if m, err := k.GetMarker(ctx, markerAddr); err == nil && m != nil {
+			return sdkerrors.Wrapf(types.ErrMarkerPaused, "%s transfers are paused by governance", coin.Denom)
+		}
+	}
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
 )
@@ -27,6 +29,8 @@ func HandleAddMarkerProposal(ctx sdk.Context, k Keeper, c *types.AddMarkerPropos
 	newMarker.AllowGovernanceControl = c.AllowGovernanceControl
 	newMarker.SupplyFixed = c.SupplyFixed
 	newMarker.MarkerType = c.MarkerType
+	newMarker.Reference = c.Reference
+	newMarker.CreatedBy = authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
 	if err := newMarker.SetSupply(c.Amount); err != nil {
 		return err
@@ -190,6 +194,7 @@ func HandleRemoveAdministratorProposal(ctx sdk.Context, k Keeper, c *types.Remov
 	if !m.HasGovernanceEnabled() {
 		return fmt.Errorf("%s marker does not allow governance control", c.Denom)
 	}
+	before := append([]types.AccessGrant{}, m.GetAccessList()...)
 	for _, a := range c.RemovedAddress {
 		addr, err := sdk.AccAddressFromBech32(a)
 		if err != nil {
@@ -199,6 +204,11 @@ func HandleRemoveAdministratorProposal(ctx sdk.Context, k Keeper, c *types.Remov
 			return err
 		}
 	}
+	if !c.AllowOrphaned {
+		if err := validateAdministrativeAccessRemains(ctx, before, m); err != nil {
+			return err
+		}
+	}
 
 	if err := m.Validate(); err != nil {
 		return err
@@ -320,6 +330,172 @@ func HandleSetDenomMetadataProposal(ctx sdk.Context, k Keeper, c *types.SetDenom
 
 	k.bankKeeper.SetDenomMetaData(ctx, c.Metadata)
 
+	// emit the same event as a marker administrator update so indexers and relayers see every metadata change
+	markerSetDenomMetaEvent := types.NewEventMarkerSetDenomMetadata(
+		c.Metadata,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(markerSetDenomMetaEvent); err != nil {
+		return err
+	}
+
 	k.Logger(ctx).Info("denom metadata set for marker", "marker", c.Metadata.Base, "denom metadata", c.Metadata.String())
 	return nil
 }
+
+// HandlePauseRestrictedTransfersProposal handles a Pause Restricted Transfers governance proposal request
+func HandlePauseRestrictedTransfersProposal(ctx sdk.Context, k Keeper, c *types.PauseRestrictedTransfersProposal) error {
+	if c.ExpiryHeight <= ctx.BlockHeight() {
+		return fmt.Errorf("expiry height %d must be after the current height %d", c.ExpiryHeight, ctx.BlockHeight())
+	}
+	for _, denom := range c.Denoms {
+		m, err := k.GetMarkerByDenom(ctx, denom)
+		if err != nil {
+			return err
+		}
+		if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+			return fmt.Errorf("%s marker is not a restricted marker", denom)
+		}
+	}
+
+	pause := c.TransferPause()
+	if err := pause.Validate(); err != nil {
+		return err
+	}
+	k.SetTransferPause(ctx, *pause)
+
+	k.Logger(ctx).Info("restricted marker transfers paused", "markers", c.Denoms, "expiry height", c.ExpiryHeight,
+		"halt supply", c.HaltSupply, "global", c.Global)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTransfersPaused(*pause))
+}
+
+// HandleResumeRestrictedTransfersProposal handles a Resume Restricted Transfers governance proposal request
+func HandleResumeRestrictedTransfersProposal(ctx sdk.Context, k Keeper, c *types.ResumeRestrictedTransfersProposal) error {
+	if k.GetTransferPause(ctx) == nil {
+		return fmt.Errorf("restricted marker transfers are not paused")
+	}
+	k.RemoveTransferPause(ctx)
+
+	k.Logger(ctx).Info("restricted marker transfers resumed")
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTransfersResumed())
+}
+
+// HandleAddToDenyListProposal handles an Add To Deny List governance proposal request
+func HandleAddToDenyListProposal(ctx sdk.Context, k Keeper, c *types.AddToDenyListProposal) error {
+	for _, address := range c.Addresses {
+		entry := types.NewDenyListEntry(address, c.Reason, ctx.BlockHeight())
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+		if err := k.SetDenyListEntry(ctx, *entry); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDenyListAdded(address, c.Reason)); err != nil {
+			return err
+		}
+	}
+
+	k.Logger(ctx).Info("addresses added to restricted marker deny list", "addresses", c.Addresses, "reason", c.Reason)
+	return nil
+}
+
+// HandleRemoveFromDenyListProposal handles a Remove From Deny List governance proposal request
+func HandleRemoveFromDenyListProposal(ctx sdk.Context, k Keeper, c *types.RemoveFromDenyListProposal) error {
+	addrs := make([]sdk.AccAddress, len(c.Addresses))
+	for i, address := range c.Addresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return err
+		}
+		if !k.IsDenyListed(ctx, addr) {
+			return fmt.Errorf("%s is not on the restricted marker deny list", address)
+		}
+		addrs[i] = addr
+	}
+	for i, addr := range addrs {
+		k.RemoveDenyListEntry(ctx, addr)
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDenyListRemoved(c.Addresses[i])); err != nil {
+			return err
+		}
+	}
+
+	k.Logger(ctx).Info("addresses removed from restricted marker deny list", "addresses", c.Addresses)
+	return nil
+}
+
+// HandleSetIbcRateLimitProposal handles a Set IBC Rate Limit governance proposal request
+func HandleSetIbcRateLimitProposal(ctx sdk.Context, k Keeper, c *types.SetIbcRateLimitProposal) error {
+	if _, err := k.GetMarkerByDenom(ctx, c.Denom); err != nil {
+		return err
+	}
+
+	limit := types.NewIbcRateLimit(c.Denom, c.ChannelId, c.MaxPercentSend, c.MaxPercentRecv, c.DurationHours)
+	limit.ResetFlow(k.bankKeeper.GetSupply(ctx, c.Denom).Amount, ctx.BlockTime())
+	if err := limit.Validate(); err != nil {
+		return err
+	}
+	k.SetIbcRateLimit(ctx, *limit)
+
+	k.Logger(ctx).Info("ibc rate limit set", "marker", c.Denom, "channel", c.ChannelId,
+		"max percent send", c.MaxPercentSend, "max percent recv", c.MaxPercentRecv, "duration hours", c.DurationHours)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerIbcRateLimitSet(*limit))
+}
+
+// HandleRemoveIbcRateLimitProposal handles a Remove IBC Rate Limit governance proposal request
+func HandleRemoveIbcRateLimitProposal(ctx sdk.Context, k Keeper, c *types.RemoveIbcRateLimitProposal) error {
+	if k.GetIbcRateLimit(ctx, c.Denom, c.ChannelId) == nil {
+		return fmt.Errorf("%s marker has no ibc rate limit on %s", c.Denom, c.ChannelId)
+	}
+	k.RemoveIbcRateLimit(ctx, c.Denom, c.ChannelId)
+
+	k.Logger(ctx).Info("ibc rate limit removed", "marker", c.Denom, "channel", c.ChannelId)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerIbcRateLimitRemoved(c.Denom, c.ChannelId))
+}
+
+// HandleMigrateDenomProposal handles a Migrate Denom governance proposal request
+func HandleMigrateDenomProposal(ctx sdk.Context, k Keeper, c *types.MigrateDenomProposal) error {
+	if _, err := k.StartDenomMigration(ctx, c.Denom, c.NewDenom, c.HoldersPerBlock); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("denom migration started", "marker", c.Denom, "new denom", c.NewDenom,
+		"holders per block", c.HoldersPerBlock)
+	return nil
+}
+
+// HandleSetTransferFeeProposal handles a Set Transfer Fee governance proposal request
+func HandleSetTransferFeeProposal(ctx sdk.Context, k Keeper, c *types.SetTransferFeeProposal) error {
+	m, err := k.ValidateTransferFee(ctx, c.TransferFee)
+	if err != nil {
+		return err
+	}
+	if !m.HasGovernanceEnabled() {
+		return fmt.Errorf("%s marker does not allow governance control", c.TransferFee.Denom)
+	}
+	if err = k.SetTransferFee(ctx, c.TransferFee); err != nil {
+		return err
+	}
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	k.Logger(ctx).Info("transfer fee set", "marker", c.TransferFee.Denom, "fee", c.TransferFee.FeeString())
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTransferFeeSet(c.TransferFee, govAddr.String()))
+}
+
+// HandleRemoveTransferFeeProposal handles a Remove Transfer Fee governance proposal request
+func HandleRemoveTransferFeeProposal(ctx sdk.Context, k Keeper, c *types.RemoveTransferFeeProposal) error {
+	m, err := k.GetMarkerByDenom(ctx, c.Denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", c.Denom, err)
+	}
+	if !m.HasGovernanceEnabled() {
+		return fmt.Errorf("%s marker does not allow governance control", c.Denom)
+	}
+	if k.GetTransferFee(ctx, m.GetAddress()) == nil {
+		return fmt.Errorf("%s marker has no transfer fee", c.Denom)
+	}
+	k.RemoveTransferFee(ctx, m.GetAddress())
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	k.Logger(ctx).Info("transfer fee removed", "marker", c.Denom)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTransferFeeRemoved(c.Denom, govAddr.String()))
+}
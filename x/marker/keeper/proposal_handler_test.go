@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -34,7 +35,7 @@ type IntegrationTestSuite struct {
 func (s *IntegrationTestSuite) SetupSuite() {
 	s.app = provenance.Setup(false)
 	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
-	s.k = markerkeeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(markertypes.ModuleName), s.app.GetSubspace(markertypes.ModuleName), s.app.AccountKeeper, s.app.BankKeeper, s.app.AuthzKeeper, s.app.GetKey(banktypes.StoreKey))
+	s.k = markerkeeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(markertypes.ModuleName), s.app.GetSubspace(markertypes.ModuleName), s.app.AccountKeeper, s.app.BankKeeper, s.app.AuthzKeeper, s.app.GetKey(banktypes.StoreKey), &s.app.GovKeeper, s.app.AttributeKeeper, s.app.FeatureFlagsKeeper)
 	s.accountAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 }
 
@@ -319,6 +320,71 @@ func (s *IntegrationTestSuite) TestMarkerProposals() {
 			),
 			nil,
 		},
+
+		// PAUSE AND RESUME RESTRICTED TRANSFERS PROPOSALS
+		{
+			"pause restricted transfers - expiry height not in the future",
+			markertypes.NewPauseRestrictedTransfersProposal("title", "description", []string{}, 0, false),
+			errors.New("expiry height 0 must be after the current height 0"),
+		},
+		{
+			"pause restricted transfers - marker not restricted",
+			markertypes.NewPauseRestrictedTransfersProposal("title", "description", []string{"test1"}, 100, false),
+			errors.New("test1 marker is not a restricted marker"),
+		},
+		{
+			"pause restricted transfers - marker does not exist",
+			markertypes.NewPauseRestrictedTransfersProposal("title", "description", []string{"nonexistent"}, 100, false),
+			fmt.Errorf("marker nonexistent not found for address: %s", markertypes.MustGetMarkerAddress("nonexistent")),
+		},
+		{
+			"pause restricted transfers - global with denoms",
+			&markertypes.PauseRestrictedTransfersProposal{Title: "title", Description: "description",
+				Denoms: []string{"testrestricted"}, ExpiryHeight: 100, HaltSupply: true, Global: true},
+			errors.New("a global pause applies to all markers and halts their supply"),
+		},
+		{
+			"pause restricted transfers - valid",
+			markertypes.NewPauseRestrictedTransfersProposal("title", "description", []string{"testrestricted"}, 100, false),
+			nil,
+		},
+		{
+			"resume restricted transfers - valid",
+			markertypes.NewResumeRestrictedTransfersProposal("title", "description"),
+			nil,
+		},
+		{
+			"resume restricted transfers - not paused",
+			markertypes.NewResumeRestrictedTransfersProposal("title", "description"),
+			errors.New("restricted marker transfers are not paused"),
+		},
+
+		// SET AND REMOVE IBC RATE LIMIT PROPOSALS
+		{
+			"set ibc rate limit - marker does not exist",
+			markertypes.NewSetIbcRateLimitProposal("title", "description", "nonexistent", "channel-0", 10, 10, 24),
+			fmt.Errorf("marker nonexistent not found for address: %s", markertypes.MustGetMarkerAddress("nonexistent")),
+		},
+		{
+			"set ibc rate limit - no quota",
+			markertypes.NewSetIbcRateLimitProposal("title", "description", "test1", "channel-0", 0, 0, 24),
+			errors.New("max percent send or recv must be greater than zero"),
+		},
+		{
+			"set ibc rate limit - valid",
+			markertypes.NewSetIbcRateLimitProposal("title", "description", "test1", "channel-0", 10, 0, 24),
+			nil,
+		},
+		{
+			"remove ibc rate limit - valid",
+			markertypes.NewRemoveIbcRateLimitProposal("title", "description", "test1", "channel-0"),
+			nil,
+		},
+		{
+			"remove ibc rate limit - no rate limit",
+			markertypes.NewRemoveIbcRateLimitProposal("title", "description", "test1", "channel-0"),
+			errors.New("test1 marker has no ibc rate limit on channel-0"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -344,6 +410,14 @@ func (s *IntegrationTestSuite) TestMarkerProposals() {
 				err = markerkeeper.HandleWithdrawEscrowProposal(s.ctx, s.k, c)
 			case *markertypes.SetDenomMetadataProposal:
 				err = markerkeeper.HandleSetDenomMetadataProposal(s.ctx, s.k, c)
+			case *markertypes.PauseRestrictedTransfersProposal:
+				err = markerkeeper.HandlePauseRestrictedTransfersProposal(s.ctx, s.k, c)
+			case *markertypes.ResumeRestrictedTransfersProposal:
+				err = markerkeeper.HandleResumeRestrictedTransfersProposal(s.ctx, s.k, c)
+			case *markertypes.SetIbcRateLimitProposal:
+				err = markerkeeper.HandleSetIbcRateLimitProposal(s.ctx, s.k, c)
+			case *markertypes.RemoveIbcRateLimitProposal:
+				err = markerkeeper.HandleRemoveIbcRateLimitProposal(s.ctx, s.k, c)
 			default:
 				panic("invalid proposal type")
 			}
@@ -359,6 +433,57 @@ func (s *IntegrationTestSuite) TestMarkerProposals() {
 
 }
 
+func (s *IntegrationTestSuite) TestSetDenomMetadataProposalEvent() {
+	err := markerkeeper.HandleAddMarkerProposal(s.ctx, s.k, markertypes.NewAddMarkerProposal("title", "description", "testmetaevent", sdk.NewInt(100), sdk.AccAddress{}, markertypes.StatusActive, markertypes.MarkerType_Coin, []markertypes.AccessGrant{}, true, true))
+	s.Require().NoError(err)
+
+	metadata := banktypes.Metadata{
+		Description: "a denom with a metadata event",
+		Base:        "testmetaevent",
+		Display:     "testmetaevent",
+		Name:        "Test Meta Event",
+		Symbol:      "TME",
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    "testmetaevent",
+				Exponent: 0,
+				Aliases:  []string{"tme"},
+			},
+		},
+	}
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	err = markerkeeper.HandleSetDenomMetadataProposal(ctx, s.k, markertypes.NewSetDenomMetadataProposal("title", "description", metadata))
+	s.Require().NoError(err)
+
+	events := ctx.EventManager().ABCIEvents()
+	s.Require().NotEmpty(events)
+	event, err := sdk.ParseTypedEvent(events[len(events)-1])
+	s.Require().NoError(err)
+	s.Require().Equal(markertypes.NewEventMarkerSetDenomMetadata(metadata, authtypes.NewModuleAddress(govtypes.ModuleName).String()), event)
+}
+
+func (s *IntegrationTestSuite) TestPauseResumeRestrictedTransfersProposalEvents() {
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	err := markerkeeper.HandlePauseRestrictedTransfersProposal(ctx, s.k, markertypes.NewPauseRestrictedTransfersProposal("title", "description", []string{"testrestricted"}, 100, false))
+	s.Require().NoError(err)
+
+	events := ctx.EventManager().ABCIEvents()
+	s.Require().NotEmpty(events)
+	event, err := sdk.ParseTypedEvent(events[len(events)-1])
+	s.Require().NoError(err)
+	s.Require().Equal(markertypes.NewEventMarkerTransfersPaused(*markertypes.NewTransferPause([]string{"testrestricted"}, 100, false)), event)
+
+	ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	err = markerkeeper.HandleResumeRestrictedTransfersProposal(ctx, s.k, markertypes.NewResumeRestrictedTransfersProposal("title", "description"))
+	s.Require().NoError(err)
+
+	events = ctx.EventManager().ABCIEvents()
+	s.Require().NotEmpty(events)
+	event, err = sdk.ParseTypedEvent(events[len(events)-1])
+	s.Require().NoError(err)
+	s.Require().Equal(markertypes.NewEventMarkerTransfersResumed(), event)
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -9,6 +9,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -52,6 +53,71 @@ func (k Keeper) AllMarkers(c context.Context, req *types.QueryAllMarkersRequest)
 	return &types.QueryAllMarkersResponse{Markers: markers, Pagination: pageRes}, nil
 }
 
+// PendingMarkers query for markers that have been proposed or finalized for at least a number of blocks
+func (k Keeper) PendingMarkers(c context.Context, req *types.QueryPendingMarkersRequest) (*types.QueryPendingMarkersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.MinAge < 0 {
+		return nil, status.Error(codes.InvalidArgument, "min age cannot be negative")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	markers := make([]*codectypes.Any, 0)
+	markerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MarkerStoreKeyPrefix)
+	pageRes, err := query.FilteredPaginate(markerStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		result, err := k.GetMarker(ctx, sdk.AccAddress(value))
+		if err != nil {
+			return false, err
+		}
+		if result == nil || !k.IsPendingMarker(ctx, result, req.MinAge) {
+			return false, nil
+		}
+		if accumulate {
+			any, anyErr := codectypes.NewAnyWithValue(result)
+			if anyErr != nil {
+				return false, status.Errorf(codes.Internal, anyErr.Error())
+			}
+			markers = append(markers, any)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPendingMarkersResponse{Markers: markers, Pagination: pageRes}, nil
+}
+
+// OrphanedMarkers returns the active markers that no address holds an unexpired grant of admin access on.
+func (k Keeper) OrphanedMarkers(c context.Context, req *types.QueryOrphanedMarkersRequest) (*types.QueryOrphanedMarkersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	markers := make([]*codectypes.Any, 0)
+	markerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MarkerStoreKeyPrefix)
+	pageRes, err := query.FilteredPaginate(markerStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		result, err := k.GetMarker(ctx, sdk.AccAddress(value))
+		if err != nil {
+			return false, err
+		}
+		if result == nil || !k.IsOrphanedMarker(ctx, result) {
+			return false, nil
+		}
+		if accumulate {
+			any, anyErr := codectypes.NewAnyWithValue(result)
+			if anyErr != nil {
+				return false, status.Errorf(codes.Internal, anyErr.Error())
+			}
+			markers = append(markers, any)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryOrphanedMarkersResponse{Markers: markers, Pagination: pageRes}, nil
+}
+
 // Marker query for a single marker by denom or address
 func (k Keeper) Marker(c context.Context, req *types.QueryMarkerRequest) (*types.QueryMarkerResponse, error) {
 	if req == nil {
@@ -66,7 +132,28 @@ func (k Keeper) Marker(c context.Context, req *types.QueryMarkerRequest) (*types
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
-	return &types.QueryMarkerResponse{Marker: any}, nil
+	return &types.QueryMarkerResponse{Marker: any, Paused: k.IsMarkerPaused(ctx, marker.GetDenom())}, nil
+}
+
+// MarkerByAddress query for a single marker by its marker account address
+func (k Keeper) MarkerByAddress(c context.Context, req *types.QueryMarkerByAddressRequest) (*types.QueryMarkerByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := k.GetMarker(ctx, addr)
+	if err != nil || marker == nil {
+		return nil, sdkerrors.Wrapf(types.ErrMarkerNotFound, "no marker found for address %s", req.Address)
+	}
+	any, err := codectypes.NewAnyWithValue(marker)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return &types.QueryMarkerByAddressResponse{Marker: any, Paused: k.IsMarkerPaused(ctx, marker.GetDenom())}, nil
 }
 
 // Holding query for all accounts holding the given marker coins
@@ -156,6 +243,289 @@ func (k Keeper) Access(c context.Context, req *types.QueryAccessRequest) (*types
 	return &types.QueryAccessResponse{Accounts: marker.GetAccessList()}, nil
 }
 
+// MarkerGrants query for the authz grants of marker msgs from addresses with access to a marker
+func (k Keeper) MarkerGrants(c context.Context, req *types.QueryMarkerGrantsRequest) (*types.QueryMarkerGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMarkerGrantsResponse{Grants: k.GetMarkerGrants(ctx, marker)}, nil
+}
+
+// DenyList query for the addresses denied transfers of restricted markers by governance
+func (k Keeper) DenyList(c context.Context, req *types.QueryDenyListRequest) (*types.QueryDenyListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	entries := make([]types.DenyListEntry, 0)
+	if len(req.Address) > 0 {
+		addr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+		}
+		if entry := k.GetDenyListEntry(ctx, addr); entry != nil {
+			entries = append(entries, *entry)
+		}
+		return &types.QueryDenyListResponse{Entries: entries}, nil
+	}
+	denyListStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenyListKeyPrefix)
+	pageRes, err := query.Paginate(denyListStore, req.Pagination, func(key []byte, value []byte) error {
+		var entry types.DenyListEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryDenyListResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// TransferPause query for the current pause of restricted marker transfers
+func (k Keeper) TransferPause(c context.Context, req *types.QueryTransferPauseRequest) (*types.QueryTransferPauseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	pause := k.GetTransferPause(ctx)
+	return &types.QueryTransferPauseResponse{
+		Pause:  pause,
+		Active: pause != nil && pause.IsActive(ctx.BlockHeight()),
+	}, nil
+}
+
+// EscrowDeposits query for coin sent directly to a marker escrow account with bank sends
+func (k Keeper) EscrowDeposits(c context.Context, req *types.QueryEscrowDepositsRequest) (*types.QueryEscrowDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	depositStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.EscrowDepositsPrefix(marker.GetAddress()))
+	var deposits []types.EscrowDeposit
+	pageRes, err := query.Paginate(depositStore, req.Pagination, func(key []byte, value []byte) error {
+		var deposit types.EscrowDeposit
+		if err := k.cdc.Unmarshal(value, &deposit); err != nil {
+			return err
+		}
+		deposits = append(deposits, deposit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
+}
+
+// IbcRateLimits query for the governance controlled quotas on the ibc transfer flow of marker denoms
+func (k Keeper) IbcRateLimits(c context.Context, req *types.QueryIbcRateLimitsRequest) (*types.QueryIbcRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	keyPrefix := types.IbcRateLimitKeyPrefix
+	if len(req.Denom) > 0 {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid denom")
+		}
+		keyPrefix = types.IbcRateLimitsPrefix(req.Denom)
+	}
+
+	limitStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	var limits []types.IbcRateLimit
+	pageRes, err := query.Paginate(limitStore, req.Pagination, func(key []byte, value []byte) error {
+		var limit types.IbcRateLimit
+		if err := k.cdc.Unmarshal(value, &limit); err != nil {
+			return err
+		}
+		limits = append(limits, limit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIbcRateLimitsResponse{RateLimits: limits, Pagination: pageRes}, nil
+}
+
+// WithdrawAllowances query for the withdraw allowances on a marker with the coin remaining in the current window
+func (k Keeper) WithdrawAllowances(c context.Context, req *types.QueryWithdrawAllowancesRequest) (*types.QueryWithdrawAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPrefix := types.WithdrawAllowancesPrefix(marker.GetAddress())
+	if len(req.Grantee) > 0 {
+		grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid grantee")
+		}
+		keyPrefix = types.WithdrawAllowanceKey(marker.GetAddress(), grantee)
+	}
+
+	allowanceStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	var allowances []types.WithdrawAllowanceStatus
+	pageRes, err := query.Paginate(allowanceStore, req.Pagination, func(key []byte, value []byte) error {
+		var allowance types.WithdrawAllowance
+		if err := k.cdc.Unmarshal(value, &allowance); err != nil {
+			return err
+		}
+		allowance.Prune(ctx.BlockTime())
+		allowances = append(allowances, types.WithdrawAllowanceStatus{Allowance: allowance, Remaining: allowance.Remaining()})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryWithdrawAllowancesResponse{Allowances: allowances, Pagination: pageRes}, nil
+}
+
+// Distributions query for the scheduled and claimable distributions to the holders of a marker
+func (k Keeper) Distributions(c context.Context, req *types.QueryDistributionsRequest) (*types.QueryDistributionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	distributionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DistributionKeyPrefix)
+	var distributions []types.Distribution
+	pageRes, err := query.FilteredPaginate(distributionStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var distribution types.Distribution
+		if err := k.cdc.Unmarshal(value, &distribution); err != nil {
+			return false, err
+		}
+		if distribution.Denom != marker.GetDenom() {
+			return false, nil
+		}
+		if accumulate {
+			distributions = append(distributions, distribution)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDistributionsResponse{Distributions: distributions, Pagination: pageRes}, nil
+}
+
+// DistributionEntitlements query for the unclaimed shares of a distribution
+func (k Keeper) DistributionEntitlements(
+	c context.Context, req *types.QueryDistributionEntitlementsRequest,
+) (*types.QueryDistributionEntitlementsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if k.GetDistribution(ctx, req.DistributionId) == nil {
+		return nil, status.Errorf(codes.NotFound, "distribution %d not found", req.DistributionId)
+	}
+
+	keyPrefix := types.DistributionEntitlementsPrefix(req.DistributionId)
+	if len(req.Address) > 0 {
+		holder, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid address")
+		}
+		keyPrefix = types.DistributionEntitlementKey(req.DistributionId, holder)
+	}
+
+	entitlementStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	var entitlements []types.DistributionEntitlement
+	pageRes, err := query.Paginate(entitlementStore, req.Pagination, func(key []byte, value []byte) error {
+		var entitlement types.DistributionEntitlement
+		if err := k.cdc.Unmarshal(value, &entitlement); err != nil {
+			return err
+		}
+		entitlements = append(entitlements, entitlement)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDistributionEntitlementsResponse{Entitlements: entitlements, Pagination: pageRes}, nil
+}
+
+// IssuerDashboard query for a summary of each marker an address administers
+func (k Keeper) IssuerDashboard(c context.Context, req *types.QueryIssuerDashboardRequest) (*types.QueryIssuerDashboardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	if req.ActivityBlocks < 0 || req.ExpiringWithin < 0 {
+		return nil, status.Error(codes.InvalidArgument, "activity blocks and expiring window cannot be negative")
+	}
+	activityBlocks := req.ActivityBlocks
+	if activityBlocks == 0 {
+		activityBlocks = types.DefaultDashboardActivityBlocks
+	}
+	expiringWithin := req.ExpiringWithin
+	if expiringWithin == 0 {
+		expiringWithin = types.DefaultDashboardExpiringWithin
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryIssuerDashboardResponse{
+		Markers: k.GetIssuerDashboard(ctx, addr, activityBlocks, expiringWithin),
+	}, nil
+}
+
+// IsTransferable query for whether an amount of a denom can be transferred between two accounts
+func (k Keeper) IsTransferable(c context.Context, req *types.QueryIsTransferableRequest) (*types.QueryIsTransferableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	from, err := sdk.AccAddressFromBech32(req.FromAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid from address")
+	}
+	to, err := sdk.AccAddressFromBech32(req.ToAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid to address")
+	}
+	admin := from
+	if len(req.AdminAddress) > 0 {
+		if admin, err = sdk.AccAddressFromBech32(req.AdminAddress); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid admin address")
+		}
+	}
+	if req.Amount.IsNil() || !req.Amount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+	if err = sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	blocks := k.GetTransferBlocks(ctx, from, to, admin, sdk.NewCoin(req.Denom, req.Amount))
+	return &types.QueryIsTransferableResponse{Transferable: len(blocks) == 0, Blocks: blocks}, nil
+}
+
 // DenomMetadata query for metadata on denom
 func (k Keeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
 	if req == nil {
@@ -169,6 +539,109 @@ func (k Keeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRe
 	ctx := sdk.UnwrapSDKContext(c)
 
 	metadata, _ := k.bankKeeper.GetDenomMetaData(ctx, req.Denom)
+	response := &types.QueryDenomMetadataResponse{Metadata: metadata}
+
+	// include the marker for this denom when there is one.
+	if marker, err := k.GetMarkerByDenom(ctx, req.Denom); err == nil {
+		any, err := codectypes.NewAnyWithValue(marker)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		response.Marker = any
+	}
+
+	return response, nil
+}
 
-	return &types.QueryDenomMetadataResponse{Metadata: metadata}, nil
+// DenomMigration query for the progress of a migration of a marker denom and its reconciliation once complete
+func (k Keeper) DenomMigration(c context.Context, req *types.QueryDenomMigrationRequest) (*types.QueryDenomMigrationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	migration := k.GetDenomMigration(ctx, req.Denom)
+	if migration == nil {
+		return nil, status.Errorf(codes.NotFound, "no denom migration for %s", req.Denom)
+	}
+	fromSupply := k.bankKeeper.GetSupply(ctx, migration.FromDenom)
+	toSupply := k.bankKeeper.GetSupply(ctx, migration.ToDenom)
+	return &types.QueryDenomMigrationResponse{
+		Migration:      *migration,
+		FromSupply:     fromSupply,
+		ToSupply:       toSupply,
+		PendingHolders: migration.TotalHolders - migration.MigratedHolders,
+		Reconciled:     migration.IsReconciled(),
+	}, nil
+}
+
+// TransferFee query for the fee charged on each transfer of a restricted marker
+func (k Keeper) TransferFee(c context.Context, req *types.QueryTransferFeeRequest) (*types.QueryTransferFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTransferFeeResponse{TransferFee: k.GetTransferFee(ctx, marker.GetAddress())}, nil
+}
+
+// Terms query for the terms document anchored to a marker and the timeline of its hashes
+func (k Keeper) Terms(c context.Context, req *types.QueryTermsRequest) (*types.QueryTermsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	history := make([]types.MarkerTermsEntry, 0)
+	termsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TermsHistoryPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(termsStore, req.Pagination, func(key []byte, value []byte) error {
+		var entry types.MarkerTermsEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		history = append(history, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTermsResponse{
+		Current:    k.GetCurrentTerms(ctx, marker.GetAddress()),
+		History:    history,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ManagementHistory query for the timeline of the addresses managing a marker
+func (k Keeper) ManagementHistory(c context.Context, req *types.QueryManagementHistoryRequest) (*types.QueryManagementHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	history := make([]types.MarkerManagementEntry, 0)
+	managementStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ManagementHistoryPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(managementStore, req.Pagination, func(key []byte, value []byte) error {
+		var entry types.MarkerManagementEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		history = append(history, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryManagementHistoryResponse{History: history, Pagination: pageRes}, nil
 }
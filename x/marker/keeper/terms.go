@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetLatestTermsEntry returns the latest entry in the terms timeline of a marker, nil if terms were never set.
+func (k Keeper) GetLatestTermsEntry(ctx sdk.Context, markerAddr sdk.AccAddress) *types.MarkerTermsEntry {
+	it := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.TermsHistoryPrefix(markerAddr))
+	defer it.Close()
+	if !it.Valid() {
+		return nil
+	}
+	var entry types.MarkerTermsEntry
+	k.cdc.MustUnmarshal(it.Value(), &entry)
+	return &entry
+}
+
+// GetCurrentTerms returns the terms currently anchored to a marker, nil if terms were never set or were cleared.
+func (k Keeper) GetCurrentTerms(ctx sdk.Context, markerAddr sdk.AccAddress) *types.MarkerTermsEntry {
+	entry := k.GetLatestTermsEntry(ctx, markerAddr)
+	if entry == nil || entry.IsCleared() {
+		return nil
+	}
+	return entry
+}
+
+// SetTermsEntry stores an entry in the terms timeline of a marker.
+func (k Keeper) SetTermsEntry(ctx sdk.Context, entry types.MarkerTermsEntry) error {
+	markerAddr, err := types.MarkerAddress(entry.Denom)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.TermsKey(markerAddr, entry.Sequence), k.cdc.MustMarshal(&entry))
+	return nil
+}
+
+// IterateTermsHistory processes the terms timeline of a marker, oldest first, with the given handler function.
+func (k Keeper) IterateTermsHistory(ctx sdk.Context, markerAddr sdk.AccAddress, handler func(types.MarkerTermsEntry) (stop bool)) {
+	k.iterateTermsEntries(ctx, types.TermsHistoryPrefix(markerAddr), handler)
+}
+
+// IterateAllTermsHistory processes the terms timelines of all markers with the given handler function.
+func (k Keeper) IterateAllTermsHistory(ctx sdk.Context, handler func(types.MarkerTermsEntry) (stop bool)) {
+	k.iterateTermsEntries(ctx, types.TermsKeyPrefix, handler)
+}
+
+func (k Keeper) iterateTermsEntries(ctx sdk.Context, keyPrefix []byte, handler func(types.MarkerTermsEntry) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var entry types.MarkerTermsEntry
+		k.cdc.MustUnmarshal(it.Value(), &entry)
+		if handler(entry) {
+			break
+		}
+	}
+}
+
+// AnchorTerms appends an entry with the hash and uri of the terms document of a marker to its terms timeline, empty
+// values clear the terms.  The caller must have admin access on the marker.
+func (k Keeper) AnchorTerms(ctx sdk.Context, caller sdk.AccAddress, denom, termsHash, uri string) (*types.MarkerTermsEntry, error) {
+	if err := types.ValidateTerms(termsHash, uri); err != nil {
+		return nil, err
+	}
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	if !k.addressHasAccess(ctx, m, caller, types.Access_Admin) {
+		return nil, fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+
+	entry := types.MarkerTermsEntry{
+		Denom:         m.GetDenom(),
+		Sequence:      1,
+		TermsHash:     termsHash,
+		Uri:           uri,
+		Administrator: caller.String(),
+		BlockHeight:   ctx.BlockHeight(),
+		BlockTime:     ctx.BlockTime().UTC(),
+	}
+	latest := k.GetLatestTermsEntry(ctx, m.GetAddress())
+	switch {
+	case latest != nil && latest.TermsHash == termsHash && latest.Uri == uri:
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTerms, "terms of %s markeraccount are unchanged", m.GetDenom())
+	case latest == nil && entry.IsCleared():
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTerms, "%s markeraccount has no terms to clear", m.GetDenom())
+	case latest != nil:
+		entry.Sequence = latest.Sequence + 1
+	}
+
+	if err = k.SetTermsEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+	return &entry, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTermsSet(entry))
+}
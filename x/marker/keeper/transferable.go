@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetTransferBlocks returns the reasons a transfer of the amount from one account to another would fail, or none if
+// it would succeed.  Restricted coins are transferred by the admin (a holder of transfer access) and all other coins
+// are transferred with a bank send.
+func (k Keeper) GetTransferBlocks(ctx sdk.Context, from, to, admin sdk.AccAddress, amount sdk.Coin) []types.TransferBlock {
+	blocks := []types.TransferBlock{}
+	block := func(reason types.TransferBlockReason, format string, args ...interface{}) {
+		blocks = append(blocks, types.TransferBlock{Reason: reason, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if k.bankKeeper.BlockedAddr(to) {
+		block(types.TransferBlockReason_TRANSFER_BLOCK_REASON_BLOCKED_RECIPIENT, "%s is not allowed to receive funds", to)
+	}
+	if spendable := k.bankKeeper.SpendableCoins(ctx, from).AmountOf(amount.Denom); spendable.LT(amount.Amount) {
+		block(types.TransferBlockReason_TRANSFER_BLOCK_REASON_INSUFFICIENT_FUNDS,
+			"%s has %s%s spendable, less than %s", from, spendable, amount.Denom, amount)
+	}
+
+	m, err := k.GetMarkerByDenom(ctx, amount.Denom)
+	if err != nil {
+		// not a marker coin, only the bank send restrictions apply.
+		if !k.bankKeeper.IsSendEnabledCoin(ctx, amount) {
+			block(types.TransferBlockReason_TRANSFER_BLOCK_REASON_SEND_DISABLED, "%s transfers are currently disabled", amount.Denom)
+		}
+		return blocks
+	}
+
+	if m.GetStatus() != types.StatusActive {
+		block(types.TransferBlockReason_TRANSFER_BLOCK_REASON_MARKER_NOT_ACTIVE,
+			"marker %s has status %s, transfers require an active marker", amount.Denom, m.GetStatus())
+	}
+	if k.IsMarkerPausedByAdmin(ctx, m.GetAddress()) {
+		block(types.TransferBlockReason_TRANSFER_BLOCK_REASON_MARKER_PAUSED, "%s is paused", amount.Denom)
+	}
+	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		if !k.bankKeeper.IsSendEnabledCoin(ctx, amount) {
+			block(types.TransferBlockReason_TRANSFER_BLOCK_REASON_SEND_DISABLED, "%s transfers are currently disabled", amount.Denom)
+		}
+		return blocks
+	}
+
+	if k.IsDenomMigrated(ctx, amount.Denom) {
+		block(types.TransferBlockReason_TRANSFER_BLOCK_REASON_SEND_DISABLED, "%s has been migrated to a new denom", amount.Denom)
+	}
+	if k.IsTransferPaused(ctx, amount.Denom) {
+		block(types.TransferBlockReason_TRANSFER_BLOCK_REASON_TRANSFERS_PAUSED,
+			"transfers of %s are paused until height %d", amount.Denom, k.GetTransferPause(ctx).ExpiryHeight)
+	}
+	for _, addr := range []sdk.AccAddress{from, to} {
+		if k.IsDenyListed(ctx, addr) {
+			block(types.TransferBlockReason_TRANSFER_BLOCK_REASON_DENY_LISTED, "%s is on the restricted marker deny list", addr)
+		}
+	}
+	if !m.AddressHasAccessAt(admin, types.Access_Transfer, ctx.BlockTime()) {
+		block(types.TransferBlockReason_TRANSFER_BLOCK_REASON_NO_TRANSFER_ACCESS, "%s is not allowed to broker transfers", admin)
+	}
+	if owed, recipient, err := k.transferFeeOwed(ctx, m, from, amount); err == nil && recipient != nil {
+		required := owed.Amount
+		if owed.Denom == amount.Denom {
+			required = required.Add(amount.Amount)
+		}
+		if spendable := k.bankKeeper.SpendableCoins(ctx, from).AmountOf(owed.Denom); spendable.LT(required) {
+			block(types.TransferBlockReason_TRANSFER_BLOCK_REASON_INSUFFICIENT_FUNDS,
+				"%s has %s%s spendable, not enough to also pay the transfer fee of %s", from, spendable, owed.Denom, owed)
+		}
+	}
+	if !admin.Equals(from) {
+		if err = k.checkTransferAuthorization(ctx, admin, from, to, amount); err != nil {
+			block(types.TransferBlockReason_TRANSFER_BLOCK_REASON_NO_TRANSFER_AUTHORIZATION, "%s", err)
+		}
+	}
+	return blocks
+}
+
+// checkTransferAuthorization returns an error if the from address has not granted the admin a marker transfer
+// authorization that accepts the transfer.  Unlike the authzHandler, the grant is not updated.
+func (k Keeper) checkTransferAuthorization(ctx sdk.Context, admin, from, to sdk.AccAddress, amount sdk.Coin) error {
+	markerAuth := types.MarkerTransferAuthorization{}
+	authorization, _ := k.authzKeeper.GetCleanAuthorization(ctx, admin, from, markerAuth.MsgTypeURL())
+	if authorization == nil {
+		return fmt.Errorf("%s account has not been granted authority to withdraw from %s account", admin, from)
+	}
+	accept, err := authorization.Accept(ctx, &types.MsgTransferRequest{Amount: amount, FromAddress: from.String(), ToAddress: to.String()})
+	if err != nil {
+		return err
+	}
+	if !accept.Accept {
+		return fmt.Errorf("authorization was not accepted for %s", admin)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetTransferFee returns the transfer fee of a marker if one has been set.
+func (k Keeper) GetTransferFee(ctx sdk.Context, markerAddr sdk.AccAddress) *types.TransferFee {
+	bz := ctx.KVStore(k.storeKey).Get(types.TransferFeeKey(markerAddr))
+	if bz == nil {
+		return nil
+	}
+	var fee types.TransferFee
+	k.cdc.MustUnmarshal(bz, &fee)
+	return &fee
+}
+
+// SetTransferFee stores the transfer fee of a marker, replacing any existing fee.
+func (k Keeper) SetTransferFee(ctx sdk.Context, fee types.TransferFee) error {
+	markerAddr, err := types.MarkerAddress(fee.Denom)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.TransferFeeKey(markerAddr), k.cdc.MustMarshal(&fee))
+	return nil
+}
+
+// RemoveTransferFee removes the transfer fee of a marker.
+func (k Keeper) RemoveTransferFee(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.TransferFeeKey(markerAddr))
+}
+
+// IterateTransferFees processes all transfer fees with the given handler function.
+func (k Keeper) IterateTransferFees(ctx sdk.Context, handler func(types.TransferFee) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TransferFeeKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var fee types.TransferFee
+		k.cdc.MustUnmarshal(it.Value(), &fee)
+		if handler(fee) {
+			break
+		}
+	}
+}
+
+// ValidateTransferFee checks that a transfer fee can be set on its marker, which must be a restricted coin marker.
+func (k Keeper) ValidateTransferFee(ctx sdk.Context, fee types.TransferFee) (types.MarkerAccountI, error) {
+	if err := fee.Validate(); err != nil {
+		return nil, err
+	}
+	m, err := k.GetMarkerByDenom(ctx, fee.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %s", fee.Denom, err)
+	}
+	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil, fmt.Errorf("marker type is not restricted_coin, transfer fees not supported")
+	}
+	return m, nil
+}
+
+// AddTransferFee sets the fee charged on each transfer of a restricted marker.  The caller must have admin access on
+// the marker.
+func (k Keeper) AddTransferFee(ctx sdk.Context, caller sdk.AccAddress, fee types.TransferFee) error {
+	m, err := k.ValidateTransferFee(ctx, fee)
+	if err != nil {
+		return err
+	}
+	if !k.addressHasAccess(ctx, m, caller, types.Access_Admin) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+	if err = k.SetTransferFee(ctx, fee); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTransferFeeSet(fee, caller.String()))
+}
+
+// DeleteTransferFee removes the transfer fee of a restricted marker.  The caller must have admin access on the marker.
+func (k Keeper) DeleteTransferFee(ctx sdk.Context, caller sdk.AccAddress, denom string) error {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	if !k.addressHasAccess(ctx, m, caller, types.Access_Admin) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+	if k.GetTransferFee(ctx, m.GetAddress()) == nil {
+		return sdkerrors.Wrapf(types.ErrTransferFeeNotFound, "%s markeraccount", m.GetDenom())
+	}
+	k.RemoveTransferFee(ctx, m.GetAddress())
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTransferFeeRemoved(denom, caller.String()))
+}
+
+// transferFeeOwed returns the fee owed by the sender on a transfer of a restricted marker and the address it is paid
+// to.  No fee is owed when the marker has no transfer fee, the fee rounds down to zero, or the sender is the recipient
+// of the fee.
+func (k Keeper) transferFeeOwed(ctx sdk.Context, m types.MarkerAccountI, from sdk.AccAddress, amount sdk.Coin) (sdk.Coin, sdk.AccAddress, error) {
+	fee := k.GetTransferFee(ctx, m.GetAddress())
+	if fee == nil {
+		return sdk.Coin{}, nil, nil
+	}
+	recipient, err := fee.RecipientAddress()
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	owed := fee.Fee(amount.Amount)
+	if !owed.IsPositive() || from.Equals(recipient) {
+		return sdk.Coin{}, nil, nil
+	}
+	return owed, recipient, nil
+}
+
+// collectTransferFee charges the sender of a transfer of a restricted marker the transfer fee of the marker, if any.
+func (k Keeper) collectTransferFee(ctx sdk.Context, m types.MarkerAccountI, from sdk.AccAddress, amount sdk.Coin) error {
+	owed, recipient, err := k.transferFeeOwed(ctx, m, from, amount)
+	if err != nil || recipient == nil {
+		return err
+	}
+	if err = k.bankKeeper.SendCoins(ctx, from, recipient, sdk.NewCoins(owed)); err != nil {
+		return fmt.Errorf("could not pay transfer fee of %s: %w", owed, err)
+	}
+	return ctx.EventManager().EmitTypedEvent(
+		types.NewEventMarkerTransferFeeCollected(m.GetDenom(), from.String(), recipient.String(), owed))
+}
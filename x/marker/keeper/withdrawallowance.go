@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetWithdrawAllowance returns the withdraw allowance of a grantee on a marker if one has been set.
+func (k Keeper) GetWithdrawAllowance(ctx sdk.Context, markerAddr, grantee sdk.AccAddress) *types.WithdrawAllowance {
+	bz := ctx.KVStore(k.storeKey).Get(types.WithdrawAllowanceKey(markerAddr, grantee))
+	if bz == nil {
+		return nil
+	}
+	var allowance types.WithdrawAllowance
+	k.cdc.MustUnmarshal(bz, &allowance)
+	return &allowance
+}
+
+// SetWithdrawAllowance stores the withdraw allowance of a grantee on a marker, replacing any existing allowance.
+func (k Keeper) SetWithdrawAllowance(ctx sdk.Context, allowance types.WithdrawAllowance) error {
+	markerAddr, err := types.MarkerAddress(allowance.Denom)
+	if err != nil {
+		return err
+	}
+	grantee, err := sdk.AccAddressFromBech32(allowance.Grantee)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.WithdrawAllowanceKey(markerAddr, grantee), k.cdc.MustMarshal(&allowance))
+	return nil
+}
+
+// RemoveWithdrawAllowance removes the withdraw allowance of a grantee on a marker.
+func (k Keeper) RemoveWithdrawAllowance(ctx sdk.Context, markerAddr, grantee sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.WithdrawAllowanceKey(markerAddr, grantee))
+}
+
+// IterateWithdrawAllowances processes all withdraw allowances with the given handler function.
+func (k Keeper) IterateWithdrawAllowances(ctx sdk.Context, handler func(types.WithdrawAllowance) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.WithdrawAllowanceKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var allowance types.WithdrawAllowance
+		k.cdc.MustUnmarshal(it.Value(), &allowance)
+		if handler(allowance) {
+			break
+		}
+	}
+}
+
+// GrantWithdrawAllowance limits the coin the grantee of the allowance can withdraw from the marker.  The caller must
+// have admin access on the marker.  Withdrawals recorded under an existing allowance still count against the new one.
+func (k Keeper) GrantWithdrawAllowance(ctx sdk.Context, caller sdk.AccAddress, allowance types.WithdrawAllowance) error {
+	m, err := k.GetMarkerByDenom(ctx, allowance.Denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", allowance.Denom, err)
+	}
+	if !k.addressHasAccess(ctx, m, caller, types.Access_Admin) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+	grantee, err := sdk.AccAddressFromBech32(allowance.Grantee)
+	if err != nil {
+		return err
+	}
+	allowance.Withdrawals = nil
+	if existing := k.GetWithdrawAllowance(ctx, m.GetAddress(), grantee); existing != nil {
+		allowance.Withdrawals = existing.Withdrawals
+	}
+	allowance.Prune(ctx.BlockTime())
+	if err = allowance.Validate(); err != nil {
+		return err
+	}
+	if err = k.SetWithdrawAllowance(ctx, allowance); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerWithdrawAllowanceSet(allowance, caller.String()))
+}
+
+// RevokeWithdrawAllowance removes the limit on the coin a grantee can withdraw from a marker.  The caller must have
+// admin access on the marker.
+func (k Keeper) RevokeWithdrawAllowance(ctx sdk.Context, caller sdk.AccAddress, denom string, grantee sdk.AccAddress) error {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	if !k.addressHasAccess(ctx, m, caller, types.Access_Admin) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+	if k.GetWithdrawAllowance(ctx, m.GetAddress(), grantee) == nil {
+		return fmt.Errorf("%s has no withdraw allowance on %s markeraccount", grantee, m.GetDenom())
+	}
+	k.RemoveWithdrawAllowance(ctx, m.GetAddress(), grantee)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerWithdrawAllowanceDeleted(denom, caller.String(), grantee.String()))
+}
+
+// useWithdrawAllowance records coin withdrawn from a marker by the caller against their withdraw allowance, returning
+// an error if it exceeds the coin remaining in the current window.  Callers without an allowance are not limited.
+func (k Keeper) useWithdrawAllowance(ctx sdk.Context, m types.MarkerAccountI, caller sdk.AccAddress, coins sdk.Coins) error {
+	allowance := k.GetWithdrawAllowance(ctx, m.GetAddress(), caller)
+	if allowance == nil {
+		return nil
+	}
+	if err := allowance.Use(ctx.BlockTime(), coins); err != nil {
+		return err
+	}
+	return k.SetWithdrawAllowance(ctx, *allowance)
+}
@@ -0,0 +1,71 @@
+package v044
+
+import (
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MigrateMarkerParams ensures every marker module parameter has a value in the param store.  Parameters that were
+// added after the store was created are set to their default values so that GetParamSet does not panic on a
+// missing key.  Parameters that already have a value are left unchanged.
+func MigrateMarkerParams(ctx sdk.Context, subspace paramtypes.Subspace) error {
+	ctx.Logger().Info("Migrating Marker Module Params")
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if subspace.Has(ctx, pair.Key) {
+			continue
+		}
+		value := reflect.ValueOf(pair.Value).Elem().Interface()
+		if err := pair.ValidatorFn(value); err != nil {
+			return err
+		}
+		ctx.Logger().Info("Setting missing marker param to default", "key", string(pair.Key))
+		subspace.Set(ctx, pair.Key, value)
+	}
+	return nil
+}
+
+// MarkerKeeperI is a minimal set of marker keeper operations required for store migrations
+type MarkerKeeperI interface {
+	// Set a marker in the auth account store
+	SetMarker(sdk.Context, types.MarkerAccountI)
+	// IterateMarker processes all markers with the given handler function.
+	IterateMarkers(sdk.Context, func(types.MarkerAccountI) bool)
+	// RecordManagement appends an entry to the management timeline of a marker when its managing addresses changed.
+	RecordManagement(sdk.Context, types.MarkerAccountI)
+}
+
+// MigrateMarkerCreatedHeight sets the created height of markers that do not have one to the current block height.
+// The height markers were actually created at is not recorded so the age of existing markers is measured from the
+// upgrade.
+func MigrateMarkerCreatedHeight(ctx sdk.Context, k MarkerKeeperI) error {
+	ctx.Logger().Info("Migrating Marker Module Marker Created Heights")
+	var markers []types.MarkerAccountI
+	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		if marker.GetCreatedHeight() == 0 {
+			markers = append(markers, marker)
+		}
+		return false
+	})
+	for _, marker := range markers {
+		marker.SetCreatedHeight(ctx.BlockHeight())
+		k.SetMarker(ctx, marker)
+	}
+	return nil
+}
+
+// MigrateMarkerManagementHistory starts the management timeline of markers with their current manager and admins at
+// the upgrade height.  Earlier changes of the managing addresses and the creators of existing markers are not recorded
+// so they cannot be backfilled.
+func MigrateMarkerManagementHistory(ctx sdk.Context, k MarkerKeeperI) error {
+	ctx.Logger().Info("Migrating Marker Module Marker Management History")
+	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		k.RecordManagement(ctx, marker)
+		return false
+	})
+	return nil
+}
@@ -0,0 +1,125 @@
+package v044_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/provenance-io/provenance/app"
+	v044 "github.com/provenance-io/provenance/x/marker/legacy/v044"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+type MigrateTestSuite struct {
+	suite.Suite
+
+	app *app.App
+	ctx sdk.Context
+}
+
+func TestMigrateTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrateTestSuite))
+}
+
+func (s *MigrateTestSuite) SetupTest() {
+	s.app = app.Setup(false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
+}
+
+// deleteParam removes a marker param value directly from the params store to simulate a param added after genesis.
+func (s *MigrateTestSuite) deleteParam(key []byte) {
+	store := prefix.NewStore(s.ctx.KVStore(s.app.GetKey(paramstypes.StoreKey)), append([]byte(types.ModuleName), '/'))
+	store.Delete(key)
+}
+
+func (s *MigrateTestSuite) TestMigrateMarkerParamsSetsMissingDefaults() {
+	subspace := s.app.GetSubspace(types.ModuleName)
+	s.deleteParam(types.ParamStoreKeyUnrestrictedDenomRegex)
+	s.Require().False(subspace.Has(s.ctx, types.ParamStoreKeyUnrestrictedDenomRegex), "param should be missing before migration")
+
+	s.Require().NoError(v044.MigrateMarkerParams(s.ctx, subspace))
+
+	s.Require().True(subspace.Has(s.ctx, types.ParamStoreKeyUnrestrictedDenomRegex), "param should be set after migration")
+	var params types.Params
+	s.Require().NotPanics(func() { subspace.GetParamSet(s.ctx, &params) }, "all params should be readable after migration")
+	s.Assert().Equal(types.DefaultParams(), params)
+}
+
+func (s *MigrateTestSuite) TestMigrateMarkerParamsSetsEmitLegacyEvents() {
+	subspace := s.app.GetSubspace(types.ModuleName)
+	s.deleteParam(types.ParamStoreKeyEmitLegacyEvents)
+	s.Require().False(subspace.Has(s.ctx, types.ParamStoreKeyEmitLegacyEvents), "param should be missing before migration")
+
+	s.Require().NoError(v044.MigrateMarkerParams(s.ctx, subspace))
+
+	s.Require().True(subspace.Has(s.ctx, types.ParamStoreKeyEmitLegacyEvents), "param should be set after migration")
+	s.Assert().Equal(types.DefaultEmitLegacyEvents, s.app.MarkerKeeper.GetEmitLegacyEvents(s.ctx))
+}
+
+func (s *MigrateTestSuite) TestMigrateMarkerParamsKeepsExistingValues() {
+	custom := types.NewParams(1000, false, "[a-z]{5,10}", false)
+	s.app.MarkerKeeper.SetParams(s.ctx, custom)
+
+	s.Require().NoError(v044.MigrateMarkerParams(s.ctx, s.app.GetSubspace(types.ModuleName)))
+
+	s.Assert().Equal(custom, s.app.MarkerKeeper.GetParams(s.ctx))
+}
+
+func (s *MigrateTestSuite) TestMigrateMarkerCreatedHeight() {
+	manager := sdk.AccAddress("manager_____________").String()
+	existing := types.NewEmptyMarkerAccount("existingcoin", manager, nil)
+	s.app.MarkerKeeper.SetMarker(s.ctx, existing)
+	created := types.NewEmptyMarkerAccount("createdcoin", manager, nil)
+	created.SetCreatedHeight(3)
+	s.app.MarkerKeeper.SetMarker(s.ctx, created)
+
+	ctx := s.ctx.WithBlockHeight(10)
+	s.Require().NoError(v044.MigrateMarkerCreatedHeight(ctx, s.app.MarkerKeeper))
+
+	m, err := s.app.MarkerKeeper.GetMarkerByDenom(ctx, "existingcoin")
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(10), m.GetCreatedHeight(), "markers without a created height use the upgrade height")
+	m, err = s.app.MarkerKeeper.GetMarkerByDenom(ctx, "createdcoin")
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(3), m.GetCreatedHeight(), "existing created heights are kept")
+}
+
+func (s *MigrateTestSuite) TestMigrateMarkerManagementHistory() {
+	manager := sdk.AccAddress("manager_____________").String()
+	managed := types.NewEmptyMarkerAccount("managedcoin", manager, nil)
+	s.app.MarkerKeeper.SetMarker(s.ctx, managed)
+	unmanaged := types.NewEmptyMarkerAccount("unmanagedcoin", "", nil)
+	unmanaged.Status = types.StatusActive
+	s.app.MarkerKeeper.SetMarker(s.ctx, unmanaged)
+
+	// markers saved before the upgrade have no management history
+	store := prefix.NewStore(s.ctx.KVStore(s.app.GetKey(types.StoreKey)), types.ManagementKeyPrefix)
+	it := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	s.Require().NoError(it.Close())
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	ctx := s.ctx.WithBlockHeight(10)
+	s.Require().NoError(v044.MigrateMarkerManagementHistory(ctx, s.app.MarkerKeeper))
+
+	entry := s.app.MarkerKeeper.GetLatestManagementEntry(ctx, managed.GetAddress())
+	s.Require().NotNil(entry, "managed marker history")
+	s.Assert().Equal(uint64(1), entry.Sequence, "sequence")
+	s.Assert().Equal([]string{manager}, entry.ManagedBy, "managed by")
+	s.Assert().Equal(int64(10), entry.BlockHeight, "markers start their history at the upgrade height")
+	s.Assert().Nil(s.app.MarkerKeeper.GetLatestManagementEntry(ctx, unmanaged.GetAddress()), "unmanaged marker history")
+
+	s.Require().NoError(v044.MigrateMarkerManagementHistory(ctx, s.app.MarkerKeeper))
+	entry = s.app.MarkerKeeper.GetLatestManagementEntry(ctx, managed.GetAddress())
+	s.Assert().Equal(uint64(1), entry.Sequence, "running the migration again does not add entries")
+}
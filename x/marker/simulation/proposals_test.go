@@ -29,7 +29,7 @@ func TestProposalContents(t *testing.T) {
 	accounts := simtypes.RandomAccounts(r, 3)
 
 	// execute ProposalContents function
-	weightedProposalContent := simulation.ProposalContents(keeper.NewKeeper(app.AppCodec(), app.GetKey(types.ModuleName), app.GetSubspace(types.ModuleName), app.AccountKeeper, app.BankKeeper, app.AuthzKeeper, app.GetKey(banktypes.StoreKey)))
+	weightedProposalContent := simulation.ProposalContents(keeper.NewKeeper(app.AppCodec(), app.GetKey(types.ModuleName), app.GetSubspace(types.ModuleName), app.AccountKeeper, app.BankKeeper, app.AuthzKeeper, app.GetKey(banktypes.StoreKey), &app.GovKeeper, app.AttributeKeeper, app.FeatureFlagsKeeper))
 	require.Len(t, weightedProposalContent, 7)
 
 	w0 := weightedProposalContent[0]
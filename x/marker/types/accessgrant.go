@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/gogo/protobuf/proto"
@@ -23,6 +24,8 @@ type AccessGrantI interface {
 
 	HasAccess(Access) bool
 	GetAccessList() []Access
+	GetExpiration() *time.Time
+	IsExpired(time.Time) bool
 
 	AddAccess(Access) error
 	RemoveAccess(Access) error
@@ -84,7 +87,7 @@ func GrantsForAddress(account sdk.AccAddress, grants ...AccessGrant) AccessGrant
 			return grant
 		}
 	}
-	return AccessGrant{account.String(), []Access{}}
+	return AccessGrant{Address: account.String(), Permissions: []Access{}}
 }
 
 // GetAddress returns the account address the access grant belongs to
@@ -101,6 +104,16 @@ func (ag AccessGrant) GetAccessList() AccessList {
 	return ag.Permissions
 }
 
+// GetExpiration returns the time at which the permissions of this grant expire, nil if they do not expire
+func (ag AccessGrant) GetExpiration() *time.Time {
+	return ag.Expiration
+}
+
+// IsExpired returns true if the grant has an expiration at or before the given block time
+func (ag AccessGrant) IsExpired(blockTime time.Time) bool {
+	return ag.Expiration != nil && !blockTime.Before(*ag.Expiration)
+}
+
 // Validate performs checks to ensure this acccess grant is properly formed.
 func (ag AccessGrant) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(ag.Address); err != nil {
@@ -182,6 +195,9 @@ func (ag AccessGrant) String() string {
 			result = fmt.Sprintf("%s, %s", result, perm)
 		}
 	}
+	if ag.Expiration != nil {
+		return fmt.Sprintf("AccessGrant: %s [%s] expires %s", ag.Address, result, ag.Expiration.Format(time.RFC3339))
+	}
 	return fmt.Sprintf("AccessGrant: %s [%s]", ag.Address, result)
 }
 
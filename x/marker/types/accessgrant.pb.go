@@ -7,16 +7,20 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -45,7 +49,7 @@ const (
 	// ACCESS_ADMIN is the ability to add access grants for accounts to the list of marker permissions.
 	Access_Admin Access = 6
 	// ACCESS_TRANSFER is the ability to invoke a send operation using the marker module to facilitate exchange.
-	// This capability is useful when the marker denomination has "send enabled = false" preventing normal bank transfer
+	// This access right is only supported on RESTRICTED markers.
 	Access_Transfer Access = 7
 )
 
@@ -83,6 +87,8 @@ func (Access) EnumDescriptor() ([]byte, []int) {
 type AccessGrant struct {
 	Address     string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Permissions AccessList `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=provenance.marker.v1.Access,castrepeated=AccessList" json:"permissions,omitempty"`
+	// optional time at which the permissions expire, permissions without an expiration do not expire
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *AccessGrant) Reset()      { *m = AccessGrant{} }
@@ -127,37 +133,40 @@ func init() {
 }
 
 var fileDescriptor_7242c30a84644575 = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xed, 0xb4, 0x4d, 0xda, 0x4b, 0x08, 0xd6, 0xa9, 0x12, 0xa9, 0x29, 0x8e, 0x01, 0x09,
-	0x55, 0x48, 0xb5, 0xd5, 0xb2, 0xb1, 0x39, 0xb1, 0x0b, 0x96, 0x1a, 0x13, 0xd9, 0x8e, 0x22, 0xb1,
-	0x54, 0xae, 0x73, 0xa4, 0xa7, 0xe2, 0x3b, 0xeb, 0xce, 0x4d, 0xe9, 0x17, 0x40, 0xc8, 0x13, 0x0b,
-	0x12, 0x8b, 0xa5, 0xcc, 0xcc, 0x7c, 0x08, 0xc6, 0x8a, 0x89, 0x0d, 0x94, 0x2c, 0x7c, 0x0c, 0x94,
-	0x5c, 0x4a, 0x3d, 0x74, 0x7b, 0xcf, 0xff, 0x9f, 0x7f, 0x7a, 0x7a, 0xf7, 0xc0, 0xb3, 0x94, 0xd1,
-	0x09, 0x22, 0x11, 0x89, 0x91, 0x99, 0x44, 0xec, 0x1c, 0x31, 0x73, 0x72, 0x60, 0x46, 0x71, 0x8c,
-	0x38, 0x1f, 0xb3, 0x88, 0x64, 0x46, 0xca, 0x68, 0x46, 0xe1, 0xf6, 0x2d, 0x67, 0x08, 0xce, 0x98,
-	0x1c, 0xa8, 0xdb, 0x63, 0x3a, 0xa6, 0x4b, 0xc0, 0x5c, 0x54, 0x82, 0x55, 0x77, 0x62, 0xca, 0x13,
-	0xca, 0x4f, 0x44, 0x20, 0x1a, 0x11, 0x3d, 0xf9, 0x22, 0x83, 0xba, 0xb5, 0x94, 0xbf, 0x5a, 0xc8,
-	0x61, 0x0b, 0xd4, 0xa2, 0xd1, 0x88, 0x21, 0xce, 0x5b, 0xb2, 0x2e, 0xef, 0x6d, 0xf9, 0x37, 0x2d,
-	0xf4, 0x40, 0x3d, 0x45, 0x2c, 0xc1, 0x9c, 0x63, 0x4a, 0x78, 0xab, 0xa2, 0xaf, 0xed, 0x35, 0x0f,
-	0x77, 0x8d, 0xbb, 0xc6, 0x30, 0x84, 0xb1, 0xd3, 0xfc, 0xf6, 0xbb, 0x0d, 0x44, 0x7d, 0x8c, 0x79,
-	0xe6, 0x97, 0x05, 0x2f, 0x77, 0x3f, 0x4d, 0xdb, 0xd2, 0xd7, 0x69, 0x5b, 0xfa, 0x3b, 0x6d, 0xcb,
-	0x3f, 0xbf, 0xef, 0x37, 0x4a, 0x63, 0xb8, 0xcf, 0x3f, 0x56, 0x40, 0x55, 0x7c, 0x80, 0x4f, 0x01,
-	0xb4, 0xba, 0x5d, 0x27, 0x08, 0x4e, 0x06, 0x5e, 0xd0, 0x77, 0xba, 0xee, 0x91, 0xeb, 0xd8, 0x8a,
-	0xa4, 0xd6, 0xf3, 0x42, 0xaf, 0x0d, 0xc8, 0x39, 0xa1, 0x97, 0x04, 0xee, 0x80, 0xfa, 0x0a, 0xea,
-	0xb9, 0x5e, 0xa8, 0xc8, 0xea, 0x66, 0x5e, 0xe8, 0xeb, 0x3d, 0x4c, 0xb2, 0x52, 0xd4, 0x19, 0xf8,
-	0x9e, 0x52, 0x11, 0x51, 0xe7, 0x82, 0x11, 0xd8, 0x06, 0xcd, 0x55, 0x64, 0x3b, 0xfd, 0x37, 0x81,
-	0x1b, 0x2a, 0x6b, 0x42, 0x6b, 0xa3, 0x94, 0x72, 0x9c, 0xc1, 0xc7, 0xe0, 0xfe, 0x0a, 0x18, 0xba,
-	0xe1, 0x6b, 0xdb, 0xb7, 0x86, 0xca, 0xba, 0xda, 0xc8, 0x0b, 0x7d, 0x73, 0x88, 0xb3, 0xb3, 0x11,
-	0x8b, 0x2e, 0xe1, 0x23, 0x70, 0xef, 0xbf, 0xe3, 0xd8, 0x09, 0x1d, 0x65, 0x43, 0x05, 0x79, 0xa1,
-	0x57, 0x6d, 0xf4, 0x1e, 0x65, 0x08, 0x3e, 0x04, 0x8d, 0x55, 0x6c, 0xd9, 0x3d, 0xd7, 0x53, 0xaa,
-	0xea, 0x56, 0x5e, 0xe8, 0x1b, 0xd6, 0x28, 0xc1, 0xa4, 0xa4, 0x0f, 0x7d, 0xcb, 0x0b, 0x8e, 0x1c,
-	0x5f, 0xa9, 0x09, 0x7d, 0xc8, 0x22, 0xc2, 0xdf, 0x21, 0xd6, 0xb9, 0xfa, 0x31, 0xd3, 0xe4, 0xeb,
-	0x99, 0x26, 0xff, 0x99, 0x69, 0xf2, 0xe7, 0xb9, 0x26, 0x5d, 0xcf, 0x35, 0xe9, 0xd7, 0x5c, 0x93,
-	0xc0, 0x03, 0x4c, 0xef, 0xdc, 0x7e, 0x47, 0x29, 0x6d, 0xb2, 0xbf, 0x78, 0xe5, 0xbe, 0xfc, 0xf6,
-	0x70, 0x8c, 0xb3, 0xb3, 0x8b, 0x53, 0x23, 0xa6, 0x89, 0x79, 0xfb, 0xd3, 0x3e, 0xa6, 0xa5, 0xce,
-	0xfc, 0x70, 0x73, 0x71, 0xd9, 0x55, 0x8a, 0xf8, 0x69, 0x75, 0x79, 0x22, 0x2f, 0xfe, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x22, 0x3b, 0x74, 0x4e, 0x93, 0x02, 0x00, 0x00,
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xb5, 0xfb, 0x23, 0x6d, 0x2f, 0xa5, 0x58, 0x56, 0x25, 0x52, 0x53, 0x6c, 0x03, 0x12, 0xaa,
+	0x90, 0x6a, 0xab, 0x65, 0x63, 0xc2, 0x8e, 0x5d, 0xb0, 0xd4, 0x98, 0xc8, 0x71, 0x14, 0x89, 0xa5,
+	0x72, 0x92, 0xab, 0x7b, 0x6a, 0x7d, 0x67, 0xdd, 0x5d, 0xd2, 0xf6, 0x1f, 0x40, 0x28, 0x53, 0x47,
+	0x96, 0x48, 0x99, 0x99, 0xf9, 0x23, 0x18, 0x2b, 0x16, 0xd8, 0x8a, 0x92, 0x85, 0x3f, 0x03, 0x25,
+	0x97, 0x50, 0x0f, 0xdd, 0xbe, 0x77, 0xef, 0x7d, 0xef, 0x7b, 0x77, 0xdf, 0x81, 0x57, 0x39, 0x25,
+	0x7d, 0x88, 0x13, 0xdc, 0x81, 0x76, 0x96, 0xd0, 0x73, 0x48, 0xed, 0xfe, 0x81, 0x9d, 0x74, 0x3a,
+	0x90, 0xb1, 0x94, 0x26, 0x98, 0x5b, 0x39, 0x25, 0x9c, 0xa8, 0xdb, 0xf7, 0x3a, 0x4b, 0xe8, 0xac,
+	0xfe, 0x81, 0xb6, 0x9d, 0x92, 0x94, 0xcc, 0x04, 0xf6, 0xb4, 0x12, 0x5a, 0x6d, 0xa7, 0x43, 0x58,
+	0x46, 0xd8, 0x89, 0x20, 0x04, 0x98, 0x53, 0x46, 0x4a, 0x48, 0x7a, 0x01, 0xed, 0x19, 0x6a, 0xf7,
+	0x4e, 0x6d, 0x8e, 0x32, 0xc8, 0x78, 0x92, 0xe5, 0x42, 0xf0, 0xe2, 0x97, 0x0c, 0xca, 0xce, 0x6c,
+	0xfa, 0xfb, 0xe9, 0x74, 0xb5, 0x02, 0xd6, 0x92, 0x6e, 0x97, 0x42, 0xc6, 0x2a, 0xb2, 0x29, 0xef,
+	0x6d, 0x44, 0x0b, 0xa8, 0x86, 0xa0, 0x9c, 0x43, 0x9a, 0x21, 0xc6, 0x10, 0xc1, 0xac, 0xb2, 0x64,
+	0x2e, 0xef, 0x6d, 0x1d, 0xee, 0x5a, 0x0f, 0xe5, 0xb4, 0x84, 0xa3, 0xbb, 0xf5, 0xed, 0xce, 0x00,
+	0xa2, 0x3e, 0x46, 0x8c, 0x47, 0x45, 0x03, 0xf5, 0x1d, 0x00, 0xf0, 0x2a, 0x47, 0x34, 0xe1, 0x88,
+	0xe0, 0xca, 0xb2, 0x29, 0xef, 0x95, 0x0f, 0x35, 0x4b, 0xe4, 0xb5, 0x16, 0x79, 0xad, 0x78, 0x91,
+	0xd7, 0x5d, 0xb9, 0xb9, 0x33, 0xe4, 0xa8, 0xd0, 0xf3, 0x76, 0xf7, 0xcb, 0xc8, 0x90, 0xbe, 0x8e,
+	0x0c, 0xe9, 0xef, 0xc8, 0x90, 0x7f, 0x7e, 0xdf, 0xdf, 0x2c, 0x5c, 0x24, 0x78, 0xfd, 0x79, 0x09,
+	0x94, 0xc4, 0x81, 0xfa, 0x12, 0xa8, 0x4e, 0xb5, 0xea, 0x37, 0x1a, 0x27, 0xcd, 0xb0, 0x51, 0xf7,
+	0xab, 0xc1, 0x51, 0xe0, 0x7b, 0x8a, 0xa4, 0x95, 0x07, 0x43, 0x73, 0xad, 0x89, 0xcf, 0x31, 0xb9,
+	0xc4, 0xea, 0x0e, 0x28, 0xcf, 0x45, 0xb5, 0x20, 0x8c, 0x15, 0x59, 0x5b, 0x1f, 0x0c, 0xcd, 0x95,
+	0x1a, 0xc2, 0xbc, 0x40, 0xb9, 0xcd, 0x28, 0x54, 0x96, 0x04, 0xe5, 0xf6, 0x28, 0x56, 0x0d, 0xb0,
+	0x35, 0xa7, 0x3c, 0xbf, 0xfe, 0xb1, 0x11, 0xc4, 0xca, 0xb2, 0xb0, 0xf5, 0x60, 0x4e, 0x18, 0xe2,
+	0xea, 0x73, 0xf0, 0x78, 0x2e, 0x68, 0x05, 0xf1, 0x07, 0x2f, 0x72, 0x5a, 0xca, 0x8a, 0xb6, 0x39,
+	0x18, 0x9a, 0xeb, 0x2d, 0xc4, 0xcf, 0xba, 0x34, 0xb9, 0x54, 0x9f, 0x81, 0x47, 0xff, 0x3d, 0x8e,
+	0xfd, 0xd8, 0x57, 0x56, 0x35, 0x30, 0x18, 0x9a, 0x25, 0x0f, 0x5e, 0x40, 0x0e, 0xd5, 0xa7, 0x60,
+	0x73, 0x4e, 0x3b, 0x5e, 0x2d, 0x08, 0x95, 0x92, 0xb6, 0x31, 0x18, 0x9a, 0xab, 0x4e, 0x37, 0x43,
+	0xb8, 0x60, 0x1f, 0x47, 0x4e, 0xd8, 0x38, 0xf2, 0x23, 0x65, 0x4d, 0xd8, 0xc7, 0x34, 0xc1, 0xec,
+	0x14, 0x52, 0xf7, 0xfa, 0xc7, 0x58, 0x97, 0x6f, 0xc7, 0xba, 0xfc, 0x67, 0xac, 0xcb, 0x37, 0x13,
+	0x5d, 0xba, 0x9d, 0xe8, 0xd2, 0xef, 0x89, 0x2e, 0x81, 0x27, 0x88, 0x3c, 0xb8, 0x3f, 0x57, 0x29,
+	0xbc, 0x64, 0x7d, 0xba, 0x8a, 0xba, 0xfc, 0xe9, 0x30, 0x45, 0xfc, 0xac, 0xd7, 0xb6, 0x3a, 0x24,
+	0xb3, 0xef, 0x9b, 0xf6, 0x11, 0x29, 0x20, 0xfb, 0x6a, 0xf1, 0xa9, 0xf9, 0x75, 0x0e, 0x59, 0xbb,
+	0x34, 0xdb, 0xe3, 0x9b, 0x7f, 0x03, 0x00, 0x2c, 0x03, 0xeb, 0xd3, 0xf6, 0x02, 0x00, 0x00,
 }
 
 func (this *AccessGrant) Equal(that interface{}) bool {
@@ -190,6 +199,13 @@ func (this *AccessGrant) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.Expiration == nil {
+		if this.Expiration != nil {
+			return false
+		}
+	} else if !this.Expiration.Equal(*that1.Expiration) {
+		return false
+	}
 	return true
 }
 func (m *AccessGrant) Marshal() (dAtA []byte, err error) {
@@ -212,21 +228,31 @@ func (m *AccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAccessgrant(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Permissions) > 0 {
-		dAtA2 := make([]byte, len(m.Permissions)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.Permissions)*10)
+		var j2 int
 		for _, num := range m.Permissions {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintAccessgrant(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintAccessgrant(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -268,6 +294,10 @@ func (m *AccessGrant) Size() (n int) {
 		}
 		n += 1 + sovAccessgrant(uint64(l)) + l
 	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAccessgrant(uint64(l))
+	}
 	return n
 }
 
@@ -407,6 +437,42 @@ func (m *AccessGrant) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccessgrant(dAtA[iNdEx:])
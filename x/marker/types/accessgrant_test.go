@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -172,3 +173,25 @@ func TestMergeAddRemovePermissions(t *testing.T) {
 	require.Error(t, roleGrant.MergeAdd(*NewAccessGrant(otherAddr, AccessList{Access_Mint, Access_Admin})))
 	require.Error(t, roleGrant.MergeRemove(*NewAccessGrant(otherAddr, AccessList{Access_Mint, Access_Admin})))
 }
+
+func TestAccessGrantExpiration(t *testing.T) {
+	now := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	expiration := now.Add(time.Hour)
+	addr := MustGetMarkerAddress("test")
+	grant := NewAccessGrant(addr, AccessList{Access_Mint})
+	require.False(t, grant.IsExpired(now), "grants without an expiration never expire")
+
+	grant.Expiration = &expiration
+	require.False(t, grant.IsExpired(now))
+	require.True(t, grant.IsExpired(expiration), "grants expire at the expiration time")
+
+	other := NewAccessGrant(MustGetMarkerAddress("other"), AccessList{Access_Burn})
+	m := NewEmptyMarkerAccount("test", addr.String(), []AccessGrant{*grant, *other})
+	require.True(t, m.AddressHasAccessAt(addr, Access_Mint, now))
+	require.False(t, m.AddressHasAccessAt(addr, Access_Mint, expiration))
+	require.True(t, m.AddressHasAccess(addr, Access_Mint), "expired grants remain until removed")
+
+	require.Empty(t, m.RemoveExpiredAccess(now))
+	require.Equal(t, []AccessGrant{*grant}, m.RemoveExpiredAccess(expiration))
+	require.Equal(t, []AccessGrant{*other}, m.GetAccessList())
+}
@@ -0,0 +1,181 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+// HoldingAttestation is a proof that an address held an amount of a marker denom at a block height.  It carries the
+// merkle proofs of the bank balance entry and of the marker account so it can be checked against a trusted app hash,
+// e.g. from a light client, without trusting the node that produced it.
+type HoldingAttestation struct {
+	// Address is the bech32 address of the holder.
+	Address string `json:"address"`
+	// Denom is the marker denom held.
+	Denom string `json:"denom"`
+	// Amount is the balance of the denom held by the address, zero if the balance proof is a proof of absence.
+	Amount sdk.Int `json:"amount"`
+	// MarkerStatus is the status of the marker at the height.
+	MarkerStatus string `json:"marker_status"`
+	// Height is the height of the state proven.
+	Height int64 `json:"height"`
+	// AppHash is the app hash of the block at Height + 1, which commits the state at Height.
+	AppHash tmbytes.HexBytes `json:"app_hash"`
+	// BalanceValue is the raw value of the balance in the bank store, empty if the address has no balance.
+	BalanceValue []byte `json:"balance_value"`
+	// BalanceProof is the merkle proof of the balance in the bank store.
+	BalanceProof *tmcrypto.ProofOps `json:"balance_proof"`
+	// MarkerValue is the raw value of the marker account in the account store.
+	MarkerValue []byte `json:"marker_value"`
+	// MarkerProof is the merkle proof of the marker account in the account store.
+	MarkerProof *tmcrypto.ProofOps `json:"marker_proof"`
+}
+
+// HoldingBalanceKey is the key of the balance of a denom held by an address in the bank store.
+func HoldingBalanceKey(addr sdk.AccAddress, denom string) []byte {
+	return append(banktypes.CreateAccountBalancesPrefix(addr), []byte(denom)...)
+}
+
+// HoldingMarkerKey is the key of the marker account of a denom in the account store.
+func HoldingMarkerKey(denom string) ([]byte, error) {
+	markerAddr, err := MarkerAddress(denom)
+	if err != nil {
+		return nil, err
+	}
+	return authtypes.AddressStoreKey(markerAddr), nil
+}
+
+// NewHoldingAttestation creates a HoldingAttestation from the raw store values and proofs, filling in the amount and
+// marker status from the values.  The proofs are not checked, use Verify for that.
+func NewHoldingAttestation(
+	cdc codec.Codec,
+	address, denom string,
+	height int64,
+	appHash []byte,
+	balanceValue []byte,
+	balanceProof *tmcrypto.ProofOps,
+	markerValue []byte,
+	markerProof *tmcrypto.ProofOps,
+) (*HoldingAttestation, error) {
+	a := &HoldingAttestation{
+		Address:      address,
+		Denom:        denom,
+		Height:       height,
+		AppHash:      appHash,
+		BalanceValue: balanceValue,
+		BalanceProof: balanceProof,
+		MarkerValue:  markerValue,
+		MarkerProof:  markerProof,
+	}
+	var err error
+	if a.Amount, err = a.decodeAmount(cdc); err != nil {
+		return nil, err
+	}
+	marker, err := a.decodeMarker(cdc)
+	if err != nil {
+		return nil, err
+	}
+	a.MarkerStatus = marker.GetStatus().String()
+	return a, nil
+}
+
+// Verify checks the proofs of the attestation against a trusted app hash of the block at Height + 1, and that the
+// amount and marker status agree with the proven values.
+func (a HoldingAttestation) Verify(cdc codec.Codec, trustedAppHash []byte) error {
+	if len(trustedAppHash) == 0 {
+		return fmt.Errorf("a trusted app hash is required")
+	}
+	if len(a.AppHash) > 0 && !bytes.Equal(a.AppHash, trustedAppHash) {
+		return fmt.Errorf("attestation app hash %s does not match trusted app hash %s",
+			a.AppHash, tmbytes.HexBytes(trustedAppHash))
+	}
+	addr, err := sdk.AccAddressFromBech32(a.Address)
+	if err != nil {
+		return fmt.Errorf("invalid address %s: %w", a.Address, err)
+	}
+	markerKey, err := HoldingMarkerKey(a.Denom)
+	if err != nil {
+		return fmt.Errorf("invalid denom %s: %w", a.Denom, err)
+	}
+
+	prt := rootmulti.DefaultProofRuntime()
+	balancePath := holdingKeyPath(banktypes.StoreKey, HoldingBalanceKey(addr, a.Denom))
+	if len(a.BalanceValue) == 0 {
+		err = prt.VerifyAbsence(a.BalanceProof, trustedAppHash, balancePath)
+	} else {
+		err = prt.VerifyValue(a.BalanceProof, trustedAppHash, balancePath, a.BalanceValue)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid balance proof: %w", err)
+	}
+	if err = prt.VerifyValue(a.MarkerProof, trustedAppHash, holdingKeyPath(authtypes.StoreKey, markerKey), a.MarkerValue); err != nil {
+		return fmt.Errorf("invalid marker proof: %w", err)
+	}
+
+	amount, err := a.decodeAmount(cdc)
+	if err != nil {
+		return err
+	}
+	if a.Amount.IsNil() || !a.Amount.Equal(amount) {
+		return fmt.Errorf("attestation amount %s does not match proven amount %s", a.Amount, amount)
+	}
+	marker, err := a.decodeMarker(cdc)
+	if err != nil {
+		return err
+	}
+	if a.MarkerStatus != marker.GetStatus().String() {
+		return fmt.Errorf("attestation marker status %s does not match proven status %s", a.MarkerStatus, marker.GetStatus())
+	}
+	return nil
+}
+
+// decodeAmount gets the amount of the denom from the balance value.
+func (a HoldingAttestation) decodeAmount(cdc codec.Codec) (sdk.Int, error) {
+	if len(a.BalanceValue) == 0 {
+		return sdk.ZeroInt(), nil
+	}
+	var balance sdk.Coin
+	if err := cdc.Unmarshal(a.BalanceValue, &balance); err != nil {
+		return sdk.Int{}, fmt.Errorf("invalid balance value: %w", err)
+	}
+	if balance.Denom != a.Denom {
+		return sdk.Int{}, fmt.Errorf("balance denom %s does not match attestation denom %s", balance.Denom, a.Denom)
+	}
+	return balance.Amount, nil
+}
+
+// decodeMarker gets the marker account from the marker value.
+func (a HoldingAttestation) decodeMarker(cdc codec.Codec) (MarkerAccountI, error) {
+	if len(a.MarkerValue) == 0 {
+		return nil, fmt.Errorf("marker %s not found", a.Denom)
+	}
+	var acc authtypes.AccountI
+	if err := cdc.UnmarshalInterface(a.MarkerValue, &acc); err != nil {
+		return nil, fmt.Errorf("invalid marker value: %w", err)
+	}
+	marker, ok := acc.(MarkerAccountI)
+	if !ok {
+		return nil, fmt.Errorf("account %s is not a marker", acc.GetAddress())
+	}
+	if marker.GetDenom() != a.Denom {
+		return nil, fmt.Errorf("marker denom %s does not match attestation denom %s", marker.GetDenom(), a.Denom)
+	}
+	return marker, nil
+}
+
+// holdingKeyPath is the merkle key path of a key in a module store.
+func holdingKeyPath(storeName string, key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL).
+		String()
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestHoldingAttestation(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	accKey := sdk.NewKVStoreKey(authtypes.StoreKey)
+	bankKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	ms := rootmulti.NewStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(accKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	holder := sdk.AccAddress("holder______________")
+	other := sdk.AccAddress("other_______________")
+	marker := NewEmptyMarkerAccount("attested", holder.String(), nil)
+	marker.Status = StatusActive
+	markerKey, err := HoldingMarkerKey("attested")
+	require.NoError(t, err)
+	markerValue, err := cdc.MarshalInterface(marker)
+	require.NoError(t, err)
+	ms.GetCommitKVStore(accKey).Set(markerKey, markerValue)
+	balanceValue := cdc.MustMarshal(&sdk.Coin{Denom: "attested", Amount: sdk.NewInt(42)})
+	ms.GetCommitKVStore(bankKey).Set(HoldingBalanceKey(holder, "attested"), balanceValue)
+	appHash := ms.Commit().Hash
+
+	query := func(store string, key []byte) abci.ResponseQuery {
+		res := ms.Query(abci.RequestQuery{Path: "/" + store + "/key", Data: key, Height: 1, Prove: true})
+		require.Zero(t, res.Code, res.Log)
+		return res
+	}
+	attest := func(addr sdk.AccAddress) *HoldingAttestation {
+		balance := query(banktypes.StoreKey, HoldingBalanceKey(addr, "attested"))
+		mark := query(authtypes.StoreKey, markerKey)
+		a, err := NewHoldingAttestation(cdc, addr.String(), "attested", 1, appHash,
+			balance.Value, balance.ProofOps, mark.Value, mark.ProofOps)
+		require.NoError(t, err, "NewHoldingAttestation")
+		return a
+	}
+
+	t.Run("holder", func(t *testing.T) {
+		a := attest(holder)
+		require.Equal(t, sdk.NewInt(42), a.Amount)
+		require.Equal(t, StatusActive.String(), a.MarkerStatus)
+		require.NoError(t, a.Verify(cdc, appHash))
+	})
+
+	t.Run("non-holder proves absence", func(t *testing.T) {
+		a := attest(other)
+		require.Equal(t, sdk.ZeroInt(), a.Amount)
+		require.NoError(t, a.Verify(cdc, appHash))
+	})
+
+	t.Run("untrusted app hash", func(t *testing.T) {
+		a := attest(holder)
+		a.AppHash = nil
+		require.Error(t, a.Verify(cdc, []byte("not the app hash")))
+		require.EqualError(t, a.Verify(cdc, nil), "a trusted app hash is required")
+	})
+
+	t.Run("inflated amount", func(t *testing.T) {
+		a := attest(holder)
+		a.Amount = sdk.NewInt(4200)
+		require.EqualError(t, a.Verify(cdc, appHash), "attestation amount 4200 does not match proven amount 42")
+	})
+
+	t.Run("forged balance value", func(t *testing.T) {
+		a := attest(holder)
+		a.BalanceValue = cdc.MustMarshal(&sdk.Coin{Denom: "attested", Amount: sdk.NewInt(4200)})
+		a.Amount = sdk.NewInt(4200)
+		err := a.Verify(cdc, appHash)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid balance proof")
+	})
+
+	t.Run("proof for another address", func(t *testing.T) {
+		a := attest(holder)
+		a.Address = other.String()
+		err := a.Verify(cdc, appHash)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid balance proof")
+	})
+
+	t.Run("changed marker status", func(t *testing.T) {
+		a := attest(holder)
+		a.MarkerStatus = StatusCancelled.String()
+		require.EqualError(t, a.Verify(cdc, appHash),
+			"attestation marker status cancelled does not match proven status active")
+	})
+}
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
@@ -10,10 +14,54 @@ var (
 	_ authz.Authorization = &MarkerTransferAuthorization{}
 )
 
+// OperatorGrantTemplates are the sets of marker msgs that can be granted to an operational account with authz generic
+// authorizations.  None of them allow changes to the access grants of a marker.
+var OperatorGrantTemplates = map[string][]sdk.Msg{
+	"mint":     {&MsgMintRequest{}},
+	"burn":     {&MsgBurnRequest{}},
+	"supply":   {&MsgMintRequest{}, &MsgBurnRequest{}},
+	"withdraw": {&MsgWithdrawRequest{}},
+	"status":   {&MsgFinalizeRequest{}, &MsgActivateRequest{}, &MsgCancelRequest{}},
+}
+
+// OperatorGrantTemplateNames returns the sorted names of the operator grant templates.
+func OperatorGrantTemplateNames() []string {
+	names := make([]string, 0, len(OperatorGrantTemplates))
+	for name := range OperatorGrantTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewOperatorGrantAuthorizations returns a generic authorization for each msg of an operator grant template.
+func NewOperatorGrantAuthorizations(template string) ([]authz.Authorization, error) {
+	msgs, found := OperatorGrantTemplates[template]
+	if !found {
+		return nil, fmt.Errorf("unknown operator grant template %s, expected one of: %s",
+			template, strings.Join(OperatorGrantTemplateNames(), ", "))
+	}
+	authorizations := make([]authz.Authorization, len(msgs))
+	for i, msg := range msgs {
+		authorizations[i] = authz.NewGenericAuthorization(sdk.MsgTypeURL(msg))
+	}
+	return authorizations, nil
+}
+
+// IsMarkerMsgTypeURL returns true if the msg type url is for a marker module msg.
+func IsMarkerMsgTypeURL(msgTypeURL string) bool {
+	return strings.HasPrefix(msgTypeURL, "/provenance.marker.v1.Msg")
+}
+
 // NewMarkerTransferAuthorization creates a new MarkerTransferAuthorization object.
-func NewMarkerTransferAuthorization(transferLimit sdk.Coins) *MarkerTransferAuthorization {
+func NewMarkerTransferAuthorization(transferLimit sdk.Coins, allowed []sdk.AccAddress) *MarkerTransferAuthorization {
+	allowedAddrs := make([]string, len(allowed))
+	for i, addr := range allowed {
+		allowedAddrs[i] = addr.String()
+	}
 	return &MarkerTransferAuthorization{
 		TransferLimit: transferLimit,
+		AllowList:     allowedAddrs,
 	}
 }
 
@@ -26,6 +74,9 @@ func (a MarkerTransferAuthorization) MsgTypeURL() string {
 func (a MarkerTransferAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
 	switch msg := msg.(type) {
 	case *MsgTransferRequest:
+		if !a.IsAllowedRecipient(msg.ToAddress) {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot send to %s address", msg.ToAddress)
+		}
 		limitLeft, isNegative := a.DecreaseTransferLimit(msg.Amount)
 		if isNegative {
 			return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
@@ -34,10 +85,10 @@ func (a MarkerTransferAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz
 			if limitLeft.IsZero() {
 				shouldDelete = true
 			}
-			return authz.AcceptResponse{Accept: true, Delete: shouldDelete, Updated: &MarkerTransferAuthorization{TransferLimit: limitLeft}}, nil
+			return authz.AcceptResponse{Accept: true, Delete: shouldDelete, Updated: &MarkerTransferAuthorization{TransferLimit: limitLeft, AllowList: a.AllowList}}, nil
 		}
 		// does not return and an updated transfer limit, this is handled in marker module
-		return authz.AcceptResponse{Accept: true, Delete: false, Updated: &MarkerTransferAuthorization{TransferLimit: a.TransferLimit}}, nil
+		return authz.AcceptResponse{Accept: true, Delete: false, Updated: &MarkerTransferAuthorization{TransferLimit: a.TransferLimit, AllowList: a.AllowList}}, nil
 	default:
 		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch")
 	}
@@ -51,9 +102,32 @@ func (a MarkerTransferAuthorization) ValidateBasic() error {
 	if !a.TransferLimit.IsAllPositive() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit cannot be negitive")
 	}
+	found := make(map[string]bool, len(a.AllowList))
+	for _, addr := range a.AllowList {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allow list address %s: %s", addr, err)
+		}
+		if found[addr] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "duplicate allow list address %s", addr)
+		}
+		found[addr] = true
+	}
 	return nil
 }
 
+// IsAllowedRecipient returns true if the allow list is empty or contains the address.
+func (a MarkerTransferAuthorization) IsAllowedRecipient(addr string) bool {
+	if len(a.AllowList) == 0 {
+		return true
+	}
+	for _, allowed := range a.AllowList {
+		if allowed == addr {
+			return true
+		}
+	}
+	return false
+}
+
 // DecreaseTransferLimit will return the decreased transfer limit and if it is negative
 func (a MarkerTransferAuthorization) DecreaseTransferLimit(amount sdk.Coin) (sdk.Coins, bool) {
 	return a.TransferLimit.SafeSub(sdk.NewCoins(amount))
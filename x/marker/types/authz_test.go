@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkerTransferAuthorizationValidateBasic(t *testing.T) {
+	allowed := testAddress()
+	limit := sdk.NewCoins(sdk.NewInt64Coin("restricted", 10))
+
+	cases := []struct {
+		name   string
+		auth   *MarkerTransferAuthorization
+		errMsg string
+	}{
+		{"no allow list", NewMarkerTransferAuthorization(limit, nil), ""},
+		{"allow list", NewMarkerTransferAuthorization(limit, []sdk.AccAddress{allowed}), ""},
+		{"nil transfer limit", NewMarkerTransferAuthorization(nil, nil), "spend limit cannot be nil: invalid coins"},
+		{
+			"invalid allow list address",
+			&MarkerTransferAuthorization{TransferLimit: limit, AllowList: []string{"invalid"}},
+			"invalid allow list address invalid: decoding bech32 failed: invalid bech32 string length 7: invalid address",
+		},
+		{
+			"duplicate allow list address",
+			NewMarkerTransferAuthorization(limit, []sdk.AccAddress{allowed, allowed}),
+			"duplicate allow list address " + allowed.String() + ": invalid address",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if len(tc.errMsg) > 0 {
+				require.EqualError(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMarkerTransferAuthorizationAccept(t *testing.T) {
+	allowed := testAddress()
+	limit := sdk.NewCoins(sdk.NewInt64Coin("restricted", 10))
+	auth := NewMarkerTransferAuthorization(limit, []sdk.AccAddress{allowed})
+
+	res, err := auth.Accept(sdk.Context{}, &MsgTransferRequest{Amount: sdk.NewInt64Coin("restricted", 5), ToAddress: allowed.String()})
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.Equal(t, &MarkerTransferAuthorization{TransferLimit: limit, AllowList: []string{allowed.String()}}, res.Updated)
+
+	other := testAddress()
+	_, err = auth.Accept(sdk.Context{}, &MsgTransferRequest{Amount: sdk.NewInt64Coin("restricted", 5), ToAddress: other.String()})
+	require.EqualError(t, err, "cannot send to "+other.String()+" address: unauthorized")
+
+	_, err = NewMarkerTransferAuthorization(limit, nil).Accept(sdk.Context{}, &MsgTransferRequest{Amount: sdk.NewInt64Coin("restricted", 5), ToAddress: other.String()})
+	require.NoError(t, err)
+
+	_, err = auth.Accept(sdk.Context{}, &MsgTransferRequest{Amount: sdk.NewInt64Coin("restricted", 11), ToAddress: allowed.String()})
+	require.EqualError(t, err, "requested amount is more than spend limit: insufficient funds")
+}
+
+func TestNewOperatorGrantAuthorizations(t *testing.T) {
+	authorizations, err := NewOperatorGrantAuthorizations("supply")
+	require.NoError(t, err)
+	require.Len(t, authorizations, 2)
+	require.Equal(t, sdk.MsgTypeURL(&MsgMintRequest{}), authorizations[0].MsgTypeURL())
+	require.Equal(t, sdk.MsgTypeURL(&MsgBurnRequest{}), authorizations[1].MsgTypeURL())
+
+	for _, name := range OperatorGrantTemplateNames() {
+		for _, msg := range OperatorGrantTemplates[name] {
+			require.True(t, IsMarkerMsgTypeURL(sdk.MsgTypeURL(msg)), "%s template msg %s", name, sdk.MsgTypeURL(msg))
+			require.NotEqual(t, sdk.MsgTypeURL(&MsgAddAccessRequest{}), sdk.MsgTypeURL(msg), "%s template", name)
+			require.NotEqual(t, sdk.MsgTypeURL(&MsgDeleteAccessRequest{}), sdk.MsgTypeURL(msg), "%s template", name)
+		}
+	}
+
+	_, err = NewOperatorGrantAuthorizations("admin")
+	require.EqualError(t, err, "unknown operator grant template admin, expected one of: burn, mint, status, supply, withdraw")
+}
@@ -55,9 +55,21 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDeleteRequest{},
 		&MsgMintRequest{},
 		&MsgBurnRequest{},
+		&MsgBurnFromRequest{},
 		&MsgWithdrawRequest{},
 		&MsgTransferRequest{},
 		&MsgSetDenomMetadataRequest{},
+		&MsgSetWithdrawAllowanceRequest{},
+		&MsgDeleteWithdrawAllowanceRequest{},
+		&MsgScheduleDistributionRequest{},
+		&MsgClaimDistributionRequest{},
+		&MsgSetFaucetPolicyRequest{},
+		&MsgRemoveFaucetPolicyRequest{},
+		&MsgSetTransferFeeRequest{},
+		&MsgRemoveTransferFeeRequest{},
+		&MsgClaimFaucetRequest{},
+		&MsgSetTermsRequest{},
+		&MsgSetMarkerPausedRequest{},
 	)
 
 	registry.RegisterImplementations(
@@ -70,6 +82,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&ChangeStatusProposal{},
 		&WithdrawEscrowProposal{},
 		&SetDenomMetadataProposal{},
+		&PauseRestrictedTransfersProposal{},
+		&ResumeRestrictedTransfersProposal{},
+		&SetIbcRateLimitProposal{},
+		&RemoveIbcRateLimitProposal{},
+		&MigrateDenomProposal{},
+		&SetTransferFeeProposal{},
+		&RemoveTransferFeeProposal{},
+		&AddToDenyListProposal{},
+		&RemoveFromDenyListProposal{},
 	)
 
 	registry.RegisterImplementations(
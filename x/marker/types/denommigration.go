@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDenomMigration creates a new migration of the holders of a marker denom to a successor marker
+func NewDenomMigration(fromDenom, toDenom string, holdersPerBlock uint32, startHeight int64) *DenomMigration {
+	return &DenomMigration{
+		FromDenom:       fromDenom,
+		ToDenom:         toDenom,
+		HoldersPerBlock: holdersPerBlock,
+		Status:          DenomMigrationStatusSnapshot,
+		StartHeight:     startHeight,
+		FromSupply:      sdk.ZeroInt(),
+		MigratedAmount:  sdk.ZeroInt(),
+	}
+}
+
+// Validate performs a static check over the denom migration format
+func (m DenomMigration) Validate() error {
+	if _, err := MarkerAddress(m.FromDenom); err != nil {
+		return fmt.Errorf("invalid denom %s: %w", m.FromDenom, err)
+	}
+	if _, err := MarkerAddress(m.ToDenom); err != nil {
+		return fmt.Errorf("invalid new denom %s: %w", m.ToDenom, err)
+	}
+	if m.FromDenom == m.ToDenom {
+		return fmt.Errorf("new denom must be different from denom %s", m.FromDenom)
+	}
+	if m.HoldersPerBlock == 0 {
+		return fmt.Errorf("holders per block must be greater than zero")
+	}
+	switch m.Status {
+	case DenomMigrationStatusSnapshot:
+	case DenomMigrationStatusInProgress, DenomMigrationStatusComplete:
+		if len(m.SnapshotNextKey) > 0 {
+			return fmt.Errorf("snapshot next key must be empty once the snapshot is taken")
+		}
+	default:
+		return fmt.Errorf("invalid status %s", m.Status)
+	}
+	if m.FromSupply.IsNil() || m.FromSupply.IsNegative() {
+		return fmt.Errorf("from supply cannot be negative")
+	}
+	if m.MigratedAmount.IsNil() || m.MigratedAmount.IsNegative() {
+		return fmt.Errorf("migrated amount cannot be negative")
+	}
+	if m.MigratedHolders > m.TotalHolders {
+		return fmt.Errorf("migrated holders %d cannot exceed total holders %d", m.MigratedHolders, m.TotalHolders)
+	}
+	return nil
+}
+
+// IsSnapshot returns true if the holders of the old denom are still being recorded
+func (m DenomMigration) IsSnapshot() bool {
+	return m.Status == DenomMigrationStatusSnapshot
+}
+
+// IsComplete returns true if all holders of the migration have been migrated
+func (m DenomMigration) IsComplete() bool {
+	return m.Status == DenomMigrationStatusComplete
+}
+
+// IsReconciled returns true if the migration is complete and the holders were minted exactly the old denom supply at
+// the start of the migration
+func (m DenomMigration) IsReconciled() bool {
+	return m.IsComplete() && m.MigratedAmount.Equal(m.FromSupply)
+}
+
+// Validate performs a static check over the denom migration holder format
+func (h DenomMigrationHolder) Validate() error {
+	if _, err := MarkerAddress(h.FromDenom); err != nil {
+		return fmt.Errorf("invalid denom %s: %w", h.FromDenom, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(h.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if h.Amount.IsNil() || !h.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive")
+	}
+	return nil
+}
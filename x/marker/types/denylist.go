@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxDenyListReasonLength is the longest reason an address can be denied with
+const MaxDenyListReasonLength = 256
+
+// NewDenyListEntry creates a new entry denying an address transfers of restricted markers
+func NewDenyListEntry(address, reason string, addedHeight int64) *DenyListEntry {
+	return &DenyListEntry{
+		Address:     address,
+		Reason:      reason,
+		AddedHeight: addedHeight,
+	}
+}
+
+// Validate performs a static check over the deny list entry format
+func (e DenyListEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return fmt.Errorf("invalid address %s: %w", e.Address, err)
+	}
+	if len(e.Reason) > MaxDenyListReasonLength {
+		return fmt.Errorf("reason cannot be longer than %d characters", MaxDenyListReasonLength)
+	}
+	if e.AddedHeight < 0 {
+		return fmt.Errorf("added height cannot be negative")
+	}
+	return nil
+}
+
+// validateDenyListAddresses checks that a list of addresses to add to or remove from the deny list is not empty and
+// has no invalid or duplicate addresses.
+func validateDenyListAddresses(addresses []string) error {
+	if len(addresses) == 0 {
+		return fmt.Errorf("at least one address is required")
+	}
+	seen := make(map[string]bool)
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid address %s: %w", address, err)
+		}
+		if seen[address] {
+			return fmt.Errorf("duplicate address %s", address)
+		}
+		seen[address] = true
+	}
+	return nil
+}
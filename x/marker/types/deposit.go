@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxEscrowDeposits is the number of the most recent escrow deposits recorded for each marker.  Older deposits are
+// pruned from the store but remain available from their EventMarkerEscrowDeposit events.
+const MaxEscrowDeposits = 100
+
+// NewEscrowDeposit creates a new record of coin sent directly to a marker escrow account
+func NewEscrowDeposit(denom string, fromAddress string, amount sdk.Coins, height int64) *EscrowDeposit {
+	return &EscrowDeposit{
+		Denom:       denom,
+		FromAddress: fromAddress,
+		Amount:      amount,
+		Height:      height,
+	}
+}
+
+// Validate performs a static check over the escrow deposit format
+func (ed EscrowDeposit) Validate() error {
+	if _, err := MarkerAddress(ed.Denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %w", ed.Denom, err)
+	}
+	if len(ed.FromAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(ed.FromAddress); err != nil {
+			return fmt.Errorf("invalid from address: %w", err)
+		}
+	}
+	if err := ed.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if ed.Amount.IsZero() {
+		return fmt.Errorf("amount must not be zero")
+	}
+	if ed.Height < 0 {
+		return fmt.Errorf("height must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDistribution creates a new distribution of a payout to the holders of a marker denom at a snapshot height
+func NewDistribution(
+	id uint64, denom string, admin sdk.AccAddress, payout sdk.Coin, snapshotHeight, claimEndHeight int64, // nolint:interfacer
+) *Distribution {
+	return &Distribution{
+		Id:             id,
+		Denom:          denom,
+		Administrator:  admin.String(),
+		Payout:         payout,
+		SnapshotHeight: snapshotHeight,
+		ClaimEndHeight: claimEndHeight,
+		Status:         DistributionStatusScheduled,
+		SnapshotTotal:  sdk.ZeroInt(),
+		Claimed:        sdk.NewCoin(payout.Denom, sdk.ZeroInt()),
+	}
+}
+
+// Validate performs a static check over the distribution format
+func (d Distribution) Validate() error {
+	if d.Id == 0 {
+		return fmt.Errorf("distribution id cannot be zero")
+	}
+	if _, err := MarkerAddress(d.Denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %w", d.Denom, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	if err := d.Payout.Validate(); err != nil {
+		return fmt.Errorf("invalid payout: %w", err)
+	}
+	if !d.Payout.IsPositive() {
+		return fmt.Errorf("payout must be positive")
+	}
+	if d.SnapshotHeight <= 0 {
+		return fmt.Errorf("snapshot height must be greater than zero")
+	}
+	if d.ClaimEndHeight <= d.SnapshotHeight {
+		return fmt.Errorf("claim end height %d must be after snapshot height %d", d.ClaimEndHeight, d.SnapshotHeight)
+	}
+	if d.Status != DistributionStatusScheduled && d.Status != DistributionStatusClaimable {
+		return fmt.Errorf("invalid distribution status %s", d.Status)
+	}
+	if d.SnapshotTotal.IsNil() || d.SnapshotTotal.IsNegative() {
+		return fmt.Errorf("snapshot total cannot be negative")
+	}
+	if err := d.Claimed.Validate(); err != nil {
+		return fmt.Errorf("invalid claimed amount: %w", err)
+	}
+	if d.Claimed.Denom != d.Payout.Denom || d.Payout.IsLT(d.Claimed) {
+		return fmt.Errorf("claimed amount %s exceeds payout %s", d.Claimed, d.Payout)
+	}
+	return nil
+}
+
+// Share returns the part of the payout owed to a holder of the balance at the snapshot.  Shares are rounded down so
+// the sum of all shares never exceeds the payout.
+func (d Distribution) Share(balance sdk.Int) sdk.Coin {
+	if !d.SnapshotTotal.IsPositive() {
+		return sdk.NewCoin(d.Payout.Denom, sdk.ZeroInt())
+	}
+	return sdk.NewCoin(d.Payout.Denom, d.Payout.Amount.Mul(balance).Quo(d.SnapshotTotal))
+}
+
+// Unclaimed returns the part of the payout that has not been claimed.
+func (d Distribution) Unclaimed() sdk.Coin {
+	return d.Payout.Sub(d.Claimed)
+}
+
+// Validate performs a static check over the distribution entitlement format
+func (e DistributionEntitlement) Validate() error {
+	if e.DistributionId == 0 {
+		return fmt.Errorf("distribution id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if e.Balance.IsNil() || !e.Balance.IsPositive() {
+		return fmt.Errorf("balance must be positive")
+	}
+	if err := e.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	return nil
+}
@@ -12,4 +12,20 @@ var (
 	ErrInvalidMarkerStatus     = sdkerrors.Register(ModuleName, 5, "invalid marker status")
 	ErrAccessTypeNotGranted    = sdkerrors.Register(ModuleName, 6, "access type not granted")
 	ErrMarkerNotFound          = sdkerrors.Register(ModuleName, 7, "marker not found")
+	ErrTransfersPaused         = sdkerrors.Register(ModuleName, 8, "restricted marker transfers are paused")
+	ErrAccessExpired           = sdkerrors.Register(ModuleName, 9, "access grant has expired")
+	ErrIbcRateLimitExceeded    = sdkerrors.Register(ModuleName, 10, "ibc rate limit exceeded")
+	ErrWithdrawAllowance       = sdkerrors.Register(ModuleName, 11, "withdraw allowance exceeded")
+	ErrOrphanedMarker          = sdkerrors.Register(ModuleName, 12, "active marker would be left without administrative access")
+	ErrDistributionNotFound    = sdkerrors.Register(ModuleName, 13, "distribution not found")
+	ErrNoEntitlement           = sdkerrors.Register(ModuleName, 14, "no unclaimed distribution entitlement")
+	ErrFaucetPolicyNotFound    = sdkerrors.Register(ModuleName, 15, "faucet policy not found")
+	ErrFaucetNotQualified      = sdkerrors.Register(ModuleName, 16, "address does not qualify for faucet")
+	ErrFaucetCooldown          = sdkerrors.Register(ModuleName, 17, "faucet claim cooldown has not elapsed")
+	ErrDenomMigrated           = sdkerrors.Register(ModuleName, 18, "marker denom has been migrated")
+	ErrTransferFeeNotFound     = sdkerrors.Register(ModuleName, 19, "transfer fee not found")
+	ErrInvalidTerms            = sdkerrors.Register(ModuleName, 20, "invalid marker terms")
+	ErrDenyListed              = sdkerrors.Register(ModuleName, 21, "address is on the restricted marker deny list")
+	ErrMarkerPaused            = sdkerrors.Register(ModuleName, 22, "marker is paused")
+	ErrReferenceMarker         = sdkerrors.Register(ModuleName, 23, "supply of reference marker is managed outside of the marker module")
 )
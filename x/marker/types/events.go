@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -35,6 +36,14 @@ const (
 	EventTelemetryKeyTransfer string = "transfer"
 	// EventTelemetryKeyWithdraw withdraw telemetry metrics key
 	EventTelemetryKeyWithdraw string = "withdraw"
+	// EventTelemetryLabelStatus marker status label for telemetry metrics
+	EventTelemetryLabelStatus string = "status"
+	// EventTelemetryKeySupply supply telemetry metrics key
+	EventTelemetryKeySupply string = "supply"
+	// EventTelemetryKeyAmount amount telemetry metrics key
+	EventTelemetryKeyAmount string = "amount"
+	// EventTelemetryKeyMarkers marker count telemetry metrics key
+	EventTelemetryKeyMarkers string = "markers"
 )
 
 func NewEventMarkerAdd(denom string, amount string, status string, manager string, markerType string) *EventMarkerAdd {
@@ -74,10 +83,11 @@ func NewEventMarkerDeleteAccess(removeAddress string, denom string, administrato
 	}
 }
 
-func NewEventMarkerFinalize(denom string, administrator string) *EventMarkerFinalize {
+func NewEventMarkerFinalize(denom string, administrator string, summary *FinalizeValidationSummary) *EventMarkerFinalize {
 	return &EventMarkerFinalize{
 		Denom:         denom,
 		Administrator: administrator,
+		Summary:       summary,
 	}
 }
 
@@ -118,6 +128,15 @@ func NewEventMarkerBurn(amount string, denom string, administrator string) *Even
 	}
 }
 
+func NewEventMarkerBurnFrom(amount string, denom string, administrator string, fromAddress string) *EventMarkerBurnFrom {
+	return &EventMarkerBurnFrom{
+		Amount:        amount,
+		Denom:         denom,
+		Administrator: administrator,
+		FromAddress:   fromAddress,
+	}
+}
+
 func NewEventMarkerWithdraw(coins string, denom string, administrator string, toAddress string) *EventMarkerWithdraw {
 	return &EventMarkerWithdraw{
 		Coins:         coins,
@@ -137,6 +156,22 @@ func NewEventMarkerTransfer(amount string, denom string, administrator string, t
 	}
 }
 
+func NewEventMarkerAccessExpired(denom string, address string, expiration string) *EventMarkerAccessExpired {
+	return &EventMarkerAccessExpired{
+		Denom:      denom,
+		Address:    address,
+		Expiration: expiration,
+	}
+}
+
+func NewEventMarkerEscrowDeposit(coins string, denom string, fromAddress string) *EventMarkerEscrowDeposit {
+	return &EventMarkerEscrowDeposit{
+		Coins:       coins,
+		Denom:       denom,
+		FromAddress: fromAddress,
+	}
+}
+
 func NewEventMarkerSetDenomMetadata(metadata banktypes.Metadata, administrator string) *EventMarkerSetDenomMetadata {
 	metadataDenomUnits := make([]*EventDenomUnit, len(metadata.DenomUnits))
 	for i, du := range metadata.DenomUnits {
@@ -157,3 +192,215 @@ func NewEventMarkerSetDenomMetadata(metadata banktypes.Metadata, administrator s
 		Administrator:       administrator,
 	}
 }
+
+func NewEventMarkerRemoved(denom string) *EventMarkerRemoved {
+	return &EventMarkerRemoved{
+		Denom: denom,
+	}
+}
+
+func NewEventMarkerTransfersPaused(pause TransferPause) *EventMarkerTransfersPaused {
+	return &EventMarkerTransfersPaused{
+		Denoms:       pause.Denoms,
+		ExpiryHeight: pause.ExpiryHeight,
+		HaltSupply:   pause.HaltSupply,
+		Global:       pause.Global,
+	}
+}
+
+func NewEventMarkerTransfersResumed() *EventMarkerTransfersResumed {
+	return &EventMarkerTransfersResumed{}
+}
+
+func NewEventMarkerPausedSet(denom string, paused bool, administrator string) *EventMarkerPausedSet {
+	return &EventMarkerPausedSet{
+		Denom:         denom,
+		Paused:        paused,
+		Administrator: administrator,
+	}
+}
+
+func NewEventMarkerDenyListAdded(address, reason string) *EventMarkerDenyListAdded {
+	return &EventMarkerDenyListAdded{
+		Address: address,
+		Reason:  reason,
+	}
+}
+
+func NewEventMarkerDenyListRemoved(address string) *EventMarkerDenyListRemoved {
+	return &EventMarkerDenyListRemoved{
+		Address: address,
+	}
+}
+
+func NewEventMarkerIbcRateLimitSet(limit IbcRateLimit) *EventMarkerIbcRateLimitSet {
+	return &EventMarkerIbcRateLimitSet{
+		Denom:          limit.Denom,
+		ChannelId:      limit.ChannelId,
+		MaxPercentSend: limit.MaxPercentSend,
+		MaxPercentRecv: limit.MaxPercentRecv,
+		DurationHours:  limit.DurationHours,
+	}
+}
+
+func NewEventMarkerIbcRateLimitRemoved(denom, channelID string) *EventMarkerIbcRateLimitRemoved {
+	return &EventMarkerIbcRateLimitRemoved{
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+func NewEventMarkerWithdrawAllowanceSet(allowance WithdrawAllowance, administrator string) *EventMarkerWithdrawAllowanceSet {
+	return &EventMarkerWithdrawAllowanceSet{
+		Denom:         allowance.Denom,
+		Administrator: administrator,
+		Grantee:       allowance.Grantee,
+		MaxAmount:     allowance.MaxAmount.String(),
+		Period:        allowance.Period.String(),
+	}
+}
+
+func NewEventMarkerWithdrawAllowanceDeleted(denom, administrator, grantee string) *EventMarkerWithdrawAllowanceDeleted {
+	return &EventMarkerWithdrawAllowanceDeleted{
+		Denom:         denom,
+		Administrator: administrator,
+		Grantee:       grantee,
+	}
+}
+
+func NewEventMarkerDistributionScheduled(d Distribution) *EventMarkerDistributionScheduled {
+	return &EventMarkerDistributionScheduled{
+		DistributionId: d.Id,
+		Denom:          d.Denom,
+		Administrator:  d.Administrator,
+		Payout:         d.Payout.String(),
+		SnapshotHeight: d.SnapshotHeight,
+		ClaimEndHeight: d.ClaimEndHeight,
+	}
+}
+
+func NewEventMarkerDistributionSnapshot(d Distribution, holders uint64) *EventMarkerDistributionSnapshot {
+	return &EventMarkerDistributionSnapshot{
+		DistributionId: d.Id,
+		Denom:          d.Denom,
+		Holders:        holders,
+		SnapshotTotal:  d.SnapshotTotal.String(),
+	}
+}
+
+func NewEventMarkerDistributionClaimed(d Distribution, claimant string, amount sdk.Coin) *EventMarkerDistributionClaimed {
+	return &EventMarkerDistributionClaimed{
+		DistributionId: d.Id,
+		Denom:          d.Denom,
+		Claimant:       claimant,
+		Amount:         amount.String(),
+	}
+}
+
+func NewEventMarkerDistributionClosed(d Distribution, returned sdk.Coin) *EventMarkerDistributionClosed {
+	return &EventMarkerDistributionClosed{
+		DistributionId: d.Id,
+		Denom:          d.Denom,
+		Returned:       returned.String(),
+	}
+}
+
+func NewEventMarkerParamsUpdated(params Params) *EventMarkerParamsUpdated {
+	return &EventMarkerParamsUpdated{
+		MaxTotalSupply:         fmt.Sprint(params.MaxTotalSupply),
+		EnableGovernance:       params.EnableGovernance,
+		UnrestrictedDenomRegex: params.UnrestrictedDenomRegex,
+		EmitLegacyEvents:       params.EmitLegacyEvents,
+	}
+}
+
+func NewEventMarkerFaucetPolicySet(policy FaucetPolicy) *EventMarkerFaucetPolicySet {
+	return &EventMarkerFaucetPolicySet{
+		Denom:             policy.Denom,
+		Administrator:     policy.Administrator,
+		Amount:            policy.Amount.String(),
+		RequiredAttribute: policy.RequiredAttribute,
+		Cooldown:          policy.Cooldown.String(),
+	}
+}
+
+func NewEventMarkerFaucetPolicyRemoved(denom, administrator string) *EventMarkerFaucetPolicyRemoved {
+	return &EventMarkerFaucetPolicyRemoved{
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+func NewEventMarkerFaucetClaimed(denom, claimant string, amount sdk.Coin) *EventMarkerFaucetClaimed {
+	return &EventMarkerFaucetClaimed{
+		Denom:    denom,
+		Claimant: claimant,
+		Amount:   amount.String(),
+	}
+}
+
+func NewEventMarkerDenomMigrationStarted(migration DenomMigration) *EventMarkerDenomMigrationStarted {
+	return &EventMarkerDenomMigrationStarted{
+		FromDenom:    migration.FromDenom,
+		ToDenom:      migration.ToDenom,
+		TotalHolders: migration.TotalHolders,
+		FromSupply:   migration.FromSupply.String(),
+	}
+}
+
+func NewEventMarkerDenomMigrated(migration DenomMigration, address string, amount sdk.Coin) *EventMarkerDenomMigrated {
+	return &EventMarkerDenomMigrated{
+		FromDenom: migration.FromDenom,
+		ToDenom:   migration.ToDenom,
+		Address:   address,
+		Amount:    amount.String(),
+	}
+}
+
+func NewEventMarkerDenomMigrationCompleted(migration DenomMigration) *EventMarkerDenomMigrationCompleted {
+	return &EventMarkerDenomMigrationCompleted{
+		FromDenom:       migration.FromDenom,
+		ToDenom:         migration.ToDenom,
+		MigratedHolders: migration.MigratedHolders,
+		MigratedAmount:  migration.MigratedAmount.String(),
+	}
+}
+
+func NewEventMarkerTransferFeeSet(fee TransferFee, administrator string) *EventMarkerTransferFeeSet {
+	event := &EventMarkerTransferFeeSet{
+		Denom:         fee.Denom,
+		Administrator: administrator,
+		BasisPoints:   fee.BasisPoints,
+		Recipient:     fee.Recipient,
+	}
+	if fee.BasisPoints == 0 {
+		event.FlatFee = fee.FlatFee.String()
+	}
+	return event
+}
+
+func NewEventMarkerTransferFeeRemoved(denom, administrator string) *EventMarkerTransferFeeRemoved {
+	return &EventMarkerTransferFeeRemoved{
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+func NewEventMarkerTransferFeeCollected(denom, from, recipient string, fee sdk.Coin) *EventMarkerTransferFeeCollected {
+	return &EventMarkerTransferFeeCollected{
+		Denom:     denom,
+		From:      from,
+		Recipient: recipient,
+		Fee:       fee.String(),
+	}
+}
+
+func NewEventMarkerTermsSet(entry MarkerTermsEntry) *EventMarkerTermsSet {
+	return &EventMarkerTermsSet{
+		Denom:         entry.Denom,
+		Administrator: entry.Administrator,
+		TermsHash:     entry.TermsHash,
+		Uri:           entry.Uri,
+		Sequence:      entry.Sequence,
+	}
+}
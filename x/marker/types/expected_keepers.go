@@ -1,9 +1,15 @@
 package types
 
 import (
+	"context"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 )
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -12,6 +18,9 @@ type AccountKeeper interface {
 	GetAccount(sdk.Context, sdk.AccAddress) authtypes.AccountI
 	SetAccount(sdk.Context, authtypes.AccountI)
 	NewAccount(sdk.Context, authtypes.AccountI) authtypes.AccountI
+
+	// Used to record the holders of a migrated denom a page of accounts at a time.
+	Accounts(context.Context, *authtypes.QueryAccountsRequest) (*authtypes.QueryAccountsResponse, error)
 }
 
 // BankKeeper defines the expected bank keeper (keeper, sendkeeper, viewkeeper) (noalias)
@@ -53,3 +62,19 @@ type BankKeeper interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
+
+// AttributeKeeper defines the expected attribute keeper used to check the attributes of faucet claimants (noalias)
+type AttributeKeeper interface {
+	GetAttributes(ctx sdk.Context, acc sdk.AccAddress, name string) ([]attrtypes.Attribute, error)
+}
+
+// GovKeeper defines the expected gov keeper used to read the pending governance proposals (noalias)
+type GovKeeper interface {
+	IterateInactiveProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal govtypes.Proposal) (stop bool))
+	IterateActiveProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal govtypes.Proposal) (stop bool))
+}
+
+// FeatureFlagKeeper defines the expected feature flag keeper used to gate new consensus behaviors (noalias)
+type FeatureFlagKeeper interface {
+	IsFeatureActive(ctx sdk.Context, name string) bool
+}
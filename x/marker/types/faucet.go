@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFaucetPolicy creates a new policy letting addresses with the required attribute claim an amount of marker coin
+func NewFaucetPolicy(
+	denom string, admin sdk.AccAddress, amount sdk.Coin, requiredAttribute string, cooldown time.Duration, // nolint:interfacer
+) *FaucetPolicy {
+	return &FaucetPolicy{
+		Denom:             denom,
+		Administrator:     admin.String(),
+		Amount:            amount,
+		RequiredAttribute: requiredAttribute,
+		Cooldown:          cooldown,
+	}
+}
+
+// Validate performs a static check over the faucet policy format
+func (p FaucetPolicy) Validate() error {
+	if _, err := MarkerAddress(p.Denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %w", p.Denom, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	if err := p.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if p.Amount.Denom != p.Denom {
+		return fmt.Errorf("amount denom %s does not match marker denom %s", p.Amount.Denom, p.Denom)
+	}
+	if !p.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive")
+	}
+	if len(strings.TrimSpace(p.RequiredAttribute)) == 0 {
+		return fmt.Errorf("required attribute cannot be empty")
+	}
+	if p.Cooldown < 0 {
+		return fmt.Errorf("cooldown cannot be negative")
+	}
+	return nil
+}
+
+// NextClaimTime returns the earliest time an address that last claimed at the given time can claim again.  The second
+// return value is false if the policy only allows a single claim per address.
+func (p FaucetPolicy) NextClaimTime(lastClaim time.Time) (time.Time, bool) {
+	if p.Cooldown == 0 {
+		return time.Time{}, false
+	}
+	return lastClaim.Add(p.Cooldown), true
+}
+
+// Validate performs a static check over the faucet claim format
+func (c FaucetClaim) Validate() error {
+	if _, err := MarkerAddress(c.Denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %w", c.Denom, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	return nil
+}
@@ -2,15 +2,18 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(params Params, markers []MarkerAccount) *GenesisState {
 	return &GenesisState{
-		Params:  params,
-		Markers: markers,
+		Params:             params,
+		Markers:            markers,
+		NextDistributionId: 1,
 	}
 }
 
@@ -21,6 +24,138 @@ func (state GenesisState) Validate() error {
 			return err
 		}
 	}
+	if state.TransferPause != nil {
+		if err := state.TransferPause.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer pause: %w", err)
+		}
+	}
+	for _, d := range state.EscrowDeposits {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("invalid escrow deposit: %w", err)
+		}
+	}
+	seen := make(map[string]bool)
+	for _, l := range state.IbcRateLimits {
+		if err := l.Validate(); err != nil {
+			return fmt.Errorf("invalid ibc rate limit: %w", err)
+		}
+		key := string(IbcRateLimitKey(l.Denom, l.ChannelId))
+		if seen[key] {
+			return fmt.Errorf("duplicate ibc rate limit of %s on %s", l.Denom, l.ChannelId)
+		}
+		seen[key] = true
+	}
+	for _, a := range state.WithdrawAllowances {
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("invalid withdraw allowance: %w", err)
+		}
+	}
+	distributions := make(map[uint64]Distribution)
+	for _, d := range state.Distributions {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("invalid distribution: %w", err)
+		}
+		if _, found := distributions[d.Id]; found {
+			return fmt.Errorf("duplicate distribution id %d", d.Id)
+		}
+		if d.Id >= state.NextDistributionId {
+			return fmt.Errorf("distribution id %d is not less than next distribution id %d", d.Id, state.NextDistributionId)
+		}
+		distributions[d.Id] = d
+	}
+	for _, e := range state.DistributionEntitlements {
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("invalid distribution entitlement: %w", err)
+		}
+		d, found := distributions[e.DistributionId]
+		if !found || d.Status != DistributionStatusClaimable {
+			return fmt.Errorf("distribution entitlement of %s for %d has no claimable distribution", e.Address, e.DistributionId)
+		}
+	}
+	policies := make(map[string]bool)
+	for _, p := range state.FaucetPolicies {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("invalid faucet policy: %w", err)
+		}
+		if policies[p.Denom] {
+			return fmt.Errorf("duplicate faucet policy for %s", p.Denom)
+		}
+		policies[p.Denom] = true
+	}
+	for _, c := range state.FaucetClaims {
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("invalid faucet claim: %w", err)
+		}
+	}
+	migrations := make(map[string]DenomMigration)
+	for _, m := range state.DenomMigrations {
+		if err := m.Validate(); err != nil {
+			return fmt.Errorf("invalid denom migration: %w", err)
+		}
+		if _, found := migrations[m.FromDenom]; found {
+			return fmt.Errorf("duplicate denom migration for %s", m.FromDenom)
+		}
+		migrations[m.FromDenom] = m
+	}
+	for _, h := range state.DenomMigrationHolders {
+		if err := h.Validate(); err != nil {
+			return fmt.Errorf("invalid denom migration holder: %w", err)
+		}
+		m, found := migrations[h.FromDenom]
+		if !found || m.IsComplete() {
+			return fmt.Errorf("denom migration holder %s of %s has no denom migration in progress", h.Address, h.FromDenom)
+		}
+	}
+	fees := make(map[string]bool)
+	for _, f := range state.TransferFees {
+		if err := f.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer fee: %w", err)
+		}
+		if fees[f.Denom] {
+			return fmt.Errorf("duplicate transfer fee for %s", f.Denom)
+		}
+		fees[f.Denom] = true
+	}
+	sequences := make(map[string]uint64)
+	for _, e := range state.TermsHistory {
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("invalid terms entry: %w", err)
+		}
+		if e.Sequence != sequences[e.Denom]+1 {
+			return fmt.Errorf("terms entry %d of %s is out of sequence", e.Sequence, e.Denom)
+		}
+		sequences[e.Denom] = e.Sequence
+	}
+	managementSequences := make(map[string]uint64)
+	for _, e := range state.ManagementHistory {
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("invalid management entry: %w", err)
+		}
+		if e.Sequence != managementSequences[e.Denom]+1 {
+			return fmt.Errorf("management entry %d of %s is out of sequence", e.Sequence, e.Denom)
+		}
+		managementSequences[e.Denom] = e.Sequence
+	}
+	denied := make(map[string]bool)
+	for _, e := range state.DenyList {
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("invalid deny list entry: %w", err)
+		}
+		if denied[e.Address] {
+			return fmt.Errorf("duplicate deny list entry for %s", e.Address)
+		}
+		denied[e.Address] = true
+	}
+	paused := make(map[string]bool)
+	for _, denom := range state.PausedMarkers {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid paused marker: %w", err)
+		}
+		if paused[denom] {
+			return fmt.Errorf("duplicate paused marker %s", denom)
+		}
+		paused[denom] = true
+	}
 	return nil
 }
 
@@ -29,6 +29,38 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// A collection of marker accounts to create on start
 	Markers []MarkerAccount `protobuf:"bytes,2,rep,name=markers,proto3" json:"markers"`
+	// An optional pause of restricted marker transfers
+	TransferPause *TransferPause `protobuf:"bytes,3,opt,name=transfer_pause,json=transferPause,proto3" json:"transfer_pause,omitempty" yaml:"transfer_pause"`
+	// Coin sent directly to marker escrow accounts with bank sends
+	EscrowDeposits []EscrowDeposit `protobuf:"bytes,4,rep,name=escrow_deposits,json=escrowDeposits,proto3" json:"escrow_deposits" yaml:"escrow_deposits"`
+	// Governance controlled quotas on the ibc transfer flow of marker denoms
+	IbcRateLimits []IbcRateLimit `protobuf:"bytes,5,rep,name=ibc_rate_limits,json=ibcRateLimits,proto3" json:"ibc_rate_limits" yaml:"ibc_rate_limits"`
+	// Limits on the coin addresses with withdraw access can withdraw from markers
+	WithdrawAllowances []WithdrawAllowance `protobuf:"bytes,6,rep,name=withdraw_allowances,json=withdrawAllowances,proto3" json:"withdraw_allowances" yaml:"withdraw_allowances"`
+	// Scheduled and claimable distributions to the holders of markers
+	Distributions []Distribution `protobuf:"bytes,7,rep,name=distributions,proto3" json:"distributions"`
+	// The unclaimed shares of claimable distributions
+	DistributionEntitlements []DistributionEntitlement `protobuf:"bytes,8,rep,name=distribution_entitlements,json=distributionEntitlements,proto3" json:"distribution_entitlements" yaml:"distribution_entitlements"`
+	// The identifier of the next distribution
+	NextDistributionId uint64 `protobuf:"varint,9,opt,name=next_distribution_id,json=nextDistributionId,proto3" json:"next_distribution_id,omitempty" yaml:"next_distribution_id"`
+	// Policies letting qualified addresses claim marker coin
+	FaucetPolicies []FaucetPolicy `protobuf:"bytes,10,rep,name=faucet_policies,json=faucetPolicies,proto3" json:"faucet_policies" yaml:"faucet_policies"`
+	// The last claim times of addresses that claimed from marker faucets
+	FaucetClaims []FaucetClaim `protobuf:"bytes,11,rep,name=faucet_claims,json=faucetClaims,proto3" json:"faucet_claims" yaml:"faucet_claims"`
+	// Migrations of marker denoms to successor markers
+	DenomMigrations []DenomMigration `protobuf:"bytes,12,rep,name=denom_migrations,json=denomMigrations,proto3" json:"denom_migrations" yaml:"denom_migrations"`
+	// The holders of migrating denoms waiting to be migrated
+	DenomMigrationHolders []DenomMigrationHolder `protobuf:"bytes,13,rep,name=denom_migration_holders,json=denomMigrationHolders,proto3" json:"denom_migration_holders" yaml:"denom_migration_holders"`
+	// Fees charged on the transfers of restricted markers
+	TransferFees []TransferFee `protobuf:"bytes,14,rep,name=transfer_fees,json=transferFees,proto3" json:"transfer_fees" yaml:"transfer_fees"`
+	// The timelines of the terms documents anchored to markers
+	TermsHistory []MarkerTermsEntry `protobuf:"bytes,15,rep,name=terms_history,json=termsHistory,proto3" json:"terms_history" yaml:"terms_history"`
+	// The addresses denied transfers of restricted markers
+	DenyList []DenyListEntry `protobuf:"bytes,16,rep,name=deny_list,json=denyList,proto3" json:"deny_list" yaml:"deny_list"`
+	// The denoms of the markers paused by their administrators
+	PausedMarkers []string `protobuf:"bytes,17,rep,name=paused_markers,json=pausedMarkers,proto3" json:"paused_markers,omitempty" yaml:"paused_markers"`
+	// The timelines of the addresses managing markers
+	ManagementHistory []MarkerManagementEntry `protobuf:"bytes,19,rep,name=management_history,json=managementHistory,proto3" json:"management_history" yaml:"management_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -73,23 +105,60 @@ func init() {
 }
 
 var fileDescriptor_5dcc4ab7c9d2f78f = []byte{
-	// 251 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd2, 0x2f, 0x33,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x41, 0xa8, 0xd1, 0x83, 0xa8, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb1, 0x9a, 0x07, 0xd5, 0x05, 0x56, 0xa2, 0x34, 0x93,
-	0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x41, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15, 0x17, 0x5b, 0x41,
-	0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x8c, 0x1e, 0x36, 0x0b,
-	0xf5, 0x02, 0xc0, 0x6a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea, 0x10, 0x72, 0xe6,
-	0x62, 0x87, 0xa8, 0x28, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc6, 0xae, 0xd9, 0x17,
-	0xcc, 0x72, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x81, 0x9a, 0x01, 0xd3, 0x69, 0xc5, 0xd1, 0xb1,
-	0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79, 0x06, 0xa7, 0xf4, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0xe0, 0x12, 0xcf, 0xcc, 0xc7, 0x6a, 0x72, 0x00, 0x63, 0x94, 0x51, 0x7a, 0x66, 0x49,
-	0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x42, 0x89, 0x6e, 0x66, 0x3e, 0x12, 0x4f, 0xbf,
-	0x02, 0x16, 0x1c, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xb0, 0x30, 0x06, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0xa8, 0x55, 0x2c, 0xc0, 0x80, 0x01, 0x00, 0x00,
+	// 843 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xc1, 0x6e, 0xdb, 0x36,
+	0x18, 0xc7, 0xad, 0x25, 0x4b, 0x13, 0x26, 0xb6, 0x53, 0x36, 0x5d, 0x94, 0xac, 0x90, 0x1c, 0x6e,
+	0xe8, 0x8c, 0x0d, 0xb5, 0xd1, 0xec, 0x96, 0xd3, 0xaa, 0x26, 0x5d, 0x0b, 0x34, 0x43, 0xc6, 0x15,
+	0x18, 0xd0, 0x8b, 0x40, 0x4b, 0xb4, 0xc3, 0x55, 0x12, 0x05, 0x91, 0x8e, 0x6b, 0x60, 0xbb, 0x0f,
+	0x3b, 0xed, 0xb0, 0x07, 0xe8, 0xe3, 0xf4, 0x58, 0xec, 0xb4, 0x53, 0x30, 0x24, 0x97, 0x9d, 0xf3,
+	0x04, 0x83, 0x48, 0x3a, 0x96, 0x15, 0x45, 0xdb, 0x4d, 0xa2, 0x7f, 0xdf, 0xff, 0x47, 0x7d, 0x92,
+	0x3f, 0x02, 0x94, 0x66, 0xfc, 0x8c, 0x26, 0x24, 0x09, 0x68, 0x3f, 0x26, 0xd9, 0x1b, 0x9a, 0xf5,
+	0xcf, 0x1e, 0xf7, 0x47, 0x34, 0xa1, 0x82, 0x89, 0x5e, 0x9a, 0x71, 0xc9, 0xe1, 0xd6, 0x9c, 0xe9,
+	0x69, 0xa6, 0x77, 0xf6, 0x78, 0x77, 0x6b, 0xc4, 0x47, 0x5c, 0x01, 0xfd, 0xfc, 0x4a, 0xb3, 0xbb,
+	0x7b, 0x95, 0x79, 0xa6, 0x4a, 0x21, 0xe8, 0xcf, 0x16, 0xd8, 0xf8, 0x56, 0x0b, 0x7e, 0x90, 0x44,
+	0x52, 0x78, 0x00, 0x56, 0x52, 0x92, 0x91, 0x58, 0xd8, 0x56, 0xc7, 0xea, 0xae, 0xef, 0x3f, 0xe8,
+	0x55, 0x09, 0x7b, 0x27, 0x8a, 0xf1, 0x96, 0xdf, 0x9f, 0xbb, 0x0d, 0x6c, 0x2a, 0xe0, 0x53, 0x70,
+	0x47, 0x13, 0xc2, 0xfe, 0xa8, 0xb3, 0xd4, 0x5d, 0xdf, 0xff, 0xac, 0xba, 0xf8, 0x58, 0x5d, 0x3d,
+	0x09, 0x02, 0x3e, 0x4e, 0xa4, 0xc9, 0x98, 0x55, 0x42, 0x0a, 0x5a, 0x32, 0x23, 0x89, 0x18, 0xd2,
+	0xcc, 0x4f, 0xc9, 0x58, 0x50, 0x7b, 0xa9, 0x63, 0xdd, 0x9e, 0xf5, 0xca, 0xb0, 0x27, 0x39, 0xea,
+	0xed, 0x5c, 0x9d, 0xbb, 0xf7, 0xa7, 0x24, 0x8e, 0x0e, 0xd0, 0x62, 0x08, 0xc2, 0x4d, 0x59, 0x24,
+	0x61, 0x04, 0xda, 0x54, 0x04, 0x19, 0x9f, 0xf8, 0x21, 0x4d, 0xb9, 0x60, 0x52, 0xd8, 0xcb, 0x75,
+	0x7b, 0x3e, 0x52, 0xf0, 0xa1, 0x66, 0x3d, 0x27, 0xdf, 0xf3, 0xd5, 0xb9, 0xfb, 0x89, 0x76, 0x95,
+	0x92, 0x10, 0x6e, 0xd1, 0x22, 0x2e, 0xe0, 0x4f, 0xa0, 0xcd, 0x06, 0x81, 0x9f, 0x11, 0x49, 0xfd,
+	0x88, 0xc5, 0xb9, 0xed, 0x63, 0x65, 0x43, 0xd5, 0xb6, 0x17, 0x83, 0x00, 0x13, 0x49, 0x5f, 0xb2,
+	0xf8, 0xa6, 0xac, 0x14, 0x84, 0x70, 0x93, 0x15, 0x68, 0x01, 0x7f, 0x06, 0xf7, 0x26, 0x4c, 0x9e,
+	0x86, 0x19, 0x99, 0xf8, 0x24, 0x8a, 0xf8, 0x24, 0xcf, 0x16, 0xf6, 0x8a, 0xf2, 0x7d, 0x51, 0xed,
+	0xfb, 0xd1, 0x14, 0x3c, 0x99, 0xf1, 0x1e, 0x32, 0xd2, 0x5d, 0x2d, 0xad, 0x48, 0x44, 0x18, 0x4e,
+	0xca, 0x65, 0x02, 0x7e, 0x07, 0x9a, 0x21, 0x13, 0x32, 0x63, 0x83, 0xb1, 0x64, 0x3c, 0x11, 0xf6,
+	0x9d, 0xba, 0xe7, 0x3c, 0x2c, 0xa0, 0xe6, 0x43, 0x58, 0x2c, 0x87, 0x7f, 0x58, 0x60, 0xa7, 0xb8,
+	0xe2, 0xd3, 0x44, 0x32, 0x19, 0xd1, 0x98, 0x26, 0x52, 0xd8, 0xab, 0x2a, 0xfc, 0xd1, 0x7f, 0x87,
+	0x1f, 0xcd, 0xab, 0xbc, 0xae, 0x79, 0xb4, 0x8e, 0x7e, 0xb4, 0x5b, 0xd3, 0x11, 0xb6, 0xc3, 0xea,
+	0x08, 0x01, 0xbf, 0x07, 0x5b, 0x09, 0x7d, 0x2b, 0xfd, 0x85, 0x62, 0x16, 0xda, 0x6b, 0x1d, 0xab,
+	0xbb, 0xec, 0xb9, 0x57, 0xe7, 0xee, 0xa7, 0x3a, 0xbd, 0x8a, 0x42, 0x18, 0xe6, 0xcb, 0xc5, 0xfd,
+	0xbd, 0x08, 0xe1, 0x1b, 0xd0, 0x1e, 0x92, 0x71, 0x40, 0xa5, 0x9f, 0xf2, 0x88, 0x05, 0x8c, 0x0a,
+	0x1b, 0xd4, 0xf5, 0xee, 0x99, 0x82, 0x4f, 0x72, 0x76, 0x5a, 0xfe, 0x46, 0x4a, 0x41, 0x08, 0xb7,
+	0x86, 0x73, 0x9a, 0x51, 0x01, 0x43, 0xd0, 0x34, 0x4c, 0x10, 0x11, 0x16, 0x0b, 0x7b, 0x5d, 0xa9,
+	0xf6, 0xea, 0x54, 0x4f, 0x73, 0xd2, 0x7b, 0x60, 0x4c, 0x5b, 0x0b, 0x26, 0x9d, 0x82, 0xf0, 0xc6,
+	0x70, 0x8e, 0x0a, 0x98, 0x82, 0xcd, 0x90, 0x26, 0x3c, 0xf6, 0x63, 0x36, 0xca, 0x88, 0xfe, 0x1e,
+	0x36, 0x94, 0xe8, 0xf3, 0x5b, 0x5e, 0x59, 0x4e, 0x1f, 0xcf, 0x60, 0xcf, 0x35, 0xae, 0x6d, 0xf3,
+	0xa6, 0x4a, 0x59, 0x08, 0xb7, 0xc3, 0x85, 0x02, 0x01, 0x7f, 0xb3, 0xc0, 0x76, 0x09, 0xf3, 0x4f,
+	0x79, 0x14, 0xe6, 0x33, 0xa9, 0xa9, 0xcc, 0x5f, 0xfe, 0x1f, 0xf3, 0x73, 0x55, 0xe2, 0x3d, 0x34,
+	0x7e, 0xa7, 0xd2, 0x3f, 0x0b, 0x46, 0xf8, 0x7e, 0x58, 0x51, 0xad, 0x9a, 0x7c, 0x3d, 0x85, 0x86,
+	0x94, 0x0a, 0xbb, 0x55, 0xd7, 0xe4, 0xd9, 0x24, 0x7b, 0x46, 0x69, 0xb9, 0xc9, 0x0b, 0x29, 0x08,
+	0x6f, 0xc8, 0x39, 0x2a, 0x20, 0x03, 0x4d, 0x49, 0xb3, 0x58, 0xf8, 0xa7, 0x4c, 0x48, 0x9e, 0x4d,
+	0xed, 0xb6, 0xb2, 0x3c, 0xac, 0x9b, 0xbd, 0xaf, 0xf2, 0x82, 0xa3, 0x44, 0x66, 0xd3, 0x1b, 0xaa,
+	0x62, 0x54, 0xae, 0xca, 0xef, 0x9f, 0xeb, 0x5b, 0xf8, 0x1a, 0xac, 0x85, 0x34, 0x99, 0xfa, 0x11,
+	0x13, 0xd2, 0xde, 0xac, 0x1b, 0x97, 0x87, 0x34, 0x99, 0xbe, 0x64, 0x42, 0x6a, 0x87, 0x6d, 0x1c,
+	0x9b, 0xd7, 0x7d, 0xd4, 0x19, 0x08, 0xaf, 0x86, 0x06, 0x84, 0xdf, 0x80, 0x96, 0x9a, 0xd4, 0xa1,
+	0x3f, 0x3b, 0x43, 0xee, 0x76, 0x96, 0xba, 0x6b, 0xc5, 0x91, 0xbe, 0xf8, 0x3b, 0xc2, 0x4d, 0xbd,
+	0x70, 0x6c, 0x4e, 0x8e, 0x5f, 0x00, 0x8c, 0x49, 0x42, 0x46, 0xea, 0x2f, 0x7a, 0xdd, 0x8d, 0x7b,
+	0x6a, 0x9b, 0x5f, 0xd5, 0x75, 0xe3, 0xf8, 0xba, 0x4a, 0x6f, 0x77, 0xcf, 0x6c, 0x77, 0x47, 0x6b,
+	0x6f, 0x86, 0x22, 0x7c, 0x77, 0xbe, 0x68, 0x9a, 0x73, 0xb0, 0xfa, 0xeb, 0x3b, 0xb7, 0xf1, 0xcf,
+	0x3b, 0xb7, 0xe1, 0x8d, 0xde, 0x5f, 0x38, 0xd6, 0x87, 0x0b, 0xc7, 0xfa, 0xfb, 0xc2, 0xb1, 0x7e,
+	0xbf, 0x74, 0x1a, 0x1f, 0x2e, 0x9d, 0xc6, 0x5f, 0x97, 0x4e, 0x03, 0x6c, 0x33, 0x5e, 0xb9, 0x91,
+	0x13, 0xeb, 0xf5, 0xfe, 0x88, 0xc9, 0xd3, 0xf1, 0xa0, 0x17, 0xf0, 0xb8, 0x3f, 0x47, 0x1e, 0x31,
+	0x5e, 0xb8, 0xeb, 0xbf, 0x9d, 0x9d, 0xe3, 0x72, 0x9a, 0x52, 0x31, 0x58, 0x51, 0x87, 0xf8, 0xd7,
+	0xff, 0x0e, 0x00, 0x69, 0xcd, 0xec, 0x24, 0x39, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +181,220 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ManagementHistory) > 0 {
+		for iNdEx := len(m.ManagementHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagementHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.PausedMarkers) > 0 {
+		for iNdEx := len(m.PausedMarkers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedMarkers[iNdEx])
+			copy(dAtA[i:], m.PausedMarkers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedMarkers[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.DenyList) > 0 {
+		for iNdEx := len(m.DenyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.TermsHistory) > 0 {
+		for iNdEx := len(m.TermsHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TermsHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.TransferFees) > 0 {
+		for iNdEx := len(m.TransferFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.DenomMigrationHolders) > 0 {
+		for iNdEx := len(m.DenomMigrationHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMigrationHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DenomMigrations) > 0 {
+		for iNdEx := len(m.DenomMigrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMigrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.FaucetClaims) > 0 {
+		for iNdEx := len(m.FaucetClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FaucetClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FaucetPolicies) > 0 {
+		for iNdEx := len(m.FaucetPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FaucetPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.NextDistributionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextDistributionId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.DistributionEntitlements) > 0 {
+		for iNdEx := len(m.DistributionEntitlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionEntitlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.WithdrawAllowances) > 0 {
+		for iNdEx := len(m.WithdrawAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.IbcRateLimits) > 0 {
+		for iNdEx := len(m.IbcRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.EscrowDeposits) > 0 {
+		for iNdEx := len(m.EscrowDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TransferPause != nil {
+		{
+			size, err := m.TransferPause.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Markers) > 0 {
 		for iNdEx := len(m.Markers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -164,6 +447,97 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.TransferPause != nil {
+		l = m.TransferPause.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.EscrowDeposits) > 0 {
+		for _, e := range m.EscrowDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcRateLimits) > 0 {
+		for _, e := range m.IbcRateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawAllowances) > 0 {
+		for _, e := range m.WithdrawAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionEntitlements) > 0 {
+		for _, e := range m.DistributionEntitlements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextDistributionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextDistributionId))
+	}
+	if len(m.FaucetPolicies) > 0 {
+		for _, e := range m.FaucetPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FaucetClaims) > 0 {
+		for _, e := range m.FaucetClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomMigrations) > 0 {
+		for _, e := range m.DenomMigrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomMigrationHolders) > 0 {
+		for _, e := range m.DenomMigrationHolders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferFees) > 0 {
+		for _, e := range m.TransferFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TermsHistory) > 0 {
+		for _, e := range m.TermsHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenyList) > 0 {
+		for _, e := range m.DenyList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedMarkers) > 0 {
+		for _, s := range m.PausedMarkers {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ManagementHistory) > 0 {
+		for _, e := range m.ManagementHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -269,6 +643,535 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferPause == nil {
+				m.TransferPause = &TransferPause{}
+			}
+			if err := m.TransferPause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowDeposits = append(m.EscrowDeposits, EscrowDeposit{})
+			if err := m.EscrowDeposits[len(m.EscrowDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcRateLimits = append(m.IbcRateLimits, IbcRateLimit{})
+			if err := m.IbcRateLimits[len(m.IbcRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAllowances = append(m.WithdrawAllowances, WithdrawAllowance{})
+			if err := m.WithdrawAllowances[len(m.WithdrawAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, Distribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionEntitlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionEntitlements = append(m.DistributionEntitlements, DistributionEntitlement{})
+			if err := m.DistributionEntitlements[len(m.DistributionEntitlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDistributionId", wireType)
+			}
+			m.NextDistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextDistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaucetPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FaucetPolicies = append(m.FaucetPolicies, FaucetPolicy{})
+			if err := m.FaucetPolicies[len(m.FaucetPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaucetClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FaucetClaims = append(m.FaucetClaims, FaucetClaim{})
+			if err := m.FaucetClaims[len(m.FaucetClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMigrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMigrations = append(m.DenomMigrations, DenomMigration{})
+			if err := m.DenomMigrations[len(m.DenomMigrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMigrationHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMigrationHolders = append(m.DenomMigrationHolders, DenomMigrationHolder{})
+			if err := m.DenomMigrationHolders[len(m.DenomMigrationHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFees = append(m.TransferFees, TransferFee{})
+			if err := m.TransferFees[len(m.TransferFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermsHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TermsHistory = append(m.TermsHistory, MarkerTermsEntry{})
+			if err := m.TermsHistory[len(m.TermsHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenyList = append(m.DenyList, DenyListEntry{})
+			if err := m.DenyList[len(m.DenyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedMarkers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedMarkers = append(m.PausedMarkers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagementHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagementHistory = append(m.ManagementHistory, MarkerManagementEntry{})
+			if err := m.ManagementHistory[len(m.ManagementHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
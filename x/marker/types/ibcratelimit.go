@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+)
+
+// NewIbcRateLimit creates a new quota on the ibc transfer flow of a marker denom over a channel
+func NewIbcRateLimit(denom, channelID string, maxPercentSend, maxPercentRecv uint32, durationHours uint64) *IbcRateLimit {
+	return &IbcRateLimit{
+		Denom:          denom,
+		ChannelId:      channelID,
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+		Flow:           NewIbcRateLimitFlow(sdk.ZeroInt(), time.Time{}),
+	}
+}
+
+// NewIbcRateLimitFlow creates an empty transfer flow for a period ending at the given time
+func NewIbcRateLimitFlow(supply sdk.Int, periodEnd time.Time) IbcRateLimitFlow {
+	return IbcRateLimitFlow{
+		Inflow:    sdk.ZeroInt(),
+		Outflow:   sdk.ZeroInt(),
+		Supply:    supply,
+		PeriodEnd: periodEnd,
+	}
+}
+
+// Validate performs a static check over the rate limit format
+func (l IbcRateLimit) Validate() error {
+	if _, err := MarkerAddress(l.Denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %w", l.Denom, err)
+	}
+	if err := host.ChannelIdentifierValidator(l.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel id %s: %w", l.ChannelId, err)
+	}
+	if l.MaxPercentSend > 100 || l.MaxPercentRecv > 100 {
+		return fmt.Errorf("max percent send and recv must be at most 100")
+	}
+	if l.MaxPercentSend == 0 && l.MaxPercentRecv == 0 {
+		return fmt.Errorf("max percent send or recv must be greater than zero")
+	}
+	if l.DurationHours == 0 {
+		return fmt.Errorf("duration hours must be greater than zero")
+	}
+	if l.Flow.Inflow.IsNil() || l.Flow.Inflow.IsNegative() ||
+		l.Flow.Outflow.IsNil() || l.Flow.Outflow.IsNegative() ||
+		l.Flow.Supply.IsNil() || l.Flow.Supply.IsNegative() {
+		return fmt.Errorf("flow amounts must not be negative")
+	}
+	return nil
+}
+
+// ResetFlow starts a new period at the given time with the marker supply the quotas are a percentage of.
+func (l *IbcRateLimit) ResetFlow(supply sdk.Int, now time.Time) {
+	l.Flow = NewIbcRateLimitFlow(supply, now.Add(time.Duration(l.DurationHours)*time.Hour))
+}
+
+// IsPeriodExpired returns true if the period of the current flow has ended at the given time.
+func (l IbcRateLimit) IsPeriodExpired(now time.Time) bool {
+	return !now.Before(l.Flow.PeriodEnd)
+}
+
+// CheckSend returns an error if sending the amount would take the net outflow of the period over the send quota.
+func (l IbcRateLimit) CheckSend(amount sdk.Int) error {
+	return checkQuota(l.MaxPercentSend, l.Flow.Supply, l.Flow.Outflow.Add(amount).Sub(l.Flow.Inflow), "send", l)
+}
+
+// CheckRecv returns an error if receiving the amount would take the net inflow of the period over the recv quota.
+func (l IbcRateLimit) CheckRecv(amount sdk.Int) error {
+	return checkQuota(l.MaxPercentRecv, l.Flow.Supply, l.Flow.Inflow.Add(amount).Sub(l.Flow.Outflow), "recv", l)
+}
+
+func checkQuota(maxPercent uint32, supply, netFlow sdk.Int, direction string, l IbcRateLimit) error {
+	if maxPercent == 0 {
+		return nil
+	}
+	quota := supply.MulRaw(int64(maxPercent)).QuoRaw(100)
+	if netFlow.GT(quota) {
+		return sdkerrors.Wrapf(ErrIbcRateLimitExceeded, "net %s flow %s%s over %s would exceed the quota of %s (%d%% of supply %s)",
+			direction, netFlow, l.Denom, l.ChannelId, quota, maxPercent, supply)
+	}
+	return nil
+}
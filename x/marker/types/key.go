@@ -31,6 +31,57 @@ const (
 var (
 	// MarkerStoreKeyPrefix prefix for marker-address reference (improves iterator performance over auth accounts)
 	MarkerStoreKeyPrefix = []byte{0x02}
+
+	// TransferPauseKey is the key for the governance controlled pause of restricted marker transfers
+	TransferPauseKey = []byte{0x03}
+
+	// EscrowDepositKeyPrefix prefix for records of coin sent directly to marker escrow accounts
+	EscrowDepositKeyPrefix = []byte{0x04}
+
+	// IbcRateLimitKeyPrefix prefix for the governance controlled quotas on the ibc transfer flow of marker denoms
+	IbcRateLimitKeyPrefix = []byte{0x05}
+
+	// WithdrawAllowanceKeyPrefix prefix for the limits on coin withdrawn from markers by addresses with withdraw access
+	WithdrawAllowanceKeyPrefix = []byte{0x06}
+
+	// DistributionKeyPrefix prefix for the distributions of payouts to the holders of markers
+	DistributionKeyPrefix = []byte{0x07}
+
+	// DistributionEntitlementKeyPrefix prefix for the unclaimed shares of distributions
+	DistributionEntitlementKeyPrefix = []byte{0x08}
+
+	// NextDistributionIDKey is the key for the identifier of the next distribution
+	NextDistributionIDKey = []byte{0x09}
+
+	// FaucetPolicyKeyPrefix prefix for the policies letting qualified addresses claim marker coin
+	FaucetPolicyKeyPrefix = []byte{0x0A}
+
+	// FaucetClaimKeyPrefix prefix for the last claim times of addresses that claimed from marker faucets
+	FaucetClaimKeyPrefix = []byte{0x0B}
+
+	// DenomMigrationKeyPrefix prefix for the migrations of marker denoms to successor markers
+	DenomMigrationKeyPrefix = []byte{0x0C}
+
+	// DenomMigrationHolderKeyPrefix prefix for the holders of migrating denoms waiting to be migrated
+	DenomMigrationHolderKeyPrefix = []byte{0x0D}
+
+	// TransferFeeKeyPrefix prefix for the fees charged on transfers of restricted markers
+	TransferFeeKeyPrefix = []byte{0x0E}
+
+	// TermsKeyPrefix prefix for the timelines of the terms documents anchored to markers
+	TermsKeyPrefix = []byte{0x0F}
+
+	// DenyListKeyPrefix prefix for the addresses denied transfers of restricted markers by governance
+	DenyListKeyPrefix = []byte{0x10}
+
+	// MarkerPausedKeyPrefix prefix for the markers paused by their administrators
+	MarkerPausedKeyPrefix = []byte{0x11}
+
+	// ManagementKeyPrefix prefix for the timelines of the addresses managing markers
+	ManagementKeyPrefix = []byte{0x13}
+
+	// EscrowDepositCountKeyPrefix prefix for the number of escrow deposits recorded for each marker
+	EscrowDepositCountKeyPrefix = []byte{0x14}
 )
 
 // MarkerAddress returns the module account address for the given denomination
@@ -59,3 +110,122 @@ func MarkerStoreKey(addr sdk.AccAddress) []byte {
 func SplitMarkerStoreKey(key []byte) sdk.AccAddress {
 	return sdk.AccAddress(key[2 : key[1]+2])
 }
+
+// EscrowDepositsPrefix returns the store key prefix for all escrow deposits of a marker
+func EscrowDepositsPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(append([]byte{}, EscrowDepositKeyPrefix...), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// EscrowDepositKey returns the store key for the deposits to a marker by an address at a block height.  Keys sort by
+// height within a marker so deposits iterate in the order they were received.
+func EscrowDepositKey(markerAddr sdk.AccAddress, height int64, from sdk.AccAddress) []byte {
+	key := append(EscrowDepositsPrefix(markerAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, address.MustLengthPrefix(from.Bytes())...)
+}
+
+// EscrowDepositCountKey returns the store key for the number of escrow deposits recorded for a marker
+func EscrowDepositCountKey(markerAddr sdk.AccAddress) []byte {
+	return append(append([]byte{}, EscrowDepositCountKeyPrefix...), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// IbcRateLimitsPrefix returns the store key prefix for all ibc rate limits of a marker denom
+func IbcRateLimitsPrefix(denom string) []byte {
+	return append(append([]byte{}, IbcRateLimitKeyPrefix...), address.MustLengthPrefix([]byte(denom))...)
+}
+
+// IbcRateLimitKey returns the store key for the ibc rate limit of a marker denom over a channel
+func IbcRateLimitKey(denom, channelID string) []byte {
+	return append(IbcRateLimitsPrefix(denom), []byte(channelID)...)
+}
+
+// WithdrawAllowancesPrefix returns the store key prefix for all withdraw allowances on a marker
+func WithdrawAllowancesPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(append([]byte{}, WithdrawAllowanceKeyPrefix...), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// WithdrawAllowanceKey returns the store key for the withdraw allowance of a grantee on a marker
+func WithdrawAllowanceKey(markerAddr sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(WithdrawAllowancesPrefix(markerAddr), address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// DistributionKey returns the store key for a distribution
+func DistributionKey(id uint64) []byte {
+	return append(append([]byte{}, DistributionKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// DistributionEntitlementsPrefix returns the store key prefix for all unclaimed shares of a distribution
+func DistributionEntitlementsPrefix(id uint64) []byte {
+	return append(append([]byte{}, DistributionEntitlementKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// DistributionEntitlementKey returns the store key for the unclaimed share of a holder in a distribution
+func DistributionEntitlementKey(id uint64, holder sdk.AccAddress) []byte {
+	return append(DistributionEntitlementsPrefix(id), address.MustLengthPrefix(holder.Bytes())...)
+}
+
+// FaucetPolicyKey returns the store key for the faucet policy of a marker
+func FaucetPolicyKey(markerAddr sdk.AccAddress) []byte {
+	return append(append([]byte{}, FaucetPolicyKeyPrefix...), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// FaucetClaimsPrefix returns the store key prefix for all faucet claims on a marker
+func FaucetClaimsPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(append([]byte{}, FaucetClaimKeyPrefix...), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// FaucetClaimKey returns the store key for the faucet claim of an address on a marker
+func FaucetClaimKey(markerAddr sdk.AccAddress, claimant sdk.AccAddress) []byte {
+	return append(FaucetClaimsPrefix(markerAddr), address.MustLengthPrefix(claimant.Bytes())...)
+}
+
+// DenomMigrationKey returns the store key for the migration of a marker denom
+func DenomMigrationKey(fromDenom string) []byte {
+	return append(append([]byte{}, DenomMigrationKeyPrefix...), []byte(fromDenom)...)
+}
+
+// DenomMigrationHoldersPrefix returns the store key prefix for all holders waiting in the migration of a marker denom
+func DenomMigrationHoldersPrefix(fromDenom string) []byte {
+	return append(append([]byte{}, DenomMigrationHolderKeyPrefix...), address.MustLengthPrefix([]byte(fromDenom))...)
+}
+
+// DenomMigrationHolderKey returns the store key for a holder waiting in the migration of a marker denom
+func DenomMigrationHolderKey(fromDenom string, holder sdk.AccAddress) []byte {
+	return append(DenomMigrationHoldersPrefix(fromDenom), address.MustLengthPrefix(holder.Bytes())...)
+}
+
+// TransferFeeKey returns the store key for the transfer fee of a marker
+func TransferFeeKey(markerAddr sdk.AccAddress) []byte {
+	return append(append([]byte{}, TransferFeeKeyPrefix...), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// TermsHistoryPrefix returns the store key prefix for the terms timeline of a marker
+func TermsHistoryPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(append([]byte{}, TermsKeyPrefix...), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// TermsKey returns the store key for an entry in the terms timeline of a marker.  Keys sort by sequence within a
+// marker so the timeline iterates oldest first.
+func TermsKey(markerAddr sdk.AccAddress, sequence uint64) []byte {
+	return append(TermsHistoryPrefix(markerAddr), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// DenyListKey returns the store key for the deny list entry of an address
+func DenyListKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, DenyListKeyPrefix...), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// MarkerPausedKey returns the store key for the pause of a marker
+func MarkerPausedKey(markerAddr sdk.AccAddress) []byte {
+	return append(append([]byte{}, MarkerPausedKeyPrefix...), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// ManagementHistoryPrefix returns the store key prefix for the management timeline of a marker
+func ManagementHistoryPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(append([]byte{}, ManagementKeyPrefix...), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// ManagementKey returns the store key for an entry in the management timeline of a marker.  Keys sort by sequence
+// within a marker so the timeline iterates oldest first.
+func ManagementKey(markerAddr sdk.AccAddress, sequence uint64) []byte {
+	return append(ManagementHistoryPrefix(markerAddr), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if the management entry is invalid.
+func (e MarkerManagementEntry) Validate() error {
+	if _, err := MarkerAddress(e.Denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %w", e.Denom, err)
+	}
+	if e.Sequence == 0 {
+		return fmt.Errorf("sequence must be positive")
+	}
+	if !sort.StringsAreSorted(e.ManagedBy) {
+		return fmt.Errorf("managed by addresses must be sorted")
+	}
+	for i, addr := range e.ManagedBy {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid managed by address: %w", err)
+		}
+		if i > 0 && e.ManagedBy[i-1] == addr {
+			return fmt.Errorf("duplicate managed by address %s", addr)
+		}
+	}
+	if e.BlockHeight < 0 {
+		return fmt.Errorf("block height cannot be negative")
+	}
+	if len(e.TxHash) > 0 {
+		if _, err := hex.DecodeString(e.TxHash); err != nil || strings.ToUpper(e.TxHash) != e.TxHash {
+			return fmt.Errorf("tx hash must be upper case hex")
+		}
+	}
+	return nil
+}
+
+// IsManagedBy returns true if the entry lists exactly the given sorted managing addresses.
+func (e MarkerManagementEntry) IsManagedBy(managers []string) bool {
+	if len(e.ManagedBy) != len(managers) {
+		return false
+	}
+	for i := range managers {
+		if e.ManagedBy[i] != managers[i] {
+			return false
+		}
+	}
+	return true
+}
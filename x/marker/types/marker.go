@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -32,17 +34,28 @@ type MarkerAccountI interface {
 
 	GetStatus() MarkerStatus
 	SetStatus(MarkerStatus) error
+	IsPending() bool
+
+	GetCreatedHeight() int64
+	SetCreatedHeight(int64)
+	GetCreatedBy() string
+	SetCreatedBy(string)
+	GetCreatedTxHash() string
+	SetCreatedTxHash(string)
 
 	GetSupply() sdk.Coin
 	SetSupply(sdk.Coin) error
 	HasFixedSupply() bool
+	IsReference() bool
 
 	GrantAccess(AccessGrantI) error
 	RevokeAccess(sdk.AccAddress) error
 	GetAccessList() []AccessGrant
 
 	AddressHasAccess(sdk.AccAddress, Access) bool
+	AddressHasAccessAt(sdk.AccAddress, Access, time.Time) bool
 	AddressListForPermission(Access) []sdk.AccAddress
+	RemoveExpiredAccess(time.Time) []AccessGrant
 
 	HasGovernanceEnabled() bool
 }
@@ -101,6 +114,10 @@ func (ma MarkerAccount) GetDenom() string { return ma.Denom }
 // invariant check
 func (ma MarkerAccount) HasFixedSupply() bool { return ma.SupplyFixed }
 
+// IsReference returns true if the marker only attaches metadata and access policy to a denom whose supply is managed
+// elsewhere.  The marker module never mints or burns the coin of a reference marker.
+func (ma MarkerAccount) IsReference() bool { return ma.Reference }
+
 // HasGovernanceEnabled returns true if this marker allows governance proposals to control this marker
 func (ma MarkerAccount) HasGovernanceEnabled() bool { return ma.AllowGovernanceControl }
 
@@ -115,6 +132,34 @@ func (ma *MarkerAccount) AddressHasAccess(addr sdk.AccAddress, role Access) bool
 	return false
 }
 
+// AddressHasAccessAt returns true if the provided address has been assigned the provided role within the current
+// MarkerAccount AccessControl and the grant has not expired at the given block time
+func (ma *MarkerAccount) AddressHasAccessAt(addr sdk.AccAddress, role Access, blockTime time.Time) bool {
+	for _, g := range ma.AccessControl {
+		if g.Address == addr.String() && g.HasAccess(role) && !g.IsExpired(blockTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveExpiredAccess removes all access grants that have expired at the given block time and returns them
+func (ma *MarkerAccount) RemoveExpiredAccess(blockTime time.Time) []AccessGrant {
+	var expired []AccessGrant
+	var accessList []AccessGrant
+	for _, ac := range ma.AccessControl {
+		if ac.IsExpired(blockTime) {
+			expired = append(expired, ac)
+		} else {
+			accessList = append(accessList, ac)
+		}
+	}
+	if len(expired) > 0 {
+		ma.AccessControl = accessList
+	}
+	return expired
+}
+
 // AddressListForPermission returns a list of all addresses with the provided rule within the
 // current MarkerAccount AccessControl list
 func (ma *MarkerAccount) AddressListForPermission(role Access) []sdk.AccAddress {
@@ -140,7 +185,14 @@ func (ma MarkerAccount) Validate() error {
 	if ma.Status < StatusActive && ma.Manager == "" && len(ma.AddressListForPermission(Access_Admin)) == 0 {
 		return fmt.Errorf("a manager is required if there are no accounts with ACCESS_ADMIN and marker is not ACTIVE")
 	}
-	if ma.Status == StatusFinalized && len(ma.AddressListForPermission(Access_Mint)) == 0 && ma.Supply.IsZero() {
+	if ma.Reference {
+		if !ma.Supply.IsZero() {
+			return fmt.Errorf("reference marker must have a zero total supply")
+		}
+		if ma.SupplyFixed {
+			return fmt.Errorf("reference marker cannot have a fixed supply")
+		}
+	} else if ma.Status == StatusFinalized && len(ma.AddressListForPermission(Access_Mint)) == 0 && ma.Supply.IsZero() {
 		return fmt.Errorf("cannot create a marker with zero total supply and no authorization for minting more")
 	}
 	// unlikely as this is set using a Coin which prohibits this value.
@@ -164,6 +216,11 @@ func (ma MarkerAccount) Validate() error {
 	if ma.Manager == ma.GetAddress().String() {
 		return fmt.Errorf("marker can not be self managed")
 	}
+	if len(ma.CreatedBy) > 0 {
+		if _, err := sdk.AccAddressFromBech32(ma.CreatedBy); err != nil {
+			return fmt.Errorf("invalid created by address: %w", err)
+		}
+	}
 	return ma.BaseAccount.Validate()
 }
 
@@ -227,6 +284,59 @@ func (ma *MarkerAccount) SetStatus(status MarkerStatus) error {
 	return nil
 }
 
+// IsPending returns true if the marker is proposed or finalized and has not been activated.
+func (ma MarkerAccount) IsPending() bool {
+	return ma.Status == StatusProposed || ma.Status == StatusFinalized
+}
+
+// GetCreatedHeight returns the block height the marker was created at.
+func (ma MarkerAccount) GetCreatedHeight() int64 {
+	return ma.CreatedHeight
+}
+
+// SetCreatedHeight sets the block height the marker was created at.
+func (ma *MarkerAccount) SetCreatedHeight(height int64) {
+	ma.CreatedHeight = height
+}
+
+// GetCreatedBy returns the address that created the marker.
+func (ma MarkerAccount) GetCreatedBy() string {
+	return ma.CreatedBy
+}
+
+// SetCreatedBy sets the address that created the marker.
+func (ma *MarkerAccount) SetCreatedBy(creator string) {
+	ma.CreatedBy = creator
+}
+
+// GetCreatedTxHash returns the hash of the tx that created the marker.
+func (ma MarkerAccount) GetCreatedTxHash() string {
+	return ma.CreatedTxHash
+}
+
+// SetCreatedTxHash sets the hash of the tx that created the marker.
+func (ma *MarkerAccount) SetCreatedTxHash(txHash string) {
+	ma.CreatedTxHash = txHash
+}
+
+// ManagingAddresses returns the sorted addresses managing a marker, its manager and the addresses with admin access.
+func ManagingAddresses(m MarkerAccountI) []string {
+	addrs := m.AddressListForPermission(Access_Admin)
+	if manager := m.GetManager(); !manager.Empty() {
+		addrs = append(addrs, manager)
+	}
+	seen := make(map[string]bool, len(addrs))
+	managers := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		if s := addr.String(); !seen[s] {
+			seen[s] = true
+			managers = append(managers, s)
+		}
+	}
+	sort.Strings(managers)
+	return managers
+}
+
 // GetMarkerType returns the type of the marker account.
 func (ma MarkerAccount) GetMarkerType() MarkerType {
 	return ma.MarkerType
@@ -298,8 +408,10 @@ func (ma *MarkerAccount) GrantAccess(access AccessGrantI) error {
 	if err := ma.RevokeAccess(access.GetAddress()); err != nil {
 		return err
 	}
-	// Append the new record
-	ma.AccessControl = append(ma.AccessControl, *NewAccessGrant(access.GetAddress(), access.GetAccessList()))
+	// Append the new record, the expiration of the new grant applies to all of the address's permissions
+	grant := NewAccessGrant(access.GetAddress(), access.GetAccessList())
+	grant.Expiration = access.GetExpiration()
+	ma.AccessControl = append(ma.AccessControl, *grant)
 	return nil
 }
 
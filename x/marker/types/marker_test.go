@@ -110,6 +110,24 @@ func TestNewMarkerValidate(t *testing.T) {
 			NewMarkerAccount(baseAcc, sdk.NewCoin("test", sdk.ZeroInt()), manager, nil, StatusFinalized, MarkerType_Coin),
 			fmt.Errorf("cannot create a marker with zero total supply and no authorization for minting more"),
 		},
+		{
+			"reference marker with a supply",
+			&MarkerAccount{BaseAccount: baseAcc, Manager: manager.String(), Status: StatusProposed, Denom: "test",
+				Supply: sdk.OneInt(), MarkerType: MarkerType_Coin, Reference: true},
+			fmt.Errorf("reference marker must have a zero total supply"),
+		},
+		{
+			"reference marker with a fixed supply",
+			&MarkerAccount{BaseAccount: baseAcc, Manager: manager.String(), Status: StatusProposed, Denom: "test",
+				Supply: sdk.ZeroInt(), MarkerType: MarkerType_Coin, SupplyFixed: true, Reference: true},
+			fmt.Errorf("reference marker cannot have a fixed supply"),
+		},
+		{
+			"finalized reference marker without supply",
+			&MarkerAccount{BaseAccount: baseAcc, Manager: manager.String(), Status: StatusFinalized, Denom: "test",
+				Supply: sdk.ZeroInt(), MarkerType: MarkerType_Coin, Reference: true},
+			nil,
+		},
 		{
 			"invalid status",
 			NewMarkerAccount(baseAcc, sdk.NewCoin("test", sdk.ZeroInt()), manager, nil, StatusUndefined, MarkerType_Coin),
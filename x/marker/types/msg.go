@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -19,9 +20,22 @@ const (
 	TypeDeleteRequest       = "delete"
 	TypeMintRequest         = "mint"
 	TypeBurnRequest         = "burn"
+	TypeBurnFromRequest     = "burnfrom"
 	TypeWithdrawRequest     = "withdraw"
 	TypeTransferRequest     = "transfer"
 	TypeSetMetadataRequest  = "setmetadata"
+
+	TypeSetWithdrawAllowanceRequest    = "setwithdrawallowance"
+	TypeDeleteWithdrawAllowanceRequest = "deletewithdrawallowance"
+	TypeScheduleDistributionRequest    = "scheduledistribution"
+	TypeClaimDistributionRequest       = "claimdistribution"
+	TypeSetFaucetPolicyRequest         = "setfaucetpolicy"
+	TypeRemoveFaucetPolicyRequest      = "removefaucetpolicy"
+	TypeClaimFaucetRequest             = "claimfaucet"
+	TypeSetTransferFeeRequest          = "settransferfee"
+	TypeRemoveTransferFeeRequest       = "removetransferfee"
+	TypeSetTermsRequest                = "setterms"
+	TypeSetMarkerPausedRequest         = "setmarkerpaused"
 )
 
 // Compile time interface check.
@@ -35,8 +49,20 @@ var (
 	_ sdk.Msg = &MsgDeleteRequest{}
 	_ sdk.Msg = &MsgMintRequest{}
 	_ sdk.Msg = &MsgBurnRequest{}
+	_ sdk.Msg = &MsgBurnFromRequest{}
 	_ sdk.Msg = &MsgWithdrawRequest{}
 	_ sdk.Msg = &MsgTransferRequest{}
+	_ sdk.Msg = &MsgSetWithdrawAllowanceRequest{}
+	_ sdk.Msg = &MsgDeleteWithdrawAllowanceRequest{}
+	_ sdk.Msg = &MsgScheduleDistributionRequest{}
+	_ sdk.Msg = &MsgClaimDistributionRequest{}
+	_ sdk.Msg = &MsgSetFaucetPolicyRequest{}
+	_ sdk.Msg = &MsgRemoveFaucetPolicyRequest{}
+	_ sdk.Msg = &MsgClaimFaucetRequest{}
+	_ sdk.Msg = &MsgSetTransferFeeRequest{}
+	_ sdk.Msg = &MsgRemoveTransferFeeRequest{}
+	_ sdk.Msg = &MsgSetTermsRequest{}
+	_ sdk.Msg = &MsgSetMarkerPausedRequest{}
 )
 
 // Type returns the message action.
@@ -66,6 +92,9 @@ func (msg MsgMintRequest) Type() string { return TypeMintRequest }
 // Type returns the message action.
 func (msg MsgBurnRequest) Type() string { return TypeBurnRequest }
 
+// Type returns the message action.
+func (msg MsgBurnFromRequest) Type() string { return TypeBurnFromRequest }
+
 // Type returns the message action.
 func (msg MsgWithdrawRequest) Type() string { return TypeWithdrawRequest }
 
@@ -75,6 +104,39 @@ func (msg MsgTransferRequest) Type() string { return TypeTransferRequest }
 // Type returns the message action.
 func (msg MsgSetDenomMetadataRequest) Type() string { return TypeSetMetadataRequest }
 
+// Type returns the message action.
+func (msg MsgSetWithdrawAllowanceRequest) Type() string { return TypeSetWithdrawAllowanceRequest }
+
+// Type returns the message action.
+func (msg MsgDeleteWithdrawAllowanceRequest) Type() string { return TypeDeleteWithdrawAllowanceRequest }
+
+// Type returns the message action.
+func (msg MsgScheduleDistributionRequest) Type() string { return TypeScheduleDistributionRequest }
+
+// Type returns the message action.
+func (msg MsgClaimDistributionRequest) Type() string { return TypeClaimDistributionRequest }
+
+// Type returns the message action.
+func (msg MsgSetFaucetPolicyRequest) Type() string { return TypeSetFaucetPolicyRequest }
+
+// Type returns the message action.
+func (msg MsgRemoveFaucetPolicyRequest) Type() string { return TypeRemoveFaucetPolicyRequest }
+
+// Type returns the message action.
+func (msg MsgClaimFaucetRequest) Type() string { return TypeClaimFaucetRequest }
+
+// Type returns the message action.
+func (msg MsgSetTransferFeeRequest) Type() string { return TypeSetTransferFeeRequest }
+
+// Type returns the message action.
+func (msg MsgRemoveTransferFeeRequest) Type() string { return TypeRemoveTransferFeeRequest }
+
+// Type returns the message action.
+func (msg MsgSetTermsRequest) Type() string { return TypeSetTermsRequest }
+
+// Type returns the message action.
+func (msg MsgSetMarkerPausedRequest) Type() string { return TypeSetMarkerPausedRequest }
+
 // NewMsgAddMarkerRequest creates a new marker in a proposed state with a given total supply a denomination
 func NewMsgAddMarkerRequest(
 	denom string, totalSupply sdk.Int, fromAddress sdk.AccAddress, manager sdk.AccAddress, markerType MarkerType, supplyFixed bool, allowGovernanceControl bool, // nolint:interfacer
@@ -112,6 +174,25 @@ func (msg MsgAddMarkerRequest) ValidateBasic() error {
 	if !testCoin.IsValid() {
 		return fmt.Errorf("invalid marker denom/total supply: %w", sdkerrors.ErrInvalidCoins)
 	}
+	if msg.Reference {
+		if !testCoin.IsZero() {
+			return fmt.Errorf("reference marker total supply must be zero")
+		}
+		if msg.SupplyFixed {
+			return fmt.Errorf("reference marker cannot have a fixed supply")
+		}
+	}
+	if msg.TransferFee != nil {
+		if msg.MarkerType != MarkerType_RestrictedCoin {
+			return fmt.Errorf("transfer fees are only supported on restricted markers")
+		}
+		if msg.TransferFee.Denom != msg.Amount.Denom {
+			return fmt.Errorf("transfer fee denom %s does not match marker denom %s", msg.TransferFee.Denom, msg.Amount.Denom)
+		}
+		if err := msg.TransferFee.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer fee: %w", err)
+		}
+	}
 
 	return nil
 }
@@ -410,6 +491,45 @@ func (msg MsgBurnRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// NewMsgBurnFromRequest creates a message to burn restricted coin held by another account
+func NewMsgBurnFromRequest(admin, from sdk.AccAddress, amount sdk.Coin) *MsgBurnFromRequest { // nolint:interfacer
+	return &MsgBurnFromRequest{
+		Administrator: admin.String(),
+		FromAddress:   from.String(),
+		Amount:        amount,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgBurnFromRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgBurnFromRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return err
+	}
+
+	return msg.Amount.Validate()
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgBurnFromRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgBurnFromRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
 // NewMsgWithdrawRequest
 func NewMsgWithdrawRequest(
 	admin sdk.AccAddress, toAddress sdk.AccAddress, denom string, amount sdk.Coins,
@@ -546,3 +666,417 @@ func (msg MsgSetDenomMetadataRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgSetWithdrawAllowanceRequest creates a message to limit the coin a grantee can withdraw from a marker in a period
+func NewMsgSetWithdrawAllowanceRequest(
+	denom string, admin, grantee sdk.AccAddress, maxAmount sdk.Coins, period time.Duration, // nolint:interfacer
+) *MsgSetWithdrawAllowanceRequest {
+	return &MsgSetWithdrawAllowanceRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Grantee:       grantee.String(),
+		MaxAmount:     maxAmount,
+		Period:        period,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetWithdrawAllowanceRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetWithdrawAllowanceRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return err
+	}
+	return NewWithdrawAllowance(msg.Denom, grantee, msg.MaxAmount, msg.Period).Validate()
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgSetWithdrawAllowanceRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgSetWithdrawAllowanceRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgDeleteWithdrawAllowanceRequest creates a message to remove the withdraw allowance of a grantee on a marker
+func NewMsgDeleteWithdrawAllowanceRequest(denom string, admin, grantee sdk.AccAddress) *MsgDeleteWithdrawAllowanceRequest { // nolint:interfacer
+	return &MsgDeleteWithdrawAllowanceRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Grantee:       grantee.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgDeleteWithdrawAllowanceRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgDeleteWithdrawAllowanceRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Grantee)
+	return err
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgDeleteWithdrawAllowanceRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgDeleteWithdrawAllowanceRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgScheduleDistributionRequest creates a message to pay the holders of a marker at a snapshot height
+func NewMsgScheduleDistributionRequest(
+	denom string, admin sdk.AccAddress, payout sdk.Coin, snapshotHeight, claimWindow int64, // nolint:interfacer
+) *MsgScheduleDistributionRequest {
+	return &MsgScheduleDistributionRequest{
+		Denom:          denom,
+		Administrator:  admin.String(),
+		Payout:         payout,
+		SnapshotHeight: snapshotHeight,
+		ClaimWindow:    claimWindow,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgScheduleDistributionRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgScheduleDistributionRequest) ValidateBasic() error {
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return err
+	}
+	if msg.ClaimWindow <= 0 {
+		return fmt.Errorf("claim window must be greater than zero")
+	}
+	return NewDistribution(1, msg.Denom, admin, msg.Payout, msg.SnapshotHeight, msg.SnapshotHeight+msg.ClaimWindow).Validate()
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgScheduleDistributionRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgScheduleDistributionRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgClaimDistributionRequest creates a message to pay a holder their share of a distribution
+func NewMsgClaimDistributionRequest(distributionID uint64, claimant sdk.AccAddress) *MsgClaimDistributionRequest { // nolint:interfacer
+	return &MsgClaimDistributionRequest{
+		DistributionId: distributionID,
+		Claimant:       claimant.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgClaimDistributionRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgClaimDistributionRequest) ValidateBasic() error {
+	if msg.DistributionId == 0 {
+		return fmt.Errorf("distribution id cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Claimant)
+	return err
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgClaimDistributionRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgClaimDistributionRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Claimant)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetFaucetPolicyRequest creates a message to let addresses with a required attribute claim marker coin
+func NewMsgSetFaucetPolicyRequest(
+	denom string, admin sdk.AccAddress, amount sdk.Coin, requiredAttribute string, cooldown time.Duration, // nolint:interfacer
+) *MsgSetFaucetPolicyRequest {
+	return &MsgSetFaucetPolicyRequest{
+		Denom:             denom,
+		Administrator:     admin.String(),
+		Amount:            amount,
+		RequiredAttribute: requiredAttribute,
+		Cooldown:          cooldown,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetFaucetPolicyRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetFaucetPolicyRequest) ValidateBasic() error {
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return err
+	}
+	return NewFaucetPolicy(msg.Denom, admin, msg.Amount, msg.RequiredAttribute, msg.Cooldown).Validate()
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgSetFaucetPolicyRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgSetFaucetPolicyRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRemoveFaucetPolicyRequest creates a message to remove the faucet policy of a marker
+func NewMsgRemoveFaucetPolicyRequest(denom string, admin sdk.AccAddress) *MsgRemoveFaucetPolicyRequest { // nolint:interfacer
+	return &MsgRemoveFaucetPolicyRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgRemoveFaucetPolicyRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRemoveFaucetPolicyRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Administrator)
+	return err
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgRemoveFaucetPolicyRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgRemoveFaucetPolicyRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgClaimFaucetRequest creates a message to pay a qualified address the faucet amount of a marker
+func NewMsgClaimFaucetRequest(denom string, claimant sdk.AccAddress) *MsgClaimFaucetRequest { // nolint:interfacer
+	return &MsgClaimFaucetRequest{
+		Denom:    denom,
+		Claimant: claimant.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgClaimFaucetRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgClaimFaucetRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Claimant)
+	return err
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgClaimFaucetRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgClaimFaucetRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Claimant)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetTransferFeeRequest creates a message to set the fee charged on each transfer of a restricted marker
+func NewMsgSetTransferFeeRequest(admin sdk.AccAddress, fee TransferFee) *MsgSetTransferFeeRequest { // nolint:interfacer
+	return &MsgSetTransferFeeRequest{
+		Administrator: admin.String(),
+		TransferFee:   fee,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetTransferFeeRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetTransferFeeRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	return msg.TransferFee.Validate()
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgSetTransferFeeRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgSetTransferFeeRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRemoveTransferFeeRequest creates a message to remove the transfer fee of a restricted marker
+func NewMsgRemoveTransferFeeRequest(denom string, admin sdk.AccAddress) *MsgRemoveTransferFeeRequest { // nolint:interfacer
+	return &MsgRemoveTransferFeeRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgRemoveTransferFeeRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRemoveTransferFeeRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Administrator)
+	return err
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgRemoveTransferFeeRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgRemoveTransferFeeRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetTermsRequest creates a message to anchor the hash and uri of the terms document of a marker
+func NewMsgSetTermsRequest(denom, termsHash, uri string, admin sdk.AccAddress) *MsgSetTermsRequest { // nolint:interfacer
+	return &MsgSetTermsRequest{
+		Denom:         denom,
+		TermsHash:     termsHash,
+		Uri:           uri,
+		Administrator: admin.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetTermsRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetTermsRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	return ValidateTerms(msg.TermsHash, msg.Uri)
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgSetTermsRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgSetTermsRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetMarkerPausedRequest creates a message to pause or resume a marker
+func NewMsgSetMarkerPausedRequest(denom string, paused bool, admin sdk.AccAddress) *MsgSetMarkerPausedRequest { // nolint:interfacer
+	return &MsgSetMarkerPausedRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Paused:        paused,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetMarkerPausedRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetMarkerPausedRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Administrator)
+	return err
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgSetMarkerPausedRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgSetMarkerPausedRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
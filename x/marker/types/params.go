@@ -16,6 +16,8 @@ const (
 	DefaultMaxTotalSupply = uint64(100000000000)
 	// DefaultUnrestrictedDenomRegex is a regex that denoms created by normal requests must pass.
 	DefaultUnrestrictedDenomRegex = `[a-zA-Z][a-zA-Z0-9\-\.]{2,64}`
+	// DefaultEmitLegacyEvents (true) indicates that the deprecated untyped events are emitted along with typed events
+	DefaultEmitLegacyEvents = true
 )
 
 var (
@@ -25,6 +27,8 @@ var (
 	ParamStoreKeyMaxTotalSupply = []byte("MaxTotalSupply")
 	// ParamStoreKeyUnrestrictedDenomRegex is the validation regex for validating denoms supplied by users.
 	ParamStoreKeyUnrestrictedDenomRegex = []byte("UnrestrictedDenomRegex")
+	// ParamStoreKeyEmitLegacyEvents indicates if the deprecated untyped events are emitted
+	ParamStoreKeyEmitLegacyEvents = []byte("EmitLegacyEvents")
 )
 
 // ParamKeyTable for marker module
@@ -37,11 +41,13 @@ func NewParams(
 	maxTotalSupply uint64,
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
+	emitLegacyEvents bool,
 ) Params {
 	return Params{
 		EnableGovernance:       enableGovernance,
 		MaxTotalSupply:         maxTotalSupply,
 		UnrestrictedDenomRegex: unrestrictedDenomRegex,
+		EmitLegacyEvents:       emitLegacyEvents,
 	}
 }
 
@@ -51,6 +57,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableGovernance, &p.EnableGovernance, validateEnableGovernance),
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxTotalSupply, &p.MaxTotalSupply, validateIntParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyUnrestrictedDenomRegex, &p.UnrestrictedDenomRegex, validateRegexParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyEmitLegacyEvents, &p.EmitLegacyEvents, validateEnableGovernance),
 	}
 }
 
@@ -60,6 +67,7 @@ func DefaultParams() Params {
 		DefaultMaxTotalSupply,
 		DefaultEnableGovernance,
 		DefaultUnrestrictedDenomRegex,
+		DefaultEmitLegacyEvents,
 	)
 }
 
@@ -98,6 +106,9 @@ func (p *Params) Equal(that interface{}) bool {
 	if p.UnrestrictedDenomRegex != that1.UnrestrictedDenomRegex {
 		return false
 	}
+	if p.EmitLegacyEvents != that1.EmitLegacyEvents {
+		return false
+	}
 	return true
 }
 
@@ -17,10 +17,13 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultEnableGovernance, p.EnableGovernance)
 	require.Equal(t, uint64(DefaultMaxTotalSupply), p.MaxTotalSupply)
 
-	require.True(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(1000, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, false, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, "a-z")))
+	require.Equal(t, DefaultEmitLegacyEvents, p.EmitLegacyEvents)
+
+	require.True(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultEmitLegacyEvents)))
+	require.False(t, p.Equal(NewParams(1000, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultEmitLegacyEvents)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, false, DefaultUnrestrictedDenomRegex, DefaultEmitLegacyEvents)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, "a-z", DefaultEmitLegacyEvents)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, false)))
 	require.False(t, p.Equal(nil))
 
 	var p2 *Params
@@ -39,17 +42,18 @@ func TestParamString(t *testing.T) {
 	require.Equal(t, `maxtotalsupply: 100000000000
 enablegovernance: true
 unrestricteddenomregex: '[a-zA-Z][a-zA-Z0-9\-\.]{2,64}'
+emitlegacyevents: true
 `, p.String())
 }
 
 func TestParamSetPairs(t *testing.T) {
 	p := DefaultParams()
 	pairs := p.ParamSetPairs()
-	require.Equal(t, 3, len(pairs))
+	require.Equal(t, 4, len(pairs))
 
 	for i := range pairs {
 		switch string(pairs[i].Key) {
-		case string(ParamStoreKeyEnableGovernance):
+		case string(ParamStoreKeyEnableGovernance), string(ParamStoreKeyEmitLegacyEvents):
 			require.Error(t, pairs[i].ValidatorFn("foo"))
 			require.NoError(t, pairs[i].ValidatorFn(true))
 		case string(ParamStoreKeyMaxTotalSupply):
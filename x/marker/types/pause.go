@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewTransferPause creates a new pause of restricted marker transfers, optionally halting marker supply changes too
+func NewTransferPause(denoms []string, expiryHeight int64, haltSupply bool) *TransferPause {
+	return &TransferPause{
+		Denoms:       denoms,
+		ExpiryHeight: expiryHeight,
+		HaltSupply:   haltSupply,
+	}
+}
+
+// NewGlobalTransferPause creates a new pause of every marker, halting marker supply changes, restricted marker
+// transfers, and bank sends and ibc transfers of coin markers
+func NewGlobalTransferPause(expiryHeight int64) *TransferPause {
+	return &TransferPause{
+		ExpiryHeight: expiryHeight,
+		HaltSupply:   true,
+		Global:       true,
+	}
+}
+
+// Validate performs a static check over the transfer pause format
+func (tp TransferPause) Validate() error {
+	if tp.ExpiryHeight <= 0 {
+		return fmt.Errorf("expiry height must be greater than zero")
+	}
+	if tp.Global && (len(tp.Denoms) > 0 || !tp.HaltSupply) {
+		return fmt.Errorf("a global pause applies to all markers and halts their supply")
+	}
+	seen := make(map[string]bool)
+	for _, denom := range tp.Denoms {
+		if _, err := MarkerAddress(denom); err != nil {
+			return fmt.Errorf("invalid denom %s: %w", denom, err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate denom %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+// IsActive returns true if the pause is in effect at the given block height
+func (tp TransferPause) IsActive(height int64) bool {
+	return height < tp.ExpiryHeight
+}
+
+// AppliesTo returns true if the pause covers the given denom
+func (tp TransferPause) AppliesTo(denom string) bool {
+	if len(tp.Denoms) == 0 {
+		return true
+	}
+	for _, d := range tp.Denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
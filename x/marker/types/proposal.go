@@ -2,11 +2,13 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
 )
 
 const (
@@ -26,6 +28,24 @@ const (
 	ProposalTypeWithdrawEscrow string = "WithdrawEscrow"
 	// ProposalTypeSetDenomMetadata is a proposal to set denom metatdata.
 	ProposalTypeSetDenomMetadata string = "SetDenomMetadata"
+	// ProposalTypePauseRestrictedTransfers is a proposal to pause transfers of restricted markers until an expiry height
+	ProposalTypePauseRestrictedTransfers string = "PauseRestrictedTransfers"
+	// ProposalTypeResumeRestrictedTransfers is a proposal to lift a pause of restricted marker transfers
+	ProposalTypeResumeRestrictedTransfers string = "ResumeRestrictedTransfers"
+	// ProposalTypeSetIbcRateLimit is a proposal to set the quotas on the ibc transfer flow of a marker over a channel
+	ProposalTypeSetIbcRateLimit string = "SetIbcRateLimit"
+	// ProposalTypeRemoveIbcRateLimit is a proposal to remove the quotas on the ibc transfer flow of a marker over a channel
+	ProposalTypeRemoveIbcRateLimit string = "RemoveIbcRateLimit"
+	// ProposalTypeMigrateDenom is a proposal to migrate all holders of a marker to a new marker denom
+	ProposalTypeMigrateDenom string = "MigrateDenom"
+	// ProposalTypeSetTransferFee is a proposal to set the fee charged on each transfer of a restricted marker
+	ProposalTypeSetTransferFee string = "SetTransferFee"
+	// ProposalTypeRemoveTransferFee is a proposal to remove the transfer fee of a restricted marker
+	ProposalTypeRemoveTransferFee string = "RemoveTransferFee"
+	// ProposalTypeAddToDenyList is a proposal to deny addresses transfers of all restricted markers
+	ProposalTypeAddToDenyList string = "AddToDenyList"
+	// ProposalTypeRemoveFromDenyList is a proposal to remove addresses from the restricted marker deny list
+	ProposalTypeRemoveFromDenyList string = "RemoveFromDenyList"
 )
 
 var (
@@ -37,6 +57,15 @@ var (
 	_ govtypes.Content = &ChangeStatusProposal{}
 	_ govtypes.Content = &WithdrawEscrowProposal{}
 	_ govtypes.Content = &SetDenomMetadataProposal{}
+	_ govtypes.Content = &PauseRestrictedTransfersProposal{}
+	_ govtypes.Content = &ResumeRestrictedTransfersProposal{}
+	_ govtypes.Content = &SetIbcRateLimitProposal{}
+	_ govtypes.Content = &RemoveIbcRateLimitProposal{}
+	_ govtypes.Content = &MigrateDenomProposal{}
+	_ govtypes.Content = &SetTransferFeeProposal{}
+	_ govtypes.Content = &RemoveTransferFeeProposal{}
+	_ govtypes.Content = &AddToDenyListProposal{}
+	_ govtypes.Content = &RemoveFromDenyListProposal{}
 )
 
 func init() {
@@ -61,6 +90,29 @@ func init() {
 
 	govtypes.RegisterProposalType(ProposalTypeSetDenomMetadata)
 	govtypes.RegisterProposalTypeCodec(SetDenomMetadataProposal{}, "provenance/marker/SetDenomMetadataProposal")
+
+	govtypes.RegisterProposalType(ProposalTypePauseRestrictedTransfers)
+	govtypes.RegisterProposalTypeCodec(PauseRestrictedTransfersProposal{}, "provenance/marker/PauseRestrictedTransfersProposal")
+	govtypes.RegisterProposalType(ProposalTypeResumeRestrictedTransfers)
+	govtypes.RegisterProposalTypeCodec(ResumeRestrictedTransfersProposal{}, "provenance/marker/ResumeRestrictedTransfersProposal")
+
+	govtypes.RegisterProposalType(ProposalTypeSetIbcRateLimit)
+	govtypes.RegisterProposalTypeCodec(SetIbcRateLimitProposal{}, "provenance/marker/SetIbcRateLimitProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveIbcRateLimit)
+	govtypes.RegisterProposalTypeCodec(RemoveIbcRateLimitProposal{}, "provenance/marker/RemoveIbcRateLimitProposal")
+
+	govtypes.RegisterProposalType(ProposalTypeMigrateDenom)
+	govtypes.RegisterProposalTypeCodec(MigrateDenomProposal{}, "provenance/marker/MigrateDenomProposal")
+
+	govtypes.RegisterProposalType(ProposalTypeSetTransferFee)
+	govtypes.RegisterProposalTypeCodec(SetTransferFeeProposal{}, "provenance/marker/SetTransferFeeProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveTransferFee)
+	govtypes.RegisterProposalTypeCodec(RemoveTransferFeeProposal{}, "provenance/marker/RemoveTransferFeeProposal")
+
+	govtypes.RegisterProposalType(ProposalTypeAddToDenyList)
+	govtypes.RegisterProposalTypeCodec(AddToDenyListProposal{}, "provenance/marker/AddToDenyListProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveFromDenyList)
+	govtypes.RegisterProposalTypeCodec(RemoveFromDenyListProposal{}, "provenance/marker/RemoveFromDenyListProposal")
 }
 
 // NewAddMarkerProposal creates a new proposal
@@ -108,6 +160,9 @@ func (amp AddMarkerProposal) ValidateBasic() error {
 	if !testCoin.IsValid() {
 		return fmt.Errorf("invalid marker denom/total supply: %w", sdkerrors.ErrInvalidCoins)
 	}
+	if amp.Reference && !testCoin.IsZero() {
+		return fmt.Errorf("reference marker total supply must be zero")
+	}
 	return govtypes.ValidateAbstract(&amp)
 }
 
@@ -203,7 +258,7 @@ func (sap SetAdministratorProposal) String() string {
 func NewRemoveAdministratorProposal(
 	title, description, denom string, administrators []string,
 ) *RemoveAdministratorProposal {
-	return &RemoveAdministratorProposal{title, description, denom, administrators}
+	return &RemoveAdministratorProposal{title, description, denom, administrators, false}
 }
 
 // Implements Proposal Interface
@@ -226,7 +281,8 @@ func (rap RemoveAdministratorProposal) String() string {
   Title:       %s
   Description: %s
   Administrators To Remove: %v
-`, rap.Denom, rap.Title, rap.Description, rap.RemovedAddress)
+  Allow Orphaned: %t
+`, rap.Denom, rap.Title, rap.Description, rap.RemovedAddress, rap.AllowOrphaned)
 }
 
 func NewChangeStatusProposal(title, description, denom string, status MarkerStatus) *ChangeStatusProposal { // nolint:interfacer
@@ -298,3 +354,343 @@ func (sdmdp SetDenomMetadataProposal) String() string {
   Metadata:    %s
 `, sdmdp.Metadata.Base, sdmdp.Title, sdmdp.Description, sdmdp.Metadata.String())
 }
+
+func NewPauseRestrictedTransfersProposal(title, description string, denoms []string, expiryHeight int64, haltSupply bool) *PauseRestrictedTransfersProposal {
+	return &PauseRestrictedTransfersProposal{
+		Title:        title,
+		Description:  description,
+		Denoms:       denoms,
+		ExpiryHeight: expiryHeight,
+		HaltSupply:   haltSupply,
+	}
+}
+
+// Implements Proposal Interface
+
+func (prtp PauseRestrictedTransfersProposal) ProposalRoute() string { return RouterKey }
+func (prtp PauseRestrictedTransfersProposal) ProposalType() string {
+	return ProposalTypePauseRestrictedTransfers
+}
+func (prtp PauseRestrictedTransfersProposal) ValidateBasic() error {
+	if err := prtp.TransferPause().Validate(); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	return govtypes.ValidateAbstract(&prtp)
+}
+
+// TransferPause returns the transfer pause set by the proposal
+func (prtp PauseRestrictedTransfersProposal) TransferPause() *TransferPause {
+	pause := NewTransferPause(prtp.Denoms, prtp.ExpiryHeight, prtp.HaltSupply)
+	pause.Global = prtp.Global
+	return pause
+}
+
+func (prtp PauseRestrictedTransfersProposal) String() string {
+	denoms := "all restricted markers"
+	if prtp.Global {
+		denoms = "all markers"
+	} else if len(prtp.Denoms) > 0 {
+		denoms = strings.Join(prtp.Denoms, ", ")
+	}
+	return fmt.Sprintf(`Pause Restricted Transfers Proposal:
+  Title:         %s
+  Description:   %s
+  Markers:       %s
+  Expiry Height: %d
+  Halt Supply:   %t
+`, prtp.Title, prtp.Description, denoms, prtp.ExpiryHeight, prtp.HaltSupply)
+}
+
+func NewResumeRestrictedTransfersProposal(title, description string) *ResumeRestrictedTransfersProposal {
+	return &ResumeRestrictedTransfersProposal{
+		Title:       title,
+		Description: description,
+	}
+}
+
+// Implements Proposal Interface
+
+func (rrtp ResumeRestrictedTransfersProposal) ProposalRoute() string { return RouterKey }
+func (rrtp ResumeRestrictedTransfersProposal) ProposalType() string {
+	return ProposalTypeResumeRestrictedTransfers
+}
+func (rrtp ResumeRestrictedTransfersProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(&rrtp)
+}
+
+func (rrtp ResumeRestrictedTransfersProposal) String() string {
+	return fmt.Sprintf(`Resume Restricted Transfers Proposal:
+  Title:       %s
+  Description: %s
+`, rrtp.Title, rrtp.Description)
+}
+
+func NewSetIbcRateLimitProposal(
+	title, description, denom, channelID string, maxPercentSend, maxPercentRecv uint32, durationHours uint64,
+) *SetIbcRateLimitProposal {
+	return &SetIbcRateLimitProposal{
+		Title:          title,
+		Description:    description,
+		Denom:          denom,
+		ChannelId:      channelID,
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// Implements Proposal Interface
+
+func (sirlp SetIbcRateLimitProposal) ProposalRoute() string { return RouterKey }
+func (sirlp SetIbcRateLimitProposal) ProposalType() string  { return ProposalTypeSetIbcRateLimit }
+func (sirlp SetIbcRateLimitProposal) ValidateBasic() error {
+	limit := NewIbcRateLimit(sirlp.Denom, sirlp.ChannelId, sirlp.MaxPercentSend, sirlp.MaxPercentRecv, sirlp.DurationHours)
+	if err := limit.Validate(); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	return govtypes.ValidateAbstract(&sirlp)
+}
+
+func (sirlp SetIbcRateLimitProposal) String() string {
+	return fmt.Sprintf(`Set IBC Rate Limit Proposal:
+  Title:            %s
+  Description:      %s
+  Marker:           %s
+  Channel:          %s
+  Max Percent Send: %d
+  Max Percent Recv: %d
+  Duration Hours:   %d
+`, sirlp.Title, sirlp.Description, sirlp.Denom, sirlp.ChannelId, sirlp.MaxPercentSend, sirlp.MaxPercentRecv, sirlp.DurationHours)
+}
+
+func NewRemoveIbcRateLimitProposal(title, description, denom, channelID string) *RemoveIbcRateLimitProposal {
+	return &RemoveIbcRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		ChannelId:   channelID,
+	}
+}
+
+// Implements Proposal Interface
+
+func (rirlp RemoveIbcRateLimitProposal) ProposalRoute() string { return RouterKey }
+func (rirlp RemoveIbcRateLimitProposal) ProposalType() string  { return ProposalTypeRemoveIbcRateLimit }
+func (rirlp RemoveIbcRateLimitProposal) ValidateBasic() error {
+	if _, err := MarkerAddress(rirlp.Denom); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(rirlp.ChannelId); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	return govtypes.ValidateAbstract(&rirlp)
+}
+
+func (rirlp RemoveIbcRateLimitProposal) String() string {
+	return fmt.Sprintf(`Remove IBC Rate Limit Proposal:
+  Title:       %s
+  Description: %s
+  Marker:      %s
+  Channel:     %s
+`, rirlp.Title, rirlp.Description, rirlp.Denom, rirlp.ChannelId)
+}
+
+// NewMigrateDenomProposal creates a new proposal
+func NewMigrateDenomProposal(title, description, denom, newDenom string, holdersPerBlock uint32) *MigrateDenomProposal {
+	return &MigrateDenomProposal{
+		Title:           title,
+		Description:     description,
+		Denom:           denom,
+		NewDenom:        newDenom,
+		HoldersPerBlock: holdersPerBlock,
+	}
+}
+
+// Implements Proposal Interface
+
+func (mdp MigrateDenomProposal) ProposalRoute() string { return RouterKey }
+func (mdp MigrateDenomProposal) ProposalType() string  { return ProposalTypeMigrateDenom }
+func (mdp MigrateDenomProposal) ValidateBasic() error {
+	if _, err := MarkerAddress(mdp.Denom); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	if _, err := MarkerAddress(mdp.NewDenom); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	if mdp.Denom == mdp.NewDenom {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, "new denom must be different from denom")
+	}
+	if mdp.HoldersPerBlock == 0 {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, "holders per block must be greater than zero")
+	}
+	return govtypes.ValidateAbstract(&mdp)
+}
+
+func (mdp MigrateDenomProposal) String() string {
+	return fmt.Sprintf(`Migrate Denom Proposal:
+  Title:             %s
+  Description:       %s
+  Marker:            %s
+  New Denom:         %s
+  Holders Per Block: %d
+`, mdp.Title, mdp.Description, mdp.Denom, mdp.NewDenom, mdp.HoldersPerBlock)
+}
+
+// NewSetTransferFeeProposal creates a new proposal
+func NewSetTransferFeeProposal(title, description string, fee TransferFee) *SetTransferFeeProposal {
+	return &SetTransferFeeProposal{
+		Title:       title,
+		Description: description,
+		TransferFee: fee,
+	}
+}
+
+// Implements Proposal Interface
+
+func (stfp SetTransferFeeProposal) ProposalRoute() string { return RouterKey }
+func (stfp SetTransferFeeProposal) ProposalType() string  { return ProposalTypeSetTransferFee }
+func (stfp SetTransferFeeProposal) ValidateBasic() error {
+	if err := stfp.TransferFee.Validate(); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	return govtypes.ValidateAbstract(&stfp)
+}
+
+func (stfp SetTransferFeeProposal) String() string {
+	recipient := stfp.TransferFee.Recipient
+	if len(recipient) == 0 {
+		recipient = "marker account"
+	}
+	return fmt.Sprintf(`Set Transfer Fee Proposal:
+  Title:       %s
+  Description: %s
+  Marker:      %s
+  Fee:         %s
+  Recipient:   %s
+`, stfp.Title, stfp.Description, stfp.TransferFee.Denom, stfp.TransferFee.FeeString(), recipient)
+}
+
+// NewRemoveTransferFeeProposal creates a new proposal
+func NewRemoveTransferFeeProposal(title, description, denom string) *RemoveTransferFeeProposal {
+	return &RemoveTransferFeeProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+	}
+}
+
+// Implements Proposal Interface
+
+func (rtfp RemoveTransferFeeProposal) ProposalRoute() string { return RouterKey }
+func (rtfp RemoveTransferFeeProposal) ProposalType() string  { return ProposalTypeRemoveTransferFee }
+func (rtfp RemoveTransferFeeProposal) ValidateBasic() error {
+	if _, err := MarkerAddress(rtfp.Denom); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	return govtypes.ValidateAbstract(&rtfp)
+}
+
+func (rtfp RemoveTransferFeeProposal) String() string {
+	return fmt.Sprintf(`Remove Transfer Fee Proposal:
+  Title:       %s
+  Description: %s
+  Marker:      %s
+`, rtfp.Title, rtfp.Description, rtfp.Denom)
+}
+
+// NewAddToDenyListProposal creates a new proposal
+func NewAddToDenyListProposal(title, description string, addresses []string, reason string) *AddToDenyListProposal {
+	return &AddToDenyListProposal{
+		Title:       title,
+		Description: description,
+		Addresses:   addresses,
+		Reason:      reason,
+	}
+}
+
+// Implements Proposal Interface
+
+func (adlp AddToDenyListProposal) ProposalRoute() string { return RouterKey }
+func (adlp AddToDenyListProposal) ProposalType() string  { return ProposalTypeAddToDenyList }
+func (adlp AddToDenyListProposal) ValidateBasic() error {
+	if err := validateDenyListAddresses(adlp.Addresses); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	if len(adlp.Reason) > MaxDenyListReasonLength {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidProposalContent, "reason cannot be longer than %d characters", MaxDenyListReasonLength)
+	}
+	return govtypes.ValidateAbstract(&adlp)
+}
+
+func (adlp AddToDenyListProposal) String() string {
+	return fmt.Sprintf(`Add To Deny List Proposal:
+  Title:       %s
+  Description: %s
+  Addresses:   %s
+  Reason:      %s
+`, adlp.Title, adlp.Description, strings.Join(adlp.Addresses, ", "), adlp.Reason)
+}
+
+// NewRemoveFromDenyListProposal creates a new proposal
+func NewRemoveFromDenyListProposal(title, description string, addresses []string) *RemoveFromDenyListProposal {
+	return &RemoveFromDenyListProposal{
+		Title:       title,
+		Description: description,
+		Addresses:   addresses,
+	}
+}
+
+// Implements Proposal Interface
+
+func (rdlp RemoveFromDenyListProposal) ProposalRoute() string { return RouterKey }
+func (rdlp RemoveFromDenyListProposal) ProposalType() string  { return ProposalTypeRemoveFromDenyList }
+func (rdlp RemoveFromDenyListProposal) ValidateBasic() error {
+	if err := validateDenyListAddresses(rdlp.Addresses); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	return govtypes.ValidateAbstract(&rdlp)
+}
+
+func (rdlp RemoveFromDenyListProposal) String() string {
+	return fmt.Sprintf(`Remove From Deny List Proposal:
+  Title:       %s
+  Description: %s
+  Addresses:   %s
+`, rdlp.Title, rdlp.Description, strings.Join(rdlp.Addresses, ", "))
+}
+
+// ProposalDenoms returns the marker denoms named by the content of a governance proposal.  Transfer pause proposals
+// without a list of denoms apply to all restricted markers but do not name any.
+func ProposalDenoms(content govtypes.Content) []string {
+	switch c := content.(type) {
+	case *AddMarkerProposal:
+		return []string{c.Amount.Denom}
+	case *SupplyIncreaseProposal:
+		return []string{c.Amount.Denom}
+	case *SupplyDecreaseProposal:
+		return []string{c.Amount.Denom}
+	case *SetAdministratorProposal:
+		return []string{c.Denom}
+	case *RemoveAdministratorProposal:
+		return []string{c.Denom}
+	case *ChangeStatusProposal:
+		return []string{c.Denom}
+	case *WithdrawEscrowProposal:
+		return []string{c.Denom}
+	case *SetDenomMetadataProposal:
+		return []string{c.Metadata.Base}
+	case *PauseRestrictedTransfersProposal:
+		return c.Denoms
+	case *SetIbcRateLimitProposal:
+		return []string{c.Denom}
+	case *RemoveIbcRateLimitProposal:
+		return []string{c.Denom}
+	case *MigrateDenomProposal:
+		return []string{c.Denom, c.NewDenom}
+	case *SetTransferFeeProposal:
+		return []string{c.TransferFee.Denom}
+	case *RemoveTransferFeeProposal:
+		return []string{c.Denom}
+	}
+	return nil
+}
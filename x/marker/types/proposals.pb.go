@@ -38,6 +38,8 @@ type AddMarkerProposal struct {
 	AccessList             []AccessGrant                           `protobuf:"bytes,7,rep,name=access_list,json=accessList,proto3" json:"access_list"`
 	SupplyFixed            bool                                    `protobuf:"varint,8,opt,name=supply_fixed,json=supplyFixed,proto3" json:"supply_fixed,omitempty"`
 	AllowGovernanceControl bool                                    `protobuf:"varint,9,opt,name=allow_governance_control,json=allowGovernanceControl,proto3" json:"allow_governance_control,omitempty"`
+	// reference adds a marker with a zero supply for a denom whose supply is managed elsewhere, see MarkerAccount
+	Reference bool `protobuf:"varint,10,opt,name=reference,proto3" json:"reference,omitempty"`
 }
 
 func (m *AddMarkerProposal) Reset()      { *m = AddMarkerProposal{} }
@@ -128,6 +130,13 @@ func (m *AddMarkerProposal) GetAllowGovernanceControl() bool {
 	return false
 }
 
+func (m *AddMarkerProposal) GetReference() bool {
+	if m != nil {
+		return m.Reference
+	}
+	return false
+}
+
 // SupplyIncreaseProposal defines a governance proposal to administer a marker and increase total supply of the marker
 // through minting coin and placing it within the marker or assigning it directly to an account
 type SupplyIncreaseProposal struct {
@@ -320,6 +329,8 @@ type RemoveAdministratorProposal struct {
 	Description    string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	Denom          string   `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 	RemovedAddress []string `protobuf:"bytes,4,rep,name=removed_address,json=removedAddress,proto3" json:"removed_address,omitempty"`
+	// allow_orphaned permits removing the last addresses with ADMIN or DELETE access on an active marker
+	AllowOrphaned bool `protobuf:"varint,5,opt,name=allow_orphaned,json=allowOrphaned,proto3" json:"allow_orphaned,omitempty"`
 }
 
 func (m *RemoveAdministratorProposal) Reset()      { *m = RemoveAdministratorProposal{} }
@@ -382,6 +393,13 @@ func (m *RemoveAdministratorProposal) GetRemovedAddress() []string {
 	return nil
 }
 
+func (m *RemoveAdministratorProposal) GetAllowOrphaned() bool {
+	if m != nil {
+		return m.AllowOrphaned
+	}
+	return false
+}
+
 // ChangeStatusProposal defines a governance proposal to administer a marker to change its status
 type ChangeStatusProposal struct {
 	Title       string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -579,234 +597,742 @@ func (m *SetDenomMetadataProposal) GetDescription() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*AddMarkerProposal)(nil), "provenance.marker.v1.AddMarkerProposal")
-	proto.RegisterType((*SupplyIncreaseProposal)(nil), "provenance.marker.v1.SupplyIncreaseProposal")
-	proto.RegisterType((*SupplyDecreaseProposal)(nil), "provenance.marker.v1.SupplyDecreaseProposal")
-	proto.RegisterType((*SetAdministratorProposal)(nil), "provenance.marker.v1.SetAdministratorProposal")
-	proto.RegisterType((*RemoveAdministratorProposal)(nil), "provenance.marker.v1.RemoveAdministratorProposal")
-	proto.RegisterType((*ChangeStatusProposal)(nil), "provenance.marker.v1.ChangeStatusProposal")
-	proto.RegisterType((*WithdrawEscrowProposal)(nil), "provenance.marker.v1.WithdrawEscrowProposal")
-	proto.RegisterType((*SetDenomMetadataProposal)(nil), "provenance.marker.v1.SetDenomMetadataProposal")
+// PauseRestrictedTransfersProposal defines a governance proposal to pause transfers of all restricted markers (or the
+// listed subset) until the expiry height.
+type PauseRestrictedTransfersProposal struct {
+	Title        string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denoms       []string `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	ExpiryHeight int64    `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	HaltSupply   bool     `protobuf:"varint,5,opt,name=halt_supply,json=haltSupply,proto3" json:"halt_supply,omitempty"`
+	Global       bool     `protobuf:"varint,6,opt,name=global,proto3" json:"global,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("provenance/marker/v1/proposals.proto", fileDescriptor_345320af87f4ec37)
+func (m *PauseRestrictedTransfersProposal) Reset()      { *m = PauseRestrictedTransfersProposal{} }
+func (*PauseRestrictedTransfersProposal) ProtoMessage() {}
+func (*PauseRestrictedTransfersProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{8}
 }
-
-var fileDescriptor_345320af87f4ec37 = []byte{
-	// 750 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x6b, 0x13, 0x4d,
-	0x18, 0xce, 0x7c, 0xf9, 0xd1, 0x64, 0xf2, 0x7d, 0xfd, 0x70, 0x09, 0x75, 0xad, 0x98, 0xa4, 0x45,
-	0x6d, 0x2e, 0xdd, 0x35, 0xf1, 0x22, 0xb9, 0x48, 0xd2, 0x6a, 0x15, 0x2c, 0x94, 0xad, 0x20, 0x78,
-	0x09, 0x93, 0xdd, 0x71, 0xbb, 0x24, 0x3b, 0xb3, 0xcc, 0x4c, 0x92, 0xf6, 0xbf, 0xf0, 0xe8, 0x49,
-	0x7a, 0xf6, 0x26, 0xde, 0x3d, 0xf7, 0x66, 0x8f, 0xe2, 0xa1, 0x4a, 0x8b, 0xe0, 0xbf, 0x20, 0x78,
-	0x90, 0x9d, 0x99, 0xa4, 0x0b, 0x0d, 0xa1, 0x52, 0x2a, 0xf4, 0xb4, 0x33, 0xef, 0xfb, 0xbc, 0x3f,
-	0x9e, 0xd9, 0xe7, 0x9d, 0x81, 0xb7, 0x23, 0x46, 0x87, 0x98, 0x20, 0xe2, 0x62, 0x3b, 0x44, 0xac,
-	0x87, 0x99, 0x3d, 0xac, 0xdb, 0x11, 0xa3, 0x11, 0xe5, 0xa8, 0xcf, 0xad, 0x88, 0x51, 0x41, 0x8d,
-	0xd2, 0x29, 0xca, 0x52, 0x28, 0x6b, 0x58, 0x5f, 0x2c, 0xf9, 0xd4, 0xa7, 0x12, 0x60, 0xc7, 0x2b,
-	0x85, 0x5d, 0x2c, 0xbb, 0x94, 0x87, 0x94, 0xdb, 0x5d, 0x44, 0x7a, 0xf6, 0xb0, 0xde, 0xc5, 0x02,
-	0xd5, 0xe5, 0xe6, 0x8c, 0x9f, 0xe3, 0x89, 0xdf, 0xa5, 0x01, 0xd1, 0xfe, 0xa5, 0xa9, 0x1d, 0xe9,
-	0xaa, 0x0a, 0x72, 0x77, 0x2a, 0x04, 0xb9, 0x2e, 0xe6, 0xdc, 0x67, 0x88, 0x08, 0x85, 0x5b, 0xfe,
-	0x99, 0x86, 0xd7, 0x5a, 0x9e, 0xb7, 0x29, 0x21, 0x5b, 0x9a, 0x93, 0x51, 0x82, 0x59, 0x11, 0x88,
-	0x3e, 0x36, 0x41, 0x15, 0xd4, 0x0a, 0x8e, 0xda, 0x18, 0x55, 0x58, 0xf4, 0x30, 0x77, 0x59, 0x10,
-	0x89, 0x80, 0x12, 0xf3, 0x1f, 0xe9, 0x4b, 0x9a, 0x8c, 0x2e, 0xcc, 0xa1, 0x90, 0x0e, 0x88, 0x30,
-	0xd3, 0x55, 0x50, 0x2b, 0x36, 0x6e, 0x58, 0x8a, 0x89, 0x15, 0x33, 0xb1, 0x34, 0x13, 0x6b, 0x8d,
-	0x06, 0xa4, 0x6d, 0x1f, 0x1c, 0x55, 0x52, 0x5f, 0x8e, 0x2a, 0x2b, 0x7e, 0x20, 0x76, 0x06, 0x5d,
-	0xcb, 0xa5, 0xa1, 0xad, 0x69, 0xab, 0xcf, 0x2a, 0xf7, 0x7a, 0xb6, 0xd8, 0x8b, 0x30, 0x97, 0x01,
-	0x8e, 0xce, 0x6c, 0x98, 0x70, 0x2e, 0x44, 0x04, 0xf9, 0x98, 0x99, 0x19, 0xd9, 0xc1, 0x78, 0x6b,
-	0x34, 0x61, 0x8e, 0x0b, 0x24, 0x06, 0xdc, 0xcc, 0x56, 0x41, 0x6d, 0xbe, 0xb1, 0x6c, 0x4d, 0xfb,
-	0x27, 0x96, 0xe2, 0xba, 0x2d, 0x91, 0x8e, 0x8e, 0x30, 0x5a, 0xb0, 0xa8, 0x10, 0x9d, 0xb8, 0xa4,
-	0x99, 0x93, 0x09, 0xaa, 0xb3, 0x12, 0x3c, 0xdf, 0x8b, 0xb0, 0x03, 0xc3, 0xc9, 0xda, 0x78, 0x02,
-	0x8b, 0xea, 0x7c, 0x3b, 0xfd, 0x80, 0x0b, 0x73, 0xae, 0x9a, 0xae, 0x15, 0x1b, 0x4b, 0xd3, 0x53,
-	0xb4, 0x24, 0x70, 0x23, 0xfe, 0x11, 0xed, 0x4c, 0x7c, 0x12, 0x0e, 0x54, 0xb1, 0xcf, 0x02, 0x2e,
-	0x8c, 0x25, 0xf8, 0x2f, 0x1f, 0x44, 0x51, 0x7f, 0xaf, 0xf3, 0x2a, 0xd8, 0xc5, 0x9e, 0x99, 0xaf,
-	0x82, 0x5a, 0xde, 0x29, 0x2a, 0xdb, 0xe3, 0xd8, 0x64, 0x3c, 0x80, 0x26, 0xea, 0xf7, 0xe9, 0xa8,
-	0xe3, 0xd3, 0x21, 0x66, 0x32, 0x7d, 0xc7, 0xa5, 0x44, 0x30, 0xda, 0x37, 0x0b, 0x12, 0xbe, 0x20,
-	0xfd, 0x1b, 0x13, 0xf7, 0x9a, 0xf2, 0x36, 0xf3, 0x6f, 0xf6, 0x2b, 0xa9, 0x1f, 0xfb, 0x15, 0xb0,
-	0xfc, 0x1d, 0xc0, 0x85, 0x6d, 0x99, 0xf3, 0x29, 0x71, 0x19, 0x46, 0x1c, 0x5f, 0x09, 0x01, 0xdc,
-	0x81, 0xf3, 0x02, 0x31, 0x1f, 0x8b, 0x0e, 0xf2, 0x3c, 0x86, 0x39, 0xd7, 0x3a, 0xf8, 0x4f, 0x59,
-	0x5b, 0xca, 0x98, 0xe0, 0xf9, 0x71, 0xc2, 0x73, 0x1d, 0x5f, 0x1d, 0x9e, 0x09, 0x02, 0x1f, 0x00,
-	0x34, 0xb7, 0x63, 0x66, 0x61, 0x40, 0x02, 0x2e, 0x18, 0x12, 0xf4, 0xe2, 0xb3, 0x5a, 0x82, 0x59,
-	0x0f, 0x13, 0x1a, 0x4a, 0x06, 0x05, 0x47, 0x6d, 0x8c, 0x87, 0x30, 0xa7, 0x84, 0x68, 0x66, 0xfe,
-	0x4c, 0xbf, 0x3a, 0x2c, 0xd1, 0xf5, 0x5b, 0x00, 0x6f, 0x3a, 0x38, 0xa4, 0x43, 0xfc, 0x37, 0x1a,
-	0x5f, 0x81, 0xff, 0x33, 0x59, 0xcc, 0x4b, 0xc8, 0x22, 0x5d, 0x2b, 0x38, 0xf3, 0xda, 0x7c, 0x56,
-	0x17, 0xef, 0x01, 0x2c, 0xad, 0xed, 0x20, 0xe2, 0x63, 0x75, 0x19, 0x5c, 0x52, 0x67, 0x2d, 0x08,
-	0x09, 0x1e, 0x75, 0xf4, 0xd5, 0x94, 0x39, 0xf7, 0xd5, 0x54, 0x20, 0x78, 0xa4, 0x96, 0x89, 0x9e,
-	0x7f, 0x01, 0xb8, 0xf0, 0x22, 0x10, 0x3b, 0x1e, 0x43, 0xa3, 0x47, 0xdc, 0x65, 0x74, 0x74, 0x49,
-	0x5d, 0xbb, 0x13, 0x85, 0x2b, 0x21, 0xcc, 0x50, 0xf8, 0xbd, 0x58, 0x00, 0xef, 0xbe, 0x56, 0x6a,
-	0xe7, 0x54, 0x38, 0x9f, 0x31, 0xca, 0xd9, 0xd9, 0xa3, 0xfc, 0x49, 0x4d, 0xc2, 0x7a, 0xdc, 0xe2,
-	0x26, 0x16, 0xc8, 0x43, 0x02, 0x5d, 0xf8, 0x00, 0x06, 0x30, 0x1f, 0xea, 0x5c, 0x7a, 0x9c, 0x6f,
-	0x9d, 0x92, 0x25, 0xbd, 0x09, 0xd9, 0x71, 0xc1, 0x76, 0x53, 0x8f, 0x74, 0x63, 0x26, 0xe1, 0x5d,
-	0xf5, 0xbe, 0x2b, 0xde, 0xe3, 0x58, 0x67, 0x52, 0xaa, 0x99, 0x89, 0x59, 0xb5, 0xfd, 0x83, 0xe3,
-	0x32, 0x38, 0x3c, 0x2e, 0x83, 0x6f, 0xc7, 0x65, 0xf0, 0xfa, 0xa4, 0x9c, 0x3a, 0x3c, 0x29, 0xa7,
-	0x3e, 0x9f, 0x94, 0x53, 0xf0, 0x7a, 0x40, 0xa7, 0xaa, 0x64, 0x0b, 0xbc, 0x4c, 0x16, 0x3e, 0x85,
-	0xac, 0x06, 0x34, 0xb1, 0xb3, 0x77, 0xc7, 0x0f, 0xbf, 0xec, 0xa0, 0x9b, 0x93, 0x0f, 0xfe, 0xfd,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x41, 0xe2, 0xdb, 0x22, 0xcf, 0x08, 0x00, 0x00,
+func (m *PauseRestrictedTransfersProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *AddMarkerProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*AddMarkerProposal)
-	if !ok {
-		that2, ok := that.(AddMarkerProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *PauseRestrictedTransfersProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseRestrictedTransfersProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
+}
+func (m *PauseRestrictedTransfersProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseRestrictedTransfersProposal.Merge(m, src)
+}
+func (m *PauseRestrictedTransfersProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseRestrictedTransfersProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseRestrictedTransfersProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseRestrictedTransfersProposal proto.InternalMessageInfo
+
+func (m *PauseRestrictedTransfersProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
+	return ""
+}
+
+func (m *PauseRestrictedTransfersProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if this.Manager != that1.Manager {
-		return false
+	return ""
+}
+
+func (m *PauseRestrictedTransfersProposal) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
 	}
-	if this.Status != that1.Status {
-		return false
+	return nil
+}
+
+func (m *PauseRestrictedTransfersProposal) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	if this.MarkerType != that1.MarkerType {
-		return false
+	return 0
+}
+
+func (m *PauseRestrictedTransfersProposal) GetHaltSupply() bool {
+	if m != nil {
+		return m.HaltSupply
 	}
-	if len(this.AccessList) != len(that1.AccessList) {
-		return false
+	return false
+}
+
+func (m *PauseRestrictedTransfersProposal) GetGlobal() bool {
+	if m != nil {
+		return m.Global
 	}
-	for i := range this.AccessList {
-		if !this.AccessList[i].Equal(&that1.AccessList[i]) {
-			return false
+	return false
+}
+
+// ResumeRestrictedTransfersProposal defines a governance proposal to lift a pause of restricted marker transfers
+// before its expiry height.
+type ResumeRestrictedTransfersProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *ResumeRestrictedTransfersProposal) Reset()      { *m = ResumeRestrictedTransfersProposal{} }
+func (*ResumeRestrictedTransfersProposal) ProtoMessage() {}
+func (*ResumeRestrictedTransfersProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{9}
+}
+func (m *ResumeRestrictedTransfersProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeRestrictedTransfersProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeRestrictedTransfersProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.SupplyFixed != that1.SupplyFixed {
-		return false
-	}
-	if this.AllowGovernanceControl != that1.AllowGovernanceControl {
-		return false
+}
+func (m *ResumeRestrictedTransfersProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeRestrictedTransfersProposal.Merge(m, src)
+}
+func (m *ResumeRestrictedTransfersProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeRestrictedTransfersProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeRestrictedTransfersProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeRestrictedTransfersProposal proto.InternalMessageInfo
+
+func (m *ResumeRestrictedTransfersProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return true
+	return ""
 }
-func (this *SupplyIncreaseProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ResumeRestrictedTransfersProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
+	return ""
+}
 
-	that1, ok := that.(*SupplyIncreaseProposal)
-	if !ok {
-		that2, ok := that.(SupplyIncreaseProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// SetIbcRateLimitProposal defines a governance proposal to set the quotas on the net ibc transfer flow of a marker
+// denom over a channel.  The flow of the current period is reset.
+type SetIbcRateLimitProposal struct {
+	Title          string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom          string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelId      string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MaxPercentSend uint32 `protobuf:"varint,5,opt,name=max_percent_send,json=maxPercentSend,proto3" json:"max_percent_send,omitempty"`
+	MaxPercentRecv uint32 `protobuf:"varint,6,opt,name=max_percent_recv,json=maxPercentRecv,proto3" json:"max_percent_recv,omitempty"`
+	DurationHours  uint64 `protobuf:"varint,7,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+}
+
+func (m *SetIbcRateLimitProposal) Reset()      { *m = SetIbcRateLimitProposal{} }
+func (*SetIbcRateLimitProposal) ProtoMessage() {}
+func (*SetIbcRateLimitProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{10}
+}
+func (m *SetIbcRateLimitProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetIbcRateLimitProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetIbcRateLimitProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
+}
+func (m *SetIbcRateLimitProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetIbcRateLimitProposal.Merge(m, src)
+}
+func (m *SetIbcRateLimitProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetIbcRateLimitProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetIbcRateLimitProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetIbcRateLimitProposal proto.InternalMessageInfo
+
+func (m *SetIbcRateLimitProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
+	return ""
+}
+
+func (m *SetIbcRateLimitProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if this.TargetAddress != that1.TargetAddress {
-		return false
+	return ""
+}
+
+func (m *SetIbcRateLimitProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return true
+	return ""
 }
-func (this *SupplyDecreaseProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SetIbcRateLimitProposal) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
+	return ""
+}
 
-	that1, ok := that.(*SupplyDecreaseProposal)
-	if !ok {
-		that2, ok := that.(SupplyDecreaseProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *SetIbcRateLimitProposal) GetMaxPercentSend() uint32 {
+	if m != nil {
+		return m.MaxPercentSend
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *SetIbcRateLimitProposal) GetMaxPercentRecv() uint32 {
+	if m != nil {
+		return m.MaxPercentRecv
 	}
-	if this.Title != that1.Title {
-		return false
+	return 0
+}
+
+func (m *SetIbcRateLimitProposal) GetDurationHours() uint64 {
+	if m != nil {
+		return m.DurationHours
 	}
-	if this.Description != that1.Description {
-		return false
+	return 0
+}
+
+// RemoveIbcRateLimitProposal defines a governance proposal to remove the quotas on the ibc transfer flow of a marker
+// denom over a channel.
+type RemoveIbcRateLimitProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom       string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelId   string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *RemoveIbcRateLimitProposal) Reset()      { *m = RemoveIbcRateLimitProposal{} }
+func (*RemoveIbcRateLimitProposal) ProtoMessage() {}
+func (*RemoveIbcRateLimitProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{11}
+}
+func (m *RemoveIbcRateLimitProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveIbcRateLimitProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveIbcRateLimitProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
+}
+func (m *RemoveIbcRateLimitProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveIbcRateLimitProposal.Merge(m, src)
+}
+func (m *RemoveIbcRateLimitProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveIbcRateLimitProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveIbcRateLimitProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveIbcRateLimitProposal proto.InternalMessageInfo
+
+func (m *RemoveIbcRateLimitProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return true
+	return ""
 }
-func (this *SetAdministratorProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RemoveIbcRateLimitProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
+	return ""
+}
 
-	that1, ok := that.(*SetAdministratorProposal)
-	if !ok {
-		that2, ok := that.(SetAdministratorProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *RemoveIbcRateLimitProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RemoveIbcRateLimitProposal) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// MigrateDenomProposal defines a governance proposal to migrate the holders of a marker denom to a successor marker
+// under a new denom.  The old marker is frozen and the successor is created with the same type, access and governance
+// control.  Holders are minted matching balances of the new denom over as many blocks as needed.
+type MigrateDenomProposal struct {
+	Title           string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom           string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	NewDenom        string `protobuf:"bytes,4,opt,name=new_denom,json=newDenom,proto3" json:"new_denom,omitempty"`
+	HoldersPerBlock uint32 `protobuf:"varint,5,opt,name=holders_per_block,json=holdersPerBlock,proto3" json:"holders_per_block,omitempty"`
+}
+
+func (m *MigrateDenomProposal) Reset()      { *m = MigrateDenomProposal{} }
+func (*MigrateDenomProposal) ProtoMessage() {}
+func (*MigrateDenomProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{12}
+}
+func (m *MigrateDenomProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateDenomProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateDenomProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *MigrateDenomProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateDenomProposal.Merge(m, src)
+}
+func (m *MigrateDenomProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateDenomProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateDenomProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateDenomProposal proto.InternalMessageInfo
+
+func (m *MigrateDenomProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if this.Title != that1.Title {
-		return false
+	return ""
+}
+
+func (m *MigrateDenomProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if this.Description != that1.Description {
-		return false
+	return ""
+}
+
+func (m *MigrateDenomProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if this.Denom != that1.Denom {
-		return false
+	return ""
+}
+
+func (m *MigrateDenomProposal) GetNewDenom() string {
+	if m != nil {
+		return m.NewDenom
 	}
-	if len(this.Access) != len(that1.Access) {
-		return false
+	return ""
+}
+
+func (m *MigrateDenomProposal) GetHoldersPerBlock() uint32 {
+	if m != nil {
+		return m.HoldersPerBlock
 	}
-	for i := range this.Access {
-		if !this.Access[i].Equal(&that1.Access[i]) {
-			return false
+	return 0
+}
+
+// SetTransferFeeProposal defines a governance proposal to set the fee charged on each transfer of a restricted marker
+type SetTransferFeeProposal struct {
+	Title       string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	TransferFee TransferFee `protobuf:"bytes,3,opt,name=transfer_fee,json=transferFee,proto3" json:"transfer_fee" yaml:"transfer_fee"`
+}
+
+func (m *SetTransferFeeProposal) Reset()      { *m = SetTransferFeeProposal{} }
+func (*SetTransferFeeProposal) ProtoMessage() {}
+func (*SetTransferFeeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{13}
+}
+func (m *SetTransferFeeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetTransferFeeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetTransferFeeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *RemoveAdministratorProposal) Equal(that interface{}) bool {
+func (m *SetTransferFeeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTransferFeeProposal.Merge(m, src)
+}
+func (m *SetTransferFeeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetTransferFeeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTransferFeeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTransferFeeProposal proto.InternalMessageInfo
+
+func (m *SetTransferFeeProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SetTransferFeeProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SetTransferFeeProposal) GetTransferFee() TransferFee {
+	if m != nil {
+		return m.TransferFee
+	}
+	return TransferFee{}
+}
+
+// RemoveTransferFeeProposal defines a governance proposal to remove the transfer fee of a restricted marker
+type RemoveTransferFeeProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom       string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *RemoveTransferFeeProposal) Reset()      { *m = RemoveTransferFeeProposal{} }
+func (*RemoveTransferFeeProposal) ProtoMessage() {}
+func (*RemoveTransferFeeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{14}
+}
+func (m *RemoveTransferFeeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveTransferFeeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveTransferFeeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveTransferFeeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTransferFeeProposal.Merge(m, src)
+}
+func (m *RemoveTransferFeeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveTransferFeeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTransferFeeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveTransferFeeProposal proto.InternalMessageInfo
+
+func (m *RemoveTransferFeeProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RemoveTransferFeeProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RemoveTransferFeeProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// AddToDenyListProposal defines a governance proposal to add addresses to the deny list blocking transfers of all
+// restricted markers to and from them.  Addresses already on the list have their reason replaced.
+type AddToDenyListProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Addresses   []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Reason      string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *AddToDenyListProposal) Reset()      { *m = AddToDenyListProposal{} }
+func (*AddToDenyListProposal) ProtoMessage() {}
+func (*AddToDenyListProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{15}
+}
+func (m *AddToDenyListProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddToDenyListProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddToDenyListProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddToDenyListProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToDenyListProposal.Merge(m, src)
+}
+func (m *AddToDenyListProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddToDenyListProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToDenyListProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddToDenyListProposal proto.InternalMessageInfo
+
+func (m *AddToDenyListProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *AddToDenyListProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AddToDenyListProposal) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *AddToDenyListProposal) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// RemoveFromDenyListProposal defines a governance proposal to remove addresses from the restricted marker deny list
+type RemoveFromDenyListProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Addresses   []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *RemoveFromDenyListProposal) Reset()      { *m = RemoveFromDenyListProposal{} }
+func (*RemoveFromDenyListProposal) ProtoMessage() {}
+func (*RemoveFromDenyListProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{16}
+}
+func (m *RemoveFromDenyListProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveFromDenyListProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveFromDenyListProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveFromDenyListProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromDenyListProposal.Merge(m, src)
+}
+func (m *RemoveFromDenyListProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveFromDenyListProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromDenyListProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveFromDenyListProposal proto.InternalMessageInfo
+
+func (m *RemoveFromDenyListProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RemoveFromDenyListProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RemoveFromDenyListProposal) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AddMarkerProposal)(nil), "provenance.marker.v1.AddMarkerProposal")
+	proto.RegisterType((*SupplyIncreaseProposal)(nil), "provenance.marker.v1.SupplyIncreaseProposal")
+	proto.RegisterType((*SupplyDecreaseProposal)(nil), "provenance.marker.v1.SupplyDecreaseProposal")
+	proto.RegisterType((*SetAdministratorProposal)(nil), "provenance.marker.v1.SetAdministratorProposal")
+	proto.RegisterType((*RemoveAdministratorProposal)(nil), "provenance.marker.v1.RemoveAdministratorProposal")
+	proto.RegisterType((*ChangeStatusProposal)(nil), "provenance.marker.v1.ChangeStatusProposal")
+	proto.RegisterType((*WithdrawEscrowProposal)(nil), "provenance.marker.v1.WithdrawEscrowProposal")
+	proto.RegisterType((*SetDenomMetadataProposal)(nil), "provenance.marker.v1.SetDenomMetadataProposal")
+	proto.RegisterType((*PauseRestrictedTransfersProposal)(nil), "provenance.marker.v1.PauseRestrictedTransfersProposal")
+	proto.RegisterType((*ResumeRestrictedTransfersProposal)(nil), "provenance.marker.v1.ResumeRestrictedTransfersProposal")
+	proto.RegisterType((*SetIbcRateLimitProposal)(nil), "provenance.marker.v1.SetIbcRateLimitProposal")
+	proto.RegisterType((*RemoveIbcRateLimitProposal)(nil), "provenance.marker.v1.RemoveIbcRateLimitProposal")
+	proto.RegisterType((*MigrateDenomProposal)(nil), "provenance.marker.v1.MigrateDenomProposal")
+	proto.RegisterType((*SetTransferFeeProposal)(nil), "provenance.marker.v1.SetTransferFeeProposal")
+	proto.RegisterType((*RemoveTransferFeeProposal)(nil), "provenance.marker.v1.RemoveTransferFeeProposal")
+	proto.RegisterType((*AddToDenyListProposal)(nil), "provenance.marker.v1.AddToDenyListProposal")
+	proto.RegisterType((*RemoveFromDenyListProposal)(nil), "provenance.marker.v1.RemoveFromDenyListProposal")
+}
+
+func init() {
+	proto.RegisterFile("provenance/marker/v1/proposals.proto", fileDescriptor_345320af87f4ec37)
+}
+
+var fileDescriptor_345320af87f4ec37 = []byte{
+	// 1165 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x36, 0x8e, 0x6b, 0x8f, 0x9b, 0x94, 0x2e, 0x26, 0xdd, 0x7e, 0xd9, 0x8e, 0xf9, 0xa8,
+	0x85, 0x54, 0x9b, 0x84, 0x0b, 0xca, 0x05, 0xd9, 0x2d, 0x6d, 0x22, 0x35, 0x22, 0xda, 0x44, 0x42,
+	0xe2, 0xb2, 0x1a, 0xef, 0xbe, 0xac, 0x57, 0xd9, 0x9d, 0x59, 0xcd, 0x8c, 0xbf, 0x24, 0xfe, 0x03,
+	0x0e, 0x20, 0x71, 0x80, 0x63, 0xcf, 0xdc, 0x10, 0x07, 0x4e, 0x70, 0x43, 0xea, 0x8d, 0x1e, 0x51,
+	0x0f, 0x01, 0x25, 0x42, 0xe2, 0xcc, 0x99, 0x03, 0x9a, 0x0f, 0xdb, 0x2b, 0x6a, 0xa2, 0xa2, 0x90,
+	0xa2, 0x9e, 0xbc, 0xf3, 0x7b, 0xbf, 0x7d, 0xef, 0xfd, 0x66, 0xde, 0x7b, 0xb3, 0x46, 0x6f, 0xa4,
+	0x8c, 0x0e, 0x80, 0x60, 0xe2, 0x43, 0x2b, 0xc1, 0xec, 0x10, 0x58, 0x6b, 0xb0, 0xde, 0x4a, 0x19,
+	0x4d, 0x29, 0xc7, 0x31, 0x6f, 0xa6, 0x8c, 0x0a, 0x6a, 0x97, 0x67, 0xac, 0xa6, 0x66, 0x35, 0x07,
+	0xeb, 0xd7, 0xcb, 0x21, 0x0d, 0xa9, 0x22, 0xb4, 0xe4, 0x93, 0xe6, 0x5e, 0xaf, 0xf8, 0x94, 0x27,
+	0x94, 0xb7, 0xba, 0x98, 0x1c, 0xb6, 0x06, 0xeb, 0x5d, 0x10, 0x78, 0x5d, 0x2d, 0x9e, 0xb1, 0x73,
+	0x98, 0xda, 0x7d, 0x1a, 0x11, 0x63, 0x5f, 0x9b, 0x9b, 0x91, 0x89, 0xaa, 0x29, 0x6f, 0xcd, 0xa5,
+	0x60, 0xdf, 0x07, 0xce, 0x43, 0x86, 0x89, 0xd0, 0xbc, 0xfa, 0x97, 0x39, 0x74, 0xa5, 0x1d, 0x04,
+	0x3b, 0x8a, 0xb2, 0x6b, 0x34, 0xd9, 0x65, 0xb4, 0x24, 0x22, 0x11, 0x83, 0x63, 0xd5, 0xac, 0x46,
+	0xd1, 0xd5, 0x0b, 0xbb, 0x86, 0x4a, 0x01, 0x70, 0x9f, 0x45, 0xa9, 0x88, 0x28, 0x71, 0x2e, 0x28,
+	0x5b, 0x16, 0xb2, 0xbb, 0x28, 0x8f, 0x13, 0xda, 0x27, 0xc2, 0x59, 0xac, 0x59, 0x8d, 0xd2, 0xc6,
+	0xb5, 0xa6, 0x56, 0xd2, 0x94, 0x4a, 0x9a, 0x46, 0x49, 0xf3, 0x2e, 0x8d, 0x48, 0xa7, 0xf5, 0xf8,
+	0xa8, 0xba, 0xf0, 0xf4, 0xa8, 0x7a, 0x3b, 0x8c, 0x44, 0xaf, 0xdf, 0x6d, 0xfa, 0x34, 0x69, 0x19,
+	0xd9, 0xfa, 0xe7, 0x0e, 0x0f, 0x0e, 0x5b, 0x62, 0x9c, 0x02, 0x57, 0x2f, 0xb8, 0xc6, 0xb3, 0xed,
+	0xa0, 0x8b, 0x09, 0x26, 0x38, 0x04, 0xe6, 0xe4, 0x54, 0x06, 0x93, 0xa5, 0xbd, 0x89, 0xf2, 0x5c,
+	0x60, 0xd1, 0xe7, 0xce, 0x52, 0xcd, 0x6a, 0xac, 0x6c, 0xd4, 0x9b, 0xf3, 0xce, 0xa4, 0xa9, 0xb5,
+	0xee, 0x29, 0xa6, 0x6b, 0xde, 0xb0, 0xdb, 0xa8, 0xa4, 0x19, 0x9e, 0x0c, 0xe9, 0xe4, 0x95, 0x83,
+	0xda, 0x69, 0x0e, 0xf6, 0xc7, 0x29, 0xb8, 0x28, 0x99, 0x3e, 0xdb, 0x5b, 0xa8, 0xa4, 0xf7, 0xd7,
+	0x8b, 0x23, 0x2e, 0x9c, 0x8b, 0xb5, 0xc5, 0x46, 0x69, 0x63, 0x6d, 0xbe, 0x8b, 0xb6, 0x22, 0x3e,
+	0x90, 0x07, 0xd1, 0xc9, 0xc9, 0x9d, 0x70, 0x91, 0x7e, 0xf7, 0x61, 0xc4, 0x85, 0xbd, 0x86, 0x2e,
+	0xf1, 0x7e, 0x9a, 0xc6, 0x63, 0xef, 0x20, 0x1a, 0x41, 0xe0, 0x14, 0x6a, 0x56, 0xa3, 0xe0, 0x96,
+	0x34, 0x76, 0x5f, 0x42, 0xf6, 0x7b, 0xc8, 0xc1, 0x71, 0x4c, 0x87, 0x5e, 0x48, 0x07, 0xc0, 0x94,
+	0x7b, 0xcf, 0xa7, 0x44, 0x30, 0x1a, 0x3b, 0x45, 0x45, 0x5f, 0x55, 0xf6, 0x07, 0x53, 0xf3, 0x5d,
+	0x6d, 0xb5, 0x6f, 0xa2, 0x22, 0x83, 0x03, 0x60, 0x40, 0x7c, 0x70, 0x90, 0xa2, 0xce, 0x80, 0xcd,
+	0xc2, 0x57, 0x8f, 0xaa, 0x0b, 0xbf, 0x3f, 0xaa, 0x5a, 0xf5, 0xdf, 0x2c, 0xb4, 0xba, 0xa7, 0x22,
+	0x6e, 0x13, 0x9f, 0x01, 0xe6, 0xf0, 0x52, 0x94, 0xc7, 0x9b, 0x68, 0x45, 0x60, 0x16, 0x82, 0xf0,
+	0x70, 0x10, 0x30, 0xe0, 0xdc, 0x54, 0xc9, 0xb2, 0x46, 0xdb, 0x1a, 0xcc, 0xe8, 0xfc, 0x61, 0xaa,
+	0xf3, 0x1e, 0xbc, 0x3c, 0x3a, 0x33, 0x02, 0xbe, 0xb5, 0x90, 0xb3, 0x27, 0x95, 0x25, 0x11, 0x89,
+	0xb8, 0x60, 0x58, 0xd0, 0xb3, 0x77, 0x72, 0x19, 0x2d, 0x05, 0x40, 0x68, 0xa2, 0x14, 0x14, 0x5d,
+	0xbd, 0xb0, 0xdf, 0x47, 0x79, 0x5d, 0xa6, 0x4e, 0xee, 0xdf, 0x55, 0xb7, 0x79, 0x2d, 0x93, 0xf5,
+	0x8f, 0x16, 0xba, 0xe1, 0x42, 0x42, 0x07, 0xf0, 0x22, 0x12, 0xbf, 0x8d, 0x2e, 0x33, 0x15, 0x2c,
+	0xc8, 0x94, 0xc5, 0x62, 0xa3, 0xe8, 0xae, 0x18, 0xd8, 0xd4, 0x85, 0x2c, 0x1f, 0xdd, 0x57, 0x94,
+	0xa5, 0x3d, 0x4c, 0x20, 0x50, 0xb3, 0xa4, 0xe0, 0x2e, 0x2b, 0xf4, 0x43, 0x03, 0x66, 0x74, 0x7c,
+	0x63, 0xa1, 0xf2, 0xdd, 0x1e, 0x26, 0x21, 0xe8, 0x89, 0x72, 0x4e, 0x02, 0xda, 0x08, 0x11, 0x18,
+	0x7a, 0x66, 0xbe, 0xe5, 0x9e, 0x7b, 0xbe, 0x15, 0x09, 0x0c, 0xf5, 0x63, 0x26, 0xe7, 0x3f, 0x2d,
+	0xb4, 0xfa, 0x51, 0x24, 0x7a, 0x01, 0xc3, 0xc3, 0x0f, 0xb8, 0xcf, 0xe8, 0xf0, 0x9c, 0xb2, 0xf6,
+	0xa7, 0x8d, 0xa0, 0xeb, 0xe5, 0x94, 0x46, 0x78, 0x47, 0xd6, 0xc9, 0xd7, 0xbf, 0x54, 0x1b, 0xcf,
+	0xd9, 0x08, 0xfc, 0x94, 0x8e, 0x5f, 0x3a, 0xbd, 0xe3, 0x7f, 0xd2, 0x0d, 0x73, 0x4f, 0xa6, 0xb8,
+	0x03, 0x02, 0x07, 0x58, 0xe0, 0x33, 0x6f, 0x40, 0x1f, 0x15, 0x12, 0xe3, 0xcb, 0x74, 0xfd, 0xad,
+	0x99, 0x58, 0x72, 0x38, 0x15, 0x3b, 0x09, 0xd8, 0xd9, 0x34, 0x9d, 0xbf, 0x71, 0xaa, 0xe0, 0x91,
+	0xfe, 0x48, 0xd0, 0xba, 0x27, 0xef, 0xba, 0xd3, 0x50, 0x9b, 0x39, 0xa9, 0xaa, 0xfe, 0xd4, 0x42,
+	0xb5, 0x5d, 0xdc, 0xe7, 0xe0, 0x02, 0x17, 0x2c, 0xf2, 0x05, 0x04, 0xfb, 0x0c, 0x13, 0x7e, 0x00,
+	0xec, 0xec, 0x05, 0xb9, 0x8a, 0xf2, 0xea, 0x34, 0xb9, 0xb3, 0xa8, 0x5a, 0xc6, 0xac, 0xec, 0xd7,
+	0xd1, 0x32, 0x8c, 0xd2, 0x88, 0x8d, 0xbd, 0x1e, 0x44, 0x61, 0x4f, 0xa8, 0xaa, 0x5c, 0x74, 0x2f,
+	0x69, 0x70, 0x4b, 0x61, 0x76, 0x15, 0x95, 0x7a, 0x38, 0x16, 0x9e, 0xbe, 0xbb, 0x4c, 0x33, 0x21,
+	0x09, 0xe9, 0x99, 0x2b, 0xbd, 0x87, 0x31, 0xed, 0xe2, 0x58, 0xdd, 0xb9, 0x05, 0xd7, 0xac, 0x32,
+	0xc7, 0x05, 0x68, 0xcd, 0x05, 0xde, 0x4f, 0xce, 0x43, 0x5c, 0x26, 0xcc, 0xa7, 0x17, 0xd0, 0xd5,
+	0x3d, 0x10, 0xdb, 0x5d, 0xdf, 0xc5, 0x02, 0x1e, 0x46, 0x49, 0x24, 0xce, 0xa9, 0x2b, 0x6e, 0x21,
+	0xe4, 0xf7, 0x30, 0x21, 0x10, 0x7b, 0x51, 0x60, 0xae, 0xa7, 0xa2, 0x41, 0xb6, 0x03, 0xbb, 0x81,
+	0x5e, 0x49, 0xf0, 0xc8, 0x4b, 0x81, 0xf9, 0x40, 0x84, 0xc7, 0x81, 0xe8, 0x21, 0xb4, 0xec, 0xae,
+	0x24, 0x78, 0xb4, 0xab, 0xe1, 0x3d, 0x20, 0xcf, 0x30, 0x19, 0xf8, 0x03, 0x27, 0xff, 0x77, 0xa6,
+	0x0b, 0xfe, 0x40, 0xf6, 0x48, 0xd0, 0x67, 0x58, 0x26, 0xe5, 0xf5, 0x68, 0x9f, 0x71, 0xe7, 0x62,
+	0xcd, 0x6a, 0xe4, 0xdc, 0xe5, 0x09, 0xba, 0x25, 0xc1, 0xcc, 0x6e, 0x7c, 0x61, 0xa1, 0xeb, 0x7a,
+	0x3c, 0xff, 0xef, 0x1b, 0x92, 0xc9, 0xea, 0x3b, 0x0b, 0x95, 0x77, 0xa2, 0x90, 0x61, 0x01, 0xaa,
+	0x7b, 0xcf, 0x29, 0x9f, 0x1b, 0x48, 0x8e, 0x4d, 0x4f, 0x5b, 0x74, 0x3a, 0x05, 0x02, 0x43, 0x15,
+	0xd2, 0x7e, 0x1b, 0x5d, 0xe9, 0xd1, 0x38, 0x00, 0xc6, 0xe5, 0xc6, 0x7b, 0xdd, 0x98, 0xfa, 0x87,
+	0xe6, 0x7c, 0x2e, 0x1b, 0xc3, 0x2e, 0xb0, 0x8e, 0x84, 0x33, 0x99, 0x7f, 0x2f, 0xbf, 0x32, 0x40,
+	0x4c, 0x0a, 0xf7, 0x3e, 0x9c, 0xfd, 0x2b, 0x03, 0xa3, 0x4b, 0xc2, 0xb8, 0xf3, 0x0e, 0x00, 0xcc,
+	0xd4, 0xf9, 0x87, 0x2b, 0x39, 0x13, 0xb8, 0x73, 0x43, 0x4e, 0x9e, 0x3f, 0x8e, 0xaa, 0xaf, 0x8e,
+	0x71, 0x12, 0x6f, 0xd6, 0xb3, 0x4e, 0xea, 0x6e, 0x49, 0xcc, 0x98, 0x99, 0xfc, 0x39, 0xba, 0xa6,
+	0xcb, 0xe1, 0xbf, 0x54, 0x30, 0x77, 0xf7, 0x33, 0x41, 0x3f, 0xb3, 0xd0, 0x6b, 0xed, 0x20, 0xd8,
+	0xa7, 0xf7, 0x80, 0x8c, 0xe5, 0x97, 0xf1, 0x99, 0x23, 0xde, 0x44, 0x45, 0x73, 0x49, 0xc0, 0x64,
+	0x9c, 0xcd, 0x00, 0x39, 0x8b, 0x18, 0x60, 0x4e, 0x89, 0x39, 0x74, 0xb3, 0xca, 0x64, 0xf4, 0xc9,
+	0xa4, 0x2b, 0xee, 0x33, 0x9a, 0xbc, 0x98, 0xac, 0x66, 0xd1, 0x3b, 0xe1, 0xe3, 0xe3, 0x8a, 0xf5,
+	0xe4, 0xb8, 0x62, 0xfd, 0x7a, 0x5c, 0xb1, 0x3e, 0x3f, 0xa9, 0x2c, 0x3c, 0x39, 0xa9, 0x2c, 0xfc,
+	0x7c, 0x52, 0x59, 0x40, 0x57, 0x23, 0x3a, 0xf7, 0xdc, 0x77, 0xad, 0x8f, 0xb3, 0xf7, 0xcb, 0x8c,
+	0x72, 0x27, 0xa2, 0x99, 0x55, 0x6b, 0x34, 0xf9, 0x93, 0xa8, 0x2e, 0x9a, 0x6e, 0x5e, 0xfd, 0x39,
+	0x7c, 0xf7, 0xaf, 0x01, 0x00, 0x5a, 0xf2, 0xb9, 0xae, 0xfb, 0x0e, 0x00, 0x00,
+}
+
+func (this *AddMarkerProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RemoveAdministratorProposal)
+	that1, ok := that.(*AddMarkerProposal)
 	if !ok {
-		that2, ok := that.(RemoveAdministratorProposal)
+		that2, ok := that.(AddMarkerProposal)
 		if ok {
 			that1 = &that2
 		} else {
@@ -824,10 +1350,167 @@ func (this *RemoveAdministratorProposal) Equal(that interface{}) bool {
 	if this.Description != that1.Description {
 		return false
 	}
-	if this.Denom != that1.Denom {
+	if !this.Amount.Equal(that1.Amount) {
 		return false
 	}
-	if len(this.RemovedAddress) != len(that1.RemovedAddress) {
+	if this.Manager != that1.Manager {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.MarkerType != that1.MarkerType {
+		return false
+	}
+	if len(this.AccessList) != len(that1.AccessList) {
+		return false
+	}
+	for i := range this.AccessList {
+		if !this.AccessList[i].Equal(&that1.AccessList[i]) {
+			return false
+		}
+	}
+	if this.SupplyFixed != that1.SupplyFixed {
+		return false
+	}
+	if this.AllowGovernanceControl != that1.AllowGovernanceControl {
+		return false
+	}
+	if this.Reference != that1.Reference {
+		return false
+	}
+	return true
+}
+func (this *SupplyIncreaseProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SupplyIncreaseProposal)
+	if !ok {
+		that2, ok := that.(SupplyIncreaseProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.TargetAddress != that1.TargetAddress {
+		return false
+	}
+	return true
+}
+func (this *SupplyDecreaseProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SupplyDecreaseProposal)
+	if !ok {
+		that2, ok := that.(SupplyDecreaseProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *SetAdministratorProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetAdministratorProposal)
+	if !ok {
+		that2, ok := that.(SetAdministratorProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.Access) != len(that1.Access) {
+		return false
+	}
+	for i := range this.Access {
+		if !this.Access[i].Equal(&that1.Access[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RemoveAdministratorProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemoveAdministratorProposal)
+	if !ok {
+		that2, ok := that.(RemoveAdministratorProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.RemovedAddress) != len(that1.RemovedAddress) {
 		return false
 	}
 	for i := range this.RemovedAddress {
@@ -835,6 +1518,9 @@ func (this *RemoveAdministratorProposal) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.AllowOrphaned != that1.AllowOrphaned {
+		return false
+	}
 	return true
 }
 func (this *ChangeStatusProposal) Equal(that interface{}) bool {
@@ -911,317 +1597,322 @@ func (this *WithdrawEscrowProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *AddMarkerProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PauseRestrictedTransfersProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *AddMarkerProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddMarkerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowGovernanceControl {
-		i--
-		if m.AllowGovernanceControl {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.SupplyFixed {
-		i--
-		if m.SupplyFixed {
-			dAtA[i] = 1
+	that1, ok := that.(*PauseRestrictedTransfersProposal)
+	if !ok {
+		that2, ok := that.(PauseRestrictedTransfersProposal)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x40
 	}
-	if len(m.AccessList) > 0 {
-		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProposals(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.MarkerType != 0 {
-		i = encodeVarintProposals(dAtA, i, uint64(m.MarkerType))
-		i--
-		dAtA[i] = 0x30
+	if this.Title != that1.Title {
+		return false
 	}
-	if m.Status != 0 {
-		i = encodeVarintProposals(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
+	if this.Description != that1.Description {
+		return false
 	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x22
+	if len(this.Denoms) != len(that1.Denoms) {
+		return false
 	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	for i := range this.Denoms {
+		if this.Denoms[i] != that1.Denoms[i] {
+			return false
 		}
-		i = encodeVarintProposals(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	if this.HaltSupply != that1.HaltSupply {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SupplyIncreaseProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Global != that1.Global {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SupplyIncreaseProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *SupplyIncreaseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TargetAddress) > 0 {
-		i -= len(m.TargetAddress)
-		copy(dAtA[i:], m.TargetAddress)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.TargetAddress)))
-		i--
-		dAtA[i] = 0x22
+func (this *ResumeRestrictedTransfersProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+
+	that1, ok := that.(*ResumeRestrictedTransfersProposal)
+	if !ok {
+		that2, ok := that.(ResumeRestrictedTransfersProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintProposals(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	if this.Title != that1.Title {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SupplyDecreaseProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Description != that1.Description {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SupplyDecreaseProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *SetIbcRateLimitProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SupplyDecreaseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*SetIbcRateLimitProposal)
+	if !ok {
+		that2, ok := that.(SetIbcRateLimitProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintProposals(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	if this.Title != that1.Title {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SetAdministratorProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Description != that1.Description {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SetAdministratorProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SetAdministratorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Access) > 0 {
-		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProposals(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	if this.Denom != that1.Denom {
+		return false
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
+	if this.ChannelId != that1.ChannelId {
+		return false
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	if this.MaxPercentSend != that1.MaxPercentSend {
+		return false
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	if this.MaxPercentRecv != that1.MaxPercentRecv {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RemoveAdministratorProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.DurationHours != that1.DurationHours {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveAdministratorProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *RemoveIbcRateLimitProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RemoveAdministratorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RemovedAddress) > 0 {
-		for iNdEx := len(m.RemovedAddress) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemovedAddress[iNdEx])
-			copy(dAtA[i:], m.RemovedAddress[iNdEx])
-			i = encodeVarintProposals(dAtA, i, uint64(len(m.RemovedAddress[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	that1, ok := that.(*RemoveIbcRateLimitProposal)
+	if !ok {
+		that2, ok := that.(RemoveIbcRateLimitProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	if this.Title != that1.Title {
+		return false
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	if this.Description != that1.Description {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.ChannelId != that1.ChannelId {
+		return false
+	}
+	return true
 }
+func (this *MigrateDenomProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ChangeStatusProposal) Marshal() (dAtA []byte, err error) {
+	that1, ok := that.(*MigrateDenomProposal)
+	if !ok {
+		that2, ok := that.(MigrateDenomProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.NewDenom != that1.NewDenom {
+		return false
+	}
+	if this.HoldersPerBlock != that1.HoldersPerBlock {
+		return false
+	}
+	return true
+}
+func (this *SetTransferFeeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetTransferFeeProposal)
+	if !ok {
+		that2, ok := that.(SetTransferFeeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !this.TransferFee.Equal(&that1.TransferFee) {
+		return false
+	}
+	return true
+}
+func (this *RemoveTransferFeeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemoveTransferFeeProposal)
+	if !ok {
+		that2, ok := that.(RemoveTransferFeeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	return true
+}
+func (this *AddToDenyListProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddToDenyListProposal)
+	if !ok {
+		that2, ok := that.(AddToDenyListProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *RemoveFromDenyListProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemoveFromDenyListProposal)
+	if !ok {
+		that2, ok := that.(RemoveFromDenyListProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *AddMarkerProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1231,28 +1922,87 @@ func (m *ChangeStatusProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChangeStatusProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddMarkerProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChangeStatusProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddMarkerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NewStatus != 0 {
-		i = encodeVarintProposals(dAtA, i, uint64(m.NewStatus))
+	if m.Reference {
 		i--
-		dAtA[i] = 0x20
+		if m.Reference {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+	if m.AllowGovernanceControl {
 		i--
-		dAtA[i] = 0x1a
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposals(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MarkerType != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposals(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
 		copy(dAtA[i:], m.Description)
@@ -1270,7 +2020,7 @@ func (m *ChangeStatusProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WithdrawEscrowProposal) Marshal() (dAtA []byte, err error) {
+func (m *SupplyIncreaseProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1280,12 +2030,12 @@ func (m *WithdrawEscrowProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WithdrawEscrowProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *SupplyIncreaseProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WithdrawEscrowProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SupplyIncreaseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1295,29 +2045,18 @@ func (m *WithdrawEscrowProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		copy(dAtA[i:], m.TargetAddress)
 		i = encodeVarintProposals(dAtA, i, uint64(len(m.TargetAddress)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x22
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProposals(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintProposals(dAtA, i, uint64(size))
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
 		copy(dAtA[i:], m.Description)
@@ -1335,7 +2074,7 @@ func (m *WithdrawEscrowProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *SetDenomMetadataProposal) Marshal() (dAtA []byte, err error) {
+func (m *SupplyDecreaseProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1345,20 +2084,20 @@ func (m *SetDenomMetadataProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetDenomMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *SupplyDecreaseProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetDenomMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SupplyDecreaseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Metadata.Size()
+		size := m.Amount.Size()
 		i -= size
-		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintProposals(dAtA, i, uint64(size))
@@ -1382,235 +2121,2759 @@ func (m *SetDenomMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProposals(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SetAdministratorProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AddMarkerProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SetAdministratorProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAdministratorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovProposals(uint64(l))
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovProposals(uint64(m.Status))
-	}
-	if m.MarkerType != 0 {
-		n += 1 + sovProposals(uint64(m.MarkerType))
-	}
-	if len(m.AccessList) > 0 {
-		for _, e := range m.AccessList {
-			l = e.Size()
-			n += 1 + l + sovProposals(uint64(l))
+	if len(m.Access) > 0 {
+		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposals(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if m.SupplyFixed {
-		n += 2
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.AllowGovernanceControl {
-		n += 2
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SupplyIncreaseProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovProposals(uint64(l))
-	l = len(m.TargetAddress)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+func (m *RemoveAdministratorProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SupplyDecreaseProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovProposals(uint64(l))
-	return n
+func (m *RemoveAdministratorProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetAdministratorProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RemoveAdministratorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if m.AllowOrphaned {
+		i--
+		if m.AllowOrphaned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.RemovedAddress) > 0 {
+		for iNdEx := len(m.RemovedAddress) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedAddress[iNdEx])
+			copy(dAtA[i:], m.RemovedAddress[iNdEx])
+			i = encodeVarintProposals(dAtA, i, uint64(len(m.RemovedAddress[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Access) > 0 {
-		for _, e := range m.Access {
-			l = e.Size()
-			n += 1 + l + sovProposals(uint64(l))
-		}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveAdministratorProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChangeStatusProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeStatusProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeStatusProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if m.NewStatus != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.NewStatus))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.RemovedAddress) > 0 {
-		for _, s := range m.RemovedAddress {
-			l = len(s)
-			n += 1 + l + sovProposals(uint64(l))
-		}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ChangeStatusProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WithdrawEscrowProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawEscrowProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawEscrowProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.TargetAddress) > 0 {
+		i -= len(m.TargetAddress)
+		copy(dAtA[i:], m.TargetAddress)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.TargetAddress)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposals(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.NewStatus != 0 {
-		n += 1 + sovProposals(uint64(m.NewStatus))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *WithdrawEscrowProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetDenomMetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDenomMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDenomMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	{
+		size := m.Metadata.Size()
+		i -= size
+		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposals(dAtA, i, uint64(size))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovProposals(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseRestrictedTransfersProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseRestrictedTransfersProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseRestrictedTransfersProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Global {
+		i--
+		if m.Global {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HaltSupply {
+		i--
+		if m.HaltSupply {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintProposals(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResumeRestrictedTransfersProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeRestrictedTransfersProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeRestrictedTransfersProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetIbcRateLimitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetIbcRateLimitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetIbcRateLimitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DurationHours != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.DurationHours))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxPercentRecv != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.MaxPercentRecv))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxPercentSend != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.MaxPercentSend))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveIbcRateLimitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveIbcRateLimitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveIbcRateLimitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrateDenomProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateDenomProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrateDenomProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HoldersPerBlock != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.HoldersPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NewDenom) > 0 {
+		i -= len(m.NewDenom)
+		copy(dAtA[i:], m.NewDenom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.NewDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetTransferFeeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetTransferFeeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetTransferFeeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TransferFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveTransferFeeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveTransferFeeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveTransferFeeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddToDenyListProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddToDenyListProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddToDenyListProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintProposals(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveFromDenyListProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveFromDenyListProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveFromDenyListProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintProposals(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposals(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddMarkerProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovProposals(uint64(m.Status))
+	}
+	if m.MarkerType != 0 {
+		n += 1 + sovProposals(uint64(m.MarkerType))
+	}
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	if m.Reference {
+		n += 2
+	}
+	return n
+}
+
+func (m *SupplyIncreaseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *SupplyDecreaseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	return n
+}
+
+func (m *SetAdministratorProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.Access) > 0 {
+		for _, e := range m.Access {
+			l = e.Size()
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveAdministratorProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.RemovedAddress) > 0 {
+		for _, s := range m.RemovedAddress {
+			l = len(s)
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	if m.AllowOrphaned {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChangeStatusProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.NewStatus != 0 {
+		n += 1 + sovProposals(uint64(m.NewStatus))
+	}
+	return n
+}
+
+func (m *WithdrawEscrowProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *SetDenomMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	return n
+}
+
+func (m *PauseRestrictedTransfersProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovProposals(uint64(m.ExpiryHeight))
+	}
+	if m.HaltSupply {
+		n += 2
+	}
+	if m.Global {
+		n += 2
+	}
+	return n
+}
+
+func (m *ResumeRestrictedTransfersProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *SetIbcRateLimitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.MaxPercentSend != 0 {
+		n += 1 + sovProposals(uint64(m.MaxPercentSend))
+	}
+	if m.MaxPercentRecv != 0 {
+		n += 1 + sovProposals(uint64(m.MaxPercentRecv))
+	}
+	if m.DurationHours != 0 {
+		n += 1 + sovProposals(uint64(m.DurationHours))
+	}
+	return n
+}
+
+func (m *RemoveIbcRateLimitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *MigrateDenomProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.NewDenom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.HoldersPerBlock != 0 {
+		n += 1 + sovProposals(uint64(m.HoldersPerBlock))
+	}
+	return n
+}
+
+func (m *SetTransferFeeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.TransferFee.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	return n
+}
+
+func (m *RemoveTransferFeeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *AddToDenyListProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveFromDenyListProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProposals(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposals(x uint64) (n int) {
+	return sovProposals(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddMarkerProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddMarkerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessGrant{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reference = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyIncreaseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyIncreaseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyIncreaseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyDecreaseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyDecreaseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyDecreaseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetAdministratorProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetAdministratorProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetAdministratorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Access = append(m.Access, AccessGrant{})
+			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveAdministratorProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveAdministratorProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveAdministratorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedAddress = append(m.RemovedAddress, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowOrphaned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowOrphaned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeStatusProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeStatusProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeStatusProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			m.NewStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewStatus |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawEscrowProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawEscrowProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawEscrowProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetDenomMetadataProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDenomMetadataProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDenomMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.TargetAddress)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	return n
-}
 
-func (m *SetDenomMetadataProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovProposals(uint64(l))
-	return n
-}
-
-func sovProposals(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProposals(x uint64) (n int) {
-	return sovProposals(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
+func (m *PauseRestrictedTransfersProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1633,10 +4896,10 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddMarkerProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseRestrictedTransfersProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddMarkerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseRestrictedTransfersProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1705,9 +4968,9 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1717,30 +4980,29 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposals
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposals
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			var stringLen uint64
+			m.ExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1750,29 +5012,16 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltSupply", wireType)
 			}
-			m.Status = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1782,16 +5031,17 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= MarkerStatus(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.HaltSupply = bool(v != 0)
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Global", wireType)
 			}
-			m.MarkerType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1801,16 +5051,67 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkerType |= MarkerType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			m.Global = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeRestrictedTransfersProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeRestrictedTransfersProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeRestrictedTransfersProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1820,31 +5121,29 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposals
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposals
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessList = append(m.AccessList, AccessGrant{})
-			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1854,32 +5153,24 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SupplyFixed = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
 			}
-			m.AllowGovernanceControl = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -1901,7 +5192,7 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SupplyIncreaseProposal) Unmarshal(dAtA []byte) error {
+func (m *SetIbcRateLimitProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1924,10 +5215,10 @@ func (m *SupplyIncreaseProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SupplyIncreaseProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetIbcRateLimitProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SupplyIncreaseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetIbcRateLimitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1996,9 +5287,9 @@ func (m *SupplyIncreaseProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -2008,28 +5299,27 @@ func (m *SupplyIncreaseProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposals
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposals
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				return io.ErrUnexpectedEOF
 			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2057,8 +5347,65 @@ func (m *SupplyIncreaseProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+			}
+			m.MaxPercentSend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPercentSend |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+			}
+			m.MaxPercentRecv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPercentRecv |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
+			}
+			m.DurationHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -2080,7 +5427,7 @@ func (m *SupplyIncreaseProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SupplyDecreaseProposal) Unmarshal(dAtA []byte) error {
+func (m *RemoveIbcRateLimitProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2103,10 +5450,10 @@ func (m *SupplyDecreaseProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SupplyDecreaseProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveIbcRateLimitProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SupplyDecreaseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveIbcRateLimitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2175,9 +5522,9 @@ func (m *SupplyDecreaseProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -2187,24 +5534,55 @@ func (m *SupplyDecreaseProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposals
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposals
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2227,7 +5605,7 @@ func (m *SupplyDecreaseProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetAdministratorProposal) Unmarshal(dAtA []byte) error {
+func (m *MigrateDenomProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2250,10 +5628,10 @@ func (m *SetAdministratorProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetAdministratorProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrateDenomProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetAdministratorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrateDenomProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2354,9 +5732,9 @@ func (m *SetAdministratorProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -2366,26 +5744,43 @@ func (m *SetAdministratorProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposals
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposals
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Access = append(m.Access, AccessGrant{})
-			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldersPerBlock", wireType)
+			}
+			m.HoldersPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldersPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -2407,7 +5802,7 @@ func (m *SetAdministratorProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveAdministratorProposal) Unmarshal(dAtA []byte) error {
+func (m *SetTransferFeeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2430,10 +5825,10 @@ func (m *RemoveAdministratorProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveAdministratorProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetTransferFeeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveAdministratorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetTransferFeeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2502,9 +5897,9 @@ func (m *RemoveAdministratorProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -2514,55 +5909,24 @@ func (m *RemoveAdministratorProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProposals
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposals
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.TransferFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RemovedAddress = append(m.RemovedAddress, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2585,7 +5949,7 @@ func (m *RemoveAdministratorProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeStatusProposal) Unmarshal(dAtA []byte) error {
+func (m *RemoveTransferFeeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2608,10 +5972,10 @@ func (m *ChangeStatusProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeStatusProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveTransferFeeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeStatusProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveTransferFeeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2710,25 +6074,6 @@ func (m *ChangeStatusProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
-			}
-			m.NewStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewStatus |= MarkerStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -2750,7 +6095,7 @@ func (m *ChangeStatusProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawEscrowProposal) Unmarshal(dAtA []byte) error {
+func (m *AddToDenyListProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2773,10 +6118,10 @@ func (m *WithdrawEscrowProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawEscrowProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddToDenyListProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawEscrowProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddToDenyListProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2845,7 +6190,7 @@ func (m *WithdrawEscrowProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2873,45 +6218,11 @@ func (m *WithdrawEscrowProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2939,7 +6250,7 @@ func (m *WithdrawEscrowProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2962,7 +6273,7 @@ func (m *WithdrawEscrowProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetDenomMetadataProposal) Unmarshal(dAtA []byte) error {
+func (m *RemoveFromDenyListProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2985,10 +6296,10 @@ func (m *SetDenomMetadataProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetDenomMetadataProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveFromDenyListProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetDenomMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveFromDenyListProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3057,9 +6368,9 @@ func (m *SetDenomMetadataProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -3069,24 +6380,23 @@ func (m *SetDenomMetadataProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposals
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposals
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -123,6 +123,7 @@ func TestProposalTypeRemoveAdministrator_Format(t *testing.T) {
   Title:       title
   Description: description
   Administrators To Remove: [%s]
+  Allow Orphaned: false
 `, addr), m.String())
 }
 
@@ -197,3 +198,171 @@ func TestProposalTypeSetDenomMetadataProposal_Format(t *testing.T) {
   Metadata:    %s
 `, m.Metadata.String()), m.String())
 }
+
+func TestProposalTypePauseRestrictedTransfers_Format(t *testing.T) {
+	m := NewPauseRestrictedTransfersProposal("title", "description", []string{"test", "test2"}, 100, true)
+	require.NotNil(t, m)
+
+	require.Equal(t, RouterKey, m.ProposalRoute())
+	require.Equal(t, ProposalTypePauseRestrictedTransfers, m.ProposalType())
+	require.NoError(t, m.ValidateBasic())
+
+	require.Equal(t, `Pause Restricted Transfers Proposal:
+  Title:         title
+  Description:   description
+  Markers:       test, test2
+  Expiry Height: 100
+  Halt Supply:   true
+`, m.String())
+
+	m.Denoms = []string{"test", "test"}
+	require.EqualError(t, m.ValidateBasic(), "duplicate denom test: invalid proposal content")
+
+	m.Denoms = nil
+	m.ExpiryHeight = 0
+	require.EqualError(t, m.ValidateBasic(), "expiry height must be greater than zero: invalid proposal content")
+
+	m.ExpiryHeight = 100
+	require.NoError(t, m.ValidateBasic())
+	require.Contains(t, m.String(), "Markers:       all restricted markers")
+}
+
+func TestProposalTypeResumeRestrictedTransfers_Format(t *testing.T) {
+	m := NewResumeRestrictedTransfersProposal("title", "description")
+	require.NotNil(t, m)
+
+	require.Equal(t, RouterKey, m.ProposalRoute())
+	require.Equal(t, ProposalTypeResumeRestrictedTransfers, m.ProposalType())
+	require.NoError(t, m.ValidateBasic())
+
+	require.Equal(t, `Resume Restricted Transfers Proposal:
+  Title:       title
+  Description: description
+`, m.String())
+}
+
+func TestProposalTypeSetIbcRateLimit_Format(t *testing.T) {
+	m := NewSetIbcRateLimitProposal("title", "description", "test", "channel-0", 10, 20, 24)
+	require.NotNil(t, m)
+
+	require.Equal(t, RouterKey, m.ProposalRoute())
+	require.Equal(t, ProposalTypeSetIbcRateLimit, m.ProposalType())
+	require.NoError(t, m.ValidateBasic())
+
+	require.Equal(t, `Set IBC Rate Limit Proposal:
+  Title:            title
+  Description:      description
+  Marker:           test
+  Channel:          channel-0
+  Max Percent Send: 10
+  Max Percent Recv: 20
+  Duration Hours:   24
+`, m.String())
+
+	m.MaxPercentRecv = 101
+	require.EqualError(t, m.ValidateBasic(), "max percent send and recv must be at most 100: invalid proposal content")
+
+	m.MaxPercentRecv = 20
+	m.DurationHours = 0
+	require.EqualError(t, m.ValidateBasic(), "duration hours must be greater than zero: invalid proposal content")
+
+	m.DurationHours = 24
+	m.ChannelId = "x"
+	require.Error(t, m.ValidateBasic())
+}
+
+func TestProposalTypeRemoveIbcRateLimit_Format(t *testing.T) {
+	m := NewRemoveIbcRateLimitProposal("title", "description", "test", "channel-0")
+	require.NotNil(t, m)
+
+	require.Equal(t, RouterKey, m.ProposalRoute())
+	require.Equal(t, ProposalTypeRemoveIbcRateLimit, m.ProposalType())
+	require.NoError(t, m.ValidateBasic())
+
+	require.Equal(t, `Remove IBC Rate Limit Proposal:
+  Title:       title
+  Description: description
+  Marker:      test
+  Channel:     channel-0
+`, m.String())
+
+	m.ChannelId = ""
+	require.Error(t, m.ValidateBasic())
+}
+
+func TestProposalTypeMigrateDenom_Format(t *testing.T) {
+	m := NewMigrateDenomProposal("title", "description", "test", "newtest", 100)
+	require.NotNil(t, m)
+
+	require.Equal(t, RouterKey, m.ProposalRoute())
+	require.Equal(t, ProposalTypeMigrateDenom, m.ProposalType())
+	require.NoError(t, m.ValidateBasic())
+
+	require.Equal(t, `Migrate Denom Proposal:
+  Title:             title
+  Description:       description
+  Marker:            test
+  New Denom:         newtest
+  Holders Per Block: 100
+`, m.String())
+
+	m.HoldersPerBlock = 0
+	require.EqualError(t, m.ValidateBasic(), "holders per block must be greater than zero: invalid proposal content")
+
+	m.HoldersPerBlock = 100
+	m.NewDenom = "test"
+	require.EqualError(t, m.ValidateBasic(), "new denom must be different from denom: invalid proposal content")
+}
+
+func TestProposalTypeSetTransferFee_Format(t *testing.T) {
+	m := NewSetTransferFeeProposal("title", "description", *NewBasisPointsTransferFee("test", 25, ""))
+	require.NotNil(t, m)
+
+	require.Equal(t, RouterKey, m.ProposalRoute())
+	require.Equal(t, ProposalTypeSetTransferFee, m.ProposalType())
+	require.NoError(t, m.ValidateBasic())
+
+	require.Equal(t, `Set Transfer Fee Proposal:
+  Title:       title
+  Description: description
+  Marker:      test
+  Fee:         25bps
+  Recipient:   marker account
+`, m.String())
+
+	m.TransferFee.BasisPoints = MaxTransferFeeBasisPoints + 1
+	require.EqualError(t, m.ValidateBasic(), "basis points 10001 cannot exceed 10000: invalid proposal content")
+
+	m.TransferFee = *NewFlatTransferFee("test", sdk.NewInt64Coin("nhash", 10), "invalid")
+	require.Error(t, m.ValidateBasic())
+
+	m.TransferFee.Recipient = ""
+	require.NoError(t, m.ValidateBasic())
+
+	m.TransferFee.BasisPoints = 25
+	require.EqualError(t, m.ValidateBasic(), "transfer fee must be either a flat fee or basis points: invalid proposal content")
+}
+
+func TestProposalTypeRemoveTransferFee_Format(t *testing.T) {
+	m := NewRemoveTransferFeeProposal("title", "description", "test")
+	require.NotNil(t, m)
+
+	require.Equal(t, RouterKey, m.ProposalRoute())
+	require.Equal(t, ProposalTypeRemoveTransferFee, m.ProposalType())
+	require.NoError(t, m.ValidateBasic())
+
+	require.Equal(t, `Remove Transfer Fee Proposal:
+  Title:       title
+  Description: description
+  Marker:      test
+`, m.String())
+}
+
+func TestProposalDenoms(t *testing.T) {
+	require.Equal(t, []string{"test"}, ProposalDenoms(NewSupplyIncreaseProposal("title", "description", sdk.NewInt64Coin("test", 10), "")))
+	require.Equal(t, []string{"test"}, ProposalDenoms(NewChangeStatusProposal("title", "description", "test", StatusCancelled)))
+	require.Equal(t, []string{"test"}, ProposalDenoms(NewSetDenomMetadataProposal("title", "description", banktypes.Metadata{Base: "test"})))
+	require.Equal(t, []string{"one", "two"}, ProposalDenoms(NewPauseRestrictedTransfersProposal("title", "description", []string{"one", "two"}, 100, false)))
+	require.Empty(t, ProposalDenoms(NewResumeRestrictedTransfersProposal("title", "description")))
+	require.Equal(t, []string{"test", "newtest"}, ProposalDenoms(NewMigrateDenomProposal("title", "description", "test", "newtest", 1)))
+}
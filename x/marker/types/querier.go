@@ -1,5 +1,16 @@
 package types
 
+import "time"
+
+const (
+	// DefaultDashboardActivityBlocks is the number of recent blocks counted by the issuer dashboard query when the
+	// request does not set one (about a day of blocks).
+	DefaultDashboardActivityBlocks int64 = 17280
+	// DefaultDashboardExpiringWithin is the window used by the issuer dashboard query for expiring grants when the
+	// request does not set one.
+	DefaultDashboardExpiringWithin = 7 * 24 * time.Hour
+)
+
 const (
 	QueryMarkers      = "all" // all instead of markers to prevent uri stuttering  in '/custom/marker/all'
 	QueryMarker       = "detail"
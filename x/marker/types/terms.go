@@ -0,0 +1,198 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// TermsSchemaV1 is the schema identifier of version 1 of the standardized marker terms document
+	TermsSchemaV1 = "provenance.marker.terms.v1"
+
+	// TermsEffectiveDateLayout is the layout of the effective date of a terms document
+	TermsEffectiveDateLayout = "2006-01-02"
+
+	// MaxTermsURILength is the longest uri a terms document can be anchored with
+	MaxTermsURILength = 1024
+)
+
+// TermsDocument is the standardized json schema of the terms document of a marker, the legal documents governing the
+// asset the marker represents.  The hash anchored to a marker is the sha256 hash of the canonical json encoding of
+// the document, with its keys sorted and insignificant whitespace removed.
+type TermsDocument struct {
+	// Schema is the identifier of the schema of the document, TermsSchemaV1
+	Schema string `json:"schema"`
+	// Denom is the denom of the marker the document governs
+	Denom string `json:"denom"`
+	// Title is the name of the document
+	Title string `json:"title"`
+	// Version is the version of the document assigned by its issuer
+	Version string `json:"version"`
+	// Issuer is the legal entity issuing the asset
+	Issuer string `json:"issuer"`
+	// Jurisdiction is the jurisdiction whose law governs the document
+	Jurisdiction string `json:"jurisdiction"`
+	// EffectiveDate is the date the document takes effect, formatted as YYYY-MM-DD
+	EffectiveDate string `json:"effective_date"`
+	// Documents are the legal documents making up the terms
+	Documents []TermsDocumentReference `json:"documents"`
+}
+
+// TermsDocumentReference is a legal document included in the terms of a marker by the hash of its contents.
+type TermsDocumentReference struct {
+	// Name is the name of the document
+	Name string `json:"name"`
+	// URI is where the document can be retrieved from
+	URI string `json:"uri"`
+	// Sha256 is the hex encoded sha256 hash of the contents of the document
+	Sha256 string `json:"sha256"`
+}
+
+// ParseTermsDocument strictly decodes a terms document from json and validates it.
+func ParseTermsDocument(bz []byte) (*TermsDocument, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	var doc TermsDocument
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidTerms, "could not decode terms document: %s", err)
+	}
+	if decoder.More() {
+		return nil, sdkerrors.Wrap(ErrInvalidTerms, "unexpected data after terms document")
+	}
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// Validate checks that all fields required by the schema are present and well formed.
+func (d TermsDocument) Validate() error {
+	if d.Schema != TermsSchemaV1 {
+		return sdkerrors.Wrapf(ErrInvalidTerms, "unsupported schema %q, expected %q", d.Schema, TermsSchemaV1)
+	}
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidTerms, "invalid denom: %s", err)
+	}
+	for name, value := range map[string]string{
+		"title":        d.Title,
+		"version":      d.Version,
+		"issuer":       d.Issuer,
+		"jurisdiction": d.Jurisdiction,
+	} {
+		if len(strings.TrimSpace(value)) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidTerms, "%s cannot be empty", name)
+		}
+	}
+	if _, err := time.Parse(TermsEffectiveDateLayout, d.EffectiveDate); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidTerms, "invalid effective date %q, expected YYYY-MM-DD", d.EffectiveDate)
+	}
+	if len(d.Documents) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTerms, "at least one document is required")
+	}
+	for i, ref := range d.Documents {
+		if len(strings.TrimSpace(ref.Name)) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidTerms, "document %d name cannot be empty", i)
+		}
+		if err := validateTermsURI(ref.URI); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidTerms, "document %d: %s", i, err)
+		}
+		if err := validateTermsHash(ref.Sha256); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidTerms, "document %d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// CanonicalJSON returns the canonical json encoding of the document, the bytes its hash is computed over.
+func (d TermsDocument) CanonicalJSON() ([]byte, error) {
+	bz, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.SortJSON(bz)
+}
+
+// Hash returns the hex encoded sha256 hash of the canonical json encoding of the document.
+func (d TermsDocument) Hash() (string, error) {
+	bz, err := d.CanonicalJSON()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(bz)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ValidateTerms checks the hash and uri anchoring a terms document to a marker.  Both are empty when the terms are
+// cleared, otherwise the hash must be a hex encoded sha256 hash and the uri, which is optional, a valid absolute uri.
+func ValidateTerms(termsHash, uri string) error {
+	if len(termsHash) == 0 {
+		if len(uri) > 0 {
+			return sdkerrors.Wrap(ErrInvalidTerms, "uri cannot be set without a terms hash")
+		}
+		return nil
+	}
+	if err := validateTermsHash(termsHash); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTerms, err.Error())
+	}
+	if len(uri) > 0 {
+		if err := validateTermsURI(uri); err != nil {
+			return sdkerrors.Wrap(ErrInvalidTerms, err.Error())
+		}
+	}
+	return nil
+}
+
+// Validate performs a static check over the terms entry format
+func (e MarkerTermsEntry) Validate() error {
+	if _, err := MarkerAddress(e.Denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %w", e.Denom, err)
+	}
+	if e.Sequence == 0 {
+		return fmt.Errorf("sequence must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	if e.BlockHeight < 0 {
+		return fmt.Errorf("block height cannot be negative")
+	}
+	return ValidateTerms(e.TermsHash, e.Uri)
+}
+
+// IsCleared returns true if the entry cleared the terms of its marker.
+func (e MarkerTermsEntry) IsCleared() bool {
+	return len(e.TermsHash) == 0
+}
+
+func validateTermsHash(termsHash string) error {
+	if len(termsHash) != sha256.Size*2 || strings.ToLower(termsHash) != termsHash {
+		return fmt.Errorf("hash must be %d lower case hex characters", sha256.Size*2)
+	}
+	if _, err := hex.DecodeString(termsHash); err != nil {
+		return fmt.Errorf("invalid hash: %w", err)
+	}
+	return nil
+}
+
+func validateTermsURI(uri string) error {
+	if len(uri) > MaxTermsURILength {
+		return fmt.Errorf("uri cannot be longer than %d characters", MaxTermsURILength)
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return fmt.Errorf("invalid uri: %w", err)
+	}
+	if !u.IsAbs() {
+		return fmt.Errorf("uri %q must be absolute", uri)
+	}
+	return nil
+}
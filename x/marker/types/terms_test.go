@@ -0,0 +1,76 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testTermsDocument = `{
+  "schema": "provenance.marker.terms.v1",
+  "denom": "termscoin",
+  "title": "Note Purchase Agreement",
+  "version": "1.0",
+  "issuer": "Example Issuer LLC",
+  "jurisdiction": "US-NY",
+  "effective_date": "2022-01-15",
+  "documents": [
+    {
+      "name": "agreement",
+      "uri": "https://example.com/terms/agreement.pdf",
+      "sha256": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+    }
+  ]
+}`
+
+func TestParseTermsDocument(t *testing.T) {
+	doc, err := ParseTermsDocument([]byte(testTermsDocument))
+	require.NoError(t, err)
+	require.Equal(t, "termscoin", doc.Denom)
+
+	// the hash is over the canonical encoding, so formatting does not change it
+	hash, err := doc.Hash()
+	require.NoError(t, err)
+	require.NoError(t, ValidateTerms(hash, ""))
+	compact := strings.Join(strings.Fields(testTermsDocument), "")
+	compact = strings.ReplaceAll(compact, "NotePurchaseAgreement", "Note Purchase Agreement")
+	compact = strings.ReplaceAll(compact, "ExampleIssuerLLC", "Example Issuer LLC")
+	other, err := ParseTermsDocument([]byte(compact))
+	require.NoError(t, err)
+	otherHash, err := other.Hash()
+	require.NoError(t, err)
+	require.Equal(t, hash, otherHash)
+
+	tests := []struct {
+		name   string
+		doc    string
+		errMsg string
+	}{
+		{"unknown field", strings.Replace(testTermsDocument, `"version"`, `"extra": "x", "version"`, 1), `unknown field "extra"`},
+		{"wrong schema", strings.Replace(testTermsDocument, ".v1", ".v2", 1), "unsupported schema"},
+		{"missing issuer", strings.Replace(testTermsDocument, "Example Issuer LLC", " ", 1), "issuer cannot be empty"},
+		{"bad effective date", strings.Replace(testTermsDocument, "2022-01-15", "01/15/2022", 1), "invalid effective date"},
+		{"relative document uri", strings.Replace(testTermsDocument, "https://example.com/terms/", "", 1), "must be absolute"},
+		{"bad document hash", strings.Replace(testTermsDocument, "9f86", "zz86", 1), "invalid hash"},
+		{"trailing data", testTermsDocument + "{}", "unexpected data"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseTermsDocument([]byte(tc.doc))
+			require.ErrorIs(t, err, ErrInvalidTerms)
+			require.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func TestValidateTerms(t *testing.T) {
+	hash := strings.Repeat("ab", 32)
+	require.NoError(t, ValidateTerms("", ""))
+	require.NoError(t, ValidateTerms(hash, ""))
+	require.NoError(t, ValidateTerms(hash, "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"))
+	require.ErrorIs(t, ValidateTerms("", "https://example.com"), ErrInvalidTerms)
+	require.ErrorIs(t, ValidateTerms(strings.ToUpper(hash), ""), ErrInvalidTerms)
+	require.ErrorIs(t, ValidateTerms(hash[2:], ""), ErrInvalidTerms)
+	require.ErrorIs(t, ValidateTerms(hash, "terms.json"), ErrInvalidTerms)
+}
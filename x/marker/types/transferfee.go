@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTransferFeeBasisPoints is the largest transfer fee in basis points, a fee equal to the transferred amount
+const MaxTransferFeeBasisPoints = 10_000
+
+// NewFlatTransferFee creates a transfer fee charging a flat amount on each transfer of a restricted marker
+func NewFlatTransferFee(denom string, fee sdk.Coin, recipient string) *TransferFee {
+	return &TransferFee{Denom: denom, FlatFee: fee, Recipient: recipient}
+}
+
+// NewBasisPointsTransferFee creates a transfer fee charging basis points of the amount of each transfer of a
+// restricted marker
+func NewBasisPointsTransferFee(denom string, basisPoints uint32, recipient string) *TransferFee {
+	return &TransferFee{Denom: denom, FlatFee: sdk.Coin{Amount: sdk.ZeroInt()}, BasisPoints: basisPoints, Recipient: recipient}
+}
+
+// Validate performs a static check over the transfer fee format
+func (f TransferFee) Validate() error {
+	if _, err := MarkerAddress(f.Denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %w", f.Denom, err)
+	}
+	hasFlatFee := len(f.FlatFee.Denom) > 0 || !(f.FlatFee.Amount.IsNil() || f.FlatFee.Amount.IsZero())
+	if hasFlatFee == (f.BasisPoints > 0) {
+		return fmt.Errorf("transfer fee must be either a flat fee or basis points")
+	}
+	if hasFlatFee {
+		if err := f.FlatFee.Validate(); err != nil {
+			return fmt.Errorf("invalid flat fee: %w", err)
+		}
+		if !f.FlatFee.IsPositive() {
+			return fmt.Errorf("flat fee must be positive")
+		}
+	}
+	if f.BasisPoints > MaxTransferFeeBasisPoints {
+		return fmt.Errorf("basis points %d cannot exceed %d", f.BasisPoints, MaxTransferFeeBasisPoints)
+	}
+	if len(f.Recipient) > 0 {
+		if _, err := sdk.AccAddressFromBech32(f.Recipient); err != nil {
+			return fmt.Errorf("invalid recipient: %w", err)
+		}
+	}
+	return nil
+}
+
+// Fee returns the fee charged on a transfer of the amount.  Basis point fees are rounded down and can be zero.
+func (f TransferFee) Fee(amount sdk.Int) sdk.Coin {
+	if f.BasisPoints > 0 {
+		return sdk.NewCoin(f.Denom, amount.MulRaw(int64(f.BasisPoints)).QuoRaw(MaxTransferFeeBasisPoints))
+	}
+	return f.FlatFee
+}
+
+// RecipientAddress returns the address transfer fees are paid to, the marker account unless a recipient is set.
+func (f TransferFee) RecipientAddress() (sdk.AccAddress, error) {
+	if len(f.Recipient) > 0 {
+		return sdk.AccAddressFromBech32(f.Recipient)
+	}
+	return MarkerAddress(f.Denom)
+}
+
+// FeeString returns a description of the fee, the flat fee coin or the basis points.
+func (f TransferFee) FeeString() string {
+	if f.BasisPoints > 0 {
+		return fmt.Sprintf("%dbps", f.BasisPoints)
+	}
+	return f.FlatFee.String()
+}
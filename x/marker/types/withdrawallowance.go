@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewWithdrawAllowance creates a new limit on the coin a grantee can withdraw from a marker within a rolling window
+func NewWithdrawAllowance(denom string, grantee sdk.AccAddress, maxAmount sdk.Coins, period time.Duration) *WithdrawAllowance { // nolint:interfacer
+	return &WithdrawAllowance{
+		Denom:     denom,
+		Grantee:   grantee.String(),
+		MaxAmount: maxAmount,
+		Period:    period,
+	}
+}
+
+// Validate performs a static check over the withdraw allowance format
+func (a WithdrawAllowance) Validate() error {
+	if _, err := MarkerAddress(a.Denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %w", a.Denom, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Grantee); err != nil {
+		return fmt.Errorf("invalid grantee: %w", err)
+	}
+	if a.MaxAmount.Empty() {
+		return fmt.Errorf("max amount cannot be empty")
+	}
+	if err := a.MaxAmount.Validate(); err != nil {
+		return fmt.Errorf("invalid max amount: %w", err)
+	}
+	if a.Period <= 0 {
+		return fmt.Errorf("period must be greater than zero")
+	}
+	for _, w := range a.Withdrawals {
+		if err := w.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid withdrawal amount: %w", err)
+		}
+	}
+	return nil
+}
+
+// Prune drops the withdrawals that are outside of the window ending at the given time.
+func (a *WithdrawAllowance) Prune(now time.Time) {
+	start := now.Add(-a.Period)
+	kept := make([]WithdrawalRecord, 0, len(a.Withdrawals))
+	for _, w := range a.Withdrawals {
+		if w.Time.After(start) {
+			kept = append(kept, w)
+		}
+	}
+	a.Withdrawals = kept
+}
+
+// Used returns the coin withdrawn within the window of the withdrawals.
+func (a WithdrawAllowance) Used() sdk.Coins {
+	used := sdk.NewCoins()
+	for _, w := range a.Withdrawals {
+		used = used.Add(w.Amount...)
+	}
+	return used
+}
+
+// Remaining returns the coin that can still be withdrawn within the window of the withdrawals.
+func (a WithdrawAllowance) Remaining() sdk.Coins {
+	remaining, _ := a.MaxAmount.SafeSub(a.Used())
+	var positive sdk.Coins
+	for _, c := range remaining {
+		if c.IsPositive() {
+			positive = append(positive, c)
+		}
+	}
+	return sdk.NewCoins(positive...)
+}
+
+// Use records a withdrawal at the given time, returning an error if it exceeds the coin remaining in the window.
+func (a *WithdrawAllowance) Use(now time.Time, amount sdk.Coins) error {
+	a.Prune(now)
+	remaining := a.Remaining()
+	if !remaining.IsAllGTE(amount) {
+		left := remaining.String()
+		if remaining.Empty() {
+			left = "nothing"
+		}
+		return sdkerrors.Wrapf(ErrWithdrawAllowance, "%s cannot withdraw %s from %s marker, allowance of %s per %s has %s remaining",
+			a.Grantee, amount, a.Denom, a.MaxAmount, a.Period, left)
+	}
+	a.Withdrawals = append(a.Withdrawals, WithdrawalRecord{Time: now, Amount: amount})
+	return nil
+}
@@ -321,18 +321,21 @@ specification_id: %s`,
 		s.contractSpecID,
 	)
 
-	s.recordAsJson = fmt.Sprintf("{\"name\":\"recordname\",\"session_id\":\"%s\",\"process\":{\"hash\":\"notarealprocesshash\",\"name\":\"record process\",\"method\":\"myMethod\"},\"inputs\":[{\"name\":\"inputname\",\"hash\":\"notarealrecordinputhash\",\"type_name\":\"inputtypename\",\"status\":\"RECORD_INPUT_STATUS_RECORD\"}],\"outputs\":[{\"hash\":\"notarealrecordoutputhash\",\"status\":\"RESULT_STATUS_PASS\"}],\"specification_id\":\"%s\"}",
+	s.recordAsJson = fmt.Sprintf("{\"name\":\"recordname\",\"session_id\":\"%s\",\"process\":{\"hash\":\"notarealprocesshash\",\"name\":\"record process\",\"method\":\"myMethod\"},\"inputs\":[{\"name\":\"inputname\",\"hash\":\"notarealrecordinputhash\",\"type_name\":\"inputtypename\",\"status\":\"RECORD_INPUT_STATUS_RECORD\",\"record_hash\":\"\",\"hash_algorithm\":\"HASH_ALGORITHM_UNSPECIFIED\"}],\"outputs\":[{\"hash\":\"notarealrecordoutputhash\",\"status\":\"RESULT_STATUS_PASS\",\"hash_algorithm\":\"HASH_ALGORITHM_UNSPECIFIED\"}],\"specification_id\":\"%s\"}",
 		s.sessionID,
 		s.recordSpecID,
 	)
 	s.recordAsText = fmt.Sprintf(`inputs:
 - hash: notarealrecordinputhash
+  hash_algorithm: HASH_ALGORITHM_UNSPECIFIED
   name: inputname
+  record_hash: ""
   status: RECORD_INPUT_STATUS_RECORD
   type_name: inputtypename
 name: recordname
 outputs:
 - hash: notarealrecordoutputhash
+  hash_algorithm: HASH_ALGORITHM_UNSPECIFIED
   status: RESULT_STATUS_PASS
 process:
   hash: notarealprocesshash
@@ -344,7 +347,7 @@ specification_id: %s`,
 		s.recordSpecID,
 	)
 
-	s.scopeSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"contract_spec_ids\":[\"%s\"]}",
+	s.scopeSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"contract_spec_ids\":[\"%s\"],\"any_owner_can_update\":false,\"strict\":false}",
 		s.scopeSpecID,
 		s.user1AddrStr,
 		s.contractSpecID,
@@ -362,7 +365,7 @@ specification_id: %s`,
 		s.scopeSpecID,
 	)
 
-	s.contractSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"hash\":\"notreallyasourcehash\",\"class_name\":\"contractclassname\"}",
+	s.contractSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"hash\":\"notreallyasourcehash\",\"class_name\":\"contractclassname\",\"any_owner_can_update\":false}",
 		s.contractSpecID,
 		s.user1AddrStr,
 	)
@@ -378,7 +381,7 @@ specification_id: %s`,
 		s.contractSpecID,
 	)
 
-	s.recordSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"name\":\"recordname\",\"inputs\":[{\"name\":\"inputname\",\"type_name\":\"inputtypename\",\"hash\":\"alsonotreallyasourcehash\"}],\"type_name\":\"recordtypename\",\"result_type\":\"DEFINITION_TYPE_RECORD\",\"responsible_parties\":[\"PARTY_TYPE_OWNER\"]}",
+	s.recordSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"name\":\"recordname\",\"inputs\":[{\"name\":\"inputname\",\"type_name\":\"inputtypename\",\"hash\":\"alsonotreallyasourcehash\"}],\"type_name\":\"recordtypename\",\"result_type\":\"DEFINITION_TYPE_RECORD\",\"responsible_parties\":[\"PARTY_TYPE_OWNER\"],\"input_validation\":\"INPUT_VALIDATION_LEVEL_UNSPECIFIED\"}",
 		s.recordSpecID,
 	)
 	s.recordSpecAsText = fmt.Sprintf(`inputs:
@@ -591,13 +594,13 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 			"get params as json output",
 			[]string{s.asJson},
 			"",
-			[]string{"\"params\":{}"},
+			[]string{"\"params\":{\"validate_cross_scope_record_inputs\":false,\"max_scope_history_entries\":100,\"strict_scope_specifications\":false,\"large_record_threshold\":65536}"},
 		},
 		{
 			"get params as text output",
 			[]string{s.asText},
 			"",
-			[]string{"params: {}"},
+			[]string{"params:\n  large_record_threshold: 65536\n  max_scope_history_entries: 100\n  strict_scope_specifications: false\n  validate_cross_scope_record_inputs: false"},
 		},
 		{
 			"get params - invalid args",
@@ -609,7 +612,7 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 			"get params as json output including request",
 			[]string{s.asJson, s.includeRequest},
 			"",
-			[]string{"\"params\":{}", "\"request\":{}"},
+			[]string{"\"params\":{\"validate_cross_scope_record_inputs\":false,\"max_scope_history_entries\":100,\"strict_scope_specifications\":false,\"large_record_threshold\":65536}", "\"request\":{}"},
 		},
 		{
 			"get locator params as json",
@@ -1737,6 +1740,84 @@ func (s *IntegrationCLITestSuite) TestGetValueOwnershipCmd() {
 	runQueryCmdTestCases(s, cmd, testCases)
 }
 
+func (s *IntegrationCLITestSuite) TestGetScopeHistoryCmd() {
+	cmd := func() *cobra.Command { return cli.GetScopeHistoryCmd() }
+
+	testCases := []queryCmdTestCase{
+		{
+			"scope id as json",
+			[]string{s.scopeID.String(), s.asJson},
+			"",
+			[]string{"\"entries\":[]", "\"pagination\":{\"next_key\":null,\"total\":\"0\"}"},
+		},
+		{
+			"scope uuid as text",
+			[]string{s.scopeUUID.String(), s.asText},
+			"",
+			[]string{"entries: []", "total: \"0\""},
+		},
+		{
+			"scope id as json including request",
+			[]string{s.scopeID.String(), s.asJson, s.includeRequest},
+			"",
+			[]string{fmt.Sprintf("\"request\":{\"scope_id\":\"%s\"", s.scopeID)},
+		},
+		{
+			"session id",
+			[]string{s.sessionID.String()},
+			fmt.Sprintf("address [%s] is not a scope address", s.sessionID),
+			[]string{},
+		},
+		{
+			"no args",
+			[]string{},
+			"accepts 1 arg(s), received 0",
+			[]string{},
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+}
+
+func (s *IntegrationCLITestSuite) TestGetRecordReverificationsCmd() {
+	cmd := func() *cobra.Command { return cli.GetRecordReverificationsCmd() }
+
+	testCases := []queryCmdTestCase{
+		{
+			"all as json",
+			[]string{s.asJson},
+			"",
+			[]string{"\"reverifications\":[]", "\"pagination\":{\"next_key\":null,\"total\":\"0\"}"},
+		},
+		{
+			"record id as json including request",
+			[]string{s.recordID.String(), s.asJson, s.includeRequest},
+			"",
+			[]string{fmt.Sprintf("\"request\":{\"record_addr\":\"%s\"", s.recordID)},
+		},
+		{
+			"scope uuid with status as text",
+			[]string{s.scopeUUID.String(), "--status", "open", s.asText, s.includeRequest},
+			"",
+			[]string{"reverifications: []", "status: REVERIFICATION_STATUS_OPEN"},
+		},
+		{
+			"unknown status",
+			[]string{s.scopeID.String(), "--status", "pending"},
+			"unknown reverification status: pending",
+			[]string{},
+		},
+		{
+			"session id",
+			[]string{s.sessionID.String()},
+			fmt.Sprintf("address [%s] is not a scope address", s.sessionID),
+			[]string{},
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+}
+
 func (s *IntegrationCLITestSuite) TestGetOSLocatorCmd() {
 	cmd := func() *cobra.Command { return cli.GetOSLocatorCmd() }
 
@@ -1880,6 +1961,90 @@ func (s *IntegrationCLITestSuite) TestGetOSLocatorCmd() {
 	runQueryCmdTestCases(s, cmd, testCases)
 }
 
+func (s *IntegrationCLITestSuite) TestGetDeriveAddressCmd() {
+	cmd := func() *cobra.Command { return cli.GetDeriveAddressCmd() }
+
+	testCases := []queryCmdTestCase{
+		{
+			"scope address components as json",
+			[]string{s.scopeID.String(), s.asJson},
+			"",
+			[]string{
+				fmt.Sprintf("\"address\":\"%s\"", s.scopeID),
+				fmt.Sprintf("\"scope_uuid\":\"%s\"", s.scopeUUID),
+			},
+		},
+		{
+			"scope as text",
+			[]string{"scope", s.scopeUUID.String(), s.asText},
+			"",
+			[]string{fmt.Sprintf("address: %s", s.scopeID), fmt.Sprintf("scope_uuid: %s", s.scopeUUID)},
+		},
+		{
+			"session",
+			[]string{"session", s.scopeUUID.String(), s.sessionUUID.String(), s.asJson},
+			"",
+			[]string{fmt.Sprintf("\"address\":\"%s\"", s.sessionID)},
+		},
+		{
+			"record",
+			[]string{"record", s.scopeUUID.String(), s.recordName, s.asJson},
+			"",
+			[]string{fmt.Sprintf("\"address\":\"%s\"", s.recordID)},
+		},
+		{
+			"scope spec",
+			[]string{"scopespec", s.scopeSpecUUID.String(), s.asJson},
+			"",
+			[]string{fmt.Sprintf("\"address\":\"%s\"", s.scopeSpecID)},
+		},
+		{
+			"contract spec",
+			[]string{"contractspec", s.contractSpecUUID.String(), s.asJson},
+			"",
+			[]string{fmt.Sprintf("\"address\":\"%s\"", s.contractSpecID)},
+		},
+		{
+			"record spec",
+			[]string{"recspec", s.contractSpecUUID.String(), s.recordName, s.asJson},
+			"",
+			[]string{fmt.Sprintf("\"address\":\"%s\"", s.recordSpecID)},
+		},
+		{
+			"record without name",
+			[]string{"record", s.scopeUUID.String()},
+			"a record name is required to derive a record address",
+			[]string{},
+		},
+		{
+			"scope with extra arg",
+			[]string{"scope", s.scopeUUID.String(), "extra"},
+			"too many arguments to derive a scope address",
+			[]string{},
+		},
+		{
+			"unknown type",
+			[]string{"thing", s.scopeUUID.String()},
+			"unknown metadata address type: thing",
+			[]string{},
+		},
+		{
+			"invalid uuid",
+			[]string{"scope", "not-a-uuid"},
+			"rpc error: code = InvalidArgument desc = invalid scope uuid [not-a-uuid]: invalid UUID length: 10: invalid request",
+			[]string{},
+		},
+		{
+			"no args",
+			[]string{},
+			"requires at least 1 arg(s), only received 0",
+			[]string{},
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+}
+
 // ---------- tx cmd tests ----------
 
 type txCmdTestCase struct {
@@ -2794,6 +2959,46 @@ func (s *IntegrationCLITestSuite) TestRecordSpecificationTxCommands() {
 			"",
 			&sdk.TxResponse{}, 0,
 		},
+		{
+			"should successfully add record specification with warn input validation",
+			cli.WriteRecordSpecificationCmd(),
+			[]string{
+				specificationID.String(),
+				recordName,
+				"record1,typename1,hashvalue",
+				"typename",
+				"record",
+				"responsibleparties",
+				fmt.Sprintf("--%s=%s", cli.FlagInputValidation, "warn"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false,
+			"",
+			&sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to add record specification, unknown input validation level",
+			cli.WriteRecordSpecificationCmd(),
+			[]string{
+				specificationID.String(),
+				recordName,
+				"record1,typename1,hashvalue",
+				"typename",
+				"record",
+				"responsibleparties",
+				fmt.Sprintf("--%s=%s", cli.FlagInputValidation, "lenient"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true,
+			"unknown input validation level: lenient",
+			&sdk.TxResponse{}, 0,
+		},
 		{
 			"should fail to add record specification, validate basic fail",
 			cmd,
@@ -3125,6 +3330,48 @@ func (s *IntegrationCLITestSuite) TestRecordTxCommands() {
 			&sdk.TxResponse{},
 			0,
 		},
+		{
+			"should fail to add record unknown record input hash algorithm",
+			addRecordCmd,
+			[]string{
+				scopeID.String(),
+				recSpecID.String(),
+				recordName,
+				"processname,hashvalue,methodname",
+				"input1name,hashvalue,typename1,proposed,md5",
+				"outputhashvalue,pass",
+				fmt.Sprintf("%s,owner;%s,originator", userAddress, userAddress),
+				contractSpecID.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, "unknown hash algorithm: md5",
+			&sdk.TxResponse{},
+			0,
+		},
+		{
+			"should fail to add record output hash not matching hash algorithm digest size",
+			addRecordCmd,
+			[]string{
+				scopeID.String(),
+				recSpecID.String(),
+				recordName,
+				"processname,hashvalue,methodname",
+				"input1name,hashvalue,typename1,proposed",
+				"outputhashvalue,pass,sha256",
+				fmt.Sprintf("%s,owner;%s,originator", userAddress, userAddress),
+				contractSpecID.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, "invalid record output: invalid HASH_ALGORITHM_SHA256 hash outputhashvalue: expected a hex or base64 encoded 32 byte digest",
+			&sdk.TxResponse{},
+			0,
+		},
 		{
 			"should fail to add record incorrect record output format",
 			addRecordCmd,
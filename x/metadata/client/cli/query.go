@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"regexp"
 	"strings"
 
@@ -15,6 +16,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/version"
 
@@ -28,6 +30,7 @@ var (
 	includeSessions    bool
 	includeRecords     bool
 	includeRecordSpecs bool
+	includeSpecs       bool
 	includeRequest     bool
 )
 
@@ -55,7 +58,13 @@ func GetQueryCmd() *cobra.Command {
 		GetMetadataRecordSpecCmd(),
 		GetOwnershipCmd(),
 		GetValueOwnershipCmd(),
+		GetScopesByPartyCmd(),
+		GetScopeHistoryCmd(),
+		GetRecordReverificationsCmd(),
+		GetSpecCompatibilityCmd(),
 		GetOSLocatorCmd(),
+		GetDeriveAddressCmd(),
+		GetValidateWriteCmd(),
 	)
 	return queryCmd
 }
@@ -139,6 +148,7 @@ func GetMetadataByIDCmd() *cobra.Command {
 	addIncludeSessionsFlag(cmd)
 	addIncludeRecordsFlag(cmd)
 	addIncludeRecordSpecsFlag(cmd)
+	addIncludeSpecsFlag(cmd)
 	addIncludeRequestFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -221,6 +231,7 @@ func GetMetadataScopeCmd() *cobra.Command {
 %[1]s scope 91978ba2-5f35-459a-86a7-feca1b0512e0
 %[1]s scope session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr
 %[1]s scope record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
+%[1]s scope scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel --include-sessions --include-records --include-specs
 %[1]s scope all`, cmdStart),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			arg0 := strings.TrimSpace(args[0])
@@ -244,6 +255,7 @@ func GetMetadataScopeCmd() *cobra.Command {
 
 	addIncludeSessionsFlag(cmd)
 	addIncludeRecordsFlag(cmd)
+	addIncludeSpecsFlag(cmd)
 	addIncludeRequestFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "scopes (all)")
@@ -524,6 +536,141 @@ func GetValueOwnershipCmd() *cobra.Command {
 	return cmd
 }
 
+// GetScopesByPartyCmd returns the command handler for metadata scope querying by owner party address and role
+func GetScopesByPartyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "party address [roles]",
+		Aliases: []string{"p", "parties"},
+		Short:   "Query the current metadata for scopes with the provided address as an owner party",
+		Long: fmt.Sprintf(`%[1]s party {address} - gets a list of scope uuids and roles for the provided owner address.
+%[1]s party {address} {roles} - gets a list of scope uuids where the provided address has one of the comma
+separated roles, e.g. servicer,custodian.`, cmdStart),
+		Args:    cobra.RangeArgs(1, 2),
+		Example: fmt.Sprintf(`%[1]s party pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 servicer`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address := strings.TrimSpace(args[0])
+			if len(address) == 0 {
+				return fmt.Errorf("empty address")
+			}
+			var roles []types.PartyType
+			if len(args) > 1 {
+				roles = parsePartyTypes(args[1])
+			}
+			return outputScopesByParty(cmd, address, roles)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scopes")
+
+	return cmd
+}
+
+// GetScopeHistoryCmd returns the command handler for metadata scope audit trail querying
+func GetScopeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history {scope_id|scope_uuid}",
+		Aliases: []string{"h", "scopehistory"},
+		Short:   "Query the audit trail of a scope",
+		Long: fmt.Sprintf(`%[1]s history {scope_id} - gets the audit trail of the scope with the given address.
+%[1]s history {scope_uuid} - gets the audit trail of the scope with the given uuid.
+
+Each entry has the message that changed the scope or one of its sessions or records, who signed it, and the block
+and transaction it was in.  Entries are oldest first.`, cmdStart),
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s history scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			scopeID := strings.TrimSpace(args[0])
+			if len(scopeID) == 0 {
+				return fmt.Errorf("empty scope id")
+			}
+			return outputScopeHistory(cmd, scopeID)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "entries")
+
+	return cmd
+}
+
+// GetRecordReverificationsCmd returns the command handler for record re-verification request querying
+func GetRecordReverificationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reverifications [{record_id|scope_id|scope_uuid}]",
+		Aliases: []string{"rv", "reverification"},
+		Short:   "Query the re-verification requests of records",
+		Long: fmt.Sprintf(`%[1]s reverifications - gets all record re-verification requests.
+%[1]s reverifications {record_id} - gets the re-verification requests of the record with the given address.
+%[1]s reverifications {scope_id|scope_uuid} - gets the re-verification requests of the records in the given scope.
+
+Use --%[2]s to limit the results to requests with a status, e.g. open, confirmed, corrected, or withdrawn.`,
+			cmdStart, FlagStatus),
+		Args: cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(`%[1]s reverifications scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel --%[2]s open`,
+			cmdStart, FlagStatus),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := types.RecordReverificationsRequest{}
+			if len(args) > 0 {
+				id := strings.TrimSpace(args[0])
+				if addr, err := types.MetadataAddressFromBech32(id); err == nil && addr.IsRecordAddress() {
+					req.RecordAddr = id
+				} else {
+					req.ScopeId = id
+				}
+			}
+			status, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			if len(status) > 0 {
+				if req.Status, err = parseReverificationStatus(status); err != nil {
+					return err
+				}
+			}
+			return outputRecordReverifications(cmd, req)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "only include requests with this status")
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reverifications")
+
+	return cmd
+}
+
+// GetSpecCompatibilityCmd returns the command handler for checking the compatibility of two scope specifications.
+func GetSpecCompatibilityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "spec-compatibility {old_scope_spec_id|old_scope_spec_uuid} {new_scope_spec_id|new_scope_spec_uuid}",
+		Aliases: []string{"compat", "speccompat"},
+		Short:   "Query the differences between two scope specifications that would break existing scopes",
+		Long: fmt.Sprintf(`%[1]s spec-compatibility {old_scope_spec_id} {new_scope_spec_id} - reports the breaking differences.
+
+Breaking differences are removed contract or record specifications, new party requirements, and changed record
+inputs or result types.  Contract specifications are paired by id, or else by class name, and record specifications
+are paired by name.`, cmdStart),
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`%[1]s spec-compatibility scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m scopespec1qjpreurq8n7ylc4y5zw6gn255lkqle56sv`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oldSpecID := strings.TrimSpace(args[0])
+			newSpecID := strings.TrimSpace(args[1])
+			if len(oldSpecID) == 0 || len(newSpecID) == 0 {
+				return fmt.Errorf("empty scope specification id")
+			}
+			return outputSpecCompatibility(cmd, oldSpecID, newSpecID)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetOSLocatorCmd returns the command handler for metadata object store locator querying.
 func GetOSLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -580,6 +727,134 @@ func GetOSLocatorCmd() *cobra.Command {
 	return cmd
 }
 
+// GetDeriveAddressCmd returns the command handler for deriving metadata addresses.
+func GetDeriveAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "derive {address|{scope|session|record|scopespec|contractspec|recspec} uuid [uuid|name]}",
+		Aliases: []string{"d", "addr"},
+		Short:   "Derive a metadata address from its uuids and name, or get the components of a metadata address",
+		Long: fmt.Sprintf(`%[1]s derive {address} - gets the components of the provided metadata address.
+%[1]s derive scope {scope_uuid} - derives the address of a scope.
+%[1]s derive session {scope_uuid} {session_uuid} - derives the address of a session.
+%[1]s derive record {scope_uuid} {record_name} - derives the address of a record.
+%[1]s derive scopespec {scope_spec_uuid} - derives the address of a scope specification.
+%[1]s derive contractspec {contract_spec_uuid} - derives the address of a contract specification.
+%[1]s derive recspec {contract_spec_uuid} {record_name} - derives the address of a record specification.
+
+The derivation is done by the chain so it always matches the addresses used to store metadata.`, cmdStart),
+		Args: cobra.MinimumNArgs(1),
+		Example: fmt.Sprintf(`%[1]s derive scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s derive scope 91978ba2-5f35-459a-86a7-feca1b0512e0
+%[1]s derive session 91978ba2-5f35-459a-86a7-feca1b0512e0 5803f8bc-6067-4eb5-951f-2121671c2ec0
+%[1]s derive record 91978ba2-5f35-459a-86a7-feca1b0512e0 recordname
+%[1]s derive scopespec dc83ea70-eacd-40fe-9adf-1cf6148bf8a2
+%[1]s derive contractspec def6bc0a-c9dd-4874-948f-5206e6060a84
+%[1]s derive recspec def6bc0a-c9dd-4874-948f-5206e6060a84 recordname`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg0 := strings.TrimSpace(args[0])
+			if len(args) == 1 {
+				if _, err := types.MetadataAddressFromBech32(arg0); err == nil {
+					return outputDeriveAddress(cmd, types.DeriveAddressRequest{Address: arg0})
+				}
+			}
+			if len(args) < 2 {
+				return fmt.Errorf("a uuid is required to derive a %s address", arg0)
+			}
+			arg1 := strings.TrimSpace(args[1])
+			rest := trimSpaceAndJoin(args[2:], " ")
+			var req types.DeriveAddressRequest
+			switch arg0 {
+			case types.PrefixScope:
+				req = types.DeriveAddressRequest{ScopeUuid: arg1}
+			case types.PrefixSession:
+				if len(rest) == 0 {
+					return errors.New("a session uuid is required to derive a session address")
+				}
+				req = types.DeriveAddressRequest{ScopeUuid: arg1, SessionUuid: rest}
+			case types.PrefixRecord:
+				if len(rest) == 0 {
+					return errors.New("a record name is required to derive a record address")
+				}
+				req = types.DeriveAddressRequest{ScopeUuid: arg1, Name: rest}
+			case types.PrefixScopeSpecification:
+				req = types.DeriveAddressRequest{ScopeSpecUuid: arg1}
+			case types.PrefixContractSpecification:
+				req = types.DeriveAddressRequest{ContractSpecUuid: arg1}
+			case types.PrefixRecordSpecification:
+				if len(rest) == 0 {
+					return errors.New("a record name is required to derive a record specification address")
+				}
+				req = types.DeriveAddressRequest{ContractSpecUuid: arg1, Name: rest}
+			default:
+				return fmt.Errorf("unknown metadata address type: %s", arg0)
+			}
+			if len(rest) > 0 && (arg0 == types.PrefixScope || arg0 == types.PrefixScopeSpecification ||
+				arg0 == types.PrefixContractSpecification) {
+				return fmt.Errorf("too many arguments to derive a %s address", arg0)
+			}
+			return outputDeriveAddress(cmd, req)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetValidateWriteCmd returns the command handler for validating a metadata write msg without committing it.
+func GetValidateWriteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "validate-write {msg_json_file}",
+		Aliases: []string{"vw", "dry-run"},
+		Short:   "Validate a metadata write msg without committing it",
+		Long: fmt.Sprintf(`%[1]s validate-write {msg_json_file} - runs the msg in the provided file through all validation
+done when it is included in a transaction, including specification and party checks, and outputs the normalized msg.
+
+The file must contain a single JSON msg with its "@type", e.g. /provenance.metadata.v1.MsgWriteScopeRequest.
+Supported msgs are: MsgWriteScopeRequest, MsgWriteSessionRequest, MsgWriteRecordRequest,
+MsgWriteScopeSpecificationRequest, MsgWriteContractSpecificationRequest, and MsgWriteRecordSpecificationRequest.
+The signers listed in the msg are taken as given.`, cmdStart),
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s validate-write scope.json", cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var msg sdk.Msg
+			if err = clientCtx.Codec.UnmarshalInterfaceJSON(contents, &msg); err != nil {
+				return fmt.Errorf("invalid msg in %s: %w", args[0], err)
+			}
+			req, err := types.NewValidateWriteRequest(msg)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidateWrite(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			if !includeRequest {
+				res.Request = nil
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // ------------ private funcs for actually querying and outputting ------------
 
 // outputParams calls the Params query and outputs the response.
@@ -615,6 +890,7 @@ func outputScope(cmd *cobra.Command, scopeID string, sessionAddr string, recordA
 		RecordAddr:      recordAddr,
 		IncludeSessions: includeSessions,
 		IncludeRecords:  includeRecords,
+		IncludeSpecs:    includeSpecs,
 	}
 
 	queryClient := types.NewQueryClient(clientCtx)
@@ -821,6 +1097,104 @@ func outputValueOwnership(cmd *cobra.Command, address string) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputScopesByParty calls the ScopesByParty query and outputs the response.
+func outputScopesByParty(cmd *cobra.Command, address string, roles []types.PartyType) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ScopesByParty(
+		context.Background(),
+		&types.ScopesByPartyRequest{Address: address, Roles: roles, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputScopeHistory calls the ScopeHistory query and outputs the response.
+func outputScopeHistory(cmd *cobra.Command, scopeID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ScopeHistory(
+		context.Background(),
+		&types.ScopeHistoryRequest{ScopeId: scopeID, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputRecordReverifications calls the RecordReverifications query and outputs the response.
+func outputRecordReverifications(cmd *cobra.Command, req types.RecordReverificationsRequest) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	req.Pagination = pageReq
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.RecordReverifications(context.Background(), &req)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputSpecCompatibility calls the CheckSpecCompatibility query and outputs the response.
+func outputSpecCompatibility(cmd *cobra.Command, oldSpecID, newSpecID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.CheckSpecCompatibility(
+		context.Background(),
+		&types.CheckSpecCompatibilityRequest{OldSpecId: oldSpecID, NewSpecId: newSpecID},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // outputScopeSpec calls the ScopeSpecification query and outputs the response.
 func outputScopeSpec(cmd *cobra.Command, specificationID string) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -1115,6 +1489,25 @@ func outputOSLocatorsAll(cmd *cobra.Command) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputDeriveAddress calls the DeriveAddress query and outputs the response.
+func outputDeriveAddress(cmd *cobra.Command, req types.DeriveAddressRequest) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.DeriveAddress(context.Background(), &req)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // ------------ private generic helper functions ------------
 
 // trimSpaceAndJoin trims leading and trailing whitespace from each arg,
@@ -1152,6 +1545,12 @@ func addIncludeRecordSpecsFlag(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&includeRecordSpecs, "include-record-specs", false, "include record specs in the output")
 }
 
+// addIncludeSpecsFlag sets up a command to look for an --include-specs flag.
+// The flag value is tied to the includeSpecs variable.
+func addIncludeSpecsFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&includeSpecs, "include-specs", false, "include the scope, contract, and record specs used by a scope in the output")
+}
+
 // addIncludeRequestFlag sets up a command to look for an --include-request.
 // The flag value is tied to the includeRequest variable.
 func addIncludeRequestFlag(cmd *cobra.Command) {
@@ -19,9 +19,14 @@ import (
 )
 
 const (
-	FlagSigners  = "signers"
-	AddSwitch    = "add"
-	RemoveSwitch = "remove"
+	FlagSigners         = "signers"
+	FlagInputValidation = "input-validation"
+	FlagAnyOwner        = "any-owner-can-update"
+	FlagStrict          = "strict"
+	FlagDisputedHash    = "disputed-hash"
+	FlagStatus          = "status"
+	AddSwitch           = "add"
+	RemoveSwitch        = "remove"
 )
 
 // NewTxCmd is the top-level command for Metadata CLI transactions.
@@ -54,6 +59,8 @@ func NewTxCmd() *cobra.Command {
 		AddContractSpecToScopeSpecCmd(),
 		RemoveContractSpecFromScopeSpecCmd(),
 
+		AddRemoveSpecificationOwnersCmd(),
+
 		WriteRecordSpecificationCmd(),
 		RemoveRecordSpecificationCmd(),
 
@@ -61,6 +68,9 @@ func NewTxCmd() *cobra.Command {
 
 		WriteRecordCmd(),
 		RemoveRecordCmd(),
+
+		RequestReverificationCmd(),
+		ResolveReverificationCmd(),
 	)
 
 	return txCmd
@@ -412,12 +422,24 @@ func WriteScopeSpecificationCmd() *cobra.Command {
 				return err
 			}
 
+			anyOwner, err := cmd.Flags().GetBool(FlagAnyOwner)
+			if err != nil {
+				return err
+			}
+
+			strict, err := cmd.Flags().GetBool(FlagStrict)
+			if err != nil {
+				return err
+			}
+
 			scopeSpec := types.ScopeSpecification{
-				SpecificationId: specificationID,
-				OwnerAddresses:  strings.Split(args[1], ","),
-				Description:     parseDescription(args[4:]),
-				PartiesInvolved: parsePartyTypes(args[2]),
-				ContractSpecIds: contractSpecIds,
+				SpecificationId:   specificationID,
+				OwnerAddresses:    strings.Split(args[1], ","),
+				Description:       parseDescription(args[4:]),
+				PartiesInvolved:   parsePartyTypes(args[2]),
+				ContractSpecIds:   contractSpecIds,
+				AnyOwnerCanUpdate: anyOwner,
+				Strict:            strict,
 			}
 
 			msg := types.NewMsgWriteScopeSpecificationRequest(scopeSpec, signers)
@@ -430,6 +452,8 @@ func WriteScopeSpecificationCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagAnyOwner, false, "Allow any single owner to update the specification")
+	cmd.Flags().Bool(FlagStrict, false, "Reject sessions and records that do not satisfy their specifications' required parties and inputs")
 	addSignerFlagCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -463,13 +487,19 @@ icon-url           - address to a image to be used as an icon (optional, can onl
 				return err
 			}
 
+			anyOwner, err := cmd.Flags().GetBool(FlagAnyOwner)
+			if err != nil {
+				return err
+			}
+
 			partiesInvolved := parsePartyTypes(args[2])
 			description := parseDescription(args[5:])
 			contractSpecification := types.ContractSpecification{SpecificationId: specificationID,
-				Description:     description,
-				OwnerAddresses:  strings.Split(args[1], ","),
-				PartiesInvolved: partiesInvolved,
-				ClassName:       args[4],
+				Description:       description,
+				OwnerAddresses:    strings.Split(args[1], ","),
+				PartiesInvolved:   partiesInvolved,
+				ClassName:         args[4],
+				AnyOwnerCanUpdate: anyOwner,
 			}
 			sourceValue := args[3]
 			var recordID types.MetadataAddress
@@ -497,6 +527,7 @@ icon-url           - address to a image to be used as an icon (optional, can onl
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().Bool(FlagAnyOwner, false, "Allow any single owner to update the specification and its record specifications")
 	addSignerFlagCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -687,8 +718,9 @@ scope-id          - scope metaaddress for the record
 record-spec-id    - associated record specification metaaddress
 name              - record name
 process           - comma delimited structure of process name, id (hash or bech32 address), and method: Example: processname,hashvalue,method
-inputs            - semicolon delimited list of input structures.  Example: name,soure-value(hash or metaaddress),typename,status(proposed,record);...
-outputs           - semicolon delimited list of outputs structures. Example: hash-value,status(pass,skip,fail);...
+inputs            - semicolon delimited list of input structures.  Example: name,soure-value(hash or metaaddress),typename,status(proposed,record)[,hash-algorithm];...
+outputs           - semicolon delimited list of outputs structures. Example: hash-value,status(pass,skip,fail)[,hash-algorithm];...
+  The optional hash-algorithm declares the algorithm used to create the hash. Accepted values: sha256,sha512,blake2b_256,blake2b_512
 parties-involved  - semicolon delimited list of party structures(address,role). Accepted roles: originator,servicer,investor,custodian,owner,affiliate,omnibus,provenance
 contract-spec-id  - a bech32 address string for a contract specification - If provided, a new session will be created using this contract specification
 session-id        - a bech32 address string for the session this record belongs to
@@ -848,7 +880,7 @@ func parseRecordInputs(cliDelimitedValue string) ([]types.RecordInput, error) {
 	inputs := make([]types.RecordInput, len(delimitedInputs))
 	for i, delimitedInput := range delimitedInputs {
 		values := strings.Split(delimitedInput, ",")
-		if len(values) != 4 {
+		if len(values) != 4 && len(values) != 5 {
 			return nil, fmt.Errorf("invalid number of values for record input: %v", len(values))
 		}
 		inputs[i] = types.RecordInput{
@@ -856,6 +888,13 @@ func parseRecordInputs(cliDelimitedValue string) ([]types.RecordInput, error) {
 			TypeName: values[2],
 			Status:   types.RecordInputStatus(types.RecordInputStatus_value[fmt.Sprintf("RECORD_INPUT_STATUS_%s", strings.ToUpper(values[3]))]),
 		}
+		if len(values) == 5 {
+			hashAlgorithm, err := parseHashAlgorithm(values[4])
+			if err != nil {
+				return nil, err
+			}
+			inputs[i].HashAlgorithm = hashAlgorithm
+		}
 		sourceValue := values[1]
 		recordID, err := types.MetadataAddressFromBech32(sourceValue)
 		if err != nil {
@@ -873,17 +912,33 @@ func parseRecordOutputs(cliDelimitedValue string) ([]types.RecordOutput, error)
 	outputs := make([]types.RecordOutput, len(delimitedOutputs))
 	for i, delimitedOutput := range delimitedOutputs {
 		values := strings.Split(delimitedOutput, ",")
-		if len(values) != 2 {
+		if len(values) != 2 && len(values) != 3 {
 			return nil, fmt.Errorf("invalid number of values for record output: %v", len(values))
 		}
 		outputs[i] = types.RecordOutput{
 			Hash:   values[0],
 			Status: types.ResultStatus(types.ResultStatus_value[fmt.Sprintf("RESULT_STATUS_%s", strings.ToUpper(values[1]))]),
 		}
+		if len(values) == 3 {
+			hashAlgorithm, err := parseHashAlgorithm(values[2])
+			if err != nil {
+				return nil, err
+			}
+			outputs[i].HashAlgorithm = hashAlgorithm
+		}
 	}
 	return outputs, nil
 }
 
+// parseHashAlgorithm parses a hash algorithm name (sha256, sha512, blake2b_256, blake2b_512)
+func parseHashAlgorithm(value string) (types.HashAlgorithm, error) {
+	hashAlgorithm, found := types.HashAlgorithm_value[fmt.Sprintf("HASH_ALGORITHM_%s", strings.ToUpper(strings.TrimSpace(value)))]
+	if !found {
+		return types.HashAlgorithm_HASH_ALGORITHM_UNSPECIFIED, fmt.Errorf("unknown hash algorithm: %s", value)
+	}
+	return types.HashAlgorithm(hashAlgorithm), nil
+}
+
 func WriteRecordSpecificationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "write-record-specification [specification-id] [name] [input-specifications] [type-name] [result-types] [responsible-parties]",
@@ -927,6 +982,11 @@ owner,originator`, version.AppName),
 				return err
 			}
 
+			inputValidation, err := parseInputValidationLevel(cmd)
+			if err != nil {
+				return err
+			}
+
 			recordSpecification := types.RecordSpecification{
 				SpecificationId:    specificationID,
 				Name:               recordName,
@@ -934,6 +994,7 @@ owner,originator`, version.AppName),
 				TypeName:           args[3],
 				ResultType:         resultType,
 				ResponsibleParties: partyTypes,
+				InputValidation:    inputValidation,
 			}
 
 			msg := *types.NewMsgWriteRecordSpecificationRequest(recordSpecification, signers)
@@ -947,11 +1008,25 @@ owner,originator`, version.AppName),
 	}
 
 	addSignerFlagCmd(cmd)
+	cmd.Flags().String(FlagInputValidation, "", "How non-conforming record inputs are handled. Accepted values: off, warn, strict (default strict)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// parseInputValidationLevel converts the input validation flag to an InputValidationLevel
+func parseInputValidationLevel(cmd *cobra.Command) (types.InputValidationLevel, error) {
+	level, err := cmd.Flags().GetString(FlagInputValidation)
+	if err != nil || len(level) == 0 {
+		return types.InputValidationLevel_INPUT_VALIDATION_LEVEL_UNSPECIFIED, err
+	}
+	value, found := types.InputValidationLevel_value[fmt.Sprintf("INPUT_VALIDATION_LEVEL_%s", strings.ToUpper(level))]
+	if !found {
+		return types.InputValidationLevel_INPUT_VALIDATION_LEVEL_UNSPECIFIED, fmt.Errorf("unknown input validation level: %s", level)
+	}
+	return types.InputValidationLevel(value), nil
+}
+
 // parseInputSpecification converts cli delimited argument and converts it to InputSpecifications
 func parseInputSpecification(cliDelimitedValue string) ([]*types.InputSpecification, error) {
 	delimitedInputs := strings.Split(cliDelimitedValue, ";")
@@ -1149,6 +1224,57 @@ func RemoveContractSpecFromScopeSpecCmd() *cobra.Command {
 	return cmd
 }
 
+// AddRemoveSpecificationOwnersCmd creates a command for adding or removing owners of a scope or contract specification.
+func AddRemoveSpecificationOwnersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "specification-owners {add|remove} [specification-id] [owner-addresses]",
+		Short: "Add or remove owners of a metadata scope or contract specification on the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata specification-owners add scopespec1qjpreurq8n7ylc4y5zw6gn255lkqle56sv pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+									 $ %[1]s tx metadata specification-owners remove contractspec1q0w6ys5g6jm509v2830374aprsrq260w62 pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			removeOrAdd := strings.ToLower(args[0])
+			if removeOrAdd != RemoveSwitch && removeOrAdd != AddSwitch {
+				return fmt.Errorf("incorrect command %s : required remove or update", removeOrAdd)
+			}
+
+			specID, err := types.MetadataAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			ownerAddresses := strings.Split(args[2], ",")
+
+			var msg sdk.Msg
+			if removeOrAdd == AddSwitch {
+				msg = types.NewMsgAddSpecificationOwnerRequest(specID, ownerAddresses, signers)
+			} else {
+				msg = types.NewMsgDeleteSpecificationOwnerRequest(specID, ownerAddresses, signers)
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // RemoveRecordCmd creates a command to remove a contract specification
 func RemoveRecordCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1221,3 +1347,120 @@ func RemoveRecordSpecificationCmd() *cobra.Command {
 
 	return cmd
 }
+
+// RequestReverificationCmd creates a command to request the re-verification of the off-chain object of a record
+func RequestReverificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-reverification [record-id] [observed-hash] [reason]",
+		Short: "Request the scope owners re-verify the off-chain object of a record whose hash does not match",
+		Long: `Request the scope owners re-verify the off-chain object of a record whose hash does not match.
+The requester (the --from address) must be an owner, the value owner, or a data access address of the scope.
+The observed-hash is the hash computed from the off-chain object.
+Use --disputed-hash to identify the record output hash that does not match.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata request-reverification record1qtjqgzrza7h5w8a4amnk9ru9s7236qz42yxp5uejah5tje7c6l0pwue0yn3 \
+  Adv+huolGTKofYCR0dw5GHm/R7sUWOwF32XR8r8r9kDy "object hash mismatch" --from=mykey`, version.AppName),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			if !recordID.IsRecordAddress() {
+				return fmt.Errorf("invalid record id: %s", args[0])
+			}
+			reason := ""
+			if len(args) > 2 {
+				reason = args[2]
+			}
+			disputedHash, err := cmd.Flags().GetString(FlagDisputedHash)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRequestReverificationRequest(recordID, disputedHash, args[1], reason,
+				clientCtx.GetFromAddress().String())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDisputedHash, "", "the record output hash that does not match the object")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ResolveReverificationCmd creates a command to resolve a record re-verification request
+func ResolveReverificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-reverification [record-id] [requester] {confirmed|corrected|withdrawn} [note]",
+		Short: "Resolve an open record re-verification request",
+		Long: `Resolve an open record re-verification request.
+A request is confirmed (the record is correct) or corrected (the record or object was fixed) by all the scope owners,
+or withdrawn by the requester.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata resolve-reverification record1qtjqgzrza7h5w8a4amnk9ru9s7236qz42yxp5uejah5tje7c6l0pwue0yn3 \
+  pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 confirmed "object re-hashed, record is correct" --from=mykey`, version.AppName),
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			if !recordID.IsRecordAddress() {
+				return fmt.Errorf("invalid record id: %s", args[0])
+			}
+			if _, err = sdk.AccAddressFromBech32(args[1]); err != nil {
+				return fmt.Errorf("invalid requester: %w", err)
+			}
+			status, err := parseReverificationStatus(args[2])
+			if err != nil {
+				return err
+			}
+			note := ""
+			if len(args) > 3 {
+				note = args[3]
+			}
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgResolveReverificationRequest(recordID, args[1], status, note, signers)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseReverificationStatus converts a status name, e.g. "confirmed" or "REVERIFICATION_STATUS_CONFIRMED", into a
+// ReverificationStatus.
+func parseReverificationStatus(value string) (types.ReverificationStatus, error) {
+	name := strings.ToUpper(strings.TrimSpace(value))
+	if !strings.HasPrefix(name, "REVERIFICATION_STATUS_") {
+		name = "REVERIFICATION_STATUS_" + name
+	}
+	status, found := types.ReverificationStatus_value[name]
+	if !found {
+		return types.ReverificationStatus_REVERIFICATION_STATUS_UNSPECIFIED, fmt.Errorf("unknown reverification status: %s", value)
+	}
+	return types.ReverificationStatus(status), nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -822,3 +823,300 @@ func (s MetadataHandlerTestSuite) TestIssue412WriteScopeOptionalField() {
 		assert.NotNil(t, 0, res)
 	})
 }
+
+func (s MetadataHandlerTestSuite) TestScopeHistory() {
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("somesource"),
+		ClassName:       "someclass",
+	}
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{cSpec.SpecificationId})
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *scopeSpec)
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scope := types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, "")
+	session := types.Session{
+		SessionId:       types.SessionMetadataAddress(scopeUUID, uuid.New()),
+		SpecificationId: cSpec.SpecificationId,
+		Parties:         scope.Owners,
+		Name:            "someclass",
+	}
+
+	ctx := s.ctx.WithBlockHeight(10).WithTxBytes([]byte("tx"))
+	msgs := []sdk.Msg{
+		types.NewMsgWriteScopeRequest(*scope, []string{s.user1}),
+		types.NewMsgAddScopeDataAccessRequest(scopeID, []string{s.user2}, []string{s.user1}),
+		types.NewMsgWriteSessionRequest(session, []string{s.user1}),
+		types.NewMsgDeleteScopeRequest(scopeID, []string{s.user1}),
+	}
+	for _, msg := range msgs {
+		_, err := s.handler(ctx, msg)
+		require.NoError(s.T(), err, "handler(%T)", msg)
+	}
+	// failed messages are not recorded
+	_, err := s.handler(ctx, types.NewMsgDeleteScopeRequest(scopeID, []string{s.user1}))
+	require.Error(s.T(), err)
+
+	res, err := s.app.MetadataKeeper.ScopeHistory(sdk.WrapSDKContext(ctx), &types.ScopeHistoryRequest{ScopeId: scopeUUID.String()})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), res.Entries, len(msgs))
+	for i, entry := range res.Entries {
+		assert.Equal(s.T(), scopeID, entry.ScopeId, "entry %d scope id", i)
+		assert.Equal(s.T(), uint64(i+1), entry.Sequence, "entry %d sequence", i)
+		assert.Equal(s.T(), int64(10), entry.BlockHeight, "entry %d block height", i)
+		assert.Equal(s.T(), "1B5B9CCB3E8D006A5230DE9BDA23FF91EDC794D4F56410560830B418528E446C", entry.TxHash, "entry %d tx hash", i)
+		assert.Equal(s.T(), sdk.MsgTypeURL(msgs[i]), entry.MsgTypeUrl, "entry %d msg type url", i)
+		assert.Equal(s.T(), []string{s.user1}, entry.Signers, "entry %d signers", i)
+	}
+
+	// the oldest entries are pruned beyond the max entries param
+	s.app.MetadataKeeper.SetParams(ctx, types.NewParams(false, 3, false, types.DefaultLargeRecordThreshold))
+	_, err = s.handler(ctx, types.NewMsgWriteScopeRequest(*scope, []string{s.user1}))
+	require.NoError(s.T(), err)
+	res, err = s.app.MetadataKeeper.ScopeHistory(sdk.WrapSDKContext(ctx), &types.ScopeHistoryRequest{ScopeId: scopeID.String()})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), res.Entries, 3)
+	assert.Equal(s.T(), uint64(3), res.Entries[0].Sequence)
+	assert.Equal(s.T(), uint64(5), res.Entries[2].Sequence)
+
+	genesis := s.app.MetadataKeeper.ExportGenesis(ctx)
+	require.NoError(s.T(), genesis.Validate())
+	assert.Equal(s.T(), res.Entries, genesis.ScopeHistory)
+
+	// no history is kept when the max entries param is zero
+	s.app.MetadataKeeper.SetParams(ctx, types.NewParams(false, 0, false, types.DefaultLargeRecordThreshold))
+	_, err = s.handler(ctx, types.NewMsgDeleteScopeRequest(scopeID, []string{s.user1}))
+	require.NoError(s.T(), err)
+	res, err = s.app.MetadataKeeper.ScopeHistory(sdk.WrapSDKContext(ctx), &types.ScopeHistoryRequest{ScopeId: scopeID.String()})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), res.Entries, 3)
+
+	_, err = s.app.MetadataKeeper.ScopeHistory(sdk.WrapSDKContext(ctx), &types.ScopeHistoryRequest{ScopeId: "invalid"})
+	require.Error(s.T(), err)
+}
+
+func (s MetadataHandlerTestSuite) TestRecordReverification() {
+	user3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scope := types.NewScope(scopeID, types.ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), []string{s.user2}, "")
+	s.app.MetadataKeeper.SetScope(s.ctx, *scope)
+	recordID := types.RecordMetadataAddress(scopeUUID, "disputed")
+	record := types.NewRecord("disputed", types.SessionMetadataAddress(scopeUUID, uuid.New()),
+		*types.NewProcess("process", &types.Process_Hash{Hash: "processhash"}, "method"), nil,
+		[]types.RecordOutput{*types.NewRecordOutput("recordhash", types.ResultStatus_RESULT_STATUS_PASS)},
+		types.RecordSpecMetadataAddress(uuid.New(), "disputed"))
+	s.app.MetadataKeeper.SetRecord(s.ctx, *record)
+
+	ctx := s.ctx.WithBlockHeight(10)
+	var events []abci.Event
+	query := func(req *types.RecordReverificationsRequest) []types.RecordReverification {
+		res, err := s.app.MetadataKeeper.RecordReverifications(sdk.WrapSDKContext(ctx), req)
+		require.NoError(s.T(), err, "RecordReverifications")
+		return res.Reverifications
+	}
+	request := func(requester, disputedHash string) error {
+		msg := types.NewMsgRequestReverificationRequest(recordID, disputedHash, "observedhash", "hash mismatch", requester)
+		res, err := s.handler(ctx, msg)
+		if err == nil {
+			events = append(events, res.Events...)
+		}
+		return err
+	}
+	resolve := func(status types.ReverificationStatus, signers ...string) error {
+		msg := types.NewMsgResolveReverificationRequest(recordID, s.user2, status, "checked", signers)
+		_, err := s.handler(ctx, msg)
+		return err
+	}
+
+	require.EqualError(s.T(), request(user3, ""),
+		fmt.Sprintf("requester %s is not an owner, value owner, or data access address of scope %s", user3, scopeID))
+	require.EqualError(s.T(), request(s.user2, "otherhash"),
+		fmt.Sprintf("record %s does not have an output with hash otherhash", recordID))
+	require.NoError(s.T(), request(s.user2, "recordhash"))
+	require.EqualError(s.T(), request(s.user2, ""),
+		fmt.Sprintf("requester %s already has an open reverification request for record %s", s.user2, recordID))
+
+	requested := false
+	for _, e := range events {
+		if e.Type == "provenance.metadata.v1.EventRecordReverificationRequested" {
+			requested = true
+		}
+	}
+	assert.True(s.T(), requested, "requested event emitted")
+
+	reverifications := query(&types.RecordReverificationsRequest{ScopeId: scopeUUID.String()})
+	require.Len(s.T(), reverifications, 1)
+	assert.Equal(s.T(), recordID, reverifications[0].RecordId)
+	assert.Equal(s.T(), s.user2, reverifications[0].Requester)
+	assert.Equal(s.T(), "recordhash", reverifications[0].DisputedHash)
+	assert.Equal(s.T(), types.ReverificationStatus_REVERIFICATION_STATUS_OPEN, reverifications[0].Status)
+	assert.Equal(s.T(), int64(10), reverifications[0].RequestedHeight)
+	assert.Empty(s.T(), query(&types.RecordReverificationsRequest{
+		RecordAddr: recordID.String(),
+		Status:     types.ReverificationStatus_REVERIFICATION_STATUS_CONFIRMED,
+	}))
+
+	require.EqualError(s.T(), resolve(types.ReverificationStatus_REVERIFICATION_STATUS_CONFIRMED, s.user2),
+		fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1))
+	require.EqualError(s.T(), resolve(types.ReverificationStatus_REVERIFICATION_STATUS_WITHDRAWN, s.user1),
+		fmt.Sprintf("missing signature from requester %s; required to withdraw", s.user2))
+	require.NoError(s.T(), resolve(types.ReverificationStatus_REVERIFICATION_STATUS_CONFIRMED, s.user1))
+	require.EqualError(s.T(), resolve(types.ReverificationStatus_REVERIFICATION_STATUS_CORRECTED, s.user1),
+		fmt.Sprintf("reverification request for record %s from %s is already resolved: REVERIFICATION_STATUS_CONFIRMED", recordID, s.user2))
+
+	reverifications = query(&types.RecordReverificationsRequest{
+		RecordAddr: recordID.String(),
+		Status:     types.ReverificationStatus_REVERIFICATION_STATUS_CONFIRMED,
+	})
+	require.Len(s.T(), reverifications, 1)
+	assert.Equal(s.T(), "checked", reverifications[0].ResolutionNote)
+	assert.Equal(s.T(), []string{s.user1}, reverifications[0].ResolvedBy)
+
+	// a new request can be made once the previous one is resolved, and withdrawn by the requester
+	require.NoError(s.T(), request(s.user2, ""))
+	require.NoError(s.T(), resolve(types.ReverificationStatus_REVERIFICATION_STATUS_WITHDRAWN, s.user2))
+
+	genesis := s.app.MetadataKeeper.ExportGenesis(ctx)
+	require.NoError(s.T(), genesis.Validate())
+	require.Len(s.T(), genesis.RecordReverifications, 1)
+	assert.Equal(s.T(), types.ReverificationStatus_REVERIFICATION_STATUS_WITHDRAWN, genesis.RecordReverifications[0].Status)
+
+	_, err := s.app.MetadataKeeper.RecordReverifications(sdk.WrapSDKContext(ctx), &types.RecordReverificationsRequest{
+		RecordAddr: recordID.String(),
+		ScopeId:    uuid.New().String(),
+	})
+	require.Error(s.T(), err)
+}
+
+func (s MetadataHandlerTestSuite) TestSpecificationOwners() {
+	user3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	cSpecUUID := uuid.New()
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(cSpecUUID),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("somesource"),
+		ClassName:       "someclass",
+	}
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+	sSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ContractSpecIds: []types.MetadataAddress{cSpec.SpecificationId},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, sSpec)
+
+	cases := []struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"add owner - not a signer",
+			types.NewMsgAddSpecificationOwnerRequest(sSpec.SpecificationId, []string{s.user2}, []string{s.user2}),
+			fmt.Sprintf("missing signature from existing owner %s; required for update", s.user1),
+		},
+		{
+			"add owner - already an owner",
+			types.NewMsgAddSpecificationOwnerRequest(sSpec.SpecificationId, []string{s.user1}, []string{s.user1}),
+			fmt.Sprintf("address %s is already an owner", s.user1),
+		},
+		{
+			"add owner - spec not found",
+			types.NewMsgAddSpecificationOwnerRequest(types.ScopeSpecMetadataAddress(uuid.New()), []string{s.user2}, []string{s.user1}),
+			"scope specification not found with id",
+		},
+		{
+			"add owner to scope spec",
+			types.NewMsgAddSpecificationOwnerRequest(sSpec.SpecificationId, []string{s.user2}, []string{s.user1}),
+			"",
+		},
+		{
+			"add owner to contract spec",
+			types.NewMsgAddSpecificationOwnerRequest(cSpec.SpecificationId, []string{s.user2, user3}, []string{s.user1}),
+			"",
+		},
+		{
+			"delete owner - not all owners signed",
+			types.NewMsgDeleteSpecificationOwnerRequest(cSpec.SpecificationId, []string{user3}, []string{s.user1}),
+			fmt.Sprintf("missing signatures from existing owners %v; required for update", []string{s.user2, user3}),
+		},
+		{
+			"delete owner - not an owner",
+			types.NewMsgDeleteSpecificationOwnerRequest(sSpec.SpecificationId, []string{user3}, []string{s.user1, s.user2}),
+			fmt.Sprintf("address %s is not an owner", user3),
+		},
+		{
+			"delete owner - no owners would remain",
+			types.NewMsgDeleteSpecificationOwnerRequest(sSpec.SpecificationId, []string{s.user1, s.user2}, []string{s.user1, s.user2}),
+			"a specification must have at least one owner",
+		},
+		{
+			"delete owner from contract spec",
+			types.NewMsgDeleteSpecificationOwnerRequest(cSpec.SpecificationId, []string{user3}, []string{s.user1, s.user2, user3}),
+			"",
+		},
+	}
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := s.handler(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	scopeSpec, found := s.app.MetadataKeeper.GetScopeSpecification(s.ctx, sSpec.SpecificationId)
+	require.True(s.T(), found)
+	assert.Equal(s.T(), []string{s.user1, s.user2}, scopeSpec.OwnerAddresses)
+	contractSpec, found := s.app.MetadataKeeper.GetContractSpecification(s.ctx, cSpec.SpecificationId)
+	require.True(s.T(), found)
+	assert.Equal(s.T(), []string{s.user1, s.user2}, contractSpec.OwnerAddresses)
+
+	s.T().Run("any owner can update", func(t *testing.T) {
+		_, err := s.handler(s.ctx, types.NewMsgWriteScopeSpecificationRequest(scopeSpec, []string{s.user1}))
+		require.Error(t, err, "update by one owner before any owner can update is set")
+
+		scopeSpec.AnyOwnerCanUpdate = true
+		_, err = s.handler(s.ctx, types.NewMsgWriteScopeSpecificationRequest(scopeSpec, []string{s.user1}))
+		require.Error(t, err, "enabling any owner can update by one owner")
+		_, err = s.handler(s.ctx, types.NewMsgWriteScopeSpecificationRequest(scopeSpec, []string{s.user1, s.user2}))
+		require.NoError(t, err, "enabling any owner can update by all owners")
+
+		scopeSpec.Description = types.NewDescription("updated", "", "", "")
+		_, err = s.handler(s.ctx, types.NewMsgWriteScopeSpecificationRequest(scopeSpec, []string{s.user2}))
+		require.NoError(t, err, "update by one owner")
+		_, err = s.handler(s.ctx, types.NewMsgWriteScopeSpecificationRequest(scopeSpec, []string{user3}))
+		require.Error(t, err, "update by a non-owner")
+
+		scopeSpec.OwnerAddresses = []string{s.user2}
+		_, err = s.handler(s.ctx, types.NewMsgWriteScopeSpecificationRequest(scopeSpec, []string{s.user2}))
+		require.Error(t, err, "owner change by one owner")
+		scopeSpec.OwnerAddresses = []string{s.user1, s.user2}
+		scopeSpec.AnyOwnerCanUpdate = false
+		_, err = s.handler(s.ctx, types.NewMsgWriteScopeSpecificationRequest(scopeSpec, []string{s.user2}))
+		require.Error(t, err, "disabling any owner can update by one owner")
+
+		contractSpec.AnyOwnerCanUpdate = true
+		_, err = s.handler(s.ctx, types.NewMsgWriteContractSpecificationRequest(contractSpec, []string{s.user1, s.user2}))
+		require.NoError(t, err, "enabling any owner can update on contract spec")
+		recSpec := types.RecordSpecification{
+			SpecificationId:    types.RecordSpecMetadataAddress(cSpecUUID, "recordname"),
+			Name:               "recordname",
+			TypeName:           "typename",
+			ResultType:         types.DefinitionType_DEFINITION_TYPE_RECORD,
+			ResponsibleParties: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		}
+		_, err = s.handler(s.ctx, &types.MsgWriteRecordSpecificationRequest{Specification: recSpec, Signers: []string{s.user2}})
+		require.NoError(t, err, "record specification written by one contract spec owner")
+	})
+}
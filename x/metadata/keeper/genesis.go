@@ -61,6 +61,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			}
 		}
 	}
+	if data.ScopeHistory != nil {
+		for _, e := range data.ScopeHistory {
+			k.SetScopeHistoryEntry(ctx, e)
+		}
+	}
+	if data.RecordReverifications != nil {
+		for _, r := range data.RecordReverifications {
+			k.SetRecordReverification(ctx, r)
+		}
+	}
 }
 
 // ExportGenesis exports the current keeper state of the metadata module.ExportGenesis
@@ -74,6 +84,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	contractSpecs := make([]types.ContractSpecification, 0)
 	recordSpecs := make([]types.RecordSpecification, 0)
 	objectStoreLocators := make([]types.ObjectStoreLocator, 0)
+	scopeHistory := make([]types.ScopeHistoryEntry, 0)
+	recordReverifications := make([]types.RecordReverification, 0)
 
 	appendToScopes := func(scope types.Scope) bool {
 		scopes = append(scopes, scope)
@@ -110,6 +122,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	}
 
+	appendToScopeHistory := func(entry types.ScopeHistoryEntry) bool {
+		scopeHistory = append(scopeHistory, entry)
+		return false
+	}
+
+	appendToRecordReverifications := func(reverification types.RecordReverification) bool {
+		recordReverifications = append(recordReverifications, reverification)
+		return false
+	}
+
 	if err := k.IterateScopes(ctx, appendToScopes); err != nil {
 		panic(err)
 	}
@@ -134,5 +156,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators)
+	if err := k.IterateScopeHistory(ctx, types.MetadataAddress{}, appendToScopeHistory); err != nil {
+		panic(err)
+	}
+
+	if err := k.IterateRecordReverifications(ctx, types.MetadataAddress{}, appendToRecordReverifications); err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, scopeHistory, recordReverifications)
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -115,22 +116,26 @@ type Keeper struct {
 
 	// To check if accounts exist and set public keys.
 	authKeeper authkeeper.AccountKeeper
+
+	// To check whether governance has activated new consensus behaviors.
+	featureFlagKeeper types.FeatureFlagKeeper
 }
 
 // NewKeeper creates new instances of the metadata Keeper.
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
-	authKeeper authkeeper.AccountKeeper,
+	authKeeper authkeeper.AccountKeeper, featureFlagKeeper types.FeatureFlagKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.OSParamKeyTable())
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable().RegisterParamSet(&types.OSLocatorParams{}))
 	}
 	return Keeper{
-		storeKey:   key,
-		cdc:        cdc,
-		paramSpace: paramSpace,
-		authKeeper: authKeeper,
+		storeKey:          key,
+		cdc:               cdc,
+		paramSpace:        paramSpace,
+		authKeeper:        authKeeper,
+		featureFlagKeeper: featureFlagKeeper,
 	}
 }
 
@@ -139,6 +144,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// IsFeatureActive returns true if governance has activated the named metadata feature at the current block height.
+func (k Keeper) IsFeatureActive(ctx sdk.Context, name string) bool {
+	return k.featureFlagKeeper != nil && k.featureFlagKeeper.IsFeatureActive(ctx, name)
+}
+
 var _ MetadataKeeperI = &Keeper{}
 
 // GetAccount looks up an account by address
@@ -171,6 +181,25 @@ func (k Keeper) ValidateAllOwnersAreSigners(existingOwners []string, signers []s
 	}
 }
 
+// ValidateSpecOwnersAreSigners makes sure that the owners of a specification are contained in the signers list: any one
+// of them when anyOwner is true, otherwise all of them.
+func (k Keeper) ValidateSpecOwnersAreSigners(owners []string, anyOwner bool, signers []string) error {
+	if !anyOwner {
+		return k.ValidateAllOwnersAreSigners(owners, signers)
+	}
+	if len(FindMissing(owners, signers)) < len(owners) {
+		return nil
+	}
+	return fmt.Errorf("missing signature from any of the existing owners %v; required for update", owners)
+}
+
+// sameSpecOwnership returns true if an update to a specification leaves its owners and any owner setting unchanged.
+func sameSpecOwnership(existingOwners []string, existingAnyOwner bool, proposedOwners []string, proposedAnyOwner bool) bool {
+	return existingAnyOwner == proposedAnyOwner &&
+		len(FindMissing(existingOwners, proposedOwners)) == 0 &&
+		len(FindMissing(proposedOwners, existingOwners)) == 0
+}
+
 // ValidateAllPartiesAreSigners validate all parties are signers
 func (k Keeper) ValidateAllPartiesAreSigners(parties []types.Party, signers []string) error {
 	addresses := make([]string, len(parties))
@@ -288,3 +317,28 @@ func pluralEnding(i int) string {
 	}
 	return "s"
 }
+
+// specViolations collects the requirements that a proposed entry does not satisfy so that, when its scope
+// specification is enforced strictly, they can all be reported together.
+type specViolations struct {
+	strict   bool
+	failures []string
+}
+
+// add returns the given failure when not strict.  When strict, the failure is collected and nil is returned.
+func (v *specViolations) add(err error) error {
+	if !v.strict {
+		return err
+	}
+	v.failures = append(v.failures, err.Error())
+	return nil
+}
+
+// err returns an error listing every collected failure, or nil if there are none.
+func (v specViolations) err(entry string, scopeSpecID types.MetadataAddress) error {
+	if len(v.failures) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s does not satisfy strict scope specification %s: %d requirement%s failed: %s",
+		entry, scopeSpecID, len(v.failures), pluralEnding(len(v.failures)), strings.Join(v.failures, "; "))
+}
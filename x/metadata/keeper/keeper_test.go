@@ -5,6 +5,8 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/google/uuid"
+
 	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/x/metadata/keeper"
 
@@ -438,6 +440,17 @@ func (s *KeeperTestSuite) TestParams() {
 	s.T().Run("param tests", func(t *testing.T) {
 		p := s.app.MetadataKeeper.GetParams(s.ctx)
 		assert.NotNil(t, p)
+		assert.Equal(t, p.ValidateCrossScopeRecordInputs, s.app.MetadataKeeper.GetValidateCrossScopeRecordInputs(s.ctx))
+
+		assert.Equal(t, p.MaxScopeHistoryEntries, s.app.MetadataKeeper.GetMaxScopeHistoryEntries(s.ctx))
+
+		assert.Equal(t, p.StrictScopeSpecifications, s.app.MetadataKeeper.GetStrictScopeSpecifications(s.ctx))
+
+		s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(true, 5, true, types.DefaultLargeRecordThreshold))
+		assert.True(t, s.app.MetadataKeeper.GetValidateCrossScopeRecordInputs(s.ctx))
+		assert.Equal(t, uint32(5), s.app.MetadataKeeper.GetMaxScopeHistoryEntries(s.ctx))
+		assert.True(t, s.app.MetadataKeeper.GetStrictScopeSpecifications(s.ctx))
+		s.app.MetadataKeeper.SetParams(s.ctx, types.DefaultParams())
 
 		osp := s.app.MetadataKeeper.GetOSLocatorParams(s.ctx)
 		assert.NotNil(t, osp)
@@ -610,3 +623,26 @@ func (s *KeeperTestSuite) TestUnionDistinct() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMigrate2to3IndexesScopeParties() {
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	scope := types.NewScope(scopeID, nil, []types.Party{
+		{Address: s.user1, Role: types.PartyType_PARTY_TYPE_SERVICER},
+	}, []string{}, "")
+	s.app.MetadataKeeper.SetScope(s.ctx, *scope)
+
+	// Remove the index entry to simulate a scope stored before the party index existed.
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	store.Delete(types.GetPartyScopeCacheKey(s.user1Addr, types.PartyType_PARTY_TYPE_SERVICER, scopeID))
+
+	migrator := keeper.NewMigrator(s.app.MetadataKeeper)
+	s.Require().NoError(migrator.Migrate2to3(s.ctx))
+
+	var found []types.MetadataAddress
+	s.Require().NoError(s.app.MetadataKeeper.IterateScopesForParty(s.ctx, s.user1Addr, types.PartyType_PARTY_TYPE_SERVICER,
+		func(id types.MetadataAddress) bool {
+			found = append(found, id)
+			return false
+		}))
+	s.Assert().Equal([]types.MetadataAddress{scopeID}, found)
+}
@@ -43,6 +43,7 @@ func (k msgServer) WriteScope(
 	}
 
 	k.SetScope(ctx, msg.Scope)
+	k.AddScopeHistory(ctx, msg.Scope.ScopeId, msg)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteScope, msg.GetSigners()))
 	return types.NewMsgWriteScopeResponse(msg.Scope.ScopeId), nil
@@ -68,6 +69,7 @@ func (k msgServer) DeleteScope(
 	}
 
 	k.RemoveScope(ctx, msg.ScopeId)
+	k.AddScopeHistory(ctx, msg.ScopeId, msg)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteScope, msg.GetSigners()))
 	return types.NewMsgDeleteScopeResponse(), nil
@@ -92,6 +94,7 @@ func (k msgServer) AddScopeDataAccess(
 	existing.AddDataAccess(msg.DataAccess)
 
 	k.SetScope(ctx, existing)
+	k.AddScopeHistory(ctx, msg.ScopeId, msg)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AddScopeDataAccess, msg.GetSigners()))
 	return types.NewMsgAddScopeDataAccessResponse(), nil
@@ -116,6 +119,7 @@ func (k msgServer) DeleteScopeDataAccess(
 	existing.RemoveDataAccess(msg.DataAccess)
 
 	k.SetScope(ctx, existing)
+	k.AddScopeHistory(ctx, msg.ScopeId, msg)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteScopeDataAccess, msg.GetSigners()))
 	return types.NewMsgDeleteScopeDataAccessResponse(), nil
@@ -148,6 +152,7 @@ func (k msgServer) AddScopeOwner(
 	}
 
 	k.SetScope(ctx, proposed)
+	k.AddScopeHistory(ctx, msg.ScopeId, msg)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AddScopeOwner, msg.GetSigners()))
 	return types.NewMsgAddScopeOwnerResponse(), nil
@@ -180,6 +185,7 @@ func (k msgServer) DeleteScopeOwner(
 	}
 
 	k.SetScope(ctx, proposed)
+	k.AddScopeHistory(ctx, msg.ScopeId, msg)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteScopeOwner, msg.GetSigners()))
 	return types.NewMsgDeleteScopeOwnerResponse(), nil
@@ -208,6 +214,7 @@ func (k msgServer) WriteSession(
 	msg.Session.Audit = existingAudit.UpdateAudit(ctx.BlockTime(), strings.Join(msg.Signers, ", "), "")
 
 	k.SetSession(ctx, msg.Session)
+	k.AddScopeHistory(ctx, msg.Session.SessionId.MustGetAsScopeAddress(), msg)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteSession, msg.GetSigners()))
 	return types.NewMsgWriteSessionResponse(msg.Session.SessionId), nil
@@ -239,6 +246,7 @@ func (k msgServer) WriteRecord(
 	}
 
 	k.SetRecord(ctx, msg.Record)
+	k.AddScopeHistory(ctx, types.ScopeMetadataAddress(scopeUUID), msg)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteRecord, msg.GetSigners()))
 	return types.NewMsgWriteRecordResponse(recordID), nil
@@ -257,6 +265,7 @@ func (k msgServer) DeleteRecord(
 	}
 
 	k.RemoveRecord(ctx, msg.RecordId)
+	k.AddScopeHistory(ctx, msg.RecordId.MustGetAsScopeAddress(), msg)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteRecord, msg.GetSigners()))
 	return types.NewMsgDeleteRecordResponse(), nil
@@ -275,7 +284,9 @@ func (k msgServer) WriteScopeSpecification(
 	var existing *types.ScopeSpecification = nil
 	if e, found := k.GetScopeSpecification(ctx, msg.Specification.SpecificationId); found {
 		existing = &e
-		if err := k.ValidateAllOwnersAreSigners(existing.OwnerAddresses, msg.Signers); err != nil {
+		anyOwner := existing.AnyOwnerCanUpdate && sameSpecOwnership(existing.OwnerAddresses, existing.AnyOwnerCanUpdate,
+			msg.Specification.OwnerAddresses, msg.Specification.AnyOwnerCanUpdate)
+		if err := k.ValidateSpecOwnersAreSigners(existing.OwnerAddresses, anyOwner, msg.Signers); err != nil {
 			return nil, err
 		}
 	}
@@ -325,7 +336,9 @@ func (k msgServer) WriteContractSpecification(
 	var existing *types.ContractSpecification = nil
 	if e, found := k.GetContractSpecification(ctx, msg.Specification.SpecificationId); found {
 		existing = &e
-		if err := k.ValidateAllOwnersAreSigners(existing.OwnerAddresses, msg.Signers); err != nil {
+		anyOwner := existing.AnyOwnerCanUpdate && sameSpecOwnership(existing.OwnerAddresses, existing.AnyOwnerCanUpdate,
+			msg.Specification.OwnerAddresses, msg.Specification.AnyOwnerCanUpdate)
+		if err := k.ValidateSpecOwnersAreSigners(existing.OwnerAddresses, anyOwner, msg.Signers); err != nil {
 			return nil, err
 		}
 	}
@@ -402,7 +415,7 @@ func (k msgServer) AddContractSpecToScopeSpec(
 	if !found {
 		return nil, fmt.Errorf("scope specification not found with id %s", msg.ScopeSpecificationId)
 	}
-	if err := k.ValidateAllOwnersAreSigners(scopeSpec.OwnerAddresses, msg.Signers); err != nil {
+	if err := k.ValidateSpecOwnersAreSigners(scopeSpec.OwnerAddresses, scopeSpec.AnyOwnerCanUpdate, msg.Signers); err != nil {
 		return nil, err
 	}
 
@@ -434,7 +447,7 @@ func (k msgServer) DeleteContractSpecFromScopeSpec(
 	if !found {
 		return nil, fmt.Errorf("scope specification not found with id %s", msg.ScopeSpecificationId)
 	}
-	if err := k.ValidateAllOwnersAreSigners(scopeSpec.OwnerAddresses, msg.Signers); err != nil {
+	if err := k.ValidateSpecOwnersAreSigners(scopeSpec.OwnerAddresses, scopeSpec.AnyOwnerCanUpdate, msg.Signers); err != nil {
 		return nil, err
 	}
 
@@ -479,7 +492,7 @@ func (k msgServer) WriteRecordSpecification(
 		return nil, fmt.Errorf("contract specification not found with id %s (uuid %s) required for adding or updating record specification with id %s",
 			contractSpecID, contractSpecUUID, msg.Specification.SpecificationId)
 	}
-	if err := k.ValidateAllOwnersAreSigners(contractSpec.OwnerAddresses, msg.Signers); err != nil {
+	if err := k.ValidateSpecOwnersAreSigners(contractSpec.OwnerAddresses, contractSpec.AnyOwnerCanUpdate, msg.Signers); err != nil {
 		return nil, err
 	}
 
@@ -529,6 +542,36 @@ func (k msgServer) DeleteRecordSpecification(
 	return types.NewMsgDeleteRecordSpecificationResponse(), nil
 }
 
+func (k msgServer) AddSpecificationOwner(
+	goCtx context.Context,
+	msg *types.MsgAddSpecificationOwnerRequest,
+) (*types.MsgAddSpecificationOwnerResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "AddSpecificationOwner")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.UpdateSpecificationOwners(ctx, msg.SpecificationId, msg.Owners, nil, msg.Signers); err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AddSpecificationOwner, msg.GetSigners()))
+	return types.NewMsgAddSpecificationOwnerResponse(), nil
+}
+
+func (k msgServer) DeleteSpecificationOwner(
+	goCtx context.Context,
+	msg *types.MsgDeleteSpecificationOwnerRequest,
+) (*types.MsgDeleteSpecificationOwnerResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "DeleteSpecificationOwner")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.UpdateSpecificationOwners(ctx, msg.SpecificationId, nil, msg.Owners, msg.Signers); err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteSpecificationOwner, msg.GetSigners()))
+	return types.NewMsgDeleteSpecificationOwnerResponse(), nil
+}
+
 func (k msgServer) WriteP8EContractSpec(
 	goCtx context.Context,
 	msg *types.MsgWriteP8EContractSpecRequest,
@@ -733,3 +776,121 @@ func (k msgServer) ModifyOSLocator(
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ModifyOSLocator, msg.GetSigners()))
 	return types.NewMsgModifyOSLocatorResponse(msg.Locator), nil
 }
+
+func (k msgServer) RequestReverification(
+	goCtx context.Context,
+	msg *types.MsgRequestReverificationRequest,
+) (*types.MsgRequestReverificationResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "RequestReverification")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, found := k.GetRecord(ctx, msg.RecordId)
+	if !found {
+		return nil, fmt.Errorf("record not found with id %s", msg.RecordId)
+	}
+	scopeID := msg.RecordId.MustGetAsScopeAddress()
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", scopeID)
+	}
+	if !scopeHasParticipant(scope, msg.Requester) {
+		return nil, fmt.Errorf("requester %s is not an owner, value owner, or data access address of scope %s",
+			msg.Requester, scopeID)
+	}
+	if len(msg.DisputedHash) > 0 && !recordHasOutputHash(record, msg.DisputedHash) {
+		return nil, fmt.Errorf("record %s does not have an output with hash %s", msg.RecordId, msg.DisputedHash)
+	}
+	requester := msg.GetSigners()[0]
+	if existing, found := k.GetRecordReverification(ctx, msg.RecordId, requester); found &&
+		existing.Status == types.ReverificationStatus_REVERIFICATION_STATUS_OPEN {
+		return nil, fmt.Errorf("requester %s already has an open reverification request for record %s",
+			msg.Requester, msg.RecordId)
+	}
+
+	k.SetRecordReverification(ctx, types.RecordReverification{
+		RecordId:        msg.RecordId,
+		Requester:       msg.Requester,
+		DisputedHash:    msg.DisputedHash,
+		ObservedHash:    msg.ObservedHash,
+		Reason:          msg.Reason,
+		Status:          types.ReverificationStatus_REVERIFICATION_STATUS_OPEN,
+		RequestedHeight: ctx.BlockHeight(),
+		RequestedTime:   ctx.BlockTime(),
+	})
+	k.AddScopeHistory(ctx, scopeID, msg)
+
+	k.EmitEvent(ctx, types.NewEventRecordReverificationRequested(msg.RecordId, msg.Requester, scope.Owners))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_RequestReverification, msg.GetSigners()))
+	return types.NewMsgRequestReverificationResponse(), nil
+}
+
+func (k msgServer) ResolveReverification(
+	goCtx context.Context,
+	msg *types.MsgResolveReverificationRequest,
+) (*types.MsgResolveReverificationResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "ResolveReverification")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	requester, _ := sdk.AccAddressFromBech32(msg.Requester) // already checked in ValidateBasic
+	reverification, found := k.GetRecordReverification(ctx, msg.RecordId, requester)
+	if !found {
+		return nil, fmt.Errorf("reverification request not found for record %s from %s", msg.RecordId, msg.Requester)
+	}
+	if reverification.Status != types.ReverificationStatus_REVERIFICATION_STATUS_OPEN {
+		return nil, fmt.Errorf("reverification request for record %s from %s is already resolved: %s",
+			msg.RecordId, msg.Requester, reverification.Status)
+	}
+	scopeID := msg.RecordId.MustGetAsScopeAddress()
+	if msg.Status == types.ReverificationStatus_REVERIFICATION_STATUS_WITHDRAWN {
+		if len(FindMissing([]string{msg.Requester}, msg.Signers)) > 0 {
+			return nil, fmt.Errorf("missing signature from requester %s; required to withdraw", msg.Requester)
+		}
+	} else {
+		scope, found := k.GetScope(ctx, scopeID)
+		if !found {
+			return nil, fmt.Errorf("scope not found with id %s", scopeID)
+		}
+		if err := k.ValidateAllPartiesAreSigners(scope.Owners, msg.Signers); err != nil {
+			return nil, err
+		}
+	}
+
+	reverification.Status = msg.Status
+	reverification.ResolutionNote = msg.Note
+	reverification.ResolvedHeight = ctx.BlockHeight()
+	reverification.ResolvedBy = msg.Signers
+	k.SetRecordReverification(ctx, reverification)
+	k.AddScopeHistory(ctx, scopeID, msg)
+
+	k.EmitEvent(ctx, types.NewEventRecordReverificationResolved(msg.RecordId, msg.Requester, msg.Status))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ResolveReverification, msg.GetSigners()))
+	return types.NewMsgResolveReverificationResponse(), nil
+}
+
+// scopeHasParticipant returns true if the address is an owner, the value owner, or a data access address of the scope.
+func scopeHasParticipant(scope types.Scope, address string) bool {
+	if scope.ValueOwnerAddress == address {
+		return true
+	}
+	for _, owner := range scope.Owners {
+		if owner.Address == address {
+			return true
+		}
+	}
+	for _, addr := range scope.DataAccess {
+		if addr == address {
+			return true
+		}
+	}
+	return false
+}
+
+// recordHasOutputHash returns true if one of the outputs of the record has the hash.
+func recordHasOutputHash(record types.Record, hash string) bool {
+	for _, output := range record.Outputs {
+		if output.Hash == hash {
+			return true
+		}
+	}
+	return false
+}
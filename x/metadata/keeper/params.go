@@ -8,8 +8,55 @@ import (
 
 // GetParams returns the total set of metadata parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	return types.Params{} // there are currently no params so no further action required here.
+	return types.Params{
+		ValidateCrossScopeRecordInputs: k.GetValidateCrossScopeRecordInputs(ctx),
+		MaxScopeHistoryEntries:         k.GetMaxScopeHistoryEntries(ctx),
+		StrictScopeSpecifications:      k.GetStrictScopeSpecifications(ctx),
+		LargeRecordThreshold:           k.GetLargeRecordThreshold(ctx),
+	}
 }
 
 // SetParams sets the metadata parameters to the param space.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {} // currently no params are supported
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetValidateCrossScopeRecordInputs gets the configured parameter for validating record inputs that reference
+// records in other scopes (or the default if unset)
+func (k Keeper) GetValidateCrossScopeRecordInputs(ctx sdk.Context) (enabled bool) {
+	enabled = types.DefaultValidateCrossScopeRecordInputs
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyValidateCrossScopeRecordInputs) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyValidateCrossScopeRecordInputs, &enabled)
+	}
+	return
+}
+
+// GetMaxScopeHistoryEntries gets the configured number of entries kept in the audit trail of each scope (or the
+// default if unset)
+func (k Keeper) GetMaxScopeHistoryEntries(ctx sdk.Context) (max uint32) {
+	max = types.DefaultMaxScopeHistoryEntries
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyMaxScopeHistoryEntries) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyMaxScopeHistoryEntries, &max)
+	}
+	return
+}
+
+// GetStrictScopeSpecifications gets the configured parameter for enforcing all scope specifications strictly (or the
+// default if unset)
+func (k Keeper) GetStrictScopeSpecifications(ctx sdk.Context) (enabled bool) {
+	enabled = types.DefaultStrictScopeSpecifications
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyStrictScopeSpecifications) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyStrictScopeSpecifications, &enabled)
+	}
+	return
+}
+
+// GetLargeRecordThreshold gets the configured serialized size above which records are stored compressed
+// and chunked (or the default if unset)
+func (k Keeper) GetLargeRecordThreshold(ctx sdk.Context) (threshold uint32) {
+	threshold = types.DefaultLargeRecordThreshold
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyLargeRecordThreshold) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyLargeRecordThreshold, &threshold)
+	}
+	return
+}
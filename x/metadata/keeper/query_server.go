@@ -6,11 +6,13 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,8 +30,7 @@ var _ types.QueryServer = Keeper{}
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Params")
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	params := k.GetParams(ctx)
 
 	return &types.QueryParamsResponse{Params: params, Request: req}, nil
 }
@@ -134,9 +135,68 @@ func (k Keeper) Scope(c context.Context, req *types.ScopeRequest) (*types.ScopeR
 		return &retval, status.Error(codes.Unavailable, err.Error())
 	}
 
+	if req.IncludeSpecs && found {
+		if err = k.addScopeSpecs(ctx, scope, &retval); err != nil {
+			return &retval, status.Error(codes.Unavailable, err.Error())
+		}
+	}
+
 	return &retval, nil
 }
 
+// addScopeSpecs adds the scope specification of the given scope, and the contract and record specifications used by
+// it and its sessions, to the provided response.
+func (k Keeper) addScopeSpecs(ctx sdk.Context, scope types.Scope, retval *types.ScopeResponse) error {
+	contractSpecIDs := []types.MetadataAddress{}
+	addContractSpecID := func(id types.MetadataAddress) {
+		if id.Empty() {
+			return
+		}
+		for _, existing := range contractSpecIDs {
+			if existing.Equals(id) {
+				return
+			}
+		}
+		contractSpecIDs = append(contractSpecIDs, id)
+	}
+
+	if !scope.SpecificationId.Empty() {
+		scopeSpec, found := k.GetScopeSpecification(ctx, scope.SpecificationId)
+		if found {
+			retval.ScopeSpecification = types.WrapScopeSpec(&scopeSpec)
+			for _, id := range scopeSpec.ContractSpecIds {
+				addContractSpecID(id)
+			}
+		} else {
+			retval.ScopeSpecification = types.WrapScopeSpecNotFound(scope.SpecificationId)
+		}
+	}
+
+	err := k.IterateSessions(ctx, scope.ScopeId, func(session types.Session) (stop bool) {
+		addContractSpecID(session.SpecificationId)
+		return false
+	})
+	if err != nil {
+		return fmt.Errorf("error iterating scope [%s] sessions: %w", scope.ScopeId, err)
+	}
+
+	for _, id := range contractSpecIDs {
+		contractSpec, found := k.GetContractSpecification(ctx, id)
+		if !found {
+			retval.ContractSpecs = append(retval.ContractSpecs, types.WrapContractSpecNotFound(id))
+			continue
+		}
+		retval.ContractSpecs = append(retval.ContractSpecs, types.WrapContractSpec(&contractSpec))
+		recSpecs, err := k.GetRecordSpecificationsForContractSpecificationID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("error getting record specifications for contract specification [%s]: %w", id, err)
+		}
+		retval.RecordSpecs = append(retval.RecordSpecs, types.WrapRecordSpecs(recSpecs)...)
+	}
+
+	return nil
+}
+
 // ScopesAll returns all scopes (limited by pagination).
 func (k Keeper) ScopesAll(c context.Context, req *types.ScopesAllRequest) (*types.ScopesAllResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopesAll")
@@ -176,6 +236,41 @@ func (k Keeper) ScopesAll(c context.Context, req *types.ScopesAllRequest) (*type
 	return &retval, nil
 }
 
+// ScopeHistory returns the audit trail entries of a scope, oldest first.
+func (k Keeper) ScopeHistory(c context.Context, req *types.ScopeHistoryRequest) (*types.ScopeHistoryResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeHistory")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.ScopeHistoryResponse{Request: req}
+
+	if req.ScopeId == "" {
+		return &retval, status.Error(codes.InvalidArgument, "scope id cannot be empty")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetScopeHistoryIteratorPrefix(scopeAddr))
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(_, value []byte) error {
+		var entry types.ScopeHistoryEntry
+		if vErr := k.cdc.Unmarshal(value, &entry); vErr != nil {
+			return vErr
+		}
+		retval.Entries = append(retval.Entries, entry)
+		return nil
+	})
+	if err != nil {
+		return &retval, status.Error(codes.Unavailable, err.Error())
+	}
+	retval.Pagination = pageRes
+
+	return &retval, nil
+}
+
 // Sessions returns sessions based on the provided request.
 func (k Keeper) Sessions(c context.Context, req *types.SessionsRequest) (*types.SessionsResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Sessions")
@@ -514,7 +609,7 @@ func (k Keeper) RecordsAll(c context.Context, req *types.RecordsAllRequest) (*ty
 
 	pageRes, err := query.Paginate(prefixStore, pageRequest, func(key, value []byte) error {
 		var record types.Record
-		vErr := record.Unmarshal(value)
+		vErr := k.unmarshalRecord(kvStore, append(types.RecordKeyPrefix, key...), value, &record)
 		if vErr == nil {
 			retval.Records = append(retval.Records, types.WrapRecord(&record))
 			return nil
@@ -540,6 +635,70 @@ func (k Keeper) RecordsAll(c context.Context, req *types.RecordsAllRequest) (*ty
 	return &retval, nil
 }
 
+// RecordReverifications returns the re-verification requests of a record, of the records in a scope, or of all records.
+func (k Keeper) RecordReverifications(
+	c context.Context,
+	req *types.RecordReverificationsRequest,
+) (*types.RecordReverificationsResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordReverifications")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.RecordReverificationsResponse{Request: req}
+
+	var id types.MetadataAddress
+	if len(req.RecordAddr) > 0 {
+		recordAddr, err := ParseRecordAddr(req.RecordAddr)
+		if err != nil {
+			return &retval, status.Error(codes.InvalidArgument, err.Error())
+		}
+		id = recordAddr
+	}
+	if len(req.ScopeId) > 0 {
+		scopeAddr, err := ParseScopeID(req.ScopeId)
+		if err != nil {
+			return &retval, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if !id.Empty() && !id.MustGetAsScopeAddress().Equals(scopeAddr) {
+			return &retval, status.Errorf(codes.InvalidArgument, "record %s is not part of scope %s", id, scopeAddr)
+		}
+		if id.Empty() {
+			id = scopeAddr
+		}
+	}
+
+	storePrefix := types.RecordReverificationKeyPrefix
+	if !id.Empty() {
+		var err error
+		if storePrefix, err = types.GetRecordReverificationIteratorPrefix(id); err != nil {
+			return &retval, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	reverificationStore := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	pageRes, err := query.FilteredPaginate(reverificationStore, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var reverification types.RecordReverification
+		if vErr := k.cdc.Unmarshal(value, &reverification); vErr != nil {
+			return false, vErr
+		}
+		if req.Status != types.ReverificationStatus_REVERIFICATION_STATUS_UNSPECIFIED && reverification.Status != req.Status {
+			return false, nil
+		}
+		if accumulate {
+			retval.Reverifications = append(retval.Reverifications, reverification)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &retval, status.Error(codes.Unavailable, err.Error())
+	}
+	retval.Pagination = pageRes
+
+	return &retval, nil
+}
+
 // Ownership returns a list of scope identifiers that list the given address as a data or value owner.
 func (k Keeper) Ownership(c context.Context, req *types.OwnershipRequest) (*types.OwnershipResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Ownership")
@@ -582,6 +741,61 @@ func (k Keeper) Ownership(c context.Context, req *types.OwnershipRequest) (*type
 	return &retval, nil
 }
 
+// ScopesByParty returns a list of scope identifiers that list the given address as an owner party.
+func (k Keeper) ScopesByParty(c context.Context, req *types.ScopesByPartyRequest) (*types.ScopesByPartyResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopesByParty")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.ScopesByPartyResponse{Request: req}
+
+	if req.Address == "" {
+		return &retval, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	roles := make(map[types.PartyType]bool, len(req.Roles))
+	for _, role := range req.Roles {
+		if _, ok := types.PartyType_name[int32(role)]; !ok || role == types.PartyType_PARTY_TYPE_UNSPECIFIED {
+			return &retval, status.Errorf(codes.InvalidArgument, "invalid party type: %s", role)
+		}
+		roles[role] = true
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	scopeStore := prefix.NewStore(store, types.GetPartyScopeCacheIteratorPrefix(addr))
+
+	pageRes, err := query.FilteredPaginate(scopeStore, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		role, ma, kErr := types.SplitPartyScopeCacheKey(key)
+		if kErr != nil {
+			return false, kErr
+		}
+		if len(roles) > 0 && !roles[role] {
+			return false, nil
+		}
+		if accumulate {
+			scopeUUID, sErr := ma.ScopeUUID()
+			if sErr != nil {
+				return false, sErr
+			}
+			retval.Scopes = append(retval.Scopes, types.PartyScope{ScopeUuid: scopeUUID.String(), Role: role})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+
+	return &retval, nil
+}
+
 // ValueOwnership returns a list of scope identifiers that list the given address as a value owner.
 func (k Keeper) ValueOwnership(c context.Context, req *types.ValueOwnershipRequest) (*types.ValueOwnershipResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ValueOwnership")
@@ -875,6 +1089,50 @@ func (k Keeper) RecordSpecificationsAll(c context.Context, req *types.RecordSpec
 	return &retval, nil
 }
 
+// CheckSpecCompatibility returns the differences between two scope specifications that would break existing scopes.
+func (k Keeper) CheckSpecCompatibility(c context.Context, req *types.CheckSpecCompatibilityRequest) (*types.CheckSpecCompatibilityResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "CheckSpecCompatibility")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.CheckSpecCompatibilityResponse{Request: req}
+
+	if len(req.OldSpecId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "old specification id cannot be empty")
+	}
+	if len(req.NewSpecId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "new specification id cannot be empty")
+	}
+
+	oldAddr, err := ParseScopeSpecID(req.OldSpecId)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+	newAddr, err := ParseScopeSpecID(req.NewSpecId)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	oldSpec, found := k.GetScopeSpecification(ctx, oldAddr)
+	if !found {
+		return &retval, status.Errorf(codes.NotFound, "scope specification not found with id %s", oldAddr)
+	}
+	newSpec, found := k.GetScopeSpecification(ctx, newAddr)
+	if !found {
+		return &retval, status.Errorf(codes.NotFound, "scope specification not found with id %s", newAddr)
+	}
+
+	retval.Differences, err = k.GetSpecDifferences(ctx, oldSpec, newSpec)
+	if err != nil {
+		return &retval, status.Error(codes.Unavailable, err.Error())
+	}
+	retval.Compatible = len(retval.Differences) == 0
+
+	return &retval, nil
+}
+
 func (k Keeper) OSLocatorParams(c context.Context, request *types.OSLocatorParamsRequest) (*types.OSLocatorParamsResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "OSLocatorParams")
 	ctx := sdk.UnwrapSDKContext(c)
@@ -998,6 +1256,161 @@ func (k Keeper) OSAllLocators(ctx context.Context, request *types.OSAllLocatorsR
 	return &retval, nil
 }
 
+// DeriveAddress derives a metadata address from uuids and a name, or breaks a metadata address into its components.
+func (k Keeper) DeriveAddress(ctx context.Context, request *types.DeriveAddressRequest) (*types.DeriveAddressResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "DeriveAddress")
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.DeriveAddressResponse{Request: request}
+
+	addr, err := DeriveMetadataAddress(request)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	retval.Address = addr.String()
+	switch {
+	case addr.IsScopeAddress():
+		retval.ScopeIdInfo = types.GetScopeIDInfo(addr)
+	case addr.IsSessionAddress():
+		retval.SessionIdInfo = types.GetSessionIDInfo(addr)
+	case addr.IsRecordAddress():
+		retval.RecordIdInfo = types.GetRecordIDInfo(addr)
+	case addr.IsScopeSpecificationAddress():
+		retval.ScopeSpecIdInfo = types.GetScopeSpecIDInfo(addr)
+	case addr.IsContractSpecificationAddress():
+		retval.ContractSpecIdInfo = types.GetContractSpecIDInfo(addr)
+	case addr.IsRecordSpecificationAddress():
+		retval.RecordSpecIdInfo = types.GetRecordSpecIDInfo(addr)
+	}
+
+	return &retval, nil
+}
+
+// ValidateWrite runs a metadata write message through all of the checks done when processing it in a transaction,
+// without committing any of the resulting state.
+func (k Keeper) ValidateWrite(c context.Context, req *types.ValidateWriteRequest) (*types.ValidateWriteResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ValidateWrite")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.ValidateWriteResponse{Request: req}
+
+	msg := req.GetWriteMsg()
+	if msg == nil {
+		return &retval, status.Error(codes.InvalidArgument, "a metadata write msg is required")
+	}
+
+	// The cache context is never written, so nothing done by the msg server is kept.
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := NewMsgServerImpl(k)
+
+	var write func() error
+	switch m := msg.(type) {
+	case *types.MsgWriteScopeRequest:
+		write = func() error { _, err := msgServer.WriteScope(goCtx, m); return err }
+	case *types.MsgWriteSessionRequest:
+		write = func() error { _, err := msgServer.WriteSession(goCtx, m); return err }
+	case *types.MsgWriteRecordRequest:
+		write = func() error { _, err := msgServer.WriteRecord(goCtx, m); return err }
+	case *types.MsgWriteScopeSpecificationRequest:
+		write = func() error { _, err := msgServer.WriteScopeSpecification(goCtx, m); return err }
+	case *types.MsgWriteContractSpecificationRequest:
+		write = func() error { _, err := msgServer.WriteContractSpecification(goCtx, m); return err }
+	case *types.MsgWriteRecordSpecificationRequest:
+		write = func() error { _, err := msgServer.WriteRecordSpecification(goCtx, m); return err }
+	default:
+		return &retval, status.Errorf(codes.InvalidArgument, "unsupported msg type: %s", sdk.MsgTypeURL(msg))
+	}
+
+	err := msg.ValidateBasic()
+	if err == nil {
+		err = write()
+	}
+	retval.Valid = err == nil
+	if err != nil {
+		retval.Error = err.Error()
+	}
+
+	retval.Msg, err = codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return &retval, status.Error(codes.Internal, err.Error())
+	}
+
+	return &retval, nil
+}
+
+// DeriveMetadataAddress gets the MetadataAddress identified by a DeriveAddressRequest.
+// If the request has an address, it is parsed and no other fields can be provided.
+// Otherwise, the address is derived from the provided uuids and name.
+func DeriveMetadataAddress(request *types.DeriveAddressRequest) (types.MetadataAddress, error) {
+	name := strings.TrimSpace(request.Name)
+	if len(request.Address) > 0 {
+		if len(request.ScopeUuid) > 0 || len(request.SessionUuid) > 0 || len(name) > 0 ||
+			len(request.ScopeSpecUuid) > 0 || len(request.ContractSpecUuid) > 0 {
+			return types.MetadataAddress{}, errors.New("an address cannot be combined with uuids or a name")
+		}
+		return types.MetadataAddressFromBech32(request.Address)
+	}
+	switch {
+	case len(request.ScopeUuid) > 0:
+		if len(request.ScopeSpecUuid) > 0 || len(request.ContractSpecUuid) > 0 {
+			return types.MetadataAddress{}, errors.New("a scope uuid cannot be combined with a specification uuid")
+		}
+		scopeUUID, err := parseUUID("scope", request.ScopeUuid)
+		if err != nil {
+			return types.MetadataAddress{}, err
+		}
+		switch {
+		case len(request.SessionUuid) > 0 && len(name) > 0:
+			return types.MetadataAddress{}, errors.New("a session uuid cannot be combined with a name")
+		case len(request.SessionUuid) > 0:
+			sessionUUID, err := parseUUID("session", request.SessionUuid)
+			if err != nil {
+				return types.MetadataAddress{}, err
+			}
+			return types.SessionMetadataAddress(scopeUUID, sessionUUID), nil
+		case len(name) > 0:
+			return types.RecordMetadataAddress(scopeUUID, name), nil
+		}
+		return types.ScopeMetadataAddress(scopeUUID), nil
+	case len(request.SessionUuid) > 0:
+		return types.MetadataAddress{}, errors.New("a scope uuid is required with a session uuid")
+	case len(request.ScopeSpecUuid) > 0:
+		if len(request.ContractSpecUuid) > 0 || len(name) > 0 {
+			return types.MetadataAddress{}, errors.New("a scope spec uuid cannot be combined with a contract spec uuid or a name")
+		}
+		specUUID, err := parseUUID("scope spec", request.ScopeSpecUuid)
+		if err != nil {
+			return types.MetadataAddress{}, err
+		}
+		return types.ScopeSpecMetadataAddress(specUUID), nil
+	case len(request.ContractSpecUuid) > 0:
+		specUUID, err := parseUUID("contract spec", request.ContractSpecUuid)
+		if err != nil {
+			return types.MetadataAddress{}, err
+		}
+		if len(name) > 0 {
+			return types.RecordSpecMetadataAddress(specUUID, name), nil
+		}
+		return types.ContractSpecMetadataAddress(specUUID), nil
+	}
+	return types.MetadataAddress{}, errors.New("an address, scope uuid, scope spec uuid, or contract spec uuid is required")
+}
+
+// parseUUID parses the provided value into a uuid, returning an error that identifies the field if it is invalid.
+func parseUUID(field string, value string) (uuid.UUID, error) {
+	uid, err := uuid.Parse(strings.TrimSpace(value))
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("invalid %s uuid [%s]: %w", field, value, err)
+	}
+	return uid, nil
+}
+
 func IsBase64(s string) bool {
 	_, err := b64.StdEncoding.DecodeString(s)
 	return err == nil
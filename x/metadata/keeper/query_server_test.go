@@ -168,6 +168,47 @@ func (s *QueryServerTestSuite) TestScopeQuery() {
 	s.Len(ownerResponse.ScopeUuids, 1)
 }
 
+func (s *QueryServerTestSuite) TestScopeQueryIncludeSpecs() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	scopeSpec := types.NewScopeSpecification(s.scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{s.cSpecID})
+	app.MetadataKeeper.SetScopeSpecification(ctx, *scopeSpec)
+	cSpec := types.NewContractSpecification(s.cSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, types.NewContractSpecificationSourceHash("HASH"), "CLASS")
+	app.MetadataKeeper.SetContractSpecification(ctx, *cSpec)
+	recSpec := types.NewRecordSpecification(s.recSpecID, s.recordName, nil, "TYPE", types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER})
+	app.MetadataKeeper.SetRecordSpecification(ctx, *recSpec)
+
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1)
+	app.MetadataKeeper.SetScope(ctx, *scope)
+	session := types.NewSession(s.sessionName, s.sessionID, s.cSpecID, ownerPartyList(s.user1), nil)
+	app.MetadataKeeper.SetSession(ctx, *session)
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	record := types.NewRecord(s.recordName, s.sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recSpecID)
+	app.MetadataKeeper.SetRecord(ctx, *record)
+
+	res, err := queryClient.Scope(gocontext.Background(), &types.ScopeRequest{ScopeId: s.scopeUUID.String()})
+	s.Require().NoError(err, "scope without specs")
+	s.Nil(res.ScopeSpecification, "scope spec when not requested")
+	s.Empty(res.ContractSpecs, "contract specs when not requested")
+	s.Empty(res.RecordSpecs, "record specs when not requested")
+
+	res, err = queryClient.Scope(gocontext.Background(), &types.ScopeRequest{
+		ScopeId:         s.scopeID.String(),
+		IncludeSessions: true,
+		IncludeRecords:  true,
+		IncludeSpecs:    true,
+	})
+	s.Require().NoError(err, "scope with specs")
+	s.Require().Len(res.Sessions, 1, "sessions")
+	s.Require().Len(res.Records, 1, "records")
+	s.Require().NotNil(res.ScopeSpecification, "scope spec")
+	s.Equal(scopeSpec, res.ScopeSpecification.Specification, "scope spec")
+	s.Require().Len(res.ContractSpecs, 1, "contract specs")
+	s.Equal(cSpec, res.ContractSpecs[0].Specification, "contract spec")
+	s.Require().Len(res.RecordSpecs, 1, "record specs")
+	s.Equal(recSpec, res.RecordSpecs[0].Specification, "record spec")
+}
+
 // TODO: ScopesAll tests
 
 func (s *QueryServerTestSuite) TestSessionsQuery() {
@@ -823,6 +864,189 @@ func (s *QueryServerTestSuite) TestRecordsQuery() {
 	s.Equal(recordNames[0], rsID.Records[0].Record.Name)
 }
 
+func (s *QueryServerTestSuite) TestScopesByPartyQuery() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	servicedID := types.ScopeMetadataAddress(uuid.New())
+	serviced := types.NewScope(servicedID, s.scopeSpecID, []types.Party{
+		{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER},
+		{Address: s.user2, Role: types.PartyType_PARTY_TYPE_SERVICER},
+	}, []string{s.user1}, s.user1)
+	app.MetadataKeeper.SetScope(ctx, *serviced)
+	owned := types.NewScope(s.scopeID, s.scopeSpecID, []types.Party{
+		{Address: s.user2, Role: types.PartyType_PARTY_TYPE_OWNER},
+		{Address: s.user2, Role: types.PartyType_PARTY_TYPE_SERVICER},
+	}, []string{s.user1}, s.user1)
+	app.MetadataKeeper.SetScope(ctx, *owned)
+
+	servicedUUID, err := servicedID.ScopeUUID()
+	s.Require().NoError(err)
+
+	res, err := queryClient.ScopesByParty(gocontext.Background(), &types.ScopesByPartyRequest{Address: s.user2})
+	s.Require().NoError(err, "all roles")
+	s.Len(res.Scopes, 3, "a scope is listed for each role")
+
+	res, err = queryClient.ScopesByParty(gocontext.Background(), &types.ScopesByPartyRequest{
+		Address: s.user2,
+		Roles:   []types.PartyType{types.PartyType_PARTY_TYPE_SERVICER},
+	})
+	s.Require().NoError(err, "servicer role")
+	s.Len(res.Scopes, 2, "scopes with user2 as servicer")
+
+	// data access and value owner addresses are not parties
+	res, err = queryClient.ScopesByParty(gocontext.Background(), &types.ScopesByPartyRequest{
+		Address: s.user1,
+		Roles:   []types.PartyType{types.PartyType_PARTY_TYPE_OWNER, types.PartyType_PARTY_TYPE_SERVICER},
+	})
+	s.Require().NoError(err, "user1 owner or servicer")
+	s.Equal([]types.PartyScope{{ScopeUuid: servicedUUID.String(), Role: types.PartyType_PARTY_TYPE_OWNER}}, res.Scopes)
+
+	// updated parties are re-indexed
+	serviced.Owners = []types.Party{{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER}}
+	app.MetadataKeeper.SetScope(ctx, *serviced)
+	res, err = queryClient.ScopesByParty(gocontext.Background(), &types.ScopesByPartyRequest{
+		Address: s.user2,
+		Roles:   []types.PartyType{types.PartyType_PARTY_TYPE_SERVICER},
+	})
+	s.Require().NoError(err, "servicer role after update")
+	s.Len(res.Scopes, 1, "scopes with user2 as servicer after update")
+
+	// removed scopes are no longer indexed
+	app.MetadataKeeper.RemoveScope(ctx, s.scopeID)
+	res, err = queryClient.ScopesByParty(gocontext.Background(), &types.ScopesByPartyRequest{Address: s.user2})
+	s.Require().NoError(err, "all roles after remove")
+	s.Empty(res.Scopes, "scopes with user2 after remove")
+
+	_, err = queryClient.ScopesByParty(gocontext.Background(), &types.ScopesByPartyRequest{
+		Address: s.user1,
+		Roles:   []types.PartyType{types.PartyType_PARTY_TYPE_UNSPECIFIED},
+	})
+	s.Require().EqualError(err, "rpc error: code = InvalidArgument desc = invalid party type: PARTY_TYPE_UNSPECIFIED")
+}
+
+func (s *QueryServerTestSuite) TestDeriveAddressQuery() {
+	queryClient := s.queryClient
+	scopeSpecUUID := s.scopeSpecUUID.String()
+	cSpecUUID := s.cSpecUUID.String()
+
+	tests := []struct {
+		name     string
+		req      *types.DeriveAddressRequest
+		expected types.DeriveAddressResponse
+		err      string
+	}{
+		{
+			"scope from uuid",
+			&types.DeriveAddressRequest{ScopeUuid: s.scopeUUID.String()},
+			types.DeriveAddressResponse{Address: s.scopeID.String(), ScopeIdInfo: types.GetScopeIDInfo(s.scopeID)},
+			"",
+		},
+		{
+			"session from uuids",
+			&types.DeriveAddressRequest{ScopeUuid: s.scopeUUID.String(), SessionUuid: s.sessionUUID.String()},
+			types.DeriveAddressResponse{Address: s.sessionID.String(), SessionIdInfo: types.GetSessionIDInfo(s.sessionID)},
+			"",
+		},
+		{
+			"record from uuid and name",
+			&types.DeriveAddressRequest{ScopeUuid: s.scopeUUID.String(), Name: " testrecord "},
+			types.DeriveAddressResponse{Address: s.recordID.String(), RecordIdInfo: types.GetRecordIDInfo(s.recordID)},
+			"",
+		},
+		{
+			"scope spec from uuid",
+			&types.DeriveAddressRequest{ScopeSpecUuid: scopeSpecUUID},
+			types.DeriveAddressResponse{Address: s.scopeSpecID.String(), ScopeSpecIdInfo: types.GetScopeSpecIDInfo(s.scopeSpecID)},
+			"",
+		},
+		{
+			"contract spec from uuid",
+			&types.DeriveAddressRequest{ContractSpecUuid: cSpecUUID},
+			types.DeriveAddressResponse{Address: s.cSpecID.String(), ContractSpecIdInfo: types.GetContractSpecIDInfo(s.cSpecID)},
+			"",
+		},
+		{
+			"record spec from uuid and name",
+			&types.DeriveAddressRequest{ContractSpecUuid: cSpecUUID, Name: s.recordName},
+			types.DeriveAddressResponse{Address: s.recSpecID.String(), RecordSpecIdInfo: types.GetRecordSpecIDInfo(s.recSpecID)},
+			"",
+		},
+		{
+			"components of a session address",
+			&types.DeriveAddressRequest{Address: s.sessionID.String()},
+			types.DeriveAddressResponse{Address: s.sessionID.String(), SessionIdInfo: types.GetSessionIDInfo(s.sessionID)},
+			"",
+		},
+		{
+			"components of a record spec address",
+			&types.DeriveAddressRequest{Address: s.recSpecID.String()},
+			types.DeriveAddressResponse{Address: s.recSpecID.String(), RecordSpecIdInfo: types.GetRecordSpecIDInfo(s.recSpecID)},
+			"",
+		},
+		{
+			"address combined with a uuid",
+			&types.DeriveAddressRequest{Address: s.scopeID.String(), ScopeUuid: s.scopeUUID.String()},
+			types.DeriveAddressResponse{},
+			"rpc error: code = InvalidArgument desc = an address cannot be combined with uuids or a name",
+		},
+		{
+			"invalid address",
+			&types.DeriveAddressRequest{Address: sdk.AccAddress("invalid_address_____").String()},
+			types.DeriveAddressResponse{},
+			"rpc error: code = InvalidArgument desc = invalid metadata address type: 105",
+		},
+		{
+			"invalid scope uuid",
+			&types.DeriveAddressRequest{ScopeUuid: "not-a-uuid"},
+			types.DeriveAddressResponse{},
+			"rpc error: code = InvalidArgument desc = invalid scope uuid [not-a-uuid]: invalid UUID length: 10",
+		},
+		{
+			"session uuid without scope uuid",
+			&types.DeriveAddressRequest{SessionUuid: s.sessionUUID.String()},
+			types.DeriveAddressResponse{},
+			"rpc error: code = InvalidArgument desc = a scope uuid is required with a session uuid",
+		},
+		{
+			"session uuid with name",
+			&types.DeriveAddressRequest{ScopeUuid: s.scopeUUID.String(), SessionUuid: s.sessionUUID.String(), Name: s.recordName},
+			types.DeriveAddressResponse{},
+			"rpc error: code = InvalidArgument desc = a session uuid cannot be combined with a name",
+		},
+		{
+			"scope uuid with spec uuid",
+			&types.DeriveAddressRequest{ScopeUuid: s.scopeUUID.String(), ContractSpecUuid: cSpecUUID},
+			types.DeriveAddressResponse{},
+			"rpc error: code = InvalidArgument desc = a scope uuid cannot be combined with a specification uuid",
+		},
+		{
+			"scope spec uuid with name",
+			&types.DeriveAddressRequest{ScopeSpecUuid: scopeSpecUUID, Name: s.recordName},
+			types.DeriveAddressResponse{},
+			"rpc error: code = InvalidArgument desc = a scope spec uuid cannot be combined with a contract spec uuid or a name",
+		},
+		{
+			"nothing provided",
+			&types.DeriveAddressRequest{Name: s.recordName},
+			types.DeriveAddressResponse{},
+			"rpc error: code = InvalidArgument desc = an address, scope uuid, scope spec uuid, or contract spec uuid is required",
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			res, err := queryClient.DeriveAddress(gocontext.Background(), tc.req)
+			if len(tc.err) > 0 {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			tc.expected.Request = tc.req
+			assert.Equal(t, tc.expected, *res)
+		})
+	}
+}
+
 // TODO: RecordsAll tests
 // TODO: Ownership tests
 // TODO: ValueOwnership tests
@@ -848,3 +1072,222 @@ func (s *QueryServerTestSuite) TestRecordsQuery() {
 // TODO: Helper ParseContractSpecID tests
 // TODO: Helper ParseRecordSpecID tests
 // TODO: Helper getPageRequest tests
+
+func (s *QueryServerTestSuite) TestCheckSpecCompatibilityQuery() {
+	queryClient := s.queryClient
+	owner := types.PartyType_PARTY_TYPE_OWNER
+	originator := types.PartyType_PARTY_TYPE_ORIGINATOR
+	servicer := types.PartyType_PARTY_TYPE_SERVICER
+	source := &types.ContractSpecification_Hash{Hash: "hash"}
+
+	oldCSpecUUID := uuid.New()
+	oldCSpecID := types.ContractSpecMetadataAddress(oldCSpecUUID)
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *types.NewContractSpecification(oldCSpecID, nil,
+		[]string{s.user1}, []types.PartyType{owner}, source, "loan"))
+	droppedCSpecID := types.ContractSpecMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *types.NewContractSpecification(droppedCSpecID, nil,
+		[]string{s.user1}, []types.PartyType{owner}, source, "servicing"))
+	newCSpecUUID := uuid.New()
+	newCSpecID := types.ContractSpecMetadataAddress(newCSpecUUID)
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *types.NewContractSpecification(newCSpecID, nil,
+		[]string{s.user1}, []types.PartyType{owner, originator}, source, "loan"))
+
+	oldRecSpecID := types.RecordSpecMetadataAddress(oldCSpecUUID, "rec1")
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(oldRecSpecID, "rec1",
+		[]*types.InputSpecification{
+			types.NewInputSpecification("in1", "Type1", types.NewInputSpecificationSourceHash("hash1")),
+			types.NewInputSpecification("in2", "Type2", types.NewInputSpecificationSourceHash("hash2")),
+		},
+		"RecordType", types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{owner}))
+	droppedRecSpecID := types.RecordSpecMetadataAddress(oldCSpecUUID, "rec2")
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(droppedRecSpecID, "rec2",
+		[]*types.InputSpecification{}, "RecordType", types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{owner}))
+	newRecSpecID := types.RecordSpecMetadataAddress(newCSpecUUID, "rec1")
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(newRecSpecID, "rec1",
+		[]*types.InputSpecification{
+			types.NewInputSpecification("in1", "Type1b", types.NewInputSpecificationSourceHash("hash1")),
+			types.NewInputSpecification("in2", "Type2", types.NewInputSpecificationSourceHash("hash2b")),
+			types.NewInputSpecification("in3", "Type3", types.NewInputSpecificationSourceHash("hash3")),
+		},
+		"RecordType", types.DefinitionType_DEFINITION_TYPE_RECORD_LIST, []types.PartyType{owner}))
+
+	oldSpecUUID := uuid.New()
+	oldSpecID := types.ScopeSpecMetadataAddress(oldSpecUUID)
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *types.NewScopeSpecification(oldSpecID, nil, []string{s.user1},
+		[]types.PartyType{owner}, []types.MetadataAddress{oldCSpecID, droppedCSpecID}))
+	copySpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *types.NewScopeSpecification(copySpecID, nil, []string{s.user1},
+		[]types.PartyType{owner}, []types.MetadataAddress{oldCSpecID, droppedCSpecID}))
+	newSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *types.NewScopeSpecification(newSpecID, nil, []string{s.user1},
+		[]types.PartyType{owner, servicer}, []types.MetadataAddress{newCSpecID}))
+
+	s.T().Run("breaking differences", func(t *testing.T) {
+		res, err := queryClient.CheckSpecCompatibility(gocontext.Background(),
+			&types.CheckSpecCompatibilityRequest{OldSpecId: oldSpecUUID.String(), NewSpecId: newSpecID.String()})
+		require.NoError(t, err)
+		assert.False(t, res.Compatible, "compatible")
+		expected := []types.SpecDifference{
+			{
+				Type:            types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_ADDED_PARTY_REQUIREMENT,
+				SpecificationId: oldSpecID.String(),
+				Description:     "scope specification requires party type PARTY_TYPE_SERVICER",
+			},
+			{
+				Type:            types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_ADDED_PARTY_REQUIREMENT,
+				SpecificationId: oldCSpecID.String(),
+				Description:     fmt.Sprintf("contract specification %s requires party type PARTY_TYPE_ORIGINATOR", newCSpecID),
+			},
+			{
+				Type:            types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_CHANGED_RESULT_TYPE,
+				SpecificationId: oldRecSpecID.String(),
+				Description: fmt.Sprintf("record specification %s has result type DEFINITION_TYPE_RECORD_LIST instead of DEFINITION_TYPE_RECORD",
+					newRecSpecID),
+			},
+			{
+				Type:            types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_CHANGED_INPUT_TYPE,
+				SpecificationId: oldRecSpecID.String(),
+				Description:     "input in1 has TypeName Type1b instead of Type1",
+			},
+			{
+				Type:            types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_CHANGED_INPUT_SOURCE,
+				SpecificationId: oldRecSpecID.String(),
+				Description:     "input in2 has source hash hash2b instead of hash hash2",
+			},
+			{
+				Type:            types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_ADDED_INPUT,
+				SpecificationId: oldRecSpecID.String(),
+				Description:     fmt.Sprintf("record specification %s requires input in3", newRecSpecID),
+			},
+			{
+				Type:            types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_REMOVED_RECORD_SPEC,
+				SpecificationId: droppedRecSpecID.String(),
+				Description:     fmt.Sprintf("record specification rec2 is not in contract specification %s", newCSpecID),
+			},
+			{
+				Type:            types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_REMOVED_CONTRACT_SPEC,
+				SpecificationId: droppedCSpecID.String(),
+				Description:     "contract specification with class name servicing is not in the new scope specification",
+			},
+		}
+		assert.ElementsMatch(t, expected, res.Differences, "differences")
+	})
+
+	s.T().Run("removed input", func(t *testing.T) {
+		res, err := queryClient.CheckSpecCompatibility(gocontext.Background(),
+			&types.CheckSpecCompatibilityRequest{OldSpecId: newSpecID.String(), NewSpecId: oldSpecID.String()})
+		require.NoError(t, err)
+		assert.False(t, res.Compatible, "compatible")
+		assert.Contains(t, res.Differences, types.SpecDifference{
+			Type:            types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_REMOVED_INPUT,
+			SpecificationId: newRecSpecID.String(),
+			Description:     fmt.Sprintf("record specification %s does not have input in3", oldRecSpecID),
+		}, "differences")
+	})
+
+	s.T().Run("compatible", func(t *testing.T) {
+		res, err := queryClient.CheckSpecCompatibility(gocontext.Background(),
+			&types.CheckSpecCompatibilityRequest{OldSpecId: oldSpecID.String(), NewSpecId: copySpecID.String()})
+		require.NoError(t, err)
+		assert.True(t, res.Compatible, "compatible")
+		assert.Empty(t, res.Differences, "differences")
+	})
+
+	errTests := []struct {
+		name string
+		req  *types.CheckSpecCompatibilityRequest
+		err  string
+	}{
+		{
+			"missing old spec id",
+			&types.CheckSpecCompatibilityRequest{NewSpecId: newSpecID.String()},
+			"rpc error: code = InvalidArgument desc = old specification id cannot be empty",
+		},
+		{
+			"missing new spec id",
+			&types.CheckSpecCompatibilityRequest{OldSpecId: oldSpecID.String()},
+			"rpc error: code = InvalidArgument desc = new specification id cannot be empty",
+		},
+		{
+			"new spec not found",
+			&types.CheckSpecCompatibilityRequest{OldSpecId: oldSpecID.String(), NewSpecId: s.scopeSpecID.String()},
+			fmt.Sprintf("rpc error: code = NotFound desc = scope specification not found with id %s", s.scopeSpecID),
+		},
+	}
+
+	for _, tc := range errTests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := queryClient.CheckSpecCompatibility(gocontext.Background(), tc.req)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func (s *QueryServerTestSuite) TestValidateWriteQuery() {
+	queryClient := s.queryClient
+
+	scopeSpec := types.NewScopeSpecification(s.scopeSpecID, nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *scopeSpec)
+
+	newRequest := func(msg sdk.Msg) *types.ValidateWriteRequest {
+		req, err := types.NewValidateWriteRequest(msg)
+		s.Require().NoError(err, "NewValidateWriteRequest")
+		return req
+	}
+
+	s.Run("nil msg", func() {
+		_, err := queryClient.ValidateWrite(gocontext.Background(), &types.ValidateWriteRequest{})
+		s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = a metadata write msg is required")
+	})
+
+	s.Run("unsupported msg", func() {
+		req := newRequest(types.NewMsgDeleteScopeRequest(s.scopeID, []string{s.user1}))
+		_, err := queryClient.ValidateWrite(gocontext.Background(), req)
+		s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = unsupported msg type: /provenance.metadata.v1.MsgDeleteScopeRequest")
+	})
+
+	s.Run("valid scope is normalized and not stored", func() {
+		scope := types.NewScope(nil, s.scopeSpecID, ownerPartyList(s.user1), []string{}, "")
+		msg := types.NewMsgWriteScopeRequest(*scope, []string{s.user1})
+		msg.ScopeUuid = s.scopeUUID.String()
+		res, err := queryClient.ValidateWrite(gocontext.Background(), newRequest(msg))
+		s.Require().NoError(err, "ValidateWrite")
+		s.Assert().True(res.Valid, "valid")
+		s.Assert().Empty(res.Error, "error")
+		s.Require().NotNil(res.Msg, "normalized msg")
+		normalized, ok := res.Msg.GetCachedValue().(*types.MsgWriteScopeRequest)
+		s.Require().True(ok, "normalized msg type: %T", res.Msg.GetCachedValue())
+		s.Assert().Equal(s.scopeID, normalized.Scope.ScopeId, "normalized scope id")
+		s.Assert().Empty(normalized.ScopeUuid, "normalized scope uuid")
+
+		_, found := s.app.MetadataKeeper.GetScope(s.ctx, s.scopeID)
+		s.Assert().False(found, "scope stored")
+	})
+
+	s.Run("missing existing owner signature", func() {
+		existing := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{}, "")
+		s.app.MetadataKeeper.SetScope(s.ctx, *existing)
+		defer s.app.MetadataKeeper.RemoveScope(s.ctx, s.scopeID)
+
+		scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user2), []string{}, "")
+		msg := types.NewMsgWriteScopeRequest(*scope, []string{s.user2})
+		res, err := queryClient.ValidateWrite(gocontext.Background(), newRequest(msg))
+		s.Require().NoError(err, "ValidateWrite")
+		s.Assert().False(res.Valid, "valid")
+		s.Assert().Equal(fmt.Sprintf("missing signature from existing owner %s; required for update", s.user1), res.Error, "error")
+
+		stored, found := s.app.MetadataKeeper.GetScope(s.ctx, s.scopeID)
+		s.Require().True(found, "scope found")
+		s.Assert().Equal(existing.Owners, stored.Owners, "stored scope owners")
+	})
+
+	s.Run("invalid basic", func() {
+		scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{}, "")
+		msg := types.NewMsgWriteScopeRequest(*scope, []string{})
+		res, err := queryClient.ValidateWrite(gocontext.Background(), newRequest(msg))
+		s.Require().NoError(err, "ValidateWrite")
+		s.Assert().False(res.Valid, "valid")
+		s.Assert().Equal("at least one signer is required", res.Error, "error")
+	})
+}
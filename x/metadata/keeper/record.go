@@ -24,7 +24,9 @@ func (k Keeper) GetRecord(ctx sdk.Context, id types.MetadataAddress) (record typ
 	if b == nil {
 		return types.Record{}, false
 	}
-	k.cdc.MustUnmarshal(b, &record)
+	if err := k.unmarshalRecord(store, id, b, &record); err != nil {
+		panic(err)
+	}
 	return record, true
 }
 
@@ -54,10 +56,11 @@ func (k Keeper) GetRecords(ctx sdk.Context, scopeAddress types.MetadataAddress,
 	return records, nil
 }
 
-// SetRecord stores a record in the module kv store.
+// SetRecord stores a record in the module kv store.  Records larger than the large record threshold param are stored
+// compressed and chunked.
 func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
 	store := ctx.KVStore(k.storeKey)
-	b := k.cdc.MustMarshal(&record)
+	b, chunks := types.EncodeRecordPayload(k.cdc.MustMarshal(&record), k.GetLargeRecordThreshold(ctx))
 
 	recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
 
@@ -68,6 +71,10 @@ func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
 		action = types.TLAction_Updated
 	}
 
+	k.removeRecordChunks(store, recordID)
+	for i, chunk := range chunks {
+		store.Set(types.GetRecordChunkKey(recordID, uint32(i)), chunk)
+	}
 	store.Set(recordID, b)
 	k.EmitEvent(ctx, event)
 	defer types.GetIncObjFunc(types.TLType_Record, action)
@@ -83,6 +90,7 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 		return
 	}
 	store := ctx.KVStore(k.storeKey)
+	k.removeRecordChunks(store, id)
 	store.Delete(id)
 	k.EmitEvent(ctx, types.NewEventRecordDeleted(id))
 	defer types.GetIncObjFunc(types.TLType_Record, types.TLAction_Deleted)
@@ -104,7 +112,7 @@ func (k Keeper) IterateRecords(ctx sdk.Context, scopeID types.MetadataAddress, h
 	defer it.Close()
 	for ; it.Valid(); it.Next() {
 		var record types.Record
-		err = k.cdc.Unmarshal(it.Value(), &record)
+		err = k.unmarshalRecord(store, it.Key(), it.Value(), &record)
 		if err != nil {
 			k.Logger(ctx).Error("could not unmarshal record", "address", it.Key(), "error", err)
 		} else if handler(record) {
@@ -114,6 +122,35 @@ func (k Keeper) IterateRecords(ctx sdk.Context, scopeID types.MetadataAddress, h
 	return nil
 }
 
+// unmarshalRecord decodes the value stored under the key of a record, reassembling and decompressing its chunks when
+// the record is stored chunked.
+func (k Keeper) unmarshalRecord(store sdk.KVStore, id types.MetadataAddress, value []byte, record *types.Record) error {
+	chunkCount, chunked, err := types.ParseChunkedRecordHeader(value)
+	if err != nil {
+		return err
+	}
+	if chunked {
+		chunks := make([][]byte, chunkCount)
+		for i := range chunks {
+			if chunks[i] = store.Get(types.GetRecordChunkKey(id, uint32(i))); chunks[i] == nil {
+				return fmt.Errorf("chunk %d of %d of record %s not found", i, chunkCount, id)
+			}
+		}
+		if value, err = types.DecodeRecordPayload(chunks); err != nil {
+			return err
+		}
+	}
+	return k.cdc.Unmarshal(value, record)
+}
+
+// removeRecordChunks deletes the chunks of a record stored compressed and chunked, if any.
+func (k Keeper) removeRecordChunks(store sdk.KVStore, id types.MetadataAddress) {
+	chunkCount, _, _ := types.ParseChunkedRecordHeader(store.Get(id))
+	for i := uint32(0); i < chunkCount; i++ {
+		store.Delete(types.GetRecordChunkKey(id, i))
+	}
+}
+
 // ValidateRecordUpdate checks the current record and the proposed record to determine if the the proposed changes are valid
 // based on the existing state
 // Note: The proposed parameter is a reference here so that the SpecificationId can be set in cases when it's not provided.
@@ -159,7 +196,8 @@ func (k Keeper) ValidateRecordUpdate(
 	}
 
 	// Make sure the scope exists.
-	if _, found := k.GetScope(ctx, scopeID); !found {
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
 		return fmt.Errorf("scope not found with id %s", scopeID)
 	}
 
@@ -194,6 +232,22 @@ func (k Keeper) ValidateRecordUpdate(
 			recSpecID, contractSpecUUID, proposed.Name)
 	}
 
+	// When the scope specification is strict, every failed requirement below is collected and reported together,
+	// and non-conforming inputs are rejected regardless of the record specification's input validation level.
+	scopeSpec, _ := k.GetScopeSpecification(ctx, scope.SpecificationId)
+	violations := specViolations{strict: k.IsStrictScopeSpecification(ctx, scopeSpec)}
+	if violations.strict {
+		if err := k.ValidatePartiesInvolved(session.Parties, recSpec.ResponsibleParties); err != nil {
+			_ = violations.add(fmt.Errorf("record specification %s: %w", recSpecID, err))
+		}
+	}
+	nonConforming := func(inputName string, err error) error {
+		if violations.strict {
+			return violations.add(err)
+		}
+		return k.checkInputConformance(ctx, recSpec, scopeID, proposed.Name, inputName, err)
+	}
+
 	// Make sure all input specs are present as inputs.
 	inputNames := make([]string, len(proposed.Inputs))
 	inputMap := make(map[string]types.RecordInput)
@@ -212,22 +266,34 @@ func (k Keeper) ValidateRecordUpdate(
 	}
 	missingInputNames := FindMissing(inputSpecNames, inputNames)
 	if len(missingInputNames) > 0 {
-		return fmt.Errorf("missing input%s %v", pluralEnding(len(missingInputNames)), missingInputNames)
+		if err := violations.add(fmt.Errorf("missing input%s %v", pluralEnding(len(missingInputNames)), missingInputNames)); err != nil {
+			return err
+		}
 	}
 	extraInputNames := FindMissing(inputNames, inputSpecNames)
 	if len(extraInputNames) > 0 {
-		return fmt.Errorf("extra input%s %v", pluralEnding(len(extraInputNames)), extraInputNames)
+		if err := violations.add(fmt.Errorf("extra input%s %v", pluralEnding(len(extraInputNames)), extraInputNames)); err != nil {
+			return err
+		}
 	}
 
 	// Make sure all the inputs conform to their spec.
+	validateCrossScopeInputs := k.GetValidateCrossScopeRecordInputs(ctx)
 	for _, name := range inputNames {
 		input := inputMap[name]
-		inputSpec := inputSpecMap[name]
+		inputSpec, hasSpec := inputSpecMap[name]
+		if !hasSpec {
+			// Already reported as an extra input.
+			continue
+		}
 
 		// Make sure the input TypeName is correct.
 		if inputSpec.TypeName != input.TypeName {
-			return fmt.Errorf("input %s has TypeName %s but spec calls for %s",
+			err := fmt.Errorf("input %s has TypeName %s but spec calls for %s",
 				input.Name, input.TypeName, inputSpec.TypeName)
+			if err = nonConforming(input.Name, err); err != nil {
+				return err
+			}
 		}
 
 		// Get the input specification source type and value
@@ -249,9 +315,15 @@ func (k Keeper) ValidateRecordUpdate(
 		inputSourceValue := ""
 		switch source := input.Source.(type) {
 		case *types.RecordInput_RecordId:
-			if _, found := k.GetRecord(ctx, source.RecordId); !found {
+			inputRecord, found := k.GetRecord(ctx, source.RecordId)
+			if !found {
 				return fmt.Errorf("input %s source record id %s not found", input.Name, source.RecordId)
 			}
+			if validateCrossScopeInputs {
+				if err := validateCrossScopeRecordInput(input, source.RecordId, inputRecord, scopeID); err != nil {
+					return err
+				}
+			}
 			inputSourceType = sourceTypeRecord
 			inputSourceValue = source.RecordId.String()
 		case *types.RecordInput_Hash:
@@ -263,12 +335,17 @@ func (k Keeper) ValidateRecordUpdate(
 
 		// Make sure the input spec source type and value match the input source type and value
 		if inputSourceType != inputSpecSourceType {
-			return fmt.Errorf("input %s has source type %s but spec calls for %s",
+			err := fmt.Errorf("input %s has source type %s but spec calls for %s",
 				input.Name, inputSourceType, inputSpecSourceType)
-		}
-		if inputSourceType == sourceTypeRecord && inputSourceValue != inputSpecSourceValue {
-			return fmt.Errorf("input %s has source value %s but spec calls for %s",
+			if err = nonConforming(input.Name, err); err != nil {
+				return err
+			}
+		} else if inputSourceType == sourceTypeRecord && inputSourceValue != inputSpecSourceValue {
+			err := fmt.Errorf("input %s has source value %s but spec calls for %s",
 				input.Name, inputSourceValue, inputSpecSourceValue)
+			if err = nonConforming(input.Name, err); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -276,17 +353,81 @@ func (k Keeper) ValidateRecordUpdate(
 	switch recSpec.ResultType {
 	case types.DefinitionType_DEFINITION_TYPE_RECORD:
 		if len(proposed.Outputs) != 1 {
-			return fmt.Errorf("invalid output count (expected: 1, got: %d)", len(proposed.Outputs))
+			if err := violations.add(fmt.Errorf("invalid output count (expected: 1, got: %d)", len(proposed.Outputs))); err != nil {
+				return err
+			}
 		}
 	case types.DefinitionType_DEFINITION_TYPE_RECORD_LIST:
 		if len(proposed.Outputs) == 0 {
-			return fmt.Errorf("invalid output count (expected > 0, got: 0)")
+			if err := violations.add(fmt.Errorf("invalid output count (expected > 0, got: 0)")); err != nil {
+				return err
+			}
 		}
 	}
 	// case types.DefinitionType_DEFINITION_TYPE_PROPOSED: ignored
 	// case types.DefinitionType_DEFINITION_TYPE_UNSPECIFIED: ignored
 
-	return nil
+	return violations.err(fmt.Sprintf("record %s", proposed.Name), scopeSpec.SpecificationId)
+}
+
+// checkInputConformance applies the input validation level of a record specification to an input that does not
+// conform to its input specification.  The error is returned when the level is strict (or unspecified).  At the warn
+// level an EventRecordInputValidationWarning is emitted instead, and at the off level the input is accepted as is.
+func (k Keeper) checkInputConformance(
+	ctx sdk.Context,
+	recSpec types.RecordSpecification,
+	scopeID types.MetadataAddress,
+	recordName string,
+	inputName string,
+	err error,
+) error {
+	switch recSpec.InputValidation {
+	case types.InputValidationLevel_INPUT_VALIDATION_LEVEL_OFF:
+		return nil
+	case types.InputValidationLevel_INPUT_VALIDATION_LEVEL_WARN:
+		recordID, addrErr := scopeID.AsRecordAddress(recordName)
+		if addrErr != nil {
+			return addrErr
+		}
+		k.Logger(ctx).Info("accepting non-conforming record input", "record", recordID, "input", inputName, "reason", err)
+		return ctx.EventManager().EmitTypedEvent(
+			types.NewEventRecordInputValidationWarning(recordID, recSpec.SpecificationId, inputName, err.Error()))
+	default:
+		return err
+	}
+}
+
+// validateCrossScopeRecordInput makes sure that an input referencing a record in a scope other than the given scope
+// provides a record hash matching one of the outputs of the referenced record.
+func validateCrossScopeRecordInput(
+	input types.RecordInput,
+	inputRecordID types.MetadataAddress,
+	inputRecord types.Record,
+	scopeID types.MetadataAddress,
+) error {
+	inputScopeID, err := inputRecordID.AsScopeAddress()
+	if err != nil {
+		return fmt.Errorf("input %s source record id %s: %w", input.Name, inputRecordID, err)
+	}
+	if inputScopeID.Equals(scopeID) {
+		return nil
+	}
+	if len(input.RecordHash) == 0 {
+		return fmt.Errorf("input %s source record id %s in scope %s is missing required record hash",
+			input.Name, inputRecordID, inputScopeID)
+	}
+	for _, output := range inputRecord.Outputs {
+		if output.Hash == input.RecordHash && hashAlgorithmsMatch(output.HashAlgorithm, input.HashAlgorithm) {
+			return nil
+		}
+	}
+	return fmt.Errorf("input %s record hash %s does not match any output of source record id %s in scope %s",
+		input.Name, input.RecordHash, inputRecordID, inputScopeID)
+}
+
+// hashAlgorithmsMatch returns true if the hash algorithms are the same, or either of them is unspecified.
+func hashAlgorithmsMatch(a, b types.HashAlgorithm) bool {
+	return a == b || a == types.HashAlgorithm_HASH_ALGORITHM_UNSPECIFIED || b == types.HashAlgorithm_HASH_ALGORITHM_UNSPECIFIED
 }
 
 // ValidateRecordRemove checks the current record and the proposed removal scope to determine if the the proposed remove is valid
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	gocontext "context"
 	"fmt"
 	"testing"
 	"time"
@@ -134,6 +135,58 @@ func (s *RecordKeeperTestSuite) TestMetadataRecordIterator() {
 
 }
 
+func (s *RecordKeeperTestSuite) TestMetadataRecordLargePayload() {
+	params := s.app.MetadataKeeper.GetParams(s.ctx)
+	params.LargeRecordThreshold = 1024
+	s.app.MetadataKeeper.SetParams(s.ctx, params)
+
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	outputs := make([]types.RecordOutput, 2000)
+	for i := range outputs {
+		outputs[i] = types.RecordOutput{Hash: uuid.New().String() + uuid.New().String(), Status: types.ResultStatus_RESULT_STATUS_PASS}
+	}
+	record := types.NewRecord(s.recordName, s.sessionID, *process, nil, outputs, s.recordSpecID)
+	s.app.MetadataKeeper.SetRecord(s.ctx, *record)
+
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	chunkCount, chunked, err := types.ParseChunkedRecordHeader(store.Get(s.recordID))
+	s.Require().NoError(err)
+	s.Require().True(chunked, "a record over the threshold is stored chunked")
+	s.Require().Greater(chunkCount, uint32(1), "the compressed record spans multiple chunks")
+	for i := uint32(0); i < chunkCount; i++ {
+		s.LessOrEqual(len(store.Get(types.GetRecordChunkKey(s.recordID, i))), types.RecordChunkSize)
+	}
+
+	r, found := s.app.MetadataKeeper.GetRecord(s.ctx, s.recordID)
+	s.Require().True(found)
+	s.Equal(*record, r, "the record is reassembled when read")
+	records, err := s.app.MetadataKeeper.GetRecords(s.ctx, s.scopeID, "")
+	s.Require().NoError(err)
+	s.Require().Len(records, 1)
+	s.Equal(record, records[0], "the record is reassembled when iterated")
+	res, err := s.queryClient.RecordsAll(gocontext.Background(), &types.RecordsAllRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Records, 1)
+	s.Equal(record, res.Records[0].Record, "the record is reassembled when paged")
+
+	// replacing it with a small record stores it whole and removes the chunks
+	small := types.NewRecord(s.recordName, s.sessionID, *process, nil, outputs[:1], s.recordSpecID)
+	s.app.MetadataKeeper.SetRecord(s.ctx, *small)
+	_, chunked, err = types.ParseChunkedRecordHeader(store.Get(s.recordID))
+	s.Require().NoError(err)
+	s.False(chunked)
+	s.Nil(store.Get(types.GetRecordChunkKey(s.recordID, 0)))
+	r, _ = s.app.MetadataKeeper.GetRecord(s.ctx, s.recordID)
+	s.Equal(*small, r)
+
+	// removing a chunked record removes its chunks
+	s.app.MetadataKeeper.SetRecord(s.ctx, *record)
+	s.NotNil(store.Get(types.GetRecordChunkKey(s.recordID, 0)))
+	s.app.MetadataKeeper.RemoveRecord(s.ctx, s.recordID)
+	s.Nil(store.Get(s.recordID))
+	s.Nil(store.Get(types.GetRecordChunkKey(s.recordID, 0)))
+}
+
 func (s *RecordKeeperTestSuite) TestValidateRecordRemove() {
 	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1)
 	s.app.MetadataKeeper.SetScope(s.ctx, *scope)
@@ -578,3 +631,372 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 		})
 	}
 }
+
+func (s *RecordKeeperTestSuite) TestValidateRecordUpdateCrossScopeInputs() {
+	auditFields := &types.AuditFields{CreatedBy: s.user1}
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+
+	// The source record lives in another scope.
+	otherScopeUUID := uuid.New()
+	otherScopeID := types.ScopeMetadataAddress(otherScopeUUID)
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(otherScopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1))
+	otherSessionID := types.SessionMetadataAddress(otherScopeUUID, uuid.New())
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession(s.sessionName, otherSessionID, s.contractSpecID, ownerPartyList(s.user1), auditFields))
+	sha256Output := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	sourceRecord := types.NewRecord("SourceRecord", otherSessionID, *process, []types.RecordInput{},
+		[]types.RecordOutput{
+			{Hash: "sourceoutput", Status: types.ResultStatus_RESULT_STATUS_PASS},
+			{Hash: sha256Output, Status: types.ResultStatus_RESULT_STATUS_PASS, HashAlgorithm: types.HashAlgorithm_HASH_ALGORITHM_SHA256},
+		}, nil)
+	s.app.MetadataKeeper.SetRecord(s.ctx, *sourceRecord)
+	sourceRecordID := types.RecordMetadataAddress(otherScopeUUID, sourceRecord.Name)
+
+	// A record in the same scope as the record being written.
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1))
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession(s.sessionName, sessionID, s.contractSpecID, ownerPartyList(s.user1), auditFields))
+	localRecord := types.NewRecord("LocalRecord", sessionID, *process, []types.RecordInput{},
+		[]types.RecordOutput{{Hash: "localoutput", Status: types.ResultStatus_RESULT_STATUS_PASS}}, nil)
+	s.app.MetadataKeeper.SetRecord(s.ctx, *localRecord)
+	localRecordID := types.RecordMetadataAddress(scopeUUID, localRecord.Name)
+
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, types.ContractSpecification{
+		SpecificationId: s.contractSpecID,
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ClassName:       "classname",
+	})
+	newRecordSpec := func(name string, sourceID types.MetadataAddress) {
+		recSpec := types.NewRecordSpecification(
+			types.RecordSpecMetadataAddress(s.contractSpecUUID, name),
+			name,
+			[]*types.InputSpecification{
+				types.NewInputSpecification("SourceInput", "SourceInputType", types.NewInputSpecificationSourceRecordID(sourceID)),
+			},
+			"TestRecordTypeName",
+			types.DefinitionType_DEFINITION_TYPE_RECORD,
+			[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		)
+		s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *recSpec)
+	}
+	newRecordSpec("CrossScopeRecord", sourceRecordID)
+	newRecordSpec("SameScopeRecord", localRecordID)
+
+	newRecord := func(name string, sourceID types.MetadataAddress, recordHash string) *types.Record {
+		input := types.NewRecordInput("SourceInput", &types.RecordInput_RecordId{RecordId: sourceID}, "SourceInputType", types.RecordInputStatus_Record)
+		input.RecordHash = recordHash
+		return types.NewRecord(name, sessionID, *process, []types.RecordInput{*input},
+			[]types.RecordOutput{{Hash: "newoutput", Status: types.ResultStatus_RESULT_STATUS_PASS}}, nil)
+	}
+	newRecordWithHashAlgorithm := func(recordHash string, algorithm types.HashAlgorithm) *types.Record {
+		record := newRecord("CrossScopeRecord", sourceRecordID, recordHash)
+		record.Inputs[0].HashAlgorithm = algorithm
+		return record
+	}
+
+	cases := []struct {
+		name     string
+		enabled  bool
+		proposed *types.Record
+		errorMsg string
+	}{
+		{
+			name:     "disabled - missing record hash allowed",
+			enabled:  false,
+			proposed: newRecord("CrossScopeRecord", sourceRecordID, ""),
+		},
+		{
+			name:     "disabled - mismatched record hash allowed",
+			enabled:  false,
+			proposed: newRecord("CrossScopeRecord", sourceRecordID, "nope"),
+		},
+		{
+			name:     "enabled - same scope input does not need record hash",
+			enabled:  true,
+			proposed: newRecord("SameScopeRecord", localRecordID, ""),
+		},
+		{
+			name:     "enabled - cross scope input missing record hash",
+			enabled:  true,
+			proposed: newRecord("CrossScopeRecord", sourceRecordID, ""),
+			errorMsg: fmt.Sprintf("input SourceInput source record id %s in scope %s is missing required record hash",
+				sourceRecordID, otherScopeID),
+		},
+		{
+			name:     "enabled - cross scope input record hash mismatch",
+			enabled:  true,
+			proposed: newRecord("CrossScopeRecord", sourceRecordID, "nope"),
+			errorMsg: fmt.Sprintf("input SourceInput record hash nope does not match any output of source record id %s in scope %s",
+				sourceRecordID, otherScopeID),
+		},
+		{
+			name:     "enabled - cross scope input record hash matches",
+			enabled:  true,
+			proposed: newRecord("CrossScopeRecord", sourceRecordID, "sourceoutput"),
+		},
+		{
+			name:     "enabled - cross scope input record hash and algorithm match",
+			enabled:  true,
+			proposed: newRecordWithHashAlgorithm(sha256Output, types.HashAlgorithm_HASH_ALGORITHM_SHA256),
+		},
+		{
+			name:     "enabled - cross scope input record hash with unspecified algorithm matches",
+			enabled:  true,
+			proposed: newRecordWithHashAlgorithm(sha256Output, types.HashAlgorithm_HASH_ALGORITHM_UNSPECIFIED),
+		},
+		{
+			name:     "enabled - cross scope input record hash algorithm mismatch",
+			enabled:  true,
+			proposed: newRecordWithHashAlgorithm(sha256Output, types.HashAlgorithm_HASH_ALGORITHM_BLAKE2B_256),
+			errorMsg: fmt.Sprintf("input SourceInput record hash %s does not match any output of source record id %s in scope %s",
+				sha256Output, sourceRecordID, otherScopeID),
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(tc.enabled, types.DefaultMaxScopeHistoryEntries, types.DefaultStrictScopeSpecifications, types.DefaultLargeRecordThreshold))
+			err := s.app.MetadataKeeper.ValidateRecordUpdate(s.ctx, nil, tc.proposed, []string{s.user1}, ownerPartyList(s.user1))
+			if len(tc.errorMsg) != 0 {
+				assert.EqualError(t, err, tc.errorMsg, "ValidateRecordUpdate expected error")
+			} else {
+				assert.NoError(t, err, "ValidateRecordUpdate unexpected error")
+			}
+		})
+	}
+}
+
+func (s *RecordKeeperTestSuite) TestValidateRecordUpdateInputValidationLevels() {
+	auditFields := &types.AuditFields{CreatedBy: s.user1}
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1))
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession(s.sessionName, sessionID, s.contractSpecID, ownerPartyList(s.user1), auditFields))
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, types.ContractSpecification{
+		SpecificationId: s.contractSpecID,
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ClassName:       "classname",
+	})
+
+	recordName := "LeveledRecord"
+	recSpecID := types.RecordSpecMetadataAddress(s.contractSpecUUID, recordName)
+	recordID := types.RecordMetadataAddress(scopeUUID, recordName)
+	setLevel := func(level types.InputValidationLevel) {
+		recSpec := types.NewRecordSpecification(
+			recSpecID,
+			recordName,
+			[]*types.InputSpecification{
+				types.NewInputSpecification("HashInput", "HashInputType", types.NewInputSpecificationSourceHash("inputhash")),
+			},
+			"TestRecordTypeName",
+			types.DefinitionType_DEFINITION_TYPE_RECORD,
+			[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		)
+		recSpec.InputValidation = level
+		s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *recSpec)
+	}
+	newRecord := func(typeName string) *types.Record {
+		input := types.NewRecordInput("HashInput", &types.RecordInput_Hash{Hash: "inputhash"}, typeName, types.RecordInputStatus_Proposed)
+		return types.NewRecord(recordName, sessionID, *process, []types.RecordInput{*input},
+			[]types.RecordOutput{{Hash: "newoutput", Status: types.ResultStatus_RESULT_STATUS_PASS}}, nil)
+	}
+	typeNameErr := "input HashInput has TypeName WrongType but spec calls for HashInputType"
+
+	cases := []struct {
+		name     string
+		level    types.InputValidationLevel
+		typeName string
+		errorMsg string
+		warning  bool
+	}{
+		{
+			name:     "unspecified - conforming input accepted",
+			level:    types.InputValidationLevel_INPUT_VALIDATION_LEVEL_UNSPECIFIED,
+			typeName: "HashInputType",
+		},
+		{
+			name:     "unspecified - non-conforming input rejected",
+			level:    types.InputValidationLevel_INPUT_VALIDATION_LEVEL_UNSPECIFIED,
+			typeName: "WrongType",
+			errorMsg: typeNameErr,
+		},
+		{
+			name:     "strict - non-conforming input rejected",
+			level:    types.InputValidationLevel_INPUT_VALIDATION_LEVEL_STRICT,
+			typeName: "WrongType",
+			errorMsg: typeNameErr,
+		},
+		{
+			name:     "warn - conforming input accepted without warning",
+			level:    types.InputValidationLevel_INPUT_VALIDATION_LEVEL_WARN,
+			typeName: "HashInputType",
+		},
+		{
+			name:     "warn - non-conforming input accepted with warning",
+			level:    types.InputValidationLevel_INPUT_VALIDATION_LEVEL_WARN,
+			typeName: "WrongType",
+			warning:  true,
+		},
+		{
+			name:     "off - non-conforming input accepted",
+			level:    types.InputValidationLevel_INPUT_VALIDATION_LEVEL_OFF,
+			typeName: "WrongType",
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			setLevel(tc.level)
+			ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+			err := s.app.MetadataKeeper.ValidateRecordUpdate(ctx, nil, newRecord(tc.typeName), []string{s.user1}, ownerPartyList(s.user1))
+			if len(tc.errorMsg) != 0 {
+				assert.EqualError(t, err, tc.errorMsg, "ValidateRecordUpdate expected error")
+			} else {
+				assert.NoError(t, err, "ValidateRecordUpdate unexpected error")
+			}
+			events := ctx.EventManager().ABCIEvents()
+			if !tc.warning {
+				assert.Empty(t, events, "ValidateRecordUpdate events")
+				return
+			}
+			if assert.Len(t, events, 1, "ValidateRecordUpdate events") {
+				event, err := sdk.ParseTypedEvent(events[0])
+				assert.NoError(t, err, "ParseTypedEvent")
+				assert.Equal(t, types.NewEventRecordInputValidationWarning(recordID, recSpecID, "HashInput", typeNameErr), event)
+			}
+		})
+	}
+}
+
+func (s *RecordKeeperTestSuite) TestValidateRecordUpdateStrictScopeSpecification() {
+	auditFields := &types.AuditFields{CreatedBy: s.user1}
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1))
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession(s.sessionName, sessionID, s.contractSpecID, ownerPartyList(s.user1), auditFields))
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, types.ContractSpecification{
+		SpecificationId: s.contractSpecID,
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ClassName:       "classname",
+	})
+
+	recordName := "StrictRecord"
+	recSpecID := types.RecordSpecMetadataAddress(s.contractSpecUUID, recordName)
+	setSpecs := func(strict bool, parties []types.PartyType) {
+		scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, []string{s.user1},
+			[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{s.contractSpecID})
+		scopeSpec.Strict = strict
+		s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *scopeSpec)
+		recSpec := types.NewRecordSpecification(
+			recSpecID,
+			recordName,
+			[]*types.InputSpecification{
+				types.NewInputSpecification("HashInput", "HashInputType", types.NewInputSpecificationSourceHash("inputhash")),
+				types.NewInputSpecification("OtherInput", "OtherInputType", types.NewInputSpecificationSourceHash("otherhash")),
+			},
+			"TestRecordTypeName",
+			types.DefinitionType_DEFINITION_TYPE_RECORD,
+			parties,
+		)
+		recSpec.InputValidation = types.InputValidationLevel_INPUT_VALIDATION_LEVEL_WARN
+		s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *recSpec)
+	}
+	newRecord := func(typeName string, withOther bool) *types.Record {
+		inputs := []types.RecordInput{
+			*types.NewRecordInput("HashInput", &types.RecordInput_Hash{Hash: "inputhash"}, typeName, types.RecordInputStatus_Proposed),
+		}
+		if withOther {
+			inputs = append(inputs, *types.NewRecordInput("OtherInput", &types.RecordInput_Hash{Hash: "otherhash"}, "OtherInputType", types.RecordInputStatus_Proposed))
+		}
+		return types.NewRecord(recordName, sessionID, *process, inputs,
+			[]types.RecordOutput{{Hash: "newoutput", Status: types.ResultStatus_RESULT_STATUS_PASS}}, nil)
+	}
+	owner := []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}
+	ownerAndOriginator := []types.PartyType{types.PartyType_PARTY_TYPE_OWNER, types.PartyType_PARTY_TYPE_ORIGINATOR}
+	strictPrefix := fmt.Sprintf("record %s does not satisfy strict scope specification %s: ", recordName, scopeSpecID)
+
+	cases := []struct {
+		name        string
+		specStrict  bool
+		paramStrict bool
+		parties     []types.PartyType
+		typeName    string
+		withOther   bool
+		errorMsg    string
+	}{
+		{
+			name:      "not strict - missing responsible party and non-conforming input accepted",
+			parties:   ownerAndOriginator,
+			typeName:  "WrongType",
+			withOther: true,
+		},
+		{
+			name:     "not strict - missing input rejected",
+			parties:  owner,
+			typeName: "HashInputType",
+			errorMsg: "missing input [OtherInput]",
+		},
+		{
+			name:       "strict spec - conforming record accepted",
+			specStrict: true,
+			parties:    owner,
+			typeName:   "HashInputType",
+			withOther:  true,
+		},
+		{
+			name:       "strict spec - non-conforming input rejected at warn level",
+			specStrict: true,
+			parties:    owner,
+			typeName:   "WrongType",
+			withOther:  true,
+			errorMsg:   strictPrefix + "1 requirement failed: input HashInput has TypeName WrongType but spec calls for HashInputType",
+		},
+		{
+			name:       "strict spec - every failure reported",
+			specStrict: true,
+			parties:    ownerAndOriginator,
+			typeName:   "WrongType",
+			errorMsg: strictPrefix + "3 requirements failed: " +
+				fmt.Sprintf("record specification %s: missing required party type [PARTY_TYPE_ORIGINATOR] from parties; ", recSpecID) +
+				"missing input [OtherInput]; " +
+				"input HashInput has TypeName WrongType but spec calls for HashInputType",
+		},
+		{
+			name:        "strict param - missing responsible party rejected",
+			paramStrict: true,
+			parties:     ownerAndOriginator,
+			typeName:    "HashInputType",
+			withOther:   true,
+			errorMsg: strictPrefix + "1 requirement failed: " +
+				fmt.Sprintf("record specification %s: missing required party type [PARTY_TYPE_ORIGINATOR] from parties", recSpecID),
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			setSpecs(tc.specStrict, tc.parties)
+			params := types.DefaultParams()
+			params.StrictScopeSpecifications = tc.paramStrict
+			s.app.MetadataKeeper.SetParams(s.ctx, params)
+			defer s.app.MetadataKeeper.SetParams(s.ctx, types.DefaultParams())
+
+			err := s.app.MetadataKeeper.ValidateRecordUpdate(s.ctx, nil, newRecord(tc.typeName, tc.withOther), []string{s.user1}, ownerPartyList(s.user1))
+			if len(tc.errorMsg) != 0 {
+				assert.EqualError(t, err, tc.errorMsg, "ValidateRecordUpdate expected error")
+			} else {
+				assert.NoError(t, err, "ValidateRecordUpdate unexpected error")
+			}
+		})
+	}
+}
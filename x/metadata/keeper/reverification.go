@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetRecordReverification returns the re-verification request of a record by a requester.
+func (k Keeper) GetRecordReverification(
+	ctx sdk.Context,
+	recordID types.MetadataAddress,
+	requester sdk.AccAddress,
+) (reverification types.RecordReverification, found bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.GetRecordReverificationKey(recordID, requester))
+	if b == nil {
+		return types.RecordReverification{}, false
+	}
+	k.cdc.MustUnmarshal(b, &reverification)
+	return reverification, true
+}
+
+// SetRecordReverification stores a re-verification request of a record.
+func (k Keeper) SetRecordReverification(ctx sdk.Context, reverification types.RecordReverification) {
+	requester, err := sdk.AccAddressFromBech32(reverification.Requester)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(
+		types.GetRecordReverificationKey(reverification.RecordId, requester),
+		k.cdc.MustMarshal(&reverification),
+	)
+}
+
+// IterateRecordReverifications processes the stored re-verification requests with the given handler.
+// If the id is an empty MetadataAddress, the requests of all records will be processed.
+// If the id is a record id, just the requests of that record will be processed.
+// Otherwise, the requests of all records in the scope of the id will be processed.
+func (k Keeper) IterateRecordReverifications(
+	ctx sdk.Context,
+	id types.MetadataAddress,
+	handler func(types.RecordReverification) (stop bool),
+) error {
+	prefix := types.RecordReverificationKeyPrefix
+	if !id.Empty() {
+		var err error
+		if prefix, err = types.GetRecordReverificationIteratorPrefix(id); err != nil {
+			return err
+		}
+	}
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var reverification types.RecordReverification
+		if err := k.cdc.Unmarshal(it.Value(), &reverification); err != nil {
+			k.Logger(ctx).Error("could not unmarshal record reverification", "address", it.Key(), "error", err)
+		} else if handler(reverification) {
+			break
+		}
+	}
+	return nil
+}
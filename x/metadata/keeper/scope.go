@@ -43,6 +43,39 @@ func (k Keeper) IterateScopesForAddress(ctx sdk.Context, address sdk.AccAddress,
 	return nil
 }
 
+// IterateScopesForParty processes scopes that list the provided address as an owner with the given role.
+func (k Keeper) IterateScopesForParty(ctx sdk.Context, address sdk.AccAddress, role types.PartyType,
+	handler func(scopeID types.MetadataAddress) (stop bool),
+) error {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetPartyRoleScopeCacheIteratorPrefix(address, role)
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var scopeID types.MetadataAddress
+		if err := scopeID.Unmarshal(it.Key()[len(prefix):]); err != nil {
+			return err
+		}
+		if handler(scopeID) {
+			break
+		}
+	}
+	return nil
+}
+
+// indexScopeParties creates the party scope index records for all stored scopes.
+func (k Keeper) indexScopeParties(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	return k.IterateScopes(ctx, func(scope types.Scope) bool {
+		for _, p := range scope.Owners {
+			if addr, err := sdk.AccAddressFromBech32(p.Address); err == nil {
+				store.Set(types.GetPartyScopeCacheKey(addr, p.Role, scope.ScopeId), []byte{0x01})
+			}
+		}
+		return false
+	})
+}
+
 // IterateScopesForScopeSpec processes scopes associated with the provided scope specification id with the given handler.
 func (k Keeper) IterateScopesForScopeSpec(ctx sdk.Context, scopeSpecID types.MetadataAddress,
 	handler func(scopeID types.MetadataAddress) (stop bool),
@@ -84,19 +117,18 @@ func (k Keeper) SetScope(ctx sdk.Context, scope types.Scope) {
 
 	var event proto.Message = types.NewEventScopeCreated(scope.ScopeId)
 	action := types.TLAction_Created
-	if store.Has(scope.ScopeId) {
+	var oldScope *types.Scope
+	if oldScopeBytes := store.Get(scope.ScopeId); oldScopeBytes != nil {
 		event = types.NewEventScopeUpdated(scope.ScopeId)
 		action = types.TLAction_Updated
-		if oldScopeBytes := store.Get(scope.ScopeId); oldScopeBytes != nil {
-			var oldScope types.Scope
-			if err := k.cdc.Unmarshal(oldScopeBytes, &oldScope); err == nil {
-				k.clearScopeIndex(ctx, oldScope)
-			}
+		var existing types.Scope
+		if err := k.cdc.Unmarshal(oldScopeBytes, &existing); err == nil {
+			oldScope = &existing
 		}
 	}
 
 	store.Set(scope.ScopeId, b)
-	k.indexScope(ctx, scope)
+	k.updateScopeIndex(ctx, oldScope, &scope)
 	k.EmitEvent(ctx, event)
 	defer types.GetIncObjFunc(types.TLType_Scope, action)
 }
@@ -127,66 +159,84 @@ func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) {
 
 	// Sessions will be removed as the last record in each is deleted.
 
-	k.clearScopeIndex(ctx, scope)
+	k.updateScopeIndex(ctx, &scope, nil)
 	store.Delete(id)
 	k.EmitEvent(ctx, types.NewEventScopeDeleted(scope.ScopeId))
 	defer types.GetIncObjFunc(types.TLType_Scope, types.TLAction_Deleted)
 }
 
-// clearScopeIndex delete any index records for this scope
-func (k Keeper) clearScopeIndex(ctx sdk.Context, scope types.Scope) {
-	store := ctx.KVStore(k.storeKey)
+// scopeIndexKeys returns the (unique) keys of the index records for the given scope.
+func scopeIndexKeys(scope types.Scope) [][]byte {
+	keys := [][]byte{}
+	seen := map[string]bool{}
+	add := func(key []byte) {
+		if !seen[string(key)] {
+			seen[string(key)] = true
+			keys = append(keys, key)
+		}
+	}
 
-	// add all party addresses to the list of cache records to remove
+	// Index all party addresses on the scope
 	addresses := []string{}
 	for _, p := range scope.Owners {
 		addresses = append(addresses, p.Address)
+		// Index the role of each owner party as well.
+		if addr, err := sdk.AccAddressFromBech32(p.Address); err == nil {
+			add(types.GetPartyScopeCacheKey(addr, p.Role, scope.ScopeId))
+		}
 	}
 	addresses = append(addresses, scope.DataAccess...)
 	if len(scope.ValueOwnerAddress) > 0 {
-		// Add to list of general addresses to clear the cache of
 		addresses = append(addresses, scope.ValueOwnerAddress)
-		// Clear out the value owner cache
+		// create a value owner cache entry as well.
 		addr, err := sdk.AccAddressFromBech32(scope.ValueOwnerAddress)
 		if err == nil {
-			store.Delete(types.GetValueOwnerScopeCacheKey(addr, scope.ScopeId))
+			add(types.GetValueOwnerScopeCacheKey(addr, scope.ScopeId))
 		}
 	}
 	for _, a := range addresses {
 		addr, err := sdk.AccAddressFromBech32(a)
 		if err == nil {
-			store.Delete(types.GetAddressScopeCacheKey(addr, scope.ScopeId))
+			add(types.GetAddressScopeCacheKey(addr, scope.ScopeId))
 		}
 	}
-	store.Delete(types.GetScopeSpecScopeCacheKey(scope.SpecificationId, scope.ScopeId))
+	if len(scope.SpecificationId) > 0 {
+		add(types.GetScopeSpecScopeCacheKey(scope.SpecificationId, scope.ScopeId))
+	}
+	return keys
 }
 
-// indexScope create index records for the given scope
-func (k Keeper) indexScope(ctx sdk.Context, scope types.Scope) {
+// updateScopeIndex changes the index records of a scope from those of the old scope to those of the new scope.  Only
+// the index records that differ are written, so updates that leave the parties, value owner, and specification of a
+// scope alone do not touch the index at all.  A nil oldScope indexes a new scope, and a nil newScope clears the index.
+func (k Keeper) updateScopeIndex(ctx sdk.Context, oldScope, newScope *types.Scope) {
 	store := ctx.KVStore(k.storeKey)
 
-	// Index all party addresses on the scope
-	addresses := []string{}
-	for _, p := range scope.Owners {
-		addresses = append(addresses, p.Address)
+	var oldKeys, newKeys [][]byte
+	if oldScope != nil {
+		oldKeys = scopeIndexKeys(*oldScope)
 	}
-	addresses = append(addresses, scope.DataAccess...)
-	if len(scope.ValueOwnerAddress) > 0 {
-		addresses = append(addresses, scope.ValueOwnerAddress)
-		// create a value owner cache entry as well.
-		addr, err := sdk.AccAddressFromBech32(scope.ValueOwnerAddress)
-		if err == nil {
-			store.Set(types.GetValueOwnerScopeCacheKey(addr, scope.ScopeId), []byte{0x01})
-		}
+	if newScope != nil {
+		newKeys = scopeIndexKeys(*newScope)
 	}
-	for _, a := range addresses {
-		addr, err := sdk.AccAddressFromBech32(a)
-		if err == nil {
-			store.Set(types.GetAddressScopeCacheKey(addr, scope.ScopeId), []byte{0x01})
+	inOld := make(map[string]bool, len(oldKeys))
+	for _, key := range oldKeys {
+		inOld[string(key)] = true
+	}
+	inNew := make(map[string]bool, len(newKeys))
+	for _, key := range newKeys {
+		inNew[string(key)] = true
+	}
+
+	for _, key := range oldKeys {
+		if !inNew[string(key)] {
+			store.Delete(key)
 		}
 	}
-	if len(scope.SpecificationId) > 0 {
-		store.Set(types.GetScopeSpecScopeCacheKey(scope.SpecificationId, scope.ScopeId), []byte{0x01})
+	for _, key := range newKeys {
+		if !inOld[string(key)] {
+			store.Set(key, []byte{0x01})
+		}
 	}
 }
 
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// AddScopeHistory appends an entry for a message that changed a scope (or one of its sessions or records) to the audit
+// trail of the scope.  The oldest entries beyond the configured max scope history entries are pruned.
+func (k Keeper) AddScopeHistory(ctx sdk.Context, scopeID types.MetadataAddress, msg sdk.Msg) {
+	max := uint64(k.GetMaxScopeHistoryEntries(ctx))
+	if max == 0 {
+		return
+	}
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetScopeHistoryIteratorPrefix(scopeID))
+
+	sequence := uint64(1)
+	last := historyStore.ReverseIterator(nil, nil)
+	if last.Valid() {
+		sequence = sdk.BigEndianToUint64(last.Key()) + 1
+	}
+	last.Close()
+
+	entry := types.ScopeHistoryEntry{
+		ScopeId:     scopeID,
+		Sequence:    sequence,
+		BlockHeight: ctx.BlockHeight(),
+		BlockTime:   ctx.BlockTime(),
+		MsgTypeUrl:  sdk.MsgTypeURL(msg),
+	}
+	if txBytes := ctx.TxBytes(); len(txBytes) > 0 {
+		entry.TxHash = fmt.Sprintf("%X", tmhash.Sum(txBytes))
+	}
+	for _, signer := range msg.GetSigners() {
+		entry.Signers = append(entry.Signers, signer.String())
+	}
+	k.SetScopeHistoryEntry(ctx, entry)
+
+	if sequence > max {
+		var pruned [][]byte
+		it := historyStore.Iterator(nil, sdk.Uint64ToBigEndian(sequence-max+1))
+		for ; it.Valid(); it.Next() {
+			pruned = append(pruned, it.Key())
+		}
+		it.Close()
+		for _, key := range pruned {
+			historyStore.Delete(key)
+		}
+	}
+}
+
+// SetScopeHistoryEntry stores an entry of the audit trail of a scope.
+func (k Keeper) SetScopeHistoryEntry(ctx sdk.Context, entry types.ScopeHistoryEntry) {
+	ctx.KVStore(k.storeKey).Set(types.GetScopeHistoryKey(entry.ScopeId, entry.Sequence), k.cdc.MustMarshal(&entry))
+}
+
+// IterateScopeHistory processes the stored audit trail entries, oldest first, with the given handler.
+// If the scopeID is an empty MetadataAddress, the entries of all scopes will be processed.
+// Otherwise, just the entries for the given scopeID will be processed.
+func (k Keeper) IterateScopeHistory(ctx sdk.Context, scopeID types.MetadataAddress, handler func(types.ScopeHistoryEntry) (stop bool)) error {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetScopeHistoryIteratorPrefix(scopeID))
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var entry types.ScopeHistoryEntry
+		if err := k.cdc.Unmarshal(it.Value(), &entry); err != nil {
+			k.Logger(ctx).Error("could not unmarshal scope history entry", "address", it.Key(), "error", err)
+		} else if handler(entry) {
+			break
+		}
+	}
+	return nil
+}
@@ -157,7 +157,21 @@ func (k Keeper) ValidateSessionUpdate(ctx sdk.Context, existing, proposed *types
 		proposed.Name = contractSpec.ClassName
 	}
 
+	// When the scope specification is strict, the parties required by the scope specification must also be present,
+	// and every missing party is reported together.
+	violations := specViolations{strict: k.IsStrictScopeSpecification(ctx, scopeSpec)}
 	if err = k.ValidatePartiesInvolved(proposed.Parties, contractSpec.PartiesInvolved); err != nil {
+		if !violations.strict {
+			return err
+		}
+		_ = violations.add(fmt.Errorf("contract specification %s: %w", contractSpec.SpecificationId, err))
+	}
+	if violations.strict {
+		if err = k.ValidatePartiesInvolved(proposed.Parties, scopeSpec.PartiesInvolved); err != nil {
+			_ = violations.add(fmt.Errorf("scope specification %s: %w", scopeSpec.SpecificationId, err))
+		}
+	}
+	if err = violations.err(fmt.Sprintf("session %s", proposed.SessionId), scopeSpec.SpecificationId); err != nil {
 		return err
 	}
 
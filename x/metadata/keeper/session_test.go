@@ -321,4 +321,77 @@ func (s *SessionKeeperTestSuite) TestMetadataValidateSessionUpdate() {
 	}
 }
 
+func (s *SessionKeeperTestSuite) TestMetadataValidateSessionUpdateStrictScopeSpecification() {
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1)
+	s.app.MetadataKeeper.SetScope(s.ctx, *scope)
+
+	contractSpec := types.NewContractSpecification(s.contractSpecID, nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_AFFILIATE}, &types.ContractSpecification_Hash{Hash: "hash"}, "processname")
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *contractSpec)
+	setScopeSpec := func(strict bool) {
+		scopeSpec := types.NewScopeSpecification(s.scopeSpecID, nil, []string{s.user1},
+			[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{s.contractSpecID})
+		scopeSpec.Strict = strict
+		s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *scopeSpec)
+	}
+
+	affiliate := types.Party{Address: s.user1, Role: types.PartyType_PARTY_TYPE_AFFILIATE}
+	owner := types.Party{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER}
+	custodian := types.Party{Address: s.user1, Role: types.PartyType_PARTY_TYPE_CUSTODIAN}
+	strictPrefix := fmt.Sprintf("session %s does not satisfy strict scope specification %s: ", s.sessionID, s.scopeSpecID)
+
+	cases := map[string]struct {
+		specStrict  bool
+		paramStrict bool
+		parties     []types.Party
+		errorMsg    string
+	}{
+		"not strict - scope spec parties not required": {
+			parties: []types.Party{affiliate},
+		},
+		"not strict - missing contract spec party rejected": {
+			parties:  []types.Party{custodian},
+			errorMsg: "missing required party type [PARTY_TYPE_AFFILIATE] from parties",
+		},
+		"strict spec - all required parties accepted": {
+			specStrict: true,
+			parties:    []types.Party{affiliate, owner},
+		},
+		"strict spec - missing scope spec party rejected": {
+			specStrict: true,
+			parties:    []types.Party{affiliate},
+			errorMsg: strictPrefix + "1 requirement failed: " +
+				fmt.Sprintf("scope specification %s: missing required party type [PARTY_TYPE_OWNER] from parties", s.scopeSpecID),
+		},
+		"strict param - every missing party reported": {
+			paramStrict: true,
+			parties:     []types.Party{custodian},
+			errorMsg: strictPrefix + "2 requirements failed: " +
+				fmt.Sprintf("contract specification %s: missing required party type [PARTY_TYPE_AFFILIATE] from parties; ", s.contractSpecID) +
+				fmt.Sprintf("scope specification %s: missing required party type [PARTY_TYPE_OWNER] from parties", s.scopeSpecID),
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		s.Run(n, func() {
+			setScopeSpec(tc.specStrict)
+			params := types.DefaultParams()
+			params.StrictScopeSpecifications = tc.paramStrict
+			s.app.MetadataKeeper.SetParams(s.ctx, params)
+			defer s.app.MetadataKeeper.SetParams(s.ctx, types.DefaultParams())
+
+			proposed := types.NewSession("processname", s.sessionID, s.contractSpecID, tc.parties, nil)
+			err := s.app.MetadataKeeper.ValidateSessionUpdate(s.ctx, nil, proposed, []string{s.user1})
+			if len(tc.errorMsg) != 0 {
+				s.Error(err)
+				s.Equal(tc.errorMsg, err.Error())
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
 // TODO: ValidateAuditUpdate tests
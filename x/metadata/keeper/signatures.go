@@ -37,7 +37,7 @@ func (k Keeper) IsMarkerAndHasAuthority(ctx sdk.Context, address string, signers
 	for _, signer := range signers {
 		saddr, serr := sdk.AccAddressFromBech32(signer)
 		// If the signer address is okay, check it for the role. If it checks out, they've got auth and we're done.
-		if serr == nil && marker.AddressHasAccess(saddr, role) {
+		if serr == nil && marker.AddressHasAccessAt(saddr, role, ctx.BlockTime()) {
 			return true, true
 		}
 	}
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetSpecDifferences returns the differences between two scope specifications that would break existing scopes if they
+// were moved from the old specification to the new one.
+//
+// Contract specifications are paired by id, or else by class name.  Paired contract specifications with the same id are
+// identical, so only pairs with different ids are compared further.  Record specifications are paired by name.
+func (k Keeper) GetSpecDifferences(ctx sdk.Context, oldSpec, newSpec types.ScopeSpecification) ([]types.SpecDifference, error) {
+	diffs := []types.SpecDifference{}
+	for _, party := range addedPartyTypes(oldSpec.PartiesInvolved, newSpec.PartiesInvolved) {
+		diffs = append(diffs, newSpecDifference(types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_ADDED_PARTY_REQUIREMENT,
+			oldSpec.SpecificationId, "scope specification requires party type %s", party))
+	}
+
+	newContractSpecs := make([]types.ContractSpecification, 0, len(newSpec.ContractSpecIds))
+	for _, id := range newSpec.ContractSpecIds {
+		if contractSpec, found := k.GetContractSpecification(ctx, id); found {
+			newContractSpecs = append(newContractSpecs, contractSpec)
+		}
+	}
+
+	for _, id := range oldSpec.ContractSpecIds {
+		oldContractSpec, found := k.GetContractSpecification(ctx, id)
+		if !found {
+			continue
+		}
+		newContractSpec, paired := findContractSpecCounterpart(oldContractSpec, newContractSpecs)
+		if !paired {
+			diffs = append(diffs, newSpecDifference(types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_REMOVED_CONTRACT_SPEC,
+				id, "contract specification with class name %s is not in the new scope specification", oldContractSpec.ClassName))
+			continue
+		}
+		if oldContractSpec.SpecificationId.Equals(newContractSpec.SpecificationId) {
+			continue
+		}
+		contractDiffs, err := k.getContractSpecDifferences(ctx, oldContractSpec, newContractSpec)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, contractDiffs...)
+	}
+
+	return diffs, nil
+}
+
+// getContractSpecDifferences returns the breaking differences between two paired contract specifications and their
+// record specifications.
+func (k Keeper) getContractSpecDifferences(ctx sdk.Context, oldSpec, newSpec types.ContractSpecification) ([]types.SpecDifference, error) {
+	diffs := []types.SpecDifference{}
+	for _, party := range addedPartyTypes(oldSpec.PartiesInvolved, newSpec.PartiesInvolved) {
+		diffs = append(diffs, newSpecDifference(types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_ADDED_PARTY_REQUIREMENT,
+			oldSpec.SpecificationId, "contract specification %s requires party type %s", newSpec.SpecificationId, party))
+	}
+
+	oldRecSpecs, err := k.GetRecordSpecificationsForContractSpecificationID(ctx, oldSpec.SpecificationId)
+	if err != nil {
+		return nil, err
+	}
+	newRecSpecs, err := k.GetRecordSpecificationsForContractSpecificationID(ctx, newSpec.SpecificationId)
+	if err != nil {
+		return nil, err
+	}
+	newRecSpecMap := make(map[string]*types.RecordSpecification, len(newRecSpecs))
+	for _, recSpec := range newRecSpecs {
+		newRecSpecMap[recSpec.Name] = recSpec
+	}
+
+	for _, oldRecSpec := range oldRecSpecs {
+		newRecSpec, found := newRecSpecMap[oldRecSpec.Name]
+		if !found {
+			diffs = append(diffs, newSpecDifference(types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_REMOVED_RECORD_SPEC,
+				oldRecSpec.SpecificationId, "record specification %s is not in contract specification %s",
+				oldRecSpec.Name, newSpec.SpecificationId))
+			continue
+		}
+		diffs = append(diffs, getRecordSpecDifferences(*oldRecSpec, *newRecSpec)...)
+	}
+
+	return diffs, nil
+}
+
+// getRecordSpecDifferences returns the breaking differences between two paired record specifications.
+func getRecordSpecDifferences(oldSpec, newSpec types.RecordSpecification) []types.SpecDifference {
+	diffs := []types.SpecDifference{}
+	for _, party := range addedPartyTypes(oldSpec.ResponsibleParties, newSpec.ResponsibleParties) {
+		diffs = append(diffs, newSpecDifference(types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_ADDED_PARTY_REQUIREMENT,
+			oldSpec.SpecificationId, "record specification %s requires party type %s", newSpec.SpecificationId, party))
+	}
+	if oldSpec.ResultType != newSpec.ResultType {
+		diffs = append(diffs, newSpecDifference(types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_CHANGED_RESULT_TYPE,
+			oldSpec.SpecificationId, "record specification %s has result type %s instead of %s",
+			newSpec.SpecificationId, newSpec.ResultType, oldSpec.ResultType))
+	}
+
+	oldInputs := make(map[string]*types.InputSpecification, len(oldSpec.Inputs))
+	for _, input := range oldSpec.Inputs {
+		oldInputs[input.Name] = input
+	}
+	newInputs := make(map[string]*types.InputSpecification, len(newSpec.Inputs))
+	for _, input := range newSpec.Inputs {
+		newInputs[input.Name] = input
+	}
+
+	for _, oldInput := range oldSpec.Inputs {
+		newInput, found := newInputs[oldInput.Name]
+		if !found {
+			diffs = append(diffs, newSpecDifference(types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_REMOVED_INPUT,
+				oldSpec.SpecificationId, "record specification %s does not have input %s", newSpec.SpecificationId, oldInput.Name))
+			continue
+		}
+		if oldInput.TypeName != newInput.TypeName {
+			diffs = append(diffs, newSpecDifference(types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_CHANGED_INPUT_TYPE,
+				oldSpec.SpecificationId, "input %s has TypeName %s instead of %s",
+				oldInput.Name, newInput.TypeName, oldInput.TypeName))
+		}
+		if oldSource, newSource := inputSpecSourceString(oldInput), inputSpecSourceString(newInput); oldSource != newSource {
+			diffs = append(diffs, newSpecDifference(types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_CHANGED_INPUT_SOURCE,
+				oldSpec.SpecificationId, "input %s has source %s instead of %s", oldInput.Name, newSource, oldSource))
+		}
+	}
+	for _, newInput := range newSpec.Inputs {
+		if _, found := oldInputs[newInput.Name]; !found {
+			diffs = append(diffs, newSpecDifference(types.SpecDifferenceType_SPEC_DIFFERENCE_TYPE_ADDED_INPUT,
+				oldSpec.SpecificationId, "record specification %s requires input %s", newSpec.SpecificationId, newInput.Name))
+		}
+	}
+
+	return diffs
+}
+
+// findContractSpecCounterpart finds the contract specification with the same id as the given one, or else the first
+// one with the same class name.
+func findContractSpecCounterpart(contractSpec types.ContractSpecification, candidates []types.ContractSpecification) (types.ContractSpecification, bool) {
+	for _, candidate := range candidates {
+		if candidate.SpecificationId.Equals(contractSpec.SpecificationId) {
+			return candidate, true
+		}
+	}
+	for _, candidate := range candidates {
+		if candidate.ClassName == contractSpec.ClassName {
+			return candidate, true
+		}
+	}
+	return types.ContractSpecification{}, false
+}
+
+// addedPartyTypes returns the party types in the new list that are not in the old list.
+func addedPartyTypes(oldParties, newParties []types.PartyType) []string {
+	oldRoles := make([]string, len(oldParties))
+	for i, party := range oldParties {
+		oldRoles[i] = party.String()
+	}
+	newRoles := make([]string, len(newParties))
+	for i, party := range newParties {
+		newRoles[i] = party.String()
+	}
+	return FindMissing(newRoles, oldRoles)
+}
+
+// inputSpecSourceString returns a string describing the source of an input specification.
+func inputSpecSourceString(inputSpec *types.InputSpecification) string {
+	switch source := inputSpec.Source.(type) {
+	case *types.InputSpecification_RecordId:
+		return fmt.Sprintf("%s %s", sourceTypeRecord, source.RecordId)
+	case *types.InputSpecification_Hash:
+		return fmt.Sprintf("%s %s", sourceTypeHash, source.Hash)
+	default:
+		return "unknown"
+	}
+}
+
+// newSpecDifference creates a SpecDifference with a formatted description.
+func newSpecDifference(diffType types.SpecDifferenceType, specID types.MetadataAddress, format string, args ...interface{}) types.SpecDifference {
+	return types.SpecDifference{
+		Type:            diffType,
+		SpecificationId: specID.String(),
+		Description:     fmt.Sprintf(format, args...),
+	}
+}
@@ -393,6 +393,13 @@ func (k Keeper) GetScopeSpecification(ctx sdk.Context, scopeSpecID types.Metadat
 	return spec, true
 }
 
+// IsStrictScopeSpecification returns true if sessions and records in scopes using the given scope specification must
+// strictly satisfy their specifications, either because the scope specification is flagged as strict, or because the
+// strict_scope_specifications param is enabled.
+func (k Keeper) IsStrictScopeSpecification(ctx sdk.Context, scopeSpec types.ScopeSpecification) bool {
+	return scopeSpec.Strict || k.GetStrictScopeSpecifications(ctx)
+}
+
 // SetScopeSpecification stores a scope specification in the module kv store.
 func (k Keeper) SetScopeSpecification(ctx sdk.Context, spec types.ScopeSpecification) {
 	store := ctx.KVStore(k.storeKey)
@@ -511,3 +518,78 @@ func (k Keeper) ValidateScopeSpecUpdate(ctx sdk.Context, existing *types.ScopeSp
 
 	return nil
 }
+
+// UpdateSpecificationOwners adds owners to and removes owners from a scope or contract specification.  All existing
+// owners must be signers, and the specification must keep at least one owner.
+func (k Keeper) UpdateSpecificationOwners(
+	ctx sdk.Context,
+	specID types.MetadataAddress,
+	toAdd []string,
+	toRemove []string,
+	signers []string,
+) error {
+	switch {
+	case specID.IsScopeSpecificationAddress():
+		spec, found := k.GetScopeSpecification(ctx, specID)
+		if !found {
+			return fmt.Errorf("scope specification not found with id %s", specID)
+		}
+		if err := k.ValidateAllOwnersAreSigners(spec.OwnerAddresses, signers); err != nil {
+			return err
+		}
+		owners, err := updateSpecOwners(spec.OwnerAddresses, toAdd, toRemove)
+		if err != nil {
+			return err
+		}
+		spec.OwnerAddresses = owners
+		if err = spec.ValidateBasic(); err != nil {
+			return err
+		}
+		k.SetScopeSpecification(ctx, spec)
+	case specID.IsContractSpecificationAddress():
+		spec, found := k.GetContractSpecification(ctx, specID)
+		if !found {
+			return fmt.Errorf("contract specification not found with id %s", specID)
+		}
+		if err := k.ValidateAllOwnersAreSigners(spec.OwnerAddresses, signers); err != nil {
+			return err
+		}
+		owners, err := updateSpecOwners(spec.OwnerAddresses, toAdd, toRemove)
+		if err != nil {
+			return err
+		}
+		spec.OwnerAddresses = owners
+		if err = spec.ValidateBasic(); err != nil {
+			return err
+		}
+		k.SetContractSpecification(ctx, spec)
+	default:
+		return fmt.Errorf("address is not a scope or contract specification id: %s", specID)
+	}
+	return nil
+}
+
+// updateSpecOwners returns a new list of specification owners with the toAdd addresses appended and the toRemove
+// addresses removed.  Added addresses must not already be owners, removed addresses must be owners, and at least one
+// owner must remain.
+func updateSpecOwners(owners []string, toAdd []string, toRemove []string) ([]string, error) {
+	for _, addr := range toAdd {
+		if len(FindMissing([]string{addr}, owners)) == 0 {
+			return nil, fmt.Errorf("address %s is already an owner", addr)
+		}
+	}
+	if missing := FindMissing(toRemove, owners); len(missing) > 0 {
+		return nil, fmt.Errorf("address %s is not an owner", missing[0])
+	}
+	updated := make([]string, 0, len(owners)+len(toAdd))
+	for _, owner := range owners {
+		if len(FindMissing([]string{owner}, toRemove)) > 0 {
+			updated = append(updated, owner)
+		}
+	}
+	updated = append(updated, toAdd...)
+	if len(updated) == 0 {
+		return nil, fmt.Errorf("a specification must have at least one owner")
+	}
+	return updated, nil
+}
@@ -2,34 +2,34 @@ package types
 
 import (
 	"bytes"
-	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/google/uuid"
 	"gopkg.in/yaml.v2"
+
+	"github.com/provenance-io/provenance/x/metadata/types/address"
 )
 
 const (
 	// PrefixScope is the address human readable prefix used with bech32 encoding of Scope IDs
-	PrefixScope = "scope"
+	PrefixScope = address.PrefixScope
 	// PrefixSession is the address human readable prefix used with bech32 encoding of Session IDs
-	PrefixSession = "session"
+	PrefixSession = address.PrefixSession
 	// PrefixRecord is the address human readable prefix used with bech32 encoding of Record IDs
-	PrefixRecord = "record"
+	PrefixRecord = address.PrefixRecord
 
 	// PrefixScopeSpecification is the address human readable prefix used with bech32 encoding of ScopeSpecification IDs
-	PrefixScopeSpecification = "scopespec"
+	PrefixScopeSpecification = address.PrefixScopeSpecification
 	// PrefixContractSpecification is the address human readable prefix used with bech32 encoding of ContractSpecification IDs
-	PrefixContractSpecification = "contractspec"
+	PrefixContractSpecification = address.PrefixContractSpecification
 	// PrefixRecordSpecification is the address human readable prefix used with bech32 encoding of RecordSpecification IDs
-	PrefixRecordSpecification = "recspec"
+	PrefixRecordSpecification = address.PrefixRecordSpecification
 )
 
 var (
@@ -43,50 +43,7 @@ type MetadataAddress []byte
 // VerifyMetadataAddressFormat checks a sequence of bytes for proper format as a MetadataAddress instance
 // returns the associated bech32 hrp/type name or any errors encountered during verification
 func VerifyMetadataAddressFormat(bz []byte) (string, error) {
-	hrp := ""
-	if len(bz) == 0 {
-		return hrp, errors.New("address is empty")
-	}
-	var requiredLength int
-	checkSecondaryUUID := false
-	switch bz[0] {
-	case ScopeKeyPrefix[0]:
-		hrp = PrefixScope
-		requiredLength = 1 + 16 // type byte plus size of one uuid
-	case SessionKeyPrefix[0]:
-		hrp = PrefixSession
-		requiredLength = 1 + 16 + 16 // type byte plus size of two uuids
-		checkSecondaryUUID = true
-	case RecordKeyPrefix[0]:
-		hrp = PrefixRecord
-		requiredLength = 1 + 16 + 16 // type byte plus size of one uuid and one half sha256 hash
-
-	case ScopeSpecificationKeyPrefix[0]:
-		hrp = PrefixScopeSpecification
-		requiredLength = 1 + 16 // type byte plus size of one uuid
-	case ContractSpecificationKeyPrefix[0]:
-		hrp = PrefixContractSpecification
-		requiredLength = 1 + 16 // type byte plus size of one uuid
-	case RecordSpecificationKeyPrefix[0]:
-		hrp = PrefixRecordSpecification
-		requiredLength = 1 + 16 + 16 // type byte plus size of one uuid plus one-half sha256 hash
-
-	default:
-		return hrp, fmt.Errorf("invalid metadata address type: %d", bz[0])
-	}
-	if len(bz) != requiredLength {
-		return hrp, fmt.Errorf("incorrect address length (expected: %d, actual: %d)", requiredLength, len(bz))
-	}
-	// all valid metadata address have at least one uuid
-	if _, err := uuid.FromBytes(bz[1:17]); err != nil {
-		return hrp, fmt.Errorf("invalid address bytes of uuid, expected uuid compliant: %w", err)
-	}
-	if checkSecondaryUUID {
-		if _, err := uuid.FromBytes(bz[17:33]); err != nil {
-			return hrp, fmt.Errorf("invalid address bytes of secondary uuid, expected uuid compliant: %w", err)
-		}
-	}
-	return hrp, nil
+	return address.VerifyFormat(bz)
 }
 
 // ConvertHashToAddress constructs a MetadataAddress using the provided type code and the raw bytes of the
@@ -140,95 +97,47 @@ func MetadataAddressFromHex(address string) (MetadataAddress, error) {
 
 // MetadataAddressFromBech32 creates a MetadataAddress from a Bech32 string.  The encoded data is checked against the
 // provided bech32 hrp along with an overall verification of the byte format.
-func MetadataAddressFromBech32(address string) (addr MetadataAddress, err error) {
-	if len(strings.TrimSpace(address)) == 0 {
-		return MetadataAddress{}, errors.New("empty address string is not allowed")
-	}
-
-	hrp, bz, err := bech32.DecodeAndConvert(address)
-	if err != nil {
-		return nil, err
-	}
-	expectedHrp, err := VerifyMetadataAddressFormat(bz)
-	if err != nil {
-		return nil, err
-	}
-	if expectedHrp != hrp {
-		return MetadataAddress{}, fmt.Errorf("invalid bech32 prefix; expected %s, got %s", expectedHrp, hrp)
-	}
-
-	return MetadataAddress(bz), nil
+func MetadataAddressFromBech32(bech string) (MetadataAddress, error) {
+	addr, err := address.FromBech32(bech)
+	return MetadataAddress(addr), err
 }
 
 // ScopeMetadataAddress creates a MetadataAddress instance for the given scope by its uuid
 func ScopeMetadataAddress(scopeUUID uuid.UUID) MetadataAddress {
-	bz, err := scopeUUID.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	return append(ScopeKeyPrefix, bz...)
+	return MetadataAddress(address.Scope(scopeUUID))
 }
 
 // SessionMetadataAddress creates a MetadataAddress instance for a session within a scope by uuids
 func SessionMetadataAddress(scopeUUID uuid.UUID, sessionUUID uuid.UUID) MetadataAddress {
-	bz, err := scopeUUID.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	addr := append(SessionKeyPrefix, bz...)
-	bz, err = sessionUUID.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	return append(addr, bz...)
+	return MetadataAddress(address.Session(scopeUUID, sessionUUID))
 }
 
 // RecordMetadataAddress creates a MetadataAddress instance for a record within a scope by scope uuid/record name
 func RecordMetadataAddress(scopeUUID uuid.UUID, name string) MetadataAddress {
-	bz, err := scopeUUID.MarshalBinary()
+	addr, err := address.Record(scopeUUID, name)
 	if err != nil {
-		panic(err)
+		panic(err.Error())
 	}
-	addr := append(RecordKeyPrefix, bz...)
-	name = strings.ToLower(strings.TrimSpace(name))
-	if len(name) < 1 {
-		panic("missing name value for record metadata address")
-	}
-	nameBytes := sha256.Sum256([]byte(name))
-	return append(addr, nameBytes[0:16]...)
+	return MetadataAddress(addr)
 }
 
 // ScopeSpecMetadataAddress creates a MetadataAddress instance for a scope specification
 func ScopeSpecMetadataAddress(specUUID uuid.UUID) MetadataAddress {
-	bz, err := specUUID.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	return append(ScopeSpecificationKeyPrefix, bz...)
+	return MetadataAddress(address.ScopeSpec(specUUID))
 }
 
 // ContractSpecMetadataAddress creates a MetadataAddress instance for a contract specification
 func ContractSpecMetadataAddress(specUUID uuid.UUID) MetadataAddress {
-	bz, err := specUUID.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	return append(ContractSpecificationKeyPrefix, bz...)
+	return MetadataAddress(address.ContractSpec(specUUID))
 }
 
 // RecordSpecMetadataAddress creates a MetadataAddress instance for a record specification
 func RecordSpecMetadataAddress(contractSpecUUID uuid.UUID, name string) MetadataAddress {
-	bz, err := contractSpecUUID.MarshalBinary()
+	addr, err := address.RecordSpec(contractSpecUUID, name)
 	if err != nil {
-		panic(err)
-	}
-	addr := append(RecordSpecificationKeyPrefix, bz...)
-	name = strings.ToLower(strings.TrimSpace(name))
-	if len(name) < 1 {
-		panic("missing name value for record spec metadata address")
+		panic(err.Error())
 	}
-	nameBytes := sha256.Sum256([]byte(name))
-	return append(addr, nameBytes[0:16]...)
+	return MetadataAddress(addr)
 }
 
 // Equals determines if the current MetadataAddress is equal to another sdk.Address
@@ -0,0 +1,316 @@
+// Package address encodes and decodes metadata addresses, the ids of scopes, sessions, records, and their
+// specifications.  It only depends on the uuid and bech32 libraries so client tooling can create and parse metadata
+// addresses without importing the provenance app.
+package address
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/google/uuid"
+)
+
+const (
+	// TypeScope is the type byte of scope addresses
+	TypeScope = byte(0x00)
+	// TypeSession is the type byte of session addresses
+	TypeSession = byte(0x01)
+	// TypeRecord is the type byte of record addresses
+	TypeRecord = byte(0x02)
+	// TypeContractSpecification is the type byte of contract specification addresses
+	TypeContractSpecification = byte(0x03)
+	// TypeScopeSpecification is the type byte of scope specification addresses
+	TypeScopeSpecification = byte(0x04)
+	// TypeRecordSpecification is the type byte of record specification addresses
+	TypeRecordSpecification = byte(0x05)
+)
+
+const (
+	// PrefixScope is the address human readable prefix used with bech32 encoding of Scope IDs
+	PrefixScope = "scope"
+	// PrefixSession is the address human readable prefix used with bech32 encoding of Session IDs
+	PrefixSession = "session"
+	// PrefixRecord is the address human readable prefix used with bech32 encoding of Record IDs
+	PrefixRecord = "record"
+
+	// PrefixScopeSpecification is the address human readable prefix used with bech32 encoding of ScopeSpecification IDs
+	PrefixScopeSpecification = "scopespec"
+	// PrefixContractSpecification is the address human readable prefix used with bech32 encoding of ContractSpecification IDs
+	PrefixContractSpecification = "contractspec"
+	// PrefixRecordSpecification is the address human readable prefix used with bech32 encoding of RecordSpecification IDs
+	PrefixRecordSpecification = "recspec"
+)
+
+// Address is a metadata address: a type byte followed by a uuid, and for some types a second uuid or a name hash.
+type Address []byte
+
+// VerifyFormat checks a sequence of bytes for proper format as a metadata address and returns the associated bech32
+// hrp/type name or any errors encountered during verification.
+func VerifyFormat(bz []byte) (string, error) {
+	hrp := ""
+	if len(bz) == 0 {
+		return hrp, errors.New("address is empty")
+	}
+	var requiredLength int
+	checkSecondaryUUID := false
+	switch bz[0] {
+	case TypeScope:
+		hrp = PrefixScope
+		requiredLength = 1 + 16 // type byte plus size of one uuid
+	case TypeSession:
+		hrp = PrefixSession
+		requiredLength = 1 + 16 + 16 // type byte plus size of two uuids
+		checkSecondaryUUID = true
+	case TypeRecord:
+		hrp = PrefixRecord
+		requiredLength = 1 + 16 + 16 // type byte plus size of one uuid and one half sha256 hash
+
+	case TypeScopeSpecification:
+		hrp = PrefixScopeSpecification
+		requiredLength = 1 + 16 // type byte plus size of one uuid
+	case TypeContractSpecification:
+		hrp = PrefixContractSpecification
+		requiredLength = 1 + 16 // type byte plus size of one uuid
+	case TypeRecordSpecification:
+		hrp = PrefixRecordSpecification
+		requiredLength = 1 + 16 + 16 // type byte plus size of one uuid plus one-half sha256 hash
+
+	default:
+		return hrp, fmt.Errorf("invalid metadata address type: %d", bz[0])
+	}
+	if len(bz) != requiredLength {
+		return hrp, fmt.Errorf("incorrect address length (expected: %d, actual: %d)", requiredLength, len(bz))
+	}
+	// all valid metadata address have at least one uuid
+	if _, err := uuid.FromBytes(bz[1:17]); err != nil {
+		return hrp, fmt.Errorf("invalid address bytes of uuid, expected uuid compliant: %w", err)
+	}
+	if checkSecondaryUUID {
+		if _, err := uuid.FromBytes(bz[17:33]); err != nil {
+			return hrp, fmt.Errorf("invalid address bytes of secondary uuid, expected uuid compliant: %w", err)
+		}
+	}
+	return hrp, nil
+}
+
+// FromBech32 creates an Address from a bech32 string.  The encoded data is checked against the bech32 hrp along with
+// an overall verification of the byte format.
+func FromBech32(address string) (Address, error) {
+	if len(strings.TrimSpace(address)) == 0 {
+		return Address{}, errors.New("empty address string is not allowed")
+	}
+
+	hrp, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, err
+	}
+	expectedHrp, err := VerifyFormat(bz)
+	if err != nil {
+		return nil, err
+	}
+	if expectedHrp != hrp {
+		return Address{}, fmt.Errorf("invalid bech32 prefix; expected %s, got %s", expectedHrp, hrp)
+	}
+
+	return Address(bz), nil
+}
+
+// Scope returns the address of the scope with the given uuid
+func Scope(scopeUUID uuid.UUID) Address {
+	return withUUIDs(TypeScope, scopeUUID)
+}
+
+// Session returns the address of a session within a scope by uuids
+func Session(scopeUUID uuid.UUID, sessionUUID uuid.UUID) Address {
+	return withUUIDs(TypeSession, scopeUUID, sessionUUID)
+}
+
+// Record returns the address of a record within a scope by scope uuid and record name.  The name is trimmed and
+// lowercased before it is hashed.
+func Record(scopeUUID uuid.UUID, name string) (Address, error) {
+	return withNameHash(TypeRecord, scopeUUID, name, "record")
+}
+
+// ScopeSpec returns the address of the scope specification with the given uuid
+func ScopeSpec(specUUID uuid.UUID) Address {
+	return withUUIDs(TypeScopeSpecification, specUUID)
+}
+
+// ContractSpec returns the address of the contract specification with the given uuid
+func ContractSpec(specUUID uuid.UUID) Address {
+	return withUUIDs(TypeContractSpecification, specUUID)
+}
+
+// RecordSpec returns the address of a record specification within a contract specification by contract
+// specification uuid and record name.  The name is trimmed and lowercased before it is hashed.
+func RecordSpec(contractSpecUUID uuid.UUID, name string) (Address, error) {
+	return withNameHash(TypeRecordSpecification, contractSpecUUID, name, "record spec")
+}
+
+// withUUIDs returns an address of the type byte followed by the uuids.
+func withUUIDs(typeByte byte, uuids ...uuid.UUID) Address {
+	addr := make(Address, 1, 1+16*len(uuids))
+	addr[0] = typeByte
+	for _, u := range uuids {
+		addr = append(addr, u[:]...)
+	}
+	return addr
+}
+
+// withNameHash returns an address of the type byte followed by the uuid and the first half of the sha256 hash of
+// the normalized name.
+func withNameHash(typeByte byte, u uuid.UUID, name string, kind string) (Address, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) < 1 {
+		return nil, fmt.Errorf("missing name value for %s metadata address", kind)
+	}
+	nameBytes := sha256.Sum256([]byte(name))
+	return append(withUUIDs(typeByte, u), nameBytes[0:16]...), nil
+}
+
+// Empty returns true if the address has no bytes
+func (a Address) Empty() bool {
+	return len(a) == 0
+}
+
+// Equals returns true if both addresses have the same bytes
+func (a Address) Equals(other Address) bool {
+	return bytes.Equal(a, other)
+}
+
+// Bytes returns the raw bytes of the address
+func (a Address) Bytes() []byte {
+	return a
+}
+
+// Validate determines if the contained bytes form a valid address according to its type
+func (a Address) Validate() error {
+	_, err := VerifyFormat(a)
+	return err
+}
+
+// Type returns the type byte of the address, e.g. TypeScope
+func (a Address) Type() (byte, error) {
+	if _, err := VerifyFormat(a); err != nil {
+		return 0, err
+	}
+	return a[0], nil
+}
+
+// Prefix returns the human readable part (prefix) of the address, e.g. "scope" or "contractspec"
+func (a Address) Prefix() (string, error) {
+	return VerifyFormat(a)
+}
+
+// String encodes the address as bech32, an invalid address is encoded as hex instead.
+func (a Address) String() string {
+	s, err := a.Bech32()
+	if err != nil {
+		return fmt.Sprintf("%X", []byte(a))
+	}
+	return s
+}
+
+// Bech32 encodes the address as bech32 with the prefix of its type.  An empty address is encoded as an empty string.
+func (a Address) Bech32() (string, error) {
+	if a.Empty() {
+		return "", nil
+	}
+	hrp, err := VerifyFormat(a)
+	if err != nil {
+		return "", err
+	}
+	return bech32.ConvertAndEncode(hrp, a)
+}
+
+// PrimaryUUID returns the uuid following the type byte.  This is the scope uuid of scope, session, and record
+// addresses, the specification uuid of scope and contract specification addresses, and the contract specification uuid
+// of record specification addresses.
+func (a Address) PrimaryUUID() (uuid.UUID, error) {
+	if _, err := VerifyFormat(a); err != nil {
+		return uuid.UUID{}, err
+	}
+	return uuid.FromBytes(a[1:17])
+}
+
+// SecondaryUUID returns the session uuid of a session address.
+func (a Address) SecondaryUUID() (uuid.UUID, error) {
+	hrp, err := VerifyFormat(a)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+	if a[0] != TypeSession {
+		return uuid.UUID{}, fmt.Errorf("%s address does not have a secondary uuid", hrp)
+	}
+	return uuid.FromBytes(a[17:33])
+}
+
+// NameHash returns a copy of the name hash of a record or record specification address.
+func (a Address) NameHash() ([]byte, error) {
+	hrp, err := VerifyFormat(a)
+	if err != nil {
+		return nil, err
+	}
+	if a[0] != TypeRecord && a[0] != TypeRecordSpecification {
+		return nil, fmt.Errorf("%s address does not have a name hash", hrp)
+	}
+	return append([]byte{}, a[17:33]...), nil
+}
+
+// Parent returns the address of the scope of a session or record address, or of the contract specification of a
+// record specification address.  Other addresses have no parent and an empty address is returned.
+func (a Address) Parent() (Address, error) {
+	if _, err := VerifyFormat(a); err != nil {
+		return nil, err
+	}
+	switch a[0] {
+	case TypeSession, TypeRecord:
+		return withUUIDs(TypeScope, uuid.Must(uuid.FromBytes(a[1:17]))), nil
+	case TypeRecordSpecification:
+		return withUUIDs(TypeContractSpecification, uuid.Must(uuid.FromBytes(a[1:17]))), nil
+	}
+	return Address{}, nil
+}
+
+// MarshalJSON returns a JSON representation of the address using a bech32 encoded string
+func (a Address) MarshalJSON() ([]byte, error) {
+	s, err := a.Bech32()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON sets the address from a JSON bech32 encoded string, an empty string is an empty address.
+func (a *Address) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return a.UnmarshalText([]byte(s))
+}
+
+// MarshalText returns the bech32 encoded address, used for map keys and other text encodings
+func (a Address) MarshalText() ([]byte, error) {
+	s, err := a.Bech32()
+	return []byte(s), err
+}
+
+// UnmarshalText sets the address from a bech32 encoded string, an empty string is an empty address.
+func (a *Address) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*a = Address{}
+		return nil
+	}
+	addr, err := FromBech32(string(text))
+	if err != nil {
+		return err
+	}
+	*a = addr
+	return nil
+}
@@ -0,0 +1,132 @@
+package address
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testScopeUUID   = uuid.MustParse("8d80b25a-c089-4446-956e-5d08cfe3e1a5")
+	testSessionUUID = uuid.MustParse("c25c7bd4-c639-4367-a842-f64fa5fccc19")
+)
+
+const (
+	testScopeBech32   = "scope1qzxcpvj6czy5g354dews3nlruxjsahhnsp"
+	testSessionBech32 = "session1qxxcpvj6czy5g354dews3nlruxjuyhrm6nrrjsm84pp0vna9lnxpjewp6kf"
+	testRecordBech32  = "record1q2xcpvj6czy5g354dews3nlruxjelpkssxyyclt9ngh74gx9ttgp27gt8kl"
+)
+
+func TestConstructors(t *testing.T) {
+	record, err := Record(testScopeUUID, " Test ")
+	require.NoError(t, err)
+	recordSpec, err := RecordSpec(testScopeUUID, "test")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		addr   Address
+		prefix string
+		bech32 string
+	}{
+		{"scope", Scope(testScopeUUID), PrefixScope, testScopeBech32},
+		{"session", Session(testScopeUUID, testSessionUUID), PrefixSession, testSessionBech32},
+		{"record", record, PrefixRecord, testRecordBech32},
+		{"scope spec", ScopeSpec(testScopeUUID), PrefixScopeSpecification, ""},
+		{"contract spec", ContractSpec(testScopeUUID), PrefixContractSpecification, ""},
+		{"record spec", recordSpec, PrefixRecordSpecification, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.addr.Validate())
+			prefix, err := tc.addr.Prefix()
+			require.NoError(t, err)
+			require.Equal(t, tc.prefix, prefix)
+			if len(tc.bech32) > 0 {
+				require.Equal(t, tc.bech32, tc.addr.String())
+			}
+			primary, err := tc.addr.PrimaryUUID()
+			require.NoError(t, err)
+			require.Equal(t, testScopeUUID, primary)
+
+			decoded, err := FromBech32(tc.addr.String())
+			require.NoError(t, err)
+			require.True(t, tc.addr.Equals(decoded))
+		})
+	}
+
+	_, err = Record(testScopeUUID, "  ")
+	require.EqualError(t, err, "missing name value for record metadata address")
+	_, err = RecordSpec(testScopeUUID, "")
+	require.EqualError(t, err, "missing name value for record spec metadata address")
+}
+
+func TestComponents(t *testing.T) {
+	session := Session(testScopeUUID, testSessionUUID)
+	secondary, err := session.SecondaryUUID()
+	require.NoError(t, err)
+	require.Equal(t, testSessionUUID, secondary)
+	parent, err := session.Parent()
+	require.NoError(t, err)
+	require.Equal(t, Scope(testScopeUUID), parent)
+
+	recordSpec, err := RecordSpec(testScopeUUID, "test")
+	require.NoError(t, err)
+	hash, err := recordSpec.NameHash()
+	require.NoError(t, err)
+	require.Len(t, hash, 16)
+	hash[0]++
+	require.NotEqual(t, hash, []byte(recordSpec[17:]), "the name hash is a copy")
+	parent, err = recordSpec.Parent()
+	require.NoError(t, err)
+	require.Equal(t, ContractSpec(testScopeUUID), parent)
+
+	scope := Scope(testScopeUUID)
+	_, err = scope.SecondaryUUID()
+	require.EqualError(t, err, "scope address does not have a secondary uuid")
+	_, err = scope.NameHash()
+	require.EqualError(t, err, "scope address does not have a name hash")
+	parent, err = scope.Parent()
+	require.NoError(t, err)
+	require.True(t, parent.Empty())
+}
+
+func TestVerifyFormat(t *testing.T) {
+	_, err := VerifyFormat(nil)
+	require.EqualError(t, err, "address is empty")
+	_, err = VerifyFormat([]byte{0x64, 0x01})
+	require.EqualError(t, err, "invalid metadata address type: 100")
+	_, err = VerifyFormat(append(Scope(testScopeUUID), 0x01))
+	require.EqualError(t, err, "incorrect address length (expected: 17, actual: 18)")
+
+	_, err = FromBech32("")
+	require.EqualError(t, err, "empty address string is not allowed")
+	_, err = FromBech32("cosmos1qzxcpvj6czy5g354dews3nlruxjsxg4amq")
+	require.Error(t, err)
+
+	invalid := Address{0x64, 0x01}
+	require.Equal(t, "6401", invalid.String(), "an invalid address is printed as hex")
+	_, err = invalid.Bech32()
+	require.Error(t, err)
+}
+
+func TestJSON(t *testing.T) {
+	type wrapper struct {
+		ID    Address `json:"id"`
+		Empty Address `json:"empty"`
+	}
+	bz, err := json.Marshal(wrapper{ID: Scope(testScopeUUID)})
+	require.NoError(t, err)
+	require.Equal(t, `{"id":"`+testScopeBech32+`","empty":""}`, string(bz))
+
+	var decoded wrapper
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, Scope(testScopeUUID), decoded.ID)
+	require.True(t, decoded.Empty.Empty())
+
+	require.Error(t, json.Unmarshal([]byte(`{"id":"scope1invalid"}`), &decoded))
+	_, err = json.Marshal(Address{0x64})
+	require.Error(t, err)
+}
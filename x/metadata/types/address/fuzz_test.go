@@ -0,0 +1,87 @@
+//go:build go1.18
+// +build go1.18
+
+package address
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func FuzzFromBech32(f *testing.F) {
+	f.Add(testScopeBech32)
+	f.Add(testSessionBech32)
+	f.Add(testRecordBech32)
+	f.Add("scope1")
+	f.Add("")
+	f.Fuzz(func(t *testing.T, bech string) {
+		addr, err := FromBech32(bech)
+		if err != nil {
+			return
+		}
+		if err = addr.Validate(); err != nil {
+			t.Fatalf("decoded %q to an invalid address: %v", bech, err)
+		}
+		again, err := FromBech32(addr.String())
+		if err != nil {
+			t.Fatalf("could not decode the re-encoded %q: %v", addr.String(), err)
+		}
+		if !addr.Equals(again) {
+			t.Fatalf("round trip of %q changed the address from %X to %X", bech, []byte(addr), []byte(again))
+		}
+	})
+}
+
+func FuzzVerifyFormat(f *testing.F) {
+	f.Add([]byte(Scope(testScopeUUID)))
+	f.Add([]byte(Session(testScopeUUID, testSessionUUID)))
+	f.Add([]byte{TypeRecord})
+	f.Add([]byte{})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		addr := Address(bz)
+		hrp, err := VerifyFormat(bz)
+		if err != nil {
+			// Components of invalid addresses are never returned, but getting them must not panic.
+			_, _ = addr.PrimaryUUID()
+			_, _ = addr.SecondaryUUID()
+			_, _ = addr.NameHash()
+			_, _ = addr.Parent()
+			_ = addr.String()
+			return
+		}
+		bech, err := addr.Bech32()
+		if err != nil {
+			t.Fatalf("could not encode valid address %X: %v", bz, err)
+		}
+		decoded, err := FromBech32(bech)
+		if err != nil || !addr.Equals(decoded) {
+			t.Fatalf("round trip of %s address %X failed: %v", hrp, bz, err)
+		}
+		if _, err = addr.PrimaryUUID(); err != nil {
+			t.Fatalf("valid %s address %X has no primary uuid: %v", hrp, bz, err)
+		}
+	})
+}
+
+func FuzzRecord(f *testing.F) {
+	f.Add(testScopeUUID[:], "test")
+	f.Add(testSessionUUID[:], " Mixed Case ")
+	f.Fuzz(func(t *testing.T, uuidBytes []byte, name string) {
+		u, err := uuid.FromBytes(uuidBytes)
+		if err != nil {
+			return
+		}
+		addr, err := Record(u, name)
+		if err != nil {
+			return
+		}
+		if err = addr.Validate(); err != nil {
+			t.Fatalf("record address for %s %q is invalid: %v", u, name, err)
+		}
+		parent, err := addr.Parent()
+		if err != nil || !parent.Equals(Scope(u)) {
+			t.Fatalf("record address for %s %q has parent %s: %v", u, name, parent, err)
+		}
+	})
+}
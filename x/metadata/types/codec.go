@@ -29,6 +29,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeleteContractSpecFromScopeSpecRequest{}, "provenance/metadata/DeleteContractSpecFromScopeSpecRequest", nil)
 	cdc.RegisterConcrete(&MsgWriteRecordSpecificationRequest{}, "provenance/metadata/WriteRecordSpecificationRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteRecordSpecificationRequest{}, "provenance/metadata/DeleteRecordSpecificationRequest", nil)
+	cdc.RegisterConcrete(&MsgAddSpecificationOwnerRequest{}, "provenance/metadata/AddSpecificationOwnerRequest", nil)
+	cdc.RegisterConcrete(&MsgDeleteSpecificationOwnerRequest{}, "provenance/metadata/DeleteSpecificationOwnerRequest", nil)
 
 	cdc.RegisterConcrete(&MsgWriteP8EContractSpecRequest{}, "provenance/metadata/WriteP8EContractSpecRequest", nil)
 	cdc.RegisterConcrete(&MsgP8EMemorializeContractRequest{}, "provenance/metadata/P8EMemorializeContractRequest", nil)
@@ -36,6 +38,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBindOSLocatorRequest{}, "provenance/metadata/BindOSLocatorRequest", nil)
 	cdc.RegisterConcrete(&MsgModifyOSLocatorRequest{}, "provenance/metadata/ModifyOSLocatorRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteOSLocatorRequest{}, "provenance/metadata/DeleteOSLocatorRequest", nil)
+
+	cdc.RegisterConcrete(&MsgRequestReverificationRequest{}, "provenance/metadata/RequestReverificationRequest", nil)
+	cdc.RegisterConcrete(&MsgResolveReverificationRequest{}, "provenance/metadata/ResolveReverificationRequest", nil)
 }
 
 // RegisterInterfaces registers implementations for the tx messages
@@ -59,6 +64,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDeleteContractSpecFromScopeSpecRequest{},
 		&MsgWriteRecordSpecificationRequest{},
 		&MsgDeleteRecordSpecificationRequest{},
+		&MsgAddSpecificationOwnerRequest{},
+		&MsgDeleteSpecificationOwnerRequest{},
 
 		&MsgWriteP8EContractSpecRequest{},
 		&MsgP8EMemorializeContractRequest{},
@@ -66,6 +73,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBindOSLocatorRequest{},
 		&MsgModifyOSLocatorRequest{},
 		&MsgDeleteOSLocatorRequest{},
+
+		&MsgRequestReverificationRequest{},
+		&MsgResolveReverificationRequest{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
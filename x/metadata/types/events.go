@@ -117,12 +117,18 @@ const (
 	TxEndpoint_WriteRecordSpecification  TxEndpoint = "WriteRecordSpecification"
 	TxEndpoint_DeleteRecordSpecification TxEndpoint = "DeleteRecordSpecification"
 
+	TxEndpoint_AddSpecificationOwner    TxEndpoint = "AddSpecificationOwner"
+	TxEndpoint_DeleteSpecificationOwner TxEndpoint = "DeleteSpecificationOwner"
+
 	TxEndpoint_WriteP8eContractSpec   TxEndpoint = "WriteP8eContractSpec"
 	TxEndpoint_P8eMemorializeContract TxEndpoint = "P8eMemorializeContract"
 
 	TxEndpoint_BindOSLocator   TxEndpoint = "BindOSLocator"
 	TxEndpoint_DeleteOSLocator TxEndpoint = "DeleteOSLocator"
 	TxEndpoint_ModifyOSLocator TxEndpoint = "ModifyOSLocator"
+
+	TxEndpoint_RequestReverification TxEndpoint = "RequestReverification"
+	TxEndpoint_ResolveReverification TxEndpoint = "ResolveReverification"
 )
 
 func NewEventTxCompleted(endpoint TxEndpoint, signers []sdk.AccAddress) *EventTxCompleted {
@@ -199,6 +205,37 @@ func NewEventRecordDeleted(recordID MetadataAddress) *EventRecordDeleted {
 	}
 }
 
+func NewEventRecordReverificationRequested(recordID MetadataAddress, requester string, owners []Party) *EventRecordReverificationRequested {
+	retval := &EventRecordReverificationRequested{
+		RecordAddr: recordID.String(),
+		ScopeAddr:  recordID.MustGetAsScopeAddress().String(),
+		Requester:  requester,
+		Owners:     make([]string, len(owners)),
+	}
+	for i, owner := range owners {
+		retval.Owners[i] = owner.Address
+	}
+	return retval
+}
+
+func NewEventRecordReverificationResolved(recordID MetadataAddress, requester string, status ReverificationStatus) *EventRecordReverificationResolved {
+	return &EventRecordReverificationResolved{
+		RecordAddr: recordID.String(),
+		ScopeAddr:  recordID.MustGetAsScopeAddress().String(),
+		Requester:  requester,
+		Status:     status.String(),
+	}
+}
+
+func NewEventRecordInputValidationWarning(recordID, recordSpecID MetadataAddress, inputName string, reason string) *EventRecordInputValidationWarning {
+	return &EventRecordInputValidationWarning{
+		RecordAddr:              recordID.String(),
+		RecordSpecificationAddr: recordSpecID.String(),
+		InputName:               inputName,
+		Reason:                  reason,
+	}
+}
+
 func NewEventScopeSpecificationCreated(scopeSpecificationID MetadataAddress) *EventScopeSpecificationCreated {
 	return &EventScopeSpecificationCreated{
 		ScopeSpecificationAddr: scopeSpecificationID.String(),
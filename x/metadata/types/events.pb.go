@@ -572,6 +572,227 @@ func (m *EventRecordDeleted) GetScopeAddr() string {
 	return ""
 }
 
+// EventRecordInputValidationWarning is an event message indicating a record input does not conform to its input
+// specification but was accepted because the record specification input validation level is warn.
+type EventRecordInputValidationWarning struct {
+	// record_addr is the bech32 address string of the record id with the non-conforming input.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// record_specification_addr is the bech32 address string of the record specification id.
+	RecordSpecificationAddr string `protobuf:"bytes,2,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
+	// input_name is the name of the non-conforming input.
+	InputName string `protobuf:"bytes,3,opt,name=input_name,json=inputName,proto3" json:"input_name,omitempty"`
+	// reason describes how the input does not conform to its specification.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventRecordInputValidationWarning) Reset()         { *m = EventRecordInputValidationWarning{} }
+func (m *EventRecordInputValidationWarning) String() string { return proto.CompactTextString(m) }
+func (*EventRecordInputValidationWarning) ProtoMessage()    {}
+func (*EventRecordInputValidationWarning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{10}
+}
+func (m *EventRecordInputValidationWarning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordInputValidationWarning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordInputValidationWarning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecordInputValidationWarning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordInputValidationWarning.Merge(m, src)
+}
+func (m *EventRecordInputValidationWarning) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordInputValidationWarning) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordInputValidationWarning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecordInputValidationWarning proto.InternalMessageInfo
+
+func (m *EventRecordInputValidationWarning) GetRecordAddr() string {
+	if m != nil {
+		return m.RecordAddr
+	}
+	return ""
+}
+
+func (m *EventRecordInputValidationWarning) GetRecordSpecificationAddr() string {
+	if m != nil {
+		return m.RecordSpecificationAddr
+	}
+	return ""
+}
+
+func (m *EventRecordInputValidationWarning) GetInputName() string {
+	if m != nil {
+		return m.InputName
+	}
+	return ""
+}
+
+func (m *EventRecordInputValidationWarning) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventRecordReverificationRequested is an event message indicating a data consumer requested the re-verification of
+// the off-chain object of a record.
+type EventRecordReverificationRequested struct {
+	// record_addr is the bech32 address string of the disputed record id.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this record belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// requester is the bech32 address string of the account requesting the re-verification.
+	Requester string `protobuf:"bytes,3,opt,name=requester,proto3" json:"requester,omitempty"`
+	// owners are the bech32 address strings of the scope owners asked to re-verify the object.
+	Owners []string `protobuf:"bytes,4,rep,name=owners,proto3" json:"owners,omitempty"`
+}
+
+func (m *EventRecordReverificationRequested) Reset()         { *m = EventRecordReverificationRequested{} }
+func (m *EventRecordReverificationRequested) String() string { return proto.CompactTextString(m) }
+func (*EventRecordReverificationRequested) ProtoMessage()    {}
+func (*EventRecordReverificationRequested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{11}
+}
+func (m *EventRecordReverificationRequested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordReverificationRequested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordReverificationRequested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecordReverificationRequested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordReverificationRequested.Merge(m, src)
+}
+func (m *EventRecordReverificationRequested) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordReverificationRequested) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordReverificationRequested.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecordReverificationRequested proto.InternalMessageInfo
+
+func (m *EventRecordReverificationRequested) GetRecordAddr() string {
+	if m != nil {
+		return m.RecordAddr
+	}
+	return ""
+}
+
+func (m *EventRecordReverificationRequested) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventRecordReverificationRequested) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
+}
+
+func (m *EventRecordReverificationRequested) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+// EventRecordReverificationResolved is an event message indicating a record re-verification request was resolved.
+type EventRecordReverificationResolved struct {
+	// record_addr is the bech32 address string of the disputed record id.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this record belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// requester is the bech32 address string of the account that requested the re-verification.
+	Requester string `protobuf:"bytes,3,opt,name=requester,proto3" json:"requester,omitempty"`
+	// status is the resolution status.
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *EventRecordReverificationResolved) Reset()         { *m = EventRecordReverificationResolved{} }
+func (m *EventRecordReverificationResolved) String() string { return proto.CompactTextString(m) }
+func (*EventRecordReverificationResolved) ProtoMessage()    {}
+func (*EventRecordReverificationResolved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{12}
+}
+func (m *EventRecordReverificationResolved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordReverificationResolved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordReverificationResolved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecordReverificationResolved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordReverificationResolved.Merge(m, src)
+}
+func (m *EventRecordReverificationResolved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordReverificationResolved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordReverificationResolved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecordReverificationResolved proto.InternalMessageInfo
+
+func (m *EventRecordReverificationResolved) GetRecordAddr() string {
+	if m != nil {
+		return m.RecordAddr
+	}
+	return ""
+}
+
+func (m *EventRecordReverificationResolved) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventRecordReverificationResolved) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
+}
+
+func (m *EventRecordReverificationResolved) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
 // EventScopeSpecificationCreated is an event message indicating a scope specification has been created.
 type EventScopeSpecificationCreated struct {
 	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
@@ -583,7 +804,7 @@ func (m *EventScopeSpecificationCreated) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationCreated) ProtoMessage()    {}
 func (*EventScopeSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{10}
+	return fileDescriptor_476cf6cf9459cf25, []int{13}
 }
 func (m *EventScopeSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,7 +851,7 @@ func (m *EventScopeSpecificationUpdated) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationUpdated) ProtoMessage()    {}
 func (*EventScopeSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{11}
+	return fileDescriptor_476cf6cf9459cf25, []int{14}
 }
 func (m *EventScopeSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,7 +898,7 @@ func (m *EventScopeSpecificationDeleted) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationDeleted) ProtoMessage()    {}
 func (*EventScopeSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{12}
+	return fileDescriptor_476cf6cf9459cf25, []int{15}
 }
 func (m *EventScopeSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,7 +945,7 @@ func (m *EventContractSpecificationCreated) Reset()         { *m = EventContract
 func (m *EventContractSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationCreated) ProtoMessage()    {}
 func (*EventContractSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{13}
+	return fileDescriptor_476cf6cf9459cf25, []int{16}
 }
 func (m *EventContractSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,7 +992,7 @@ func (m *EventContractSpecificationUpdated) Reset()         { *m = EventContract
 func (m *EventContractSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationUpdated) ProtoMessage()    {}
 func (*EventContractSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{14}
+	return fileDescriptor_476cf6cf9459cf25, []int{17}
 }
 func (m *EventContractSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -818,7 +1039,7 @@ func (m *EventContractSpecificationDeleted) Reset()         { *m = EventContract
 func (m *EventContractSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationDeleted) ProtoMessage()    {}
 func (*EventContractSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{15}
+	return fileDescriptor_476cf6cf9459cf25, []int{18}
 }
 func (m *EventContractSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -868,7 +1089,7 @@ func (m *EventRecordSpecificationCreated) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationCreated) ProtoMessage()    {}
 func (*EventRecordSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{16}
+	return fileDescriptor_476cf6cf9459cf25, []int{19}
 }
 func (m *EventRecordSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,7 +1146,7 @@ func (m *EventRecordSpecificationUpdated) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationUpdated) ProtoMessage()    {}
 func (*EventRecordSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{17}
+	return fileDescriptor_476cf6cf9459cf25, []int{20}
 }
 func (m *EventRecordSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,7 +1203,7 @@ func (m *EventRecordSpecificationDeleted) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationDeleted) ProtoMessage()    {}
 func (*EventRecordSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{18}
+	return fileDescriptor_476cf6cf9459cf25, []int{21}
 }
 func (m *EventRecordSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1035,7 +1256,7 @@ func (m *EventOSLocatorCreated) Reset()         { *m = EventOSLocatorCreated{} }
 func (m *EventOSLocatorCreated) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorCreated) ProtoMessage()    {}
 func (*EventOSLocatorCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{19}
+	return fileDescriptor_476cf6cf9459cf25, []int{22}
 }
 func (m *EventOSLocatorCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,7 +1302,7 @@ func (m *EventOSLocatorUpdated) Reset()         { *m = EventOSLocatorUpdated{} }
 func (m *EventOSLocatorUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorUpdated) ProtoMessage()    {}
 func (*EventOSLocatorUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{20}
+	return fileDescriptor_476cf6cf9459cf25, []int{23}
 }
 func (m *EventOSLocatorUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1127,7 +1348,7 @@ func (m *EventOSLocatorDeleted) Reset()         { *m = EventOSLocatorDeleted{} }
 func (m *EventOSLocatorDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorDeleted) ProtoMessage()    {}
 func (*EventOSLocatorDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{21}
+	return fileDescriptor_476cf6cf9459cf25, []int{24}
 }
 func (m *EventOSLocatorDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1174,6 +1395,9 @@ func init() {
 	proto.RegisterType((*EventRecordCreated)(nil), "provenance.metadata.v1.EventRecordCreated")
 	proto.RegisterType((*EventRecordUpdated)(nil), "provenance.metadata.v1.EventRecordUpdated")
 	proto.RegisterType((*EventRecordDeleted)(nil), "provenance.metadata.v1.EventRecordDeleted")
+	proto.RegisterType((*EventRecordInputValidationWarning)(nil), "provenance.metadata.v1.EventRecordInputValidationWarning")
+	proto.RegisterType((*EventRecordReverificationRequested)(nil), "provenance.metadata.v1.EventRecordReverificationRequested")
+	proto.RegisterType((*EventRecordReverificationResolved)(nil), "provenance.metadata.v1.EventRecordReverificationResolved")
 	proto.RegisterType((*EventScopeSpecificationCreated)(nil), "provenance.metadata.v1.EventScopeSpecificationCreated")
 	proto.RegisterType((*EventScopeSpecificationUpdated)(nil), "provenance.metadata.v1.EventScopeSpecificationUpdated")
 	proto.RegisterType((*EventScopeSpecificationDeleted)(nil), "provenance.metadata.v1.EventScopeSpecificationDeleted")
@@ -1193,39 +1417,47 @@ func init() {
 }
 
 var fileDescriptor_476cf6cf9459cf25 = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x44, 0x14, 0x32, 0xe5, 0x00, 0x06, 0x82, 0x03, 0xc2, 0xfd, 0xc3, 0xa5, 0x97,
-	0xda, 0x2a, 0x70, 0x40, 0x1c, 0x90, 0x20, 0x70, 0x43, 0x02, 0x25, 0x45, 0x48, 0xbd, 0xc0, 0x76,
-	0x77, 0x28, 0x16, 0xcd, 0xae, 0xb5, 0xbb, 0x4d, 0xcb, 0x5b, 0xf0, 0x02, 0xbc, 0x0f, 0xc7, 0x1e,
-	0x39, 0xa2, 0xe4, 0x45, 0x50, 0xd6, 0x1e, 0xec, 0x36, 0x2e, 0x06, 0x42, 0x80, 0xe3, 0xcc, 0xce,
-	0x7c, 0xbf, 0xd9, 0x6f, 0xe7, 0xb0, 0x70, 0x3b, 0xd5, 0x6a, 0x84, 0x92, 0x49, 0x8e, 0xf1, 0x10,
-	0x2d, 0x13, 0xcc, 0xb2, 0x78, 0xb4, 0x15, 0xe3, 0x08, 0xa5, 0x35, 0x51, 0xaa, 0x95, 0x55, 0x7e,
-	0xa7, 0x28, 0x8a, 0xa8, 0x28, 0x1a, 0x6d, 0xad, 0xbf, 0x81, 0x4b, 0x4f, 0xa7, 0x75, 0xdb, 0x47,
-	0x3d, 0x35, 0x4c, 0xf7, 0xd1, 0xa2, 0xf0, 0x3b, 0xb0, 0x34, 0x54, 0xe2, 0x60, 0x1f, 0x03, 0x6f,
-	0xd5, 0xdb, 0x68, 0xf7, 0xf3, 0xc8, 0xbf, 0x01, 0x17, 0x50, 0x8a, 0x54, 0x25, 0xd2, 0x06, 0x4d,
-	0x77, 0xf2, 0x3d, 0xf6, 0x03, 0x38, 0x6f, 0x92, 0x3d, 0x89, 0xda, 0x04, 0xad, 0xd5, 0xd6, 0x46,
-	0xbb, 0x4f, 0xe1, 0xfa, 0x1d, 0xb8, 0xec, 0x08, 0x03, 0xae, 0x52, 0xec, 0x69, 0x64, 0x53, 0xc4,
-	0x2d, 0x00, 0x33, 0x8d, 0x5f, 0x33, 0x21, 0x74, 0x8e, 0x69, 0xbb, 0xcc, 0x23, 0x21, 0xf4, 0xc9,
-	0x9e, 0x97, 0xa9, 0xf8, 0xe5, 0x9e, 0x27, 0x98, 0x5d, 0xa5, 0xa6, 0xe7, 0x15, 0x5c, 0xc9, 0x7a,
-	0xd0, 0x98, 0x44, 0x49, 0x9a, 0x6e, 0x0d, 0x2e, 0x9a, 0x2c, 0x53, 0xee, 0x5b, 0xce, 0x73, 0xd3,
-	0xce, 0x53, 0xc2, 0xcd, 0x1a, 0x61, 0xba, 0xc2, 0x1f, 0x17, 0xa6, 0x7b, 0xce, 0x2f, 0x7c, 0x08,
-	0xbe, 0x13, 0xee, 0x23, 0x57, 0x5a, 0x90, 0x13, 0x2b, 0xb0, 0xac, 0x5d, 0xa2, 0x2c, 0x0b, 0x59,
-	0xca, 0xa9, 0x9e, 0x06, 0x37, 0xeb, 0xc0, 0xad, 0x1f, 0x83, 0xc9, 0xa9, 0xbf, 0x00, 0xde, 0x3e,
-	0x01, 0x26, 0x27, 0x6b, 0xc1, 0x35, 0xaa, 0x3b, 0x10, 0x16, 0x6b, 0x38, 0x48, 0x91, 0x27, 0x6f,
-	0x13, 0xce, 0x6c, 0x69, 0xbb, 0xee, 0x43, 0x90, 0x09, 0x98, 0xf2, 0x69, 0x19, 0xd7, 0x31, 0x33,
-	0xcd, 0x35, 0xda, 0x64, 0xdb, 0x22, 0xb4, 0xc9, 0x99, 0xdf, 0xd7, 0xe6, 0xb0, 0xe6, 0xb4, 0x7b,
-	0x4a, 0x5a, 0xcd, 0xb8, 0xad, 0xb4, 0xe5, 0x21, 0xdc, 0xe4, 0xf9, 0xf9, 0xd9, 0x84, 0x2e, 0xaf,
-	0x92, 0xa8, 0x87, 0x90, 0x3f, 0x0b, 0x85, 0x90, 0x51, 0xf3, 0x42, 0x3e, 0x79, 0xb0, 0x52, 0xda,
-	0xcc, 0x4a, 0xb7, 0x1e, 0x40, 0x37, 0x5f, 0xd3, 0x33, 0x09, 0xd7, 0xf5, 0x6c, 0xbb, 0xdb, 0xe0,
-	0x9a, 0xf9, 0x9a, 0xf3, 0xcc, 0x47, 0x46, 0xff, 0xaf, 0xf3, 0xd1, 0x1b, 0xfd, 0xcb, 0xf9, 0x36,
-	0xe1, 0x9a, 0x1b, 0xef, 0xf9, 0xe0, 0x99, 0xe2, 0xcc, 0x2a, 0x4d, 0x8f, 0x7a, 0x15, 0xce, 0xa9,
-	0x43, 0x89, 0x34, 0x40, 0x16, 0xcc, 0x96, 0x93, 0xc7, 0x3f, 0x59, 0x4e, 0x57, 0xae, 0x2c, 0x7f,
-	0xfc, 0xfe, 0xf3, 0x38, 0xf4, 0x8e, 0xc7, 0xa1, 0xf7, 0x75, 0x1c, 0x7a, 0x1f, 0x27, 0x61, 0xe3,
-	0x78, 0x12, 0x36, 0xbe, 0x4c, 0xc2, 0x06, 0x74, 0x13, 0x15, 0x55, 0xff, 0x1a, 0x5e, 0x78, 0x3b,
-	0xf7, 0xf6, 0x12, 0xfb, 0xee, 0x60, 0x37, 0xe2, 0x6a, 0x18, 0x17, 0x45, 0x9b, 0x89, 0x2a, 0x45,
-	0xf1, 0x51, 0xf1, 0x1f, 0xb1, 0x1f, 0x52, 0x34, 0xbb, 0x4b, 0xee, 0x33, 0x72, 0xf7, 0x5b, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x6c, 0x3a, 0xe4, 0x3b, 0xb3, 0x08, 0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xae, 0xd3, 0xfe, 0xfa, 0x23, 0x53, 0x0e, 0x60, 0xa0, 0xb8, 0xfc, 0x71, 0x5b, 0x73, 0xe9,
+	0xa5, 0x89, 0x0a, 0x1c, 0x10, 0x07, 0x24, 0x08, 0x1c, 0x90, 0x10, 0xa0, 0xa4, 0x50, 0xa9, 0x97,
+	0xb2, 0xb5, 0x87, 0xb0, 0x22, 0xde, 0x35, 0xbb, 0x1b, 0xb7, 0xbc, 0x05, 0x57, 0x90, 0x78, 0x0e,
+	0x5e, 0x81, 0x63, 0x8f, 0x1c, 0x51, 0xf2, 0x22, 0xc8, 0x6b, 0x6f, 0xe2, 0xa4, 0x6e, 0x5c, 0x1a,
+	0x5a, 0x38, 0xce, 0xec, 0xcc, 0xf7, 0x7d, 0xfb, 0xed, 0xee, 0x68, 0xe1, 0x56, 0x24, 0x78, 0x8c,
+	0x8c, 0x30, 0x1f, 0xeb, 0x21, 0x2a, 0x12, 0x10, 0x45, 0xea, 0xf1, 0x46, 0x1d, 0x63, 0x64, 0x4a,
+	0xd6, 0x22, 0xc1, 0x15, 0xb7, 0x17, 0x87, 0x45, 0x35, 0x53, 0x54, 0x8b, 0x37, 0xbc, 0x37, 0x70,
+	0xe1, 0x49, 0x52, 0xb7, 0xb9, 0xdf, 0xe0, 0x61, 0xd4, 0x41, 0x85, 0x81, 0xbd, 0x08, 0xf3, 0x21,
+	0x0f, 0xba, 0x1d, 0x74, 0xac, 0x15, 0x6b, 0xad, 0xda, 0xcc, 0x22, 0xfb, 0x1a, 0x9c, 0x43, 0x16,
+	0x44, 0x9c, 0x32, 0xe5, 0x54, 0xf4, 0xca, 0x20, 0xb6, 0x1d, 0xf8, 0x5f, 0xd2, 0x36, 0x43, 0x21,
+	0x9d, 0xd9, 0x95, 0xd9, 0xb5, 0x6a, 0xd3, 0x84, 0xde, 0x6d, 0xb8, 0xa8, 0x19, 0x5a, 0x3e, 0x8f,
+	0xb0, 0x21, 0x90, 0x24, 0x14, 0x37, 0x01, 0x64, 0x12, 0xef, 0x90, 0x20, 0x10, 0x19, 0x4d, 0x55,
+	0x67, 0x1e, 0x06, 0x81, 0x18, 0xed, 0x79, 0x15, 0x05, 0xbf, 0xdd, 0xf3, 0x18, 0x3b, 0x78, 0x8c,
+	0x9e, 0x2d, 0xb8, 0x94, 0xf6, 0xa0, 0x94, 0x94, 0x33, 0xa3, 0x6e, 0x15, 0xce, 0xcb, 0x34, 0x93,
+	0xef, 0x5b, 0xc8, 0x72, 0x49, 0xe7, 0x18, 0x70, 0xa5, 0x04, 0xd8, 0x6c, 0xe1, 0x8f, 0x03, 0x9b,
+	0x7d, 0x4e, 0x0f, 0xbc, 0x07, 0xb6, 0x06, 0x6e, 0xa2, 0xcf, 0x45, 0x60, 0x9c, 0x58, 0x86, 0x05,
+	0xa1, 0x13, 0x79, 0x58, 0x48, 0x53, 0x1a, 0x75, 0x9c, 0xb8, 0x52, 0x46, 0x3c, 0x3b, 0x99, 0xd8,
+	0x38, 0x75, 0x06, 0xc4, 0x9b, 0x23, 0xc4, 0xc6, 0xc9, 0x52, 0xe2, 0x12, 0xd4, 0x6f, 0x16, 0xac,
+	0xe6, 0x60, 0x9f, 0xb2, 0xa8, 0xab, 0x5e, 0x93, 0x0e, 0x0d, 0x88, 0xa2, 0x9c, 0x6d, 0x11, 0xc1,
+	0x28, 0x6b, 0x97, 0xb3, 0xdc, 0x87, 0xa5, 0xac, 0x40, 0x46, 0xe8, 0xd3, 0xb7, 0xd4, 0xd7, 0xfd,
+	0xf9, 0xbd, 0x5e, 0x4d, 0x0b, 0x5a, 0xf9, 0x75, 0xa3, 0x90, 0x26, 0xb4, 0x3b, 0x8c, 0x84, 0x68,
+	0x14, 0xea, 0xcc, 0x73, 0x12, 0x62, 0xf2, 0xbc, 0x05, 0x12, 0xc9, 0x99, 0x33, 0x97, 0x3e, 0xef,
+	0x34, 0xf2, 0xbe, 0x58, 0xe0, 0xe5, 0x94, 0x37, 0x31, 0x46, 0x31, 0x00, 0x6e, 0xe2, 0x87, 0x2e,
+	0xca, 0x13, 0x18, 0x34, 0x7e, 0xd1, 0xec, 0x1b, 0x50, 0x15, 0x19, 0xd8, 0xc0, 0xbe, 0x41, 0x22,
+	0x11, 0xc7, 0xf7, 0xf4, 0x18, 0x99, 0xd3, 0x63, 0x24, 0x8b, 0xbc, 0xcf, 0xa3, 0xb6, 0x8e, 0x8b,
+	0x93, 0xbc, 0x13, 0x9f, 0x85, 0x36, 0xa9, 0x88, 0xea, 0x4a, 0x63, 0x5c, 0x1a, 0x79, 0xdb, 0xe0,
+	0x0e, 0x27, 0xcf, 0xc8, 0x71, 0x98, 0x67, 0x74, 0x0f, 0x9c, 0x94, 0xb6, 0xe0, 0x30, 0x53, 0x91,
+	0x8b, 0xf2, 0x50, 0xb3, 0xbe, 0x4e, 0x47, 0x63, 0x9b, 0x97, 0x72, 0x1a, 0xd8, 0xe6, 0x31, 0x9c,
+	0x1c, 0xdb, 0xcf, 0x8e, 0xab, 0xc1, 0x99, 0x12, 0xc4, 0x57, 0x85, 0xb6, 0x3c, 0x80, 0xeb, 0x7e,
+	0xb6, 0x7e, 0x34, 0xc3, 0x92, 0x5f, 0x04, 0x51, 0x4e, 0x62, 0xfc, 0x39, 0x55, 0x12, 0x63, 0xd4,
+	0xb4, 0x24, 0x5f, 0x2d, 0x58, 0xce, 0x5d, 0xef, 0x42, 0xb7, 0x26, 0x8e, 0x04, 0x6b, 0xf2, 0x48,
+	0x28, 0xd1, 0x57, 0x99, 0x46, 0x9f, 0x31, 0xfa, 0x5f, 0xd5, 0x67, 0xce, 0xe8, 0x6f, 0xea, 0x5b,
+	0x87, 0x2b, 0x5a, 0xde, 0x8b, 0xd6, 0x33, 0xee, 0x13, 0xc5, 0x85, 0x39, 0xd4, 0xcb, 0xf0, 0x9f,
+	0x9e, 0x70, 0x99, 0x80, 0x34, 0x38, 0x5c, 0x6e, 0x3c, 0x3e, 0x66, 0xb9, 0xd9, 0x72, 0x61, 0xf9,
+	0xa3, 0xf7, 0xdf, 0x7b, 0xae, 0x75, 0xd0, 0x73, 0xad, 0x9f, 0x3d, 0xd7, 0xfa, 0xd4, 0x77, 0x67,
+	0x0e, 0xfa, 0xee, 0xcc, 0x8f, 0xbe, 0x3b, 0x03, 0x4b, 0x94, 0xd7, 0x8a, 0x3f, 0x8a, 0x2f, 0xad,
+	0xed, 0xbb, 0x6d, 0xaa, 0xde, 0x75, 0x77, 0x6b, 0x3e, 0x0f, 0xeb, 0xc3, 0xa2, 0x75, 0xca, 0x73,
+	0x51, 0x7d, 0x7f, 0xf8, 0x05, 0x55, 0x1f, 0x23, 0x94, 0xbb, 0xf3, 0xfa, 0xff, 0x79, 0xe7, 0xd7,
+	0x00, 0xb1, 0x63, 0xa9, 0x2b, 0xa6, 0x0a, 0x00, 0x00,
 }
 
 func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
@@ -1600,7 +1832,7 @@ func (m *EventRecordDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
+func (m *EventRecordInputValidationWarning) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1610,27 +1842,48 @@ func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventScopeSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRecordInputValidationWarning) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventScopeSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRecordInputValidationWarning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InputName) > 0 {
+		i -= len(m.InputName)
+		copy(dAtA[i:], m.InputName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InputName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecordSpecificationAddr) > 0 {
+		i -= len(m.RecordSpecificationAddr)
+		copy(dAtA[i:], m.RecordSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventRecordReverificationRequested) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1640,27 +1893,50 @@ func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventScopeSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRecordReverificationRequested) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventScopeSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRecordReverificationRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+func (m *EventRecordReverificationResolved) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1670,27 +1946,48 @@ func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventScopeSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRecordReverificationResolved) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventScopeSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRecordReverificationResolved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1700,27 +1997,27 @@ func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventContractSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventContractSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1730,20 +2027,110 @@ func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventContractSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventContractSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2164,6 +2551,83 @@ func (m *EventRecordDeleted) Size() (n int) {
 	return n
 }
 
+func (m *EventRecordInputValidationWarning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InputName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordReverificationRequested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRecordReverificationResolved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventScopeSpecificationCreated) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3478,6 +3942,540 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventRecordInputValidationWarning) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordInputValidationWarning: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordInputValidationWarning: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecordReverificationRequested) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordReverificationRequested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordReverificationRequested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecordReverificationResolved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordReverificationResolved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordReverificationResolved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventScopeSpecificationCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
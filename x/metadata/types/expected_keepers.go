@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeatureFlagKeeper defines the expected feature flag keeper used to gate new consensus behaviors (noalias)
+type FeatureFlagKeeper interface {
+	IsFeatureActive(ctx sdk.Context, name string) bool
+}
@@ -1,7 +1,22 @@
 package types
 
+import "fmt"
+
 // Validate ensures the genesis state is valid.
 func (state GenesisState) Validate() error {
+	for _, entry := range state.ScopeHistory {
+		if !entry.ScopeId.IsScopeAddress() {
+			return fmt.Errorf("invalid scope history entry scope id %s", entry.ScopeId)
+		}
+		if entry.Sequence == 0 {
+			return fmt.Errorf("invalid scope history entry sequence 0 for scope %s", entry.ScopeId)
+		}
+	}
+	for _, reverification := range state.RecordReverifications {
+		if err := reverification.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -16,6 +31,8 @@ func NewGenesisState(
 	contracSpecs []ContractSpecification,
 	recordSpecs []RecordSpecification,
 	objectStoreLocators []ObjectStoreLocator,
+	scopeHistory []ScopeHistoryEntry,
+	recordReverifications []RecordReverification,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                 params,
@@ -27,6 +44,8 @@ func NewGenesisState(
 		ContractSpecifications: contracSpecs,
 		RecordSpecifications:   recordSpecs,
 		ObjectStoreLocators:    objectStoreLocators,
+		ScopeHistory:           scopeHistory,
+		RecordReverifications:  recordReverifications,
 	}
 }
 
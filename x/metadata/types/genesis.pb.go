@@ -36,6 +36,10 @@ type GenesisState struct {
 	RecordSpecifications   []RecordSpecification   `protobuf:"bytes,7,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications"`
 	OSLocatorParams        OSLocatorParams         `protobuf:"bytes,8,opt,name=o_s_locator_params,json=oSLocatorParams,proto3" json:"o_s_locator_params"`
 	ObjectStoreLocators    []ObjectStoreLocator    `protobuf:"bytes,9,rep,name=object_store_locators,json=objectStoreLocators,proto3" json:"object_store_locators"`
+	// scope_history is the audit trail of all scopes.
+	ScopeHistory []ScopeHistoryEntry `protobuf:"bytes,10,rep,name=scope_history,json=scopeHistory,proto3" json:"scope_history"`
+	// record_reverifications are the re-verification requests of records.
+	RecordReverifications []RecordReverification `protobuf:"bytes,11,rep,name=record_reverifications,json=recordReverifications,proto3" json:"record_reverifications"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,37 +84,40 @@ func init() {
 }
 
 var fileDescriptor_a835c20198efc302 = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x6e, 0x13, 0x31,
-	0x10, 0xc6, 0x77, 0x69, 0xd9, 0x06, 0x17, 0x09, 0xc9, 0xa4, 0x65, 0xa9, 0xc4, 0xa6, 0xaa, 0x40,
-	0x44, 0x45, 0xdd, 0x55, 0x0b, 0x27, 0x40, 0x48, 0x94, 0x03, 0x17, 0xa4, 0x56, 0xdd, 0x5b, 0x2f,
-	0x2b, 0xc7, 0x71, 0x83, 0xa1, 0xd9, 0x59, 0x79, 0x4c, 0x04, 0x6f, 0xc0, 0x11, 0x89, 0x17, 0xe8,
-	0xe3, 0xf4, 0xd8, 0x23, 0x27, 0x84, 0x92, 0x0b, 0x8f, 0x51, 0xc5, 0xf6, 0x36, 0xcd, 0x1f, 0xe7,
-	0x96, 0xec, 0xfc, 0xbe, 0xef, 0x9b, 0xb1, 0xc7, 0xe4, 0x69, 0xa5, 0x60, 0x20, 0x4a, 0x56, 0x72,
-	0x91, 0xf5, 0x85, 0x66, 0x5d, 0xa6, 0x59, 0x36, 0xd8, 0xcf, 0x7a, 0xa2, 0x14, 0x28, 0x31, 0xad,
-	0x14, 0x68, 0xa0, 0x9b, 0x13, 0x2a, 0xad, 0xa9, 0x74, 0xb0, 0xbf, 0xd5, 0xec, 0x41, 0x0f, 0x0c,
-	0x92, 0x8d, 0x7f, 0x59, 0x7a, 0xeb, 0x99, 0xc7, 0xf3, 0x46, 0x69, 0xb1, 0x1d, 0x0f, 0x86, 0x1c,
-	0x2a, 0xe1, 0x98, 0x5d, 0x1f, 0x53, 0x09, 0x2e, 0xcf, 0x24, 0x67, 0x5a, 0x42, 0xe9, 0xd8, 0xb6,
-	0x87, 0x85, 0xce, 0x17, 0xc1, 0x35, 0x6a, 0x50, 0xce, 0x75, 0xe7, 0x77, 0x44, 0xee, 0x7f, 0xb4,
-	0x03, 0xe6, 0x9a, 0x69, 0x41, 0xdf, 0x92, 0xa8, 0x62, 0x8a, 0xf5, 0x31, 0x0e, 0xb7, 0xc3, 0xf6,
-	0xfa, 0x41, 0x92, 0x2e, 0x1e, 0x38, 0x3d, 0x36, 0xd4, 0xe1, 0xea, 0xe5, 0xdf, 0x56, 0x70, 0xe2,
-	0x34, 0xf4, 0x0d, 0x89, 0x4c, 0xcf, 0x18, 0xdf, 0xd9, 0x5e, 0x69, 0xaf, 0x1f, 0x3c, 0xf1, 0xa9,
-	0xf3, 0x31, 0x55, 0x8b, 0xad, 0x84, 0xbe, 0x27, 0x0d, 0x14, 0x88, 0x12, 0x4a, 0x8c, 0x57, 0x8c,
-	0xbc, 0xe5, 0x95, 0x5b, 0xce, 0x19, 0xdc, 0xc8, 0xe8, 0x3b, 0xb2, 0xa6, 0x04, 0x07, 0xd5, 0xc5,
-	0x78, 0xd5, 0x38, 0x78, 0xdb, 0x3f, 0x31, 0x98, 0x33, 0xa8, 0x45, 0x94, 0x93, 0xa6, 0x69, 0xa6,
-	0x98, 0x3a, 0x55, 0x8c, 0xef, 0x1a, 0xb3, 0xdd, 0xa5, 0xd3, 0xe4, 0xb7, 0x25, 0xce, 0xf8, 0x21,
-	0xce, 0x55, 0x90, 0x9e, 0x93, 0x47, 0x1c, 0x4a, 0xad, 0x18, 0xd7, 0xb3, 0x39, 0x91, 0xc9, 0xd9,
-	0xf3, 0xe5, 0x7c, 0x70, 0xb2, 0x45, 0x51, 0x9b, 0x7c, 0x51, 0x11, 0xe9, 0x19, 0xd9, 0xb0, 0xd3,
-	0xcd, 0x66, 0xad, 0x99, 0xac, 0x17, 0xcb, 0x0f, 0x68, 0x51, 0x52, 0x53, 0xcd, 0x97, 0x90, 0x9e,
-	0x12, 0x0a, 0x05, 0x16, 0xe7, 0xc0, 0x99, 0x06, 0x55, 0xb8, 0x25, 0x6a, 0x98, 0x25, 0x7a, 0xee,
-	0x0b, 0x39, 0xca, 0x3f, 0x59, 0x7e, 0x6a, 0x9b, 0x1e, 0xc0, 0xf4, 0x67, 0xda, 0x25, 0x1b, 0x76,
-	0x75, 0x0b, 0xb3, 0xbb, 0x75, 0x08, 0xc6, 0xf7, 0x96, 0xdf, 0xcb, 0x91, 0x11, 0xe5, 0x63, 0x8d,
-	0x33, 0xac, 0xef, 0x05, 0xe6, 0x2a, 0xf8, 0xba, 0xf1, 0xf3, 0xa2, 0x15, 0xfc, 0xbf, 0x68, 0x05,
-	0x87, 0x5f, 0x2f, 0x87, 0x49, 0x78, 0x35, 0x4c, 0xc2, 0x7f, 0xc3, 0x24, 0xfc, 0x35, 0x4a, 0x82,
-	0xab, 0x51, 0x12, 0xfc, 0x19, 0x25, 0x01, 0x79, 0x2c, 0xc1, 0x13, 0x76, 0x1c, 0x9e, 0xbe, 0xea,
-	0x49, 0xfd, 0xf9, 0x5b, 0x27, 0xe5, 0xd0, 0xcf, 0x26, 0xd0, 0x9e, 0x84, 0x5b, 0xff, 0xb2, 0xef,
-	0x93, 0x17, 0xa9, 0x7f, 0x54, 0x02, 0x3b, 0x91, 0x79, 0x89, 0x2f, 0xaf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x3a, 0xcc, 0x7d, 0x59, 0x80, 0x04, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0xc7, 0x77, 0xa5, 0x02, 0x0e, 0x35, 0x26, 0x23, 0xe0, 0xda, 0xc4, 0x85, 0x34, 0x1a, 0xb1,
+	0xda, 0xdd, 0xb4, 0x7a, 0x52, 0x63, 0x62, 0x8d, 0xd1, 0x83, 0x49, 0x1b, 0xf0, 0xd4, 0x0b, 0x59,
+	0x86, 0x29, 0x1d, 0x2d, 0xfb, 0x36, 0xf3, 0x46, 0x22, 0xdf, 0xc0, 0xa3, 0x1f, 0xa1, 0x1f, 0xa7,
+	0xc7, 0x1e, 0x3c, 0x78, 0x32, 0x06, 0x2e, 0x7e, 0x0c, 0xc3, 0xcc, 0x50, 0xa0, 0xec, 0xee, 0x0d,
+	0xf6, 0xfd, 0xfe, 0xff, 0xff, 0xbc, 0x79, 0x2f, 0x43, 0x1e, 0x26, 0x12, 0x46, 0x3c, 0x8e, 0x62,
+	0xc6, 0xc3, 0x21, 0x57, 0x51, 0x3f, 0x52, 0x51, 0x38, 0xda, 0x0b, 0x07, 0x3c, 0xe6, 0x28, 0x30,
+	0x48, 0x24, 0x28, 0xa0, 0xf5, 0x05, 0x15, 0xcc, 0xa9, 0x60, 0xb4, 0xb7, 0x55, 0x1d, 0xc0, 0x00,
+	0x34, 0x12, 0xce, 0x7e, 0x19, 0x7a, 0xeb, 0x51, 0x86, 0xe7, 0x95, 0xd2, 0x60, 0xdb, 0x19, 0x18,
+	0x32, 0x48, 0xb8, 0x65, 0x76, 0xb2, 0x98, 0x84, 0x33, 0x71, 0x22, 0x58, 0xa4, 0x04, 0xc4, 0x96,
+	0x6d, 0x65, 0xb0, 0xd0, 0xfb, 0xc2, 0x99, 0x42, 0x05, 0xd2, 0xba, 0x6e, 0xff, 0x2a, 0x91, 0xcd,
+	0x0f, 0xa6, 0xc1, 0x8e, 0x8a, 0x14, 0xa7, 0xaf, 0x49, 0x31, 0x89, 0x64, 0x34, 0x44, 0xcf, 0x6d,
+	0xba, 0xad, 0xca, 0xbe, 0x1f, 0xa4, 0x37, 0x1c, 0x1c, 0x69, 0xea, 0x60, 0xe3, 0xe2, 0x4f, 0xc3,
+	0x69, 0x5b, 0x0d, 0x7d, 0x45, 0x8a, 0xfa, 0xcc, 0xe8, 0xdd, 0x68, 0x16, 0x5a, 0x95, 0xfd, 0x07,
+	0x59, 0xea, 0xce, 0x8c, 0x9a, 0x8b, 0x8d, 0x84, 0xbe, 0x25, 0x65, 0xe4, 0x88, 0x02, 0x62, 0xf4,
+	0x0a, 0x5a, 0xde, 0xc8, 0x94, 0x1b, 0xce, 0x1a, 0x5c, 0xc9, 0xe8, 0x1b, 0x52, 0x92, 0x9c, 0x81,
+	0xec, 0xa3, 0xb7, 0xd1, 0x2c, 0xe4, 0x1d, 0xbf, 0xad, 0x31, 0x6b, 0x30, 0x17, 0x51, 0x46, 0xaa,
+	0xfa, 0x30, 0xdd, 0x95, 0x5b, 0x45, 0xef, 0xa6, 0x36, 0xdb, 0xc9, 0xed, 0xa6, 0xb3, 0x2c, 0xb1,
+	0xc6, 0x77, 0x71, 0xad, 0x82, 0xf4, 0x8c, 0xdc, 0x63, 0x10, 0x2b, 0x19, 0x31, 0x75, 0x3d, 0xa7,
+	0xa8, 0x73, 0x76, 0xb3, 0x72, 0xde, 0x59, 0x59, 0x5a, 0x54, 0x9d, 0xa5, 0x15, 0x91, 0x9e, 0x90,
+	0x9a, 0xe9, 0xee, 0x7a, 0x56, 0x49, 0x67, 0x3d, 0xcd, 0xbf, 0xa0, 0xb4, 0xa4, 0xaa, 0x5c, 0x2f,
+	0x21, 0x3d, 0x26, 0x14, 0xba, 0xd8, 0x3d, 0x03, 0x16, 0x29, 0x90, 0x5d, 0xbb, 0x44, 0x65, 0xbd,
+	0x44, 0x8f, 0xb3, 0x42, 0x0e, 0x3b, 0x9f, 0x0c, 0xbf, 0xb2, 0x4d, 0x77, 0x60, 0xf5, 0x33, 0xed,
+	0x93, 0x9a, 0x59, 0xdd, 0xae, 0xde, 0xdd, 0x79, 0x08, 0x7a, 0xb7, 0xf2, 0xe7, 0x72, 0xa8, 0x45,
+	0x9d, 0x99, 0xc6, 0x1a, 0xce, 0xe7, 0x02, 0x6b, 0x15, 0xa4, 0x9f, 0xc9, 0x6d, 0x33, 0xfc, 0x53,
+	0x31, 0x8b, 0x19, 0x7b, 0x44, 0xbb, 0x3f, 0xc9, 0x9d, 0xfa, 0x47, 0xc3, 0xbe, 0x8f, 0x95, 0x1c,
+	0x5b, 0xf3, 0x4d, 0x5c, 0x2a, 0x50, 0x41, 0xea, 0xf6, 0xfe, 0x25, 0x1f, 0x71, 0xb9, 0x34, 0x80,
+	0x8a, 0xb6, 0x7f, 0x96, 0x3f, 0x80, 0xf6, 0x8a, 0xc8, 0x26, 0xd4, 0x64, 0x4a, 0x0d, 0x5f, 0x96,
+	0x7f, 0x9c, 0x37, 0x9c, 0x7f, 0xe7, 0x0d, 0xe7, 0xe0, 0xeb, 0xc5, 0xc4, 0x77, 0x2f, 0x27, 0xbe,
+	0xfb, 0x77, 0xe2, 0xbb, 0x3f, 0xa7, 0xbe, 0x73, 0x39, 0xf5, 0x9d, 0xdf, 0x53, 0xdf, 0x21, 0xf7,
+	0x05, 0x64, 0x04, 0x1e, 0xb9, 0xc7, 0x2f, 0x06, 0x42, 0x9d, 0x7e, 0xeb, 0x05, 0x0c, 0x86, 0xe1,
+	0x02, 0xda, 0x15, 0xb0, 0xf4, 0x2f, 0xfc, 0xbe, 0x78, 0x52, 0xd4, 0x38, 0xe1, 0xd8, 0x2b, 0xea,
+	0xa7, 0xe4, 0xf9, 0xff, 0x01, 0x00, 0x42, 0x45, 0x7e, 0x83, 0x41, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -133,6 +140,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecordReverifications) > 0 {
+		for iNdEx := len(m.RecordReverifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordReverifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ScopeHistory) > 0 {
+		for iNdEx := len(m.ScopeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.ObjectStoreLocators) > 0 {
 		for iNdEx := len(m.ObjectStoreLocators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -317,6 +352,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScopeHistory) > 0 {
+		for _, e := range m.ScopeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecordReverifications) > 0 {
+		for _, e := range m.RecordReverifications {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -659,6 +706,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeHistory = append(m.ScopeHistory, ScopeHistoryEntry{})
+			if err := m.ScopeHistory[len(m.ScopeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordReverifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordReverifications = append(m.RecordReverifications, RecordReverification{})
+			if err := m.RecordReverifications[len(m.RecordReverifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
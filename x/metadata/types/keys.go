@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -42,6 +45,12 @@ const (
 //
 // - 0x21<owner_address>: ObjectStoreLocator
 //
+// - 0x23<scope_id><sequence>: ScopeHistoryEntry
+//
+// - 0x24<record_id><requester_address>: RecordReverification
+//
+// - 0x25<record_id><chunk_index>: a chunk of the payload of a large Record
+//
 // These keys are used for indexing and more specific iteration.
 // These keys are handled using the stuff in this file.
 // The "..._address" parts are all bytes of an Account Address.
@@ -58,6 +67,8 @@ const (
 // - 0x14<contract_spec_id><scope_spec_id>: 0x01
 //
 // - 0x20<owner_address><contract_spec_id>: 0x01
+//
+// - 0x22<party_address><party_type><scope_id>: 0x01
 var (
 	// ScopeKeyPrefix is the key for scope records in metadata store
 	ScopeKeyPrefix = []byte{0x00}
@@ -88,6 +99,18 @@ var (
 
 	// OSLocatorAddressKeyPrefix is the key for OSLocator Record by address
 	OSLocatorAddressKeyPrefix = []byte{0x21}
+
+	// PartyScopeCacheKeyPrefix for scope lookup by owner party address and role
+	PartyScopeCacheKeyPrefix = []byte{0x22}
+
+	// ScopeHistoryKeyPrefix is the key for the audit trail entries of scopes
+	ScopeHistoryKeyPrefix = []byte{0x23}
+
+	// RecordReverificationKeyPrefix is the key for the re-verification requests of records
+	RecordReverificationKeyPrefix = []byte{0x24}
+
+	// RecordChunkKeyPrefix is the key for the chunks of the payloads of large records
+	RecordChunkKeyPrefix = []byte{0x25}
 )
 
 // GetAddressScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
@@ -100,6 +123,37 @@ func GetAddressScopeCacheKey(addr sdk.AccAddress, scopeID MetadataAddress) []byt
 	return append(GetAddressScopeCacheIteratorPrefix(addr), scopeID.Bytes()...)
 }
 
+// GetPartyScopeCacheIteratorPrefix returns an iterator prefix for all party scope cache entries for a given address
+func GetPartyScopeCacheIteratorPrefix(addr sdk.AccAddress) []byte {
+	return append(PartyScopeCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GetPartyRoleScopeCacheIteratorPrefix returns an iterator prefix for all party scope cache entries for a given
+// address and role
+func GetPartyRoleScopeCacheIteratorPrefix(addr sdk.AccAddress, role PartyType) []byte {
+	roleBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(roleBytes, uint32(role))
+	return append(GetPartyScopeCacheIteratorPrefix(addr), roleBytes...)
+}
+
+// GetPartyScopeCacheKey returns the store key for a party scope cache entry
+func GetPartyScopeCacheKey(addr sdk.AccAddress, role PartyType, scopeID MetadataAddress) []byte {
+	return append(GetPartyRoleScopeCacheIteratorPrefix(addr, role), scopeID.Bytes()...)
+}
+
+// SplitPartyScopeCacheKey returns the role and scope id from a party scope cache key with the address prefix removed
+func SplitPartyScopeCacheKey(key []byte) (PartyType, MetadataAddress, error) {
+	if len(key) < 4 {
+		return PartyType_PARTY_TYPE_UNSPECIFIED, nil, fmt.Errorf("invalid party scope cache key length %d", len(key))
+	}
+	role := PartyType(binary.BigEndian.Uint32(key[:4]))
+	var scopeID MetadataAddress
+	if err := scopeID.Unmarshal(key[4:]); err != nil {
+		return role, nil, err
+	}
+	return role, scopeID, nil
+}
+
 // GetScopeSpecScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
 func GetScopeSpecScopeCacheIteratorPrefix(scopeSpecID MetadataAddress) []byte {
 	return append(ScopeSpecScopeCacheKeyPrefix, scopeSpecID.Bytes()...)
@@ -154,3 +208,44 @@ func GetAddressContractSpecCacheKey(addr sdk.AccAddress, contractSpecID Metadata
 func GetOSLocatorKey(addr sdk.AccAddress) []byte {
 	return append(OSLocatorAddressKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
 }
+
+// GetScopeHistoryIteratorPrefix returns an iterator prefix for all audit trail entries of a scope
+func GetScopeHistoryIteratorPrefix(scopeID MetadataAddress) []byte {
+	return append(ScopeHistoryKeyPrefix, scopeID.Bytes()...)
+}
+
+// GetScopeHistoryKey returns the store key for an audit trail entry of a scope
+func GetScopeHistoryKey(scopeID MetadataAddress, sequence uint64) []byte {
+	return append(GetScopeHistoryIteratorPrefix(scopeID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetRecordReverificationIteratorPrefix returns an iterator prefix for all re-verification requests of a record.
+// If the id is a scope (or session) id, the prefix is for the requests of all records in that scope.
+func GetRecordReverificationIteratorPrefix(id MetadataAddress) ([]byte, error) {
+	if id.IsRecordAddress() {
+		return append(RecordReverificationKeyPrefix, id.Bytes()...), nil
+	}
+	recordPrefix, err := id.ScopeRecordIteratorPrefix()
+	if err != nil {
+		return nil, err
+	}
+	return append(RecordReverificationKeyPrefix, recordPrefix...), nil
+}
+
+// GetRecordReverificationKey returns the store key for a re-verification request of a record
+func GetRecordReverificationKey(recordID MetadataAddress, requester sdk.AccAddress) []byte {
+	key := append(RecordReverificationKeyPrefix, recordID.Bytes()...)
+	return append(key, address.MustLengthPrefix(requester.Bytes())...)
+}
+
+// GetRecordChunkIteratorPrefix returns an iterator prefix for all chunks of the payload of a record
+func GetRecordChunkIteratorPrefix(recordID MetadataAddress) []byte {
+	return append(RecordChunkKeyPrefix, recordID.Bytes()...)
+}
+
+// GetRecordChunkKey returns the store key for a chunk of the payload of a record
+func GetRecordChunkKey(recordID MetadataAddress, index uint32) []byte {
+	indexBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(indexBytes, index)
+	return append(GetRecordChunkIteratorPrefix(recordID), indexBytes...)
+}
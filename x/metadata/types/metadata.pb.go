@@ -25,6 +25,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the set of params for the metadata module.
 type Params struct {
+	// validate_cross_scope_record_inputs indicates that record inputs referencing a record in another scope must
+	// exist and provide a record_hash matching one of the referenced record's outputs.
+	ValidateCrossScopeRecordInputs bool `protobuf:"varint,1,opt,name=validate_cross_scope_record_inputs,json=validateCrossScopeRecordInputs,proto3" json:"validate_cross_scope_record_inputs,omitempty" yaml:"validate_cross_scope_record_inputs"`
+	// max_scope_history_entries is the number of entries kept in the audit trail of each scope.  The oldest entries are
+	// pruned as new ones are added, and no audit trail is kept when zero.
+	MaxScopeHistoryEntries uint32 `protobuf:"varint,2,opt,name=max_scope_history_entries,json=maxScopeHistoryEntries,proto3" json:"max_scope_history_entries,omitempty" yaml:"max_scope_history_entries"`
+	// strict_scope_specifications indicates that all scope specifications are enforced strictly, as if their strict
+	// flag were set.
+	StrictScopeSpecifications bool `protobuf:"varint,3,opt,name=strict_scope_specifications,json=strictScopeSpecifications,proto3" json:"strict_scope_specifications,omitempty" yaml:"strict_scope_specifications"`
+	// large_record_threshold is the serialized size in bytes above which a record is compressed with zstd and
+	// split across multiple store entries, reassembled transparently when read.  Zero stores all records whole.
+	LargeRecordThreshold uint32 `protobuf:"varint,4,opt,name=large_record_threshold,json=largeRecordThreshold,proto3" json:"large_record_threshold,omitempty" yaml:"large_record_threshold"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +71,34 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetValidateCrossScopeRecordInputs() bool {
+	if m != nil {
+		return m.ValidateCrossScopeRecordInputs
+	}
+	return false
+}
+
+func (m *Params) GetMaxScopeHistoryEntries() uint32 {
+	if m != nil {
+		return m.MaxScopeHistoryEntries
+	}
+	return 0
+}
+
+func (m *Params) GetStrictScopeSpecifications() bool {
+	if m != nil {
+		return m.StrictScopeSpecifications
+	}
+	return false
+}
+
+func (m *Params) GetLargeRecordThreshold() uint32 {
+	if m != nil {
+		return m.LargeRecordThreshold
+	}
+	return 0
+}
+
 // ScopeIdInfo contains various info regarding a scope id.
 type ScopeIdInfo struct {
 	// scope_id is the raw bytes of the scope address.
@@ -560,70 +600,80 @@ func init() {
 }
 
 var fileDescriptor_786fb0ab3f663d79 = []byte{
-	// 994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x5e, 0x37, 0x21, 0x6d, 0x5e, 0x7e, 0xec, 0xc6, 0xdd, 0x4d, 0xb6, 0x69, 0xea, 0x49, 0xa7,
-	0x54, 0x5a, 0x85, 0xb2, 0x4b, 0x4b, 0x25, 0xa4, 0xdc, 0x08, 0xaa, 0x94, 0xaa, 0x2a, 0x8a, 0xbc,
-	0x02, 0x09, 0x84, 0x14, 0xb9, 0xb6, 0x93, 0x58, 0xb0, 0xeb, 0x95, 0xbd, 0x1b, 0xb5, 0xe2, 0xc0,
-	0xbf, 0xc0, 0x91, 0x63, 0xef, 0x9c, 0xf8, 0x2f, 0x7a, 0xac, 0xc4, 0x05, 0x71, 0x18, 0x41, 0xc2,
-	0x81, 0xf3, 0xfc, 0x05, 0xc8, 0x33, 0x63, 0xcf, 0x1b, 0xff, 0xb8, 0x71, 0xf3, 0x8c, 0xbf, 0xf7,
-	0x7d, 0xf6, 0xfb, 0x3e, 0xbf, 0xd9, 0x85, 0x87, 0xb3, 0x24, 0xbe, 0x0c, 0xa7, 0xde, 0xd4, 0x0f,
-	0x47, 0x93, 0x70, 0xee, 0x05, 0xde, 0xdc, 0x1b, 0x5d, 0x3e, 0x2e, 0xae, 0x87, 0xb3, 0x24, 0x9e,
-	0xc7, 0xf6, 0xb6, 0x86, 0x0d, 0x8b, 0x5b, 0x97, 0x8f, 0x77, 0xbb, 0xe7, 0xf1, 0x79, 0x2c, 0x20,
-	0xa3, 0xec, 0x4a, 0xa2, 0xa9, 0x0d, 0x2b, 0x27, 0x5e, 0xe2, 0x4d, 0xd2, 0xc3, 0x5b, 0xbf, 0xbc,
-	0x25, 0xad, 0x7f, 0xdf, 0x12, 0x8b, 0xfe, 0x7e, 0x03, 0xd6, 0xc6, 0x7e, 0x3c, 0x0b, 0x9f, 0x07,
-	0xcf, 0xa7, 0x67, 0xb1, 0xfd, 0x0c, 0x6e, 0xa5, 0xd9, 0xf2, 0x34, 0x0a, 0xfa, 0xd6, 0xbe, 0x35,
-	0x58, 0x3f, 0x3a, 0x78, 0xc7, 0x48, 0xeb, 0x4f, 0x46, 0xda, 0x2f, 0x95, 0xc0, 0xe7, 0x41, 0x90,
-	0x84, 0x69, 0xca, 0x19, 0x69, 0xbf, 0xf1, 0x26, 0x3f, 0x1c, 0xd2, 0xbc, 0x80, 0xba, 0x37, 0x53,
-	0x49, 0x65, 0x1f, 0x41, 0x3b, 0xdf, 0x3d, 0x9d, 0x25, 0xe1, 0x59, 0xf4, 0xba, 0x7f, 0x43, 0xb0,
-	0xed, 0x72, 0x46, 0xb6, 0xcd, 0x32, 0x05, 0xa0, 0xee, 0x86, 0xaa, 0x3e, 0x11, 0x6b, 0xfb, 0x25,
-	0xdc, 0x2e, 0x20, 0xf2, 0x62, 0xb1, 0x88, 0x82, 0xfe, 0x92, 0xe0, 0x71, 0x38, 0x23, 0xbb, 0x25,
-	0x1e, 0x0d, 0xa2, 0x6e, 0x47, 0x71, 0x89, 0x77, 0xfb, 0x6a, 0x11, 0x05, 0xf6, 0x53, 0x00, 0x09,
-	0xf0, 0x82, 0x20, 0xe9, 0x2f, 0xef, 0x5b, 0x83, 0xd5, 0xa3, 0x1e, 0x67, 0x64, 0x0b, 0xb3, 0x64,
-	0xf7, 0xa8, 0xbb, 0x2a, 0x16, 0xd9, 0x7b, 0xea, 0x2a, 0xa1, 0xfd, 0x41, 0x7d, 0x95, 0x94, 0x94,
-	0x55, 0x99, 0x16, 0xfd, 0x6d, 0x19, 0x36, 0xc6, 0x61, 0x9a, 0x46, 0xf1, 0x54, 0xf5, 0xf5, 0x05,
-	0x40, 0x2a, 0x37, 0x74, 0x67, 0x1f, 0x35, 0x77, 0x36, 0xa7, 0x2f, 0x4a, 0x32, 0xfa, 0x9c, 0xd0,
-	0x3e, 0x86, 0x2d, 0x7d, 0xc7, 0xec, 0xef, 0x1e, 0x67, 0xa4, 0x5f, 0x2e, 0x2e, 0x3a, 0xdc, 0x2e,
-	0x38, 0x54, 0x8f, 0xc7, 0xd0, 0x43, 0xb0, 0x4a, 0x97, 0xf7, 0x39, 0x23, 0x7b, 0x15, 0x36, 0xfc,
-	0xd2, 0x76, 0xc1, 0xa8, 0x3b, 0xfd, 0x0d, 0xec, 0x60, 0xb4, 0xba, 0x14, 0xb4, 0xcb, 0x82, 0x96,
-	0x72, 0x46, 0x9c, 0x2a, 0x2d, 0x02, 0x52, 0xb7, 0xab, 0x89, 0xe5, 0x85, 0xa0, 0x3e, 0x84, 0xf5,
-	0x1c, 0x26, 0x6c, 0x94, 0x86, 0xec, 0x70, 0x46, 0x6e, 0x9b, 0x7c, 0xd2, 0xc8, 0x35, 0xb5, 0x14,
-	0x56, 0xa2, 0x5a, 0xf1, 0x2c, 0x2b, 0x4d, 0xb5, 0xf2, 0x01, 0xf2, 0x5a, 0xa1, 0xeb, 0xc1, 0x46,
-	0x11, 0xb3, 0x68, 0x7a, 0x16, 0xf7, 0x6f, 0xee, 0x5b, 0x83, 0xb5, 0x27, 0x0f, 0x86, 0xf5, 0x1f,
-	0xe0, 0x10, 0x7d, 0x52, 0x47, 0x7d, 0xce, 0x48, 0xb7, 0x14, 0xd5, 0x8c, 0x23, 0x93, 0xd0, 0x30,
-	0x7a, 0xb5, 0x04, 0xeb, 0x6e, 0xe8, 0xc7, 0x49, 0xa0, 0x22, 0x73, 0x0c, 0xab, 0x89, 0x58, 0xeb,
-	0xc4, 0x7c, 0xd4, 0x9c, 0x98, 0x8e, 0x54, 0x28, 0x2a, 0xa8, 0x7b, 0x2b, 0x51, 0x6c, 0xf6, 0x33,
-	0xe8, 0x14, 0xfb, 0x66, 0x5c, 0xee, 0x72, 0x46, 0x76, 0x4a, 0x95, 0x45, 0x5a, 0x36, 0x73, 0x02,
-	0x15, 0x96, 0x13, 0xe8, 0x6a, 0x50, 0x25, 0x2b, 0x84, 0x33, 0x72, 0xb7, 0x4c, 0x85, 0xa3, 0xb2,
-	0x95, 0xd3, 0xe9, 0xa4, 0x8c, 0xa1, 0xa7, 0xb1, 0x17, 0x5e, 0x7a, 0x11, 0x06, 0xa7, 0x53, 0x6f,
-	0x12, 0xaa, 0x9c, 0xa0, 0xf8, 0xd5, 0xc2, 0xa8, 0x6b, 0xe7, 0x9c, 0xc7, 0x62, 0xf7, 0x4b, 0x6f,
-	0x12, 0xda, 0x9f, 0xc1, 0x9a, 0x42, 0xa3, 0x88, 0x6c, 0x73, 0x46, 0x6c, 0x83, 0x4a, 0x26, 0x04,
-	0xe4, 0x4a, 0x04, 0xa4, 0x62, 0xf2, 0xca, 0xff, 0x6e, 0xf2, 0xaf, 0x4b, 0xd0, 0x16, 0x65, 0xe3,
-	0x59, 0xe8, 0x2b, 0x9f, 0xc7, 0xb9, 0x6c, 0x3a, 0x0b, 0x7d, 0xed, 0xf5, 0xa8, 0xd9, 0x6b, 0x43,
-	0x48, 0x55, 0xe5, 0x42, 0x92, 0x38, 0xf3, 0xca, 0xb8, 0x6d, 0xda, 0x8e, 0xbc, 0xaa, 0x43, 0x51,
-	0x77, 0x0b, 0x71, 0x29, 0xf7, 0x23, 0xb8, 0x67, 0x62, 0xd1, 0x0a, 0xc5, 0x60, 0xc0, 0x19, 0xf9,
-	0xb0, 0x8e, 0xba, 0x04, 0xa7, 0x6e, 0x1f, 0x69, 0x14, 0x3d, 0x11, 0xb1, 0x28, 0x4e, 0x0f, 0x81,
-	0x46, 0xf3, 0xba, 0x72, 0x7a, 0x14, 0x80, 0xfc, 0xf4, 0xc8, 0x38, 0x84, 0x99, 0x26, 0x07, 0x9a,
-	0xde, 0xf5, 0x1c, 0xf2, 0x91, 0x34, 0x87, 0x18, 0xe3, 0xff, 0x2c, 0x81, 0xfd, 0x45, 0x3c, 0x9d,
-	0x27, 0x9e, 0x3f, 0x47, 0x86, 0x7d, 0x07, 0x1d, 0x5f, 0xed, 0x96, 0x3c, 0x7b, 0xd2, 0xec, 0x99,
-	0xfa, 0xca, 0xca, 0x85, 0xd4, 0xdd, 0xf4, 0x0d, 0x85, 0x6c, 0x7a, 0x96, 0x41, 0xa6, 0x79, 0x68,
-	0x7a, 0x36, 0x00, 0xa9, 0xdb, 0x35, 0x49, 0x95, 0x85, 0x3f, 0xc2, 0x83, 0x4a, 0x85, 0xb9, 0x81,
-	0x8c, 0x1c, 0x72, 0x46, 0x0e, 0x1a, 0x64, 0xaa, 0x45, 0xd4, 0x75, 0x4c, 0x49, 0xdc, 0x37, 0x61,
-	0xea, 0x0b, 0xb0, 0xcd, 0x32, 0xe4, 0xeb, 0x3d, 0xce, 0xc8, 0x9d, 0x3a, 0x2d, 0x69, 0x6d, 0x07,
-	0x53, 0x0b, 0x77, 0x2b, 0x64, 0xc8, 0xe0, 0x46, 0x32, 0xf5, 0xcb, 0xc0, 0x2f, 0x3d, 0x19, 0xfd,
-	0x7b, 0x19, 0x3a, 0x72, 0xf2, 0x22, 0x93, 0xbf, 0x06, 0x35, 0xfe, 0x4a, 0x16, 0x7f, 0xd2, 0x6c,
-	0x71, 0xcf, 0x98, 0x2f, 0x85, 0xc1, 0xeb, 0x09, 0xe2, 0x46, 0x23, 0xaf, 0xd6, 0xdc, 0xea, 0xc8,
-	0x2b, 0x5b, 0x6b, 0x63, 0x3a, 0x65, 0xec, 0x02, 0xee, 0x97, 0xd0, 0x8d, 0xb6, 0x3e, 0xe2, 0x8c,
-	0x0c, 0x6a, 0x05, 0xea, 0x9a, 0xb5, 0x87, 0xc5, 0x2a, 0x96, 0x7a, 0xb0, 0x5b, 0xe2, 0xa8, 0xce,
-	0xf0, 0x87, 0x9c, 0x91, 0xfb, 0xb5, 0x7a, 0xc6, 0x20, 0xdf, 0xc6, 0x42, 0x68, 0x98, 0xeb, 0xa3,
-	0x4b, 0x67, 0x46, 0xda, 0x5c, 0x3d, 0xba, 0x50, 0x62, 0x36, 0x35, 0x9d, 0xc8, 0xcb, 0x4f, 0xd0,
-	0xab, 0x84, 0x18, 0x8d, 0xf8, 0x83, 0xa6, 0x11, 0x5f, 0xfd, 0xfa, 0xb1, 0x43, 0xb5, 0x94, 0xd4,
-	0xb5, 0xfd, 0x6a, 0xd5, 0xf7, 0xef, 0xae, 0x1c, 0xeb, 0xfd, 0x95, 0x63, 0xfd, 0x75, 0xe5, 0x58,
-	0x3f, 0x5f, 0x3b, 0xad, 0xf7, 0xd7, 0x4e, 0xeb, 0x8f, 0x6b, 0xa7, 0x05, 0x77, 0xa2, 0xb8, 0x41,
-	0xfd, 0xc4, 0xfa, 0xf6, 0xe9, 0x79, 0x34, 0xbf, 0x58, 0xbc, 0x1a, 0xfa, 0xf1, 0x64, 0xa4, 0x41,
-	0x1f, 0x47, 0x31, 0x5a, 0x8d, 0x5e, 0xeb, 0xbf, 0x08, 0xf3, 0x37, 0xb3, 0x30, 0x7d, 0xb5, 0x22,
-	0x7e, 0xef, 0x7f, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18, 0x3b, 0xb8, 0x76, 0x46, 0x0c,
-	0x00, 0x00,
+	// 1162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0x8e, 0x9b, 0x90, 0x26, 0x6f, 0xec, 0xc4, 0xd9, 0x3a, 0x8e, 0xf3, 0xe5, 0x49, 0xa6, 0x2d,
+	0x0a, 0xa1, 0xb5, 0x69, 0xa9, 0x84, 0x94, 0x1b, 0xae, 0x22, 0x25, 0xaa, 0x8a, 0xa2, 0x35, 0x1f,
+	0x02, 0x21, 0x59, 0xd3, 0xdd, 0x4d, 0xbc, 0x22, 0xf6, 0x5a, 0x3b, 0xeb, 0x28, 0x11, 0x07, 0xfe,
+	0x02, 0x47, 0x8e, 0xbd, 0x73, 0x40, 0xfc, 0x8b, 0x1e, 0x2b, 0x71, 0x41, 0x1c, 0x46, 0x90, 0x70,
+	0xe0, 0x3c, 0xbf, 0x00, 0xed, 0xcc, 0xec, 0xee, 0xec, 0x17, 0xe2, 0xd0, 0xdb, 0x7c, 0x3c, 0xef,
+	0xf3, 0xcc, 0xbc, 0xcf, 0xbb, 0xef, 0xd8, 0xf0, 0x70, 0xe2, 0x7b, 0x97, 0xce, 0x98, 0x8c, 0x2d,
+	0xa7, 0x3b, 0x72, 0x02, 0x62, 0x93, 0x80, 0x74, 0x2f, 0x9f, 0xc4, 0xe3, 0xce, 0xc4, 0xf7, 0x02,
+	0xcf, 0x68, 0x26, 0xb0, 0x4e, 0xbc, 0x75, 0xf9, 0x64, 0xb3, 0x71, 0xee, 0x9d, 0x7b, 0x02, 0xd2,
+	0x0d, 0x47, 0x12, 0x8d, 0x7f, 0x99, 0x85, 0xf9, 0x53, 0xe2, 0x93, 0x11, 0x35, 0xae, 0x01, 0x5f,
+	0x92, 0x0b, 0xd7, 0x26, 0x81, 0x33, 0xb0, 0x7c, 0x8f, 0xd2, 0x01, 0xb5, 0xbc, 0x89, 0x33, 0xf0,
+	0x1d, 0xcb, 0xf3, 0xed, 0x81, 0x3b, 0x9e, 0x4c, 0x03, 0xda, 0xaa, 0xec, 0x56, 0xf6, 0x17, 0x7a,
+	0x8f, 0x39, 0x43, 0x1f, 0x5c, 0x93, 0xd1, 0xc5, 0xe1, 0xff, 0x88, 0xc1, 0x66, 0x3b, 0x02, 0x3d,
+	0x0f, 0x31, 0xfd, 0x10, 0x62, 0x0a, 0xc4, 0x89, 0x00, 0x18, 0x03, 0xd8, 0x18, 0x91, 0x2b, 0x15,
+	0x3b, 0x74, 0x69, 0xe0, 0xf9, 0xd7, 0x03, 0x67, 0x1c, 0xf8, 0xae, 0x43, 0x5b, 0x77, 0x76, 0x2b,
+	0xfb, 0xb5, 0xde, 0x03, 0xce, 0xd0, 0xae, 0x54, 0x2c, 0x85, 0x62, 0xb3, 0x39, 0x22, 0x57, 0x82,
+	0xfe, 0x58, 0xee, 0x1c, 0xc9, 0x0d, 0xe3, 0x0c, 0xb6, 0x68, 0xe0, 0xbb, 0x56, 0xa0, 0x02, 0xe9,
+	0xc4, 0xb1, 0xdc, 0x33, 0xd7, 0x22, 0x81, 0xeb, 0x8d, 0x69, 0x6b, 0x56, 0x5c, 0xea, 0x7d, 0xce,
+	0x10, 0x96, 0x12, 0xff, 0x01, 0xc6, 0xe6, 0x86, 0xdc, 0x15, 0x3a, 0xfd, 0xd4, 0x9e, 0xf1, 0x15,
+	0x34, 0x2f, 0x88, 0x7f, 0x1e, 0x27, 0x20, 0x18, 0xfa, 0x0e, 0x1d, 0x7a, 0x17, 0x76, 0x6b, 0x4e,
+	0xdc, 0x62, 0x8f, 0x33, 0xb4, 0x23, 0x25, 0x8a, 0x71, 0xd8, 0x6c, 0x88, 0x0d, 0x99, 0x9e, 0xcf,
+	0xa3, 0xe5, 0xc3, 0x85, 0x9f, 0x5e, 0xa3, 0x99, 0x7f, 0x5e, 0xa3, 0x0a, 0xfe, 0xed, 0x0e, 0x2c,
+	0x09, 0xe9, 0x13, 0xfb, 0x64, 0x7c, 0xe6, 0x19, 0x47, 0xb0, 0x20, 0x8f, 0xe9, 0xda, 0xc2, 0x9c,
+	0x6a, 0xef, 0xe0, 0x0d, 0x43, 0x33, 0x7f, 0x30, 0xb4, 0xf2, 0x52, 0xd9, 0xff, 0xa9, 0x6d, 0xfb,
+	0x0e, 0xa5, 0x9c, 0xa1, 0x15, 0x75, 0x3d, 0x15, 0x80, 0xcd, 0xbb, 0x54, 0x52, 0x19, 0x3d, 0x58,
+	0x89, 0x56, 0x07, 0x13, 0xdf, 0x39, 0x73, 0xaf, 0x44, 0xe2, 0xab, 0xbd, 0x4d, 0xce, 0x50, 0x33,
+	0x1d, 0xa6, 0x00, 0xd8, 0xac, 0xa9, 0xe8, 0x53, 0x31, 0x37, 0x5e, 0xc2, 0xbd, 0x18, 0x22, 0x07,
+	0xd3, 0xa9, 0x6b, 0x8b, 0xec, 0x56, 0x7b, 0x6d, 0xce, 0xd0, 0x66, 0x86, 0x27, 0x01, 0x61, 0xb3,
+	0xae, 0xb8, 0xc4, 0xdd, 0xbe, 0x98, 0xba, 0xb6, 0xf1, 0x0c, 0x40, 0x02, 0x88, 0x6d, 0xfb, 0x22,
+	0x81, 0x8b, 0xbd, 0x35, 0xce, 0xd0, 0xaa, 0xce, 0x12, 0xee, 0x61, 0x73, 0x51, 0x4c, 0xc2, 0x7b,
+	0x26, 0x51, 0x42, 0xfb, 0xbd, 0xe2, 0x28, 0x29, 0xb9, 0x48, 0x23, 0x2d, 0xfc, 0xeb, 0x1c, 0xd4,
+	0xfa, 0x0e, 0xa5, 0xae, 0x37, 0x56, 0x79, 0x7d, 0x01, 0x40, 0xe5, 0x42, 0x92, 0xd9, 0x47, 0xe5,
+	0x99, 0x8d, 0xe8, 0xe3, 0x90, 0x90, 0x3e, 0x22, 0x34, 0x8e, 0x61, 0x35, 0xd9, 0x49, 0xe7, 0x77,
+	0x9b, 0x33, 0xd4, 0xca, 0x06, 0xc7, 0x19, 0x5e, 0x89, 0x39, 0x54, 0x8e, 0xfb, 0xb0, 0xa6, 0xc1,
+	0x72, 0x59, 0xde, 0xe5, 0x0c, 0x6d, 0xe7, 0xd8, 0xf4, 0x4b, 0x1b, 0x31, 0x63, 0x92, 0xe9, 0xaf,
+	0x61, 0x5d, 0x47, 0xab, 0xa1, 0xa0, 0x9d, 0x13, 0xb4, 0x98, 0x33, 0xd4, 0xce, 0xd3, 0x6a, 0x40,
+	0x6c, 0x36, 0x12, 0x62, 0x39, 0x10, 0xd4, 0x87, 0x50, 0x8d, 0x60, 0xc2, 0x46, 0x69, 0xc8, 0x3a,
+	0x67, 0xe8, 0x5e, 0x9a, 0x4f, 0x1a, 0xb9, 0xa4, 0xa6, 0xc2, 0x4a, 0x2d, 0x56, 0x9c, 0x65, 0xbe,
+	0x2c, 0x56, 0x1e, 0x60, 0x89, 0x6a, 0xba, 0x04, 0x6a, 0x71, 0x99, 0xb9, 0xe3, 0x33, 0xaf, 0x75,
+	0x77, 0xb7, 0xb2, 0xbf, 0xf4, 0xf4, 0x7e, 0xa7, 0xb8, 0x3d, 0x76, 0xb4, 0x4f, 0xaa, 0xd7, 0xe2,
+	0x0c, 0x35, 0x32, 0xa5, 0x1a, 0x72, 0x84, 0x12, 0x09, 0x0c, 0xdf, 0xcc, 0x42, 0x55, 0xb5, 0x31,
+	0x59, 0x32, 0xc7, 0xb0, 0x18, 0x35, 0xbe, 0xa8, 0x62, 0x3e, 0x2c, 0xaf, 0x98, 0xba, 0x54, 0x88,
+	0x23, 0xb0, 0xb9, 0xe0, 0x2b, 0x36, 0xe3, 0x08, 0xea, 0xf1, 0x7a, 0xba, 0x5c, 0xb6, 0x38, 0x43,
+	0xeb, 0x99, 0xc8, 0xb8, 0x5a, 0x96, 0x23, 0x02, 0x55, 0x2c, 0xa7, 0xd0, 0x48, 0x40, 0xb9, 0x5a,
+	0x41, 0x9c, 0xa1, 0xad, 0x2c, 0x95, 0x5e, 0x2a, 0xab, 0x11, 0x5d, 0x52, 0x29, 0x7d, 0x58, 0x4b,
+	0xb0, 0x43, 0x42, 0x87, 0x8e, 0x3d, 0x18, 0x93, 0x91, 0xd3, 0x9a, 0xcb, 0x96, 0x5f, 0x21, 0x0c,
+	0x9b, 0x46, 0xc4, 0x79, 0x2c, 0x56, 0x3f, 0x23, 0x23, 0xc7, 0xf8, 0x04, 0x96, 0x14, 0x5a, 0x2b,
+	0x91, 0x26, 0x67, 0xc8, 0x48, 0x51, 0xc9, 0x0a, 0x01, 0x39, 0x13, 0x05, 0x92, 0x33, 0x79, 0xfe,
+	0x9d, 0x9b, 0xfc, 0xf3, 0x2c, 0xac, 0xc4, 0x9d, 0x5e, 0xf9, 0xdc, 0x87, 0x5a, 0xf2, 0x32, 0x24,
+	0x5e, 0x77, 0xcb, 0xbd, 0x4e, 0x09, 0xa9, 0xa8, 0x48, 0x48, 0x12, 0x87, 0x5e, 0xa5, 0xb6, 0xd3,
+	0xb6, 0x6b, 0x5e, 0x15, 0xa1, 0xb0, 0xb9, 0xaa, 0x71, 0x29, 0xf7, 0x5d, 0xd8, 0x49, 0x63, 0xb5,
+	0x99, 0x56, 0x06, 0xfb, 0x9c, 0xa1, 0x07, 0x45, 0xd4, 0x19, 0x38, 0x36, 0x5b, 0x9a, 0x46, 0x9c,
+	0x13, 0x51, 0x16, 0xf1, 0xeb, 0x21, 0xd0, 0x5a, 0xbf, 0xce, 0xbd, 0x1e, 0x31, 0x20, 0x7a, 0x3d,
+	0x42, 0x0e, 0x61, 0x66, 0x9a, 0x43, 0xeb, 0xde, 0xc5, 0x1c, 0xf2, 0x48, 0x35, 0xaa, 0x9f, 0x03,
+	0xff, 0x3d, 0x0b, 0xc6, 0x73, 0x6f, 0x1c, 0xf8, 0xc4, 0x0a, 0x34, 0xc3, 0xbe, 0x85, 0xba, 0xa5,
+	0x56, 0x33, 0x9e, 0x3d, 0x2d, 0xf7, 0x4c, 0x7d, 0x65, 0xd9, 0x40, 0x6c, 0x2e, 0x5b, 0x29, 0x85,
+	0xb0, 0x7b, 0x66, 0x41, 0x69, 0xf3, 0xb4, 0xee, 0x59, 0x02, 0xc4, 0x66, 0x23, 0x4d, 0xaa, 0x2c,
+	0xfc, 0x1e, 0xee, 0xe7, 0x22, 0xd2, 0x0b, 0x9a, 0x91, 0x1d, 0xce, 0xd0, 0x41, 0x89, 0x4c, 0x3e,
+	0x08, 0x9b, 0xed, 0xb4, 0xa4, 0x9e, 0x37, 0x61, 0xea, 0x0b, 0x30, 0xd2, 0x61, 0x9a, 0xaf, 0x3b,
+	0x9c, 0xa1, 0x8d, 0x22, 0x2d, 0x69, 0x6d, 0x5d, 0xa7, 0x16, 0xee, 0xe6, 0xc8, 0x34, 0x83, 0x4b,
+	0xc9, 0xd4, 0x2f, 0x03, 0x2b, 0x73, 0x32, 0xfc, 0xd7, 0x1c, 0xd4, 0x65, 0xe7, 0xd5, 0x4c, 0xfe,
+	0x12, 0x54, 0xfb, 0xcb, 0x58, 0xfc, 0x51, 0xb9, 0xc5, 0x6b, 0xa9, 0xfe, 0x12, 0x1b, 0x5c, 0xf5,
+	0x35, 0x6e, 0xad, 0xe5, 0x15, 0x9a, 0x9b, 0x6f, 0x79, 0x59, 0x6b, 0x0d, 0x9d, 0x4e, 0x19, 0x3b,
+	0x85, 0xbd, 0x0c, 0xba, 0xd4, 0xd6, 0x47, 0x9c, 0xa1, 0xfd, 0x42, 0x81, 0xa2, 0x64, 0x6d, 0xeb,
+	0x62, 0x39, 0x4b, 0x09, 0x6c, 0x66, 0x38, 0xf2, 0x3d, 0xfc, 0x21, 0x67, 0x68, 0xaf, 0x50, 0x2f,
+	0xd5, 0xc8, 0x9b, 0xba, 0x90, 0xd6, 0xcc, 0x93, 0xa7, 0x2b, 0xa9, 0x19, 0x69, 0x73, 0xfe, 0xe9,
+	0xd2, 0x2a, 0x66, 0x39, 0xa1, 0x13, 0xf5, 0xf2, 0x03, 0xac, 0xe5, 0x8a, 0x58, 0x6b, 0xf1, 0x07,
+	0x65, 0x2d, 0x3e, 0xff, 0xf5, 0xeb, 0x0e, 0x15, 0x52, 0x62, 0xd3, 0xb0, 0xf2, 0x51, 0xdf, 0xbd,
+	0xb9, 0x69, 0x57, 0xde, 0xde, 0xb4, 0x2b, 0x7f, 0xde, 0xb4, 0x2b, 0x3f, 0xde, 0xb6, 0x67, 0xde,
+	0xde, 0xb6, 0x67, 0x7e, 0xbf, 0x6d, 0xcf, 0xc0, 0x86, 0xeb, 0x95, 0xa8, 0x9f, 0x56, 0xbe, 0x79,
+	0x76, 0xee, 0x06, 0xc3, 0xe9, 0xab, 0x8e, 0xe5, 0x8d, 0xba, 0x09, 0xe8, 0xb1, 0xeb, 0x69, 0xb3,
+	0xee, 0x55, 0xf2, 0x07, 0x2e, 0xb8, 0x9e, 0x38, 0xf4, 0xd5, 0xbc, 0xf8, 0x37, 0xf6, 0xf1, 0xbf,
+	0x03, 0x00, 0xcb, 0x48, 0x59, 0x94, 0xe4, 0x0d, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -645,6 +695,18 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.ValidateCrossScopeRecordInputs != that1.ValidateCrossScopeRecordInputs {
+		return false
+	}
+	if this.MaxScopeHistoryEntries != that1.MaxScopeHistoryEntries {
+		return false
+	}
+	if this.StrictScopeSpecifications != that1.StrictScopeSpecifications {
+		return false
+	}
+	if this.LargeRecordThreshold != that1.LargeRecordThreshold {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -667,6 +729,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LargeRecordThreshold != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.LargeRecordThreshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StrictScopeSpecifications {
+		i--
+		if m.StrictScopeSpecifications {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxScopeHistoryEntries != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxScopeHistoryEntries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidateCrossScopeRecordInputs {
+		i--
+		if m.ValidateCrossScopeRecordInputs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1096,6 +1188,18 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.ValidateCrossScopeRecordInputs {
+		n += 2
+	}
+	if m.MaxScopeHistoryEntries != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxScopeHistoryEntries))
+	}
+	if m.StrictScopeSpecifications {
+		n += 2
+	}
+	if m.LargeRecordThreshold != 0 {
+		n += 1 + sovMetadata(uint64(m.LargeRecordThreshold))
+	}
 	return n
 }
 
@@ -1312,6 +1416,84 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidateCrossScopeRecordInputs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidateCrossScopeRecordInputs = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScopeHistoryEntries", wireType)
+			}
+			m.MaxScopeHistoryEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScopeHistoryEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictScopeSpecifications", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictScopeSpecifications = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeRecordThreshold", wireType)
+			}
+			m.LargeRecordThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LargeRecordThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -31,11 +31,15 @@ const (
 	TypeMsgDeleteContractSpecFromScopeSpecRequest = "delete_contract_spec_from_scope_spec_request"
 	TypeMsgWriteRecordSpecificationRequest        = "write_record_specification_request"
 	TypeMsgDeleteRecordSpecificationRequest       = "delete_record_specification_request"
+	TypeMsgAddSpecificationOwnerRequest           = "add_specification_owner_request"
+	TypeMsgDeleteSpecificationOwnerRequest        = "delete_specification_owner_request"
 	TypeMsgWriteP8EContractSpecRequest            = "write_p8e_contract_spec_request"
 	TypeMsgP8eMemorializeContractRequest          = "p8e_memorialize_contract_request"
 	TypeMsgBindOSLocatorRequest                   = "write_os_locator_request"
 	TypeMsgDeleteOSLocatorRequest                 = "delete_os_locator_request"
 	TypeMsgModifyOSLocatorRequest                 = "modify_os_locator_request"
+	TypeMsgRequestReverificationRequest           = "request_reverification_request"
+	TypeMsgResolveReverificationRequest           = "resolve_reverification_request"
 )
 
 // Compile time interface checks.
@@ -57,11 +61,15 @@ var (
 	_ sdk.Msg = &MsgDeleteContractSpecFromScopeSpecRequest{}
 	_ sdk.Msg = &MsgWriteRecordSpecificationRequest{}
 	_ sdk.Msg = &MsgDeleteRecordSpecificationRequest{}
+	_ sdk.Msg = &MsgAddSpecificationOwnerRequest{}
+	_ sdk.Msg = &MsgDeleteSpecificationOwnerRequest{}
 	_ sdk.Msg = &MsgBindOSLocatorRequest{}
 	_ sdk.Msg = &MsgDeleteOSLocatorRequest{}
 	_ sdk.Msg = &MsgModifyOSLocatorRequest{}
 	_ sdk.Msg = &MsgWriteP8EContractSpecRequest{}
 	_ sdk.Msg = &MsgP8EMemorializeContractRequest{}
+	_ sdk.Msg = &MsgRequestReverificationRequest{}
+	_ sdk.Msg = &MsgResolveReverificationRequest{}
 )
 
 // private method to convert an array of strings into an array of Acc Addresses.
@@ -1074,6 +1082,112 @@ func (msg MsgDeleteRecordSpecificationRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgAddSpecificationOwnerRequest  ------------------
+
+// NewMsgAddSpecificationOwnerRequest creates a new msg instance
+func NewMsgAddSpecificationOwnerRequest(specificationID MetadataAddress, owners []string, signers []string) *MsgAddSpecificationOwnerRequest {
+	return &MsgAddSpecificationOwnerRequest{
+		SpecificationId: specificationID,
+		Owners:          owners,
+		Signers:         signers,
+	}
+}
+
+func (msg MsgAddSpecificationOwnerRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgAddSpecificationOwnerRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgAddSpecificationOwnerRequest) Type() string {
+	return TypeMsgAddSpecificationOwnerRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgAddSpecificationOwnerRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgAddSpecificationOwnerRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgAddSpecificationOwnerRequest) ValidateBasic() error {
+	return validateSpecificationOwnerChange(msg.SpecificationId, msg.Owners, msg.Signers)
+}
+
+// ------------------  MsgDeleteSpecificationOwnerRequest  ------------------
+
+// NewMsgDeleteSpecificationOwnerRequest creates a new msg instance
+func NewMsgDeleteSpecificationOwnerRequest(specificationID MetadataAddress, owners []string, signers []string) *MsgDeleteSpecificationOwnerRequest {
+	return &MsgDeleteSpecificationOwnerRequest{
+		SpecificationId: specificationID,
+		Owners:          owners,
+		Signers:         signers,
+	}
+}
+
+func (msg MsgDeleteSpecificationOwnerRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgDeleteSpecificationOwnerRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgDeleteSpecificationOwnerRequest) Type() string {
+	return TypeMsgDeleteSpecificationOwnerRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgDeleteSpecificationOwnerRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgDeleteSpecificationOwnerRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgDeleteSpecificationOwnerRequest) ValidateBasic() error {
+	return validateSpecificationOwnerChange(msg.SpecificationId, msg.Owners, msg.Signers)
+}
+
+// validateSpecificationOwnerChange checks the fields of a msg adding or removing specification owners.
+func validateSpecificationOwnerChange(specificationID MetadataAddress, owners []string, signers []string) error {
+	if !specificationID.IsScopeSpecificationAddress() && !specificationID.IsContractSpecificationAddress() {
+		return fmt.Errorf("address is not a scope or contract specification id: %v", specificationID.String())
+	}
+	if len(owners) < 1 {
+		return fmt.Errorf("at least one owner address is required")
+	}
+	seen := make(map[string]bool)
+	for _, owner := range owners {
+		if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+			return fmt.Errorf("owner address is invalid: %s", owner)
+		}
+		if seen[owner] {
+			return fmt.Errorf("duplicate owner address: %s", owner)
+		}
+		seen[owner] = true
+	}
+	if len(signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
 // ------------------  MsgP8EMemorializeContractRequest  ------------------
 
 // NewMsgP8EMemorializeContractRequest creates a new msg instance
@@ -1244,6 +1358,126 @@ func (msg MsgModifyOSLocatorRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{stringToAccAddress(msg.Locator.Owner)}
 }
 
+// ------------------  MsgRequestReverificationRequest  ------------------
+
+// NewMsgRequestReverificationRequest creates a new msg instance
+func NewMsgRequestReverificationRequest(
+	recordID MetadataAddress,
+	disputedHash, observedHash, reason string,
+	requester string,
+) *MsgRequestReverificationRequest {
+	return &MsgRequestReverificationRequest{
+		RecordId:     recordID,
+		DisputedHash: disputedHash,
+		ObservedHash: observedHash,
+		Reason:       reason,
+		Requester:    requester,
+	}
+}
+
+func (msg MsgRequestReverificationRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgRequestReverificationRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgRequestReverificationRequest) Type() string {
+	return TypeMsgRequestReverificationRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRequestReverificationRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{stringToAccAddress(msg.Requester)}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgRequestReverificationRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgRequestReverificationRequest) ValidateBasic() error {
+	if !msg.RecordId.IsRecordAddress() {
+		return fmt.Errorf("invalid record id: %s", msg.RecordId)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Requester); err != nil {
+		return fmt.Errorf("invalid requester: %w", err)
+	}
+	if len(strings.TrimSpace(msg.ObservedHash)) == 0 {
+		return errors.New("observed hash cannot be empty")
+	}
+	if msg.ObservedHash == msg.DisputedHash {
+		return errors.New("observed hash cannot be the same as the disputed hash")
+	}
+	return nil
+}
+
+// ------------------  MsgResolveReverificationRequest  ------------------
+
+// NewMsgResolveReverificationRequest creates a new msg instance
+func NewMsgResolveReverificationRequest(
+	recordID MetadataAddress,
+	requester string,
+	status ReverificationStatus,
+	note string,
+	signers []string,
+) *MsgResolveReverificationRequest {
+	return &MsgResolveReverificationRequest{
+		RecordId:  recordID,
+		Requester: requester,
+		Status:    status,
+		Note:      note,
+		Signers:   signers,
+	}
+}
+
+func (msg MsgResolveReverificationRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgResolveReverificationRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgResolveReverificationRequest) Type() string {
+	return TypeMsgResolveReverificationRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgResolveReverificationRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgResolveReverificationRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgResolveReverificationRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	if !msg.RecordId.IsRecordAddress() {
+		return fmt.Errorf("invalid record id: %s", msg.RecordId)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Requester); err != nil {
+		return fmt.Errorf("invalid requester: %w", err)
+	}
+	if !msg.Status.IsResolution() {
+		return fmt.Errorf("invalid resolution status: %s", msg.Status)
+	}
+	return nil
+}
+
 // ------------------  SessionIdComponents  ------------------
 
 func (msg *SessionIdComponents) GetSessionAddr() (*MetadataAddress, error) {
@@ -1368,6 +1602,14 @@ func NewMsgDeleteRecordSpecificationResponse() *MsgDeleteRecordSpecificationResp
 	return &MsgDeleteRecordSpecificationResponse{}
 }
 
+func NewMsgAddSpecificationOwnerResponse() *MsgAddSpecificationOwnerResponse {
+	return &MsgAddSpecificationOwnerResponse{}
+}
+
+func NewMsgDeleteSpecificationOwnerResponse() *MsgDeleteSpecificationOwnerResponse {
+	return &MsgDeleteSpecificationOwnerResponse{}
+}
+
 func NewMsgWriteP8EContractSpecResponse(
 	contractSpecID MetadataAddress,
 	recordSpecIDs ...MetadataAddress,
@@ -1411,3 +1653,11 @@ func NewMsgModifyOSLocatorResponse(objectStoreLocator ObjectStoreLocator) *MsgMo
 		Locator: objectStoreLocator,
 	}
 }
+
+func NewMsgRequestReverificationResponse() *MsgRequestReverificationResponse {
+	return &MsgRequestReverificationResponse{}
+}
+
+func NewMsgResolveReverificationResponse() *MsgResolveReverificationResponse {
+	return &MsgResolveReverificationResponse{}
+}
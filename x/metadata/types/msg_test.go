@@ -323,6 +323,69 @@ func TestDeleteScopeOwnerValidateBasic(t *testing.T) {
 	}
 }
 
+func TestSpecificationOwnerValidateBasic(t *testing.T) {
+	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
+	contractSpecID := ContractSpecMetadataAddress(uuid.New())
+	recordSpecID := RecordSpecMetadataAddress(uuid.New(), "recordname")
+	owner := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+
+	cases := map[string]struct {
+		msg      sdk.Msg
+		wantErr  bool
+		errorMsg string
+	}{
+		"should fail to validate basic, incorrect specification id type": {
+			NewMsgAddSpecificationOwnerRequest(recordSpecID, []string{owner}, []string{owner}),
+			true,
+			fmt.Sprintf("address is not a scope or contract specification id: %v", recordSpecID.String()),
+		},
+		"should fail to validate basic, requires at least one owner address": {
+			NewMsgDeleteSpecificationOwnerRequest(scopeSpecID, []string{}, []string{owner}),
+			true,
+			"at least one owner address is required",
+		},
+		"should fail to validate basic, incorrect owner address format": {
+			NewMsgAddSpecificationOwnerRequest(contractSpecID, []string{"notabech32address"}, []string{owner}),
+			true,
+			"owner address is invalid: notabech32address",
+		},
+		"should fail to validate basic, duplicate owner address": {
+			NewMsgDeleteSpecificationOwnerRequest(contractSpecID, []string{owner, owner}, []string{owner}),
+			true,
+			fmt.Sprintf("duplicate owner address: %s", owner),
+		},
+		"should fail to validate basic, requires at least one signer": {
+			NewMsgAddSpecificationOwnerRequest(scopeSpecID, []string{owner}, []string{}),
+			true,
+			"at least one signer is required",
+		},
+		"should successfully validate basic, add to scope specification": {
+			NewMsgAddSpecificationOwnerRequest(scopeSpecID, []string{owner}, []string{owner}),
+			false,
+			"",
+		},
+		"should successfully validate basic, delete from contract specification": {
+			NewMsgDeleteSpecificationOwnerRequest(contractSpecID, []string{owner}, []string{owner}),
+			false,
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgAddContractSpecToScopeSpecRequestValidateBasic(t *testing.T) {
 	contractSpecID := ContractSpecMetadataAddress(uuid.New())
 	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	yaml "gopkg.in/yaml.v2"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -8,25 +10,61 @@ import (
 
 var _ paramtypes.ParamSet = &Params{}
 
+// Default parameter values
+const (
+	DefaultValidateCrossScopeRecordInputs = false
+	DefaultMaxScopeHistoryEntries         = uint32(100)
+	DefaultStrictScopeSpecifications      = false
+	DefaultLargeRecordThreshold           = uint32(64 * 1024)
+)
+
+// Parameter store keys
+var (
+	ParamStoreKeyValidateCrossScopeRecordInputs = []byte("ValidateCrossScopeRecordInputs")
+	ParamStoreKeyMaxScopeHistoryEntries         = []byte("MaxScopeHistoryEntries")
+	ParamStoreKeyStrictScopeSpecifications      = []byte("StrictScopeSpecifications")
+	ParamStoreKeyLargeRecordThreshold           = []byte("LargeRecordThreshold")
+)
+
 // ParamKeyTable for metadata module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new parameter object
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	validateCrossScopeRecordInputs bool,
+	maxScopeHistoryEntries uint32,
+	strictScopeSpecifications bool,
+	largeRecordThreshold uint32,
+) Params {
+	return Params{
+		ValidateCrossScopeRecordInputs: validateCrossScopeRecordInputs,
+		MaxScopeHistoryEntries:         maxScopeHistoryEntries,
+		StrictScopeSpecifications:      strictScopeSpecifications,
+		LargeRecordThreshold:           largeRecordThreshold,
+	}
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // pairs of auth module's parameters.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyValidateCrossScopeRecordInputs, &p.ValidateCrossScopeRecordInputs, validateBoolParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxScopeHistoryEntries, &p.MaxScopeHistoryEntries, validateUint32Param),
+		paramtypes.NewParamSetPair(ParamStoreKeyStrictScopeSpecifications, &p.StrictScopeSpecifications, validateBoolParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyLargeRecordThreshold, &p.LargeRecordThreshold, validateUint32Param),
+	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultValidateCrossScopeRecordInputs,
+		DefaultMaxScopeHistoryEntries,
+		DefaultStrictScopeSpecifications,
+		DefaultLargeRecordThreshold,
+	)
 }
 
 // String implements stringer interface
@@ -34,3 +72,21 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateBoolParam(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateUint32Param(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // -------------- ScopeWrapper --------------
 
 // WrapScope wraps a scope in a ScopeWrapper and populates the _addr and _uuid fields.
@@ -124,3 +129,54 @@ func WrapRecordSpecNotFound(ma MetadataAddress) *RecordSpecificationWrapper {
 		RecordSpecIdInfo: GetRecordSpecIDInfo(ma),
 	}
 }
+
+// -------------- ValidateWrite --------------
+
+var (
+	_ codectypes.UnpackInterfacesMessage = (*ValidateWriteRequest)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*ValidateWriteResponse)(nil)
+)
+
+// NewValidateWriteRequest creates a ValidateWriteRequest for the provided write message.
+func NewValidateWriteRequest(msg sdk.Msg) (*ValidateWriteRequest, error) {
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidateWriteRequest{Msg: msgAny}, nil
+}
+
+// GetWriteMsg returns the cached sdk.Msg of the request, or nil if it has not been unpacked.
+func (r ValidateWriteRequest) GetWriteMsg() sdk.Msg {
+	if r.Msg == nil {
+		return nil
+	}
+	msg, ok := r.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil
+	}
+	return msg
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r ValidateWriteRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if r.Msg == nil {
+		return nil
+	}
+	var msg sdk.Msg
+	return unpacker.UnpackAny(r.Msg, &msg)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r ValidateWriteResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if r.Msg != nil {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(r.Msg, &msg); err != nil {
+			return err
+		}
+	}
+	if r.Request != nil {
+		return r.Request.UnpackInterfaces(unpacker)
+	}
+	return nil
+}
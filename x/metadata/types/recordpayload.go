@@ -0,0 +1,95 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// RecordChunkSize is the largest number of bytes of a compressed record payload stored in a single store entry
+	RecordChunkSize = 32 * 1024
+
+	// MaxRecordPayloadSize is the largest serialized record a compressed payload may decompress to
+	MaxRecordPayloadSize = 64 * 1024 * 1024
+
+	// chunkedRecordMarker is the first byte of the value stored under the key of a chunked record.  A serialized record
+	// never starts with it since field number zero is not a valid protobuf field.
+	chunkedRecordMarker = byte(0x00)
+	// chunkedRecordVersion identifies the layout of a chunked record payload: the serialized record compressed by
+	// recordEncoder as a single zstd frame and split into chunks.  The compressed bytes are part of consensus state,
+	// so any change to the encoder, its options or the compress module version needs a new version.
+	chunkedRecordVersion = byte(0x01)
+	// chunkedRecordHeaderLength is the length of the value stored under the key of a chunked record.
+	chunkedRecordHeaderLength = 6
+)
+
+var (
+	// The encoder options are pinned so the same record always compresses to the same bytes.  The encoder and decoder
+	// are safe for concurrent use with EncodeAll and DecodeAll.
+	recordEncoder, _ = zstd.NewWriter(nil,
+		zstd.WithEncoderLevel(zstd.SpeedDefault),
+		zstd.WithEncoderConcurrency(1),
+		zstd.WithWindowSize(1<<20),
+		zstd.WithEncoderCRC(true),
+		zstd.WithZeroFrames(true),
+	)
+	recordDecoder, _ = zstd.NewReader(nil,
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderMaxMemory(MaxRecordPayloadSize),
+	)
+)
+
+// EncodeRecordPayload prepares a serialized record for storage.  A record no larger than the threshold, or any record
+// when the threshold is zero, is stored whole and returned unchanged with no chunks.  A larger record is compressed
+// with zstd and split into chunks of at most RecordChunkSize bytes, and the returned value is a header identifying
+// the layout and the number of chunks.
+func EncodeRecordPayload(bz []byte, threshold uint32) (value []byte, chunks [][]byte) {
+	if threshold == 0 || len(bz) <= int(threshold) {
+		return bz, nil
+	}
+	compressed := recordEncoder.EncodeAll(bz, nil)
+	for len(compressed) > 0 {
+		n := RecordChunkSize
+		if len(compressed) < n {
+			n = len(compressed)
+		}
+		chunks = append(chunks, compressed[:n])
+		compressed = compressed[n:]
+	}
+	value = make([]byte, chunkedRecordHeaderLength)
+	value[0] = chunkedRecordMarker
+	value[1] = chunkedRecordVersion
+	binary.BigEndian.PutUint32(value[2:], uint32(len(chunks)))
+	return value, chunks
+}
+
+// ParseChunkedRecordHeader returns the number of chunks of a record stored compressed and chunked, and false if the
+// stored value is a whole serialized record.
+func ParseChunkedRecordHeader(value []byte) (chunkCount uint32, chunked bool, err error) {
+	if len(value) == 0 || value[0] != chunkedRecordMarker {
+		return 0, false, nil
+	}
+	if len(value) != chunkedRecordHeaderLength {
+		return 0, true, fmt.Errorf("invalid chunked record header length %d", len(value))
+	}
+	if value[1] != chunkedRecordVersion {
+		return 0, true, fmt.Errorf("unknown chunked record version %d", value[1])
+	}
+	return binary.BigEndian.Uint32(value[2:]), true, nil
+}
+
+// DecodeRecordPayload reassembles and decompresses the chunks of a record stored compressed and chunked into the
+// serialized record.
+func DecodeRecordPayload(chunks [][]byte) ([]byte, error) {
+	var compressed []byte
+	for _, chunk := range chunks {
+		compressed = append(compressed, chunk...)
+	}
+	bz, err := recordDecoder.DecodeAll(compressed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress record payload: %w", err)
+	}
+	return bz, nil
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordPayloadEncoding(t *testing.T) {
+	small := []byte("small record")
+	value, chunks := EncodeRecordPayload(small, 1024)
+	require.Equal(t, small, value, "records within the threshold are stored whole")
+	require.Nil(t, chunks)
+	value, chunks = EncodeRecordPayload(bytes.Repeat(small, 1000), 0)
+	require.Equal(t, bytes.Repeat(small, 1000), value, "records are stored whole when the threshold is zero")
+	require.Nil(t, chunks)
+
+	large := bytes.Repeat(small, 10000)
+	value, chunks = EncodeRecordPayload(large, 1024)
+	chunkCount, chunked, err := ParseChunkedRecordHeader(value)
+	require.NoError(t, err)
+	require.True(t, chunked)
+	require.Equal(t, uint32(len(chunks)), chunkCount)
+	require.Less(t, len(chunks), 2, "repetitive records compress")
+	decoded, err := DecodeRecordPayload(chunks)
+	require.NoError(t, err)
+	require.Equal(t, large, decoded)
+
+	_, chunked, err = ParseChunkedRecordHeader(small)
+	require.NoError(t, err)
+	require.False(t, chunked, "a serialized record is not a chunked record header")
+	_, _, err = ParseChunkedRecordHeader([]byte{0x00, 0x01})
+	require.EqualError(t, err, "invalid chunked record header length 2")
+	_, _, err = ParseChunkedRecordHeader([]byte{0x00, 0x09, 0x00, 0x00, 0x00, 0x01})
+	require.EqualError(t, err, "unknown chunked record version 9")
+	_, err = DecodeRecordPayload([][]byte{[]byte("not zstd")})
+	require.Error(t, err)
+}
+
+// The compressed bytes of a chunked record are part of consensus state, so they must never change for the same record.
+// A failure here means the encoder output changed, e.g. after a compress module upgrade, and needs a new layout version.
+func TestRecordPayloadEncodingGolden(t *testing.T) {
+	value, chunks := EncodeRecordPayload([]byte("provenance record payload, provenance record payload, provenance record payload"), 16)
+	require.Equal(t, "000100000001", hex.EncodeToString(value), "header")
+	require.Len(t, chunks, 1)
+	require.Equal(t,
+		"28b52ffd0400250100b40170726f76656e616e6365207265636f7264207061796c6f61642c200154140426c703dbfd5dfe",
+		hex.EncodeToString(chunks[0]), "compressed payload")
+
+	// Pseudo random bytes do not compress, so they span several chunks.
+	payload := make([]byte, 3*RecordChunkSize)
+	x := uint32(1)
+	for i := range payload {
+		x = x*1103515245 + 12345
+		payload[i] = byte(x >> 16)
+	}
+	value, chunks = EncodeRecordPayload(payload, 1024)
+	require.Equal(t, "000100000004", hex.EncodeToString(value), "header")
+	expected := []struct {
+		length int
+		sha256 string
+	}{
+		{RecordChunkSize, "f846e84f801760a4261b69d104f42be4c0f3a3a162cde04ce621815c6d9e7411"},
+		{RecordChunkSize, "b1d899b444386ad9a737a639a2f3922aecd172993870898da38e1576ac09372d"},
+		{RecordChunkSize, "6bf3515352cb8c34aae1d653cf48deb9b3b6873abe04751074fc5d63215853b8"},
+		{16, "b43b22f8b7d95e98606f12e7f88185d2c1dc644b5c6e089f03cbd9b422ffc12c"},
+	}
+	require.Len(t, chunks, len(expected))
+	for i, chunk := range chunks {
+		hash := sha256.Sum256(chunk)
+		require.Len(t, chunk, expected[i].length, "chunk %d length", i)
+		require.Equal(t, expected[i].sha256, hex.EncodeToString(hash[:]), "chunk %d hash", i)
+	}
+	decoded, err := DecodeRecordPayload(chunks)
+	require.NoError(t, err)
+	require.Equal(t, payload, decoded)
+}
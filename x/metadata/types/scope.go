@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
@@ -356,9 +358,15 @@ func (ri RecordInput) ValidateBasic() error {
 		if ri.Status != RecordInputStatus_Proposed {
 			return fmt.Errorf("hash specifier only applies to proposed inputs")
 		}
+		if len(ri.RecordHash) > 0 {
+			return fmt.Errorf("record hash only applies to record id inputs")
+		}
 		if len(source.Hash) < 1 {
 			return fmt.Errorf("missing required hash for proposed value")
 		}
+		if err := ri.HashAlgorithm.ValidateHash(source.Hash); err != nil {
+			return err
+		}
 	case *RecordInput_RecordId:
 		if ri.Status != RecordInputStatus_Record {
 			return fmt.Errorf("record id must be used with Record type inputs")
@@ -370,6 +378,12 @@ func (ri RecordInput) ValidateBasic() error {
 		if prefix != PrefixRecord {
 			return fmt.Errorf("invalid record id address (found %s, expected record)", prefix)
 		}
+		if ri.HashAlgorithm != HashAlgorithm_HASH_ALGORITHM_UNSPECIFIED && len(ri.RecordHash) == 0 {
+			return fmt.Errorf("hash algorithm requires a record hash for record id inputs")
+		}
+		if err = ri.HashAlgorithm.ValidateHash(ri.RecordHash); err != nil {
+			return fmt.Errorf("invalid record hash: %w", err)
+		}
 	}
 	if len(ri.TypeName) < 1 {
 		return fmt.Errorf("missing type name")
@@ -408,7 +422,7 @@ func (ro RecordOutput) ValidateBasic() error {
 	if len(ro.Hash) < 1 {
 		return fmt.Errorf("missing required hash")
 	}
-	return nil
+	return ro.HashAlgorithm.ValidateHash(ro.Hash)
 }
 
 // String implements stringer interface
@@ -416,6 +430,40 @@ func (ro RecordOutput) String() string {
 	return fmt.Sprintf("%s - %s", ro.Hash, ro.Status)
 }
 
+// DigestSize returns the number of bytes in a digest created by the hash algorithm (or zero if unspecified or unknown)
+func (x HashAlgorithm) DigestSize() int {
+	switch x {
+	case HashAlgorithm_HASH_ALGORITHM_SHA256, HashAlgorithm_HASH_ALGORITHM_BLAKE2B_256:
+		return 32
+	case HashAlgorithm_HASH_ALGORITHM_SHA512, HashAlgorithm_HASH_ALGORITHM_BLAKE2B_512:
+		return 64
+	}
+	return 0
+}
+
+// ValidateHash checks that the hash is a hex or base64 encoded digest of the size created by the hash algorithm.
+// Hashes are not checked when the algorithm is unspecified.
+func (x HashAlgorithm) ValidateHash(hash string) error {
+	if x == HashAlgorithm_HASH_ALGORITHM_UNSPECIFIED {
+		return nil
+	}
+	size := x.DigestSize()
+	if size == 0 {
+		return fmt.Errorf("unknown hash algorithm: %d", x)
+	}
+	if bz, err := hex.DecodeString(hash); err == nil && len(bz) == size {
+		return nil
+	}
+	for _, encoding := range []*base64.Encoding{
+		base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding,
+	} {
+		if bz, err := encoding.DecodeString(hash); err == nil && len(bz) == size {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid %s hash %s: expected a hex or base64 encoded %d byte digest", x, hash, size)
+}
+
 // NewProcess creates a new instance of Process
 func NewProcess(name string, processID isProcess_ProcessId, method string) *Process {
 	return &Process{
@@ -532,3 +580,31 @@ s2Loop:
 	}
 	return true
 }
+
+// ValidateBasic performs static checking of RecordReverification format
+func (r RecordReverification) ValidateBasic() error {
+	if !r.RecordId.IsRecordAddress() {
+		return fmt.Errorf("invalid record reverification record id: %s", r.RecordId)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Requester); err != nil {
+		return fmt.Errorf("invalid record reverification requester: %w", err)
+	}
+	if r.Status == ReverificationStatus_REVERIFICATION_STATUS_UNSPECIFIED {
+		return fmt.Errorf("record reverification status cannot be unspecified")
+	}
+	if _, ok := ReverificationStatus_name[int32(r.Status)]; !ok {
+		return fmt.Errorf("invalid record reverification status: %d", r.Status)
+	}
+	return nil
+}
+
+// IsResolution returns true if the status resolves a re-verification request, i.e. it is neither open nor unspecified.
+func (x ReverificationStatus) IsResolution() bool {
+	switch x {
+	case ReverificationStatus_REVERIFICATION_STATUS_CONFIRMED,
+		ReverificationStatus_REVERIFICATION_STATUS_CORRECTED,
+		ReverificationStatus_REVERIFICATION_STATUS_WITHDRAWN:
+		return true
+	}
+	return false
+}
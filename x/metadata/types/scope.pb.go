@@ -60,6 +60,47 @@ func (RecordInputStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_edeea634bfb18aba, []int{0}
 }
 
+// HashAlgorithm indicates the algorithm used to create a record input or output hash.  The digest of a hash with a
+// specified algorithm must be the size produced by that algorithm, encoded as hex or base64.
+type HashAlgorithm int32
+
+const (
+	// HASH_ALGORITHM_UNSPECIFIED indicates the algorithm is not declared and the digest size is not validated
+	HashAlgorithm_HASH_ALGORITHM_UNSPECIFIED HashAlgorithm = 0
+	// HASH_ALGORITHM_SHA256 indicates a 32 byte SHA-256 digest
+	HashAlgorithm_HASH_ALGORITHM_SHA256 HashAlgorithm = 1
+	// HASH_ALGORITHM_SHA512 indicates a 64 byte SHA-512 digest
+	HashAlgorithm_HASH_ALGORITHM_SHA512 HashAlgorithm = 2
+	// HASH_ALGORITHM_BLAKE2B_256 indicates a 32 byte BLAKE2b-256 digest
+	HashAlgorithm_HASH_ALGORITHM_BLAKE2B_256 HashAlgorithm = 3
+	// HASH_ALGORITHM_BLAKE2B_512 indicates a 64 byte BLAKE2b-512 digest
+	HashAlgorithm_HASH_ALGORITHM_BLAKE2B_512 HashAlgorithm = 4
+)
+
+var HashAlgorithm_name = map[int32]string{
+	0: "HASH_ALGORITHM_UNSPECIFIED",
+	1: "HASH_ALGORITHM_SHA256",
+	2: "HASH_ALGORITHM_SHA512",
+	3: "HASH_ALGORITHM_BLAKE2B_256",
+	4: "HASH_ALGORITHM_BLAKE2B_512",
+}
+
+var HashAlgorithm_value = map[string]int32{
+	"HASH_ALGORITHM_UNSPECIFIED": 0,
+	"HASH_ALGORITHM_SHA256":      1,
+	"HASH_ALGORITHM_SHA512":      2,
+	"HASH_ALGORITHM_BLAKE2B_256": 3,
+	"HASH_ALGORITHM_BLAKE2B_512": 4,
+}
+
+func (x HashAlgorithm) String() string {
+	return proto.EnumName(HashAlgorithm_name, int32(x))
+}
+
+func (HashAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{1}
+}
+
 // ResultStatus indicates the various states of execution of a record
 type ResultStatus int32
 
@@ -93,7 +134,47 @@ func (x ResultStatus) String() string {
 }
 
 func (ResultStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{1}
+	return fileDescriptor_edeea634bfb18aba, []int{2}
+}
+
+// ReverificationStatus is the resolution status of a record re-verification request.
+type ReverificationStatus int32
+
+const (
+	// REVERIFICATION_STATUS_UNSPECIFIED indicates an unset status
+	ReverificationStatus_REVERIFICATION_STATUS_UNSPECIFIED ReverificationStatus = 0
+	// REVERIFICATION_STATUS_OPEN indicates the request is waiting on the scope owners
+	ReverificationStatus_REVERIFICATION_STATUS_OPEN ReverificationStatus = 1
+	// REVERIFICATION_STATUS_CONFIRMED indicates the scope owners re-verified the object and the record is correct
+	ReverificationStatus_REVERIFICATION_STATUS_CONFIRMED ReverificationStatus = 2
+	// REVERIFICATION_STATUS_CORRECTED indicates the scope owners found the mismatch and corrected the record or object
+	ReverificationStatus_REVERIFICATION_STATUS_CORRECTED ReverificationStatus = 3
+	// REVERIFICATION_STATUS_WITHDRAWN indicates the requester withdrew the request
+	ReverificationStatus_REVERIFICATION_STATUS_WITHDRAWN ReverificationStatus = 4
+)
+
+var ReverificationStatus_name = map[int32]string{
+	0: "REVERIFICATION_STATUS_UNSPECIFIED",
+	1: "REVERIFICATION_STATUS_OPEN",
+	2: "REVERIFICATION_STATUS_CONFIRMED",
+	3: "REVERIFICATION_STATUS_CORRECTED",
+	4: "REVERIFICATION_STATUS_WITHDRAWN",
+}
+
+var ReverificationStatus_value = map[string]int32{
+	"REVERIFICATION_STATUS_UNSPECIFIED": 0,
+	"REVERIFICATION_STATUS_OPEN":        1,
+	"REVERIFICATION_STATUS_CONFIRMED":   2,
+	"REVERIFICATION_STATUS_CORRECTED":   3,
+	"REVERIFICATION_STATUS_WITHDRAWN":   4,
+}
+
+func (x ReverificationStatus) String() string {
+	return proto.EnumName(ReverificationStatus_name, int32(x))
+}
+
+func (ReverificationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{3}
 }
 
 // Scope defines a root reference for a collection of records owned by one or more parties.
@@ -165,12 +246,11 @@ func (m *Scope) GetValueOwnerAddress() string {
 	return ""
 }
 
+// A Session is created for an execution context against a specific specification instance
 //
-//A Session is created for an execution context against a specific specification instance
-//
-//The context will have a specification and set of parties involved.  The Session may be updated several
-//times so long as the parties listed are signers on the transaction.  NOTE: When there are no Records within a Scope
-//that reference a Session it is removed.
+// The context will have a specification and set of parties involved.  The Session may be updated several
+// times so long as the parties listed are signers on the transaction.  NOTE: When there are no Records within a Scope
+// that reference a Session it is removed.
 type Session struct {
 	SessionId MetadataAddress `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,customtype=MetadataAddress" json:"session_id" yaml:"session_id"`
 	// unique id of the contract specification that was used to create this session.
@@ -440,6 +520,10 @@ type RecordInput struct {
 	TypeName string `protobuf:"bytes,4,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty" yaml:"type_name"`
 	// Indicates if this input was a recorded fact on chain or just a given hashed input
 	Status RecordInputStatus `protobuf:"varint,5,opt,name=status,proto3,enum=provenance.metadata.v1.RecordInputStatus" json:"status,omitempty"`
+	// the expected hash of an output of the record referenced by record_id (optional, only used with record_id sources)
+	RecordHash string `protobuf:"bytes,6,opt,name=record_hash,json=recordHash,proto3" json:"record_hash,omitempty" yaml:"record_hash"`
+	// the algorithm used to create the hash (or record_hash) of this input (optional)
+	HashAlgorithm HashAlgorithm `protobuf:"varint,7,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=provenance.metadata.v1.HashAlgorithm" json:"hash_algorithm,omitempty" yaml:"hash_algorithm"`
 }
 
 func (m *RecordInput) Reset()      { *m = RecordInput{} }
@@ -525,6 +609,20 @@ func (m *RecordInput) GetStatus() RecordInputStatus {
 	return RecordInputStatus_Unknown
 }
 
+func (m *RecordInput) GetRecordHash() string {
+	if m != nil {
+		return m.RecordHash
+	}
+	return ""
+}
+
+func (m *RecordInput) GetHashAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return HashAlgorithm_HASH_ALGORITHM_UNSPECIFIED
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*RecordInput) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -539,6 +637,8 @@ type RecordOutput struct {
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	// Status of the process execution associated with this output indicating success,failure, or pending
 	Status ResultStatus `protobuf:"varint,2,opt,name=status,proto3,enum=provenance.metadata.v1.ResultStatus" json:"status,omitempty"`
+	// the algorithm used to create the hash of this output (optional)
+	HashAlgorithm HashAlgorithm `protobuf:"varint,3,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=provenance.metadata.v1.HashAlgorithm" json:"hash_algorithm,omitempty" yaml:"hash_algorithm"`
 }
 
 func (m *RecordOutput) Reset()      { *m = RecordOutput{} }
@@ -587,6 +687,13 @@ func (m *RecordOutput) GetStatus() ResultStatus {
 	return ResultStatus_RESULT_STATUS_UNSPECIFIED
 }
 
+func (m *RecordOutput) GetHashAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return HashAlgorithm_HASH_ALGORITHM_UNSPECIFIED
+}
+
 // A Party is an address with/in a given role associated with a contract
 type Party struct {
 	// address of the account (on chain)
@@ -732,9 +839,235 @@ func (m *AuditFields) GetMessage() string {
 	return ""
 }
 
+// ScopeHistoryEntry is an entry in the audit trail of a scope, recording a message that changed the scope or one of its
+// sessions or records.
+type ScopeHistoryEntry struct {
+	// scope_id is the id of the changed scope.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// sequence is the position of the entry in the audit trail of the scope, starting at 1.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// block_height is the height of the block with the change.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	// block_time is the time of the block with the change.
+	BlockTime time.Time `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time" yaml:"block_time"`
+	// tx_hash is the hash of the transaction with the message, empty if the change was not made by a transaction.
+	TxHash string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+	// msg_type_url is the type url of the message that made the change.
+	MsgTypeUrl string `protobuf:"bytes,6,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// signers are the addresses that signed the message.
+	Signers []string `protobuf:"bytes,7,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *ScopeHistoryEntry) Reset()         { *m = ScopeHistoryEntry{} }
+func (m *ScopeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*ScopeHistoryEntry) ProtoMessage()    {}
+func (*ScopeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{8}
+}
+func (m *ScopeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeHistoryEntry.Merge(m, src)
+}
+func (m *ScopeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeHistoryEntry proto.InternalMessageInfo
+
+func (m *ScopeHistoryEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ScopeHistoryEntry) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ScopeHistoryEntry) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *ScopeHistoryEntry) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ScopeHistoryEntry) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *ScopeHistoryEntry) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+// RecordReverification is a request by a data consumer to have the owners of a scope re-verify the off-chain object of
+// a record whose hash does not match the one recorded, along with its resolution.
+type RecordReverification struct {
+	// record_id is the id of the disputed record.
+	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id" yaml:"record_id"`
+	// requester is the address of the data consumer that requested the re-verification.
+	Requester string `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	// disputed_hash is the record output hash that does not match the object, empty if the whole record is disputed.
+	DisputedHash string `protobuf:"bytes,3,opt,name=disputed_hash,json=disputedHash,proto3" json:"disputed_hash,omitempty" yaml:"disputed_hash"`
+	// observed_hash is the hash the requester computed from the off-chain object.
+	ObservedHash string `protobuf:"bytes,4,opt,name=observed_hash,json=observedHash,proto3" json:"observed_hash,omitempty" yaml:"observed_hash"`
+	// reason describes the mismatch.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// status is the resolution status of the request.
+	Status ReverificationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=provenance.metadata.v1.ReverificationStatus" json:"status,omitempty"`
+	// requested_height is the height of the block with the request.
+	RequestedHeight int64 `protobuf:"varint,7,opt,name=requested_height,json=requestedHeight,proto3" json:"requested_height,omitempty" yaml:"requested_height"`
+	// requested_time is the time of the block with the request.
+	RequestedTime time.Time `protobuf:"bytes,8,opt,name=requested_time,json=requestedTime,proto3,stdtime" json:"requested_time" yaml:"requested_time"`
+	// resolution_note describes the resolution, empty while open.
+	ResolutionNote string `protobuf:"bytes,9,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty" yaml:"resolution_note"`
+	// resolved_height is the height of the block with the resolution, zero while open.
+	ResolvedHeight int64 `protobuf:"varint,10,opt,name=resolved_height,json=resolvedHeight,proto3" json:"resolved_height,omitempty" yaml:"resolved_height"`
+	// resolved_by are the addresses that signed the resolution.
+	ResolvedBy []string `protobuf:"bytes,11,rep,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty" yaml:"resolved_by"`
+}
+
+func (m *RecordReverification) Reset()         { *m = RecordReverification{} }
+func (m *RecordReverification) String() string { return proto.CompactTextString(m) }
+func (*RecordReverification) ProtoMessage()    {}
+func (*RecordReverification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{9}
+}
+func (m *RecordReverification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordReverification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordReverification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordReverification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordReverification.Merge(m, src)
+}
+func (m *RecordReverification) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordReverification) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordReverification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordReverification proto.InternalMessageInfo
+
+func (m *RecordReverification) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
+}
+
+func (m *RecordReverification) GetDisputedHash() string {
+	if m != nil {
+		return m.DisputedHash
+	}
+	return ""
+}
+
+func (m *RecordReverification) GetObservedHash() string {
+	if m != nil {
+		return m.ObservedHash
+	}
+	return ""
+}
+
+func (m *RecordReverification) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RecordReverification) GetStatus() ReverificationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ReverificationStatus_REVERIFICATION_STATUS_UNSPECIFIED
+}
+
+func (m *RecordReverification) GetRequestedHeight() int64 {
+	if m != nil {
+		return m.RequestedHeight
+	}
+	return 0
+}
+
+func (m *RecordReverification) GetRequestedTime() time.Time {
+	if m != nil {
+		return m.RequestedTime
+	}
+	return time.Time{}
+}
+
+func (m *RecordReverification) GetResolutionNote() string {
+	if m != nil {
+		return m.ResolutionNote
+	}
+	return ""
+}
+
+func (m *RecordReverification) GetResolvedHeight() int64 {
+	if m != nil {
+		return m.ResolvedHeight
+	}
+	return 0
+}
+
+func (m *RecordReverification) GetResolvedBy() []string {
+	if m != nil {
+		return m.ResolvedBy
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("provenance.metadata.v1.RecordInputStatus", RecordInputStatus_name, RecordInputStatus_value)
+	proto.RegisterEnum("provenance.metadata.v1.HashAlgorithm", HashAlgorithm_name, HashAlgorithm_value)
 	proto.RegisterEnum("provenance.metadata.v1.ResultStatus", ResultStatus_name, ResultStatus_value)
+	proto.RegisterEnum("provenance.metadata.v1.ReverificationStatus", ReverificationStatus_name, ReverificationStatus_value)
 	proto.RegisterType((*Scope)(nil), "provenance.metadata.v1.Scope")
 	proto.RegisterType((*Session)(nil), "provenance.metadata.v1.Session")
 	proto.RegisterType((*Record)(nil), "provenance.metadata.v1.Record")
@@ -743,6 +1076,8 @@ func init() {
 	proto.RegisterType((*RecordOutput)(nil), "provenance.metadata.v1.RecordOutput")
 	proto.RegisterType((*Party)(nil), "provenance.metadata.v1.Party")
 	proto.RegisterType((*AuditFields)(nil), "provenance.metadata.v1.AuditFields")
+	proto.RegisterType((*ScopeHistoryEntry)(nil), "provenance.metadata.v1.ScopeHistoryEntry")
+	proto.RegisterType((*RecordReverification)(nil), "provenance.metadata.v1.RecordReverification")
 }
 
 func init() {
@@ -750,81 +1085,118 @@ func init() {
 }
 
 var fileDescriptor_edeea634bfb18aba = []byte{
-	// 1180 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1a, 0x57,
-	0x10, 0x67, 0x01, 0x83, 0x19, 0x68, 0x8d, 0x5f, 0x2c, 0x42, 0x68, 0xcc, 0xd2, 0x6d, 0xa5, 0xb8,
-	0x6e, 0x0a, 0xb5, 0xfb, 0x4f, 0x4a, 0xff, 0x89, 0xb5, 0xb1, 0x82, 0x92, 0xda, 0x68, 0x31, 0x97,
-	0x4a, 0x2d, 0x5a, 0x76, 0x9f, 0xf1, 0x2a, 0xc0, 0x5b, 0xed, 0x3e, 0x9c, 0xa0, 0xde, 0x2a, 0x55,
-	0x95, 0x7c, 0xca, 0x31, 0x17, 0x4b, 0xed, 0x07, 0xe8, 0xf7, 0xc8, 0x31, 0xc7, 0xaa, 0x87, 0x6d,
-	0x65, 0xdf, 0x7c, 0xe4, 0xd6, 0x5b, 0xb5, 0xef, 0xbd, 0x85, 0x25, 0x06, 0x2b, 0x55, 0x9b, 0xdb,
-	0xce, 0xcc, 0x6f, 0xe6, 0xcd, 0xfc, 0x66, 0xde, 0xbc, 0x05, 0xc5, 0x76, 0xc8, 0x09, 0x1e, 0xe8,
-	0x03, 0x03, 0x57, 0xfa, 0x98, 0xea, 0xa6, 0x4e, 0xf5, 0xca, 0xc9, 0x56, 0xc5, 0x35, 0x88, 0x8d,
-	0xcb, 0xb6, 0x43, 0x28, 0x41, 0xb9, 0x29, 0xa6, 0x1c, 0x60, 0xca, 0x27, 0x5b, 0x85, 0xb5, 0x2e,
-	0xe9, 0x12, 0x06, 0xa9, 0xf8, 0x5f, 0x1c, 0x5d, 0x90, 0xbb, 0x84, 0x74, 0x7b, 0xb8, 0xc2, 0xa4,
-	0xce, 0xf0, 0xa8, 0x42, 0xad, 0x3e, 0x76, 0xa9, 0xde, 0xb7, 0x05, 0xa0, 0xf4, 0x32, 0xc0, 0xc4,
-	0xae, 0xe1, 0x58, 0x36, 0x25, 0x8e, 0x40, 0x6c, 0x2e, 0x4a, 0xca, 0xc6, 0x86, 0x75, 0x64, 0x19,
-	0x3a, 0xb5, 0xc8, 0x80, 0x63, 0x95, 0xbf, 0xa3, 0xb0, 0xd4, 0xf4, 0x93, 0x45, 0x35, 0x58, 0x66,
-	0x59, 0xb7, 0x2d, 0x33, 0x2f, 0x95, 0xa4, 0x8d, 0x8c, 0xba, 0xf9, 0xdc, 0x93, 0x23, 0x7f, 0x78,
-	0xf2, 0xca, 0x37, 0x22, 0x48, 0xd5, 0x34, 0x1d, 0xec, 0xba, 0x63, 0x4f, 0x5e, 0x19, 0xe9, 0xfd,
-	0xde, 0x3d, 0x25, 0x70, 0x50, 0xb4, 0x24, 0xfb, 0xac, 0x9b, 0xe8, 0x3b, 0xc8, 0xce, 0x9c, 0xe3,
-	0x87, 0x8b, 0xb2, 0x70, 0xdb, 0x8b, 0xc3, 0xdd, 0x14, 0xe1, 0x5e, 0x72, 0x54, 0xb4, 0x95, 0x19,
-	0x55, 0xdd, 0x44, 0x9f, 0x43, 0x82, 0x3c, 0x1e, 0x60, 0xc7, 0xcd, 0xc7, 0x4a, 0xb1, 0x8d, 0xf4,
-	0xf6, 0x7a, 0x79, 0x3e, 0xbb, 0xe5, 0x86, 0xee, 0xd0, 0x91, 0x1a, 0xf7, 0xcf, 0xd4, 0x84, 0x0b,
-	0xfa, 0x0c, 0xd2, 0xbe, 0xb9, 0xad, 0x1b, 0x06, 0x76, 0xdd, 0x7c, 0xbc, 0x14, 0xdb, 0x48, 0xa9,
-	0xb9, 0xb1, 0x27, 0x23, 0x7e, 0x7e, 0xc8, 0xa8, 0x68, 0xc0, 0x52, 0x64, 0x02, 0xda, 0x87, 0x1b,
-	0x27, 0x7a, 0x6f, 0x88, 0xdb, 0x2c, 0x50, 0x5b, 0xe7, 0x89, 0xe7, 0x97, 0x4a, 0xd2, 0x46, 0x4a,
-	0x2d, 0x8e, 0x3d, 0xb9, 0xc0, 0x03, 0xcc, 0x01, 0x29, 0xda, 0x2a, 0xd3, 0x1e, 0xf8, 0x4a, 0x51,
-	0xf1, 0xbd, 0xf8, 0xb3, 0x5f, 0xe4, 0x88, 0xf2, 0x2c, 0x06, 0xc9, 0x26, 0x76, 0x5d, 0x8b, 0x0c,
-	0xd0, 0x03, 0x00, 0x97, 0x7f, 0x4e, 0xf9, 0xbf, 0xbb, 0x98, 0xb0, 0x55, 0x41, 0xd8, 0xc4, 0x45,
-	0xd1, 0x52, 0x42, 0x78, 0xfd, 0x3d, 0xf8, 0x12, 0x92, 0xb6, 0xee, 0x50, 0x0b, 0xff, 0xab, 0x26,
-	0x04, 0x3e, 0xe8, 0x7d, 0x88, 0x0f, 0xf4, 0x3e, 0xce, 0xc7, 0x19, 0x7b, 0x37, 0x2f, 0x3d, 0x39,
-	0x4e, 0x47, 0x36, 0x1e, 0x7b, 0x72, 0x9a, 0xa7, 0xe0, 0x4b, 0x8a, 0xc6, 0x40, 0x28, 0x0f, 0x49,
-	0x83, 0x0c, 0x28, 0x7e, 0x42, 0x19, 0xdb, 0x19, 0x2d, 0x10, 0x51, 0x0b, 0x96, 0xf4, 0xa1, 0x69,
-	0xd1, 0xbc, 0x51, 0x92, 0x36, 0xd2, 0xdb, 0xef, 0x2c, 0xca, 0xa1, 0xea, 0x83, 0xf6, 0x2c, 0xdc,
-	0x33, 0x5d, 0xb5, 0x30, 0xf6, 0xe4, 0x1c, 0x3f, 0x84, 0xf9, 0xde, 0x25, 0x7d, 0x8b, 0xe2, 0xbe,
-	0x4d, 0x47, 0x8a, 0xc6, 0xa3, 0x89, 0xd6, 0xfc, 0x16, 0x83, 0x84, 0x86, 0x0d, 0xe2, 0x98, 0xe8,
-	0x8e, 0x48, 0x57, 0x62, 0xe9, 0xde, 0xb8, 0xf4, 0xe4, 0xa8, 0x65, 0x8e, 0x3d, 0x39, 0xc5, 0xe3,
-	0xf8, 0x0c, 0xf1, 0x54, 0x67, 0x5b, 0x18, 0xfd, 0x6f, 0x2d, 0xfc, 0x1a, 0x92, 0xb6, 0x43, 0xd8,
-	0x98, 0xc6, 0x58, 0x7d, 0xf2, 0x42, 0x8e, 0x39, 0x6c, 0xc2, 0x32, 0x17, 0x51, 0x15, 0x12, 0xd6,
-	0xc0, 0x1e, 0x52, 0x3e, 0xe6, 0xd7, 0xf0, 0xc3, 0xcb, 0xac, 0xfb, 0xd8, 0xe0, 0xba, 0x70, 0x47,
-	0xb4, 0x0b, 0x49, 0x32, 0xa4, 0x2c, 0xc6, 0x12, 0x8b, 0xf1, 0xee, 0xf5, 0x31, 0x0e, 0x18, 0x38,
-	0x48, 0x44, 0xb8, 0xce, 0x1d, 0xc6, 0xc4, 0xff, 0x36, 0x8c, 0xa2, 0x5f, 0x3f, 0x40, 0x52, 0xf0,
-	0x80, 0x0a, 0x90, 0x0c, 0xee, 0x27, 0x6b, 0xd9, 0xfd, 0x88, 0x16, 0x28, 0xd0, 0x1a, 0xc4, 0x8f,
-	0x75, 0xf7, 0x98, 0x35, 0xc7, 0x37, 0x30, 0x09, 0x21, 0xd1, 0x61, 0x9f, 0xe8, 0x94, 0x68, 0x66,
-	0x0e, 0x12, 0x7d, 0x4c, 0x8f, 0x89, 0xc9, 0xc7, 0x54, 0x13, 0x12, 0x3f, 0x4e, 0xcd, 0x00, 0x08,
-	0x9e, 0xfd, 0xa4, 0x7e, 0x8a, 0x42, 0x3a, 0xc4, 0xe2, 0x24, 0x9e, 0x14, 0x8a, 0xb7, 0x07, 0x29,
-	0x87, 0x41, 0xa6, 0xb3, 0x71, 0x67, 0x7e, 0xe9, 0x59, 0x5e, 0xfa, 0x04, 0xad, 0xdc, 0x8f, 0x68,
-	0xcb, 0x5c, 0xaa, 0x9b, 0x93, 0x0a, 0x62, 0x33, 0x15, 0x6c, 0x41, 0xca, 0xbf, 0x34, 0xed, 0xd0,
-	0xbd, 0x5a, 0x9b, 0x86, 0x9a, 0x98, 0x14, 0x6d, 0xd9, 0xff, 0xde, 0xf7, 0x13, 0xaa, 0x42, 0xc2,
-	0xa5, 0x3a, 0x1d, 0xf2, 0x2d, 0xf6, 0xe6, 0xf6, 0x7b, 0xaf, 0x30, 0x1f, 0x4d, 0xe6, 0xa0, 0x09,
-	0x47, 0xc1, 0xc5, 0x32, 0x24, 0x5c, 0x32, 0x74, 0x0c, 0xac, 0x1c, 0x41, 0x26, 0x3c, 0x08, 0x3e,
-	0x0f, 0x2c, 0x57, 0xc1, 0x03, 0xcb, 0xf4, 0x8b, 0xc9, 0xb1, 0x51, 0x76, 0xec, 0x35, 0x23, 0xe5,
-	0x0e, 0x7b, 0x73, 0x4f, 0x54, 0xbe, 0x87, 0x25, 0xb6, 0x58, 0xfc, 0xe5, 0x30, 0xd3, 0xea, 0x69,
-	0xa3, 0x3f, 0x81, 0xb8, 0x43, 0x7a, 0x58, 0x1c, 0xf2, 0xf6, 0xb5, 0xfb, 0xe9, 0x70, 0x64, 0x63,
-	0x8d, 0xc1, 0x45, 0xfc, 0x9f, 0xe3, 0x90, 0x0e, 0x6d, 0x0d, 0xf4, 0xa3, 0x04, 0x19, 0xc3, 0xc1,
-	0x3a, 0xc5, 0x66, 0xdb, 0xd4, 0x29, 0x6f, 0x6c, 0x7a, 0xbb, 0x50, 0xe6, 0x2f, 0x71, 0x39, 0x78,
-	0x89, 0xcb, 0x87, 0xc1, 0x53, 0xad, 0xee, 0xf8, 0xa3, 0x7d, 0xe9, 0xc9, 0xb9, 0xb0, 0xdf, 0x74,
-	0xdb, 0x8c, 0x3d, 0x79, 0x9d, 0xf7, 0x66, 0xbe, 0x5d, 0x79, 0xfa, 0xa7, 0x2c, 0x69, 0x69, 0x61,
-	0xdc, 0xd5, 0x29, 0x46, 0x5f, 0x01, 0x04, 0xd8, 0xce, 0x88, 0x0f, 0xb0, 0x2a, 0x8f, 0x3d, 0xf9,
-	0xad, 0xd9, 0x38, 0x9d, 0x51, 0x78, 0xa7, 0xa5, 0x84, 0x5a, 0x1d, 0xb1, 0x22, 0x86, 0xb6, 0x39,
-	0x2d, 0x22, 0xf6, 0xea, 0x45, 0x84, 0xfd, 0xe6, 0x15, 0x31, 0xdf, 0x2e, 0x8a, 0x10, 0xc6, 0xa0,
-	0x88, 0x00, 0xdb, 0x19, 0x89, 0x41, 0x0d, 0x15, 0x31, 0xb5, 0xcd, 0x14, 0x21, 0xd4, 0xea, 0x08,
-	0x7d, 0x0a, 0xc9, 0x13, 0xec, 0xf8, 0x2b, 0x92, 0x4d, 0xed, 0x1b, 0xea, 0xed, 0xb1, 0x27, 0xe7,
-	0xc5, 0xdb, 0xcb, 0x0d, 0x61, 0xcf, 0x00, 0xec, 0xfb, 0xf5, 0xb1, 0xeb, 0xea, 0x5d, 0xcc, 0x56,
-	0x4f, 0x2a, 0xec, 0x27, 0x0c, 0x33, 0x7e, 0x42, 0xb7, 0xf9, 0xab, 0x04, 0xab, 0x57, 0xe6, 0x1f,
-	0x7d, 0x08, 0xb2, 0x56, 0xdb, 0x39, 0xd0, 0x76, 0xdb, 0xf5, 0xfd, 0x46, 0xeb, 0xb0, 0xdd, 0x3c,
-	0xac, 0x1e, 0xb6, 0x9a, 0xed, 0xd6, 0x7e, 0xb3, 0x51, 0xdb, 0xa9, 0xef, 0xd5, 0x6b, 0xbb, 0xd9,
-	0x48, 0x21, 0x7d, 0x7a, 0x56, 0x4a, 0xb6, 0x06, 0x8f, 0x06, 0xe4, 0xf1, 0x00, 0x95, 0xe1, 0xf6,
-	0x3c, 0x8f, 0x86, 0x76, 0xd0, 0x38, 0x68, 0xd6, 0x76, 0xb3, 0x52, 0x21, 0x73, 0x7a, 0x56, 0x5a,
-	0x6e, 0x38, 0xc4, 0x26, 0x2e, 0x36, 0xd1, 0x26, 0x14, 0xe6, 0xe1, 0xb9, 0x2e, 0x1b, 0x2d, 0xc0,
-	0xe9, 0x59, 0x49, 0xbc, 0x4f, 0x9b, 0x43, 0xff, 0xd6, 0x4d, 0xef, 0x0a, 0x5a, 0x87, 0x5b, 0x5a,
-	0xad, 0xd9, 0x7a, 0x38, 0x3f, 0x2f, 0x94, 0x03, 0x34, 0x6b, 0x6e, 0x54, 0x9b, 0xcd, 0xac, 0x74,
-	0x55, 0xdf, 0x7c, 0x50, 0x6f, 0x64, 0xa3, 0x57, 0xf5, 0x7b, 0xd5, 0xfa, 0xc3, 0x6c, 0x4c, 0x7d,
-	0xf4, 0xfc, 0xbc, 0x28, 0xbd, 0x38, 0x2f, 0x4a, 0x7f, 0x9d, 0x17, 0xa5, 0xa7, 0x17, 0xc5, 0xc8,
-	0x8b, 0x8b, 0x62, 0xe4, 0xf7, 0x8b, 0x62, 0x04, 0x6e, 0x59, 0x64, 0xc1, 0x7d, 0x6b, 0x48, 0xdf,
-	0x7e, 0xdc, 0xb5, 0xe8, 0xf1, 0xb0, 0x53, 0x36, 0x48, 0xbf, 0x32, 0x05, 0x7d, 0x60, 0x91, 0x90,
-	0x54, 0x79, 0x32, 0xfd, 0x6d, 0xf5, 0xf7, 0x95, 0xdb, 0x49, 0xb0, 0xe9, 0xfc, 0xe8, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x99, 0x3c, 0x21, 0x36, 0x6f, 0x0b, 0x00, 0x00,
+	// 1762 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x8f, 0x1a, 0xd9,
+	0x11, 0x9f, 0x06, 0x06, 0x86, 0x82, 0x99, 0xc1, 0xcf, 0xe3, 0x31, 0x66, 0xed, 0x69, 0xdc, 0x9b,
+	0xd5, 0x4e, 0xc6, 0x0e, 0x13, 0x4f, 0xe2, 0x8d, 0xe2, 0xc4, 0x89, 0xe8, 0x81, 0x09, 0xc8, 0x36,
+	0xa0, 0x07, 0x93, 0x8d, 0x22, 0x25, 0xa8, 0xa1, 0xdf, 0x32, 0x2d, 0x03, 0xcd, 0x76, 0x3f, 0x58,
+	0xa3, 0xdc, 0x72, 0x89, 0xe4, 0x43, 0xb4, 0xc7, 0xbd, 0x58, 0x4a, 0x0e, 0x51, 0x4e, 0xf9, 0x3f,
+	0x56, 0x4a, 0xa4, 0xec, 0x31, 0xca, 0xa1, 0x13, 0xd9, 0x37, 0x1f, 0xb9, 0xe5, 0x16, 0xbd, 0x8f,
+	0xa6, 0x1b, 0x06, 0x66, 0x37, 0xca, 0xee, 0xad, 0xab, 0xea, 0x57, 0xf5, 0xea, 0xeb, 0xd5, 0x2b,
+	0x00, 0x6d, 0xe4, 0xd8, 0x13, 0x32, 0x34, 0x86, 0x5d, 0x72, 0x3c, 0x20, 0xd4, 0x30, 0x0d, 0x6a,
+	0x1c, 0x4f, 0x1e, 0x1c, 0xbb, 0x5d, 0x7b, 0x44, 0x0a, 0x23, 0xc7, 0xa6, 0x36, 0xda, 0x0f, 0x30,
+	0x05, 0x1f, 0x53, 0x98, 0x3c, 0xc8, 0xed, 0xf5, 0xec, 0x9e, 0xcd, 0x21, 0xc7, 0xec, 0x4b, 0xa0,
+	0x73, 0x6a, 0xcf, 0xb6, 0x7b, 0x7d, 0x72, 0xcc, 0xa9, 0xce, 0xf8, 0xa3, 0x63, 0x6a, 0x0d, 0x88,
+	0x4b, 0x8d, 0xc1, 0x48, 0x02, 0xf2, 0xcb, 0x00, 0x93, 0xb8, 0x5d, 0xc7, 0x1a, 0x51, 0xdb, 0x91,
+	0x88, 0xa3, 0x75, 0x4e, 0x8d, 0x48, 0xd7, 0xfa, 0xc8, 0xea, 0x1a, 0xd4, 0xb2, 0x87, 0x02, 0xab,
+	0xfd, 0x27, 0x02, 0x9b, 0x4d, 0xe6, 0x2c, 0x2a, 0xc3, 0x16, 0xf7, 0xba, 0x6d, 0x99, 0x59, 0x25,
+	0xaf, 0x1c, 0xa6, 0xf5, 0xa3, 0xcf, 0x3d, 0x75, 0xe3, 0x9f, 0x9e, 0xba, 0xfb, 0x4c, 0x1a, 0x29,
+	0x9a, 0xa6, 0x43, 0x5c, 0x77, 0xe6, 0xa9, 0xbb, 0x53, 0x63, 0xd0, 0x7f, 0xa4, 0xf9, 0x0a, 0x1a,
+	0x4e, 0xf0, 0xcf, 0xaa, 0x89, 0x7e, 0x05, 0x99, 0x85, 0x73, 0x98, 0xb9, 0x08, 0x37, 0x77, 0xb2,
+	0xde, 0xdc, 0x4d, 0x69, 0x6e, 0x49, 0x51, 0xc3, 0xbb, 0x0b, 0xac, 0xaa, 0x89, 0x7e, 0x04, 0x71,
+	0xfb, 0x93, 0x21, 0x71, 0xdc, 0x6c, 0x34, 0x1f, 0x3d, 0x4c, 0x9d, 0xdc, 0x29, 0xac, 0xce, 0x6e,
+	0xa1, 0x61, 0x38, 0x74, 0xaa, 0xc7, 0xd8, 0x99, 0x58, 0xaa, 0xa0, 0x1f, 0x40, 0x8a, 0x89, 0xdb,
+	0x46, 0xb7, 0x4b, 0x5c, 0x37, 0x1b, 0xcb, 0x47, 0x0f, 0x93, 0xfa, 0xfe, 0xcc, 0x53, 0x91, 0x38,
+	0x3f, 0x24, 0xd4, 0x30, 0x70, 0x17, 0x39, 0x81, 0x6a, 0x70, 0x7d, 0x62, 0xf4, 0xc7, 0xa4, 0xcd,
+	0x0d, 0xb5, 0x0d, 0xe1, 0x78, 0x76, 0x33, 0xaf, 0x1c, 0x26, 0xf5, 0x83, 0x99, 0xa7, 0xe6, 0x84,
+	0x81, 0x15, 0x20, 0x0d, 0x5f, 0xe3, 0xdc, 0x3a, 0x63, 0xca, 0x88, 0x1f, 0xc5, 0x3e, 0xfb, 0x83,
+	0xba, 0xa1, 0x7d, 0x16, 0x85, 0x44, 0x93, 0xb8, 0xae, 0x65, 0x0f, 0xd1, 0x13, 0x00, 0x57, 0x7c,
+	0x06, 0xf9, 0xbf, 0xbf, 0x3e, 0x61, 0xd7, 0x64, 0xc2, 0xe6, 0x2a, 0x1a, 0x4e, 0x4a, 0xe2, 0x9b,
+	0xaf, 0xc1, 0x63, 0x48, 0x8c, 0x0c, 0x87, 0x5a, 0xe4, 0x7f, 0x2a, 0x82, 0xaf, 0x83, 0xee, 0x41,
+	0x6c, 0x68, 0x0c, 0x48, 0x36, 0xc6, 0xb3, 0x77, 0xf3, 0xad, 0xa7, 0xc6, 0xe8, 0x74, 0x44, 0x66,
+	0x9e, 0x9a, 0x12, 0x2e, 0x30, 0x4a, 0xc3, 0x1c, 0x84, 0xb2, 0x90, 0xe8, 0xda, 0x43, 0x4a, 0x5e,
+	0x50, 0x9e, 0xed, 0x34, 0xf6, 0x49, 0x74, 0x0e, 0x9b, 0xc6, 0xd8, 0xb4, 0x68, 0xb6, 0x9b, 0x57,
+	0x0e, 0x53, 0x27, 0xef, 0xae, 0xf3, 0xa1, 0xc8, 0x40, 0x67, 0x16, 0xe9, 0x9b, 0xae, 0x9e, 0x9b,
+	0x79, 0xea, 0xbe, 0x38, 0x84, 0xeb, 0xde, 0xb7, 0x07, 0x16, 0x25, 0x83, 0x11, 0x9d, 0x6a, 0x58,
+	0x58, 0x93, 0xa5, 0xf9, 0x4b, 0x14, 0xe2, 0x98, 0x74, 0x6d, 0xc7, 0x44, 0xef, 0x4b, 0x77, 0x15,
+	0xee, 0xee, 0xf5, 0xb7, 0x9e, 0x1a, 0xb1, 0xcc, 0x99, 0xa7, 0x26, 0x85, 0x1d, 0x96, 0x21, 0xe1,
+	0xea, 0x62, 0x09, 0x23, 0xff, 0x5f, 0x09, 0x7f, 0x0a, 0x89, 0x91, 0x63, 0xf3, 0x36, 0x8d, 0xf2,
+	0xf8, 0xd4, 0xb5, 0x39, 0x16, 0xb0, 0x79, 0x96, 0x05, 0x89, 0x8a, 0x10, 0xb7, 0x86, 0xa3, 0x31,
+	0x15, 0x6d, 0x7e, 0x45, 0x7e, 0x44, 0x98, 0x55, 0x86, 0xf5, 0xaf, 0x8b, 0x50, 0x44, 0x25, 0x48,
+	0xd8, 0x63, 0xca, 0x6d, 0x6c, 0x72, 0x1b, 0xdf, 0xba, 0xda, 0x46, 0x7d, 0x4c, 0x03, 0x23, 0xbe,
+	0xea, 0xca, 0x66, 0x8c, 0x7f, 0x6d, 0xcd, 0x28, 0xeb, 0xf5, 0x1b, 0x48, 0xc8, 0x3c, 0xa0, 0x1c,
+	0x24, 0xfc, 0xfb, 0xc9, 0x4b, 0x56, 0xd9, 0xc0, 0x3e, 0x03, 0xed, 0x41, 0xec, 0xc2, 0x70, 0x2f,
+	0xb2, 0x11, 0x29, 0xe0, 0x14, 0x42, 0xb2, 0xc2, 0x2c, 0xd1, 0x49, 0x59, 0xcc, 0x7d, 0x88, 0x0f,
+	0x08, 0xbd, 0xb0, 0x4d, 0xd1, 0xa6, 0x58, 0x52, 0xe2, 0x38, 0x3d, 0x0d, 0x20, 0xf3, 0xcc, 0x9c,
+	0xfa, 0x73, 0x14, 0x52, 0xa1, 0x2c, 0xce, 0xed, 0x29, 0x21, 0x7b, 0x67, 0x90, 0x74, 0x38, 0x24,
+	0xe8, 0x8d, 0xf7, 0x57, 0x87, 0x9e, 0x11, 0xa1, 0xcf, 0xd1, 0x5a, 0x65, 0x03, 0x6f, 0x09, 0xaa,
+	0x6a, 0xce, 0x23, 0x88, 0x2e, 0x44, 0xf0, 0x00, 0x92, 0xec, 0xd2, 0xb4, 0x43, 0xf7, 0x6a, 0x2f,
+	0x30, 0x35, 0x17, 0x69, 0x78, 0x8b, 0x7d, 0xd7, 0x98, 0x43, 0x45, 0x88, 0xbb, 0xd4, 0xa0, 0x63,
+	0x31, 0xc5, 0x76, 0x4e, 0xbe, 0xfd, 0x15, 0xfa, 0xa3, 0xc9, 0x15, 0xb0, 0x54, 0x64, 0xe3, 0x54,
+	0x7a, 0xc9, 0x5d, 0x8a, 0xe7, 0x95, 0xc5, 0x71, 0x1a, 0x12, 0x6a, 0x18, 0x04, 0x55, 0x61, 0xee,
+	0xf6, 0x60, 0x87, 0x31, 0xdb, 0x46, 0xbf, 0x67, 0x3b, 0x16, 0xbd, 0x18, 0x64, 0x13, 0xdc, 0x87,
+	0xf7, 0xd6, 0xf9, 0xc0, 0xb4, 0x8a, 0x3e, 0x58, 0xbf, 0x35, 0xf3, 0xd4, 0x1b, 0xe2, 0x88, 0x45,
+	0x33, 0x1a, 0xde, 0xbe, 0x08, 0x23, 0x65, 0xb5, 0xb6, 0x20, 0xee, 0xda, 0x63, 0xa7, 0x4b, 0xb4,
+	0xbf, 0x2b, 0x90, 0x0e, 0xf7, 0x2a, 0x2b, 0x15, 0xf7, 0x5d, 0x96, 0x8a, 0x27, 0xf3, 0xc7, 0xf3,
+	0xcc, 0x44, 0xb8, 0x57, 0x57, 0x74, 0xbd, 0x3b, 0xee, 0x2f, 0x27, 0xe5, 0x72, 0x6c, 0xd1, 0x6f,
+	0x30, 0x36, 0xed, 0xd7, 0xb0, 0xc9, 0x87, 0x2c, 0x1b, 0x94, 0x0b, 0x6d, 0x1f, 0x34, 0xfd, 0x43,
+	0x88, 0x39, 0x76, 0x9f, 0xc8, 0x68, 0xee, 0x5e, 0x39, 0xab, 0x5b, 0xd3, 0x11, 0xc1, 0x1c, 0x2e,
+	0xed, 0xff, 0x2e, 0x06, 0xa9, 0xd0, 0x04, 0x45, 0xbf, 0x55, 0x20, 0xdd, 0x75, 0x88, 0x41, 0x89,
+	0xd9, 0x36, 0x0d, 0x2a, 0x9a, 0x3c, 0x75, 0x92, 0x2b, 0x88, 0xad, 0xa4, 0xe0, 0x6f, 0x25, 0x85,
+	0x96, 0xbf, 0xb6, 0xe8, 0xa7, 0xec, 0x9a, 0xbf, 0xf5, 0xd4, 0xfd, 0xb0, 0x5e, 0x30, 0x79, 0x67,
+	0x9e, 0x7a, 0x47, 0x04, 0xbc, 0x5a, 0xae, 0x7d, 0xfa, 0x2f, 0x55, 0xc1, 0x29, 0x29, 0x2c, 0x19,
+	0x94, 0xa0, 0x9f, 0x00, 0xf8, 0xd8, 0xce, 0x54, 0x5c, 0x66, 0x5d, 0x9d, 0x79, 0xea, 0x3b, 0x8b,
+	0x76, 0x3a, 0xd3, 0xf0, 0x7c, 0x4f, 0x4a, 0xb6, 0x3e, 0xe5, 0x41, 0x8c, 0x47, 0x66, 0x10, 0x44,
+	0xf4, 0xab, 0x07, 0x11, 0xd6, 0x5b, 0x15, 0xc4, 0x6a, 0xb9, 0x0c, 0x42, 0x0a, 0xfd, 0x20, 0x7c,
+	0x6c, 0x67, 0x9a, 0x8d, 0x2d, 0x07, 0x11, 0xc8, 0x16, 0x82, 0x90, 0x6c, 0x7d, 0x8a, 0x3e, 0x80,
+	0xc4, 0x84, 0x38, 0xec, 0xb9, 0xe0, 0x37, 0x78, 0x5b, 0xbf, 0x3d, 0xf3, 0xd4, 0xac, 0x50, 0x96,
+	0x82, 0xb0, 0xa6, 0x0f, 0x66, 0x7a, 0x03, 0xe2, 0xba, 0x46, 0x8f, 0xc8, 0x1b, 0x1b, 0xd2, 0x93,
+	0x82, 0x05, 0x3d, 0xc9, 0xd3, 0x7e, 0x1f, 0x85, 0x6b, 0x7c, 0x53, 0xac, 0x58, 0x2e, 0xb5, 0x9d,
+	0x69, 0x79, 0x48, 0x9d, 0xe9, 0xd7, 0xb5, 0x35, 0xe6, 0x60, 0xcb, 0x25, 0x1f, 0x8f, 0xc9, 0xb0,
+	0x2b, 0xfa, 0x34, 0x86, 0xe7, 0x34, 0x7a, 0x04, 0xe9, 0x4e, 0xdf, 0xee, 0x3e, 0x6f, 0x5f, 0x10,
+	0xab, 0x77, 0x41, 0x79, 0xb1, 0xa2, 0xfa, 0xcd, 0x99, 0xa7, 0x5e, 0x17, 0xf6, 0xc2, 0x52, 0x0d,
+	0xa7, 0x38, 0x59, 0xe1, 0x14, 0xfa, 0x05, 0x80, 0x90, 0xb2, 0x2d, 0x3a, 0x1b, 0xfb, 0xd2, 0x32,
+	0xdf, 0x61, 0xce, 0x07, 0x8f, 0x73, 0xa0, 0x2b, 0x0a, 0x98, 0xe4, 0x0c, 0x06, 0x47, 0xf7, 0x20,
+	0x41, 0x5f, 0x88, 0xc1, 0x27, 0xd6, 0x40, 0x34, 0xf3, 0xd4, 0x1d, 0xa1, 0x26, 0x05, 0x1a, 0x8e,
+	0xd3, 0x17, 0x7c, 0xe0, 0xfd, 0x10, 0xd2, 0x03, 0xb7, 0xd7, 0xe6, 0x83, 0x78, 0xec, 0xf4, 0x65,
+	0xe2, 0x43, 0x21, 0x84, 0xa5, 0x1a, 0x86, 0x81, 0xdb, 0x63, 0x37, 0xf1, 0xdc, 0xe9, 0xb3, 0x7b,
+	0xed, 0x5a, 0x3d, 0xbe, 0xf1, 0x26, 0xd8, 0xbe, 0x8a, 0x7d, 0x52, 0xfb, 0xeb, 0x26, 0xec, 0x89,
+	0x61, 0x86, 0xc9, 0x84, 0x38, 0xf3, 0x47, 0x11, 0x55, 0xc2, 0x6f, 0x8d, 0x28, 0xca, 0xbd, 0xf5,
+	0x45, 0xb9, 0xf4, 0xde, 0x84, 0x5e, 0x9b, 0xdb, 0xcc, 0xd2, 0xc7, 0x63, 0xe2, 0x52, 0xe2, 0x88,
+	0x7b, 0x86, 0x03, 0x06, 0x7a, 0x0c, 0xdb, 0xa6, 0xe5, 0x8e, 0xc6, 0xac, 0x4d, 0x83, 0x47, 0x49,
+	0xcf, 0xce, 0x3c, 0x75, 0x4f, 0x18, 0x5d, 0x10, 0x6b, 0x38, 0xed, 0xd3, 0x3c, 0x29, 0x8f, 0x61,
+	0xdb, 0xee, 0xb8, 0xc4, 0x99, 0xf8, 0xea, 0xb1, 0x65, 0xf5, 0x05, 0xb1, 0x86, 0xd3, 0x3e, 0xcd,
+	0xd5, 0xf7, 0x21, 0xee, 0x10, 0xc3, 0x95, 0xed, 0x9f, 0xc4, 0x92, 0x42, 0xa5, 0xf9, 0xf8, 0x8e,
+	0xf3, 0x81, 0x77, 0x7f, 0xfd, 0xf8, 0x0e, 0x67, 0x6d, 0x69, 0x8c, 0x9f, 0x41, 0xc6, 0x0f, 0xd4,
+	0xf4, 0x1b, 0x2f, 0xc1, 0x1b, 0xef, 0x9d, 0x60, 0x3d, 0x59, 0x46, 0x68, 0x78, 0x77, 0xce, 0x92,
+	0x0d, 0x68, 0xc2, 0x4e, 0x80, 0xe2, 0x4d, 0xb8, 0xf5, 0xa5, 0x4d, 0x78, 0x57, 0x36, 0xe1, 0x8d,
+	0xe5, 0x53, 0x82, 0x46, 0xdc, 0x9e, 0x33, 0x79, 0x33, 0x9e, 0xc2, 0xae, 0x43, 0x5c, 0xbb, 0x3f,
+	0xe6, 0x7b, 0xd2, 0xd0, 0xa6, 0x24, 0x9b, 0xe4, 0xc9, 0x0c, 0x2d, 0xbc, 0x4b, 0x00, 0x0d, 0xef,
+	0x04, 0x9c, 0x9a, 0x4d, 0x03, 0x23, 0x93, 0x20, 0x62, 0xe0, 0x11, 0x2f, 0x1b, 0x99, 0x84, 0x02,
+	0xde, 0xf1, 0x39, 0x32, 0x5e, 0xbe, 0x13, 0x48, 0x4c, 0x67, 0x9a, 0x4d, 0x2d, 0xff, 0xc4, 0x0a,
+	0x09, 0xf9, 0x4e, 0x20, 0x28, 0x7d, 0x7a, 0xf4, 0x47, 0x05, 0xae, 0x5d, 0x5a, 0x35, 0xd0, 0x77,
+	0x41, 0xc5, 0xe5, 0xd3, 0x3a, 0x2e, 0xb5, 0xab, 0xb5, 0xc6, 0x79, 0xab, 0xdd, 0x6c, 0x15, 0x5b,
+	0xe7, 0xcd, 0xf6, 0x79, 0xad, 0xd9, 0x28, 0x9f, 0x56, 0xcf, 0xaa, 0xe5, 0x52, 0x66, 0x23, 0x97,
+	0x7a, 0xf9, 0x2a, 0x9f, 0x38, 0x1f, 0x3e, 0x1f, 0xda, 0x9f, 0x0c, 0x51, 0x01, 0x6e, 0xaf, 0xd2,
+	0x68, 0xe0, 0x7a, 0xa3, 0xde, 0x2c, 0x97, 0x32, 0x4a, 0x2e, 0xfd, 0xf2, 0x55, 0x7e, 0xab, 0xe1,
+	0xd8, 0x23, 0xdb, 0x25, 0x26, 0x3a, 0x82, 0xdc, 0x2a, 0xbc, 0xe0, 0x65, 0x22, 0x39, 0x78, 0xf9,
+	0x2a, 0x2f, 0x7f, 0x0a, 0x1c, 0xfd, 0x49, 0x81, 0xed, 0x85, 0xe7, 0x1a, 0x1d, 0x40, 0xae, 0x52,
+	0x6c, 0x56, 0xda, 0xc5, 0xa7, 0x3f, 0xab, 0xe3, 0x6a, 0xab, 0xf2, 0x6c, 0xd1, 0x35, 0x74, 0x0b,
+	0x6e, 0x2c, 0xc9, 0x9b, 0x95, 0xe2, 0xc9, 0xc3, 0x0f, 0x32, 0xca, 0x6a, 0xd1, 0xc3, 0x07, 0x27,
+	0x99, 0xc8, 0x0a, 0xab, 0xfa, 0xd3, 0xe2, 0x93, 0xf2, 0x89, 0xde, 0x66, 0xaa, 0xd1, 0x2b, 0xe4,
+	0x4c, 0x3f, 0x76, 0x34, 0x86, 0x74, 0x78, 0x37, 0x41, 0x77, 0xe0, 0x16, 0x2e, 0x37, 0xcf, 0x9f,
+	0xae, 0xce, 0x1f, 0xda, 0x07, 0xb4, 0x28, 0x6e, 0x14, 0x9b, 0xcd, 0x8c, 0x72, 0x99, 0xdf, 0x7c,
+	0x52, 0x6d, 0x64, 0x22, 0x97, 0xf9, 0x67, 0xc5, 0xea, 0xd3, 0x4c, 0xf4, 0xe8, 0x6f, 0x0a, 0xec,
+	0xad, 0xba, 0x54, 0xe8, 0x3d, 0xb8, 0x8b, 0xcb, 0x3f, 0x2f, 0xe3, 0xea, 0x59, 0xf5, 0xb4, 0xd8,
+	0xaa, 0xd6, 0x6b, 0xab, 0xfd, 0x38, 0x80, 0xdc, 0x6a, 0x58, 0xbd, 0x51, 0xae, 0x65, 0x14, 0xf4,
+	0x2e, 0xa8, 0xab, 0xe5, 0xa7, 0xf5, 0xda, 0x59, 0x15, 0x3f, 0x2b, 0x97, 0x32, 0x91, 0xab, 0x40,
+	0x18, 0x97, 0x4f, 0x5b, 0xe5, 0x52, 0x26, 0xba, 0x1e, 0xf4, 0x61, 0xb5, 0x55, 0x29, 0xe1, 0xe2,
+	0x87, 0xb5, 0x4c, 0x4c, 0x7f, 0xfe, 0xf9, 0xeb, 0x03, 0xe5, 0x8b, 0xd7, 0x07, 0xca, 0xbf, 0x5f,
+	0x1f, 0x28, 0x9f, 0xbe, 0x39, 0xd8, 0xf8, 0xe2, 0xcd, 0xc1, 0xc6, 0x3f, 0xde, 0x1c, 0x6c, 0xc0,
+	0x2d, 0xcb, 0x5e, 0x33, 0x54, 0x1a, 0xca, 0x2f, 0xbf, 0xdf, 0xb3, 0xe8, 0xc5, 0xb8, 0x53, 0xe8,
+	0xda, 0x83, 0xe3, 0x00, 0xf4, 0x1d, 0xcb, 0x0e, 0x51, 0xc7, 0x2f, 0x82, 0x3f, 0x66, 0xd8, 0xd8,
+	0x77, 0x3b, 0x71, 0x3e, 0x07, 0xbe, 0xf7, 0xdf, 0x01, 0x00, 0xad, 0x0a, 0x40, 0xbf, 0x51, 0x12,
+	0x00, 0x00,
 }
 
 func (m *Scope) Marshal() (dAtA []byte, err error) {
@@ -1167,6 +1539,18 @@ func (m *RecordInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HashAlgorithm != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.HashAlgorithm))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RecordHash) > 0 {
+		i -= len(m.RecordHash)
+		copy(dAtA[i:], m.RecordHash)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.RecordHash)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Status != 0 {
 		i = encodeVarintScope(dAtA, i, uint64(m.Status))
 		i--
@@ -1251,6 +1635,11 @@ func (m *RecordOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HashAlgorithm != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.HashAlgorithm))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Status != 0 {
 		i = encodeVarintScope(dAtA, i, uint64(m.Status))
 		i--
@@ -1366,78 +1755,252 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
-	offset -= sovScope(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScopeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Scope) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ScopeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, e := range m.Owners {
-			l = e.Size()
-			n += 1 + l + sovScope(uint64(l))
-		}
-	}
-	if len(m.DataAccess) > 0 {
-		for _, s := range m.DataAccess {
-			l = len(s)
-			n += 1 + l + sovScope(uint64(l))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	l = len(m.ValueOwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-
-func (m *Session) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	l = m.SessionId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	if len(m.Parties) > 0 {
-		for _, e := range m.Parties {
-			l = e.Size()
-			n += 1 + l + sovScope(uint64(l))
-		}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	i -= n5
+	i = encodeVarintScope(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockHeight != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Context)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	if m.Sequence != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Audit != nil {
-		l = m.Audit.Size()
-		n += 2 + l + sovScope(uint64(l))
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Record) Size() (n int) {
+func (m *RecordReverification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordReverification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordReverification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResolvedBy) > 0 {
+		for iNdEx := len(m.ResolvedBy) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ResolvedBy[iNdEx])
+			copy(dAtA[i:], m.ResolvedBy[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.ResolvedBy[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.ResolvedHeight != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.ResolvedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ResolutionNote) > 0 {
+		i -= len(m.ResolutionNote)
+		copy(dAtA[i:], m.ResolutionNote)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.ResolutionNote)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RequestedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RequestedTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintScope(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x42
+	if m.RequestedHeight != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.RequestedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ObservedHash) > 0 {
+		i -= len(m.ObservedHash)
+		copy(dAtA[i:], m.ObservedHash)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.ObservedHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DisputedHash) > 0 {
+		i -= len(m.DisputedHash)
+		copy(dAtA[i:], m.DisputedHash)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.DisputedHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.RecordId.Size()
+		i -= size
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScope(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Scope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = len(m.ValueOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *Session) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SessionId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Parties) > 0 {
+		for _, e := range m.Parties {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.Context)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Audit != nil {
+		l = m.Audit.Size()
+		n += 2 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *Record) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1528,6 +2091,13 @@ func (m *RecordInput) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovScope(uint64(m.Status))
 	}
+	l = len(m.RecordHash)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.HashAlgorithm != 0 {
+		n += 1 + sovScope(uint64(m.HashAlgorithm))
+	}
 	return n
 }
 
@@ -1564,6 +2134,9 @@ func (m *RecordOutput) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovScope(uint64(m.Status))
 	}
+	if m.HashAlgorithm != 0 {
+		n += 1 + sovScope(uint64(m.HashAlgorithm))
+	}
 	return n
 }
 
@@ -1611,6 +2184,87 @@ func (m *AuditFields) Size() (n int) {
 	return n
 }
 
+func (m *ScopeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovScope(uint64(m.Sequence))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovScope(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecordReverification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.DisputedHash)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.ObservedHash)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovScope(uint64(m.Status))
+	}
+	if m.RequestedHeight != 0 {
+		n += 1 + sovScope(uint64(m.RequestedHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RequestedTime)
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.ResolutionNote)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.ResolvedHeight != 0 {
+		n += 1 + sovScope(uint64(m.ResolvedHeight))
+	}
+	if len(m.ResolvedBy) > 0 {
+		for _, s := range m.ResolvedBy {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovScope(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2690,6 +3344,57 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -2791,6 +3496,25 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -3144,6 +3868,621 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScopeHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordReverification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordReverification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordReverification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputedHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisputedHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservedHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ReverificationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedHeight", wireType)
+			}
+			m.RequestedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RequestedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionNote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolutionNote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedHeight", wireType)
+			}
+			m.ResolvedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolvedBy = append(m.ResolvedBy, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipScope(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
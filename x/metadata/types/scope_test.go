@@ -334,6 +334,44 @@ value_owner_address: ""
 	})
 }
 
+const (
+	// sha256Hex is the hex encoded sha256 digest of "test".
+	sha256Hex = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	// blake2b512Base64 is the base64 encoded blake2b-512 digest of "test".
+	blake2b512Base64 = "pxB51ChT3qJuRTAEM4ZwpTgUt4E3/77QdgOkHXakg6qbwztYL3fTCmXm8pqJbAQR84MS4dZuC/Fjhshqib6lcg=="
+)
+
+func (s *ScopeTestSuite) TestHashAlgorithmValidateHash() {
+	tests := []struct {
+		name      string
+		algorithm HashAlgorithm
+		hash      string
+		err       string
+	}{
+		{"unspecified allows any hash", HashAlgorithm_HASH_ALGORITHM_UNSPECIFIED, "anything", ""},
+		{"sha256 hex", HashAlgorithm_HASH_ALGORITHM_SHA256, sha256Hex, ""},
+		{"sha256 base64", HashAlgorithm_HASH_ALGORITHM_SHA256, "n4bQgYhMfWWaL+qgxVrQFaO/TxsrC4Is0V1sFbDwCgg=", ""},
+		{"blake2b 256 with a raw url base64 32 byte digest", HashAlgorithm_HASH_ALGORITHM_BLAKE2B_256, "n4bQgYhMfWWaL-qgxVrQFaO_TxsrC4Is0V1sFbDwCgg", ""},
+		{"blake2b 512 base64", HashAlgorithm_HASH_ALGORITHM_BLAKE2B_512, blake2b512Base64, ""},
+		{"sha512 with 32 byte digest", HashAlgorithm_HASH_ALGORITHM_SHA512, sha256Hex,
+			fmt.Sprintf("invalid HASH_ALGORITHM_SHA512 hash %s: expected a hex or base64 encoded 64 byte digest", sha256Hex)},
+		{"sha256 not encoded", HashAlgorithm_HASH_ALGORITHM_SHA256, "not a hash",
+			"invalid HASH_ALGORITHM_SHA256 hash not a hash: expected a hex or base64 encoded 32 byte digest"},
+		{"unknown algorithm", HashAlgorithm(99), sha256Hex, "unknown hash algorithm: 99"},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			err := tc.algorithm.ValidateHash(tc.hash)
+			if len(tc.err) > 0 {
+				require.EqualError(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func (s *ScopeTestSuite) TestRecordValidateBasic() {
 	scopeUUID := uuid.New()
 	sessionUUID := uuid.New()
@@ -432,6 +470,14 @@ func (s *ScopeTestSuite) TestRecordValidateBasic() {
 			"invalid record input: hash specifier only applies to proposed inputs",
 			true,
 		},
+		{
+			"Invalid record, record hash provided for record input source hash",
+			NewRecord("name", sessionID, *validPs,
+				[]RecordInput{{Name: "ri_name", Source: &RecordInput_Hash{"hash"}, TypeName: "type_name", Status: RecordInputStatus_Proposed, RecordHash: "recordhash"}},
+				[]RecordOutput{*validRO}, nil),
+			"invalid record input: record hash only applies to record id inputs",
+			true,
+		},
 		{
 			"Invalid record, incorrect status of proposed for record input source record id",
 			NewRecord("name", sessionID, *validPs,
@@ -496,6 +542,41 @@ func (s *ScopeTestSuite) TestRecordValidateBasic() {
 			"",
 			false,
 		},
+		{
+			"Valid record, hash algorithms with matching digest sizes",
+			NewRecord("name", sessionID, *validPs,
+				[]RecordInput{
+					{Name: "name", Source: &RecordInput_Hash{sha256Hex}, TypeName: "type_name", Status: RecordInputStatus_Proposed, HashAlgorithm: HashAlgorithm_HASH_ALGORITHM_SHA256},
+					{Name: "name2", Source: &RecordInput_RecordId{recordID}, TypeName: "type_name", Status: RecordInputStatus_Record, RecordHash: blake2b512Base64, HashAlgorithm: HashAlgorithm_HASH_ALGORITHM_BLAKE2B_512},
+				},
+				[]RecordOutput{{Hash: blake2b512Base64, Status: ResultStatus_RESULT_STATUS_PASS, HashAlgorithm: HashAlgorithm_HASH_ALGORITHM_BLAKE2B_512}}, nil),
+			"",
+			false,
+		},
+		{
+			"Invalid record, record input hash digest size does not match algorithm",
+			NewRecord("name", sessionID, *validPs,
+				[]RecordInput{{Name: "name", Source: &RecordInput_Hash{sha256Hex}, TypeName: "type_name", Status: RecordInputStatus_Proposed, HashAlgorithm: HashAlgorithm_HASH_ALGORITHM_SHA512}},
+				[]RecordOutput{*validRO}, nil),
+			fmt.Sprintf("invalid record input: invalid HASH_ALGORITHM_SHA512 hash %s: expected a hex or base64 encoded 64 byte digest", sha256Hex),
+			true,
+		},
+		{
+			"Invalid record, record input hash algorithm without record hash",
+			NewRecord("name", sessionID, *validPs,
+				[]RecordInput{{Name: "name", Source: &RecordInput_RecordId{recordID}, TypeName: "type_name", Status: RecordInputStatus_Record, HashAlgorithm: HashAlgorithm_HASH_ALGORITHM_SHA256}},
+				[]RecordOutput{*validRO}, nil),
+			"invalid record input: hash algorithm requires a record hash for record id inputs",
+			true,
+		},
+		{
+			"Invalid record, record output hash digest size does not match algorithm",
+			NewRecord("name", sessionID, *validPs,
+				[]RecordInput{*validRI},
+				[]RecordOutput{{Hash: "ro_hash", Status: ResultStatus_RESULT_STATUS_PASS, HashAlgorithm: HashAlgorithm_HASH_ALGORITHM_SHA256}}, nil),
+			"invalid record output: invalid HASH_ALGORITHM_SHA256 hash ro_hash: expected a hex or base64 encoded 32 byte digest",
+			true,
+		},
 	}
 
 	for _, tt := range tests {
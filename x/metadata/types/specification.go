@@ -248,6 +248,9 @@ func (s RecordSpecification) ValidateBasic() error {
 	if s.ResultType == DefinitionType_DEFINITION_TYPE_UNSPECIFIED {
 		return errors.New("record specification result type cannot be unspecified")
 	}
+	if _, found := InputValidationLevel_name[int32(s.InputValidation)]; !found {
+		return fmt.Errorf("invalid record specification input validation level: %d", s.InputValidation)
+	}
 
 	return nil
 }
@@ -60,6 +60,42 @@ func (DefinitionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1e2d1042057ea889, []int{0}
 }
 
+// InputValidationLevel indicates how record inputs that do not conform to their input specification are handled
+type InputValidationLevel int32
+
+const (
+	// INPUT_VALIDATION_LEVEL_UNSPECIFIED indicates the default level, which is the same as strict
+	InputValidationLevel_INPUT_VALIDATION_LEVEL_UNSPECIFIED InputValidationLevel = 0
+	// INPUT_VALIDATION_LEVEL_OFF indicates input type names and sources are not checked
+	InputValidationLevel_INPUT_VALIDATION_LEVEL_OFF InputValidationLevel = 1
+	// INPUT_VALIDATION_LEVEL_WARN indicates non-conforming inputs are accepted with an EventRecordInputValidationWarning
+	InputValidationLevel_INPUT_VALIDATION_LEVEL_WARN InputValidationLevel = 2
+	// INPUT_VALIDATION_LEVEL_STRICT indicates non-conforming inputs are rejected
+	InputValidationLevel_INPUT_VALIDATION_LEVEL_STRICT InputValidationLevel = 3
+)
+
+var InputValidationLevel_name = map[int32]string{
+	0: "INPUT_VALIDATION_LEVEL_UNSPECIFIED",
+	1: "INPUT_VALIDATION_LEVEL_OFF",
+	2: "INPUT_VALIDATION_LEVEL_WARN",
+	3: "INPUT_VALIDATION_LEVEL_STRICT",
+}
+
+var InputValidationLevel_value = map[string]int32{
+	"INPUT_VALIDATION_LEVEL_UNSPECIFIED": 0,
+	"INPUT_VALIDATION_LEVEL_OFF":         1,
+	"INPUT_VALIDATION_LEVEL_WARN":        2,
+	"INPUT_VALIDATION_LEVEL_STRICT":      3,
+}
+
+func (x InputValidationLevel) String() string {
+	return proto.EnumName(InputValidationLevel_name, int32(x))
+}
+
+func (InputValidationLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1e2d1042057ea889, []int{1}
+}
+
 // PartyType are the different roles parties on a contract may use
 type PartyType int32
 
@@ -113,7 +149,7 @@ func (x PartyType) String() string {
 }
 
 func (PartyType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{1}
+	return fileDescriptor_1e2d1042057ea889, []int{2}
 }
 
 // ScopeSpecification defines the required parties, resources, conditions, and consideration outputs for a contract
@@ -128,6 +164,13 @@ type ScopeSpecification struct {
 	PartiesInvolved []PartyType `protobuf:"varint,4,rep,packed,name=parties_involved,json=partiesInvolved,proto3,enum=provenance.metadata.v1.PartyType" json:"parties_involved,omitempty" yaml:"parties_involved"`
 	// A list of contract specification ids allowed for a scope based on this specification.
 	ContractSpecIds []MetadataAddress `protobuf:"bytes,5,rep,name=contract_spec_ids,json=contractSpecIds,proto3,customtype=MetadataAddress" json:"contract_spec_ids" yaml:"contract_spec_ids"`
+	// Whether a signature from any one of the owners can update this specification (changes to the owners or this
+	// setting always require all owners).
+	AnyOwnerCanUpdate bool `protobuf:"varint,6,opt,name=any_owner_can_update,json=anyOwnerCanUpdate,proto3" json:"any_owner_can_update,omitempty" yaml:"any_owner_can_update"`
+	// Whether sessions and records in scopes using this specification are rejected (rather than warned about or
+	// accepted) when they do not satisfy the parties and inputs required by their specifications.  When false, the
+	// strict_scope_specifications module param is used.
+	Strict bool `protobuf:"varint,7,opt,name=strict,proto3" json:"strict,omitempty" yaml:"strict"`
 }
 
 func (m *ScopeSpecification) Reset()      { *m = ScopeSpecification{} }
@@ -183,6 +226,20 @@ func (m *ScopeSpecification) GetPartiesInvolved() []PartyType {
 	return nil
 }
 
+func (m *ScopeSpecification) GetAnyOwnerCanUpdate() bool {
+	if m != nil {
+		return m.AnyOwnerCanUpdate
+	}
+	return false
+}
+
+func (m *ScopeSpecification) GetStrict() bool {
+	if m != nil {
+		return m.Strict
+	}
+	return false
+}
+
 // ContractSpecification defines the required parties, resources, conditions, and consideration outputs for a contract
 type ContractSpecification struct {
 	// unique identifier for this specification on chain
@@ -202,6 +259,9 @@ type ContractSpecification struct {
 	Source isContractSpecification_Source `protobuf_oneof:"source"`
 	// name of the class/type of this contract executable
 	ClassName string `protobuf:"bytes,7,opt,name=class_name,json=className,proto3" json:"class_name,omitempty" yaml:"class_name"`
+	// Whether a signature from any one of the owners can update this specification and its record specifications
+	// (changes to the owners or this setting always require all owners).
+	AnyOwnerCanUpdate bool `protobuf:"varint,8,opt,name=any_owner_can_update,json=anyOwnerCanUpdate,proto3" json:"any_owner_can_update,omitempty" yaml:"any_owner_can_update"`
 }
 
 func (m *ContractSpecification) Reset()      { *m = ContractSpecification{} }
@@ -294,6 +354,13 @@ func (m *ContractSpecification) GetClassName() string {
 	return ""
 }
 
+func (m *ContractSpecification) GetAnyOwnerCanUpdate() bool {
+	if m != nil {
+		return m.AnyOwnerCanUpdate
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ContractSpecification) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -316,6 +383,8 @@ type RecordSpecification struct {
 	ResultType DefinitionType `protobuf:"varint,5,opt,name=result_type,json=resultType,proto3,enum=provenance.metadata.v1.DefinitionType" json:"result_type,omitempty" yaml:"result_type"`
 	// Type of party responsible for this record
 	ResponsibleParties []PartyType `protobuf:"varint,6,rep,packed,name=responsible_parties,json=responsibleParties,proto3,enum=provenance.metadata.v1.PartyType" json:"responsible_parties,omitempty" yaml:"responsible_parties"`
+	// How strictly record inputs are checked against the input specifications (defaults to strict)
+	InputValidation InputValidationLevel `protobuf:"varint,7,opt,name=input_validation,json=inputValidation,proto3,enum=provenance.metadata.v1.InputValidationLevel" json:"input_validation,omitempty" yaml:"input_validation,omitempty"`
 }
 
 func (m *RecordSpecification) Reset()      { *m = RecordSpecification{} }
@@ -385,6 +454,13 @@ func (m *RecordSpecification) GetResponsibleParties() []PartyType {
 	return nil
 }
 
+func (m *RecordSpecification) GetInputValidation() InputValidationLevel {
+	if m != nil {
+		return m.InputValidation
+	}
+	return InputValidationLevel_INPUT_VALIDATION_LEVEL_UNSPECIFIED
+}
+
 // InputSpecification defines a name, type_name, and source reference (either on or off chain) to define an input
 // parameter
 type InputSpecification struct {
@@ -558,6 +634,7 @@ func (m *Description) GetIconUrl() string {
 
 func init() {
 	proto.RegisterEnum("provenance.metadata.v1.DefinitionType", DefinitionType_name, DefinitionType_value)
+	proto.RegisterEnum("provenance.metadata.v1.InputValidationLevel", InputValidationLevel_name, InputValidationLevel_value)
 	proto.RegisterEnum("provenance.metadata.v1.PartyType", PartyType_name, PartyType_value)
 	proto.RegisterType((*ScopeSpecification)(nil), "provenance.metadata.v1.ScopeSpecification")
 	proto.RegisterType((*ContractSpecification)(nil), "provenance.metadata.v1.ContractSpecification")
@@ -571,67 +648,78 @@ func init() {
 }
 
 var fileDescriptor_1e2d1042057ea889 = []byte{
-	// 957 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x8e, 0xda, 0x56,
-	0x14, 0xc6, 0x63, 0x86, 0x81, 0x4b, 0x34, 0x38, 0x77, 0x18, 0xe2, 0x4c, 0x2a, 0x4c, 0x5d, 0xa9,
-	0xa5, 0x23, 0x15, 0x34, 0x24, 0x52, 0xa5, 0xec, 0xf8, 0x31, 0xcd, 0x95, 0x26, 0x06, 0x5d, 0x60,
-	0xaa, 0x54, 0xaa, 0x2c, 0x8f, 0x7d, 0x33, 0x63, 0x15, 0x6c, 0xcb, 0x36, 0xa4, 0xf3, 0x0e, 0x5d,
-	0x74, 0xd7, 0x2e, 0xfb, 0x0c, 0x7d, 0x8a, 0x74, 0x97, 0xee, 0xaa, 0x2c, 0x50, 0x35, 0xf3, 0x04,
-	0xe5, 0x09, 0xaa, 0x7b, 0x6d, 0xc0, 0x78, 0x40, 0xca, 0xa6, 0x5d, 0x65, 0x77, 0xcf, 0xf9, 0xbe,
-	0x73, 0x7c, 0xfc, 0x9d, 0xcf, 0x57, 0x06, 0xa7, 0xae, 0xe7, 0xcc, 0x88, 0xad, 0xdb, 0x06, 0xa9,
-	0x4f, 0x48, 0xa0, 0x9b, 0x7a, 0xa0, 0xd7, 0x67, 0x67, 0x75, 0xdf, 0x25, 0x86, 0xf5, 0xda, 0x32,
-	0xf4, 0xc0, 0x72, 0xec, 0x9a, 0xeb, 0x39, 0x81, 0x03, 0x4b, 0x6b, 0x6e, 0x6d, 0xc9, 0xad, 0xcd,
-	0xce, 0x4e, 0x8a, 0x57, 0xce, 0x95, 0xc3, 0x28, 0x75, 0x7a, 0x0a, 0xd9, 0xf2, 0x9f, 0x3c, 0x80,
-	0x03, 0xc3, 0x71, 0xc9, 0x20, 0xde, 0x0a, 0x7e, 0x0f, 0x84, 0x8d, 0xde, 0x9a, 0x65, 0x8a, 0x5c,
-	0x85, 0xab, 0x3e, 0x68, 0x35, 0xde, 0xce, 0xa5, 0xd4, 0xfb, 0xb9, 0x54, 0x78, 0x19, 0xf5, 0x6e,
-	0x9a, 0xa6, 0x47, 0x7c, 0x7f, 0x31, 0x97, 0x1e, 0xdd, 0xe8, 0x93, 0xf1, 0x73, 0x39, 0x59, 0x28,
-	0xe3, 0xc2, 0x46, 0x0a, 0x99, 0x50, 0x01, 0x79, 0x93, 0xf8, 0x86, 0x67, 0xb9, 0x34, 0x21, 0xee,
-	0x55, 0xb8, 0x6a, 0xbe, 0xf1, 0x59, 0x6d, 0xfb, 0xe4, 0xb5, 0xce, 0x9a, 0x8a, 0xe3, 0x75, 0xb0,
-	0x0d, 0x0a, 0xce, 0x1b, 0x9b, 0x78, 0x9a, 0x1e, 0xce, 0x40, 0x7c, 0x91, 0xaf, 0xf0, 0xd5, 0x5c,
-	0xeb, 0x64, 0x31, 0x97, 0x4a, 0xe1, 0x34, 0x09, 0x82, 0x8c, 0x0f, 0x59, 0xa6, 0xb9, 0x4c, 0x40,
-	0x0b, 0x08, 0xae, 0xee, 0x05, 0x16, 0xf1, 0x35, 0xcb, 0x9e, 0x39, 0xe3, 0x19, 0x31, 0xc5, 0x74,
-	0x85, 0xaf, 0x1e, 0x36, 0x3e, 0xdd, 0x35, 0x50, 0x5f, 0xf7, 0x82, 0x9b, 0xe1, 0x8d, 0x4b, 0x5a,
-	0x4f, 0xd6, 0xaf, 0x9d, 0x6c, 0x22, 0xe3, 0x42, 0x94, 0x42, 0x51, 0x06, 0x6a, 0xe0, 0xa1, 0xe1,
-	0xd8, 0x81, 0xa7, 0x1b, 0x81, 0x46, 0x25, 0xd1, 0x2c, 0xd3, 0x17, 0xf7, 0x2b, 0x7c, 0xf5, 0x41,
-	0xeb, 0xe9, 0x6e, 0x59, 0xc5, 0xb0, 0xff, 0xbd, 0x4a, 0x19, 0x17, 0x96, 0x39, 0xba, 0x3c, 0x64,
-	0xfa, 0xcf, 0xd3, 0xbf, 0xfe, 0x26, 0xa5, 0xe4, 0x5f, 0xd2, 0xe0, 0xb8, 0x1d, 0x43, 0x3e, 0xae,
-	0xf5, 0xbf, 0x5d, 0xeb, 0x39, 0xc8, 0x7b, 0xc4, 0x77, 0xa6, 0x9e, 0x41, 0xa8, 0xa0, 0xfb, 0x4c,
-	0xd0, 0x2f, 0xb7, 0x8b, 0x09, 0xc3, 0xae, 0x31, 0xbe, 0xfc, 0x22, 0x85, 0xc1, 0x32, 0x46, 0x26,
-	0x2c, 0x82, 0xf4, 0xb5, 0xee, 0x5f, 0x8b, 0x99, 0x0a, 0x57, 0xcd, 0xbd, 0x48, 0x61, 0x16, 0xc1,
-	0x67, 0x00, 0x18, 0x63, 0xdd, 0xf7, 0x35, 0x5b, 0x9f, 0x10, 0xf1, 0x80, 0x62, 0xad, 0xe3, 0xc5,
-	0x5c, 0x7a, 0x18, 0x99, 0x63, 0x85, 0xc9, 0x38, 0xc7, 0x02, 0x55, 0x9f, 0x90, 0xd0, 0x0f, 0xad,
-	0x2c, 0xc8, 0x84, 0xdd, 0xe5, 0xf7, 0x3c, 0x38, 0xc2, 0xc4, 0x70, 0x3c, 0xf3, 0x7f, 0xf5, 0x05,
-	0x04, 0x69, 0x36, 0x36, 0x35, 0x44, 0x0e, 0xb3, 0x33, 0x6c, 0x81, 0x8c, 0x65, 0xbb, 0xd3, 0x20,
-	0xdc, 0x6d, 0xbe, 0x71, 0xba, 0x6b, 0x2b, 0x88, 0xb2, 0x36, 0xc6, 0xc5, 0x51, 0x25, 0x3c, 0x03,
-	0xb9, 0xe0, 0xc6, 0x25, 0xa1, 0x26, 0x69, 0xa6, 0x49, 0x71, 0x31, 0x97, 0x84, 0x70, 0xb0, 0x15,
-	0x24, 0xe3, 0x2c, 0x3d, 0x53, 0x45, 0xa0, 0xc6, 0x76, 0x35, 0x1d, 0x07, 0x1a, 0x4d, 0xb1, 0x5d,
-	0x1d, 0x36, 0x3e, 0xdf, 0x6d, 0xd1, 0xd7, 0x96, 0x6d, 0xd1, 0x67, 0x32, 0x5b, 0x94, 0x36, 0x16,
-	0xb8, 0x6c, 0x22, 0xb3, 0xf5, 0x4d, 0xc7, 0x01, 0xe5, 0x40, 0x0f, 0x1c, 0x79, 0xc4, 0x77, 0x1d,
-	0xdb, 0xb7, 0x2e, 0xc7, 0x44, 0x8b, 0xbc, 0x22, 0x66, 0x3e, 0xd4, 0x7a, 0xe5, 0xc5, 0x5c, 0x3a,
-	0x59, 0x3d, 0x23, 0xd9, 0x47, 0xc6, 0x30, 0x96, 0xed, 0x87, 0xc9, 0xe8, 0xb3, 0xff, 0x83, 0x03,
-	0xf0, 0xbe, 0x58, 0x2b, 0xf1, 0xb9, 0x98, 0xf8, 0x1b, 0xc2, 0xed, 0x7d, 0x90, 0x70, 0x5d, 0x90,
-	0xf3, 0x98, 0x73, 0xa8, 0x37, 0x78, 0xe6, 0x8d, 0x2f, 0xb6, 0xfb, 0x42, 0x58, 0x4e, 0x1f, 0xb1,
-	0xa9, 0xc1, 0xb3, 0x61, 0x14, 0xb3, 0x77, 0x3a, 0x6e, 0xef, 0x7b, 0x46, 0xfd, 0x9d, 0x03, 0xf9,
-	0xd8, 0xfd, 0xb0, 0xf5, 0x25, 0x2a, 0x9b, 0xb7, 0x0d, 0xcf, 0xa0, 0x8d, 0x8b, 0xe4, 0x6b, 0x90,
-	0x7f, 0x43, 0x2e, 0x7d, 0x2b, 0x20, 0xda, 0xd4, 0x1b, 0x47, 0x0e, 0x89, 0x2d, 0x31, 0x06, 0xca,
-	0x18, 0x44, 0xd1, 0xc8, 0x1b, 0xc3, 0x1a, 0xc8, 0x5a, 0x86, 0x63, 0xb3, 0xaa, 0x7d, 0x56, 0x75,
-	0xb4, 0x98, 0x4b, 0x85, 0xb0, 0x6a, 0x89, 0xc8, 0xf8, 0x80, 0x1e, 0x47, 0xde, 0x38, 0x1c, 0xff,
-	0xf4, 0x27, 0x0e, 0x1c, 0x6e, 0x3a, 0x06, 0x4a, 0xe0, 0x49, 0x47, 0xe9, 0x22, 0x15, 0x0d, 0x51,
-	0x4f, 0xd5, 0x86, 0xaf, 0xfa, 0x8a, 0x36, 0x52, 0x07, 0x7d, 0xa5, 0x8d, 0xba, 0x48, 0xe9, 0x08,
-	0x29, 0xf8, 0x09, 0x10, 0x93, 0x84, 0x3e, 0xee, 0xf5, 0x7b, 0x03, 0xa5, 0x23, 0x70, 0xf0, 0x04,
-	0x94, 0x92, 0x28, 0x56, 0xda, 0x3d, 0xdc, 0x11, 0xf6, 0xb6, 0xb5, 0x0e, 0x31, 0xed, 0x1c, 0x0d,
-	0x86, 0x02, 0x7f, 0xfa, 0x0f, 0x07, 0x72, 0x2b, 0x5f, 0xd1, 0x56, 0xfd, 0x26, 0x1e, 0xbe, 0xda,
-	0x36, 0xc4, 0x63, 0x70, 0x1c, 0xc3, 0x7a, 0x18, 0x7d, 0x83, 0xd4, 0xe6, 0xb0, 0x87, 0x05, 0x0e,
-	0x3e, 0x02, 0x47, 0x31, 0x68, 0xa0, 0xe0, 0x0b, 0xd4, 0x56, 0xb0, 0xb0, 0x97, 0x00, 0x90, 0x7a,
-	0xa1, 0x0c, 0x68, 0x05, 0x0f, 0x45, 0x50, 0x8c, 0x01, 0xed, 0xd1, 0x60, 0xd8, 0xeb, 0xa0, 0xa6,
-	0x2a, 0xa4, 0x61, 0x11, 0x08, 0xf1, 0xc7, 0x7c, 0xab, 0x2a, 0x58, 0xd8, 0x4f, 0xf0, 0x9b, 0xdd,
-	0x2e, 0x3a, 0x47, 0xcd, 0xa1, 0x22, 0x64, 0x60, 0x09, 0xc0, 0x38, 0xff, 0xa5, 0x8a, 0x5a, 0xa3,
-	0x81, 0x70, 0x90, 0x18, 0xb7, 0x8f, 0x7b, 0x17, 0x8a, 0xda, 0x54, 0xdb, 0x8a, 0x90, 0x6d, 0xfd,
-	0xf0, 0xf6, 0xb6, 0xcc, 0xbd, 0xbb, 0x2d, 0x73, 0x7f, 0xdf, 0x96, 0xb9, 0x9f, 0xef, 0xca, 0xa9,
-	0x77, 0x77, 0xe5, 0xd4, 0x5f, 0x77, 0xe5, 0x14, 0x78, 0x6c, 0x39, 0x3b, 0x3e, 0xbe, 0x3e, 0xf7,
-	0xdd, 0xb3, 0x2b, 0x2b, 0xb8, 0x9e, 0x5e, 0xd6, 0x0c, 0x67, 0x52, 0x5f, 0x93, 0xbe, 0xb2, 0x9c,
-	0x58, 0x54, 0xff, 0x71, 0xfd, 0xeb, 0x45, 0xbf, 0x0a, 0xff, 0x32, 0xc3, 0x7e, 0xa1, 0x9e, 0xfe,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x02, 0x4e, 0x99, 0x71, 0x9e, 0x09, 0x00, 0x00,
+	// 1135 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4d, 0x8f, 0xda, 0x46,
+	0x18, 0xc6, 0x0b, 0x4b, 0x60, 0x48, 0x17, 0xef, 0x2c, 0xd9, 0x90, 0x4d, 0x8b, 0x59, 0x57, 0x4d,
+	0xc9, 0x2a, 0x05, 0x2d, 0x89, 0x54, 0x29, 0x37, 0x3e, 0x4c, 0x33, 0x12, 0x31, 0x68, 0xf8, 0x88,
+	0x52, 0xa9, 0xb2, 0xbc, 0xf6, 0x64, 0x77, 0x54, 0xb0, 0x2d, 0xdb, 0x90, 0x72, 0xe9, 0x2f, 0xe8,
+	0xa1, 0xc7, 0x1e, 0xab, 0xfe, 0x84, 0xfe, 0x8a, 0xf4, 0x52, 0xe5, 0x58, 0xe5, 0x80, 0xaa, 0xdd,
+	0x53, 0x8f, 0xe5, 0x17, 0x54, 0x1e, 0x1b, 0xd6, 0x78, 0xa1, 0x8a, 0x2a, 0xb5, 0xa7, 0xde, 0x3c,
+	0xcf, 0xfb, 0xbc, 0xef, 0xbc, 0xf3, 0xbc, 0xcf, 0x8c, 0x0c, 0x4e, 0x2c, 0xdb, 0x9c, 0x12, 0x43,
+	0x35, 0x34, 0x52, 0x19, 0x13, 0x57, 0xd5, 0x55, 0x57, 0xad, 0x4c, 0x4f, 0x2b, 0x8e, 0x45, 0x34,
+	0xfa, 0x8a, 0x6a, 0xaa, 0x4b, 0x4d, 0xa3, 0x6c, 0xd9, 0xa6, 0x6b, 0xc2, 0xc3, 0x6b, 0x6e, 0x79,
+	0xc9, 0x2d, 0x4f, 0x4f, 0x8f, 0x72, 0xe7, 0xe6, 0xb9, 0xc9, 0x28, 0x15, 0xef, 0xcb, 0x67, 0x8b,
+	0xbf, 0x26, 0x00, 0xec, 0x69, 0xa6, 0x45, 0x7a, 0xe1, 0x52, 0xf0, 0x2b, 0xc0, 0xaf, 0xd5, 0x56,
+	0xa8, 0x9e, 0xe7, 0x8a, 0x5c, 0xe9, 0x76, 0xbd, 0xfa, 0x66, 0x2e, 0xc4, 0xde, 0xcd, 0x85, 0xec,
+	0xf3, 0xa0, 0x76, 0x4d, 0xd7, 0x6d, 0xe2, 0x38, 0x8b, 0xb9, 0x70, 0x77, 0xa6, 0x8e, 0x47, 0x4f,
+	0xc5, 0x68, 0xa2, 0x88, 0xb3, 0x6b, 0x10, 0xd2, 0xa1, 0x04, 0x32, 0x3a, 0x71, 0x34, 0x9b, 0x5a,
+	0x1e, 0x90, 0xdf, 0x29, 0x72, 0xa5, 0x4c, 0xf5, 0xe3, 0xf2, 0xe6, 0xce, 0xcb, 0xcd, 0x6b, 0x2a,
+	0x0e, 0xe7, 0xc1, 0x06, 0xc8, 0x9a, 0xaf, 0x0d, 0x62, 0x2b, 0xaa, 0xdf, 0x03, 0x71, 0xf2, 0xf1,
+	0x62, 0xbc, 0x94, 0xae, 0x1f, 0x2d, 0xe6, 0xc2, 0xa1, 0xdf, 0x4d, 0x84, 0x20, 0xe2, 0x3d, 0x86,
+	0xd4, 0x96, 0x00, 0xa4, 0x80, 0xb7, 0x54, 0xdb, 0xa5, 0xc4, 0x51, 0xa8, 0x31, 0x35, 0x47, 0x53,
+	0xa2, 0xe7, 0x13, 0xc5, 0x78, 0x69, 0xaf, 0x7a, 0xbc, 0xad, 0xa1, 0xae, 0x6a, 0xbb, 0xb3, 0xfe,
+	0xcc, 0x22, 0xf5, 0xfb, 0xd7, 0xc7, 0x8e, 0x16, 0x11, 0x71, 0x36, 0x80, 0x50, 0x80, 0x40, 0x05,
+	0xec, 0x6b, 0xa6, 0xe1, 0xda, 0xaa, 0xe6, 0x2a, 0x9e, 0x24, 0x0a, 0xd5, 0x9d, 0xfc, 0x6e, 0x31,
+	0x5e, 0xba, 0x5d, 0x7f, 0xbc, 0x5d, 0xd6, 0xbc, 0x5f, 0xff, 0x46, 0xa6, 0x88, 0xb3, 0x4b, 0xcc,
+	0x1b, 0x1e, 0xd2, 0x1d, 0xd8, 0x05, 0x39, 0xd5, 0x98, 0x29, 0xfe, 0x99, 0x35, 0xd5, 0x50, 0x26,
+	0x96, 0xae, 0xba, 0x24, 0x9f, 0x2c, 0x72, 0xa5, 0x54, 0x5d, 0x58, 0xcc, 0x85, 0xfb, 0x7e, 0xb1,
+	0x4d, 0x2c, 0x11, 0xef, 0xab, 0xc6, 0xac, 0xe3, 0xa1, 0x0d, 0xd5, 0x18, 0x30, 0x0c, 0x3e, 0x04,
+	0x49, 0xc7, 0xb5, 0xa9, 0xe6, 0xe6, 0x6f, 0xb1, 0x1a, 0xfb, 0x8b, 0xb9, 0xf0, 0x41, 0x30, 0x67,
+	0x86, 0x8b, 0x38, 0x20, 0x3c, 0x4d, 0xfc, 0xf0, 0xa3, 0x10, 0x13, 0xff, 0x48, 0x80, 0x3b, 0x8d,
+	0x50, 0x5b, 0xff, 0x7b, 0xea, 0xdf, 0xf5, 0x54, 0x1b, 0x64, 0x6c, 0xe2, 0x98, 0x13, 0x5b, 0x23,
+	0x9e, 0xa0, 0xbb, 0x4c, 0xd0, 0x87, 0x9b, 0xc5, 0x84, 0x7e, 0xd5, 0x10, 0x5f, 0x7c, 0x16, 0xc3,
+	0x60, 0xb9, 0x46, 0x3a, 0xcc, 0x81, 0xc4, 0x85, 0xea, 0x5c, 0x30, 0xc3, 0xa4, 0x9f, 0xc5, 0x30,
+	0x5b, 0xc1, 0x27, 0x00, 0x68, 0x23, 0xd5, 0x71, 0x14, 0x43, 0x1d, 0x13, 0x66, 0x84, 0x74, 0xfd,
+	0xce, 0x62, 0x2e, 0xec, 0x07, 0xce, 0x5c, 0xc5, 0x44, 0x9c, 0x66, 0x0b, 0x59, 0x1d, 0x93, 0xad,
+	0x66, 0x4c, 0xfd, 0x53, 0x33, 0xfa, 0x0e, 0xab, 0xa7, 0x40, 0xd2, 0xef, 0x57, 0x7c, 0x97, 0x00,
+	0x07, 0x98, 0x68, 0xa6, 0xad, 0xff, 0xa7, 0x4e, 0x83, 0x20, 0xc1, 0x84, 0xf0, 0x2c, 0x96, 0xc6,
+	0xec, 0x1b, 0xd6, 0x41, 0x92, 0x1a, 0xd6, 0xc4, 0xf5, 0xdd, 0x92, 0xa9, 0x9e, 0x6c, 0x9b, 0x33,
+	0xf2, 0x58, 0x6b, 0xed, 0xe2, 0x20, 0x13, 0x9e, 0x82, 0xb4, 0x3b, 0xb3, 0x88, 0xaf, 0x72, 0x82,
+	0xa9, 0x9c, 0x5b, 0xcc, 0x05, 0xde, 0x6f, 0x6c, 0x15, 0x12, 0x71, 0xca, 0xfb, 0x66, 0x1a, 0x2b,
+	0x6c, 0xfa, 0x93, 0x91, 0xab, 0x78, 0x10, 0x9b, 0xfe, 0x5e, 0xf5, 0xc1, 0x76, 0xd3, 0xbf, 0xa2,
+	0x06, 0xf5, 0xf6, 0x64, 0x46, 0x3b, 0x5c, 0xb3, 0xc4, 0xb2, 0x88, 0xc8, 0x0c, 0x31, 0x19, 0xb9,
+	0x1e, 0x07, 0xda, 0xe0, 0xc0, 0x26, 0x8e, 0x65, 0x1a, 0x0e, 0x3d, 0x1b, 0x11, 0x25, 0x70, 0x5f,
+	0x3e, 0xf9, 0xbe, 0x66, 0x2e, 0x2c, 0xe6, 0xc2, 0xd1, 0x6a, 0x8f, 0x68, 0x1d, 0x11, 0xc3, 0x10,
+	0xda, 0xf5, 0x41, 0xf8, 0x2d, 0xe0, 0x99, 0x22, 0xca, 0x54, 0x1d, 0x51, 0x9d, 0x69, 0xc4, 0x4c,
+	0xb7, 0x57, 0x7d, 0xf4, 0xb7, 0xaa, 0x0e, 0x57, 0xf4, 0x36, 0x99, 0x92, 0x51, 0xfd, 0x93, 0xc5,
+	0x5c, 0x38, 0xf6, 0xf7, 0x8e, 0xd6, 0x7b, 0x64, 0x8e, 0xa9, 0x4b, 0xc6, 0x96, 0x3b, 0x13, 0x71,
+	0x96, 0xae, 0x27, 0x07, 0x0f, 0xd9, 0x2f, 0x1c, 0x80, 0x37, 0x87, 0xb5, 0x1a, 0x3e, 0x17, 0x1a,
+	0xfe, 0xda, 0xe0, 0x76, 0xde, 0x6b, 0x70, 0x2d, 0x90, 0xb6, 0x99, 0x73, 0x3d, 0x6f, 0xc6, 0x99,
+	0x37, 0x3f, 0xdd, 0xec, 0x4b, 0x7e, 0xa9, 0x5e, 0xc0, 0xf6, 0xae, 0x6c, 0xca, 0x5f, 0x85, 0x2e,
+	0x6c, 0x22, 0x7c, 0x61, 0x6f, 0x5c, 0x94, 0x9f, 0x39, 0x90, 0x09, 0xbd, 0x78, 0x1b, 0x0f, 0x51,
+	0x5c, 0x7f, 0x3f, 0xe3, 0x2c, 0x14, 0x86, 0xe0, 0xe7, 0x20, 0xf3, 0x9a, 0x9c, 0x39, 0xd4, 0x25,
+	0xca, 0xc4, 0x1e, 0x05, 0x0e, 0x0d, 0x99, 0x28, 0x14, 0x14, 0x31, 0x08, 0x56, 0x03, 0x7b, 0x04,
+	0xcb, 0x20, 0x45, 0x35, 0xd3, 0x60, 0x59, 0xbb, 0x2c, 0xeb, 0x60, 0x31, 0x17, 0xb2, 0xc1, 0x68,
+	0x82, 0x88, 0x88, 0x6f, 0x79, 0x9f, 0x03, 0x7b, 0xe4, 0xb7, 0x7f, 0xf2, 0x1d, 0x07, 0xf6, 0xd6,
+	0x1d, 0x0b, 0x05, 0x70, 0xbf, 0x29, 0xb5, 0x90, 0x8c, 0xfa, 0xa8, 0x23, 0x2b, 0xfd, 0x97, 0x5d,
+	0x49, 0x19, 0xc8, 0xbd, 0xae, 0xd4, 0x40, 0x2d, 0x24, 0x35, 0xf9, 0x18, 0xfc, 0x10, 0xe4, 0xa3,
+	0x84, 0x2e, 0xee, 0x74, 0x3b, 0x3d, 0xa9, 0xc9, 0x73, 0xf0, 0x08, 0x1c, 0x46, 0xa3, 0x58, 0x6a,
+	0x74, 0x70, 0x93, 0xdf, 0xd9, 0x54, 0xda, 0x8f, 0x29, 0x6d, 0xd4, 0xeb, 0xf3, 0xf1, 0x93, 0x9f,
+	0x38, 0x90, 0xdb, 0x64, 0x33, 0xf8, 0x00, 0x88, 0x48, 0xee, 0x0e, 0xfa, 0xca, 0xb0, 0xd6, 0x46,
+	0xcd, 0x1a, 0xcb, 0x6f, 0x4b, 0x43, 0xa9, 0x1d, 0xe9, 0xad, 0x00, 0x8e, 0xb6, 0xf0, 0x3a, 0xad,
+	0x16, 0xcf, 0x79, 0x1d, 0x6c, 0x89, 0xbf, 0xa8, 0x61, 0x99, 0xdf, 0x81, 0xc7, 0xe0, 0xa3, 0x2d,
+	0x84, 0x5e, 0x1f, 0xa3, 0x86, 0xd7, 0xe4, 0x9f, 0x1c, 0x48, 0xaf, 0x2e, 0x9f, 0x77, 0xde, 0x6e,
+	0x0d, 0xf7, 0x5f, 0x6e, 0x52, 0xea, 0x1e, 0xb8, 0x13, 0x8a, 0x75, 0x30, 0xfa, 0x02, 0xc9, 0xb5,
+	0x7e, 0x07, 0xf3, 0x1c, 0xbc, 0x0b, 0x0e, 0x42, 0xa1, 0x9e, 0x84, 0x87, 0xa8, 0x21, 0x61, 0x7e,
+	0x27, 0x12, 0x40, 0xf2, 0x50, 0xea, 0x79, 0x19, 0x71, 0x98, 0x07, 0xb9, 0x50, 0xa0, 0x31, 0xe8,
+	0xf5, 0x3b, 0x4d, 0x54, 0x93, 0xf9, 0x04, 0xcc, 0x01, 0x3e, 0xbc, 0xcd, 0x0b, 0x59, 0xc2, 0xfc,
+	0x6e, 0x84, 0x5f, 0x6b, 0xb5, 0x50, 0x1b, 0xd5, 0xfa, 0x12, 0x9f, 0x84, 0x87, 0x00, 0x86, 0xf9,
+	0xcf, 0x65, 0x54, 0x1f, 0xf4, 0xf8, 0x5b, 0x91, 0x76, 0xbb, 0xb8, 0x33, 0x94, 0xe4, 0x9a, 0xdc,
+	0x90, 0xf8, 0x54, 0xfd, 0xeb, 0x37, 0x97, 0x05, 0xee, 0xed, 0x65, 0x81, 0xfb, 0xfd, 0xb2, 0xc0,
+	0x7d, 0x7f, 0x55, 0x88, 0xbd, 0xbd, 0x2a, 0xc4, 0x7e, 0xbb, 0x2a, 0xc4, 0xc0, 0x3d, 0x6a, 0x6e,
+	0x79, 0x30, 0xba, 0xdc, 0x97, 0x4f, 0xce, 0xa9, 0x7b, 0x31, 0x39, 0x2b, 0x6b, 0xe6, 0xb8, 0x72,
+	0x4d, 0xfa, 0x8c, 0x9a, 0xa1, 0x55, 0xe5, 0x9b, 0xeb, 0xdf, 0x6d, 0xef, 0xea, 0x3a, 0x67, 0x49,
+	0xf6, 0xdb, 0xfc, 0xf8, 0xaf, 0x01, 0x00, 0x06, 0x8c, 0x15, 0x48, 0x92, 0x0b, 0x00, 0x00,
 }
 
 func (m *ScopeSpecification) Marshal() (dAtA []byte, err error) {
@@ -654,6 +742,26 @@ func (m *ScopeSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Strict {
+		i--
+		if m.Strict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AnyOwnerCanUpdate {
+		i--
+		if m.AnyOwnerCanUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.ContractSpecIds) > 0 {
 		for iNdEx := len(m.ContractSpecIds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -740,6 +848,16 @@ func (m *ContractSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AnyOwnerCanUpdate {
+		i--
+		if m.AnyOwnerCanUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ClassName) > 0 {
 		i -= len(m.ClassName)
 		copy(dAtA[i:], m.ClassName)
@@ -861,6 +979,11 @@ func (m *RecordSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InputValidation != 0 {
+		i = encodeVarintSpecification(dAtA, i, uint64(m.InputValidation))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.ResponsibleParties) > 0 {
 		dAtA8 := make([]byte, len(m.ResponsibleParties)*10)
 		var j7 int
@@ -1097,6 +1220,12 @@ func (m *ScopeSpecification) Size() (n int) {
 			n += 1 + l + sovSpecification(uint64(l))
 		}
 	}
+	if m.AnyOwnerCanUpdate {
+		n += 2
+	}
+	if m.Strict {
+		n += 2
+	}
 	return n
 }
 
@@ -1132,6 +1261,9 @@ func (m *ContractSpecification) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSpecification(uint64(l))
 	}
+	if m.AnyOwnerCanUpdate {
+		n += 2
+	}
 	return n
 }
 
@@ -1187,6 +1319,9 @@ func (m *RecordSpecification) Size() (n int) {
 		}
 		n += 1 + sovSpecification(uint64(l)) + l
 	}
+	if m.InputValidation != 0 {
+		n += 1 + sovSpecification(uint64(m.InputValidation))
+	}
 	return n
 }
 
@@ -1495,6 +1630,46 @@ func (m *ScopeSpecification) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyOwnerCanUpdate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AnyOwnerCanUpdate = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Strict = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
@@ -1815,6 +1990,26 @@ func (m *ContractSpecification) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyOwnerCanUpdate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AnyOwnerCanUpdate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
@@ -2084,6 +2279,25 @@ func (m *RecordSpecification) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ResponsibleParties", wireType)
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputValidation", wireType)
+			}
+			m.InputValidation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InputValidation |= InputValidationLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
@@ -691,6 +691,21 @@ func (s *SpecificationTestSuite) TestRecordSpecValidateBasic() {
 			},
 			"record specification result type cannot be unspecified",
 		},
+
+		// InputValidation test
+		{
+			"input validation level must be known",
+			&RecordSpecification{
+				SpecificationId:    RecordSpecMetadataAddress(contractSpecUUID, "recspecname"),
+				Name:               "recspecname",
+				Inputs:             []*InputSpecification{},
+				TypeName:           "recspectypename",
+				ResultType:         DefinitionType_DEFINITION_TYPE_RECORD,
+				ResponsibleParties: []PartyType{PartyType_PARTY_TYPE_OWNER},
+				InputValidation:    InputValidationLevel(99),
+			},
+			"invalid record specification input validation level: 99",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1160,6 +1175,8 @@ parties_involved:
 - 5
 contract_spec_ids:
 - contractspec1qd2qmt038k7yc0azq46htdlhgwzqg6cr9l
+any_owner_can_update: false
+strict: false
 `
 	actual := scopeSpec.String()
 	// fmt.Printf("Actual:\n%s\n-----\n", actual)
@@ -1193,6 +1210,7 @@ parties_involved:
 - 5
 source: null
 class_name: 'CS 201: Intro to Blockchain'
+any_owner_can_update: false
 `
 	actual := contractSpec.String()
 	// fmt.Printf("Actual:\n%s\n-----\n", actual)
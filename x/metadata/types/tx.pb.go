@@ -1317,6 +1317,164 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse proto.InternalMessageInfo
 
+// MsgAddSpecificationOwnerRequest is the request type for the Msg/AddSpecificationOwner RPC method.
+type MsgAddSpecificationOwnerRequest struct {
+	// MetadataAddress for the scope or contract specification to add owners to.
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	// AccAddress owner addresses to be added to the specification
+	Owners []string `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners,omitempty" yaml:"owners"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgAddSpecificationOwnerRequest) Reset()      { *m = MsgAddSpecificationOwnerRequest{} }
+func (*MsgAddSpecificationOwnerRequest) ProtoMessage() {}
+func (*MsgAddSpecificationOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{29}
+}
+func (m *MsgAddSpecificationOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddSpecificationOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddSpecificationOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddSpecificationOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddSpecificationOwnerRequest.Merge(m, src)
+}
+func (m *MsgAddSpecificationOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddSpecificationOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddSpecificationOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddSpecificationOwnerRequest proto.InternalMessageInfo
+
+// MsgAddSpecificationOwnerResponse is the response type for the Msg/AddSpecificationOwner RPC method.
+type MsgAddSpecificationOwnerResponse struct {
+}
+
+func (m *MsgAddSpecificationOwnerResponse) Reset()         { *m = MsgAddSpecificationOwnerResponse{} }
+func (m *MsgAddSpecificationOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddSpecificationOwnerResponse) ProtoMessage()    {}
+func (*MsgAddSpecificationOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{30}
+}
+func (m *MsgAddSpecificationOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddSpecificationOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddSpecificationOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddSpecificationOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddSpecificationOwnerResponse.Merge(m, src)
+}
+func (m *MsgAddSpecificationOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddSpecificationOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddSpecificationOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddSpecificationOwnerResponse proto.InternalMessageInfo
+
+// MsgDeleteSpecificationOwnerRequest is the request type for the Msg/DeleteSpecificationOwner RPC method.
+type MsgDeleteSpecificationOwnerRequest struct {
+	// MetadataAddress for the scope or contract specification to remove owners from.
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	// AccAddress owner addresses to be removed from the specification
+	Owners []string `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners,omitempty" yaml:"owners"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgDeleteSpecificationOwnerRequest) Reset()      { *m = MsgDeleteSpecificationOwnerRequest{} }
+func (*MsgDeleteSpecificationOwnerRequest) ProtoMessage() {}
+func (*MsgDeleteSpecificationOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{31}
+}
+func (m *MsgDeleteSpecificationOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteSpecificationOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteSpecificationOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteSpecificationOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteSpecificationOwnerRequest.Merge(m, src)
+}
+func (m *MsgDeleteSpecificationOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteSpecificationOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteSpecificationOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteSpecificationOwnerRequest proto.InternalMessageInfo
+
+// MsgDeleteSpecificationOwnerResponse is the response type for the Msg/DeleteSpecificationOwner RPC method.
+type MsgDeleteSpecificationOwnerResponse struct {
+}
+
+func (m *MsgDeleteSpecificationOwnerResponse) Reset()         { *m = MsgDeleteSpecificationOwnerResponse{} }
+func (m *MsgDeleteSpecificationOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteSpecificationOwnerResponse) ProtoMessage()    {}
+func (*MsgDeleteSpecificationOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{32}
+}
+func (m *MsgDeleteSpecificationOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteSpecificationOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteSpecificationOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteSpecificationOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteSpecificationOwnerResponse.Merge(m, src)
+}
+func (m *MsgDeleteSpecificationOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteSpecificationOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteSpecificationOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteSpecificationOwnerResponse proto.InternalMessageInfo
+
 // MsgDeleteContractSpecificationRequest is the request type for the Msg/DeleteContractSpecification RPC method.
 type MsgDeleteContractSpecificationRequest struct {
 	// MetadataAddress for the contract specification to delete.
@@ -1327,7 +1485,7 @@ type MsgDeleteContractSpecificationRequest struct {
 func (m *MsgDeleteContractSpecificationRequest) Reset()      { *m = MsgDeleteContractSpecificationRequest{} }
 func (*MsgDeleteContractSpecificationRequest) ProtoMessage() {}
 func (*MsgDeleteContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{29}
+	return fileDescriptor_3a3a0892f91e3036, []int{33}
 }
 func (m *MsgDeleteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1366,7 +1524,7 @@ func (m *MsgDeleteContractSpecificationResponse) Reset() {
 func (m *MsgDeleteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteContractSpecificationResponse) ProtoMessage()    {}
 func (*MsgDeleteContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{30}
+	return fileDescriptor_3a3a0892f91e3036, []int{34}
 }
 func (m *MsgDeleteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1413,7 +1571,7 @@ type MsgWriteRecordSpecificationRequest struct {
 func (m *MsgWriteRecordSpecificationRequest) Reset()      { *m = MsgWriteRecordSpecificationRequest{} }
 func (*MsgWriteRecordSpecificationRequest) ProtoMessage() {}
 func (*MsgWriteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{31}
+	return fileDescriptor_3a3a0892f91e3036, []int{35}
 }
 func (m *MsgWriteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1453,7 +1611,7 @@ func (m *MsgWriteRecordSpecificationResponse) Reset()         { *m = MsgWriteRec
 func (m *MsgWriteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteRecordSpecificationResponse) ProtoMessage()    {}
 func (*MsgWriteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{32}
+	return fileDescriptor_3a3a0892f91e3036, []int{36}
 }
 func (m *MsgWriteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1499,7 +1657,7 @@ type MsgDeleteRecordSpecificationRequest struct {
 func (m *MsgDeleteRecordSpecificationRequest) Reset()      { *m = MsgDeleteRecordSpecificationRequest{} }
 func (*MsgDeleteRecordSpecificationRequest) ProtoMessage() {}
 func (*MsgDeleteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{33}
+	return fileDescriptor_3a3a0892f91e3036, []int{37}
 }
 func (m *MsgDeleteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1536,7 +1694,7 @@ func (m *MsgDeleteRecordSpecificationResponse) Reset()         { *m = MsgDeleteR
 func (m *MsgDeleteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteRecordSpecificationResponse) ProtoMessage()    {}
 func (*MsgDeleteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{34}
+	return fileDescriptor_3a3a0892f91e3036, []int{38}
 }
 func (m *MsgDeleteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1575,7 +1733,7 @@ type MsgWriteP8EContractSpecRequest struct {
 func (m *MsgWriteP8EContractSpecRequest) Reset()      { *m = MsgWriteP8EContractSpecRequest{} }
 func (*MsgWriteP8EContractSpecRequest) ProtoMessage() {}
 func (*MsgWriteP8EContractSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{35}
+	return fileDescriptor_3a3a0892f91e3036, []int{39}
 }
 func (m *MsgWriteP8EContractSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1618,7 +1776,7 @@ func (m *MsgWriteP8EContractSpecResponse) Reset()         { *m = MsgWriteP8ECont
 func (m *MsgWriteP8EContractSpecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteP8EContractSpecResponse) ProtoMessage()    {}
 func (*MsgWriteP8EContractSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{36}
+	return fileDescriptor_3a3a0892f91e3036, []int{40}
 }
 func (m *MsgWriteP8EContractSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1682,7 +1840,7 @@ type MsgP8EMemorializeContractRequest struct {
 func (m *MsgP8EMemorializeContractRequest) Reset()      { *m = MsgP8EMemorializeContractRequest{} }
 func (*MsgP8EMemorializeContractRequest) ProtoMessage() {}
 func (*MsgP8EMemorializeContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{37}
+	return fileDescriptor_3a3a0892f91e3036, []int{41}
 }
 func (m *MsgP8EMemorializeContractRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1725,7 +1883,7 @@ func (m *MsgP8EMemorializeContractResponse) Reset()         { *m = MsgP8EMemoria
 func (m *MsgP8EMemorializeContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgP8EMemorializeContractResponse) ProtoMessage()    {}
 func (*MsgP8EMemorializeContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{38}
+	return fileDescriptor_3a3a0892f91e3036, []int{42}
 }
 func (m *MsgP8EMemorializeContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1785,7 +1943,7 @@ func (m *MsgBindOSLocatorRequest) Reset()         { *m = MsgBindOSLocatorRequest
 func (m *MsgBindOSLocatorRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgBindOSLocatorRequest) ProtoMessage()    {}
 func (*MsgBindOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{39}
+	return fileDescriptor_3a3a0892f91e3036, []int{43}
 }
 func (m *MsgBindOSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1823,7 +1981,7 @@ func (m *MsgBindOSLocatorResponse) Reset()         { *m = MsgBindOSLocatorRespon
 func (m *MsgBindOSLocatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBindOSLocatorResponse) ProtoMessage()    {}
 func (*MsgBindOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{40}
+	return fileDescriptor_3a3a0892f91e3036, []int{44}
 }
 func (m *MsgBindOSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1869,7 +2027,7 @@ func (m *MsgDeleteOSLocatorRequest) Reset()         { *m = MsgDeleteOSLocatorReq
 func (m *MsgDeleteOSLocatorRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteOSLocatorRequest) ProtoMessage()    {}
 func (*MsgDeleteOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{41}
+	return fileDescriptor_3a3a0892f91e3036, []int{45}
 }
 func (m *MsgDeleteOSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1907,7 +2065,7 @@ func (m *MsgDeleteOSLocatorResponse) Reset()         { *m = MsgDeleteOSLocatorRe
 func (m *MsgDeleteOSLocatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteOSLocatorResponse) ProtoMessage()    {}
 func (*MsgDeleteOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{42}
+	return fileDescriptor_3a3a0892f91e3036, []int{46}
 }
 func (m *MsgDeleteOSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1953,7 +2111,7 @@ func (m *MsgModifyOSLocatorRequest) Reset()         { *m = MsgModifyOSLocatorReq
 func (m *MsgModifyOSLocatorRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyOSLocatorRequest) ProtoMessage()    {}
 func (*MsgModifyOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{43}
+	return fileDescriptor_3a3a0892f91e3036, []int{47}
 }
 func (m *MsgModifyOSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1991,7 +2149,7 @@ func (m *MsgModifyOSLocatorResponse) Reset()         { *m = MsgModifyOSLocatorRe
 func (m *MsgModifyOSLocatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyOSLocatorResponse) ProtoMessage()    {}
 func (*MsgModifyOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{44}
+	return fileDescriptor_3a3a0892f91e3036, []int{48}
 }
 func (m *MsgModifyOSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,6 +2185,171 @@ func (m *MsgModifyOSLocatorResponse) GetLocator() ObjectStoreLocator {
 	return ObjectStoreLocator{}
 }
 
+// MsgRequestReverificationRequest is the request type for the Msg/RequestReverification RPC method.
+type MsgRequestReverificationRequest struct {
+	// record_id is the id of the disputed record.
+	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id" yaml:"record_id"`
+	// disputed_hash is the record output hash that does not match the object, empty if the whole record is disputed.
+	DisputedHash string `protobuf:"bytes,2,opt,name=disputed_hash,json=disputedHash,proto3" json:"disputed_hash,omitempty" yaml:"disputed_hash"`
+	// observed_hash is the hash the requester computed from the off-chain object.
+	ObservedHash string `protobuf:"bytes,3,opt,name=observed_hash,json=observedHash,proto3" json:"observed_hash,omitempty" yaml:"observed_hash"`
+	// reason describes the mismatch.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// requester is the address of the data consumer requesting the re-verification.
+	Requester string `protobuf:"bytes,5,opt,name=requester,proto3" json:"requester,omitempty"`
+}
+
+func (m *MsgRequestReverificationRequest) Reset()      { *m = MsgRequestReverificationRequest{} }
+func (*MsgRequestReverificationRequest) ProtoMessage() {}
+func (*MsgRequestReverificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{49}
+}
+func (m *MsgRequestReverificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestReverificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestReverificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestReverificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestReverificationRequest.Merge(m, src)
+}
+func (m *MsgRequestReverificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestReverificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestReverificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestReverificationRequest proto.InternalMessageInfo
+
+// MsgRequestReverificationResponse is the response type for the Msg/RequestReverification RPC method.
+type MsgRequestReverificationResponse struct {
+}
+
+func (m *MsgRequestReverificationResponse) Reset()         { *m = MsgRequestReverificationResponse{} }
+func (m *MsgRequestReverificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestReverificationResponse) ProtoMessage()    {}
+func (*MsgRequestReverificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{50}
+}
+func (m *MsgRequestReverificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestReverificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestReverificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestReverificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestReverificationResponse.Merge(m, src)
+}
+func (m *MsgRequestReverificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestReverificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestReverificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestReverificationResponse proto.InternalMessageInfo
+
+// MsgResolveReverificationRequest is the request type for the Msg/ResolveReverification RPC method.
+type MsgResolveReverificationRequest struct {
+	// record_id is the id of the disputed record.
+	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id" yaml:"record_id"`
+	// requester is the address that requested the re-verification.
+	Requester string `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	// status is the resolution, CONFIRMED or CORRECTED by the scope owners, or WITHDRAWN by the requester.
+	Status ReverificationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=provenance.metadata.v1.ReverificationStatus" json:"status,omitempty"`
+	// note describes the resolution.
+	Note    string   `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	Signers []string `protobuf:"bytes,5,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgResolveReverificationRequest) Reset()      { *m = MsgResolveReverificationRequest{} }
+func (*MsgResolveReverificationRequest) ProtoMessage() {}
+func (*MsgResolveReverificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{51}
+}
+func (m *MsgResolveReverificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveReverificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveReverificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveReverificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveReverificationRequest.Merge(m, src)
+}
+func (m *MsgResolveReverificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveReverificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveReverificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveReverificationRequest proto.InternalMessageInfo
+
+// MsgResolveReverificationResponse is the response type for the Msg/ResolveReverification RPC method.
+type MsgResolveReverificationResponse struct {
+}
+
+func (m *MsgResolveReverificationResponse) Reset()         { *m = MsgResolveReverificationResponse{} }
+func (m *MsgResolveReverificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveReverificationResponse) ProtoMessage()    {}
+func (*MsgResolveReverificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{52}
+}
+func (m *MsgResolveReverificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveReverificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveReverificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveReverificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveReverificationResponse.Merge(m, src)
+}
+func (m *MsgResolveReverificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveReverificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveReverificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveReverificationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgWriteScopeRequest)(nil), "provenance.metadata.v1.MsgWriteScopeRequest")
 	proto.RegisterType((*MsgWriteScopeResponse)(nil), "provenance.metadata.v1.MsgWriteScopeResponse")
@@ -2057,6 +2380,10 @@ func init() {
 	proto.RegisterType((*MsgAddContractSpecToScopeSpecResponse)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecResponse")
 	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecRequest")
 	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecResponse")
+	proto.RegisterType((*MsgAddSpecificationOwnerRequest)(nil), "provenance.metadata.v1.MsgAddSpecificationOwnerRequest")
+	proto.RegisterType((*MsgAddSpecificationOwnerResponse)(nil), "provenance.metadata.v1.MsgAddSpecificationOwnerResponse")
+	proto.RegisterType((*MsgDeleteSpecificationOwnerRequest)(nil), "provenance.metadata.v1.MsgDeleteSpecificationOwnerRequest")
+	proto.RegisterType((*MsgDeleteSpecificationOwnerResponse)(nil), "provenance.metadata.v1.MsgDeleteSpecificationOwnerResponse")
 	proto.RegisterType((*MsgDeleteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationRequest")
 	proto.RegisterType((*MsgDeleteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationResponse")
 	proto.RegisterType((*MsgWriteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationRequest")
@@ -2073,140 +2400,159 @@ func init() {
 	proto.RegisterType((*MsgDeleteOSLocatorResponse)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorResponse")
 	proto.RegisterType((*MsgModifyOSLocatorRequest)(nil), "provenance.metadata.v1.MsgModifyOSLocatorRequest")
 	proto.RegisterType((*MsgModifyOSLocatorResponse)(nil), "provenance.metadata.v1.MsgModifyOSLocatorResponse")
+	proto.RegisterType((*MsgRequestReverificationRequest)(nil), "provenance.metadata.v1.MsgRequestReverificationRequest")
+	proto.RegisterType((*MsgRequestReverificationResponse)(nil), "provenance.metadata.v1.MsgRequestReverificationResponse")
+	proto.RegisterType((*MsgResolveReverificationRequest)(nil), "provenance.metadata.v1.MsgResolveReverificationRequest")
+	proto.RegisterType((*MsgResolveReverificationResponse)(nil), "provenance.metadata.v1.MsgResolveReverificationResponse")
 }
 
 func init() { proto.RegisterFile("provenance/metadata/v1/tx.proto", fileDescriptor_3a3a0892f91e3036) }
 
 var fileDescriptor_3a3a0892f91e3036 = []byte{
-	// 2045 bytes of a gzipped FileDescriptorProto
+	// 2285 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
-	0x15, 0xdf, 0xbb, 0xdb, 0xc4, 0xf6, 0xb1, 0x8d, 0x9d, 0x1b, 0x7f, 0xec, 0x4e, 0x9a, 0x1d, 0x73,
-	0x9b, 0xb4, 0xae, 0xd3, 0xec, 0x12, 0x37, 0x34, 0x8e, 0x9b, 0x00, 0xd9, 0x16, 0x14, 0x43, 0xad,
-	0x44, 0x63, 0xa0, 0x02, 0x09, 0x45, 0x9b, 0x9d, 0x6b, 0x67, 0xa8, 0x3d, 0x77, 0x3b, 0x33, 0xce,
-	0x17, 0x0f, 0xa5, 0x12, 0x0f, 0x51, 0x85, 0x50, 0x01, 0xa9, 0xa2, 0x12, 0xaa, 0xf2, 0xd8, 0x07,
-	0x24, 0x3e, 0x1e, 0x11, 0x7f, 0x40, 0x85, 0x84, 0xd4, 0x17, 0x24, 0x54, 0xd0, 0xaa, 0x4a, 0x24,
-	0xc4, 0xf3, 0x3e, 0xf0, 0x8c, 0x66, 0xe6, 0xce, 0xce, 0xbd, 0xbb, 0x77, 0x3e, 0x76, 0xeb, 0x06,
-	0x23, 0xf1, 0x10, 0x29, 0x33, 0x7b, 0xbe, 0x7e, 0xe7, 0xfe, 0xee, 0x39, 0xf7, 0x9e, 0x31, 0xe8,
-	0x6d, 0x87, 0xdd, 0xa6, 0x76, 0xd3, 0x6e, 0xd1, 0xfa, 0x1e, 0xf5, 0x9a, 0x66, 0xd3, 0x6b, 0xd6,
-	0x6f, 0x9f, 0xab, 0x7b, 0x77, 0x6b, 0x6d, 0x87, 0x79, 0x0c, 0x2f, 0xc4, 0x02, 0xb5, 0x48, 0xa0,
-	0x76, 0xfb, 0x9c, 0x36, 0xb7, 0xc3, 0x76, 0x58, 0x20, 0x52, 0xf7, 0xff, 0x17, 0x4a, 0x6b, 0xa7,
-	0x13, 0xcc, 0xf5, 0x34, 0x43, 0xb1, 0xe5, 0x04, 0x31, 0x76, 0xf3, 0x87, 0xb4, 0xe5, 0xb9, 0x1e,
-	0x73, 0x28, 0x97, 0x3c, 0x95, 0x20, 0xd9, 0x5e, 0xa3, 0xfe, 0x3f, 0x2e, 0x45, 0x12, 0xa4, 0xdc,
-	0x16, 0x6b, 0x47, 0x32, 0x2b, 0x49, 0x32, 0x6d, 0xda, 0xb2, 0xb6, 0xad, 0x56, 0xd3, 0xb3, 0x98,
-	0x1d, 0xca, 0x92, 0x7f, 0x22, 0x98, 0xdb, 0x74, 0x77, 0x5e, 0x77, 0x2c, 0x8f, 0x6e, 0xf9, 0x36,
-	0x0c, 0xfa, 0xe6, 0x3e, 0x75, 0x3d, 0x7c, 0x11, 0x8e, 0x04, 0x36, 0xcb, 0x68, 0x09, 0x2d, 0x4f,
-	0xae, 0x9e, 0xac, 0xa9, 0xb3, 0x53, 0x0b, 0x94, 0x1a, 0x4f, 0x7d, 0xd4, 0xd1, 0x0b, 0x46, 0xa8,
-	0x81, 0xcb, 0x30, 0xe6, 0x5a, 0x3b, 0x36, 0x75, 0xdc, 0x72, 0x71, 0xa9, 0xb4, 0x3c, 0x61, 0x44,
-	0x8f, 0xf8, 0x3c, 0x40, 0x20, 0x72, 0x63, 0x7f, 0xdf, 0x32, 0xcb, 0xa5, 0x25, 0xb4, 0x3c, 0xd1,
-	0x98, 0xef, 0x76, 0xf4, 0x63, 0xf7, 0x9a, 0x7b, 0xbb, 0xeb, 0x24, 0xfe, 0x8d, 0x18, 0x13, 0xc1,
-	0xc3, 0x77, 0xf6, 0x2d, 0x13, 0x9f, 0x83, 0x09, 0x3f, 0xf4, 0x50, 0xe9, 0xa9, 0x40, 0x69, 0xae,
-	0xdb, 0xd1, 0x67, 0xb9, 0x52, 0xf4, 0x13, 0x31, 0xc6, 0xfd, 0xff, 0xfb, 0x2a, 0xeb, 0xb3, 0x0f,
-	0x1e, 0xea, 0x85, 0x5f, 0x3d, 0xd4, 0x0b, 0xff, 0x7a, 0xa8, 0x17, 0x7e, 0xfc, 0x8f, 0xa5, 0x02,
-	0xb9, 0x0f, 0xf3, 0x7d, 0x38, 0xdd, 0x36, 0xb3, 0x5d, 0x8a, 0x9b, 0x30, 0x1d, 0xfa, 0xb5, 0xcc,
-	0x1b, 0x96, 0xbd, 0xcd, 0x38, 0xe0, 0x67, 0x52, 0x01, 0x6f, 0x98, 0x1b, 0xf6, 0x36, 0x6b, 0x94,
-	0xbb, 0x1d, 0x7d, 0x4e, 0x8c, 0x9d, 0xdb, 0x20, 0xc6, 0xa4, 0x1b, 0x8b, 0x91, 0x77, 0x50, 0xe0,
-	0xfc, 0x55, 0xba, 0x4b, 0xfb, 0xb2, 0xfc, 0x75, 0x18, 0x8f, 0x14, 0x03, 0xbf, 0x53, 0x8d, 0x15,
-	0x3f, 0x93, 0x9f, 0x74, 0xf4, 0x99, 0x4d, 0xee, 0xf3, 0x8a, 0x69, 0x3a, 0xd4, 0x75, 0xbb, 0x1d,
-	0x7d, 0x46, 0xf6, 0x44, 0x8c, 0x31, 0xee, 0x24, 0x39, 0xe3, 0x8a, 0x44, 0x94, 0x61, 0xa1, 0x3f,
-	0x96, 0x30, 0x13, 0xe4, 0xcf, 0x08, 0x9e, 0xde, 0x74, 0x77, 0xae, 0x98, 0x66, 0xf0, 0xfe, 0x55,
-	0xdf, 0x79, 0xab, 0x45, 0x5d, 0xf7, 0x80, 0xa3, 0xbd, 0x00, 0x93, 0xbe, 0xe8, 0x8d, 0x66, 0x60,
-	0x3c, 0x8c, 0xb8, 0xb1, 0xd0, 0xed, 0xe8, 0x38, 0x54, 0x11, 0x7e, 0x24, 0x06, 0x98, 0xbd, 0x30,
-	0x44, 0x98, 0xa5, 0x2c, 0x98, 0x3a, 0x9c, 0x4c, 0xc0, 0xc2, 0xd1, 0xfe, 0x05, 0x81, 0x2e, 0x27,
-	0xe2, 0x7f, 0x1b, 0x30, 0x81, 0xa5, 0x64, 0x38, 0x1c, 0xf3, 0x27, 0x08, 0x16, 0x85, 0xac, 0x5c,
-	0xbb, 0x63, 0x53, 0xe7, 0x80, 0xb1, 0xbe, 0x06, 0x47, 0xd9, 0x9d, 0x1e, 0x13, 0x53, 0x0a, 0xc7,
-	0xf5, 0xa6, 0xe3, 0xdd, 0x6b, 0xcc, 0xfb, 0x3e, 0xba, 0x1d, 0x7d, 0x3a, 0x34, 0x18, 0xaa, 0x12,
-	0x83, 0xdb, 0x18, 0x2a, 0x01, 0x1a, 0x94, 0x07, 0xb1, 0x71, 0xe0, 0x7f, 0x44, 0xa0, 0xc9, 0xd9,
-	0xf9, 0x3c, 0xb0, 0x3f, 0x2f, 0x61, 0x9f, 0x68, 0x1c, 0x3b, 0x18, 0x60, 0x27, 0xe1, 0x84, 0x32,
-	0x76, 0x8e, 0xed, 0x4f, 0xc5, 0x60, 0x47, 0x87, 0xa5, 0x8d, 0xba, 0xae, 0xc5, 0xec, 0x08, 0xd7,
-	0x57, 0x61, 0xcc, 0x0d, 0xdf, 0xf0, 0xaa, 0xa6, 0x27, 0x56, 0xb5, 0x50, 0x8c, 0x17, 0xf2, 0x48,
-	0x2b, 0xa5, 0x94, 0xbf, 0x8d, 0x60, 0x9e, 0x4b, 0xf9, 0x55, 0xaf, 0xc5, 0xf6, 0xda, 0xcc, 0xa6,
-	0xb6, 0xe7, 0x06, 0x65, 0x7d, 0x72, 0xf5, 0x4c, 0x86, 0xa7, 0x0d, 0xf3, 0x95, 0x9e, 0x4a, 0x63,
-	0xa9, 0xdb, 0xd1, 0x9f, 0xe6, 0x69, 0x55, 0xd9, 0x24, 0xc6, 0x71, 0x77, 0x50, 0xed, 0x60, 0x1a,
-	0xc3, 0x5f, 0x11, 0x1c, 0x57, 0xc4, 0x84, 0x5f, 0x92, 0x7a, 0x15, 0x4a, 0xe9, 0x55, 0x57, 0x0b,
-	0x62, 0xb7, 0xea, 0xe9, 0x35, 0x4d, 0xd3, 0x29, 0x17, 0xd5, 0x7a, 0xfe, 0x6f, 0xb1, 0x9e, 0xcf,
-	0x2d, 0xbc, 0x0e, 0x53, 0x11, 0x76, 0xa1, 0x3b, 0x2e, 0x76, 0x3b, 0xfa, 0x71, 0x39, 0x33, 0x21,
-	0xa4, 0x49, 0xfe, 0xe8, 0xfb, 0x6c, 0x60, 0x98, 0x8d, 0xe8, 0x48, 0x6d, 0xcf, 0xda, 0xb6, 0xa8,
-	0x43, 0x7e, 0x12, 0xee, 0x75, 0x99, 0x16, 0xbc, 0xe7, 0x59, 0x30, 0x23, 0xe4, 0x59, 0xe8, 0x7a,
-	0xa7, 0x33, 0x57, 0x2d, 0xe8, 0x7b, 0x5a, 0xb7, 0xa3, 0x2f, 0x0c, 0xac, 0x57, 0xd8, 0xf9, 0xa6,
-	0x5d, 0x51, 0x94, 0xfc, 0xbc, 0x14, 0x37, 0x5e, 0x83, 0xb6, 0x98, 0x63, 0x46, 0xe4, 0xbc, 0x04,
-	0x47, 0x9d, 0xe0, 0x05, 0xf7, 0x5d, 0x4d, 0xf2, 0x1d, 0xaa, 0x71, 0x6a, 0x72, 0x9d, 0x43, 0xce,
-	0xcc, 0x6f, 0x01, 0x6e, 0x31, 0xdb, 0x73, 0x9a, 0x2d, 0xef, 0x46, 0x3f, 0x45, 0x4f, 0x76, 0x3b,
-	0x7a, 0x25, 0x34, 0x39, 0x28, 0x43, 0x8c, 0xd9, 0xe8, 0xe5, 0x16, 0xe7, 0x2c, 0xbe, 0x0c, 0x63,
-	0xed, 0xa6, 0xe3, 0x59, 0xd4, 0x2d, 0x1f, 0xc9, 0x53, 0x53, 0xf9, 0x1e, 0xe6, 0x3a, 0x0a, 0xca,
-	0xbf, 0x15, 0x17, 0x8c, 0x68, 0x49, 0x38, 0x31, 0x28, 0x7c, 0x21, 0xcc, 0x6f, 0x1f, 0x2f, 0x4e,
-	0xa5, 0xaf, 0x0d, 0xa7, 0x45, 0xa5, 0xdb, 0xd1, 0xe7, 0x43, 0x64, 0xb2, 0x15, 0x62, 0x4c, 0x39,
-	0x82, 0x20, 0xf9, 0x19, 0x12, 0x0e, 0x21, 0x32, 0x2b, 0xae, 0xc2, 0x44, 0x4f, 0x97, 0xd7, 0xe2,
-	0x33, 0xc9, 0xb5, 0x78, 0xb6, 0xcf, 0x1b, 0x31, 0xc6, 0x23, 0x47, 0x43, 0x1d, 0x8a, 0x2a, 0xc1,
-	0x5e, 0x91, 0xe3, 0x89, 0x7b, 0xe6, 0x17, 0xa5, 0x93, 0xe3, 0x96, 0x78, 0x8c, 0x8e, 0xc2, 0xfe,
-	0x2e, 0x4c, 0x4b, 0xc7, 0x6b, 0x9e, 0xb7, 0x95, 0xd4, 0x53, 0xa4, 0x64, 0x89, 0x2f, 0x9b, 0x6c,
-	0x26, 0x85, 0xe6, 0x52, 0xf1, 0x2b, 0x8d, 0x58, 0xfc, 0xde, 0x47, 0x40, 0xd2, 0xc0, 0x71, 0x5a,
-	0xb8, 0x80, 0xc3, 0xfa, 0x12, 0x98, 0x95, 0xa9, 0xf1, 0x5c, 0x26, 0x44, 0xce, 0x0e, 0x81, 0xf7,
-	0x83, 0xc6, 0x88, 0x31, 0xe3, 0xca, 0xf2, 0xe4, 0xb7, 0x61, 0x6c, 0x42, 0xdf, 0x53, 0x66, 0xfe,
-	0x07, 0x30, 0x2b, 0xa5, 0x2c, 0xe6, 0xcd, 0x6a, 0x32, 0x6f, 0x16, 0xe3, 0x2c, 0x89, 0x8a, 0x7e,
-	0x14, 0xe2, 0xab, 0x21, 0x59, 0x74, 0x1a, 0x9e, 0x49, 0x0d, 0x98, 0x33, 0xea, 0x53, 0x04, 0xa7,
-	0xa2, 0xa4, 0xbf, 0x22, 0x6c, 0xf6, 0x01, 0x68, 0xdf, 0x53, 0x93, 0xea, 0x6c, 0x52, 0xc6, 0x95,
-	0xc6, 0xfe, 0x2b, 0xbc, 0xfa, 0x10, 0xc1, 0xe9, 0x0c, 0x88, 0x9c, 0x5a, 0x6f, 0xc1, 0xbc, 0x5c,
-	0x05, 0x65, 0x76, 0xad, 0xe4, 0xc1, 0xca, 0x09, 0x26, 0xd4, 0x6a, 0xa5, 0x49, 0x62, 0xe0, 0xd6,
-	0x80, 0x16, 0xf9, 0x4d, 0x31, 0x58, 0x8d, 0x2b, 0xa6, 0x29, 0x9a, 0xfc, 0x36, 0xeb, 0x2d, 0x60,
-	0xb4, 0x1a, 0x36, 0x54, 0x24, 0xb3, 0x07, 0xc4, 0xb8, 0xc5, 0x96, 0x2a, 0x3f, 0x1b, 0x26, 0xbe,
-	0x05, 0x0b, 0xf1, 0x3e, 0x91, 0x9c, 0x15, 0x47, 0x76, 0x36, 0xe7, 0x0e, 0xd0, 0x52, 0xe6, 0x78,
-	0xe6, 0x61, 0xf4, 0xb9, 0x60, 0x61, 0xd3, 0xb2, 0xc5, 0x59, 0xfe, 0xfb, 0x22, 0x3c, 0xdf, 0xdb,
-	0x0d, 0xa2, 0xf0, 0x37, 0x1c, 0xb6, 0xf7, 0xff, 0xe4, 0x2a, 0x93, 0xfb, 0x02, 0xac, 0xe4, 0x49,
-	0x19, 0xcf, 0xf0, 0x1f, 0xc2, 0x4d, 0x36, 0x28, 0x7e, 0x98, 0x6b, 0xe4, 0x32, 0x3c, 0x9b, 0x15,
-	0x33, 0x87, 0xf7, 0x6f, 0xa1, 0x37, 0x85, 0x3d, 0x59, 0x89, 0xed, 0x75, 0x75, 0x91, 0x3c, 0x93,
-	0x7e, 0x62, 0xf9, 0x4c, 0x25, 0x52, 0x7d, 0xba, 0x2b, 0x8d, 0x74, 0xba, 0x53, 0xa4, 0xe8, 0x03,
-	0x14, 0xf4, 0x91, 0x64, 0xe0, 0xbc, 0x74, 0xde, 0x81, 0xe3, 0xfc, 0xe0, 0xa3, 0x28, 0x9c, 0xcb,
-	0xd9, 0xf8, 0x79, 0xd9, 0xac, 0x76, 0x3b, 0xba, 0x26, 0x9d, 0xa3, 0xe4, 0xa2, 0x39, 0xeb, 0xf4,
-	0x69, 0x90, 0xdf, 0x21, 0xa1, 0xd1, 0xa5, 0x2c, 0xcd, 0x21, 0xa2, 0xdd, 0xb3, 0x41, 0x91, 0x4f,
-	0x89, 0x98, 0x93, 0xee, 0x21, 0x82, 0x6a, 0x94, 0xfb, 0xeb, 0x6b, 0x12, 0x43, 0x23, 0x54, 0x06,
-	0x4c, 0x45, 0x8b, 0xe8, 0x47, 0x94, 0x95, 0xef, 0xf6, 0x1a, 0x95, 0x9a, 0x15, 0x27, 0x9b, 0x64,
-	0x63, 0x28, 0x28, 0x1f, 0x14, 0x83, 0xc1, 0x95, 0x3a, 0xc4, 0x43, 0xd2, 0x55, 0xf1, 0x7d, 0x98,
-	0x53, 0x90, 0x29, 0x1a, 0x0a, 0xe5, 0x27, 0xa7, 0xde, 0xed, 0xe8, 0x27, 0x12, 0xc9, 0xe9, 0x12,
-	0xe3, 0x58, 0x3f, 0x3b, 0x5d, 0xf2, 0xa0, 0x14, 0x8c, 0xc2, 0xae, 0xaf, 0xd1, 0x4d, 0xba, 0xc7,
-	0x1c, 0xab, 0xb9, 0x6b, 0xdd, 0xef, 0xa5, 0x29, 0x5a, 0xc5, 0x4a, 0xdf, 0xc8, 0x67, 0x22, 0x1e,
-	0xe3, 0x54, 0x60, 0x7c, 0xc7, 0x61, 0xfb, 0xed, 0xa8, 0x1b, 0x4c, 0x18, 0x63, 0xc1, 0xf3, 0x86,
-	0x89, 0xcf, 0x27, 0xb6, 0x8d, 0x60, 0xf7, 0x27, 0xb4, 0x80, 0xaf, 0x81, 0x7f, 0x2b, 0xb1, 0xbc,
-	0xe6, 0xae, 0x1b, 0xdc, 0x01, 0x53, 0xee, 0x53, 0x3e, 0x5b, 0x0c, 0x2e, 0x6b, 0xf4, 0xb4, 0x7c,
-	0x0b, 0x51, 0x92, 0xcb, 0x47, 0xb2, 0x2d, 0xf4, 0xc0, 0xf6, 0xb4, 0xf0, 0x55, 0x00, 0x9f, 0x52,
-	0x4d, 0x6f, 0xdf, 0xa1, 0x6e, 0xf9, 0x68, 0x36, 0x67, 0xb7, 0x22, 0xe9, 0x2d, 0xea, 0x19, 0x82,
-	0xae, 0xcf, 0x55, 0xcb, 0xbe, 0xcd, 0xde, 0xa0, 0x4e, 0x79, 0x2c, 0xcc, 0x0e, 0x7f, 0x54, 0x70,
-	0xf5, 0xef, 0xc5, 0xe0, 0xf2, 0x94, 0xb4, 0x14, 0x4f, 0x6c, 0x04, 0xaf, 0x9a, 0x78, 0x14, 0x3f,
-	0x9f, 0x89, 0x07, 0xbe, 0x05, 0x33, 0xf2, 0xed, 0x37, 0x6c, 0xfc, 0x79, 0x2f, 0xd1, 0x82, 0xa7,
-	0x3e, 0x33, 0xc4, 0x98, 0x16, 0x6f, 0xd1, 0x2e, 0x61, 0xc1, 0xad, 0xb5, 0x61, 0xd9, 0xe6, 0xb5,
-	0xad, 0xd7, 0x58, 0xab, 0xe9, 0xb1, 0xde, 0x44, 0xf3, 0x9b, 0x30, 0xb6, 0x1b, 0xbe, 0xc9, 0xda,
-	0xf2, 0xd7, 0x82, 0x2f, 0x51, 0x5b, 0x1e, 0x73, 0x28, 0xb7, 0x11, 0x0d, 0x10, 0xb8, 0x81, 0xf5,
-	0xf1, 0x07, 0x7c, 0x49, 0xc9, 0x76, 0x30, 0x62, 0xed, 0x73, 0xc8, 0x17, 0xf1, 0x00, 0x3d, 0x92,
-	0x37, 0xa1, 0xd2, 0xab, 0xd6, 0x4f, 0x08, 0xda, 0x2d, 0x61, 0x40, 0xfc, 0x24, 0xc0, 0x6d, 0x32,
-	0xd3, 0xda, 0xbe, 0xf7, 0x44, 0xc1, 0x0d, 0xb8, 0x3c, 0x78, 0x70, 0xab, 0xef, 0x2d, 0x42, 0x69,
-	0xd3, 0xdd, 0xc1, 0x16, 0x40, 0x3c, 0x54, 0xc0, 0x2f, 0x24, 0x19, 0x54, 0x7d, 0x7a, 0xd4, 0xce,
-	0xe6, 0x94, 0xe6, 0xe1, 0xef, 0xc2, 0xa4, 0x70, 0xe5, 0xc6, 0x69, 0xda, 0x83, 0x5f, 0xe0, 0xb4,
-	0x5a, 0x5e, 0x71, 0xee, 0xed, 0x6d, 0x04, 0x78, 0xf0, 0xab, 0x12, 0x3e, 0x9f, 0x62, 0x26, 0xf1,
-	0x83, 0x9a, 0xf6, 0xe5, 0x21, 0xb5, 0x78, 0x0c, 0xef, 0x20, 0x98, 0x57, 0x7e, 0xe8, 0xc1, 0x17,
-	0xf2, 0xa1, 0x19, 0x8c, 0x64, 0x6d, 0x78, 0x45, 0x1e, 0x8c, 0x03, 0xd3, 0xd2, 0x37, 0x17, 0x5c,
-	0xcf, 0x01, 0x4a, 0xfc, 0xfa, 0xa2, 0x7d, 0x29, 0xbf, 0x02, 0xf7, 0xf9, 0x23, 0x98, 0xed, 0xff,
-	0x1c, 0x82, 0x57, 0xf3, 0x21, 0x90, 0x3c, 0xbf, 0x38, 0x94, 0x0e, 0x77, 0xce, 0x60, 0x4a, 0x1c,
-	0xaa, 0xe3, 0x5a, 0x26, 0x5d, 0xa5, 0x8f, 0x32, 0x5a, 0x3d, 0xb7, 0x7c, 0x4c, 0x70, 0xe1, 0x2e,
-	0x80, 0x33, 0xb7, 0x87, 0x34, 0x50, 0xd5, 0x6a, 0x79, 0xc5, 0x63, 0x78, 0xe2, 0x31, 0x19, 0x67,
-	0x6f, 0x10, 0xd9, 0x5f, 0x3d, 0xb7, 0x3c, 0x77, 0xf8, 0x2e, 0x82, 0xc5, 0x84, 0x01, 0x24, 0xbe,
-	0x98, 0xab, 0x14, 0xa8, 0x2e, 0x1f, 0xda, 0xfa, 0x28, 0xaa, 0x3c, 0xa4, 0x5f, 0x22, 0x28, 0x27,
-	0x8d, 0xf1, 0xf0, 0x7a, 0x3e, 0xd2, 0x28, 0x83, 0x7a, 0x79, 0x24, 0x5d, 0x1e, 0xd5, 0xfb, 0x08,
-	0xb4, 0xe4, 0x89, 0x1a, 0xbe, 0x94, 0x05, 0x38, 0x6d, 0x44, 0xa0, 0x5d, 0x1e, 0x51, 0x9b, 0xc7,
-	0xf6, 0x6b, 0x04, 0x27, 0x52, 0x2e, 0xf5, 0xf8, 0x72, 0x26, 0xf0, 0xd4, 0xe8, 0xbe, 0x32, 0xaa,
-	0xba, 0x90, 0xba, 0xe4, 0x99, 0x55, 0x6a, 0xea, 0x32, 0x07, 0x83, 0xa9, 0xa9, 0xcb, 0x1e, 0x94,
-	0xe1, 0x0f, 0x11, 0xe8, 0x19, 0x23, 0x1f, 0x7c, 0x65, 0x28, 0xfc, 0xaa, 0x09, 0x9b, 0xd6, 0xf8,
-	0x2c, 0x26, 0x84, 0x7d, 0x91, 0x34, 0x96, 0xc0, 0xeb, 0xf9, 0x0a, 0xcd, 0xd0, 0xfb, 0x22, 0x73,
-	0x0e, 0xf2, 0x1e, 0x82, 0x4a, 0xe2, 0xcd, 0x1e, 0xbf, 0x9c, 0xb3, 0x1e, 0x29, 0xe3, 0xba, 0x34,
-	0x9a, 0x32, 0x0f, 0xec, 0xa7, 0x08, 0xe6, 0x54, 0xd7, 0x74, 0xfc, 0x52, 0x16, 0x5c, 0xf5, 0xe8,
-	0x41, 0xbb, 0x30, 0xb4, 0x1e, 0x1f, 0x6b, 0x94, 0x1e, 0x14, 0x11, 0xfe, 0x05, 0x82, 0x05, 0xf5,
-	0x4d, 0x0c, 0xa7, 0xb5, 0xff, 0xd4, 0x7b, 0xb4, 0x76, 0x71, 0x04, 0x4d, 0x31, 0x28, 0x07, 0xa6,
-	0xa5, 0xfb, 0x44, 0xea, 0xf1, 0x41, 0x75, 0xd5, 0x49, 0x3d, 0x3e, 0xa8, 0xaf, 0x2a, 0x77, 0x61,
-	0xa6, 0xef, 0xa0, 0x8f, 0xcf, 0x65, 0x2e, 0xf4, 0x80, 0xdf, 0xd5, 0x61, 0x54, 0x62, 0xcf, 0x7d,
-	0xa7, 0xf0, 0x54, 0xcf, 0xea, 0x4b, 0x42, 0xaa, 0xe7, 0x84, 0x43, 0x7e, 0xe3, 0x8d, 0x8f, 0x1e,
-	0x55, 0xd1, 0xc7, 0x8f, 0xaa, 0xe8, 0xd3, 0x47, 0x55, 0xf4, 0xee, 0xe3, 0x6a, 0xe1, 0xe3, 0xc7,
-	0xd5, 0xc2, 0xdf, 0x1e, 0x57, 0x0b, 0x50, 0xb1, 0x58, 0x82, 0xbd, 0xeb, 0xe8, 0xfb, 0xe7, 0x77,
-	0x2c, 0xef, 0xd6, 0xfe, 0xcd, 0x5a, 0x8b, 0xed, 0xd5, 0x63, 0xa1, 0xb3, 0x16, 0x13, 0x9e, 0xea,
-	0x77, 0xe3, 0x3f, 0x33, 0xf4, 0xee, 0xb5, 0xa9, 0x7b, 0xf3, 0x68, 0xf0, 0xc7, 0x85, 0x2f, 0xfe,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x30, 0x5d, 0x0c, 0x32, 0x74, 0x29, 0x00, 0x00,
+	0x15, 0xf7, 0xec, 0x26, 0xfe, 0x38, 0xb6, 0x6b, 0xf7, 0xc6, 0x1f, 0xeb, 0x49, 0xb2, 0xe3, 0x4e,
+	0xe2, 0xd6, 0x75, 0x9a, 0xdd, 0xc6, 0x0d, 0x8d, 0xe3, 0xc4, 0x40, 0xb6, 0x01, 0xc5, 0x50, 0x2b,
+	0xd1, 0x18, 0xa8, 0x40, 0x42, 0xd6, 0x64, 0xe7, 0xda, 0x1e, 0x6a, 0xcf, 0xdd, 0xce, 0x9d, 0x75,
+	0x3e, 0x78, 0x28, 0x95, 0x78, 0x88, 0x10, 0x82, 0x02, 0x12, 0xa2, 0x12, 0xaa, 0xf2, 0xd8, 0x07,
+	0x24, 0x28, 0x8f, 0x88, 0x3f, 0xa0, 0x42, 0x42, 0xaa, 0x84, 0x10, 0xa8, 0xa0, 0x55, 0x95, 0x48,
+	0x88, 0xe7, 0x7d, 0xe0, 0x19, 0xcd, 0xcc, 0x9d, 0x9d, 0x7b, 0x77, 0x3e, 0x77, 0x6b, 0x87, 0x80,
+	0x78, 0xb0, 0xe4, 0x99, 0x39, 0xbf, 0xf3, 0x75, 0xcf, 0x3d, 0xe7, 0xdc, 0x73, 0x17, 0x94, 0x86,
+	0x4d, 0x0e, 0xb0, 0xa5, 0x5b, 0x75, 0x5c, 0xdd, 0xc7, 0x8e, 0x6e, 0xe8, 0x8e, 0x5e, 0x3d, 0xb8,
+	0x50, 0x75, 0xee, 0x56, 0x1a, 0x36, 0x71, 0x08, 0x9a, 0x09, 0x09, 0x2a, 0x01, 0x41, 0xe5, 0xe0,
+	0x82, 0x3c, 0xb5, 0x43, 0x76, 0x88, 0x47, 0x52, 0x75, 0xff, 0xf3, 0xa9, 0xe5, 0x85, 0x04, 0x76,
+	0x1d, 0xa4, 0x4f, 0xb6, 0x98, 0x40, 0x46, 0x6e, 0x7f, 0x07, 0xd7, 0x1d, 0xea, 0x10, 0x1b, 0x33,
+	0xca, 0xb3, 0x09, 0x94, 0x8d, 0x15, 0xec, 0xfe, 0x31, 0x2a, 0x35, 0x81, 0x8a, 0xd6, 0x49, 0x23,
+	0xa0, 0x59, 0x4a, 0xa2, 0x69, 0xe0, 0xba, 0xb9, 0x6d, 0xd6, 0x75, 0xc7, 0x24, 0x96, 0x4f, 0xab,
+	0xfe, 0x43, 0x82, 0xa9, 0x0d, 0xba, 0xf3, 0x86, 0x6d, 0x3a, 0x78, 0xd3, 0xe5, 0xa1, 0xe1, 0xb7,
+	0x9a, 0x98, 0x3a, 0xe8, 0x32, 0x1c, 0xf7, 0x78, 0x96, 0xa4, 0x79, 0x69, 0x71, 0x74, 0xf9, 0x74,
+	0x25, 0xde, 0x3b, 0x15, 0x0f, 0x54, 0x3b, 0xf6, 0x51, 0x4b, 0x19, 0xd0, 0x7c, 0x04, 0x2a, 0xc1,
+	0x10, 0x35, 0x77, 0x2c, 0x6c, 0xd3, 0x52, 0x61, 0xbe, 0xb8, 0x38, 0xa2, 0x05, 0x8f, 0xe8, 0x22,
+	0x80, 0x47, 0xb2, 0xd5, 0x6c, 0x9a, 0x46, 0xa9, 0x38, 0x2f, 0x2d, 0x8e, 0xd4, 0xa6, 0xdb, 0x2d,
+	0xe5, 0xd9, 0x7b, 0xfa, 0xfe, 0xde, 0xaa, 0x1a, 0x7e, 0x53, 0xb5, 0x11, 0xef, 0xe1, 0xeb, 0x4d,
+	0xd3, 0x40, 0x17, 0x60, 0xc4, 0x55, 0xdd, 0x07, 0x1d, 0xf3, 0x40, 0x53, 0xed, 0x96, 0x32, 0xc9,
+	0x40, 0xc1, 0x27, 0x55, 0x1b, 0x76, 0xff, 0x77, 0x21, 0xab, 0x93, 0x0f, 0x1e, 0x2a, 0x03, 0xbf,
+	0x78, 0xa8, 0x0c, 0xfc, 0xf3, 0xa1, 0x32, 0xf0, 0xbd, 0xbf, 0xcf, 0x0f, 0xa8, 0xf7, 0x61, 0xba,
+	0xcb, 0x4e, 0xda, 0x20, 0x16, 0xc5, 0x48, 0x87, 0x71, 0x5f, 0xae, 0x69, 0x6c, 0x99, 0xd6, 0x36,
+	0x61, 0x06, 0x9f, 0x49, 0x35, 0x78, 0xdd, 0x58, 0xb7, 0xb6, 0x49, 0xad, 0xd4, 0x6e, 0x29, 0x53,
+	0xbc, 0xee, 0x8c, 0x87, 0xaa, 0x8d, 0xd2, 0x90, 0x4c, 0xfd, 0x81, 0xe4, 0x09, 0xbf, 0x8e, 0xf7,
+	0x70, 0x97, 0x97, 0xbf, 0x04, 0xc3, 0x01, 0xd0, 0x93, 0x3b, 0x56, 0x5b, 0x72, 0x3d, 0xf9, 0x49,
+	0x4b, 0x99, 0xd8, 0x60, 0x32, 0xaf, 0x19, 0x86, 0x8d, 0x29, 0x6d, 0xb7, 0x94, 0x09, 0x51, 0x92,
+	0xaa, 0x0d, 0x31, 0x21, 0xc9, 0x1e, 0x8f, 0x71, 0x44, 0x09, 0x66, 0xba, 0x75, 0xf1, 0x3d, 0xa1,
+	0xfe, 0x41, 0x82, 0x53, 0x1b, 0x74, 0xe7, 0x9a, 0x61, 0x78, 0xef, 0xaf, 0xbb, 0xc2, 0xeb, 0x75,
+	0x4c, 0xe9, 0x21, 0x6b, 0x7b, 0x09, 0x46, 0x5d, 0xd2, 0x2d, 0xdd, 0x63, 0xee, 0x6b, 0x5c, 0x9b,
+	0x69, 0xb7, 0x14, 0xe4, 0x43, 0xb8, 0x8f, 0xaa, 0x06, 0x46, 0x47, 0x0d, 0xde, 0xcc, 0x62, 0x96,
+	0x99, 0x0a, 0x9c, 0x4e, 0xb0, 0x85, 0x59, 0xfb, 0x47, 0x09, 0x14, 0xd1, 0x11, 0xff, 0xdd, 0x06,
+	0xab, 0x30, 0x9f, 0x6c, 0x0e, 0xb3, 0xf9, 0x13, 0x09, 0x66, 0x39, 0xaf, 0xdc, 0xbc, 0x63, 0x61,
+	0xfb, 0x90, 0x6d, 0x7d, 0x1d, 0x06, 0xc9, 0x9d, 0x4e, 0x24, 0xa6, 0x24, 0x8e, 0x5b, 0xba, 0xed,
+	0xdc, 0xab, 0x4d, 0xbb, 0x32, 0xda, 0x2d, 0x65, 0xdc, 0x67, 0xe8, 0x43, 0x55, 0x8d, 0xf1, 0xe8,
+	0xc9, 0x01, 0x32, 0x94, 0xa2, 0xb6, 0x31, 0xc3, 0x7f, 0x27, 0x81, 0x2c, 0x7a, 0xe7, 0x28, 0x6c,
+	0x7f, 0x51, 0xb0, 0x7d, 0xa4, 0xf6, 0xec, 0xe1, 0x18, 0x76, 0x1a, 0x4e, 0xc6, 0xea, 0xce, 0x6c,
+	0xfb, 0x7d, 0x01, 0x66, 0x3a, 0xa9, 0x0d, 0x53, 0x6a, 0x12, 0x2b, 0xb0, 0xeb, 0x0b, 0x30, 0x44,
+	0xfd, 0x37, 0x2c, 0xab, 0x29, 0x89, 0x59, 0xcd, 0x27, 0x63, 0x89, 0x3c, 0x40, 0xa5, 0xa4, 0xf2,
+	0x77, 0x24, 0x98, 0x66, 0x54, 0x6e, 0xd6, 0xab, 0x93, 0xfd, 0x06, 0xb1, 0xb0, 0xe5, 0x50, 0x2f,
+	0xad, 0x8f, 0x2e, 0x9f, 0xcb, 0x90, 0xb4, 0x6e, 0xbc, 0xd6, 0x81, 0xd4, 0xe6, 0xdb, 0x2d, 0xe5,
+	0x14, 0x73, 0x6b, 0x1c, 0x4f, 0x55, 0x3b, 0x41, 0xa3, 0xb0, 0xc3, 0x29, 0x0c, 0x7f, 0x96, 0xe0,
+	0x44, 0x8c, 0x4e, 0xe8, 0x55, 0xa1, 0x56, 0x49, 0x29, 0xb5, 0xea, 0xc6, 0x00, 0x5f, 0xad, 0x3a,
+	0x38, 0xdd, 0x30, 0xec, 0x52, 0x21, 0x1e, 0xe7, 0x7e, 0x0b, 0x71, 0x6e, 0x6c, 0xa1, 0x55, 0x18,
+	0x0b, 0x6c, 0xe7, 0xaa, 0xe3, 0x6c, 0xbb, 0xa5, 0x9c, 0x10, 0x3d, 0xe3, 0x9b, 0x34, 0xca, 0x1e,
+	0x5d, 0x99, 0x35, 0x04, 0x93, 0x41, 0x38, 0x62, 0xcb, 0x31, 0xb7, 0x4d, 0x6c, 0xab, 0xdf, 0xf7,
+	0xf7, 0xba, 0x18, 0x16, 0xac, 0xe6, 0x99, 0x30, 0xc1, 0xf9, 0x99, 0xab, 0x7a, 0x0b, 0x99, 0xab,
+	0xe6, 0xd5, 0x3d, 0xb9, 0xdd, 0x52, 0x66, 0x22, 0xeb, 0xe5, 0x57, 0xbe, 0x71, 0xca, 0x93, 0xaa,
+	0x3f, 0x29, 0x86, 0x85, 0x57, 0xc3, 0x75, 0x62, 0x1b, 0x41, 0x70, 0x5e, 0x85, 0x41, 0xdb, 0x7b,
+	0xc1, 0x64, 0x97, 0x93, 0x64, 0xfb, 0x30, 0x16, 0x9a, 0x0c, 0xf3, 0x94, 0x47, 0xe6, 0x57, 0x01,
+	0xd5, 0x89, 0xe5, 0xd8, 0x7a, 0xdd, 0xd9, 0xea, 0x0e, 0xd1, 0xd3, 0xed, 0x96, 0x32, 0xe7, 0xb3,
+	0x8c, 0xd2, 0xa8, 0xda, 0x64, 0xf0, 0x72, 0x93, 0xc5, 0x2c, 0x5a, 0x83, 0xa1, 0x86, 0x6e, 0x3b,
+	0x26, 0xa6, 0xa5, 0xe3, 0x79, 0x72, 0x2a, 0xdb, 0xc3, 0x0c, 0x13, 0x13, 0xf2, 0x6f, 0x87, 0x09,
+	0x23, 0x58, 0x12, 0x16, 0x18, 0x18, 0x9e, 0xf1, 0xfd, 0xdb, 0x15, 0x17, 0x67, 0xd3, 0xd7, 0x86,
+	0x85, 0xc5, 0x5c, 0xbb, 0xa5, 0x4c, 0xfb, 0x96, 0x89, 0x5c, 0x54, 0x6d, 0xcc, 0xe6, 0x08, 0xd5,
+	0x1f, 0x49, 0x5c, 0x13, 0x22, 0x46, 0xc5, 0x0d, 0x18, 0xe9, 0x60, 0x59, 0x2e, 0x3e, 0x97, 0x9c,
+	0x8b, 0x27, 0xbb, 0xa4, 0xa9, 0xda, 0x70, 0x20, 0xa8, 0xa7, 0xa6, 0x68, 0x0e, 0x66, 0x23, 0xfa,
+	0x84, 0x35, 0xf3, 0x39, 0xa1, 0x73, 0xdc, 0xe4, 0xdb, 0xe8, 0x40, 0xed, 0x6f, 0xc0, 0xb8, 0xd0,
+	0x5e, 0x33, 0xbf, 0x2d, 0xa5, 0x76, 0x91, 0x02, 0x27, 0xb6, 0x6c, 0x22, 0x9b, 0x94, 0x30, 0x17,
+	0x92, 0x5f, 0xb1, 0xcf, 0xe4, 0xf7, 0x9e, 0x04, 0x6a, 0x9a, 0x71, 0x2c, 0x2c, 0x28, 0x20, 0x3f,
+	0xbf, 0x78, 0x6c, 0xc5, 0xd0, 0x78, 0x21, 0xd3, 0x44, 0x16, 0x1d, 0x5c, 0xdc, 0x47, 0x99, 0xa9,
+	0xda, 0x04, 0x15, 0xe9, 0xd5, 0x5f, 0xfb, 0xba, 0x71, 0x75, 0x2f, 0xd6, 0xf3, 0xdf, 0x86, 0x49,
+	0xc1, 0x65, 0x61, 0xdc, 0x2c, 0x27, 0xc7, 0xcd, 0x6c, 0xe8, 0x25, 0x1e, 0xe8, 0x6a, 0xc1, 0xbf,
+	0xea, 0x31, 0x8a, 0x16, 0xe0, 0x4c, 0xaa, 0xc2, 0x2c, 0xa2, 0x3e, 0x95, 0xe0, 0x6c, 0xe0, 0xf4,
+	0xd7, 0xb8, 0xcd, 0x1e, 0x31, 0xed, 0x9b, 0xf1, 0x41, 0x75, 0x3e, 0xc9, 0xe3, 0xb1, 0xcc, 0xfe,
+	0x23, 0x71, 0xf5, 0x81, 0x04, 0x0b, 0x19, 0x26, 0xb2, 0xd0, 0x7a, 0x1b, 0xa6, 0xc5, 0x2c, 0x28,
+	0x46, 0xd7, 0x52, 0x1e, 0x5b, 0x59, 0x80, 0x71, 0xb9, 0x3a, 0x96, 0xa5, 0xaa, 0xa1, 0x7a, 0x04,
+	0xa5, 0xfe, 0xaa, 0xe0, 0xad, 0xc6, 0x35, 0xc3, 0xe0, 0x59, 0x7e, 0x8d, 0x74, 0x16, 0x30, 0x58,
+	0x0d, 0x0b, 0xe6, 0x04, 0xb6, 0x87, 0x14, 0x71, 0xb3, 0xf5, 0x38, 0xff, 0xac, 0x1b, 0x68, 0x17,
+	0x66, 0xc2, 0x7d, 0x22, 0x08, 0x2b, 0xf4, 0x2d, 0x6c, 0x8a, 0x46, 0xc2, 0x72, 0xdd, 0xe8, 0xa9,
+	0x19, 0x7d, 0x01, 0x16, 0x32, 0xbc, 0xc5, 0xa2, 0xfc, 0xc3, 0x02, 0xbc, 0xd8, 0xd9, 0x0d, 0x3c,
+	0xf1, 0x97, 0x6d, 0xb2, 0xff, 0x7f, 0xe7, 0xc6, 0x3a, 0xf7, 0x25, 0x58, 0xca, 0xe3, 0x32, 0xe6,
+	0xe1, 0xbf, 0xf8, 0x27, 0x58, 0xf7, 0xc4, 0xc3, 0xcb, 0x14, 0x4e, 0x36, 0x47, 0x9c, 0x1d, 0x8f,
+	0xe8, 0xc4, 0xe3, 0x9f, 0x65, 0x13, 0x0c, 0x0b, 0xeb, 0x32, 0x57, 0x1e, 0xfe, 0xc7, 0x1c, 0x20,
+	0x54, 0x92, 0x64, 0x1f, 0xfc, 0xd6, 0x4f, 0xb3, 0xd1, 0x80, 0x79, 0x9a, 0xab, 0xe4, 0x22, 0x3c,
+	0x9f, 0xa5, 0x33, 0x33, 0xef, 0x5f, 0x5c, 0x77, 0xe2, 0x77, 0x65, 0xb1, 0xb6, 0xbd, 0x11, 0x5f,
+	0x26, 0xcf, 0xa5, 0xf7, 0xac, 0x9f, 0xa9, 0x48, 0xc6, 0xf7, 0xf7, 0xc5, 0xbe, 0xfa, 0xfb, 0x18,
+	0x17, 0xbd, 0x2f, 0xc1, 0x99, 0x54, 0xc3, 0x59, 0xf1, 0xbc, 0x03, 0x27, 0x58, 0xeb, 0x1b, 0x53,
+	0x3a, 0x17, 0xb3, 0xed, 0x67, 0x85, 0xb3, 0xdc, 0x6e, 0x29, 0xb2, 0xd0, 0x49, 0x8b, 0x65, 0x73,
+	0xd2, 0xee, 0x42, 0xa8, 0xbf, 0x91, 0xb8, 0x00, 0x4d, 0x59, 0x9a, 0xa7, 0x28, 0xec, 0x9e, 0x87,
+	0xb3, 0xe9, 0x1a, 0xb3, 0xa0, 0x7b, 0x28, 0x41, 0x39, 0xf0, 0xfd, 0xad, 0x15, 0x21, 0x42, 0x03,
+	0xab, 0x34, 0x18, 0x0b, 0x16, 0xd1, 0xd5, 0x28, 0xcb, 0xdf, 0xee, 0xf4, 0x9e, 0x67, 0xc3, 0x82,
+	0x4d, 0xe0, 0xd1, 0x93, 0x29, 0xef, 0x17, 0x40, 0x49, 0x54, 0xf1, 0x29, 0xe9, 0xab, 0xd0, 0x7d,
+	0x98, 0x8a, 0x09, 0xa6, 0x60, 0x2c, 0x98, 0x3f, 0x38, 0x95, 0x76, 0x4b, 0x39, 0x99, 0x18, 0x9c,
+	0x54, 0xd5, 0x9e, 0xed, 0x8e, 0x4e, 0xaa, 0x3e, 0x28, 0x7a, 0x05, 0xe4, 0xd6, 0x0a, 0xde, 0xc0,
+	0xfb, 0xc4, 0x36, 0xf5, 0x3d, 0xf3, 0x7e, 0xc7, 0x4d, 0xc1, 0x2a, 0xce, 0x75, 0x0d, 0xfd, 0x46,
+	0xc2, 0x41, 0xde, 0x1c, 0x0c, 0xef, 0xd8, 0xa4, 0xd9, 0x08, 0xfa, 0x81, 0x11, 0x6d, 0xc8, 0x7b,
+	0x5e, 0x37, 0xd0, 0xc5, 0xc4, 0xc6, 0xc1, 0xdb, 0xfd, 0x09, 0x4d, 0xc0, 0x17, 0xc1, 0x3d, 0x97,
+	0x9a, 0x8e, 0xbe, 0x47, 0x4b, 0xc7, 0xd2, 0x4f, 0xd4, 0x6e, 0xb4, 0x68, 0x8c, 0x56, 0xeb, 0xa0,
+	0x5c, 0x0e, 0x81, 0x93, 0x4b, 0xc7, 0xb3, 0x39, 0x74, 0x8c, 0xed, 0xa0, 0xd0, 0x0d, 0x00, 0x37,
+	0xa4, 0x74, 0xa7, 0x69, 0x63, 0x5a, 0x1a, 0xcc, 0x8e, 0xd9, 0xcd, 0x80, 0x7a, 0x13, 0x3b, 0x1a,
+	0x87, 0x75, 0x63, 0xd5, 0xb4, 0x0e, 0xc8, 0x9b, 0xd8, 0x2e, 0x0d, 0xf9, 0xde, 0x61, 0x8f, 0x31,
+	0xb1, 0xfa, 0xb7, 0x02, 0x3c, 0x97, 0xb2, 0x14, 0x4f, 0xec, 0x12, 0x26, 0x6e, 0xe6, 0x55, 0x38,
+	0x9a, 0x99, 0x17, 0xda, 0x85, 0x09, 0x71, 0xfe, 0xe1, 0x57, 0xfc, 0xbc, 0x63, 0x14, 0x4e, 0x52,
+	0x17, 0x1b, 0x55, 0x1b, 0xe7, 0xe7, 0x28, 0x54, 0x25, 0xde, 0xdc, 0xa2, 0x66, 0x5a, 0xc6, 0xcd,
+	0xcd, 0xd7, 0x49, 0x5d, 0x77, 0x48, 0xa7, 0xf1, 0xf9, 0x0a, 0x0c, 0xed, 0xf9, 0x6f, 0xb2, 0xb6,
+	0xfc, 0x4d, 0xef, 0x2e, 0x72, 0xd3, 0x21, 0x36, 0x66, 0x3c, 0x82, 0x11, 0x12, 0x63, 0xb0, 0x3a,
+	0xfc, 0x80, 0x2d, 0xa9, 0xba, 0x0d, 0xa5, 0xa8, 0x40, 0xb6, 0x88, 0x87, 0x28, 0x51, 0x7d, 0x0b,
+	0xe6, 0x3a, 0xd9, 0xfa, 0x09, 0x99, 0xb6, 0xcb, 0x5d, 0x11, 0x3c, 0x09, 0xe3, 0x36, 0x88, 0x61,
+	0x6e, 0xdf, 0x7b, 0xa2, 0xc6, 0x45, 0x44, 0x1e, 0x81, 0x71, 0x1f, 0xfa, 0xc5, 0x89, 0x99, 0xa3,
+	0xe1, 0x03, 0x6c, 0x47, 0xda, 0x82, 0xc3, 0x1b, 0xf2, 0xad, 0xc1, 0xb8, 0x61, 0xd2, 0x46, 0xd3,
+	0xc1, 0xc6, 0xd6, 0xae, 0x4e, 0x77, 0xd9, 0xc0, 0x9d, 0xcb, 0x09, 0xc2, 0x67, 0x55, 0x1b, 0x0b,
+	0x9e, 0x6f, 0xe8, 0x74, 0xd7, 0x85, 0x93, 0xdb, 0x14, 0xdb, 0x07, 0x01, 0xbc, 0xd8, 0x0d, 0x17,
+	0x3e, 0xab, 0xda, 0x58, 0xf0, 0xec, 0xc1, 0x67, 0xdc, 0x11, 0xb6, 0x4e, 0x89, 0xe5, 0x8f, 0x76,
+	0x35, 0xf6, 0x84, 0x4e, 0xb9, 0xf6, 0x79, 0xa6, 0x62, 0xdb, 0xcb, 0xd6, 0x23, 0x5a, 0xf8, 0x22,
+	0xf1, 0xbc, 0x93, 0xe0, 0x32, 0xd6, 0x97, 0xfc, 0x38, 0xf0, 0x2b, 0x25, 0x7b, 0x07, 0xf8, 0xa8,
+	0xfd, 0x2a, 0x58, 0x50, 0xe8, 0xb2, 0x00, 0x5d, 0x87, 0x41, 0xea, 0xe8, 0x4e, 0xd3, 0x1f, 0xa9,
+	0x3f, 0xb3, 0xfc, 0x52, 0x72, 0x5e, 0xe3, 0xd5, 0xdc, 0xf4, 0x30, 0x1a, 0xc3, 0x22, 0x04, 0xc7,
+	0x2c, 0xe2, 0x60, 0xe6, 0x3b, 0xef, 0x7f, 0xbe, 0x0d, 0x3a, 0x9e, 0xef, 0x94, 0x98, 0xe0, 0x10,
+	0xdf, 0x6b, 0xcb, 0x7f, 0x3a, 0x09, 0xc5, 0x0d, 0xba, 0x83, 0x4c, 0x80, 0x70, 0xc8, 0x89, 0x12,
+	0xf5, 0x8d, 0xfb, 0x29, 0x84, 0x7c, 0x3e, 0x27, 0x35, 0xdb, 0x4c, 0x7b, 0x30, 0xca, 0x8d, 0x00,
+	0x51, 0x1a, 0x3a, 0xfa, 0x8b, 0x00, 0xb9, 0x92, 0x97, 0x9c, 0x49, 0x7b, 0x47, 0x02, 0x14, 0xbd,
+	0xe5, 0x46, 0x17, 0x53, 0xd8, 0x24, 0x5e, 0xf0, 0xcb, 0x9f, 0xeb, 0x11, 0xc5, 0x74, 0x70, 0x7f,
+	0xdf, 0x10, 0x7b, 0xf1, 0x8c, 0x2e, 0xe5, 0xb3, 0x26, 0xaa, 0xc9, 0x4a, 0xef, 0x40, 0xa6, 0x8c,
+	0x0d, 0xe3, 0xc2, 0x1d, 0x30, 0xaa, 0xe6, 0x30, 0x8a, 0x1f, 0x19, 0xc8, 0x2f, 0xe7, 0x07, 0x30,
+	0x99, 0xdf, 0x85, 0xc9, 0xee, 0xeb, 0x59, 0xb4, 0x9c, 0xcf, 0x02, 0x41, 0xf2, 0x2b, 0x3d, 0x61,
+	0x98, 0x70, 0x02, 0x63, 0xfc, 0x25, 0x1f, 0xaa, 0x64, 0x86, 0xab, 0x70, 0x49, 0x2c, 0x57, 0x73,
+	0xd3, 0x87, 0x01, 0xce, 0x9d, 0x4c, 0x51, 0xe6, 0xf6, 0x10, 0x2e, 0x78, 0xe4, 0x4a, 0x5e, 0xf2,
+	0xd0, 0x3c, 0xfe, 0xd0, 0x86, 0xb2, 0x37, 0x88, 0x28, 0xaf, 0x9a, 0x9b, 0x9e, 0x09, 0x7c, 0x57,
+	0x82, 0xd9, 0x84, 0x0b, 0x11, 0x74, 0x39, 0x57, 0x2a, 0x88, 0x3b, 0x0a, 0xcb, 0xab, 0xfd, 0x40,
+	0x99, 0x4a, 0x3f, 0x93, 0xa0, 0x94, 0x74, 0xad, 0x80, 0x56, 0xf3, 0x05, 0x4d, 0xac, 0x52, 0x57,
+	0xfa, 0xc2, 0x32, 0xad, 0xde, 0x93, 0x40, 0x4e, 0x9e, 0xf0, 0xa3, 0xab, 0x59, 0x06, 0xa7, 0x0d,
+	0xac, 0xe4, 0xb5, 0x3e, 0xd1, 0x4c, 0xb7, 0x5f, 0x4a, 0x70, 0x32, 0x65, 0xc4, 0x84, 0xd6, 0x32,
+	0x0d, 0x4f, 0xd5, 0xee, 0xf3, 0xfd, 0xc2, 0x39, 0xd7, 0x25, 0xcf, 0xd0, 0x53, 0x5d, 0x97, 0x79,
+	0x51, 0x21, 0xaf, 0xf5, 0x89, 0x66, 0xba, 0x7d, 0x20, 0x81, 0x92, 0x31, 0x82, 0x46, 0xd7, 0x7a,
+	0xb2, 0x3f, 0x6e, 0xe2, 0x2f, 0xd7, 0x3e, 0x0b, 0x0b, 0x6e, 0x5f, 0x24, 0x0d, 0xc9, 0xd0, 0x6a,
+	0xbe, 0x44, 0xd3, 0xf3, 0xbe, 0xc8, 0x9c, 0xca, 0xfd, 0x5c, 0x82, 0xb9, 0xc4, 0x39, 0x13, 0xba,
+	0x92, 0x33, 0x1f, 0xc5, 0xea, 0x75, 0xb5, 0x3f, 0x30, 0x57, 0xa7, 0x63, 0x87, 0xea, 0xa9, 0x75,
+	0x3a, 0xed, 0x7e, 0x41, 0x5e, 0xe9, 0x1d, 0x18, 0xcd, 0x69, 0x51, 0x7d, 0x72, 0xe4, 0xb4, 0x44,
+	0x95, 0xae, 0xf4, 0x85, 0x65, 0x5a, 0xfd, 0x50, 0x82, 0xa9, 0xb8, 0xb9, 0x1a, 0x7a, 0x35, 0x2b,
+	0x22, 0xe2, 0x67, 0x85, 0xf2, 0xa5, 0x9e, 0x71, 0xac, 0xdf, 0x2f, 0x3e, 0x28, 0x48, 0xe8, 0xa7,
+	0x12, 0xcc, 0xc4, 0x8f, 0x4e, 0x50, 0x9a, 0xe7, 0x53, 0x07, 0x5f, 0xf2, 0xe5, 0x3e, 0x90, 0xbc,
+	0x52, 0x36, 0x8c, 0x0b, 0x03, 0x80, 0xd4, 0x0e, 0x2b, 0x6e, 0x36, 0x21, 0xbf, 0x9c, 0x1f, 0xc0,
+	0xd6, 0xe5, 0x2e, 0x4c, 0x74, 0x9d, 0xcc, 0xd1, 0x85, 0xcc, 0x75, 0x8e, 0xc8, 0x5d, 0xee, 0x05,
+	0x12, 0x4a, 0xee, 0x3a, 0x36, 0xa7, 0x4a, 0x8e, 0x3f, 0xd5, 0xcb, 0xcb, 0xbd, 0x40, 0xb8, 0xed,
+	0x1a, 0x7b, 0x26, 0x4c, 0xdd, 0xae, 0x69, 0x07, 0xef, 0xd4, 0xed, 0x9a, 0x7a, 0xfc, 0x64, 0xca,
+	0xc4, 0x1c, 0xb5, 0x32, 0x94, 0x49, 0x3e, 0xad, 0xca, 0x2b, 0xbd, 0x03, 0x7d, 0x65, 0x6a, 0x6f,
+	0x7e, 0xf4, 0xa8, 0x2c, 0x7d, 0xfc, 0xa8, 0x2c, 0x7d, 0xfa, 0xa8, 0x2c, 0xbd, 0xfb, 0xb8, 0x3c,
+	0xf0, 0xf1, 0xe3, 0xf2, 0xc0, 0x5f, 0x1f, 0x97, 0x07, 0x60, 0xce, 0x24, 0x09, 0x5c, 0x6f, 0x49,
+	0xdf, 0xba, 0xb8, 0x63, 0x3a, 0xbb, 0xcd, 0xdb, 0x95, 0x3a, 0xd9, 0xaf, 0x86, 0x44, 0xe7, 0x4d,
+	0xc2, 0x3d, 0x55, 0xef, 0x86, 0xbf, 0x99, 0x77, 0xee, 0x35, 0x30, 0xbd, 0x3d, 0xe8, 0xfd, 0x52,
+	0xfe, 0x95, 0x7f, 0x0f, 0x00, 0xfc, 0x29, 0x0a, 0xf9, 0x41, 0x30, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2255,6 +2601,10 @@ type MsgClient interface {
 	WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error)
 	// DeleteRecordSpecification deletes a record specification.
 	DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error)
+	// AddSpecificationOwner adds owner addresses to a scope or contract specification.
+	AddSpecificationOwner(ctx context.Context, in *MsgAddSpecificationOwnerRequest, opts ...grpc.CallOption) (*MsgAddSpecificationOwnerResponse, error)
+	// DeleteSpecificationOwner removes owner addresses from a scope or contract specification.
+	DeleteSpecificationOwner(ctx context.Context, in *MsgDeleteSpecificationOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteSpecificationOwnerResponse, error)
 	// WriteP8eContractSpec adds a P8e v39 contract spec as a v40 ContractSpecification
 	// It only exists to help facilitate the transition. Users should transition to WriteContractSpecification.
 	WriteP8EContractSpec(ctx context.Context, in *MsgWriteP8EContractSpecRequest, opts ...grpc.CallOption) (*MsgWriteP8EContractSpecResponse, error)
@@ -2267,6 +2617,12 @@ type MsgClient interface {
 	DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error)
 	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
 	ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error)
+	// RequestReverification records a request by a data consumer to have the scope owners re-verify the off-chain object
+	// of a record whose hash does not match.
+	RequestReverification(ctx context.Context, in *MsgRequestReverificationRequest, opts ...grpc.CallOption) (*MsgRequestReverificationResponse, error)
+	// ResolveReverification resolves an open re-verification request, by the scope owners or, to withdraw it, by the
+	// requester.
+	ResolveReverification(ctx context.Context, in *MsgResolveReverificationRequest, opts ...grpc.CallOption) (*MsgResolveReverificationResponse, error)
 }
 
 type msgClient struct {
@@ -2430,6 +2786,24 @@ func (c *msgClient) DeleteRecordSpecification(ctx context.Context, in *MsgDelete
 	return out, nil
 }
 
+func (c *msgClient) AddSpecificationOwner(ctx context.Context, in *MsgAddSpecificationOwnerRequest, opts ...grpc.CallOption) (*MsgAddSpecificationOwnerResponse, error) {
+	out := new(MsgAddSpecificationOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddSpecificationOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteSpecificationOwner(ctx context.Context, in *MsgDeleteSpecificationOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteSpecificationOwnerResponse, error) {
+	out := new(MsgDeleteSpecificationOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteSpecificationOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *msgClient) WriteP8EContractSpec(ctx context.Context, in *MsgWriteP8EContractSpecRequest, opts ...grpc.CallOption) (*MsgWriteP8EContractSpecResponse, error) {
 	out := new(MsgWriteP8EContractSpecResponse)
@@ -2477,6 +2851,24 @@ func (c *msgClient) ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorR
 	return out, nil
 }
 
+func (c *msgClient) RequestReverification(ctx context.Context, in *MsgRequestReverificationRequest, opts ...grpc.CallOption) (*MsgRequestReverificationResponse, error) {
+	out := new(MsgRequestReverificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/RequestReverification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveReverification(ctx context.Context, in *MsgResolveReverificationRequest, opts ...grpc.CallOption) (*MsgResolveReverificationResponse, error) {
+	out := new(MsgResolveReverificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ResolveReverification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// WriteScope adds or updates a scope.
@@ -2513,6 +2905,10 @@ type MsgServer interface {
 	WriteRecordSpecification(context.Context, *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error)
 	// DeleteRecordSpecification deletes a record specification.
 	DeleteRecordSpecification(context.Context, *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error)
+	// AddSpecificationOwner adds owner addresses to a scope or contract specification.
+	AddSpecificationOwner(context.Context, *MsgAddSpecificationOwnerRequest) (*MsgAddSpecificationOwnerResponse, error)
+	// DeleteSpecificationOwner removes owner addresses from a scope or contract specification.
+	DeleteSpecificationOwner(context.Context, *MsgDeleteSpecificationOwnerRequest) (*MsgDeleteSpecificationOwnerResponse, error)
 	// WriteP8eContractSpec adds a P8e v39 contract spec as a v40 ContractSpecification
 	// It only exists to help facilitate the transition. Users should transition to WriteContractSpecification.
 	WriteP8EContractSpec(context.Context, *MsgWriteP8EContractSpecRequest) (*MsgWriteP8EContractSpecResponse, error)
@@ -2525,6 +2921,12 @@ type MsgServer interface {
 	DeleteOSLocator(context.Context, *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error)
 	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
 	ModifyOSLocator(context.Context, *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error)
+	// RequestReverification records a request by a data consumer to have the scope owners re-verify the off-chain object
+	// of a record whose hash does not match.
+	RequestReverification(context.Context, *MsgRequestReverificationRequest) (*MsgRequestReverificationResponse, error)
+	// ResolveReverification resolves an open re-verification request, by the scope owners or, to withdraw it, by the
+	// requester.
+	ResolveReverification(context.Context, *MsgResolveReverificationRequest) (*MsgResolveReverificationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2582,6 +2984,12 @@ func (*UnimplementedMsgServer) WriteRecordSpecification(ctx context.Context, req
 func (*UnimplementedMsgServer) DeleteRecordSpecification(ctx context.Context, req *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecordSpecification not implemented")
 }
+func (*UnimplementedMsgServer) AddSpecificationOwner(ctx context.Context, req *MsgAddSpecificationOwnerRequest) (*MsgAddSpecificationOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSpecificationOwner not implemented")
+}
+func (*UnimplementedMsgServer) DeleteSpecificationOwner(ctx context.Context, req *MsgDeleteSpecificationOwnerRequest) (*MsgDeleteSpecificationOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSpecificationOwner not implemented")
+}
 func (*UnimplementedMsgServer) WriteP8EContractSpec(ctx context.Context, req *MsgWriteP8EContractSpecRequest) (*MsgWriteP8EContractSpecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WriteP8EContractSpec not implemented")
 }
@@ -2597,6 +3005,12 @@ func (*UnimplementedMsgServer) DeleteOSLocator(ctx context.Context, req *MsgDele
 func (*UnimplementedMsgServer) ModifyOSLocator(ctx context.Context, req *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModifyOSLocator not implemented")
 }
+func (*UnimplementedMsgServer) RequestReverification(ctx context.Context, req *MsgRequestReverificationRequest) (*MsgRequestReverificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReverification not implemented")
+}
+func (*UnimplementedMsgServer) ResolveReverification(ctx context.Context, req *MsgResolveReverificationRequest) (*MsgResolveReverificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReverification not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2908,61 +3322,97 @@ func _Msg_DeleteRecordSpecification_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_WriteP8EContractSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteP8EContractSpecRequest)
+func _Msg_AddSpecificationOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddSpecificationOwnerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).WriteP8EContractSpec(ctx, in)
+		return srv.(MsgServer).AddSpecificationOwner(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteP8EContractSpec",
+		FullMethod: "/provenance.metadata.v1.Msg/AddSpecificationOwner",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteP8EContractSpec(ctx, req.(*MsgWriteP8EContractSpecRequest))
+		return srv.(MsgServer).AddSpecificationOwner(ctx, req.(*MsgAddSpecificationOwnerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_P8EMemorializeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgP8EMemorializeContractRequest)
+func _Msg_DeleteSpecificationOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteSpecificationOwnerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).P8EMemorializeContract(ctx, in)
+		return srv.(MsgServer).DeleteSpecificationOwner(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/P8EMemorializeContract",
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteSpecificationOwner",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).P8EMemorializeContract(ctx, req.(*MsgP8EMemorializeContractRequest))
+		return srv.(MsgServer).DeleteSpecificationOwner(ctx, req.(*MsgDeleteSpecificationOwnerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_BindOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBindOSLocatorRequest)
+func _Msg_WriteP8EContractSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteP8EContractSpecRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).BindOSLocator(ctx, in)
+		return srv.(MsgServer).WriteP8EContractSpec(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/BindOSLocator",
+		FullMethod: "/provenance.metadata.v1.Msg/WriteP8EContractSpec",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BindOSLocator(ctx, req.(*MsgBindOSLocatorRequest))
+		return srv.(MsgServer).WriteP8EContractSpec(ctx, req.(*MsgWriteP8EContractSpecRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_DeleteOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Msg_P8EMemorializeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgP8EMemorializeContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).P8EMemorializeContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/P8EMemorializeContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).P8EMemorializeContract(ctx, req.(*MsgP8EMemorializeContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BindOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBindOSLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BindOSLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/BindOSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BindOSLocator(ctx, req.(*MsgBindOSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDeleteOSLocatorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -2998,6 +3448,42 @@ func _Msg_ModifyOSLocator_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RequestReverification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestReverificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestReverification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/RequestReverification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestReverification(ctx, req.(*MsgRequestReverificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveReverification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveReverificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveReverification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/ResolveReverification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveReverification(ctx, req.(*MsgResolveReverificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.metadata.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -3070,6 +3556,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRecordSpecification",
 			Handler:    _Msg_DeleteRecordSpecification_Handler,
 		},
+		{
+			MethodName: "AddSpecificationOwner",
+			Handler:    _Msg_AddSpecificationOwner_Handler,
+		},
+		{
+			MethodName: "DeleteSpecificationOwner",
+			Handler:    _Msg_DeleteSpecificationOwner_Handler,
+		},
 		{
 			MethodName: "WriteP8eContractSpec",
 			Handler:    _Msg_WriteP8EContractSpec_Handler,
@@ -3090,6 +3584,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModifyOSLocator",
 			Handler:    _Msg_ModifyOSLocator_Handler,
 		},
+		{
+			MethodName: "RequestReverification",
+			Handler:    _Msg_RequestReverification_Handler,
+		},
+		{
+			MethodName: "ResolveReverification",
+			Handler:    _Msg_ResolveReverification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/metadata/v1/tx.proto",
@@ -4273,6 +4775,154 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAddSpecificationOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSpecificationOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSpecificationOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddSpecificationOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSpecificationOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSpecificationOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteSpecificationOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteSpecificationOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteSpecificationOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteSpecificationOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteSpecificationOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteSpecificationOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4924,43 +5574,211 @@ func (m *MsgModifyOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRequestReverificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgWriteScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRequestReverificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestReverificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Scope.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ScopeUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ObservedHash) > 0 {
+		i -= len(m.ObservedHash)
+		copy(dAtA[i:], m.ObservedHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObservedHash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *MsgWriteScopeResponse) Size() (n int) {
+	if len(m.DisputedHash) > 0 {
+		i -= len(m.DisputedHash)
+		copy(dAtA[i:], m.DisputedHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DisputedHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.RecordId.Size()
+		i -= size
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestReverificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestReverificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestReverificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveReverificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveReverificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveReverificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.RecordId.Size()
+		i -= size
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveReverificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveReverificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveReverificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgWriteScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Scope.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ScopeUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteScopeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5421,6 +6239,70 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAddSpecificationOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddSpecificationOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteSpecificationOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteSpecificationOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDeleteContractSpecificationRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5669,37 +6551,110 @@ func (m *MsgModifyOSLocatorResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgRequestReverificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.DisputedHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObservedHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgRequestReverificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeRequest: wiretype end group for non-group")
-		}
+
+func (m *MsgResolveReverificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgResolveReverificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopeRequest: wiretype end group for non-group")
+		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: MsgWriteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
@@ -8805,7 +9760,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MsgDeleteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAddSpecificationOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8828,10 +9783,10 @@ func (m *MsgDeleteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddSpecificationOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddSpecificationOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8868,6 +9823,38 @@ func (m *MsgDeleteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -8920,7 +9907,7 @@ func (m *MsgDeleteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddSpecificationOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8943,10 +9930,10 @@ func (m *MsgDeleteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddSpecificationOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddSpecificationOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8970,7 +9957,7 @@ func (m *MsgDeleteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteSpecificationOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8993,17 +9980,17 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteSpecificationOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteSpecificationOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9013,28 +10000,28 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9062,11 +10049,11 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9094,7 +10081,7 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9117,7 +10104,7 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteSpecificationOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9140,48 +10127,12 @@ func (m *MsgWriteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteSpecificationOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteSpecificationOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RecordSpecIdInfo == nil {
-				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
-			}
-			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9203,7 +10154,7 @@ func (m *MsgWriteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9226,10 +10177,10 @@ func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteContractSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9318,7 +10269,7 @@ func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9341,10 +10292,10 @@ func (m *MsgDeleteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteContractSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9368,7 +10319,7 @@ func (m *MsgDeleteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9391,15 +10342,15 @@ func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteP8eContractSpecRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteRecordSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteP8eContractSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteRecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contractspec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9426,7 +10377,7 @@ func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Contractspec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9462,6 +10413,38 @@ func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9483,7 +10466,7 @@ func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9506,15 +10489,15 @@ func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteP8eContractSpecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteRecordSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteP8eContractSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteRecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9541,54 +10524,20 @@ func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractSpecIdInfo == nil {
-				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
+			if m.RecordSpecIdInfo == nil {
+				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
 			}
-			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfos", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordSpecIdInfos = append(m.RecordSpecIdInfos, &RecordSpecIdInfo{})
-			if err := m.RecordSpecIdInfos[len(m.RecordSpecIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
@@ -9603,7 +10552,7 @@ func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9626,17 +10575,17 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgP8eMemorializeContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteRecordSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgP8eMemorializeContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteRecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9646,27 +10595,28 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9694,115 +10644,111 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupId = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecificationId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recitals", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Recitals == nil {
-				m.Recitals = &p8e.Recitals{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if err := m.Recitals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRecordSpecificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Contract == nil {
-				m.Contract = &p8e.Contract{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteP8eContractSpecRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteP8eContractSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contractspec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9829,16 +10775,13 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Signatures == nil {
-				m.Signatures = &p8e.SignatureSet{}
-			}
-			if err := m.Signatures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Contractspec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Invoker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9866,7 +10809,7 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Invoker = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9889,7 +10832,7 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9912,15 +10855,15 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgP8eMemorializeContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteP8eContractSpecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgP8eMemorializeContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteP8eContractSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9947,16 +10890,16 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeIdInfo == nil {
-				m.ScopeIdInfo = &ScopeIdInfo{}
+			if m.ContractSpecIdInfo == nil {
+				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
 			}
-			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9983,16 +10926,838 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdInfo == nil {
-				m.SessionIdInfo = &SessionIdInfo{}
-			}
-			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecordSpecIdInfos = append(m.RecordSpecIdInfos, &RecordSpecIdInfo{})
+			if err := m.RecordSpecIdInfos[len(m.RecordSpecIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfos", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgP8eMemorializeContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgP8eMemorializeContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeSpecificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recitals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recitals == nil {
+				m.Recitals = &p8e.Recitals{}
+			}
+			if err := m.Recitals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Contract == nil {
+				m.Contract = &p8e.Contract{}
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signatures == nil {
+				m.Signatures = &p8e.SignatureSet{}
+			}
+			if err := m.Signatures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invoker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invoker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgP8eMemorializeContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgP8eMemorializeContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
+			}
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdInfo == nil {
+				m.SessionIdInfo = &SessionIdInfo{}
+			}
+			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordIdInfos = append(m.RecordIdInfos, &RecordIdInfo{})
+			if err := m.RecordIdInfos[len(m.RecordIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBindOSLocatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindOSLocatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBindOSLocatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindOSLocatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteOSLocatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteOSLocatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteOSLocatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteOSLocatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModifyOSLocatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyOSLocatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10019,8 +11784,7 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordIdInfos = append(m.RecordIdInfos, &RecordIdInfo{})
-			if err := m.RecordIdInfos[len(m.RecordIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10045,7 +11809,7 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBindOSLocatorRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyOSLocatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10068,10 +11832,10 @@ func (m *MsgBindOSLocatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBindOSLocatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyOSLocatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBindOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10096,16 +11860,227 @@ func (m *MsgBindOSLocatorRequest) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestReverificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestReverificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestReverificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputedHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisputedHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservedHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10128,7 +12103,7 @@ func (m *MsgBindOSLocatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBindOSLocatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestReverificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10151,45 +12126,12 @@ func (m *MsgBindOSLocatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBindOSLocatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestReverificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBindOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestReverificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10211,7 +12153,7 @@ func (m *MsgBindOSLocatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteOSLocatorRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveReverificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10234,17 +12176,17 @@ func (m *MsgDeleteOSLocatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteOSLocatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveReverificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveReverificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10254,80 +12196,81 @@ func (m *MsgDeleteOSLocatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteOSLocatorResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteOSLocatorResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ReverificationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10337,80 +12280,29 @@ func (m *MsgDeleteOSLocatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Note = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgModifyOSLocatorRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyOSLocatorRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10420,24 +12312,23 @@ func (m *MsgModifyOSLocatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10460,7 +12351,7 @@ func (m *MsgModifyOSLocatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyOSLocatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveReverificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10483,45 +12374,12 @@ func (m *MsgModifyOSLocatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyOSLocatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveReverificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveReverificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
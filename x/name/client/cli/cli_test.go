@@ -3,6 +3,8 @@ package cli_test
 import (
 	"encoding/base64"
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"sort"
 	"strings"
 	"testing"
@@ -190,12 +192,22 @@ func (s *IntegrationTestSuite) TestGetNameParamsCmd() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			"{\"max_segment_length\":32,\"min_segment_length\":1,\"max_name_levels\":2,\"allow_unrestricted_names\":true}",
+			"{\"max_segment_length\":32,\"min_segment_length\":1,\"max_name_levels\":2,\"allow_unrestricted_names\":true,\"default_lease_seconds\":\"0\",\"max_lease_seconds\":\"0\",\"lease_grace_seconds\":\"0\",\"domain_name_regex\":\"\",\"domain_verifier\":\"\",\"allow_unicode_names\":false,\"binding_deposit\":{\"denom\":\"\",\"amount\":\"0\"},\"deposit_holding_seconds\":\"0\"}",
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
-			`allow_unrestricted_names: true
+			`allow_unicode_names: false
+allow_unrestricted_names: true
+binding_deposit:
+  amount: "0"
+  denom: ""
+default_lease_seconds: "0"
+deposit_holding_seconds: "0"
+domain_name_regex: ""
+domain_verifier: ""
+lease_grace_seconds: "0"
+max_lease_seconds: "0"
 max_name_levels: 2
 max_segment_length: 32
 min_segment_length: 1`,
@@ -253,6 +265,28 @@ func (s *IntegrationTestSuite) TestResolveNameCommand() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestDumpAndValidateDumpCommands() {
+	clientCtx := s.testnet.Validators[0].ClientCtx
+	expected, err := nametypes.NewNameDump("attribute", nametypes.NameRecords{
+		nametypes.NewNameRecord("attribute", s.accountAddr, false),
+		nametypes.NewNameRecord("example.attribute", s.accountAddr, false),
+	})
+	s.Require().NoError(err)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, namecli.DumpCommand(), []string{"--root=attribute"})
+	s.Require().NoError(err)
+	s.Require().Equal(strings.TrimSpace(string(expected)), strings.TrimSpace(out.String()))
+
+	archive := filepath.Join(s.T().TempDir(), "attribute.jsonl")
+	s.Require().NoError(ioutil.WriteFile(archive, out.Bytes(), 0600))
+	stamp, _, err := nametypes.ValidateNameDump(expected)
+	s.Require().NoError(err)
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, namecli.ValidateDumpCommand(), []string{archive})
+	s.Require().NoError(err)
+	s.Require().Equal(fmt.Sprintf("valid name dump of 2 names below attribute (sha256 %s)", stamp.Sha256), strings.TrimSpace(out.String()))
+}
+
 func (s *IntegrationTestSuite) TestReverseLookupCommand() {
 	accountKey := secp256k1.GenPrivKeyFromSecret([]byte("nobindinginthisaccount"))
 	addr, _ := sdk.AccAddressFromHex(accountKey.PubKey().Address().String())
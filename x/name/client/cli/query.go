@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -12,11 +13,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/provenance-io/provenance/x/name/types"
 )
 
+const flagRoot = "root"
+
+const flagStatus = "status"
+
 // GetQueryCmd is the top-level command for name CLI queries.
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
@@ -31,6 +37,12 @@ func GetQueryCmd() *cobra.Command {
 		QueryParamsCmd(),
 		ResolveNameCommand(),
 		ReverseLookupCommand(),
+		LeaseCommand(),
+		DomainVerificationCommand(),
+		DomainVerificationsCommand(),
+		DepositCommand(),
+		DumpCommand(),
+		ValidateDumpCommand(),
 	)
 
 	return queryCmd
@@ -97,6 +109,142 @@ func ResolveNameCommand() *cobra.Command {
 	return cmd
 }
 
+// LeaseCommand returns the command handler for querying the lease of a name.
+func LeaseCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lease [name]",
+		Short:   "Query the lease expiration of a name",
+		Example: fmt.Sprintf(`$ %s query name lease attrib.name`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			name := strings.ToLower(strings.TrimSpace(args[0]))
+
+			response, err := queryClient.Lease(context.Background(), &types.QueryLeaseRequest{Name: name})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// DepositCommand returns the command handler for querying the binding deposit held for a name.
+func DepositCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deposit [name]",
+		Short:   "Query the binding deposit held for a name",
+		Example: fmt.Sprintf(`$ %s query name deposit sample.root`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			name := strings.ToLower(strings.TrimSpace(args[0]))
+
+			response, err := queryClient.Deposit(context.Background(), &types.QueryDepositRequest{Name: name})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// DomainVerificationCommand returns the command handler for querying the verification of an external domain name.
+func DomainVerificationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "domain-verification [name]",
+		Short:   "Query the verification of an external domain name",
+		Example: fmt.Sprintf(`$ %s query name domain-verification example.com`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			name := strings.ToLower(strings.TrimSpace(args[0]))
+
+			response, err := queryClient.DomainVerification(context.Background(), &types.QueryDomainVerificationRequest{Name: name})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// DomainVerificationsCommand returns the command handler for listing the verifications of external domain names.
+func DomainVerificationsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "domain-verifications",
+		Short: "Query the verifications of external domain names",
+		Example: fmt.Sprintf(`$ %[1]s query name domain-verifications
+$ %[1]s query name domain-verifications --status pending --limit=100
+`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			request := types.QueryDomainVerificationsRequest{Pagination: pageReq}
+			status, err := cmd.Flags().GetString(flagStatus)
+			if err != nil {
+				return err
+			}
+			if len(status) > 0 {
+				value, found := types.DomainVerificationStatus_value["DOMAIN_VERIFICATION_STATUS_"+strings.ToUpper(strings.TrimSpace(status))]
+				if !found {
+					return fmt.Errorf("invalid status %q: must be one of pending, verified, rejected", status)
+				}
+				request.Status = types.DomainVerificationStatus(value)
+			}
+
+			response, err := queryClient.DomainVerifications(context.Background(), &request)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	cmd.Flags().String(flagStatus, "", "Only include verifications with this status (pending, verified, rejected)")
+	flags.AddPaginationFlagsToCmd(cmd, "domain verifications")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // ReverseLookupCommand returns the command handler for finding all names that point to an address.
 func ReverseLookupCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -141,6 +289,84 @@ $ %[1]s query name loopup pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --page=2 --l
 	return cmd
 }
 
+// DumpCommand returns the command handler for exporting all names below a root name as a name dump archive.
+func DumpCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "Export all names at or below a root name as a hash stamped JSON lines archive",
+		Long: strings.TrimSpace(`Export all names at or below a root name as a deterministic archive.
+Each line of the archive is a JSON name record (ordered by name) including its issuance policy and
+sorted issuers, and the final line is a stamp containing the archive format, the root name, the
+record count, and the sha256 hash of all preceding lines.
+`),
+		Example: fmt.Sprintf(`$ %[1]s query name dump --root attrib.name
+$ %[1]s query name dump --root attrib.name > attrib.name.jsonl`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			root, err := cmd.Flags().GetString(flagRoot)
+			if err != nil {
+				return err
+			}
+			root = strings.ToLower(strings.TrimSpace(root))
+
+			var records []types.NameRecord
+			pageReq := &query.PageRequest{}
+			for {
+				response, err := queryClient.Subtree(context.Background(), &types.QuerySubtreeRequest{Root: root, Pagination: pageReq})
+				if err != nil {
+					return fmt.Errorf("failed to query names below \"%s\": %w", root, err)
+				}
+				records = append(records, response.Records...)
+				if response.Pagination == nil || len(response.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: response.Pagination.NextKey}
+			}
+			archive, err := types.NewNameDump(root, records)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(archive)
+		},
+	}
+
+	cmd.Flags().String(flagRoot, "", "The root name of the names to export")
+	_ = cmd.MarkFlagRequired(flagRoot)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ValidateDumpCommand returns the command handler for checking a name dump archive before importing it elsewhere.
+func ValidateDumpCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "validate-dump [file]",
+		Short:   "Validate the format, hash stamp, and name hierarchy of a name dump archive",
+		Example: fmt.Sprintf(`$ %s query name validate-dump attrib.name.jsonl`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			archive, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			stamp, _, err := types.ValidateNameDump(archive)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("valid name dump of %d names below %s (sha256 %s)\n", stamp.Count, stamp.Root, stamp.Sha256)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
 // sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
 func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	encoded, err := flagSet.GetString(flags.FlagPageKey)
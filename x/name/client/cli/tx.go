@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/provenance-io/provenance/x/name/types"
@@ -19,6 +20,12 @@ import (
 // The flag for created restricted names
 const flagRestricted = "restrict"
 
+// The flag for the lease duration of created names
+const flagLeaseSeconds = "lease-seconds"
+
+// The flag for rejecting a domain verification
+const flagReject = "reject"
+
 // NewTxCmd is the top-level command for name CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -31,6 +38,11 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetBindNameCmd(),
 		GetDeleteNameCmd(),
+		GetRenewNameCmd(),
+		GetRequestDomainVerificationCmd(),
+		GetAttestDomainVerificationCmd(),
+		GetReclaimNameDepositCmd(),
+		GetSetNamePolicyCmd(),
 	)
 	return txCmd
 }
@@ -63,10 +75,15 @@ func GetBindNameCmd() *cobra.Command {
 					false,
 				),
 			)
+			msg.LeaseSeconds, err = cmd.Flags().GetUint64(flagLeaseSeconds)
+			if err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	cmd.Flags().BoolP(flagRestricted, "r", true, "Restrict creation of child names to owner only")
+	cmd.Flags().Uint64(flagLeaseSeconds, 0, "Seconds until the name expires (0 uses the default lease param)")
 
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
@@ -97,3 +114,156 @@ func GetDeleteNameCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetRenewNameCmd is the CLI command for extending the lease of a bound name.
+func GetRenewNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "renew [name] [seconds]",
+		Short:   "Extend the lease of a bound name in the provenance blockchain",
+		Example: fmt.Sprintf(`$ %s tx name renew sample.root.example 31536000`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			seconds, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid lease seconds %s: %w", args[1], err)
+			}
+			msg := types.NewMsgRenewNameRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.FromAddress,
+				seconds,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRequestDomainVerificationCmd is the CLI command for requesting the verification of an external domain name.
+func GetRequestDomainVerificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-domain-verification [name]",
+		Short: "Request the verification of an external domain name before binding it",
+		Long: strings.TrimSpace(`Request the verification of an external domain name before binding it to the from address.
+The returned challenge must be published in a DNS TXT record of the domain as "provenance-verification=<challenge>"
+so the domain verifier can attest to it.`),
+		Example: fmt.Sprintf(`$ %s tx name request-domain-verification example.com`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRequestDomainVerificationRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.FromAddress,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAttestDomainVerificationCmd is the CLI command for the domain verifier to attest to a domain verification.
+func GetAttestDomainVerificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-domain-verification [name] [challenge]",
+		Short: "Attest to the verification of an external domain name as the domain verifier",
+		Example: fmt.Sprintf(`$ %[1]s tx name attest-domain-verification example.com 5f2b...c01a
+$ %[1]s tx name attest-domain-verification example.com --reject`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			reject, err := cmd.Flags().GetBool(flagReject)
+			if err != nil {
+				return err
+			}
+			challenge := ""
+			if len(args) > 1 {
+				challenge = strings.TrimSpace(args[1])
+			}
+			msg := types.NewMsgAttestDomainVerificationRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.FromAddress,
+				challenge,
+				!reject,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(flagReject, false, "Reject the domain verification because the challenge was not found")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetReclaimNameDepositCmd is the CLI command for reclaiming the deposit paid to bind a name.
+func GetReclaimNameDepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reclaim-deposit [name]",
+		Short: "Reclaim the deposit paid to bind a name under an unrestricted root name",
+		Long: strings.TrimSpace(`Reclaim the deposit paid by the from address to bind a name under an unrestricted root name.
+The deposit holding period must have passed and the name must have attributes or child names bound under it.`),
+		Example: fmt.Sprintf(`$ %s tx name reclaim-deposit sample.root`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgReclaimNameDepositRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.FromAddress,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSetNamePolicyCmd is the CLI command for setting the policy controlling who can bind names under a name.
+func GetSetNamePolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-policy [name] [open|owner-only|allowlist] [issuer ...]",
+		Short: "Set the policy controlling which addresses can bind names under a name",
+		Long: strings.TrimSpace(`Set the policy controlling which addresses can bind names directly under a name owned by the from address.
+An open name allows any address to bind names under it, an owner-only name allows only its owner, and an allowlist
+name allows its owner and the listed issuer addresses.`),
+		Example: fmt.Sprintf(`$ %[1]s tx name set-policy registrar.pb owner-only
+$ %[1]s tx name set-policy registrar.pb allowlist pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			policy, err := types.ParseNameIssuancePolicy(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetNamePolicyRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.FromAddress,
+				policy,
+				args[2:],
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
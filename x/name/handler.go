@@ -23,6 +23,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgDeleteNameRequest:
 			res, err := msgServer.DeleteName(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRenewNameRequest:
+			res, err := msgServer.RenewName(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRequestDomainVerificationRequest:
+			res, err := msgServer.RequestDomainVerification(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAttestDomainVerificationRequest:
+			res, err := msgServer.AttestDomainVerification(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgReclaimNameDepositRequest:
+			res, err := msgServer.ReclaimNameDeposit(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetNamePolicyRequest:
+			res, err := msgServer.SetNamePolicy(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -34,6 +49,8 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.CreateRootNameProposal:
 			return keeper.HandleCreateRootNameProposal(ctx, k, c)
+		case *types.ClaimNameDepositProposal:
+			return keeper.HandleClaimNameDepositProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized name proposal content type: %T", c)
 		}
@@ -18,6 +18,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	simapp "github.com/provenance-io/provenance/app"
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
 	"github.com/provenance-io/provenance/x/name"
 	"github.com/provenance-io/provenance/x/name/keeper"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
@@ -92,7 +93,7 @@ func TestCreateName(t *testing.T) {
 
 	app.NameKeeper.InitGenesis(ctx, nameData)
 
-	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName))
+	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName), app.BankKeeper, &app.AttributeKeeper)
 	handler := name.NewHandler(app.NameKeeper)
 
 	for _, tc := range tests {
@@ -122,6 +123,11 @@ func TestDeleteName(t *testing.T) {
 		msg           *nametypes.MsgDeleteNameRequest
 		expectedEvent proto.Message
 	}{
+		{
+			name:          "delete name record used by attributes",
+			msg:           nametypes.NewMsgDeleteNameRequest(nametypes.NewNameRecord("attr.name", addr1, false)),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, nametypes.ErrNameHasAttributes.Error()),
+		},
 		{
 			name:          "delete name record",
 			msg:           nametypes.NewMsgDeleteNameRequest(nametypes.NewNameRecord("example.name", addr1, false)),
@@ -150,9 +156,12 @@ func TestDeleteName(t *testing.T) {
 	nameData.Params.MinSegmentLength = 2
 	nameData.Params.MaxSegmentLength = 16
 
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("attr.name", addr1, false))
 	app.NameKeeper.InitGenesis(ctx, nameData)
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+		attributetypes.NewAttribute("attr.name", addr1, attributetypes.AttributeType_String, []byte("value")), addr1))
 
-	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName))
+	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName), app.BankKeeper, &app.AttributeKeeper)
 	handler := name.NewHandler(app.NameKeeper)
 
 	for _, tc := range tests {
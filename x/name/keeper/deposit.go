@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// GetDeposit returns the binding deposit held for a name, or nil if no deposit is held.
+func (keeper Keeper) GetDeposit(ctx sdk.Context, name string) (*types.NameDeposit, error) {
+	key, err := types.GetDepositKey(name)
+	if err != nil {
+		return nil, err
+	}
+	bz := ctx.KVStore(keeper.storeKey).Get(key)
+	if bz == nil {
+		return nil, nil
+	}
+	deposit := &types.NameDeposit{}
+	if err = keeper.cdc.Unmarshal(bz, deposit); err != nil {
+		return nil, err
+	}
+	return deposit, nil
+}
+
+// SetDeposit stores the binding deposit held for a name.
+func (keeper Keeper) SetDeposit(ctx sdk.Context, deposit types.NameDeposit) error {
+	if err := deposit.Validate(); err != nil {
+		return err
+	}
+	key, err := types.GetDepositKey(deposit.Name)
+	if err != nil {
+		return err
+	}
+	bz, err := keeper.cdc.Marshal(&deposit)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(keeper.storeKey).Set(key, bz)
+	return nil
+}
+
+// RemoveDeposit deletes the binding deposit record of a name.
+func (keeper Keeper) RemoveDeposit(ctx sdk.Context, name string) error {
+	key, err := types.GetDepositKey(name)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(keeper.storeKey).Delete(key)
+	return nil
+}
+
+// GetAllDeposits returns all stored binding deposits.
+func (keeper Keeper) GetAllDeposits(ctx sdk.Context) []types.NameDeposit {
+	deposits := []types.NameDeposit{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), types.DepositKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		deposit := types.NameDeposit{}
+		keeper.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+	return deposits
+}
+
+// RequiresDeposit returns true if binding a name under the given parent record requires a deposit from the binder.
+// Deposits are only required for names bound directly under a root name open to any address by an address other than
+// the owner of the root.
+func (keeper Keeper) RequiresDeposit(ctx sdk.Context, parent types.NameRecord, binder string) bool {
+	if parent.EffectiveIssuancePolicy() != types.NameIssuancePolicy_NAME_ISSUANCE_POLICY_OPEN ||
+		strings.Contains(parent.Name, ".") || parent.Address == binder {
+		return false
+	}
+	return keeper.GetBindingDeposit(ctx).IsPositive()
+}
+
+// PayDeposit moves the binding deposit param amount from the depositor into the module account and records it for
+// the name.  The deposit is held until the deposit holding period has passed.
+func (keeper Keeper) PayDeposit(ctx sdk.Context, name string, depositor sdk.AccAddress) error {
+	existing, err := keeper.GetDeposit(ctx, name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return sdkerrors.Wrapf(types.ErrNameDeposit, "a deposit is already held for %s", name)
+	}
+	amount := keeper.GetBindingDeposit(ctx)
+	if err = keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+	holding := time.Duration(keeper.GetDepositHoldingSeconds(ctx)) * time.Second
+	deposit := types.NameDeposit{
+		Name:        name,
+		Depositor:   depositor.String(),
+		Amount:      amount,
+		ReleaseTime: ctx.BlockTime().Add(holding),
+	}
+	if err = keeper.SetDeposit(ctx, deposit); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventNameDepositPaid(deposit))
+}
+
+// HasAttributes returns true if any account has an attribute with the name.  Such names must stay bound, otherwise
+// the attributes would be left with a name that anyone could bind again.
+func (keeper Keeper) HasAttributes(ctx sdk.Context, name string) (bool, error) {
+	accounts, err := keeper.attrKeeper.GetAccountsByAttributeName(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	return len(accounts) > 0, nil
+}
+
+// IsNameInUse returns true if a name is bound and has attributes or child names bound under it.
+func (keeper Keeper) IsNameInUse(ctx sdk.Context, name string) (bool, error) {
+	if !keeper.NameExists(ctx, name) {
+		return false, nil
+	}
+	hasAttributes, err := keeper.HasAttributes(ctx, name)
+	if err != nil || hasAttributes {
+		return hasAttributes, err
+	}
+	subtreeKey, err := types.GetSubtreeKey(name)
+	if err != nil {
+		return false, err
+	}
+	// The subtree index lists the name itself first, so any other key under its prefix is a child name.
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), subtreeKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if !bytes.Equal(iterator.Key(), subtreeKey) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ReclaimDeposit returns the binding deposit of a name to its depositor.  The holding period must have passed and the
+// name must be in use.
+func (keeper Keeper) ReclaimDeposit(ctx sdk.Context, name string, depositor string) (*types.NameDeposit, error) {
+	deposit, err := keeper.releasedDeposit(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if deposit.Depositor != depositor {
+		return nil, sdkerrors.Wrapf(types.ErrNameDeposit, "%s did not pay the deposit for %s", depositor, name)
+	}
+	inUse, err := keeper.IsNameInUse(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !inUse {
+		return nil, sdkerrors.Wrapf(types.ErrNameDeposit, "%s does not have any attributes or child names", name)
+	}
+	addr, err := sdk.AccAddressFromBech32(deposit.Depositor)
+	if err != nil {
+		return nil, err
+	}
+	if err = keeper.releaseDeposit(ctx, *deposit, addr); err != nil {
+		return nil, err
+	}
+	return deposit, ctx.EventManager().EmitTypedEvent(types.NewEventNameDepositRefunded(*deposit))
+}
+
+// ClaimDeposit sends the binding deposit of a name to the recipient.  The holding period must have passed and the
+// name must not be in use.
+func (keeper Keeper) ClaimDeposit(ctx sdk.Context, name string, recipient sdk.AccAddress) (*types.NameDeposit, error) {
+	deposit, err := keeper.releasedDeposit(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	inUse, err := keeper.IsNameInUse(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if inUse {
+		return nil, sdkerrors.Wrapf(types.ErrNameDeposit, "%s is in use", name)
+	}
+	if err = keeper.releaseDeposit(ctx, *deposit, recipient); err != nil {
+		return nil, err
+	}
+	return deposit, ctx.EventManager().EmitTypedEvent(types.NewEventNameDepositClaimed(*deposit, recipient.String()))
+}
+
+// releasedDeposit returns the binding deposit of a name if its holding period has passed.
+func (keeper Keeper) releasedDeposit(ctx sdk.Context, name string) (*types.NameDeposit, error) {
+	deposit, err := keeper.GetDeposit(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if deposit == nil {
+		return nil, sdkerrors.Wrapf(types.ErrNameDeposit, "no deposit is held for %s", name)
+	}
+	if !deposit.IsReleased(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrNameDeposit, "deposit for %s is held until %s", name, deposit.ReleaseTime)
+	}
+	return deposit, nil
+}
+
+// releaseDeposit sends a binding deposit from the module account to an address and removes its record.
+func (keeper Keeper) releaseDeposit(ctx sdk.Context, deposit types.NameDeposit, to sdk.AccAddress) error {
+	if err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, sdk.NewCoins(deposit.Amount)); err != nil {
+		return err
+	}
+	return keeper.RemoveDeposit(ctx, deposit.Name)
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/name/keeper"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func (s *KeeperTestSuite) TestBindingDeposits() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NameKeeper)
+	deposit := sdk.NewInt64Coin("hash", 100)
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	params.AllowUnrestrictedNames = true
+	params.BindingDeposit = deposit
+	params.DepositHoldingSeconds = 3600
+	s.app.NameKeeper.SetParams(s.ctx, params)
+	s.Require().NoError(app.FundAccount(s.app, s.ctx, s.user2Addr, sdk.NewCoins(sdk.NewInt64Coin("hash", 250))))
+	moduleAddr := authtypes.NewModuleAddress(nametypes.ModuleName)
+	bind := func(ctx sdk.Context, name, parent string, signer sdk.AccAddress) error {
+		_, err := msgServer.BindName(sdk.WrapSDKContext(ctx), nametypes.NewMsgBindNameRequest(
+			nametypes.NewNameRecord(name, signer, false),
+			nametypes.NewNameRecord(parent, signer, false),
+		))
+		return err
+	}
+	later := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(2 * time.Hour))
+
+	s.Run("root owners do not pay a deposit", func() {
+		s.Require().NoError(bind(s.ctx, "owned", "name", s.user1Addr))
+		deposit, err := s.app.NameKeeper.GetDeposit(s.ctx, "owned.name")
+		s.Require().NoError(err)
+		s.Require().Nil(deposit)
+	})
+	s.Run("names below the root level do not pay a deposit", func() {
+		s.Require().False(s.app.NameKeeper.RequiresDeposit(s.ctx, nametypes.NewNameRecord("example.name", s.user1Addr, false), s.user2))
+	})
+	s.Run("other addresses pay a deposit under unrestricted roots", func() {
+		s.Require().NoError(bind(s.ctx, "squat", "name", s.user2Addr))
+		held, err := s.app.NameKeeper.GetDeposit(s.ctx, "squat.name")
+		s.Require().NoError(err)
+		s.Require().Equal(nametypes.NameDeposit{
+			Name:        "squat.name",
+			Depositor:   s.user2,
+			Amount:      deposit,
+			ReleaseTime: s.ctx.BlockTime().Add(time.Hour),
+		}, *held)
+		s.Require().Equal("100hash", s.app.BankKeeper.GetBalance(s.ctx, moduleAddr, "hash").String())
+		s.Require().Equal("150hash", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, "hash").String())
+	})
+	s.Run("binding fails without funds for the deposit", func() {
+		s.Require().NoError(bind(s.ctx, "used", "name", s.user2Addr))
+		s.Require().Error(bind(s.ctx, "broke", "name", s.user2Addr))
+		s.Require().False(s.app.NameKeeper.NameExists(s.ctx, "broke.name"))
+	})
+	s.Run("deposits are held until the holding period passes", func() {
+		_, err := msgServer.ReclaimNameDeposit(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgReclaimNameDepositRequest("used.name", s.user2Addr))
+		s.Require().Error(err)
+		s.Require().Contains(err.Error(), "is held until")
+		_, err = s.app.NameKeeper.ClaimDeposit(s.ctx, "squat.name", s.user1Addr)
+		s.Require().ErrorIs(err, nametypes.ErrNameDeposit)
+	})
+	s.Run("deposits of unused names cannot be reclaimed", func() {
+		_, err := s.app.NameKeeper.ReclaimDeposit(later, "squat.name", s.user2)
+		s.Require().ErrorIs(err, nametypes.ErrNameDeposit)
+	})
+	s.Run("only the depositor can reclaim a deposit", func() {
+		_, err := s.app.NameKeeper.ReclaimDeposit(later, "used.name", s.user1)
+		s.Require().ErrorIs(err, nametypes.ErrNameDeposit)
+	})
+	s.Run("deposits of names in use are returned to the depositor", func() {
+		s.Require().NoError(bind(later, "sub", "used.name", s.user2Addr))
+		_, err := s.app.NameKeeper.ClaimDeposit(later, "used.name", s.user1Addr)
+		s.Require().ErrorIs(err, nametypes.ErrNameDeposit)
+		_, err = msgServer.ReclaimNameDeposit(sdk.WrapSDKContext(later), nametypes.NewMsgReclaimNameDepositRequest("used.name", s.user2Addr))
+		s.Require().NoError(err)
+		held, err := s.app.NameKeeper.GetDeposit(later, "used.name")
+		s.Require().NoError(err)
+		s.Require().Nil(held)
+		s.Require().Equal("150hash", s.app.BankKeeper.GetBalance(later, s.user2Addr, "hash").String())
+	})
+	s.Run("deposits of unused names can be claimed by governance", func() {
+		proposal := nametypes.NewClaimNameDepositProposal("title", "description", "squat.name", s.user1Addr)
+		s.Require().NoError(keeper.HandleClaimNameDepositProposal(later, s.app.NameKeeper, proposal))
+		s.Require().Equal("100hash", s.app.BankKeeper.GetBalance(later, s.user1Addr, "hash").String())
+		s.Require().True(s.app.BankKeeper.GetBalance(later, moduleAddr, "hash").IsZero())
+		s.Require().Empty(s.app.NameKeeper.GetAllDeposits(later))
+	})
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// IsDomainName returns true if a (normalized) name matches the domain name regex param, meaning it is an external
+// domain that must be verified before being bound.
+func (keeper Keeper) IsDomainName(ctx sdk.Context, name string) bool {
+	exp := keeper.GetDomainNameRegex(ctx)
+	if len(exp) == 0 {
+		return false
+	}
+	matched, err := regexp.MatchString(fmt.Sprintf(`^%s$`, exp), name)
+	return err == nil && matched
+}
+
+// GetDomainVerification returns the verification of an external domain, or nil if one has not been requested.
+func (keeper Keeper) GetDomainVerification(ctx sdk.Context, name string) (*types.DomainVerification, error) {
+	key, err := types.GetDomainVerificationKey(name)
+	if err != nil {
+		return nil, err
+	}
+	bz := ctx.KVStore(keeper.storeKey).Get(key)
+	if bz == nil {
+		return nil, nil
+	}
+	verification := &types.DomainVerification{}
+	if err = keeper.cdc.Unmarshal(bz, verification); err != nil {
+		return nil, err
+	}
+	return verification, nil
+}
+
+// SetDomainVerification stores the verification of an external domain, replacing any existing verification.
+func (keeper Keeper) SetDomainVerification(ctx sdk.Context, verification types.DomainVerification) error {
+	key, err := types.GetDomainVerificationKey(verification.Name)
+	if err != nil {
+		return err
+	}
+	bz, err := keeper.cdc.Marshal(&verification)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(keeper.storeKey).Set(key, bz)
+	return nil
+}
+
+// GetAllDomainVerifications returns all stored domain verifications.
+func (keeper Keeper) GetAllDomainVerifications(ctx sdk.Context) []types.DomainVerification {
+	verifications := []types.DomainVerification{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), types.DomainVerificationKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		verification := types.DomainVerification{}
+		keeper.cdc.MustUnmarshal(iterator.Value(), &verification)
+		verifications = append(verifications, verification)
+	}
+	return verifications
+}
+
+// RequestDomainVerification starts the verification of an external domain for an owner and returns the challenge the
+// owner must publish in a DNS TXT record of the domain (as "provenance-verification=<challenge>").  A domain that is
+// already verified for a different owner cannot be requested.
+func (keeper Keeper) RequestDomainVerification(ctx sdk.Context, name string, owner string) (*types.DomainVerification, error) {
+	if len(keeper.GetDomainVerifier(ctx)) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrDomainVerification, "no domain verifier is configured")
+	}
+	if !keeper.IsDomainName(ctx, name) {
+		return nil, sdkerrors.Wrapf(types.ErrDomainVerification, "%s is not an external domain name", name)
+	}
+	existing, err := keeper.GetDomainVerification(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.Owner != owner && existing.Status == types.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_VERIFIED {
+		return nil, sdkerrors.Wrapf(types.ErrDomainVerification, "%s is already verified for %s", name, existing.Owner)
+	}
+	challenge := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%d", name, owner, ctx.BlockHeight())))
+	verification := types.DomainVerification{
+		Name:      name,
+		Owner:     owner,
+		Challenge: fmt.Sprintf("%x", challenge),
+		Status:    types.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_PENDING,
+	}
+	if err = keeper.SetDomainVerification(ctx, verification); err != nil {
+		return nil, err
+	}
+	requestedEvent := types.NewEventDomainVerificationRequested(name, owner, verification.Challenge)
+	if err = ctx.EventManager().EmitTypedEvent(requestedEvent); err != nil {
+		return nil, err
+	}
+	return &verification, nil
+}
+
+// AttestDomainVerification records the result of the domain verifier checking the DNS TXT record of a pending
+// domain verification.  A verified attestation must include the challenge of the pending verification.
+func (keeper Keeper) AttestDomainVerification(ctx sdk.Context, name string, verifier string, challenge string, verified bool) (*types.DomainVerification, error) {
+	if expected := keeper.GetDomainVerifier(ctx); len(expected) == 0 || expected != verifier {
+		return nil, sdkerrors.Wrapf(types.ErrDomainVerification, "%s is not the domain verifier", verifier)
+	}
+	verification, err := keeper.GetDomainVerification(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if verification == nil {
+		return nil, sdkerrors.Wrapf(types.ErrDomainVerification, "no verification requested for %s", name)
+	}
+	if verification.Status != types.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_PENDING {
+		return nil, sdkerrors.Wrapf(types.ErrDomainVerification, "verification of %s is not pending", name)
+	}
+	verification.Status = types.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_REJECTED
+	if verified {
+		if challenge != verification.Challenge {
+			return nil, sdkerrors.Wrapf(types.ErrDomainVerification, "challenge does not match verification of %s", name)
+		}
+		verification.Status = types.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_VERIFIED
+	}
+	verification.Verifier = verifier
+	if err = keeper.SetDomainVerification(ctx, *verification); err != nil {
+		return nil, err
+	}
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventDomainVerificationAttested(*verification)); err != nil {
+		return nil, err
+	}
+	return verification, nil
+}
+
+// CheckDomainVerification returns an error if a name is an external domain that has not been verified for the address
+// it is being bound to.
+func (keeper Keeper) CheckDomainVerification(ctx sdk.Context, name string, address string) error {
+	if !keeper.IsDomainName(ctx, name) {
+		return nil
+	}
+	verification, err := keeper.GetDomainVerification(ctx, name)
+	if err != nil {
+		return err
+	}
+	if verification == nil || verification.Owner != address ||
+		verification.Status != types.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_VERIFIED {
+		return sdkerrors.Wrapf(types.ErrDomainNotVerified, "%s for %s", name, address)
+	}
+	return nil
+}
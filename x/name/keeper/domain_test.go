@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/keeper"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func (s *KeeperTestSuite) setDomainParams(regex, verifier string) {
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	params.DomainNameRegex = regex
+	params.DomainVerifier = verifier
+	s.app.NameKeeper.SetParams(s.ctx, params)
+}
+
+func (s *KeeperTestSuite) TestDomainVerification() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NameKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	bindMsg := nametypes.NewMsgBindNameRequest(
+		nametypes.NewNameRecord("site", s.user2Addr, false),
+		nametypes.NewNameRecord("name", s.user1Addr, false),
+	)
+	s.setDomainParams(`[a-z]+\.name`, "")
+
+	s.Run("verification cannot be requested without a verifier", func() {
+		_, err := s.app.NameKeeper.RequestDomainVerification(s.ctx, "site.name", s.user2)
+		s.Require().ErrorIs(err, nametypes.ErrDomainVerification)
+	})
+	s.setDomainParams(`[a-z]+\.name`, s.user1)
+	s.Run("names that are not domains cannot be verified", func() {
+		_, err := s.app.NameKeeper.RequestDomainVerification(s.ctx, "sub.site.name", s.user2)
+		s.Require().ErrorIs(err, nametypes.ErrDomainVerification)
+	})
+	s.Run("unverified domains cannot be bound", func() {
+		_, err := msgServer.BindName(goCtx, bindMsg)
+		s.Require().Error(err)
+		s.Require().Contains(err.Error(), nametypes.ErrDomainNotVerified.Error())
+	})
+
+	var challenge string
+	s.Run("requesting verification returns a challenge", func() {
+		res, err := msgServer.RequestDomainVerification(goCtx, nametypes.NewMsgRequestDomainVerificationRequest("site.name", s.user2Addr))
+		s.Require().NoError(err)
+		s.Require().NotEmpty(res.Challenge)
+		challenge = res.Challenge
+		verification, err := s.app.NameKeeper.GetDomainVerification(s.ctx, "site.name")
+		s.Require().NoError(err)
+		s.Require().Equal(nametypes.DomainVerification{
+			Name:      "site.name",
+			Owner:     s.user2,
+			Challenge: challenge,
+			Status:    nametypes.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_PENDING,
+		}, *verification)
+	})
+	s.Run("pending domains cannot be bound", func() {
+		s.Require().ErrorIs(s.app.NameKeeper.CheckDomainVerification(s.ctx, "site.name", s.user2), nametypes.ErrDomainNotVerified)
+	})
+	s.Run("only the verifier can attest", func() {
+		_, err := s.app.NameKeeper.AttestDomainVerification(s.ctx, "site.name", s.user2, challenge, true)
+		s.Require().ErrorIs(err, nametypes.ErrDomainVerification)
+	})
+	s.Run("the challenge must match to verify", func() {
+		_, err := s.app.NameKeeper.AttestDomainVerification(s.ctx, "site.name", s.user1, "wrong", true)
+		s.Require().ErrorIs(err, nametypes.ErrDomainVerification)
+	})
+	s.Run("verified domains can be bound to the owner", func() {
+		_, err := msgServer.AttestDomainVerification(goCtx, nametypes.NewMsgAttestDomainVerificationRequest("site.name", s.user1Addr, challenge, true))
+		s.Require().NoError(err)
+		s.Require().ErrorIs(s.app.NameKeeper.CheckDomainVerification(s.ctx, "site.name", s.user1), nametypes.ErrDomainNotVerified)
+		_, err = msgServer.BindName(goCtx, bindMsg)
+		s.Require().NoError(err)
+	})
+	s.Run("verified domains cannot be requested by another owner", func() {
+		_, err := s.app.NameKeeper.RequestDomainVerification(s.ctx, "site.name", s.user1)
+		s.Require().ErrorIs(err, nametypes.ErrDomainVerification)
+	})
+	s.Run("attested verifications are no longer pending", func() {
+		_, err := s.app.NameKeeper.AttestDomainVerification(s.ctx, "site.name", s.user1, challenge, true)
+		s.Require().ErrorIs(err, nametypes.ErrDomainVerification)
+	})
+	s.Run("rejected verifications do not allow binding", func() {
+		verification, err := s.app.NameKeeper.RequestDomainVerification(s.ctx, "other.name", s.user2)
+		s.Require().NoError(err)
+		verification, err = s.app.NameKeeper.AttestDomainVerification(s.ctx, "other.name", s.user1, "", false)
+		s.Require().NoError(err)
+		s.Require().Equal(nametypes.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_REJECTED, verification.Status)
+		s.Require().Equal(s.user1, verification.Verifier)
+		s.Require().ErrorIs(s.app.NameKeeper.CheckDomainVerification(s.ctx, "other.name", s.user2), nametypes.ErrDomainNotVerified)
+	})
+	s.Run("verifications can be queried by status", func() {
+		res, err := s.app.NameKeeper.DomainVerifications(goCtx, &nametypes.QueryDomainVerificationsRequest{
+			Status: nametypes.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_VERIFIED,
+		})
+		s.Require().NoError(err)
+		s.Require().Len(res.Verifications, 1)
+		s.Require().Equal("site.name", res.Verifications[0].Name)
+		s.Require().Len(s.app.NameKeeper.GetAllDomainVerifications(s.ctx), 2)
+	})
+	s.Run("verifications are exported", func() {
+		genesis := s.app.NameKeeper.ExportGenesis(s.ctx)
+		s.Require().Len(genesis.DomainVerifications, 2)
+		s.Require().NoError(genesis.Validate())
+	})
+}
@@ -17,6 +17,26 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		if err := keeper.SetNameRecord(ctx, record.Name, addr, record.Restricted); err != nil {
 			panic(err)
 		}
+		if record.IssuancePolicy != types.NameIssuancePolicy_NAME_ISSUANCE_POLICY_UNSPECIFIED {
+			if err := keeper.SetIssuancePolicy(ctx, record.Name, record.IssuancePolicy, record.Issuers); err != nil {
+				panic(err)
+			}
+		}
+	}
+	for _, lease := range data.Leases {
+		if err := keeper.SetLease(ctx, lease); err != nil {
+			panic(err)
+		}
+	}
+	for _, verification := range data.DomainVerifications {
+		if err := keeper.SetDomainVerification(ctx, verification); err != nil {
+			panic(err)
+		}
+	}
+	for _, deposit := range data.Deposits {
+		if err := keeper.SetDeposit(ctx, deposit); err != nil {
+			panic(err)
+		}
 	}
 }
 
@@ -34,5 +54,5 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err := keeper.IterateRecords(ctx, types.NameKeyPrefix, appendToRecords); err != nil {
 		panic(err)
 	}
-	return types.NewGenesisState(params, records)
+	return types.NewGenesisState(params, records, keeper.GetAllLeases(ctx), keeper.GetAllDomainVerifications(ctx), keeper.GetAllDeposits(ctx))
 }
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// The name of the name address index invariant
+const addressIndexInvariantName = "address-index"
+
+// RegisterInvariants registers module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, addressIndexInvariantName, AddressIndexInvariant(k))
+}
+
+// AllInvariants runs all invariants of the name module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return AddressIndexInvariant(k)(ctx)
+	}
+}
+
+// AddressIndexInvariant checks that every name binding has a matching address index entry and that every address
+// index entry refers to an existing name binding for the same address.
+func AddressIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var problems []string
+		store := ctx.KVStore(k.storeKey)
+
+		// Forward bindings must have an identical address index entry.
+		nameIterator := sdk.KVStorePrefixIterator(store, types.NameKeyPrefix)
+		for ; nameIterator.Valid(); nameIterator.Next() {
+			record := types.NameRecord{}
+			if err := k.cdc.Unmarshal(nameIterator.Value(), &record); err != nil {
+				problems = append(problems, fmt.Sprintf("invalid name record at key %X: %v", nameIterator.Key(), err))
+				continue
+			}
+			addr, err := sdk.AccAddressFromBech32(record.Address)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("name %s has an invalid address: %v", record.Name, err))
+				continue
+			}
+			addrPrefix, err := types.GetAddressKeyPrefix(addr)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("name %s has an invalid address: %v", record.Name, err))
+				continue
+			}
+			indexValue := store.Get(append(addrPrefix, nameIterator.Key()...))
+			if !bytes.Equal(indexValue, nameIterator.Value()) {
+				problems = append(problems, fmt.Sprintf("name %s is missing from the address index of %s", record.Name, record.Address))
+			}
+		}
+		nameIterator.Close()
+
+		// Address index entries must refer to a forward binding for the same address.
+		indexIterator := sdk.KVStorePrefixIterator(store, types.AddressKeyPrefix)
+		for ; indexIterator.Valid(); indexIterator.Next() {
+			record := types.NameRecord{}
+			if err := k.cdc.Unmarshal(indexIterator.Value(), &record); err != nil {
+				problems = append(problems, fmt.Sprintf("invalid address index record at key %X: %v", indexIterator.Key(), err))
+				continue
+			}
+			nameKey, err := types.GetNameKeyPrefix(record.Name)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("address index record has an invalid name %s: %v", record.Name, err))
+				continue
+			}
+			if !bytes.HasSuffix(indexIterator.Key(), nameKey) {
+				problems = append(problems, fmt.Sprintf("address index key %X does not match name %s", indexIterator.Key(), record.Name))
+				continue
+			}
+			if !bytes.Equal(store.Get(nameKey), indexIterator.Value()) {
+				problems = append(problems, fmt.Sprintf("address index of %s has stale name %s", record.Address, record.Name))
+			}
+		}
+		indexIterator.Close()
+
+		broken := len(problems) > 0
+		msg := fmt.Sprintf("found %d name address index problems\n", len(problems))
+		for _, problem := range problems {
+			msg += fmt.Sprintf("\t%s\n", problem)
+		}
+		return sdk.FormatInvariant(types.ModuleName, addressIndexInvariantName, msg), broken
+	}
+}
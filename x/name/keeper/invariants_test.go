@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+
+	namekeeper "github.com/provenance-io/provenance/x/name/keeper"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func TestAddressIndexInvariant(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	user := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// Get a reference to our invariant checks
+	invariantChecks := namekeeper.AllInvariants(app.NameKeeper)
+	require.NotNil(t, invariantChecks)
+
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "invariant", user, false))
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "sub.invariant", user, false))
+
+	// forward bindings and the address index agree
+	_, isBroken := invariantChecks(ctx)
+	require.False(t, isBroken)
+
+	store := ctx.KVStore(app.GetKey(nametypes.StoreKey))
+	nameKey, err := nametypes.GetNameKeyPrefix("sub.invariant")
+	require.NoError(t, err)
+	addrPrefix, err := nametypes.GetAddressKeyPrefix(user)
+	require.NoError(t, err)
+	indexKey := append(addrPrefix, nameKey...)
+
+	// a missing address index entry breaks the invariant
+	indexValue := store.Get(indexKey)
+	store.Delete(indexKey)
+	msg, isBroken := invariantChecks(ctx)
+	require.True(t, isBroken)
+	require.Contains(t, msg, "name sub.invariant is missing from the address index of "+user.String())
+
+	// a stale address index entry breaks the invariant
+	store.Set(indexKey, indexValue)
+	store.Delete(nameKey)
+	msg, isBroken = invariantChecks(ctx)
+	require.True(t, isBroken)
+	require.Contains(t, msg, "address index of "+user.String()+" has stale name sub.invariant")
+}
@@ -30,17 +30,26 @@ type Keeper struct {
 
 	// The codec codec for binary encoding/decoding.
 	cdc codec.BinaryCodec
+
+	// To hold binding deposits in the module account.
+	bankKeeper types.BankKeeper
+
+	// To check for attributes using a name before a binding deposit is returned.
+	attrKeeper types.AttributeKeeper
 }
 
 // NewKeeper returns a name keeper. It handles:
 // - managing a hierarchy of names
 // - enforcing permissions for name creation/deletion
+// - holding deposits for names bound under unrestricted root names
 //
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key sdk.StoreKey,
 	paramSpace paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
+	attrKeeper types.AttributeKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -51,6 +60,8 @@ func NewKeeper(
 		storeKey:   key,
 		paramSpace: paramSpace,
 		cdc:        cdc,
+		bankKeeper: bankKeeper,
+		attrKeeper: attrKeeper,
 	}
 }
 
@@ -101,6 +112,12 @@ func (keeper Keeper) SetNameRecord(ctx sdk.Context, name string, addr sdk.AccAdd
 	}
 	indexKey := append(addrPrefix, key...) // [0x04] :: [addr-bytes] :: [name-key-bytes]
 	store.Set(indexKey, bz)
+	// And index by place in the name hierarchy
+	subtreeKey, err := types.GetSubtreeKey(name)
+	if err != nil {
+		return err
+	}
+	store.Set(subtreeKey, bz)
 
 	nameBoundEvent := types.NewEventNameBound(record.Address, name)
 
@@ -111,6 +128,93 @@ func (keeper Keeper) SetNameRecord(ctx sdk.Context, name string, addr sdk.AccAdd
 	return nil
 }
 
+// SetIssuancePolicy sets the policy controlling which addresses can bind names directly under a bound name.
+func (keeper Keeper) SetIssuancePolicy(ctx sdk.Context, name string, policy types.NameIssuancePolicy, issuers []string) error {
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	record.SetIssuancePolicy(policy, issuers)
+	if err = record.ValidateBasic(); err != nil {
+		return err
+	}
+	addr, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return err
+	}
+	key, err := types.GetNameKeyPrefix(record.Name)
+	if err != nil {
+		return err
+	}
+	addrPrefix, err := types.GetAddressKeyPrefix(addr)
+	if err != nil {
+		return err
+	}
+	subtreeKey, err := types.GetSubtreeKey(record.Name)
+	if err != nil {
+		return err
+	}
+	bz, err := keeper.cdc.Marshal(record)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(key, bz)
+	store.Set(append(addrPrefix, key...), bz)
+	store.Set(subtreeKey, bz)
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventNameIssuancePolicySet(*record))
+}
+
+// CheckIssuer returns an error unless the issuance policy of the parent record allows the issuer to bind names
+// directly under it.
+func (keeper Keeper) CheckIssuer(parent types.NameRecord, issuer sdk.AccAddress) error { // nolint:interfacer
+	if parent.CanIssue(issuer.String()) {
+		return nil
+	}
+	if parent.EffectiveIssuancePolicy() == types.NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "parent name %s is restricted to its owner and issuers and %s is neither", parent.Name, issuer)
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "parent name %s is restricted and does not resolve to %s", parent.Name, issuer)
+}
+
+// BindNameIfMissing binds a name to the owner address unless the name is already bound.  The same checks are applied
+// as a Msg/BindName signed by the owner: the parent name must exist, its issuance policy must allow the owner, and the
+// owner pays the binding deposit for names directly under an open root.  New names are restricted and leased for the
+// default lease duration.
+func (keeper Keeper) BindNameIfMissing(ctx sdk.Context, name string, owner sdk.AccAddress) error {
+	name, err := keeper.Normalize(ctx, name)
+	if err != nil {
+		return err
+	}
+	if keeper.NameExists(ctx, name) {
+		return nil
+	}
+	sep := strings.Index(name, ".")
+	if sep < 0 {
+		return sdkerrors.Wrapf(types.ErrNameNotBound, "root name %s can only be bound through governance", name)
+	}
+	parent, err := keeper.GetRecordByName(ctx, name[sep+1:])
+	if err != nil {
+		return err
+	}
+	if err = keeper.CheckIssuer(*parent, owner); err != nil {
+		return err
+	}
+	if err = keeper.CheckDomainVerification(ctx, name, owner.String()); err != nil {
+		return err
+	}
+	if keeper.RequiresDeposit(ctx, *parent, owner.String()) {
+		if err = keeper.PayDeposit(ctx, name, owner); err != nil {
+			return err
+		}
+	}
+	if err = keeper.SetNameRecord(ctx, name, owner, true); err != nil {
+		return err
+	}
+	return keeper.LeaseName(ctx, name, 0)
+}
+
 // GetRecordByName resolves a record by name.
 func (keeper Keeper) GetRecordByName(ctx sdk.Context, name string) (record *types.NameRecord, err error) {
 	key, err := types.GetNameKeyPrefix(name)
@@ -173,6 +277,26 @@ func (keeper Keeper) GetRecordsByAddress(ctx sdk.Context, address sdk.AccAddress
 	return records, nil
 }
 
+// GetRecordsInSubtree looks up all names at or below a root name.  Each name is followed by the names below it.
+func (keeper Keeper) GetRecordsInSubtree(ctx sdk.Context, root string) (types.NameRecords, error) {
+	// Return value data structure.
+	records := types.NameRecords{}
+	// Handler that adds all records of the subtree.
+	appendToRecords := func(record types.NameRecord) error {
+		records = append(records, record)
+		return nil
+	}
+	subtreeKey, err := types.GetSubtreeKey(root)
+	if err != nil {
+		return nil, err
+	}
+	// Collect and return all names at or below the root.
+	if err := keeper.IterateRecords(ctx, subtreeKey, appendToRecords); err != nil {
+		return records, err
+	}
+	return records, nil
+}
+
 // DeleteRecord removes a name record from the kvstore.
 func (keeper Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 	// Need the record to clear the address index
@@ -200,6 +324,16 @@ func (keeper Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 	if store.Has(indexKey) {
 		store.Delete(indexKey)
 	}
+	// Delete the subtree index record
+	subtreeKey, err := types.GetSubtreeKey(name)
+	if err != nil {
+		return err
+	}
+	store.Delete(subtreeKey)
+	// Delete any lease on the name
+	if err := keeper.RemoveLease(ctx, name); err != nil {
+		return err
+	}
 
 	nameUnboundEvent := types.NewEventNameUnbound(record.Address, name)
 
@@ -239,8 +373,12 @@ func (keeper Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle Handl
 // Normalize returns a name is storage format.
 func (keeper Keeper) Normalize(ctx sdk.Context, name string) (string, error) {
 	comps := make([]string, 0)
+	allowUnicode := keeper.GetAllowUnicodeNames(ctx)
 	for _, comp := range strings.Split(name, ".") {
 		comp = strings.ToLower(strings.TrimSpace(comp))
+		if allowUnicode {
+			comp = normalizeUnicode(comp)
+		}
 		lenComp := uint32(len(comp))
 		isUUID := isValidUUID(comp)
 		if lenComp < keeper.GetMinSegmentLength(ctx) {
@@ -249,7 +387,11 @@ func (keeper Keeper) Normalize(ctx sdk.Context, name string) (string, error) {
 		if lenComp > keeper.GetMaxSegmentLength(ctx) && !isUUID {
 			return "", types.ErrNameSegmentTooLong
 		}
-		if !isValid(comp) {
+		valid := isValid(comp)
+		if allowUnicode {
+			valid = isValidUnicode(comp)
+		}
+		if !valid {
 			return "", types.ErrNameInvalid
 		}
 		comps = append(comps, comp)
@@ -8,10 +8,12 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/name/keeper"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/suite"
 
 	nametypes "github.com/provenance-io/provenance/x/name/types"
@@ -86,13 +88,30 @@ func (s *KeeperTestSuite) TestSetup() {
   minsegmentlength: 2
   maxnamelevels: 16
   allowunrestrictednames: false
+  defaultleaseseconds: 0
+  maxleaseseconds: 0
+  leasegraceseconds: 0
+  domainnameregex: ""
+  domainverifier: ""
+  allowunicodenames: false
+  bindingdeposit:
+    denom: ""
+    amount: "0"
+  depositholdingseconds: 0
 bindings:
 - name: name
   address: %[1]s
   restricted: false
+  issuancepolicy: 0
+  issuers: []
 - name: example.name
   address: %[1]s
   restricted: false
+  issuancepolicy: 0
+  issuers: []
+leases: []
+domainverifications: []
+deposits: []
 `, s.user1Addr.String()), string(out))
 }
 
@@ -150,6 +169,110 @@ func (s *KeeperTestSuite) TestNameNormalization() {
 	}
 }
 
+func (s *KeeperTestSuite) TestUnicodeNameNormalization() {
+	tests := []struct {
+		name         string
+		input        string
+		want         string
+		wantErr      bool
+		wantErrNoUni bool
+	}{
+		{"ascii names are unchanged", "TEST.Normalize.pio", "test.normalize.pio", false, false},
+		{"han", "名前.name", "名前.name", false, true},
+		{"hiragana and han", "なまえ漢字.name", "なまえ漢字.name", false, true},
+		{"katakana with prolonged sound mark", "データ.name", "データ.name", false, true},
+		{"cyrillic", "Имя.name", "имя.name", false, false},
+		{"fullwidth is normalized to ascii", "ｆｕｌｌ.name", "full.name", false, false},
+		{"fail on latin mixed with cyrillic", "pаypal.name", "", true, false},
+		{"fail on cyrillic latin lookalikes", "сосо.name", "", true, false},
+		{"fail on hangul mixed with hiragana", "한なま.name", "", true, true},
+		{"fail on symbols", "🙂🙂.name", "", true, true},
+		{"fail on multiple dashes", "名-前-字.name", "", true, true},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.app.NameKeeper.Normalize(s.ctx, tt.input)
+			s.Assert().Equal(tt.wantErrNoUni, err != nil, "without unicode names")
+
+			params := s.app.NameKeeper.GetParams(s.ctx)
+			params.AllowUnicodeNames = true
+			s.app.NameKeeper.SetParams(s.ctx, params)
+			defer func() {
+				params.AllowUnicodeNames = false
+				s.app.NameKeeper.SetParams(s.ctx, params)
+			}()
+
+			got, err := s.app.NameKeeper.Normalize(s.ctx, tt.input)
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Require().Equal(tt.want, got)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMigrate2to3() {
+	// names bound before unicode names were allowed may not be normalized
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "ｆｕｌｌ.name", s.user2Addr, true))
+	s.Require().NoError(s.app.NameKeeper.LeaseName(s.ctx, "ｆｕｌｌ.name", 3600))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "ｎａｍｅ", s.user2Addr, false))
+	// names bound before the subtree index existed are not indexed
+	store := s.ctx.KVStore(s.app.GetKey(nametypes.StoreKey))
+	for _, name := range []string{"name", "example.name"} {
+		subtreeKey, err := nametypes.GetSubtreeKey(name)
+		s.Require().NoError(err)
+		store.Delete(subtreeKey)
+	}
+
+	migrator := keeper.NewMigrator(s.app.NameKeeper)
+	s.Require().NoError(migrator.Migrate2to3(s.ctx))
+
+	s.Run("ascii names are untouched", func() {
+		record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "example.name")
+		s.Require().NoError(err)
+		s.Require().Equal(s.user1, record.Address)
+	})
+	s.Run("names are moved to the normalized name", func() {
+		s.Require().False(s.app.NameKeeper.NameExists(s.ctx, "ｆｕｌｌ.name"))
+		record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "full.name")
+		s.Require().NoError(err)
+		s.Require().Equal("full.name", record.Name)
+		s.Require().Equal(s.user2, record.Address)
+		s.Require().True(record.Restricted)
+
+		records, err := s.app.NameKeeper.GetRecordsByAddress(s.ctx, s.user2Addr)
+		s.Require().NoError(err)
+		s.Require().Len(records, 2)
+
+		lease, err := s.app.NameKeeper.GetLease(s.ctx, "full.name")
+		s.Require().NoError(err)
+		s.Require().NotNil(lease)
+		s.Require().Equal("full.name", lease.Name)
+		lease, err = s.app.NameKeeper.GetLease(s.ctx, "ｆｕｌｌ.name")
+		s.Require().NoError(err)
+		s.Require().Nil(lease)
+	})
+	s.Run("names whose normalized name is bound are left as is", func() {
+		record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "ｎａｍｅ")
+		s.Require().NoError(err)
+		s.Require().Equal(s.user2, record.Address)
+		record, err = s.app.NameKeeper.GetRecordByName(s.ctx, "name")
+		s.Require().NoError(err)
+		s.Require().Equal(s.user1, record.Address)
+	})
+	s.Run("all names are indexed by their place in the name hierarchy", func() {
+		records, err := s.app.NameKeeper.GetRecordsInSubtree(s.ctx, "name")
+		s.Require().NoError(err)
+		names := make([]string, len(records))
+		for i, record := range records {
+			names[i] = record.Name
+		}
+		s.Require().Equal([]string{"name", "example.name", "full.name"}, names)
+	})
+}
+
 func (s *KeeperTestSuite) TestSetName() {
 	cases := map[string]struct {
 		recordName     string
@@ -202,6 +325,121 @@ func (s *KeeperTestSuite) TestSetName() {
 	}
 }
 
+func (s *KeeperTestSuite) TestBindNameIfMissing() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "restricted.name", s.user1Addr, true))
+
+	cases := map[string]struct {
+		name     string
+		owner    sdk.AccAddress
+		errorMsg string
+		wantAddr string
+	}{
+		"existing name is left alone": {
+			name:     "example.name",
+			owner:    s.user2Addr,
+			wantAddr: s.user1,
+		},
+		"root name cannot be bound": {
+			name:     "newroot",
+			owner:    s.user1Addr,
+			errorMsg: "root name newroot can only be bound through governance: no address bound to name",
+		},
+		"parent name must exist": {
+			name:     "new.missing.name",
+			owner:    s.user1Addr,
+			errorMsg: "no address bound to name",
+		},
+		"restricted parent must resolve to owner": {
+			name:     "new.restricted.name",
+			owner:    s.user2Addr,
+			errorMsg: fmt.Sprintf("parent name restricted.name is restricted and does not resolve to %s: unauthorized", s.user2),
+		},
+		"restricted parent owned by owner": {
+			name:     "new.restricted.name",
+			owner:    s.user1Addr,
+			wantAddr: s.user1,
+		},
+		"unrestricted parent": {
+			name:     "New.Example.Name",
+			owner:    s.user2Addr,
+			wantAddr: s.user2,
+		},
+	}
+	for _, n := range []string{
+		"existing name is left alone",
+		"root name cannot be bound",
+		"parent name must exist",
+		"restricted parent must resolve to owner",
+		"restricted parent owned by owner",
+		"unrestricted parent",
+	} {
+		tc := cases[n]
+		s.Run(n, func() {
+			err := s.app.NameKeeper.BindNameIfMissing(s.ctx, tc.name, tc.owner)
+			if len(tc.errorMsg) > 0 {
+				s.EqualError(err, tc.errorMsg)
+				return
+			}
+			s.Require().NoError(err)
+			name, err := s.app.NameKeeper.Normalize(s.ctx, tc.name)
+			s.Require().NoError(err)
+			record, err := s.app.NameKeeper.GetRecordByName(s.ctx, name)
+			s.Require().NoError(err)
+			s.Equal(tc.wantAddr, record.Address)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSetNamePolicy() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NameKeeper)
+	issuer := sdk.AccAddress("issuer______________")
+	bind := func(child string, signer sdk.AccAddress) error {
+		msg := nametypes.NewMsgBindNameRequest(nametypes.NewNameRecord(child, signer, false), nametypes.NewNameRecord("example.name", signer, false))
+		_, err := msgServer.BindName(sdk.WrapSDKContext(s.ctx), msg)
+		return err
+	}
+	setPolicy := func(owner sdk.AccAddress, policy nametypes.NameIssuancePolicy, issuers ...string) error {
+		_, err := msgServer.SetNamePolicy(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgSetNamePolicyRequest("example.name", owner, policy, issuers))
+		return err
+	}
+
+	// the unrestricted name is open to anyone
+	s.Require().NoError(bind("open", s.user2Addr))
+
+	s.Require().EqualError(setPolicy(s.user2Addr, nametypes.NameIssuancePolicy_NAME_ISSUANCE_POLICY_OWNER_ONLY),
+		"msg sender cannot set name policy: unauthorized", "only the owner sets the policy")
+	s.Require().Error(setPolicy(s.user1Addr, nametypes.NameIssuancePolicy_NAME_ISSUANCE_POLICY_OWNER_ONLY, issuer.String()),
+		"issuers are only listed with the allowlist policy")
+
+	s.Require().NoError(setPolicy(s.user1Addr, nametypes.NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST, issuer.String()))
+	record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "example.name")
+	s.Require().NoError(err)
+	s.Require().True(record.Restricted, "the restricted flag follows the policy")
+	s.Require().Equal([]string{issuer.String()}, record.Issuers)
+	records, err := s.app.NameKeeper.GetRecordsByAddress(s.ctx, s.user1Addr)
+	s.Require().NoError(err)
+	s.Require().Contains(records, *record, "the address index is updated")
+
+	s.Require().NoError(bind("issued", issuer), "listed issuers can bind names")
+	s.Require().NoError(bind("owned", s.user1Addr), "the owner can bind names")
+	s.Require().EqualError(bind("denied", s.user2Addr), fmt.Sprintf(
+		"parent name example.name is restricted to its owner and issuers and %s is neither: unauthorized: invalid request", s.user2))
+	s.Require().EqualError(s.app.NameKeeper.BindNameIfMissing(s.ctx, "denied.example.name", s.user2Addr), fmt.Sprintf(
+		"parent name example.name is restricted to its owner and issuers and %s is neither: unauthorized", s.user2))
+
+	s.Require().NoError(setPolicy(s.user1Addr, nametypes.NameIssuancePolicy_NAME_ISSUANCE_POLICY_OWNER_ONLY))
+	s.Require().Error(bind("reissued", issuer), "issuers are dropped with the allowlist")
+
+	s.Require().NoError(setPolicy(s.user1Addr, nametypes.NameIssuancePolicy_NAME_ISSUANCE_POLICY_OPEN))
+	s.Require().NoError(bind("reopened", s.user2Addr))
+	record, err = s.app.NameKeeper.GetRecordByName(s.ctx, "example.name")
+	s.Require().NoError(err)
+	s.Require().False(record.Restricted)
+
+	gen := s.app.NameKeeper.ExportGenesis(s.ctx)
+	s.Require().Contains(gen.Bindings, *record, "the policy is exported")
+}
+
 func (s *KeeperTestSuite) TestGetName() {
 	s.Run("get valid root name", func() {
 		r, err := s.app.NameKeeper.GetRecordByName(s.ctx, "name")
@@ -277,6 +515,56 @@ func (s *KeeperTestSuite) TestIterateRecord() {
 
 }
 
+func (s *KeeperTestSuite) TestGetRecordsInSubtree() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "sub.example.name", s.user2Addr, true))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "notexample.name", s.user2Addr, false))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "example.example.name", s.user2Addr, false))
+
+	records, err := s.app.NameKeeper.GetRecordsInSubtree(s.ctx, "example.name")
+	s.Require().NoError(err)
+	s.Require().Equal(nametypes.NameRecords{
+		nametypes.NewNameRecord("example.name", s.user1Addr, false),
+		nametypes.NewNameRecord("example.example.name", s.user2Addr, false),
+		nametypes.NewNameRecord("sub.example.name", s.user2Addr, true),
+	}, records)
+
+	// the index follows changes to the records
+	s.Require().NoError(s.app.NameKeeper.SetIssuancePolicy(s.ctx, "sub.example.name",
+		nametypes.NameIssuancePolicy_NAME_ISSUANCE_POLICY_OWNER_ONLY, nil))
+	s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "example.example.name"))
+	records, err = s.app.NameKeeper.GetRecordsInSubtree(s.ctx, "example.name")
+	s.Require().NoError(err)
+	s.Require().Len(records, 2)
+	s.Require().Equal("sub.example.name", records[1].Name)
+	s.Require().Equal(nametypes.NameIssuancePolicy_NAME_ISSUANCE_POLICY_OWNER_ONLY, records[1].IssuancePolicy)
+
+	records, err = s.app.NameKeeper.GetRecordsInSubtree(s.ctx, "unknown")
+	s.Require().NoError(err)
+	s.Require().Empty(records)
+
+	s.Run("the subtree query pages through the index", func() {
+		res, err := s.app.NameKeeper.Subtree(sdk.WrapSDKContext(s.ctx), &nametypes.QuerySubtreeRequest{
+			Root:       "Name",
+			Pagination: &query.PageRequest{Limit: 2},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(res.Records, 2)
+		s.Require().Equal("name", res.Records[0].Name)
+		s.Require().Equal("example.name", res.Records[1].Name)
+		s.Require().NotEmpty(res.Pagination.NextKey)
+
+		res, err = s.app.NameKeeper.Subtree(sdk.WrapSDKContext(s.ctx), &nametypes.QuerySubtreeRequest{
+			Root:       "name",
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(res.Records, 2)
+		s.Require().Equal("sub.example.name", res.Records[0].Name)
+		s.Require().Equal("notexample.name", res.Records[1].Name)
+		s.Require().Empty(res.Pagination.NextKey)
+	})
+}
+
 func (s *KeeperTestSuite) TestSecp256r1KeyAlgo() {
 	s.Run("should successfully add name for account with secp256r1 key", func() {
 		err := s.app.NameKeeper.SetNameRecord(s.ctx, "secp256r1.name", s.user2Addr, true)
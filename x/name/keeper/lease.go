@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// GetLease returns the lease of a name, or nil if the name does not expire.
+func (keeper Keeper) GetLease(ctx sdk.Context, name string) (*types.NameLease, error) {
+	key, err := types.GetLeaseKey(name)
+	if err != nil {
+		return nil, err
+	}
+	bz := ctx.KVStore(keeper.storeKey).Get(key)
+	if bz == nil {
+		return nil, nil
+	}
+	lease := &types.NameLease{}
+	if err = keeper.cdc.Unmarshal(bz, lease); err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+// SetLease stores the lease of a name and indexes it by expiration, replacing any existing lease.
+func (keeper Keeper) SetLease(ctx sdk.Context, lease types.NameLease) error {
+	if err := keeper.RemoveLease(ctx, lease.Name); err != nil {
+		return err
+	}
+	key, err := types.GetLeaseKey(lease.Name)
+	if err != nil {
+		return err
+	}
+	expirationKey, err := types.GetLeaseExpirationKey(lease.Expiration, lease.Name)
+	if err != nil {
+		return err
+	}
+	bz, err := keeper.cdc.Marshal(&lease)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(key, bz)
+	store.Set(expirationKey, []byte(lease.Name))
+	return nil
+}
+
+// RemoveLease deletes the lease of a name and its expiration index entry, if one exists.
+func (keeper Keeper) RemoveLease(ctx sdk.Context, name string) error {
+	lease, err := keeper.GetLease(ctx, name)
+	if err != nil || lease == nil {
+		return err
+	}
+	key, err := types.GetLeaseKey(name)
+	if err != nil {
+		return err
+	}
+	expirationKey, err := types.GetLeaseExpirationKey(lease.Expiration, name)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(key)
+	store.Delete(expirationKey)
+	return nil
+}
+
+// GetAllLeases returns all stored name leases.
+func (keeper Keeper) GetAllLeases(ctx sdk.Context) []types.NameLease {
+	leases := []types.NameLease{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), types.LeaseKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		lease := types.NameLease{}
+		keeper.cdc.MustUnmarshal(iterator.Value(), &lease)
+		leases = append(leases, lease)
+	}
+	return leases
+}
+
+// LeaseDuration returns the lease duration to use for a requested number of seconds.  A request of zero uses the
+// default lease duration param; a result of zero means the name does not expire.
+func (keeper Keeper) LeaseDuration(ctx sdk.Context, seconds uint64) (time.Duration, error) {
+	if seconds == 0 {
+		seconds = keeper.GetDefaultLeaseSeconds(ctx)
+	}
+	if max := keeper.GetMaxLeaseSeconds(ctx); max > 0 && seconds > max {
+		return 0, sdkerrors.Wrapf(types.ErrLeaseTooLong, "%d seconds exceeds maximum of %d", seconds, max)
+	}
+	if seconds > types.MaxLeaseSecondsLimit {
+		return 0, sdkerrors.Wrapf(types.ErrLeaseTooLong, "%d seconds exceeds limit of %d", seconds, types.MaxLeaseSecondsLimit)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// LeaseName applies a lease of the requested number of seconds to a bound name starting from the current block time.
+// Names are not leased when the resulting duration is zero.
+func (keeper Keeper) LeaseName(ctx sdk.Context, name string, seconds uint64) error {
+	duration, err := keeper.LeaseDuration(ctx, seconds)
+	if err != nil || duration == 0 {
+		return err
+	}
+	return keeper.SetLease(ctx, types.NameLease{Name: name, Expiration: ctx.BlockTime().Add(duration)})
+}
+
+// RenewLease extends the lease of a name by the requested number of seconds.  Leases that have already expired (but
+// are still within the grace period) are renewed from the current block time.
+func (keeper Keeper) RenewLease(ctx sdk.Context, name string, owner string, seconds uint64) (*types.NameLease, error) {
+	lease, err := keeper.GetLease(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if lease == nil {
+		return nil, sdkerrors.Wrap(types.ErrNameNotLeased, name)
+	}
+	duration, err := keeper.LeaseDuration(ctx, seconds)
+	if err != nil {
+		return nil, err
+	}
+	now := ctx.BlockTime()
+	start := lease.Expiration
+	if start.Before(now) {
+		start = now
+	}
+	expiration := start.Add(duration)
+	if max := keeper.GetMaxLeaseSeconds(ctx); max > 0 && expiration.Sub(now) > time.Duration(max)*time.Second {
+		return nil, sdkerrors.Wrapf(types.ErrLeaseTooLong, "lease of %s would expire after %s", name, now.Add(time.Duration(max)*time.Second))
+	}
+	renewed := types.NameLease{Name: name, Expiration: expiration}
+	if err = keeper.SetLease(ctx, renewed); err != nil {
+		return nil, err
+	}
+	renewedEvent := types.NewEventNameLeaseRenewed(owner, name, expiration.Format(time.RFC3339))
+	if err = ctx.EventManager().EmitTypedEvent(renewedEvent); err != nil {
+		return nil, err
+	}
+	return &renewed, nil
+}
+
+// RemoveExpiredNames unbinds all names whose lease expired before the current block time less the grace period.
+// Unbound names can be bound again by the owner of the parent name.  Expired names that still have child names or
+// attributes cannot be unbound, since that would leave the children and attributes under a name anyone could bind, so
+// they are returned to the owner of the parent name instead.
+func (keeper Keeper) RemoveExpiredNames(ctx sdk.Context) {
+	cutoff := ctx.BlockTime().Add(-time.Duration(keeper.GetLeaseGraceSeconds(ctx)) * time.Second)
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.LeaseExpirationKeyPrefix, types.GetLeaseExpirationPrefix(cutoff))
+	var names []string
+	for ; iterator.Valid(); iterator.Next() {
+		names = append(names, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, name := range names {
+		inUse, err := keeper.IsNameInUse(ctx, name)
+		switch {
+		case err != nil:
+			keeper.Logger(ctx).Error("unable to check expired name", "name", name, "err", err)
+		case inUse:
+			cacheCtx, writeCache := ctx.CacheContext()
+			if err = keeper.returnToParentOwner(cacheCtx, name); err != nil {
+				keeper.Logger(ctx).Error("unable to return expired name to the parent owner", "name", name, "err", err)
+			} else {
+				writeCache()
+			}
+		default:
+			err = keeper.DeleteRecord(ctx, name)
+			if err != nil {
+				keeper.Logger(ctx).Error("unable to remove expired name", "name", name, "err", err)
+			}
+		}
+		// Clear the lease so the sweep does not keep retrying a name that cannot be removed.
+		if err != nil {
+			if err = keeper.RemoveLease(ctx, name); err != nil {
+				keeper.Logger(ctx).Error("unable to remove expired lease", "name", name, "err", err)
+			}
+		}
+	}
+}
+
+// returnToParentOwner rebinds an expired name to the owner of its parent name without a lease.  Root names have no
+// parent so only their lease is cleared.
+func (keeper Keeper) returnToParentOwner(ctx sdk.Context, name string) error {
+	sep := strings.Index(name, ".")
+	if sep < 0 {
+		return keeper.RemoveLease(ctx, name)
+	}
+	parent, err := keeper.GetRecordByName(ctx, name[sep+1:])
+	if err != nil {
+		return err
+	}
+	parentOwner, err := sdk.AccAddressFromBech32(parent.Address)
+	if err != nil {
+		return err
+	}
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	// Deleting the record also removes its lease.
+	if err = keeper.DeleteRecord(ctx, name); err != nil {
+		return err
+	}
+	return keeper.SetNameRecord(ctx, name, parentOwner, record.Restricted)
+}
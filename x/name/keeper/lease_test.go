@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/name/keeper"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func (s *KeeperTestSuite) setLeaseParams(defaultSeconds, maxSeconds, graceSeconds uint64) {
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	params.DefaultLeaseSeconds = defaultSeconds
+	params.MaxLeaseSeconds = maxSeconds
+	params.LeaseGraceSeconds = graceSeconds
+	s.app.NameKeeper.SetParams(s.ctx, params)
+}
+
+func (s *KeeperTestSuite) TestLeaseName() {
+	start := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(start)
+	s.setLeaseParams(0, 0, 0)
+
+	s.Run("zero duration without default does not lease", func() {
+		s.Require().NoError(s.app.NameKeeper.LeaseName(s.ctx, "example.name", 0))
+		lease, err := s.app.NameKeeper.GetLease(s.ctx, "example.name")
+		s.Require().NoError(err)
+		s.Require().Nil(lease)
+	})
+	s.Run("default duration is used when none requested", func() {
+		s.setLeaseParams(60, 0, 0)
+		s.Require().NoError(s.app.NameKeeper.LeaseName(s.ctx, "example.name", 0))
+		lease, err := s.app.NameKeeper.GetLease(s.ctx, "example.name")
+		s.Require().NoError(err)
+		s.Require().Equal(nametypes.NameLease{Name: "example.name", Expiration: start.Add(time.Minute)}, *lease)
+	})
+	s.Run("duration over the maximum is rejected", func() {
+		s.setLeaseParams(60, 120, 0)
+		err := s.app.NameKeeper.LeaseName(s.ctx, "example.name", 121)
+		s.Require().ErrorIs(err, nametypes.ErrLeaseTooLong)
+	})
+	s.Run("leases are exported", func() {
+		leases := s.app.NameKeeper.GetAllLeases(s.ctx)
+		s.Require().Equal([]nametypes.NameLease{{Name: "example.name", Expiration: start.Add(time.Minute)}}, leases)
+	})
+	s.Run("deleting the name removes the lease", func() {
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "example.name"))
+		lease, err := s.app.NameKeeper.GetLease(s.ctx, "example.name")
+		s.Require().NoError(err)
+		s.Require().Nil(lease)
+		s.Require().Empty(s.app.NameKeeper.GetAllLeases(s.ctx))
+	})
+}
+
+func (s *KeeperTestSuite) TestRenewLease() {
+	start := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(start)
+	s.setLeaseParams(0, 300, 0)
+
+	s.Run("renewing a name without a lease fails", func() {
+		_, err := s.app.NameKeeper.RenewLease(s.ctx, "example.name", s.user1, 60)
+		s.Require().ErrorIs(err, nametypes.ErrNameNotLeased)
+	})
+	s.Require().NoError(s.app.NameKeeper.LeaseName(s.ctx, "example.name", 60))
+	s.Run("renewal extends from the current expiration", func() {
+		lease, err := s.app.NameKeeper.RenewLease(s.ctx, "example.name", s.user1, 60)
+		s.Require().NoError(err)
+		s.Require().Equal(start.Add(2*time.Minute), lease.Expiration)
+		em := s.ctx.EventManager().ABCIEvents()
+		event, err := sdk.ParseTypedEvent(em[len(em)-1])
+		s.Require().NoError(err)
+		s.Require().Equal(nametypes.NewEventNameLeaseRenewed(s.user1, "example.name", start.Add(2*time.Minute).Format(time.RFC3339)), event)
+	})
+	s.Run("renewal beyond the maximum is rejected", func() {
+		_, err := s.app.NameKeeper.RenewLease(s.ctx, "example.name", s.user1, 240)
+		s.Require().ErrorIs(err, nametypes.ErrLeaseTooLong)
+	})
+	s.Run("renewal of an expired lease extends from the block time", func() {
+		later := start.Add(time.Hour)
+		lease, err := s.app.NameKeeper.RenewLease(s.ctx.WithBlockTime(later), "example.name", s.user1, 60)
+		s.Require().NoError(err)
+		s.Require().Equal(later.Add(time.Minute), lease.Expiration)
+		s.Require().Len(s.app.NameKeeper.GetAllLeases(s.ctx), 1)
+	})
+}
+
+func (s *KeeperTestSuite) TestRemoveExpiredNames() {
+	start := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(start)
+	s.setLeaseParams(0, 0, 30)
+	s.Require().NoError(s.app.NameKeeper.LeaseName(s.ctx, "example.name", 60))
+
+	s.Run("names are kept until the lease expires", func() {
+		s.app.NameKeeper.RemoveExpiredNames(s.ctx.WithBlockTime(start.Add(time.Minute)))
+		s.Require().True(s.app.NameKeeper.NameExists(s.ctx, "example.name"))
+	})
+	s.Run("names are kept during the grace period", func() {
+		s.app.NameKeeper.RemoveExpiredNames(s.ctx.WithBlockTime(start.Add(90 * time.Second)))
+		s.Require().True(s.app.NameKeeper.NameExists(s.ctx, "example.name"))
+	})
+	s.Run("names are removed after the grace period", func() {
+		s.app.NameKeeper.RemoveExpiredNames(s.ctx.WithBlockTime(start.Add(91 * time.Second)))
+		s.Require().False(s.app.NameKeeper.NameExists(s.ctx, "example.name"))
+		s.Require().True(s.app.NameKeeper.NameExists(s.ctx, "name"))
+		s.Require().Empty(s.app.NameKeeper.GetAllLeases(s.ctx))
+	})
+	s.Run("expired names can be bound again by the parent owner", func() {
+		msgServer := keeper.NewMsgServerImpl(s.app.NameKeeper)
+		_, err := msgServer.BindName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgBindNameRequest(
+			nametypes.NewNameRecord("example", s.user2Addr, false),
+			nametypes.NewNameRecord("name", s.user1Addr, false),
+		))
+		s.Require().NoError(err)
+		s.Require().True(s.app.NameKeeper.ResolvesTo(s.ctx, "example.name", s.user2Addr))
+	})
+}
+
+func (s *KeeperTestSuite) TestRemoveExpiredNamesInUse() {
+	start := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(start)
+	s.setLeaseParams(0, 0, 0)
+	// names squatted by user2 under the name root owned by user1
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "parent.name", s.user2Addr, false))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "child.parent.name", s.user2Addr, false))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "attr.name", s.user2Addr, true))
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx,
+		attributetypes.NewAttribute("attr.name", s.user2Addr, attributetypes.AttributeType_String, []byte("value")), s.user2Addr))
+	for _, name := range []string{"parent.name", "attr.name", "example.name"} {
+		s.Require().NoError(s.app.NameKeeper.LeaseName(s.ctx, name, 60))
+	}
+	s.app.NameKeeper.RemoveExpiredNames(s.ctx.WithBlockTime(start.Add(2 * time.Minute)))
+
+	s.Run("names with child names are returned to the parent owner", func() {
+		s.Require().True(s.app.NameKeeper.ResolvesTo(s.ctx, "parent.name", s.user1Addr))
+		s.Require().True(s.app.NameKeeper.ResolvesTo(s.ctx, "child.parent.name", s.user2Addr))
+		records, err := s.app.NameKeeper.GetRecordsInSubtree(s.ctx, "parent.name")
+		s.Require().NoError(err)
+		s.Require().Equal(nametypes.NameRecords{
+			nametypes.NewNameRecord("parent.name", s.user1Addr, false),
+			nametypes.NewNameRecord("child.parent.name", s.user2Addr, false),
+		}, records)
+		records, err = s.app.NameKeeper.GetRecordsByAddress(s.ctx, s.user2Addr)
+		s.Require().NoError(err)
+		s.Require().Len(records, 1, "only the child name is left to the squatter")
+	})
+	s.Run("names with attributes are returned to the parent owner", func() {
+		s.Require().True(s.app.NameKeeper.ResolvesTo(s.ctx, "attr.name", s.user1Addr))
+		record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "attr.name")
+		s.Require().NoError(err)
+		s.Require().True(record.Restricted)
+		attrs, err := s.app.AttributeKeeper.GetAttributes(s.ctx, s.user2Addr, "attr.name")
+		s.Require().NoError(err)
+		s.Require().Len(attrs, 1)
+	})
+	s.Run("names not in use are removed", func() {
+		s.Require().False(s.app.NameKeeper.NameExists(s.ctx, "example.name"))
+	})
+	s.Run("returned names are not leased", func() {
+		s.Require().Empty(s.app.NameKeeper.GetAllLeases(s.ctx))
+	})
+}
+
+func (s *KeeperTestSuite) TestIsNameInUse() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "leaf.example.name", s.user2Addr, false))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "sibling.name", s.user2Addr, false))
+	for name, expected := range map[string]bool{
+		"name":              true,
+		"example.name":      true,
+		"leaf.example.name": false,
+		"sibling.name":      false,
+		"missing.name":      false,
+	} {
+		inUse, err := s.app.NameKeeper.IsNameInUse(s.ctx, name)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, inUse, name)
+	}
+}
+
+func (s *KeeperTestSuite) TestRenewNameMsg() {
+	s.ctx = s.ctx.WithBlockTime(time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC))
+	s.setLeaseParams(60, 0, 0)
+	msgServer := keeper.NewMsgServerImpl(s.app.NameKeeper)
+	_, err := msgServer.BindName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgBindNameRequest(
+		nametypes.NewNameRecord("leased", s.user2Addr, false),
+		nametypes.NewNameRecord("name", s.user1Addr, false),
+	))
+	s.Require().NoError(err)
+
+	s.Run("only the owner can renew", func() {
+		_, err := msgServer.RenewName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgRenewNameRequest("leased.name", s.user1Addr, 60))
+		s.Require().EqualError(err, "msg sender cannot renew name: unauthorized")
+	})
+	s.Run("owner renewal is queryable", func() {
+		_, err := msgServer.RenewName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgRenewNameRequest("leased.name", s.user2Addr, 60))
+		s.Require().NoError(err)
+		res, err := s.app.NameKeeper.Lease(sdk.WrapSDKContext(s.ctx), &nametypes.QueryLeaseRequest{Name: "leased.name"})
+		s.Require().NoError(err)
+		s.Require().Equal(s.ctx.BlockTime().Add(2*time.Minute), res.Lease.Expiration)
+	})
+	s.Run("names without a lease are reported", func() {
+		_, err := s.app.NameKeeper.Lease(sdk.WrapSDKContext(s.ctx), &nametypes.QueryLeaseRequest{Name: "name"})
+		s.Require().ErrorIs(err, nametypes.ErrNameNotLeased)
+	})
+}
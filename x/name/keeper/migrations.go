@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v042 "github.com/provenance-io/provenance/x/name/legacy/v042"
+	"github.com/provenance-io/provenance/x/name/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -23,3 +24,122 @@ func (m *Migrator) Migrate1to2(ctx sdk.Context) error {
 	ctx.Logger().Info("Finished Migrating Name Module from Version 1 to 2")
 	return err
 }
+
+// Migrate2to3 migrates from version 2 to 3.  Names that are not in NFKC normalized form are moved to their normalized
+// name so they still resolve once unicode names are allowed.  ASCII names are already normalized and left untouched,
+// as are names used by attributes, which are stored under the name as it was bound.  All names are then indexed by
+// their place in the name hierarchy.
+func (m *Migrator) Migrate2to3(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Name Module from Version 2 to 3 (1/2)")
+	var records []types.NameRecord
+	err := m.keeper.IterateRecords(ctx, types.NameKeyPrefix, func(record types.NameRecord) error {
+		if normalizeUnicode(record.Name) != record.Name {
+			records = append(records, record)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	renamed := 0
+	for _, record := range records {
+		name := normalizeUnicode(record.Name)
+		if m.keeper.NameExists(ctx, name) {
+			ctx.Logger().Error("normalized name is already bound, leaving name as is", "name", record.Name, "normalized", name)
+			continue
+		}
+		hasAttributes, attrErr := m.keeper.HasAttributes(ctx, record.Name)
+		if attrErr != nil {
+			return attrErr
+		}
+		if hasAttributes {
+			ctx.Logger().Error("name is used by attributes, leaving name as is", "name", record.Name, "normalized", name)
+			continue
+		}
+		if err = m.keeper.renameRecord(ctx, record, name); err != nil {
+			return err
+		}
+		renamed++
+	}
+	ctx.Logger().Info("Migrating Name Module from Version 2 to 3 (2/2)")
+	records = nil
+	err = m.keeper.IterateRecords(ctx, types.NameKeyPrefix, func(record types.NameRecord) error {
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(m.keeper.storeKey)
+	for i := range records {
+		subtreeKey, keyErr := types.GetSubtreeKey(records[i].Name)
+		if keyErr != nil {
+			return keyErr
+		}
+		bz, marshalErr := m.keeper.cdc.Marshal(&records[i])
+		if marshalErr != nil {
+			return marshalErr
+		}
+		store.Set(subtreeKey, bz)
+	}
+	ctx.Logger().Info("Finished Migrating Name Module from Version 2 to 3", "renamed", renamed, "indexed", len(records))
+	return nil
+}
+
+// renameRecord moves a name record, along with its lease and domain verification, to a new name.
+func (keeper Keeper) renameRecord(ctx sdk.Context, record types.NameRecord, name string) error {
+	lease, err := keeper.GetLease(ctx, record.Name)
+	if err != nil {
+		return err
+	}
+	verification, err := keeper.GetDomainVerification(ctx, record.Name)
+	if err != nil {
+		return err
+	}
+	if err = keeper.DeleteRecord(ctx, record.Name); err != nil {
+		return err
+	}
+	address, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return err
+	}
+	key, err := types.GetNameKeyPrefix(name)
+	if err != nil {
+		return err
+	}
+	addrPrefix, err := types.GetAddressKeyPrefix(address)
+	if err != nil {
+		return err
+	}
+	record.Name = name
+	bz, err := keeper.cdc.Marshal(&record)
+	if err != nil {
+		return err
+	}
+	subtreeKey, err := types.GetSubtreeKey(name)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(key, bz)
+	store.Set(append(addrPrefix, key...), bz)
+	store.Set(subtreeKey, bz)
+	if lease != nil {
+		lease.Name = name
+		if err = keeper.SetLease(ctx, *lease); err != nil {
+			return err
+		}
+	}
+	if verification != nil {
+		verificationKey, keyErr := types.GetDomainVerificationKey(verification.Name)
+		if keyErr != nil {
+			return keyErr
+		}
+		store.Delete(verificationKey)
+		verification.Name = name
+		if err = keeper.SetDomainVerification(ctx, *verification); err != nil {
+			return err
+		}
+	}
+	return nil
+}
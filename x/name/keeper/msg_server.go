@@ -38,18 +38,15 @@ func (s msgServer) BindName(goCtx context.Context, msg *types.MsgBindNameRequest
 		ctx.Logger().Error("unable to find parent name record", "err", err)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
-	// Ensure that if the parent name is restricted, it resolves to the given parent address (message signer).
-	if record.Restricted {
-		parentAddress, addrErr := sdk.AccAddressFromBech32(msg.Parent.Address)
-		if addrErr != nil {
-			ctx.Logger().Error("unable to parse parent address", "err", addrErr)
-			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, addrErr.Error())
-		}
-		if !s.Keeper.ResolvesTo(ctx, msg.Parent.Name, parentAddress) {
-			errm := "parent name is restricted and does not resolve to the provided parent address"
-			ctx.Logger().Error(errm)
-			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, errm)
-		}
+	// Ensure the issuance policy of the parent name allows the given parent address (message signer).
+	parentAddress, err := sdk.AccAddressFromBech32(msg.Parent.Address)
+	if err != nil {
+		ctx.Logger().Error("unable to parse parent address", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err = s.Keeper.CheckIssuer(*record, parentAddress); err != nil {
+		ctx.Logger().Error("parent name does not allow the signer to bind names", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 	// Combine names, normalize, and check for existing record
 	n := fmt.Sprintf("%s.%s", msg.Record.Name, msg.Parent.Name)
@@ -62,6 +59,18 @@ func (s msgServer) BindName(goCtx context.Context, msg *types.MsgBindNameRequest
 		ctx.Logger().Error("name already bound", "name", name)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, types.ErrNameAlreadyBound.Error())
 	}
+	// Ensure external domains have been verified for the address
+	if err := s.Keeper.CheckDomainVerification(ctx, name, msg.Record.Address); err != nil {
+		ctx.Logger().Error("domain not verified", "name", name, "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Hold a deposit from the signer for names bound directly under open root names
+	if s.Keeper.RequiresDeposit(ctx, *record, parentAddress.String()) {
+		if err := s.Keeper.PayDeposit(ctx, name, parentAddress); err != nil {
+			ctx.Logger().Error("unable to pay name deposit", "err", err)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
 	// Bind name to address
 	address, err := sdk.AccAddressFromBech32(msg.Record.Address)
 	if err != nil {
@@ -72,6 +81,18 @@ func (s msgServer) BindName(goCtx context.Context, msg *types.MsgBindNameRequest
 		ctx.Logger().Error("unable to bind name", "err", err)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	// Apply the requested issuance policy to the new name
+	if msg.Record.IssuancePolicy != types.NameIssuancePolicy_NAME_ISSUANCE_POLICY_UNSPECIFIED {
+		if err := s.Keeper.SetIssuancePolicy(ctx, name, msg.Record.IssuancePolicy, msg.Record.Issuers); err != nil {
+			ctx.Logger().Error("unable to set name policy", "err", err)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	// Apply the requested (or default) lease to the new name
+	if err := s.Keeper.LeaseName(ctx, name, msg.LeaseSeconds); err != nil {
+		ctx.Logger().Error("unable to lease name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 
 	// key: modulename+name+bind
 	defer func() {
@@ -123,6 +144,16 @@ func (s msgServer) DeleteName(goCtx context.Context, msg *types.MsgDeleteNameReq
 		ctx.Logger().Error("msg sender cannot delete name", "name", name)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot delete name")
 	}
+	// Ensure no attributes use the name
+	hasAttributes, err := s.Keeper.HasAttributes(ctx, name)
+	if err != nil {
+		ctx.Logger().Error("error checking name attributes", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if hasAttributes {
+		ctx.Logger().Error("name is used by attributes", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, types.ErrNameHasAttributes.Error())
+	}
 	// Delete
 	if err := s.Keeper.DeleteRecord(ctx, name); err != nil {
 		ctx.Logger().Error("error deleting name", "err", err)
@@ -148,3 +179,168 @@ func (s msgServer) DeleteName(goCtx context.Context, msg *types.MsgDeleteNameReq
 
 	return &types.MsgDeleteNameResponse{}, nil
 }
+
+// RenewName extends the lease of a name owned by the msg sender
+func (s msgServer) RenewName(goCtx context.Context, msg *types.MsgRenewNameRequest) (*types.MsgRenewNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Normalize
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Parse address
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		ctx.Logger().Error("invalid address", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Ensure permission
+	if !s.Keeper.ResolvesTo(ctx, name, owner) {
+		ctx.Logger().Error("msg sender cannot renew name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot renew name")
+	}
+	// Renew
+	if _, err := s.Keeper.RenewLease(ctx, name, msg.Owner, msg.LeaseSeconds); err != nil {
+		ctx.Logger().Error("error renewing name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// key: modulename+name+renew
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "renew"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("name", name), telemetry.NewLabel("address", msg.Owner)},
+		)
+	}()
+
+	return &types.MsgRenewNameResponse{}, nil
+}
+
+// RequestDomainVerification starts the verification of an external domain for the msg sender
+func (s msgServer) RequestDomainVerification(
+	goCtx context.Context,
+	msg *types.MsgRequestDomainVerificationRequest,
+) (*types.MsgRequestDomainVerificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Normalize
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Request
+	verification, err := s.Keeper.RequestDomainVerification(ctx, name, msg.Owner)
+	if err != nil {
+		ctx.Logger().Error("error requesting domain verification", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgRequestDomainVerificationResponse{Challenge: verification.Challenge}, nil
+}
+
+// AttestDomainVerification records the domain verifier's result for a pending domain verification
+func (s msgServer) AttestDomainVerification(
+	goCtx context.Context,
+	msg *types.MsgAttestDomainVerificationRequest,
+) (*types.MsgAttestDomainVerificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Normalize
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Attest
+	if _, err := s.Keeper.AttestDomainVerification(ctx, name, msg.Verifier, msg.Challenge, msg.Verified); err != nil {
+		ctx.Logger().Error("error attesting domain verification", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgAttestDomainVerificationResponse{}, nil
+}
+
+// ReclaimNameDeposit returns the binding deposit of a name in use to the msg sender that paid it
+func (s msgServer) ReclaimNameDeposit(
+	goCtx context.Context,
+	msg *types.MsgReclaimNameDepositRequest,
+) (*types.MsgReclaimNameDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Normalize
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Reclaim
+	if _, err := s.Keeper.ReclaimDeposit(ctx, name, msg.Depositor); err != nil {
+		ctx.Logger().Error("error reclaiming name deposit", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgReclaimNameDepositResponse{}, nil
+}
+
+// SetNamePolicy sets the issuance policy of a name owned by the msg sender
+func (s msgServer) SetNamePolicy(goCtx context.Context, msg *types.MsgSetNamePolicyRequest) (*types.MsgSetNamePolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Normalize
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Parse address
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		ctx.Logger().Error("invalid address", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Ensure permission
+	if !s.Keeper.ResolvesTo(ctx, name, owner) {
+		ctx.Logger().Error("msg sender cannot set name policy", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot set name policy")
+	}
+	// Set
+	if err := s.Keeper.SetIssuancePolicy(ctx, name, msg.IssuancePolicy, msg.Issuers); err != nil {
+		ctx.Logger().Error("error setting name policy", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// key: modulename+name+policy
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "policy"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("name", name), telemetry.NewLabel("policy", msg.IssuancePolicy.String())},
+		)
+	}()
+
+	return &types.MsgSetNamePolicyResponse{}, nil
+}
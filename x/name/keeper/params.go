@@ -13,6 +13,14 @@ func (keeper Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 		MinSegmentLength:       keeper.GetMinSegmentLength(ctx),
 		MaxNameLevels:          keeper.GetMaxNameLevels(ctx),
 		AllowUnrestrictedNames: keeper.GetAllowUnrestrictedNames(ctx),
+		DefaultLeaseSeconds:    keeper.GetDefaultLeaseSeconds(ctx),
+		MaxLeaseSeconds:        keeper.GetMaxLeaseSeconds(ctx),
+		LeaseGraceSeconds:      keeper.GetLeaseGraceSeconds(ctx),
+		DomainNameRegex:        keeper.GetDomainNameRegex(ctx),
+		DomainVerifier:         keeper.GetDomainVerifier(ctx),
+		AllowUnicodeNames:      keeper.GetAllowUnicodeNames(ctx),
+		BindingDeposit:         keeper.GetBindingDeposit(ctx),
+		DepositHoldingSeconds:  keeper.GetDepositHoldingSeconds(ctx),
 	}
 }
 
@@ -56,3 +64,78 @@ func (keeper Keeper) GetAllowUnrestrictedNames(ctx sdk.Context) (enabled bool) {
 	}
 	return
 }
+
+// GetDefaultLeaseSeconds returns the lease duration applied to names bound without one (or default if unset)
+func (keeper Keeper) GetDefaultLeaseSeconds(ctx sdk.Context) (seconds uint64) {
+	seconds = types.DefaultDefaultLeaseSeconds
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyDefaultLeaseSeconds) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyDefaultLeaseSeconds, &seconds)
+	}
+	return
+}
+
+// GetMaxLeaseSeconds returns the maximum lease duration allowed for a name (or default if unset)
+func (keeper Keeper) GetMaxLeaseSeconds(ctx sdk.Context) (seconds uint64) {
+	seconds = types.DefaultMaxLeaseSeconds
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyMaxLeaseSeconds) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyMaxLeaseSeconds, &seconds)
+	}
+	return
+}
+
+// GetLeaseGraceSeconds returns the time after lease expiration before a name is unbound (or default if unset)
+func (keeper Keeper) GetLeaseGraceSeconds(ctx sdk.Context) (seconds uint64) {
+	seconds = types.DefaultLeaseGraceSeconds
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyLeaseGraceSeconds) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyLeaseGraceSeconds, &seconds)
+	}
+	return
+}
+
+// GetDomainNameRegex returns the regex of names that must be verified before being bound (or default if unset)
+func (keeper Keeper) GetDomainNameRegex(ctx sdk.Context) (regex string) {
+	regex = types.DefaultDomainNameRegex
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyDomainNameRegex) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyDomainNameRegex, &regex)
+	}
+	return
+}
+
+// GetDomainVerifier returns the address of the verifier that attests to domain verifications (or default if unset)
+func (keeper Keeper) GetDomainVerifier(ctx sdk.Context) (verifier string) {
+	verifier = types.DefaultDomainVerifier
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyDomainVerifier) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyDomainVerifier, &verifier)
+	}
+	return
+}
+
+// GetAllowUnicodeNames returns the current unicode names allowed parameter (or default if unset)
+func (keeper Keeper) GetAllowUnicodeNames(ctx sdk.Context) (enabled bool) {
+	enabled = types.DefaultAllowUnicodeNames
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyAllowUnicodeNames) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyAllowUnicodeNames, &enabled)
+	}
+	return
+}
+
+// GetBindingDeposit returns the deposit required to bind a name under an unrestricted root name (or default if unset)
+func (keeper Keeper) GetBindingDeposit(ctx sdk.Context) (deposit sdk.Coin) {
+	deposit = types.DefaultBindingDeposit()
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyBindingDeposit) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyBindingDeposit, &deposit)
+	}
+	if deposit.Amount.IsNil() {
+		deposit.Amount = sdk.ZeroInt()
+	}
+	return
+}
+
+// GetDepositHoldingSeconds returns the time a binding deposit is held before release (or default if unset)
+func (keeper Keeper) GetDepositHoldingSeconds(ctx sdk.Context) (seconds uint64) {
+	seconds = types.DefaultDepositHoldingSeconds
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyDepositHoldingSeconds) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyDepositHoldingSeconds, &seconds)
+	}
+	return
+}
@@ -35,6 +35,11 @@ func HandleCreateRootNameProposal(ctx sdk.Context, k Keeper, p *types.CreateRoot
 			if err = k.SetNameRecord(ctx, name, addr, p.Restricted); err != nil {
 				return err
 			}
+			if p.IssuancePolicy != types.NameIssuancePolicy_NAME_ISSUANCE_POLICY_UNSPECIFIED {
+				if err = k.SetIssuancePolicy(ctx, name, p.IssuancePolicy, p.Issuers); err != nil {
+					return err
+				}
+			}
 			logger.Info(fmt.Sprintf("create root name proposal: created %s and set the owner as %s", name, p.Owner))
 		} else {
 			logger.Info(fmt.Sprintf("create root name proposal: intermediate domain %s exists, skipping", name))
@@ -43,3 +48,21 @@ func HandleCreateRootNameProposal(ctx sdk.Context, k Keeper, p *types.CreateRoot
 
 	return nil
 }
+
+// HandleClaimNameDepositProposal is a handler for executing a passed claim name deposit proposal
+func HandleClaimNameDepositProposal(ctx sdk.Context, k Keeper, p *types.ClaimNameDepositProposal) error {
+	recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		return err
+	}
+	name, err := k.Normalize(ctx, p.Name)
+	if err != nil {
+		return err
+	}
+	deposit, err := k.ClaimDeposit(ctx, name, recipient)
+	if err != nil {
+		return err
+	}
+	k.Logger(ctx).Info(fmt.Sprintf("claim name deposit proposal: sent %s deposit for %s to %s", deposit.Amount, name, p.Recipient))
+	return nil
+}
@@ -87,5 +87,5 @@ func queryLookupNames(ctx sdk.Context, path []string, _ abci.RequestQuery, keepe
 }
 
 func queryResFromNameRecord(r types.NameRecord) types.QueryNameResult {
-	return types.QueryNameResult(r)
+	return types.NewQueryNameResult(r)
 }
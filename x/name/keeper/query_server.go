@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/provenance-io/provenance/x/name/types"
@@ -73,3 +74,108 @@ func (keeper Keeper) ReverseLookup(c context.Context, request *types.QueryRevers
 
 	return &types.QueryReverseLookupResponse{Name: names, Pagination: pageRes}, nil
 }
+
+// Subtree gets all name records at or below a root name, each name followed by the names below it.
+func (keeper Keeper) Subtree(c context.Context, request *types.QuerySubtreeRequest) (*types.QuerySubtreeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	root, err := keeper.Normalize(ctx, request.Root)
+	if err != nil {
+		return nil, err
+	}
+	key, err := types.GetSubtreeKey(root)
+	if err != nil {
+		return nil, err
+	}
+	records := types.NameRecords{}
+	subtreeStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), key)
+	pageRes, err := query.Paginate(subtreeStore, request.Pagination, func(key []byte, value []byte) error {
+		var record types.NameRecord
+		if err := keeper.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySubtreeResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// Lease gets the lease of a name.
+func (keeper Keeper) Lease(c context.Context, request *types.QueryLeaseRequest) (*types.QueryLeaseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	name, err := keeper.Normalize(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !keeper.NameExists(ctx, name) {
+		return nil, types.ErrNameNotBound
+	}
+	lease, err := keeper.GetLease(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if lease == nil {
+		return nil, types.ErrNameNotLeased
+	}
+	return &types.QueryLeaseResponse{Lease: lease}, nil
+}
+
+// DomainVerification gets the verification of an external domain.
+func (keeper Keeper) DomainVerification(c context.Context, request *types.QueryDomainVerificationRequest) (*types.QueryDomainVerificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	name, err := keeper.Normalize(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	verification, err := keeper.GetDomainVerification(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if verification == nil {
+		return nil, sdkerrors.Wrapf(types.ErrDomainVerification, "no verification requested for %s", name)
+	}
+	return &types.QueryDomainVerificationResponse{Verification: verification}, nil
+}
+
+// DomainVerifications gets all domain verifications, optionally filtered by status.
+func (keeper Keeper) DomainVerifications(c context.Context, request *types.QueryDomainVerificationsRequest) (*types.QueryDomainVerificationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	verifications := []types.DomainVerification{}
+	verificationStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.DomainVerificationKeyPrefix)
+	pageRes, err := query.FilteredPaginate(verificationStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var verification types.DomainVerification
+		if err := keeper.cdc.Unmarshal(value, &verification); err != nil {
+			return false, err
+		}
+		if request.Status != types.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_UNSPECIFIED && verification.Status != request.Status {
+			return false, nil
+		}
+		if accumulate {
+			verifications = append(verifications, verification)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDomainVerificationsResponse{Verifications: verifications, Pagination: pageRes}, nil
+}
+
+// Deposit gets the binding deposit held for a name.
+func (keeper Keeper) Deposit(c context.Context, request *types.QueryDepositRequest) (*types.QueryDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	name, err := keeper.Normalize(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	deposit, err := keeper.GetDeposit(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if deposit == nil {
+		return nil, sdkerrors.Wrapf(types.ErrNameDeposit, "no deposit is held for %s", name)
+	}
+	return &types.QueryDepositResponse{Deposit: deposit}, nil
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// unicodeScripts are the scripts whose letters can be used in name segments when unicode names are allowed.
+var unicodeScripts = []*unicode.RangeTable{
+	unicode.Latin, unicode.Greek, unicode.Cyrillic, unicode.Armenian, unicode.Georgian, unicode.Hebrew, unicode.Arabic,
+	unicode.Devanagari, unicode.Bengali, unicode.Tamil, unicode.Thai, unicode.Hangul, unicode.Hiragana,
+	unicode.Katakana, unicode.Han,
+}
+
+// unicodeScriptSets are the combinations of scripts that are commonly written together and can share a name segment.
+var unicodeScriptSets = [][]*unicode.RangeTable{
+	{unicode.Han, unicode.Hiragana, unicode.Katakana},
+	{unicode.Han, unicode.Hangul},
+}
+
+// latinLookalikes are the Cyrillic and Greek letters that look like Latin letters.  A Cyrillic or Greek segment written
+// only with them could be mistaken for a Latin name.
+const latinLookalikes = "аеіјкорсухѕһԁԛԝӏүαεικνορυχ"
+
+// prolongedSoundMark is the Japanese vowel lengthening mark, which is shared by Hiragana and Katakana.
+const prolongedSoundMark = 'ー'
+
+// normalizeUnicode returns the NFKC normalized lower case form of a name segment.
+func normalizeUnicode(s string) string {
+	return norm.NFKC.String(strings.ToLower(norm.NFKC.String(s)))
+}
+
+// Check whether a normalized unicode name segment is valid.  Segments can have letters of a single script (or of a
+// script set), combining marks following a letter, ASCII digits, and a single dash.
+func isValidUnicode(s string) bool {
+	if isValidUUID(s) {
+		return true
+	}
+	if strings.Count(s, "-") > 1 || !norm.NFKC.IsNormalString(s) {
+		return false
+	}
+	var scripts []*unicode.RangeTable
+	onlyLookalikes := true
+	var prev rune
+	for _, c := range s {
+		switch {
+		case c == '-' || ('0' <= c && c <= '9'):
+		case unicode.In(c, unicode.Mn, unicode.Mc):
+			if !unicode.IsLetter(prev) && !unicode.In(prev, unicode.Mn, unicode.Mc) {
+				return false
+			}
+		case unicode.IsLetter(c) && !unicode.IsUpper(c) && !unicode.IsTitle(c):
+			script := letterScript(c)
+			if c == prolongedSoundMark {
+				script = unicode.Katakana
+			}
+			if script == nil {
+				return false
+			}
+			if !containsScript(scripts, script) {
+				scripts = append(scripts, script)
+			}
+			if !strings.ContainsRune(latinLookalikes, c) {
+				onlyLookalikes = false
+			}
+		default:
+			return false
+		}
+		prev = c
+	}
+	if len(scripts) == 1 && (scripts[0] == unicode.Cyrillic || scripts[0] == unicode.Greek) && onlyLookalikes {
+		return false
+	}
+	return len(scripts) <= 1 || isScriptSet(scripts)
+}
+
+// letterScript returns the allowed script of a letter, or nil if it is not in one.
+func letterScript(c rune) *unicode.RangeTable {
+	for _, script := range unicodeScripts {
+		if unicode.Is(script, c) {
+			return script
+		}
+	}
+	return nil
+}
+
+// isScriptSet returns true if all of the scripts are in one of the script sets.
+func isScriptSet(scripts []*unicode.RangeTable) bool {
+	for _, set := range unicodeScriptSets {
+		inSet := true
+		for _, script := range scripts {
+			if !containsScript(set, script) {
+				inSet = false
+				break
+			}
+		}
+		if inSet {
+			return true
+		}
+	}
+	return false
+}
+
+func containsScript(scripts []*unicode.RangeTable, script *unicode.RangeTable) bool {
+	for _, s := range scripts {
+		if s == script {
+			return true
+		}
+	}
+	return false
+}
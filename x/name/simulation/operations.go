@@ -122,6 +122,10 @@ func SimulateMsgDeleteName(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk ban
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteNameRequest, "name record does not belong to user"), nil, nil
 		}
 
+		if hasAttributes, err := k.HasAttributes(ctx, randomRecord.Name); err != nil || hasAttributes {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteNameRequest, "name record is used by attributes"), nil, err
+		}
+
 		msg := types.NewMsgDeleteNameRequest(randomRecord)
 
 		return Dispatch(r, app, ctx, ak, bk, simAccount, chainID, msg)
@@ -14,7 +14,13 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(MsgBindNameRequest{}, "provenance/MsgBindNameRequest", nil)
 	cdc.RegisterConcrete(MsgDeleteNameRequest{}, "provenance/MsgDeleteNameRequest", nil)
+	cdc.RegisterConcrete(MsgRenewNameRequest{}, "provenance/MsgRenewNameRequest", nil)
+	cdc.RegisterConcrete(MsgRequestDomainVerificationRequest{}, "provenance/MsgRequestDomainVerificationRequest", nil)
+	cdc.RegisterConcrete(MsgAttestDomainVerificationRequest{}, "provenance/MsgAttestDomainVerificationRequest", nil)
+	cdc.RegisterConcrete(MsgReclaimNameDepositRequest{}, "provenance/MsgReclaimNameDepositRequest", nil)
+	cdc.RegisterConcrete(MsgSetNamePolicyRequest{}, "provenance/MsgSetNamePolicyRequest", nil)
 	cdc.RegisterConcrete(CreateRootNameProposal{}, "provenance/CreateRootNameProposal", nil)
+	cdc.RegisterConcrete(ClaimNameDepositProposal{}, "provenance/ClaimNameDepositProposal", nil)
 }
 
 // RegisterInterfaces registers concrete implentations for the given type names
@@ -23,11 +29,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgBindNameRequest{},
 		&MsgDeleteNameRequest{},
+		&MsgRenewNameRequest{},
+		&MsgRequestDomainVerificationRequest{},
+		&MsgAttestDomainVerificationRequest{},
+		&MsgReclaimNameDepositRequest{},
+		&MsgSetNamePolicyRequest{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&CreateRootNameProposal{},
+		&ClaimNameDepositProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
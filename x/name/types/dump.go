@@ -0,0 +1,176 @@
+package types
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// NameDumpFormat is the format version of the name dump archives created by NewNameDump.  Archives of any other
+// format are rejected by ValidateNameDump.
+const NameDumpFormat = 2
+
+// NameDumpEntry is a single name record line in a name dump archive.  The issuance policy is the enum name and the
+// issuers are sorted.
+type NameDumpEntry struct {
+	Name           string   `json:"name"`
+	Address        string   `json:"address"`
+	Restricted     bool     `json:"restricted"`
+	IssuancePolicy string   `json:"issuance_policy"`
+	Issuers        []string `json:"issuers"`
+}
+
+// NameDumpStamp is the final line in a name dump archive.  The hash is the hex encoded sha256 of all preceding lines
+// (each including its trailing newline).
+type NameDumpStamp struct {
+	Format int    `json:"format"`
+	Root   string `json:"root"`
+	Count  int    `json:"count"`
+	Sha256 string `json:"sha256"`
+}
+
+// newNameDumpEntry creates the name dump entry of a record.
+func newNameDumpEntry(record NameRecord) NameDumpEntry {
+	issuers := append([]string{}, record.Issuers...)
+	sort.Strings(issuers)
+	return NameDumpEntry{
+		Name:           record.Name,
+		Address:        record.Address,
+		Restricted:     record.Restricted,
+		IssuancePolicy: record.IssuancePolicy.String(),
+		Issuers:        issuers,
+	}
+}
+
+// toNameRecord converts a name dump entry back to the record it was created from.
+func (entry NameDumpEntry) toNameRecord() (NameRecord, error) {
+	policy, ok := NameIssuancePolicy_value[entry.IssuancePolicy]
+	if !ok {
+		return NameRecord{}, fmt.Errorf("invalid name issuance policy: %q", entry.IssuancePolicy)
+	}
+	if !sort.StringsAreSorted(entry.Issuers) {
+		return NameRecord{}, fmt.Errorf("issuers are not sorted")
+	}
+	record := NameRecord{
+		Name:           entry.Name,
+		Address:        entry.Address,
+		Restricted:     entry.Restricted,
+		IssuancePolicy: NameIssuancePolicy(policy),
+	}
+	if len(entry.Issuers) > 0 {
+		record.Issuers = entry.Issuers
+	}
+	if record.IssuancePolicy != NameIssuancePolicy_NAME_ISSUANCE_POLICY_UNSPECIFIED &&
+		record.Restricted != (record.IssuancePolicy != NameIssuancePolicy_NAME_ISSUANCE_POLICY_OPEN) {
+		return NameRecord{}, fmt.Errorf("restricted %t does not match the %s policy", record.Restricted, record.IssuancePolicy)
+	}
+	return record, record.ValidateBasic()
+}
+
+// NewNameDump creates a deterministic name dump archive of the given records under the given root name.
+// The archive is a set of JSON lines, one per record ordered by name, followed by a hash stamp line.
+func NewNameDump(root string, records NameRecords) ([]byte, error) {
+	entries := make([]NameDumpEntry, len(records))
+	for i, record := range records {
+		entries[i] = newNameDumpEntry(record)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+
+	var buf bytes.Buffer
+	for _, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	hash := sha256.Sum256(buf.Bytes())
+	stamp, err := json.Marshal(NameDumpStamp{Format: NameDumpFormat, Root: root, Count: len(entries), Sha256: hex.EncodeToString(hash[:])})
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(stamp)
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// ValidateNameDump checks the format, hash stamp, and contents of a name dump archive and returns the stamp and
+// the name records it contains.
+func ValidateNameDump(archive []byte) (*NameDumpStamp, NameRecords, error) {
+	lines := make([][]byte, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(archive))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(archive)+1)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) > 0 {
+			lines = append(lines, append([]byte{}, scanner.Bytes()...))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	if len(lines) == 0 {
+		return nil, nil, fmt.Errorf("name dump is empty")
+	}
+
+	stamp := NameDumpStamp{}
+	if err := json.Unmarshal(lines[len(lines)-1], &stamp); err != nil {
+		return nil, nil, fmt.Errorf("invalid name dump stamp: %w", err)
+	}
+	if stamp.Format != NameDumpFormat {
+		return nil, nil, fmt.Errorf("unsupported name dump format %d, expected %d", stamp.Format, NameDumpFormat)
+	}
+	if strings.TrimSpace(stamp.Root) == "" {
+		return nil, nil, fmt.Errorf("name dump stamp is missing a root name")
+	}
+	lines = lines[:len(lines)-1]
+	if stamp.Count != len(lines) {
+		return nil, nil, fmt.Errorf("name dump stamp count %d does not match %d records", stamp.Count, len(lines))
+	}
+
+	hsh := sha256.New()
+	records := make(NameRecords, len(lines))
+	names := make(map[string]bool, len(lines))
+	suffix := "." + stamp.Root
+	for i, line := range lines {
+		hsh.Write(line)
+		hsh.Write([]byte{'\n'})
+
+		entry := NameDumpEntry{}
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, nil, fmt.Errorf("invalid name dump record on line %d: %w", i+1, err)
+		}
+		record, err := entry.toNameRecord()
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid name dump record %s: %w", entry.Name, err)
+		}
+		if record.Name != stamp.Root && !strings.HasSuffix(record.Name, suffix) {
+			return nil, nil, fmt.Errorf("name dump record %s is not within root %s", record.Name, stamp.Root)
+		}
+		if i > 0 && records[i-1].Name >= record.Name {
+			return nil, nil, fmt.Errorf("name dump records are not in order at %s", record.Name)
+		}
+		records[i] = record
+		names[record.Name] = true
+	}
+	if hash := hex.EncodeToString(hsh.Sum(nil)); hash != stamp.Sha256 {
+		return nil, nil, fmt.Errorf("name dump hash %s does not match stamp %s", hash, stamp.Sha256)
+	}
+
+	// Every name below the root must have its parent name in the archive.
+	for _, record := range records {
+		if record.Name == stamp.Root {
+			continue
+		}
+		parent := record.Name[strings.Index(record.Name, ".")+1:]
+		if parent != stamp.Root && !names[parent] {
+			return nil, nil, fmt.Errorf("name dump record %s is missing parent %s", record.Name, parent)
+		}
+	}
+	return &stamp, records, nil
+}
@@ -0,0 +1,149 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNameDumpRoundTrip(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	records := NameRecords{
+		NewNameRecord("b.example.pb", addr, false),
+		NewNameRecord("example.pb", addr, true),
+		NewNameRecord("a.example.pb", addr, true),
+	}
+
+	dump, err := NewNameDump("example.pb", records)
+	require.NoError(t, err)
+	again, err := NewNameDump("example.pb", NameRecords{records[2], records[0], records[1]})
+	require.NoError(t, err)
+	require.Equal(t, dump, again, "name dump should be deterministic")
+
+	stamp, restored, err := ValidateNameDump(dump)
+	require.NoError(t, err)
+	require.Equal(t, "example.pb", stamp.Root)
+	require.Equal(t, 3, stamp.Count)
+	require.Equal(t, NameRecords{records[2], records[0], records[1]}, restored)
+
+	tampered := bytes.Replace(dump, []byte(`"restricted":false`), []byte(`"restricted":true`), 1)
+	_, _, err = ValidateNameDump(tampered)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not match stamp")
+
+	outside, err := NewNameDump("other.pb", records)
+	require.NoError(t, err)
+	_, _, err = ValidateNameDump(outside)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is not within root")
+
+	orphan, err := NewNameDump("pb", NameRecords{records[0]})
+	require.NoError(t, err)
+	_, _, err = ValidateNameDump(orphan)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is missing parent example.pb")
+
+	lines := bytes.SplitN(dump, []byte{'\n'}, 2)
+	_, _, err = ValidateNameDump(lines[1])
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not match 2 records")
+
+	_, _, err = ValidateNameDump([]byte{})
+	require.EqualError(t, err, "name dump is empty")
+
+	oldFormat := bytes.Replace(dump, []byte(`{"format":2,`), []byte(`{`), 1)
+	_, _, err = ValidateNameDump(oldFormat)
+	require.EqualError(t, err, "unsupported name dump format 0, expected 2")
+}
+
+func TestNameDumpIssuancePolicy(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	issuers := []string{
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(issuers)))
+	allowlist := NewNameRecord("allow.example.pb", addr, false)
+	allowlist.SetIssuancePolicy(NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST, issuers)
+	ownerOnly := NewNameRecord("owner.example.pb", addr, false)
+	ownerOnly.SetIssuancePolicy(NameIssuancePolicy_NAME_ISSUANCE_POLICY_OWNER_ONLY, nil)
+	records := NameRecords{NewNameRecord("example.pb", addr, false), allowlist, ownerOnly}
+
+	dump, err := NewNameDump("example.pb", records)
+	require.NoError(t, err)
+	lines := bytes.Split(dump, []byte{'\n'})
+	require.Contains(t, string(lines[0]), `"restricted":true,"issuance_policy":"NAME_ISSUANCE_POLICY_ALLOWLIST","issuers":["`+issuers[1]+`","`+issuers[0]+`"]`)
+	require.Contains(t, string(lines[1]), `"restricted":false,"issuance_policy":"NAME_ISSUANCE_POLICY_UNSPECIFIED","issuers":[]`)
+	require.Contains(t, string(lines[2]), `"restricted":true,"issuance_policy":"NAME_ISSUANCE_POLICY_OWNER_ONLY","issuers":[]`)
+
+	_, restored, err := ValidateNameDump(dump)
+	require.NoError(t, err)
+	require.Len(t, restored, 3)
+	require.Equal(t, NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST, restored[0].IssuancePolicy)
+	require.Equal(t, []string{issuers[1], issuers[0]}, restored[0].Issuers, "issuers are restored sorted")
+	require.True(t, restored[0].Restricted)
+	require.True(t, restored[0].CanIssue(issuers[0]), "an allowlist name is not restored as an open name")
+	require.Equal(t, records[0], restored[1])
+	require.Equal(t, ownerOnly, restored[2])
+
+	tests := []struct {
+		name     string
+		entry    NameDumpEntry
+		expected string
+	}{
+		{
+			"unknown policy",
+			NameDumpEntry{Name: "a.example.pb", Address: addr.String(), IssuancePolicy: "NAME_ISSUANCE_POLICY_ANYONE"},
+			`invalid name dump record a.example.pb: invalid name issuance policy: "NAME_ISSUANCE_POLICY_ANYONE"`,
+		},
+		{
+			"unsorted issuers",
+			NameDumpEntry{Name: "a.example.pb", Address: addr.String(), Restricted: true, IssuancePolicy: "NAME_ISSUANCE_POLICY_ALLOWLIST", Issuers: issuers},
+			"invalid name dump record a.example.pb: issuers are not sorted",
+		},
+		{
+			"allowlist without issuers",
+			NameDumpEntry{Name: "a.example.pb", Address: addr.String(), Restricted: true, IssuancePolicy: "NAME_ISSUANCE_POLICY_ALLOWLIST", Issuers: []string{}},
+			"invalid name dump record a.example.pb: the NAME_ISSUANCE_POLICY_ALLOWLIST policy requires at least one issuer",
+		},
+		{
+			"restricted open name",
+			NameDumpEntry{Name: "a.example.pb", Address: addr.String(), Restricted: true, IssuancePolicy: "NAME_ISSUANCE_POLICY_OPEN", Issuers: []string{}},
+			"invalid name dump record a.example.pb: restricted true does not match the NAME_ISSUANCE_POLICY_OPEN policy",
+		},
+		{
+			"unrestricted allowlist name",
+			NameDumpEntry{Name: "a.example.pb", Address: addr.String(), IssuancePolicy: "NAME_ISSUANCE_POLICY_ALLOWLIST", Issuers: []string{issuers[1]}},
+			"invalid name dump record a.example.pb: restricted false does not match the NAME_ISSUANCE_POLICY_ALLOWLIST policy",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			line, err := json.Marshal(tc.entry)
+			require.NoError(t, err)
+			_, _, err = ValidateNameDump(stampNameDumpLines("example.pb", line))
+			require.EqualError(t, err, tc.expected)
+		})
+	}
+}
+
+// stampNameDumpLines creates a name dump archive of the given lines as they are, with a valid stamp.
+func stampNameDumpLines(root string, lines ...[]byte) []byte {
+	var buf bytes.Buffer
+	for _, line := range lines {
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	hash := sha256.Sum256(buf.Bytes())
+	stamp, _ := json.Marshal(NameDumpStamp{Format: NameDumpFormat, Root: root, Count: len(lines), Sha256: hex.EncodeToString(hash[:])})
+	buf.Write(stamp)
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
@@ -22,4 +22,16 @@ var (
 	ErrInvalidAddress = sdkerrors.Register(ModuleName, 8, "invalid account address")
 	// ErrNameContainsSegments indicates a multi-segment name in a single segment context.
 	ErrNameContainsSegments = sdkerrors.Register(ModuleName, 9, "invalid name: \".\" is reserved")
+	// ErrNameNotLeased occurs when a renewal is requested for a name that does not expire
+	ErrNameNotLeased = sdkerrors.Register(ModuleName, 10, "name does not have a lease")
+	// ErrLeaseTooLong occurs when a requested lease exceeds the maximum lease duration
+	ErrLeaseTooLong = sdkerrors.Register(ModuleName, 11, "lease exceeds the maximum lease duration")
+	// ErrDomainNotVerified occurs when a name for an external domain is bound without a verification for the address
+	ErrDomainNotVerified = sdkerrors.Register(ModuleName, 12, "domain is not verified")
+	// ErrDomainVerification occurs when a domain verification cannot be requested or attested to
+	ErrDomainVerification = sdkerrors.Register(ModuleName, 13, "invalid domain verification")
+	// ErrNameDeposit occurs when a binding deposit cannot be paid, reclaimed or claimed
+	ErrNameDeposit = sdkerrors.Register(ModuleName, 14, "invalid name deposit")
+	// ErrNameHasAttributes occurs when a name that attributes are still using is unbound
+	ErrNameHasAttributes = sdkerrors.Register(ModuleName, 15, "name is used by attributes")
 )
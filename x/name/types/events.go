@@ -5,11 +5,15 @@ const (
 	EventTypeNameBound string = "name_bound"
 	// EventTypeNameUnbound is the type of event generated when a name is unbound from an address (deleted).
 	EventTypeNameUnbound string = "name_unbound"
+	// EventTypeNameLeaseRenewed is the type of event generated when the lease of a name is renewed.
+	EventTypeNameLeaseRenewed string = "name_lease_renewed"
 
 	// KeyAttributeName is the key for a name.
 	KeyAttributeName string = "name"
 	// KeyAttributeAddress is the key for an address.
 	KeyAttributeAddress string = "address"
+	// KeyAttributeExpiration is the key for a lease expiration time.
+	KeyAttributeExpiration string = "expiration"
 )
 
 func NewEventNameBound(address string, name string) *EventNameBound {
@@ -25,3 +29,63 @@ func NewEventNameUnbound(address string, name string) *EventNameUnbound {
 		Name:    name,
 	}
 }
+
+func NewEventNameIssuancePolicySet(record NameRecord) *EventNameIssuancePolicySet {
+	return &EventNameIssuancePolicySet{
+		Name:           record.Name,
+		Address:        record.Address,
+		IssuancePolicy: record.IssuancePolicy.String(),
+		Issuers:        record.Issuers,
+	}
+}
+
+func NewEventNameLeaseRenewed(address string, name string, expiration string) *EventNameLeaseRenewed {
+	return &EventNameLeaseRenewed{
+		Address:    address,
+		Name:       name,
+		Expiration: expiration,
+	}
+}
+
+func NewEventDomainVerificationRequested(name string, owner string, challenge string) *EventDomainVerificationRequested {
+	return &EventDomainVerificationRequested{
+		Name:      name,
+		Owner:     owner,
+		Challenge: challenge,
+	}
+}
+
+func NewEventDomainVerificationAttested(verification DomainVerification) *EventDomainVerificationAttested {
+	return &EventDomainVerificationAttested{
+		Name:     verification.Name,
+		Owner:    verification.Owner,
+		Verifier: verification.Verifier,
+		Status:   verification.Status.String(),
+	}
+}
+
+func NewEventNameDepositPaid(deposit NameDeposit) *EventNameDepositPaid {
+	return &EventNameDepositPaid{
+		Name:        deposit.Name,
+		Depositor:   deposit.Depositor,
+		Amount:      deposit.Amount.String(),
+		ReleaseTime: deposit.ReleaseTime.String(),
+	}
+}
+
+func NewEventNameDepositRefunded(deposit NameDeposit) *EventNameDepositRefunded {
+	return &EventNameDepositRefunded{
+		Name:      deposit.Name,
+		Depositor: deposit.Depositor,
+		Amount:    deposit.Amount.String(),
+	}
+}
+
+func NewEventNameDepositClaimed(deposit NameDeposit, recipient string) *EventNameDepositClaimed {
+	return &EventNameDepositClaimed{
+		Name:      deposit.Name,
+		Depositor: deposit.Depositor,
+		Recipient: recipient,
+		Amount:    deposit.Amount.String(),
+	}
+}
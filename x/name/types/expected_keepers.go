@@ -9,3 +9,14 @@ type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
 	Set(ctx sdk.Context, key []byte, param interface{})
 }
+
+// BankKeeper defines the expected bank keeper used to hold binding deposits (noalias)
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// AttributeKeeper defines the expected attribute keeper used to determine if a name is in use (noalias)
+type AttributeKeeper interface {
+	GetAccountsByAttributeName(ctx sdk.Context, name string) ([]sdk.AccAddress, error)
+}
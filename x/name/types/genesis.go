@@ -12,10 +12,19 @@ import (
 type NameRecords []NameRecord
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, nameRecords NameRecords) *GenesisState {
+func NewGenesisState(
+	params Params,
+	nameRecords NameRecords,
+	leases []NameLease,
+	verifications []DomainVerification,
+	deposits []NameDeposit,
+) *GenesisState {
 	return &GenesisState{
-		Params:   params,
-		Bindings: nameRecords,
+		Params:              params,
+		Bindings:            nameRecords,
+		Leases:              leases,
+		DomainVerifications: verifications,
+		Deposits:            deposits,
 	}
 }
 
@@ -42,7 +51,9 @@ func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMe
 
 // Validate ensures a genesis state is valid.
 func (state GenesisState) Validate() error {
+	names := make(map[string]bool, len(state.Bindings))
 	for _, record := range state.Bindings {
+		names[strings.ToLower(strings.TrimSpace(record.Name))] = true
 		if strings.TrimSpace(record.Name) == "" {
 			return fmt.Errorf("name cannot be empty")
 		}
@@ -50,6 +61,21 @@ func (state GenesisState) Validate() error {
 			return fmt.Errorf("address cannot be empty")
 		}
 	}
+	for _, lease := range state.Leases {
+		if !names[strings.ToLower(strings.TrimSpace(lease.Name))] {
+			return fmt.Errorf("lease for %s does not have a name binding", lease.Name)
+		}
+	}
+	for _, verification := range state.DomainVerifications {
+		if err := verification.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, deposit := range state.Deposits {
+		if err := deposit.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
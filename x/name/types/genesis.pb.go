@@ -29,6 +29,12 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// bindings defines all the name records present at genesis
 	Bindings []NameRecord `protobuf:"bytes,2,rep,name=bindings,proto3" json:"bindings"`
+	// leases defines the expiration of leased name records present at genesis
+	Leases []NameLease `protobuf:"bytes,3,rep,name=leases,proto3" json:"leases"`
+	// domain_verifications defines the verifications of external domains present at genesis
+	DomainVerifications []DomainVerification `protobuf:"bytes,4,rep,name=domain_verifications,json=domainVerifications,proto3" json:"domain_verifications"`
+	// deposits defines the binding deposits held at genesis
+	Deposits []NameDeposit `protobuf:"bytes,5,rep,name=deposits,proto3" json:"deposits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,23 +77,29 @@ func init() {
 func init() { proto.RegisterFile("provenance/name/v1/genesis.proto", fileDescriptor_dba8546991615694) }
 
 var fileDescriptor_dba8546991615694 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4b, 0xcc, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x42, 0xa8,
-	0xd0, 0x03, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83,
-	0x58, 0x10, 0x95, 0x52, 0xb2, 0x58, 0xcc, 0x02, 0xeb, 0x00, 0x4b, 0x2b, 0x4d, 0x61, 0xe4, 0xe2,
-	0x71, 0x87, 0x18, 0x1d, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc1, 0xc5, 0x56, 0x90, 0x58, 0x94,
-	0x98, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0x69, 0x95, 0x5e, 0x00,
-	0x58, 0x85, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xf5, 0x42, 0x0e, 0x5c, 0x1c, 0x49,
-	0x99, 0x79, 0x29, 0x99, 0x79, 0xe9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x72, 0xd8,
-	0xf4, 0xfa, 0x25, 0xe6, 0xa6, 0x06, 0xa5, 0x26, 0xe7, 0x17, 0xa5, 0x40, 0xf5, 0xc3, 0x75, 0x59,
-	0x71, 0x74, 0x2c, 0x90, 0x67, 0x78, 0xb1, 0x40, 0x9e, 0xc1, 0x29, 0xf9, 0xc4, 0x23, 0x39, 0xc6,
-	0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39,
-	0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xb8, 0x44, 0x33, 0xf3, 0xb1, 0x98, 0x1a, 0xc0, 0x18, 0x65, 0x90,
-	0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0x50, 0xa0, 0x9b, 0x99, 0x8f,
-	0xc4, 0xd3, 0xaf, 0x80, 0x84, 0x41, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x08, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x53, 0xef, 0x56, 0x6f, 0x01, 0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4f, 0xf2, 0x40,
+	0x18, 0x80, 0x5b, 0xe0, 0x23, 0xe4, 0xf8, 0xa6, 0x13, 0x93, 0x86, 0x84, 0x2b, 0x71, 0x30, 0x2c,
+	0xf6, 0x04, 0x17, 0xa3, 0x8b, 0x12, 0x12, 0x17, 0x63, 0x08, 0x26, 0x0e, 0x2e, 0xe4, 0x68, 0x5f,
+	0xeb, 0x25, 0xf6, 0xae, 0xe9, 0x9d, 0x8d, 0xfe, 0x03, 0x47, 0x7f, 0x02, 0x3f, 0x87, 0x91, 0xd1,
+	0xc9, 0x18, 0x58, 0xfc, 0x03, 0xee, 0x86, 0x2b, 0x08, 0x09, 0x65, 0x6b, 0xf3, 0x3e, 0xcf, 0xf3,
+	0xb6, 0x79, 0x51, 0x33, 0x4e, 0x64, 0x0a, 0x82, 0x09, 0x1f, 0xa8, 0x60, 0x11, 0xd0, 0xb4, 0x4d,
+	0x43, 0x10, 0xa0, 0xb8, 0xf2, 0xe2, 0x44, 0x6a, 0x89, 0xf1, 0x9a, 0xf0, 0x16, 0x84, 0x97, 0xb6,
+	0xeb, 0xb5, 0x50, 0x86, 0xd2, 0x8c, 0xe9, 0xe2, 0x29, 0x23, 0xeb, 0x8d, 0x9c, 0x96, 0x31, 0xcc,
+	0xf8, 0xe0, 0xa7, 0x80, 0xfe, 0x5f, 0x65, 0xe9, 0x5b, 0xcd, 0x34, 0xe0, 0x53, 0x54, 0x8e, 0x59,
+	0xc2, 0x22, 0xe5, 0xd8, 0x4d, 0xbb, 0x55, 0xed, 0xd4, 0xbd, 0xed, 0x55, 0x5e, 0xdf, 0x10, 0xdd,
+	0xd2, 0xe4, 0xd3, 0xb5, 0x06, 0x4b, 0x1e, 0x5f, 0xa0, 0xca, 0x88, 0x8b, 0x80, 0x8b, 0x50, 0x39,
+	0x85, 0x66, 0xb1, 0x55, 0xed, 0x90, 0x3c, 0xf7, 0x86, 0x45, 0x30, 0x00, 0x5f, 0x26, 0xc1, 0xd2,
+	0xff, 0xb3, 0xf0, 0x39, 0x2a, 0x3f, 0x01, 0x53, 0xa0, 0x9c, 0xa2, 0xf1, 0x1b, 0xbb, 0xfc, 0xeb,
+	0x05, 0xb5, 0x5a, 0x9f, 0x29, 0x78, 0x88, 0x6a, 0x81, 0x8c, 0x18, 0x17, 0xc3, 0x14, 0x12, 0xfe,
+	0xc0, 0x7d, 0xa6, 0xb9, 0x14, 0xca, 0x29, 0x99, 0xd4, 0x61, 0x5e, 0xaa, 0x67, 0xf8, 0xbb, 0x0d,
+	0x7c, 0xd9, 0xdc, 0x0b, 0xb6, 0x26, 0x0a, 0x5f, 0xa2, 0x4a, 0x00, 0xb1, 0x54, 0x5c, 0x2b, 0xe7,
+	0x9f, 0x89, 0xba, 0xbb, 0xbe, 0xaf, 0x97, 0x71, 0xab, 0x1f, 0x5c, 0x69, 0x67, 0x95, 0xb7, 0xb1,
+	0x6b, 0x7d, 0x8f, 0x5d, 0xab, 0xeb, 0x4f, 0x66, 0xc4, 0x9e, 0xce, 0x88, 0xfd, 0x35, 0x23, 0xf6,
+	0xfb, 0x9c, 0x58, 0xd3, 0x39, 0xb1, 0x3e, 0xe6, 0xc4, 0x42, 0xfb, 0x5c, 0xe6, 0x64, 0xfb, 0xf6,
+	0xfd, 0x71, 0xc8, 0xf5, 0xe3, 0xf3, 0xc8, 0xf3, 0x65, 0x44, 0xd7, 0xc0, 0x11, 0x97, 0x1b, 0x6f,
+	0xf4, 0x25, 0x3b, 0xb2, 0x7e, 0x8d, 0x41, 0x8d, 0xca, 0xe6, 0xc6, 0x27, 0xbf, 0x03, 0x00, 0x0f,
+	0xba, 0xba, 0xcf, 0x50, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -110,6 +122,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DomainVerifications) > 0 {
+		for iNdEx := len(m.DomainVerifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DomainVerifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Leases) > 0 {
+		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Bindings) > 0 {
 		for iNdEx := len(m.Bindings) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -162,6 +216,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Leases) > 0 {
+		for _, e := range m.Leases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DomainVerifications) > 0 {
+		for _, e := range m.DomainVerifications {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -267,6 +339,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leases = append(m.Leases, NameLease{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainVerifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainVerifications = append(m.DomainVerifications, DomainVerification{})
+			if err := m.DomainVerifications[len(m.DomainVerifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, NameDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
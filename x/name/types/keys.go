@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -34,6 +35,16 @@ var (
 	NameKeyPrefix = []byte{0x03}
 	// AddressKeyPrefix is a prefix added to keys for indexing name records by address.
 	AddressKeyPrefix = []byte{0x05}
+	// LeaseKeyPrefix is a prefix added to keys for storing name leases.
+	LeaseKeyPrefix = []byte{0x06}
+	// LeaseExpirationKeyPrefix is a prefix added to keys for indexing name leases by expiration time.
+	LeaseExpirationKeyPrefix = []byte{0x07}
+	// DomainVerificationKeyPrefix is a prefix added to keys for storing the verifications of external domains.
+	DomainVerificationKeyPrefix = []byte{0x08}
+	// DepositKeyPrefix is a prefix added to keys for storing the binding deposits of names.
+	DepositKeyPrefix = []byte{0x09}
+	// SubtreeKeyPrefix is a prefix added to keys for indexing name records by their place in the name hierarchy.
+	SubtreeKeyPrefix = []byte{0x0A}
 )
 
 // GetNameKeyPrefix converts a name into key format.
@@ -72,6 +83,59 @@ func getNamePrefixByType(name string, key []byte) ([]byte, error) {
 	return key, nil
 }
 
+// GetSubtreeKey returns the store key indexing a name record by its place in the name hierarchy.  The segments are
+// ordered from the root down and each is followed by a zero byte, so the key of a name is a prefix of the keys of all
+// names below it.
+func GetSubtreeKey(name string) ([]byte, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("name can not be empty: %w", ErrNameInvalid)
+	}
+	comps := strings.Split(name, ".")
+	key := append([]byte{}, SubtreeKeyPrefix...)
+	for i := len(comps) - 1; i >= 0; i-- {
+		comp := strings.TrimSpace(comps[i])
+		if len(comp) == 0 {
+			return nil, fmt.Errorf("name segment cannot be empty: %w", ErrNameInvalid)
+		}
+		if strings.IndexByte(comp, 0) >= 0 {
+			return nil, fmt.Errorf("name segment cannot contain a zero byte: %w", ErrNameInvalid)
+		}
+		key = append(append(key, comp...), 0)
+	}
+	return key, nil
+}
+
+// GetLeaseKey returns the store key for the lease of a name.
+func GetLeaseKey(name string) ([]byte, error) {
+	return getNamePrefixByType(name, LeaseKeyPrefix)
+}
+
+// GetDomainVerificationKey returns the store key for the verification of an external domain.
+func GetDomainVerificationKey(name string) ([]byte, error) {
+	return getNamePrefixByType(name, DomainVerificationKeyPrefix)
+}
+
+// GetDepositKey returns the store key for the binding deposit of a name.
+func GetDepositKey(name string) ([]byte, error) {
+	return getNamePrefixByType(name, DepositKeyPrefix)
+}
+
+// GetLeaseExpirationKey returns the store key indexing the lease of a name by its expiration time.
+func GetLeaseExpirationKey(expiration time.Time, name string) ([]byte, error) {
+	nameKey, err := GetNameKeyPrefix(name)
+	if err != nil {
+		return nil, err
+	}
+	key := GetLeaseExpirationPrefix(expiration)
+	return append(key, nameKey[len(NameKeyPrefix):]...), nil
+}
+
+// GetLeaseExpirationPrefix returns the store key prefix for leases expiring at the given time.  Iterating up to the
+// prefix of a time returns all leases that expired before it.
+func GetLeaseExpirationPrefix(expiration time.Time) []byte {
+	return append(append([]byte{}, LeaseExpirationKeyPrefix...), sdk.FormatTimeBytes(expiration)...)
+}
+
 // GetAddressKeyPrefix returns a store key for a name record address
 func GetAddressKeyPrefix(addr sdk.AccAddress) (key []byte, err error) {
 	err = sdk.VerifyAddressFormat(addr.Bytes())
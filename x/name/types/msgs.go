@@ -11,10 +11,22 @@ import (
 const (
 	TypeMsgBindNameRequest   = "bind_name"
 	TypeMsgDeleteNameRequest = "delete_name"
+	TypeMsgRenewNameRequest  = "renew_name"
+
+	TypeMsgRequestDomainVerificationRequest = "request_domain_verification"
+	TypeMsgAttestDomainVerificationRequest  = "attest_domain_verification"
+
+	TypeMsgReclaimNameDepositRequest = "reclaim_name_deposit"
+
+	TypeMsgSetNamePolicyRequest = "set_name_policy"
 )
 
 // Compile time interface checks.
-var _, _ sdk.Msg = &MsgBindNameRequest{}, &MsgDeleteNameRequest{}
+var (
+	_, _, _ sdk.Msg = &MsgBindNameRequest{}, &MsgDeleteNameRequest{}, &MsgRenewNameRequest{}
+	_, _    sdk.Msg = &MsgRequestDomainVerificationRequest{}, &MsgAttestDomainVerificationRequest{}
+	_, _    sdk.Msg = &MsgReclaimNameDepositRequest{}, &MsgSetNamePolicyRequest{}
+)
 
 // NewMsgBindNameRequest creates a new bind name request
 func NewMsgBindNameRequest(record, parent NameRecord) *MsgBindNameRequest {
@@ -47,7 +59,7 @@ func (msg MsgBindNameRequest) ValidateBasic() error {
 	if strings.TrimSpace(msg.Record.Address) == "" {
 		return fmt.Errorf("address cannot be empty")
 	}
-	return nil
+	return ValidateIssuancePolicy(msg.Record.IssuancePolicy, msg.Record.Issuers)
 }
 
 // GetSignBytes encodes the message for signing
@@ -101,3 +113,213 @@ func (msg MsgDeleteNameRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgRenewNameRequest creates a new Renew Name Request
+func NewMsgRenewNameRequest(name string, owner sdk.AccAddress, leaseSeconds uint64) *MsgRenewNameRequest { // nolint:interfacer
+	return &MsgRenewNameRequest{
+		Name:         name,
+		Owner:        owner.String(),
+		LeaseSeconds: leaseSeconds,
+	}
+}
+
+// Route implements Msg
+func (msg MsgRenewNameRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgRenewNameRequest) Type() string { return TypeMsgRenewNameRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRenewNameRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRenewNameRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the name owner.
+func (msg MsgRenewNameRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRequestDomainVerificationRequest creates a new Request Domain Verification Request
+func NewMsgRequestDomainVerificationRequest(name string, owner sdk.AccAddress) *MsgRequestDomainVerificationRequest { // nolint:interfacer
+	return &MsgRequestDomainVerificationRequest{
+		Name:  name,
+		Owner: owner.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgRequestDomainVerificationRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgRequestDomainVerificationRequest) Type() string {
+	return TypeMsgRequestDomainVerificationRequest
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRequestDomainVerificationRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRequestDomainVerificationRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the address the domain will be bound to.
+func (msg MsgRequestDomainVerificationRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgAttestDomainVerificationRequest creates a new Attest Domain Verification Request
+func NewMsgAttestDomainVerificationRequest(name string, verifier sdk.AccAddress, challenge string, verified bool) *MsgAttestDomainVerificationRequest { // nolint:interfacer
+	return &MsgAttestDomainVerificationRequest{
+		Name:      name,
+		Verifier:  verifier.String(),
+		Challenge: challenge,
+		Verified:  verified,
+	}
+}
+
+// Route implements Msg
+func (msg MsgAttestDomainVerificationRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgAttestDomainVerificationRequest) Type() string {
+	return TypeMsgAttestDomainVerificationRequest
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgAttestDomainVerificationRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Verifier); err != nil {
+		return fmt.Errorf("invalid verifier address: %w", err)
+	}
+	if msg.Verified && strings.TrimSpace(msg.Challenge) == "" {
+		return fmt.Errorf("challenge cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAttestDomainVerificationRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the domain verifier.
+func (msg MsgAttestDomainVerificationRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Verifier)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgReclaimNameDepositRequest creates a new Reclaim Name Deposit Request
+func NewMsgReclaimNameDepositRequest(name string, depositor sdk.AccAddress) *MsgReclaimNameDepositRequest { // nolint:interfacer
+	return &MsgReclaimNameDepositRequest{
+		Name:      name,
+		Depositor: depositor.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgReclaimNameDepositRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgReclaimNameDepositRequest) Type() string { return TypeMsgReclaimNameDepositRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgReclaimNameDepositRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return fmt.Errorf("invalid depositor address: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgReclaimNameDepositRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the depositor.
+func (msg MsgReclaimNameDepositRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetNamePolicyRequest creates a new Set Name Policy Request
+func NewMsgSetNamePolicyRequest(name string, owner sdk.AccAddress, policy NameIssuancePolicy, issuers []string) *MsgSetNamePolicyRequest { // nolint:interfacer
+	return &MsgSetNamePolicyRequest{
+		Name:           name,
+		Owner:          owner.String(),
+		IssuancePolicy: policy,
+		Issuers:        issuers,
+	}
+}
+
+// Route implements Msg
+func (msg MsgSetNamePolicyRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgSetNamePolicyRequest) Type() string { return TypeMsgSetNamePolicyRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetNamePolicyRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	if msg.IssuancePolicy == NameIssuancePolicy_NAME_ISSUANCE_POLICY_UNSPECIFIED {
+		return fmt.Errorf("issuance policy cannot be unspecified")
+	}
+	return ValidateIssuancePolicy(msg.IssuancePolicy, msg.Issuers)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetNamePolicyRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the name owner.
+func (msg MsgSetNamePolicyRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
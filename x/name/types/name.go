@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -18,6 +19,9 @@ func NewNameRecord(name string, address sdk.AccAddress, restricted bool) NameRec
 
 // implement fmt.Stringer
 func (nr NameRecord) String() string {
+	if nr.IssuancePolicy == NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST {
+		return strings.TrimSpace(fmt.Sprintf(`%s: %s [allowlist: %s]`, nr.Name, nr.Address, strings.Join(nr.Issuers, ", ")))
+	}
 	if nr.Restricted {
 		return strings.TrimSpace(fmt.Sprintf(`%s: %s [restricted]`, nr.Name, nr.Address))
 	}
@@ -32,5 +36,129 @@ func (nr NameRecord) ValidateBasic() error {
 	if strings.TrimSpace(nr.Name) == "" {
 		return ErrNameSegmentTooShort
 	}
+	return ValidateIssuancePolicy(nr.IssuancePolicy, nr.Issuers)
+}
+
+// EffectiveIssuancePolicy returns the issuance policy of the record, derived from the restricted flag when the policy
+// is unspecified.
+func (nr NameRecord) EffectiveIssuancePolicy() NameIssuancePolicy {
+	if nr.IssuancePolicy != NameIssuancePolicy_NAME_ISSUANCE_POLICY_UNSPECIFIED {
+		return nr.IssuancePolicy
+	}
+	if nr.Restricted {
+		return NameIssuancePolicy_NAME_ISSUANCE_POLICY_OWNER_ONLY
+	}
+	return NameIssuancePolicy_NAME_ISSUANCE_POLICY_OPEN
+}
+
+// CanIssue returns true if the issuance policy of the record allows the address to bind names directly under it.  The
+// owner of a name can always bind names under it.
+func (nr NameRecord) CanIssue(address string) bool {
+	switch nr.EffectiveIssuancePolicy() {
+	case NameIssuancePolicy_NAME_ISSUANCE_POLICY_OPEN:
+		return true
+	case NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST:
+		for _, issuer := range nr.Issuers {
+			if issuer == address {
+				return true
+			}
+		}
+	}
+	return nr.Address == address
+}
+
+// SetIssuancePolicy sets the issuance policy and issuers of the record.  The restricted flag is kept in sync for
+// clients that only read it.
+func (nr *NameRecord) SetIssuancePolicy(policy NameIssuancePolicy, issuers []string) {
+	nr.IssuancePolicy = policy
+	nr.Issuers = issuers
+	if policy != NameIssuancePolicy_NAME_ISSUANCE_POLICY_UNSPECIFIED {
+		nr.Restricted = policy != NameIssuancePolicy_NAME_ISSUANCE_POLICY_OPEN
+	}
+}
+
+// ValidateIssuancePolicy checks that an issuance policy is known and that issuers, distinct valid addresses, are
+// listed with and only with the allowlist policy.
+func ValidateIssuancePolicy(policy NameIssuancePolicy, issuers []string) error {
+	if _, ok := NameIssuancePolicy_name[int32(policy)]; !ok {
+		return fmt.Errorf("invalid name issuance policy: %d", policy)
+	}
+	if policy != NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST {
+		if len(issuers) > 0 {
+			return fmt.Errorf("issuers can only be listed with the %s policy", NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST)
+		}
+		return nil
+	}
+	if len(issuers) == 0 {
+		return fmt.Errorf("the %s policy requires at least one issuer", NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST)
+	}
+	seen := make(map[string]bool, len(issuers))
+	for _, issuer := range issuers {
+		if _, err := sdk.AccAddressFromBech32(issuer); err != nil {
+			return fmt.Errorf("invalid issuer address %s: %w", issuer, err)
+		}
+		if seen[issuer] {
+			return fmt.Errorf("duplicate issuer address %s", issuer)
+		}
+		seen[issuer] = true
+	}
+	return nil
+}
+
+// ParseNameIssuancePolicy returns the issuance policy with the given short name (open, owner-only, allowlist) or
+// enum name.
+func ParseNameIssuancePolicy(s string) (NameIssuancePolicy, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "open":
+		return NameIssuancePolicy_NAME_ISSUANCE_POLICY_OPEN, nil
+	case "owner-only", "owner_only":
+		return NameIssuancePolicy_NAME_ISSUANCE_POLICY_OWNER_ONLY, nil
+	case "allowlist":
+		return NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST, nil
+	}
+	if policy, ok := NameIssuancePolicy_value[strings.ToUpper(strings.TrimSpace(s))]; ok {
+		return NameIssuancePolicy(policy), nil
+	}
+	return NameIssuancePolicy_NAME_ISSUANCE_POLICY_UNSPECIFIED, fmt.Errorf("invalid name issuance policy: %s", s)
+}
+
+// Validate performs basic stateless validity checks on a domain verification.
+func (dv DomainVerification) Validate() error {
+	if strings.TrimSpace(dv.Name) == "" {
+		return fmt.Errorf("domain verification name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(dv.Owner); err != nil {
+		return fmt.Errorf("invalid domain verification owner for %s: %w", dv.Name, err)
+	}
+	if strings.TrimSpace(dv.Challenge) == "" {
+		return fmt.Errorf("domain verification challenge for %s cannot be empty", dv.Name)
+	}
+	if _, ok := DomainVerificationStatus_name[int32(dv.Status)]; !ok || dv.Status == DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_UNSPECIFIED {
+		return fmt.Errorf("invalid domain verification status for %s: %s", dv.Name, dv.Status)
+	}
+	if len(dv.Verifier) > 0 {
+		if _, err := sdk.AccAddressFromBech32(dv.Verifier); err != nil {
+			return fmt.Errorf("invalid domain verifier for %s: %w", dv.Name, err)
+		}
+	}
 	return nil
 }
+
+// Validate performs basic stateless validity checks on a binding deposit.
+func (nd NameDeposit) Validate() error {
+	if strings.TrimSpace(nd.Name) == "" {
+		return fmt.Errorf("name deposit name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(nd.Depositor); err != nil {
+		return fmt.Errorf("invalid name deposit depositor for %s: %w", nd.Name, err)
+	}
+	if nd.Amount.Amount.IsNil() || !nd.Amount.IsValid() || nd.Amount.IsZero() {
+		return fmt.Errorf("invalid name deposit amount for %s: %s", nd.Name, nd.Amount)
+	}
+	return nil
+}
+
+// IsReleased returns true if the holding period of the deposit has passed at the given time.
+func (nd NameDeposit) IsReleased(blockTime time.Time) bool {
+	return !blockTime.Before(nd.ReleaseTime)
+}
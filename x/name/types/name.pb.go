@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,6 +28,79 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// NameIssuancePolicy controls which addresses can bind names directly under a name.
+type NameIssuancePolicy int32
+
+const (
+	// NAME_ISSUANCE_POLICY_UNSPECIFIED follows the restricted flag of the record: owner only when restricted, open
+	// otherwise
+	NameIssuancePolicy_NAME_ISSUANCE_POLICY_UNSPECIFIED NameIssuancePolicy = 0
+	// NAME_ISSUANCE_POLICY_OPEN allows any address to bind names under the name
+	NameIssuancePolicy_NAME_ISSUANCE_POLICY_OPEN NameIssuancePolicy = 1
+	// NAME_ISSUANCE_POLICY_OWNER_ONLY allows only the owner of the name to bind names under it
+	NameIssuancePolicy_NAME_ISSUANCE_POLICY_OWNER_ONLY NameIssuancePolicy = 2
+	// NAME_ISSUANCE_POLICY_ALLOWLIST allows the owner of the name and the listed issuers to bind names under it
+	NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST NameIssuancePolicy = 3
+)
+
+var NameIssuancePolicy_name = map[int32]string{
+	0: "NAME_ISSUANCE_POLICY_UNSPECIFIED",
+	1: "NAME_ISSUANCE_POLICY_OPEN",
+	2: "NAME_ISSUANCE_POLICY_OWNER_ONLY",
+	3: "NAME_ISSUANCE_POLICY_ALLOWLIST",
+}
+
+var NameIssuancePolicy_value = map[string]int32{
+	"NAME_ISSUANCE_POLICY_UNSPECIFIED": 0,
+	"NAME_ISSUANCE_POLICY_OPEN":        1,
+	"NAME_ISSUANCE_POLICY_OWNER_ONLY":  2,
+	"NAME_ISSUANCE_POLICY_ALLOWLIST":   3,
+}
+
+func (x NameIssuancePolicy) String() string {
+	return proto.EnumName(NameIssuancePolicy_name, int32(x))
+}
+
+func (NameIssuancePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{0}
+}
+
+// DomainVerificationStatus is the status of the verification of an external DNS domain.
+type DomainVerificationStatus int32
+
+const (
+	// DOMAIN_VERIFICATION_STATUS_UNSPECIFIED is an invalid/unknown status
+	DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_UNSPECIFIED DomainVerificationStatus = 0
+	// DOMAIN_VERIFICATION_STATUS_PENDING indicates the challenge has not yet been attested to by the verifier
+	DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_PENDING DomainVerificationStatus = 1
+	// DOMAIN_VERIFICATION_STATUS_VERIFIED indicates the verifier found the challenge in a DNS TXT record of the domain
+	DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_VERIFIED DomainVerificationStatus = 2
+	// DOMAIN_VERIFICATION_STATUS_REJECTED indicates the verifier could not find the challenge for the domain
+	DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_REJECTED DomainVerificationStatus = 3
+)
+
+var DomainVerificationStatus_name = map[int32]string{
+	0: "DOMAIN_VERIFICATION_STATUS_UNSPECIFIED",
+	1: "DOMAIN_VERIFICATION_STATUS_PENDING",
+	2: "DOMAIN_VERIFICATION_STATUS_VERIFIED",
+	3: "DOMAIN_VERIFICATION_STATUS_REJECTED",
+}
+
+var DomainVerificationStatus_value = map[string]int32{
+	"DOMAIN_VERIFICATION_STATUS_UNSPECIFIED": 0,
+	"DOMAIN_VERIFICATION_STATUS_PENDING":     1,
+	"DOMAIN_VERIFICATION_STATUS_VERIFIED":    2,
+	"DOMAIN_VERIFICATION_STATUS_REJECTED":    3,
+}
+
+func (x DomainVerificationStatus) String() string {
+	return proto.EnumName(DomainVerificationStatus_name, int32(x))
+}
+
+func (DomainVerificationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{1}
+}
+
 // Params defines the set of params for the name module.
 type Params struct {
 	// maximum length of name segment to allow
@@ -33,6 +111,22 @@ type Params struct {
 	MaxNameLevels uint32 `protobuf:"varint,3,opt,name=max_name_levels,json=maxNameLevels,proto3" json:"max_name_levels,omitempty"`
 	// determines if unrestricted name keys are allowed or not
 	AllowUnrestrictedNames bool `protobuf:"varint,4,opt,name=allow_unrestricted_names,json=allowUnrestrictedNames,proto3" json:"allow_unrestricted_names,omitempty"`
+	// lease duration in seconds applied to bound names that do not request one, zero for names that do not expire
+	DefaultLeaseSeconds uint64 `protobuf:"varint,5,opt,name=default_lease_seconds,json=defaultLeaseSeconds,proto3" json:"default_lease_seconds,omitempty"`
+	// maximum lease duration in seconds that a name may be bound or renewed for, zero for no maximum
+	MaxLeaseSeconds uint64 `protobuf:"varint,6,opt,name=max_lease_seconds,json=maxLeaseSeconds,proto3" json:"max_lease_seconds,omitempty"`
+	// number of seconds after a lease expires before the name is unbound
+	LeaseGraceSeconds uint64 `protobuf:"varint,7,opt,name=lease_grace_seconds,json=leaseGraceSeconds,proto3" json:"lease_grace_seconds,omitempty"`
+	// regex of names that are external DNS domains and must be verified before being bound, empty for no verification
+	DomainNameRegex string `protobuf:"bytes,8,opt,name=domain_name_regex,json=domainNameRegex,proto3" json:"domain_name_regex,omitempty"`
+	// address of the verifier that attests to the verification of external DNS domains
+	DomainVerifier string `protobuf:"bytes,9,opt,name=domain_verifier,json=domainVerifier,proto3" json:"domain_verifier,omitempty"`
+	// determines if name segments can use NFKC normalized unicode letters from a controlled set of scripts
+	AllowUnicodeNames bool `protobuf:"varint,10,opt,name=allow_unicode_names,json=allowUnicodeNames,proto3" json:"allow_unicode_names,omitempty"`
+	// refundable deposit required to bind a name directly under an unrestricted root name, zero for no deposit
+	BindingDeposit types.Coin `protobuf:"bytes,11,opt,name=binding_deposit,json=bindingDeposit,proto3" json:"binding_deposit"`
+	// number of seconds a binding deposit is held before it can be reclaimed by the depositor or claimed by governance
+	DepositHoldingSeconds uint64 `protobuf:"varint,12,opt,name=deposit_holding_seconds,json=depositHoldingSeconds,proto3" json:"deposit_holding_seconds,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -96,14 +190,74 @@ func (m *Params) GetAllowUnrestrictedNames() bool {
 	return false
 }
 
+func (m *Params) GetDefaultLeaseSeconds() uint64 {
+	if m != nil {
+		return m.DefaultLeaseSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetMaxLeaseSeconds() uint64 {
+	if m != nil {
+		return m.MaxLeaseSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetLeaseGraceSeconds() uint64 {
+	if m != nil {
+		return m.LeaseGraceSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetDomainNameRegex() string {
+	if m != nil {
+		return m.DomainNameRegex
+	}
+	return ""
+}
+
+func (m *Params) GetDomainVerifier() string {
+	if m != nil {
+		return m.DomainVerifier
+	}
+	return ""
+}
+
+func (m *Params) GetAllowUnicodeNames() bool {
+	if m != nil {
+		return m.AllowUnicodeNames
+	}
+	return false
+}
+
+func (m *Params) GetBindingDeposit() types.Coin {
+	if m != nil {
+		return m.BindingDeposit
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetDepositHoldingSeconds() uint64 {
+	if m != nil {
+		return m.DepositHoldingSeconds
+	}
+	return 0
+}
+
 // NameRecord is a structure used to bind ownership of a name hierarchy to a collection of addresses
 type NameRecord struct {
 	// The bound name
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The address the name resolved to.
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// Whether owner signature is required to add sub-names.
+	// Whether owner signature is required to add sub-names.  Kept in sync with the issuance policy once one is set.
 	Restricted bool `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// The policy controlling who can bind names directly under this name, unspecified to follow the restricted flag.
+	IssuancePolicy NameIssuancePolicy `protobuf:"varint,4,opt,name=issuance_policy,json=issuancePolicy,proto3,enum=provenance.name.v1.NameIssuancePolicy" json:"issuance_policy,omitempty"`
+	// The addresses allowed to bind names directly under this name when the issuance policy is an allowlist.
+	Issuers []string `protobuf:"bytes,5,rep,name=issuers,proto3" json:"issuers,omitempty"`
 }
 
 func (m *NameRecord) Reset()      { *m = NameRecord{} }
@@ -159,21 +313,249 @@ func (m *NameRecord) GetRestricted() bool {
 	return false
 }
 
+func (m *NameRecord) GetIssuancePolicy() NameIssuancePolicy {
+	if m != nil {
+		return m.IssuancePolicy
+	}
+	return NameIssuancePolicy_NAME_ISSUANCE_POLICY_UNSPECIFIED
+}
+
+func (m *NameRecord) GetIssuers() []string {
+	if m != nil {
+		return m.Issuers
+	}
+	return nil
+}
+
+// NameLease is the expiration of a name binding.  Names without a lease do not expire.
+type NameLease struct {
+	// The leased name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The time at which the lease expires
+	Expiration time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *NameLease) Reset()         { *m = NameLease{} }
+func (m *NameLease) String() string { return proto.CompactTextString(m) }
+func (*NameLease) ProtoMessage()    {}
+func (*NameLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{2}
+}
+func (m *NameLease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NameLease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NameLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameLease.Merge(m, src)
+}
+func (m *NameLease) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameLease proto.InternalMessageInfo
+
+func (m *NameLease) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NameLease) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+// DomainVerification tracks the verification that the owner of an external DNS domain controls the address a name for
+// the domain will be bound to.
+type DomainVerification struct {
+	// The name of the external domain, e.g. example.com
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address that requested the verification, the only address the name can be bound to once verified
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The challenge to publish in a DNS TXT record of the domain
+	Challenge string `protobuf:"bytes,3,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// The status of the verification
+	Status DomainVerificationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=provenance.name.v1.DomainVerificationStatus" json:"status,omitempty"`
+	// The address of the verifier that attested to the verification, empty while pending
+	Verifier string `protobuf:"bytes,5,opt,name=verifier,proto3" json:"verifier,omitempty"`
+}
+
+func (m *DomainVerification) Reset()         { *m = DomainVerification{} }
+func (m *DomainVerification) String() string { return proto.CompactTextString(m) }
+func (*DomainVerification) ProtoMessage()    {}
+func (*DomainVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{3}
+}
+func (m *DomainVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainVerification.Merge(m, src)
+}
+func (m *DomainVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainVerification proto.InternalMessageInfo
+
+func (m *DomainVerification) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DomainVerification) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *DomainVerification) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
+}
+
+func (m *DomainVerification) GetStatus() DomainVerificationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_UNSPECIFIED
+}
+
+func (m *DomainVerification) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
+	}
+	return ""
+}
+
+// NameDeposit is a refundable deposit paid to bind a name directly under an unrestricted root name.
+type NameDeposit struct {
+	// The name the deposit was paid for
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address that paid the deposit
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// The amount of the deposit
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// The time after which the deposit can be reclaimed by the depositor or claimed by governance
+	ReleaseTime time.Time `protobuf:"bytes,4,opt,name=release_time,json=releaseTime,proto3,stdtime" json:"release_time"`
+}
+
+func (m *NameDeposit) Reset()         { *m = NameDeposit{} }
+func (m *NameDeposit) String() string { return proto.CompactTextString(m) }
+func (*NameDeposit) ProtoMessage()    {}
+func (*NameDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{4}
+}
+func (m *NameDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NameDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NameDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameDeposit.Merge(m, src)
+}
+func (m *NameDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameDeposit proto.InternalMessageInfo
+
+func (m *NameDeposit) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NameDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *NameDeposit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *NameDeposit) GetReleaseTime() time.Time {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return time.Time{}
+}
+
 // CreateRootNameProposal details a proposal to create a new root name
 // that is controlled by a given owner and optionally restricted to the owner
-// for the sole creation of sub names.
+// for the sole creation of sub names.  An issuance policy, when specified,
+// takes the place of the restricted flag.
 type CreateRootNameProposal struct {
-	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Owner       string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	Restricted  bool   `protobuf:"varint,5,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	Title          string             `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string             `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Name           string             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Owner          string             `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Restricted     bool               `protobuf:"varint,5,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	IssuancePolicy NameIssuancePolicy `protobuf:"varint,6,opt,name=issuance_policy,json=issuancePolicy,proto3,enum=provenance.name.v1.NameIssuancePolicy" json:"issuance_policy,omitempty"`
+	Issuers        []string           `protobuf:"bytes,7,rep,name=issuers,proto3" json:"issuers,omitempty"`
 }
 
 func (m *CreateRootNameProposal) Reset()      { *m = CreateRootNameProposal{} }
 func (*CreateRootNameProposal) ProtoMessage() {}
 func (*CreateRootNameProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{2}
+	return fileDescriptor_a314256905bb00ec, []int{5}
 }
 func (m *CreateRootNameProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,6 +584,47 @@ func (m *CreateRootNameProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CreateRootNameProposal proto.InternalMessageInfo
 
+// ClaimNameDepositProposal details a proposal to claim the deposit of a name that is not being used once its holding
+// period has passed.
+type ClaimNameDepositProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Recipient   string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *ClaimNameDepositProposal) Reset()      { *m = ClaimNameDepositProposal{} }
+func (*ClaimNameDepositProposal) ProtoMessage() {}
+func (*ClaimNameDepositProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{6}
+}
+func (m *ClaimNameDepositProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimNameDepositProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimNameDepositProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimNameDepositProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimNameDepositProposal.Merge(m, src)
+}
+func (m *ClaimNameDepositProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimNameDepositProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimNameDepositProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimNameDepositProposal proto.InternalMessageInfo
+
 // Event emitted when name is bound.
 type EventNameBound struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -212,7 +635,7 @@ func (m *EventNameBound) Reset()         { *m = EventNameBound{} }
 func (m *EventNameBound) String() string { return proto.CompactTextString(m) }
 func (*EventNameBound) ProtoMessage()    {}
 func (*EventNameBound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{3}
+	return fileDescriptor_a314256905bb00ec, []int{7}
 }
 func (m *EventNameBound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,7 +688,7 @@ func (m *EventNameUnbound) Reset()         { *m = EventNameUnbound{} }
 func (m *EventNameUnbound) String() string { return proto.CompactTextString(m) }
 func (*EventNameUnbound) ProtoMessage()    {}
 func (*EventNameUnbound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{4}
+	return fileDescriptor_a314256905bb00ec, []int{8}
 }
 func (m *EventNameUnbound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,399 +731,3815 @@ func (m *EventNameUnbound) GetName() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "provenance.name.v1.Params")
-	proto.RegisterType((*NameRecord)(nil), "provenance.name.v1.NameRecord")
-	proto.RegisterType((*CreateRootNameProposal)(nil), "provenance.name.v1.CreateRootNameProposal")
-	proto.RegisterType((*EventNameBound)(nil), "provenance.name.v1.EventNameBound")
-	proto.RegisterType((*EventNameUnbound)(nil), "provenance.name.v1.EventNameUnbound")
+// Event emitted when the issuance policy of a name is set.
+type EventNameIssuancePolicySet struct {
+	Name           string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address        string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	IssuancePolicy string   `protobuf:"bytes,3,opt,name=issuance_policy,json=issuancePolicy,proto3" json:"issuance_policy,omitempty"`
+	Issuers        []string `protobuf:"bytes,4,rep,name=issuers,proto3" json:"issuers,omitempty"`
 }
 
-func init() { proto.RegisterFile("provenance/name/v1/name.proto", fileDescriptor_a314256905bb00ec) }
-
-var fileDescriptor_a314256905bb00ec = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x6e, 0x13, 0x41,
-	0x10, 0x86, 0x6f, 0x13, 0x3b, 0xc4, 0x03, 0x81, 0x68, 0x65, 0xa2, 0x13, 0x12, 0x17, 0xcb, 0x05,
-	0x4a, 0x01, 0x3e, 0x22, 0x1a, 0x44, 0x81, 0x50, 0x10, 0x5d, 0x84, 0xac, 0x43, 0x69, 0x68, 0xcc,
-	0xfa, 0x6e, 0x74, 0x59, 0xe9, 0x6e, 0xf7, 0xb4, 0xbb, 0xbe, 0x98, 0x37, 0xa0, 0xa4, 0xa4, 0x4c,
-	0xc9, 0x93, 0x20, 0xca, 0x94, 0x94, 0xc8, 0x6e, 0x78, 0x0c, 0xb4, 0x73, 0x71, 0x7c, 0x31, 0x15,
-	0xd5, 0xee, 0xcc, 0xff, 0xcf, 0xcc, 0x37, 0xd2, 0xc0, 0xe3, 0xca, 0xe8, 0x1a, 0x95, 0x50, 0x29,
-	0xc6, 0x4a, 0x94, 0x18, 0xd7, 0xc7, 0xf4, 0x8e, 0x2a, 0xa3, 0x9d, 0xe6, 0x7c, 0x2d, 0x8f, 0x28,
-	0x5d, 0x1f, 0x3f, 0xea, 0xe7, 0x3a, 0xd7, 0x24, 0xc7, 0xfe, 0xd7, 0x38, 0x87, 0x3f, 0x18, 0xec,
-	0x8c, 0x85, 0x11, 0xa5, 0xe5, 0x4f, 0x81, 0x97, 0x62, 0x3e, 0xb1, 0x98, 0x97, 0xa8, 0xdc, 0xa4,
-	0x40, 0x95, 0xbb, 0xf3, 0x90, 0x0d, 0xd8, 0xd1, 0x5e, 0xb2, 0x5f, 0x8a, 0xf9, 0x87, 0x46, 0x38,
-	0xa5, 0x3c, 0xb9, 0xa5, 0xda, 0x74, 0x6f, 0x5d, 0xbb, 0xa5, 0xba, 0xed, 0x7e, 0x02, 0x0f, 0x7c,
-	0x6f, 0xcf, 0x32, 0x29, 0xb0, 0xc6, 0xc2, 0x86, 0xdb, 0x64, 0xdd, 0x2b, 0xc5, 0xfc, 0xbd, 0x28,
-	0xf1, 0x94, 0x92, 0xfc, 0x25, 0x84, 0xa2, 0x28, 0xf4, 0xc5, 0x64, 0xa6, 0x0c, 0x5a, 0x67, 0x64,
-	0xea, 0x30, 0xa3, 0x32, 0x1b, 0x76, 0x06, 0xec, 0x68, 0x37, 0x39, 0x20, 0xfd, 0xac, 0x25, 0xfb,
-	0x72, 0x3b, 0xfc, 0x04, 0xe0, 0x3f, 0x09, 0xa6, 0xda, 0x64, 0x9c, 0x43, 0xc7, 0x17, 0x11, 0x7d,
-	0x2f, 0xa1, 0x3f, 0x0f, 0xe1, 0x8e, 0xc8, 0x32, 0x83, 0xd6, 0x12, 0x66, 0x2f, 0x59, 0x85, 0x3c,
-	0x02, 0x58, 0xb7, 0x23, 0xb0, 0xdd, 0xa4, 0x95, 0x79, 0xd5, 0xf9, 0x76, 0x79, 0x18, 0x0c, 0xbf,
-	0x33, 0x38, 0x78, 0x6b, 0x50, 0x38, 0x4c, 0xb4, 0x76, 0x7e, 0xd8, 0xd8, 0xe8, 0x4a, 0x5b, 0x51,
-	0xf0, 0x3e, 0x74, 0x9d, 0x74, 0xc5, 0x6a, 0x5e, 0x13, 0xf0, 0x01, 0xdc, 0xcd, 0xd0, 0xa6, 0x46,
-	0x56, 0x4e, 0x6a, 0x75, 0x3d, 0xb4, 0x9d, 0xba, 0xc1, 0xdc, 0x6e, 0x61, 0xf6, 0xa1, 0xab, 0x2f,
-	0x14, 0x1a, 0xda, 0xb7, 0x97, 0x34, 0xc1, 0x06, 0x62, 0xf7, 0x1f, 0xc4, 0x7b, 0x5f, 0x2e, 0x0f,
-	0x03, 0x8f, 0xf9, 0xc7, 0xa3, 0xbe, 0x86, 0xfb, 0xef, 0x6a, 0x54, 0x04, 0x79, 0xa2, 0x67, 0x2a,
-	0x6b, 0x2f, 0xcf, 0x6e, 0x2f, 0xbf, 0x62, 0xd8, 0x5a, 0x33, 0x0c, 0xdf, 0xc0, 0xfe, 0x4d, 0xfd,
-	0x99, 0x9a, 0xfe, 0x7f, 0x87, 0x93, 0xf4, 0xe7, 0x22, 0x62, 0x57, 0x8b, 0x88, 0xfd, 0x5e, 0x44,
-	0xec, 0xeb, 0x32, 0x0a, 0xae, 0x96, 0x51, 0xf0, 0x6b, 0x19, 0x05, 0xf0, 0x50, 0xd2, 0xed, 0x6d,
-	0x9c, 0xe7, 0x98, 0x7d, 0x7c, 0x9e, 0x4b, 0x77, 0x3e, 0x9b, 0x8e, 0x52, 0x5d, 0xc6, 0x6b, 0xc3,
-	0x33, 0xa9, 0x5b, 0x51, 0x3c, 0x6f, 0xce, 0xdd, 0x7d, 0xae, 0xd0, 0x4e, 0x77, 0xe8, 0x86, 0x5f,
-	0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x10, 0x3c, 0x3a, 0x83, 0x0e, 0x03, 0x00, 0x00,
+func (m *EventNameIssuancePolicySet) Reset()         { *m = EventNameIssuancePolicySet{} }
+func (m *EventNameIssuancePolicySet) String() string { return proto.CompactTextString(m) }
+func (*EventNameIssuancePolicySet) ProtoMessage()    {}
+func (*EventNameIssuancePolicySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{9}
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameIssuancePolicySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameIssuancePolicySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameIssuancePolicySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventNameIssuancePolicySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameIssuancePolicySet.Merge(m, src)
+}
+func (m *EventNameIssuancePolicySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameIssuancePolicySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameIssuancePolicySet.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowUnrestrictedNames {
-		i--
-		if m.AllowUnrestrictedNames {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MaxNameLevels != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MaxNameLevels))
-		i--
-		dAtA[i] = 0x18
+var xxx_messageInfo_EventNameIssuancePolicySet proto.InternalMessageInfo
+
+func (m *EventNameIssuancePolicySet) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.MinSegmentLength != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MinSegmentLength))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *EventNameIssuancePolicySet) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.MaxSegmentLength != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MaxSegmentLength))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *EventNameIssuancePolicySet) GetIssuancePolicy() string {
+	if m != nil {
+		return m.IssuancePolicy
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *NameRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameIssuancePolicySet) GetIssuers() []string {
+	if m != nil {
+		return m.Issuers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *NameRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Event emitted when a name lease is renewed.
+type EventNameLeaseRenewed struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Expiration string `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
-func (m *NameRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Restricted {
-		i--
-		if m.Restricted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventNameLeaseRenewed) Reset()         { *m = EventNameLeaseRenewed{} }
+func (m *EventNameLeaseRenewed) String() string { return proto.CompactTextString(m) }
+func (*EventNameLeaseRenewed) ProtoMessage()    {}
+func (*EventNameLeaseRenewed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{10}
+}
+func (m *EventNameLeaseRenewed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameLeaseRenewed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameLeaseRenewed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventNameLeaseRenewed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameLeaseRenewed.Merge(m, src)
+}
+func (m *EventNameLeaseRenewed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameLeaseRenewed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameLeaseRenewed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameLeaseRenewed proto.InternalMessageInfo
+
+func (m *EventNameLeaseRenewed) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventNameLeaseRenewed) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameLeaseRenewed) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreateRootNameProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Event emitted when the verification of an external domain is requested.
+type EventDomainVerificationRequested struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner     string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Challenge string `protobuf:"bytes,3,opt,name=challenge,proto3" json:"challenge,omitempty"`
 }
 
-func (m *CreateRootNameProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Restricted {
-		i--
-		if m.Restricted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventDomainVerificationRequested) Reset()         { *m = EventDomainVerificationRequested{} }
+func (m *EventDomainVerificationRequested) String() string { return proto.CompactTextString(m) }
+func (*EventDomainVerificationRequested) ProtoMessage()    {}
+func (*EventDomainVerificationRequested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{11}
+}
+func (m *EventDomainVerificationRequested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDomainVerificationRequested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDomainVerificationRequested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventDomainVerificationRequested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDomainVerificationRequested.Merge(m, src)
+}
+func (m *EventDomainVerificationRequested) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDomainVerificationRequested) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDomainVerificationRequested.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDomainVerificationRequested proto.InternalMessageInfo
+
+func (m *EventDomainVerificationRequested) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventDomainVerificationRequested) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventDomainVerificationRequested) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventNameBound) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// Event emitted when the verifier attests to the verification of an external domain.
+type EventDomainVerificationAttested struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner    string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Verifier string `protobuf:"bytes,3,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	Status   string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *EventDomainVerificationAttested) Reset()         { *m = EventDomainVerificationAttested{} }
+func (m *EventDomainVerificationAttested) String() string { return proto.CompactTextString(m) }
+func (*EventDomainVerificationAttested) ProtoMessage()    {}
+func (*EventDomainVerificationAttested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{12}
+}
+func (m *EventDomainVerificationAttested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDomainVerificationAttested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDomainVerificationAttested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventDomainVerificationAttested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDomainVerificationAttested.Merge(m, src)
+}
+func (m *EventDomainVerificationAttested) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDomainVerificationAttested) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDomainVerificationAttested.DiscardUnknown(m)
 }
 
-func (m *EventNameBound) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventDomainVerificationAttested proto.InternalMessageInfo
+
+func (m *EventDomainVerificationAttested) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *EventNameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventDomainVerificationAttested) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventDomainVerificationAttested) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventNameUnbound) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDomainVerificationAttested) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventNameUnbound) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Event emitted when a deposit is paid to bind a name.
+type EventNameDepositPaid struct {
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Depositor   string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount      string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ReleaseTime string `protobuf:"bytes,4,opt,name=release_time,json=releaseTime,proto3" json:"release_time,omitempty"`
 }
 
-func (m *EventNameUnbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventNameDepositPaid) Reset()         { *m = EventNameDepositPaid{} }
+func (m *EventNameDepositPaid) String() string { return proto.CompactTextString(m) }
+func (*EventNameDepositPaid) ProtoMessage()    {}
+func (*EventNameDepositPaid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{13}
+}
+func (m *EventNameDepositPaid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameDepositPaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameDepositPaid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventNameDepositPaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameDepositPaid.Merge(m, src)
+}
+func (m *EventNameDepositPaid) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameDepositPaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameDepositPaid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameDepositPaid proto.InternalMessageInfo
+
+func (m *EventNameDepositPaid) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintName(dAtA []byte, offset int, v uint64) int {
-	offset -= sovName(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventNameDepositPaid) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventNameDepositPaid) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventNameDepositPaid) GetReleaseTime() string {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return ""
+}
+
+// Event emitted when a name deposit is returned to its depositor.
+type EventNameDepositRefunded struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount    string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventNameDepositRefunded) Reset()         { *m = EventNameDepositRefunded{} }
+func (m *EventNameDepositRefunded) String() string { return proto.CompactTextString(m) }
+func (*EventNameDepositRefunded) ProtoMessage()    {}
+func (*EventNameDepositRefunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{14}
+}
+func (m *EventNameDepositRefunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameDepositRefunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameDepositRefunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameDepositRefunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameDepositRefunded.Merge(m, src)
+}
+func (m *EventNameDepositRefunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameDepositRefunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameDepositRefunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameDepositRefunded proto.InternalMessageInfo
+
+func (m *EventNameDepositRefunded) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNameDepositRefunded) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *EventNameDepositRefunded) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// Event emitted when a name deposit is claimed by governance.
+type EventNameDepositClaimed struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventNameDepositClaimed) Reset()         { *m = EventNameDepositClaimed{} }
+func (m *EventNameDepositClaimed) String() string { return proto.CompactTextString(m) }
+func (*EventNameDepositClaimed) ProtoMessage()    {}
+func (*EventNameDepositClaimed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{15}
+}
+func (m *EventNameDepositClaimed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameDepositClaimed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameDepositClaimed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameDepositClaimed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameDepositClaimed.Merge(m, src)
+}
+func (m *EventNameDepositClaimed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameDepositClaimed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameDepositClaimed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameDepositClaimed proto.InternalMessageInfo
+
+func (m *EventNameDepositClaimed) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNameDepositClaimed) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *EventNameDepositClaimed) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventNameDepositClaimed) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("provenance.name.v1.NameIssuancePolicy", NameIssuancePolicy_name, NameIssuancePolicy_value)
+	proto.RegisterEnum("provenance.name.v1.DomainVerificationStatus", DomainVerificationStatus_name, DomainVerificationStatus_value)
+	proto.RegisterType((*Params)(nil), "provenance.name.v1.Params")
+	proto.RegisterType((*NameRecord)(nil), "provenance.name.v1.NameRecord")
+	proto.RegisterType((*NameLease)(nil), "provenance.name.v1.NameLease")
+	proto.RegisterType((*DomainVerification)(nil), "provenance.name.v1.DomainVerification")
+	proto.RegisterType((*NameDeposit)(nil), "provenance.name.v1.NameDeposit")
+	proto.RegisterType((*CreateRootNameProposal)(nil), "provenance.name.v1.CreateRootNameProposal")
+	proto.RegisterType((*ClaimNameDepositProposal)(nil), "provenance.name.v1.ClaimNameDepositProposal")
+	proto.RegisterType((*EventNameBound)(nil), "provenance.name.v1.EventNameBound")
+	proto.RegisterType((*EventNameUnbound)(nil), "provenance.name.v1.EventNameUnbound")
+	proto.RegisterType((*EventNameIssuancePolicySet)(nil), "provenance.name.v1.EventNameIssuancePolicySet")
+	proto.RegisterType((*EventNameLeaseRenewed)(nil), "provenance.name.v1.EventNameLeaseRenewed")
+	proto.RegisterType((*EventDomainVerificationRequested)(nil), "provenance.name.v1.EventDomainVerificationRequested")
+	proto.RegisterType((*EventDomainVerificationAttested)(nil), "provenance.name.v1.EventDomainVerificationAttested")
+	proto.RegisterType((*EventNameDepositPaid)(nil), "provenance.name.v1.EventNameDepositPaid")
+	proto.RegisterType((*EventNameDepositRefunded)(nil), "provenance.name.v1.EventNameDepositRefunded")
+	proto.RegisterType((*EventNameDepositClaimed)(nil), "provenance.name.v1.EventNameDepositClaimed")
+}
+
+func init() { proto.RegisterFile("provenance/name/v1/name.proto", fileDescriptor_a314256905bb00ec) }
+
+var fileDescriptor_a314256905bb00ec = []byte{
+	// 1250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x13, 0x15, 0x2d, 0xd9, 0x31, 0xc7, 0x89, 0xad, 0x6c, 0x62, 0x87, 0x31, 0x12, 0x49, 0x3f, 0xe6,
+	0x07, 0xc7, 0x30, 0x52, 0xaa, 0x71, 0x81, 0xb6, 0xe8, 0xa1, 0xa8, 0x2c, 0x31, 0x89, 0x0a, 0x45,
+	0x12, 0x28, 0x3b, 0x41, 0x7a, 0x21, 0x56, 0xe4, 0x5a, 0xde, 0x82, 0xe4, 0xaa, 0x5c, 0xca, 0x71,
+	0x4e, 0x45, 0xd1, 0x4b, 0x2f, 0x05, 0x82, 0xf6, 0xd2, 0x63, 0xd0, 0x0f, 0x52, 0xb4, 0xb7, 0x00,
+	0xbd, 0xe4, 0xd8, 0x4b, 0xff, 0x20, 0xb9, 0xf4, 0x63, 0x14, 0xbb, 0xa4, 0x24, 0xd2, 0x56, 0xd2,
+	0x38, 0x48, 0x4f, 0xd6, 0xce, 0xbc, 0xd9, 0x99, 0x7d, 0xf3, 0x66, 0x08, 0xc3, 0xd5, 0x61, 0xc8,
+	0x0e, 0x49, 0x80, 0x03, 0x87, 0x54, 0x03, 0xec, 0x93, 0xea, 0xe1, 0x4d, 0xf9, 0xd7, 0x18, 0x86,
+	0x2c, 0x62, 0x08, 0x4d, 0xdd, 0x86, 0x34, 0x1f, 0xde, 0x5c, 0x2f, 0x39, 0x8c, 0xfb, 0x8c, 0x57,
+	0xfb, 0x98, 0x0b, 0x78, 0x9f, 0x44, 0xf8, 0x66, 0xd5, 0x61, 0x34, 0x88, 0x63, 0xd6, 0x2f, 0x0e,
+	0xd8, 0x80, 0xc9, 0x9f, 0x55, 0xf1, 0x2b, 0xb1, 0x96, 0x07, 0x8c, 0x0d, 0x3c, 0x52, 0x95, 0xa7,
+	0xfe, 0x68, 0xbf, 0x1a, 0x51, 0x9f, 0xf0, 0x08, 0xfb, 0xc3, 0x18, 0xa0, 0xff, 0x5e, 0x80, 0x85,
+	0x2e, 0x0e, 0xb1, 0xcf, 0xd1, 0x0d, 0x40, 0x3e, 0x3e, 0xb2, 0x39, 0x19, 0xf8, 0x24, 0x88, 0x6c,
+	0x8f, 0x04, 0x83, 0xe8, 0x40, 0x53, 0x2a, 0xca, 0xe6, 0x39, 0xab, 0xe8, 0xe3, 0xa3, 0x5e, 0xec,
+	0x68, 0x49, 0xbb, 0x44, 0xd3, 0xe0, 0x38, 0x7a, 0x2e, 0x41, 0xd3, 0x20, 0x8b, 0xde, 0x80, 0x15,
+	0x71, 0xb7, 0x78, 0x8c, 0xed, 0x91, 0x43, 0xe2, 0x71, 0x2d, 0x2f, 0xa1, 0xe7, 0x7c, 0x7c, 0xd4,
+	0xc6, 0x3e, 0x69, 0x49, 0x23, 0xfa, 0x10, 0x34, 0xec, 0x79, 0xec, 0xa1, 0x3d, 0x0a, 0x42, 0xc2,
+	0xa3, 0x90, 0x3a, 0x11, 0x71, 0x65, 0x18, 0xd7, 0x0a, 0x15, 0x65, 0x73, 0xd1, 0x5a, 0x93, 0xfe,
+	0xbd, 0x94, 0x5b, 0x84, 0x73, 0xb4, 0x0d, 0xab, 0x2e, 0xd9, 0xc7, 0x23, 0x4f, 0xd4, 0x82, 0x39,
+	0xb1, 0x39, 0x71, 0x58, 0xe0, 0x72, 0x6d, 0xbe, 0xa2, 0x6c, 0x16, 0xac, 0x0b, 0x89, 0xb3, 0x25,
+	0x7c, 0xbd, 0xd8, 0x85, 0xb6, 0xe0, 0xbc, 0xa8, 0x2a, 0x8b, 0x5f, 0x90, 0x78, 0x51, 0x6e, 0x06,
+	0x6b, 0xc0, 0x85, 0x18, 0x37, 0x08, 0xb1, 0x33, 0x45, 0x9f, 0x91, 0xe8, 0xf3, 0xd2, 0x75, 0x5b,
+	0x78, 0x52, 0x77, 0xbb, 0xcc, 0xc7, 0x34, 0x88, 0x1f, 0x1d, 0x92, 0x01, 0x39, 0xd2, 0x16, 0x2b,
+	0xca, 0xa6, 0x6a, 0xad, 0xc4, 0x0e, 0x51, 0xb7, 0x25, 0xcc, 0xe8, 0x3a, 0x24, 0x26, 0xfb, 0x90,
+	0x84, 0x74, 0x9f, 0x92, 0x50, 0x53, 0x25, 0x72, 0x39, 0x36, 0xdf, 0x4b, 0xac, 0xa2, 0x88, 0x31,
+	0x3d, 0xd4, 0x61, 0x2e, 0x49, 0x98, 0x01, 0xc9, 0xcc, 0xf9, 0x84, 0x19, 0xe9, 0x89, 0x49, 0xb9,
+	0x03, 0x2b, 0x7d, 0x1a, 0xb8, 0x34, 0x18, 0xd8, 0x2e, 0x19, 0x32, 0x4e, 0x23, 0x6d, 0xa9, 0xa2,
+	0x6c, 0x2e, 0x6d, 0x5f, 0x36, 0x62, 0x39, 0x19, 0x42, 0x4e, 0x46, 0x22, 0x27, 0xa3, 0xce, 0x68,
+	0xb0, 0x53, 0x78, 0xfa, 0x47, 0x39, 0x67, 0x2d, 0x27, 0x71, 0x8d, 0x38, 0x0c, 0xbd, 0x0f, 0x97,
+	0x92, 0x1b, 0xec, 0x03, 0xe6, 0xc9, 0x1b, 0xc7, 0x14, 0x9c, 0x95, 0x14, 0xac, 0x26, 0xee, 0x3b,
+	0xb1, 0x37, 0xa1, 0x41, 0xff, 0x55, 0x01, 0x88, 0x1f, 0xea, 0xb0, 0xd0, 0x45, 0x08, 0x0a, 0xa2,
+	0x64, 0xa9, 0x2a, 0xd5, 0x92, 0xbf, 0x91, 0x06, 0x67, 0xb0, 0xeb, 0x86, 0x84, 0x73, 0x29, 0x1f,
+	0xd5, 0x1a, 0x1f, 0x51, 0x09, 0x60, 0xda, 0x66, 0x29, 0x98, 0x45, 0x2b, 0x65, 0x41, 0x1d, 0x58,
+	0xa1, 0x9c, 0x8f, 0xc4, 0x9c, 0xd8, 0x43, 0xe6, 0x51, 0xe7, 0x91, 0x14, 0xc9, 0xf2, 0xf6, 0x86,
+	0x71, 0x72, 0x82, 0x0c, 0x51, 0x46, 0x33, 0x81, 0x77, 0x25, 0xda, 0x5a, 0xa6, 0x99, 0xb3, 0x28,
+	0x45, 0x58, 0x48, 0x28, 0x64, 0x93, 0x17, 0xa5, 0x24, 0xc7, 0x8f, 0x0a, 0x3f, 0x3c, 0x29, 0xe7,
+	0x74, 0x02, 0x6a, 0x2c, 0x56, 0xcc, 0xc9, 0xcc, 0xb7, 0x34, 0x00, 0xc8, 0xd1, 0x90, 0x86, 0x38,
+	0xa2, 0x2c, 0x90, 0xcf, 0x59, 0xda, 0x5e, 0x37, 0xe2, 0x21, 0x34, 0xc6, 0x43, 0x68, 0xec, 0x8e,
+	0x87, 0x70, 0x67, 0x51, 0x90, 0xfd, 0xf8, 0xcf, 0xb2, 0x62, 0xa5, 0xe2, 0xf4, 0x9f, 0x14, 0x40,
+	0x8d, 0x54, 0xe7, 0x1d, 0x69, 0x9e, 0x99, 0xf0, 0x22, 0xcc, 0xb3, 0x87, 0x01, 0x09, 0x13, 0xea,
+	0xe2, 0x03, 0xba, 0x02, 0xaa, 0x73, 0x80, 0x3d, 0x31, 0x94, 0x44, 0xf2, 0xa6, 0x5a, 0x53, 0x03,
+	0x6a, 0xc0, 0x02, 0x8f, 0x70, 0x34, 0xe2, 0x09, 0x5b, 0x37, 0x66, 0xb1, 0x75, 0x32, 0x7f, 0x4f,
+	0xc6, 0x58, 0x49, 0x2c, 0x5a, 0x87, 0xc5, 0x89, 0x5a, 0xe7, 0x65, 0x8a, 0xc9, 0x59, 0xff, 0x45,
+	0x81, 0x25, 0x41, 0xd4, 0x58, 0x3d, 0xb3, 0x2a, 0xbf, 0x02, 0x6a, 0x22, 0x19, 0x36, 0xae, 0x7e,
+	0x6a, 0x40, 0x1f, 0xc0, 0x02, 0xf6, 0xd9, 0x28, 0x88, 0xb4, 0xfc, 0xeb, 0x09, 0x36, 0x81, 0xa3,
+	0xdb, 0x70, 0x36, 0x24, 0xf1, 0xa4, 0x8a, 0x5d, 0xa7, 0x15, 0x4e, 0xd1, 0x83, 0xa5, 0x24, 0x52,
+	0xf8, 0xf4, 0xef, 0xe7, 0x60, 0xad, 0x1e, 0x12, 0x1c, 0x11, 0x8b, 0xb1, 0x48, 0xbc, 0xa6, 0x1b,
+	0xb2, 0x21, 0xe3, 0xd8, 0x13, 0xa4, 0x47, 0x34, 0xf2, 0xc6, 0xef, 0x89, 0x0f, 0xa8, 0x02, 0x4b,
+	0x2e, 0xe1, 0x4e, 0x48, 0x87, 0x93, 0xe6, 0xab, 0x56, 0xda, 0x34, 0xa1, 0x21, 0x3f, 0xab, 0x81,
+	0x85, 0x74, 0x03, 0xb3, 0xca, 0x9f, 0x7f, 0x1d, 0xe5, 0x2f, 0xbc, 0x2d, 0xe5, 0x9f, 0xc9, 0x2a,
+	0xff, 0xec, 0x37, 0x4f, 0xca, 0x39, 0xa1, 0xfe, 0xbf, 0xc5, 0x04, 0x7c, 0xa7, 0x80, 0x56, 0xf7,
+	0x30, 0xf5, 0x53, 0xed, 0xfd, 0x4f, 0x78, 0xb9, 0x02, 0x6a, 0x48, 0x1c, 0x3a, 0xa4, 0x24, 0x88,
+	0x12, 0x6e, 0xa6, 0x86, 0x63, 0x45, 0x7d, 0x0c, 0xcb, 0xe6, 0x21, 0x09, 0x64, 0x93, 0x76, 0xd8,
+	0x28, 0x70, 0xd3, 0x3b, 0x45, 0xc9, 0xee, 0x94, 0x71, 0xae, 0xb9, 0x69, 0x2e, 0xfd, 0x13, 0x28,
+	0x4e, 0xe2, 0xf7, 0x82, 0xfe, 0x1b, 0xdc, 0xf0, 0xad, 0x02, 0xeb, 0x93, 0x2b, 0xb2, 0x54, 0xf7,
+	0x48, 0x74, 0xca, 0xb5, 0x77, 0xfd, 0x64, 0x73, 0x63, 0x66, 0x5e, 0xd1, 0xb4, 0x42, 0xa6, 0x69,
+	0x3a, 0x81, 0xd5, 0x49, 0x39, 0x72, 0x5b, 0x59, 0x24, 0x20, 0x0f, 0xc9, 0x29, 0x9f, 0x25, 0x64,
+	0x98, 0x5a, 0x67, 0x71, 0x11, 0xe9, 0x45, 0xf5, 0x39, 0x54, 0x64, 0x9a, 0x93, 0xcb, 0xc2, 0x22,
+	0x5f, 0x8c, 0x08, 0x17, 0x52, 0x7d, 0x4b, 0x5b, 0x4b, 0xff, 0x12, 0xca, 0x2f, 0xc9, 0x55, 0x8b,
+	0xa2, 0xd3, 0xa6, 0x4a, 0x2f, 0xaf, 0x7c, 0x76, 0x79, 0xa1, 0xb5, 0xcc, 0x7a, 0x54, 0xc7, 0x0b,
+	0x4f, 0xff, 0x5a, 0x81, 0x8b, 0x13, 0x52, 0xc7, 0xd2, 0xc7, 0xd4, 0x7d, 0x83, 0xed, 0xb6, 0x96,
+	0xd9, 0x6e, 0xea, 0x64, 0x79, 0xfd, 0x6f, 0xc6, 0xf2, 0x52, 0xb3, 0x6b, 0xc9, 0x05, 0xed, 0x78,
+	0x11, 0x16, 0xd9, 0x1f, 0x05, 0x2e, 0x79, 0x8b, 0x85, 0xe8, 0x5f, 0x29, 0x70, 0xe9, 0x78, 0x1a,
+	0x39, 0xf6, 0x6f, 0x94, 0x25, 0x33, 0xcb, 0xf9, 0x63, 0xb3, 0x9c, 0xaa, 0xa1, 0x90, 0xae, 0x61,
+	0xeb, 0x47, 0x05, 0xd0, 0xc9, 0x71, 0x42, 0xff, 0x87, 0x4a, 0xbb, 0x76, 0xd7, 0xb4, 0x9b, 0xbd,
+	0xde, 0x5e, 0xad, 0x5d, 0x37, 0xed, 0x6e, 0xa7, 0xd5, 0xac, 0x3f, 0xb0, 0xf7, 0xda, 0xbd, 0xae,
+	0x59, 0x6f, 0xde, 0x6a, 0x9a, 0x8d, 0x62, 0x0e, 0x5d, 0x85, 0xcb, 0x33, 0x51, 0x9d, 0xae, 0xd9,
+	0x2e, 0x2a, 0xe8, 0x1a, 0x94, 0x67, 0xbb, 0xef, 0xb7, 0x4d, 0xcb, 0xee, 0xb4, 0x5b, 0x0f, 0x8a,
+	0x73, 0x48, 0x87, 0xd2, 0x4c, 0x50, 0xad, 0xd5, 0xea, 0xdc, 0x6f, 0x35, 0x7b, 0xbb, 0xc5, 0xfc,
+	0xd6, 0xcf, 0x0a, 0x68, 0x2f, 0xfb, 0x54, 0xa2, 0x2d, 0xd8, 0x68, 0x74, 0xee, 0xd6, 0x9a, 0x6d,
+	0xfb, 0x9e, 0x69, 0x35, 0x6f, 0x35, 0xeb, 0xb5, 0xdd, 0x66, 0xa7, 0x6d, 0xf7, 0x76, 0x6b, 0xbb,
+	0x7b, 0xbd, 0x63, 0x05, 0x6f, 0x80, 0xfe, 0x0a, 0x6c, 0xd7, 0x6c, 0x37, 0x9a, 0xed, 0xdb, 0x45,
+	0x05, 0x5d, 0x87, 0x6b, 0xaf, 0xc0, 0xc5, 0x36, 0xb3, 0x51, 0x9c, 0xfb, 0x17, 0xa0, 0x65, 0x7e,
+	0x6a, 0xd6, 0x77, 0xcd, 0x46, 0x31, 0xbf, 0xe3, 0x3c, 0x7d, 0x5e, 0x52, 0x9e, 0x3d, 0x2f, 0x29,
+	0x7f, 0x3d, 0x2f, 0x29, 0x8f, 0x5f, 0x94, 0x72, 0xcf, 0x5e, 0x94, 0x72, 0xbf, 0xbd, 0x28, 0xe5,
+	0x60, 0x95, 0xb2, 0x19, 0x9f, 0x93, 0xae, 0xf2, 0xd9, 0xbb, 0x03, 0x1a, 0x1d, 0x8c, 0xfa, 0x86,
+	0xc3, 0xfc, 0xea, 0x14, 0xf0, 0x0e, 0x65, 0xa9, 0x53, 0xf5, 0x28, 0xfe, 0xd7, 0x26, 0x7a, 0x34,
+	0x24, 0xbc, 0xbf, 0x20, 0x3f, 0xbc, 0xef, 0xfd, 0x33, 0x00, 0xa1, 0xb2, 0xd1, 0x06, 0xfa, 0x0c,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxSegmentLength != 0 {
-		n += 1 + sovName(uint64(m.MaxSegmentLength))
+	if m.DepositHoldingSeconds != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.DepositHoldingSeconds))
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.MinSegmentLength != 0 {
-		n += 1 + sovName(uint64(m.MinSegmentLength))
+	{
+		size, err := m.BindingDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintName(dAtA, i, uint64(size))
 	}
-	if m.MaxNameLevels != 0 {
-		n += 1 + sovName(uint64(m.MaxNameLevels))
+	i--
+	dAtA[i] = 0x5a
+	if m.AllowUnicodeNames {
+		i--
+		if m.AllowUnicodeNames {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.DomainVerifier) > 0 {
+		i -= len(m.DomainVerifier)
+		copy(dAtA[i:], m.DomainVerifier)
+		i = encodeVarintName(dAtA, i, uint64(len(m.DomainVerifier)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.DomainNameRegex) > 0 {
+		i -= len(m.DomainNameRegex)
+		copy(dAtA[i:], m.DomainNameRegex)
+		i = encodeVarintName(dAtA, i, uint64(len(m.DomainNameRegex)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.LeaseGraceSeconds != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.LeaseGraceSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxLeaseSeconds != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxLeaseSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DefaultLeaseSeconds != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.DefaultLeaseSeconds))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.AllowUnrestrictedNames {
-		n += 2
+		i--
+		if m.AllowUnrestrictedNames {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if m.MaxNameLevels != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxNameLevels))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinSegmentLength != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MinSegmentLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxSegmentLength != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxSegmentLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *NameRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NameRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NameRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.Issuers) > 0 {
+		for iNdEx := len(m.Issuers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Issuers[iNdEx])
+			copy(dAtA[i:], m.Issuers[iNdEx])
+			i = encodeVarintName(dAtA, i, uint64(len(m.Issuers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if m.IssuancePolicy != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.IssuancePolicy))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.Restricted {
-		n += 2
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NameLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintName(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NameDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReleaseTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintName(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintName(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRootNameProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateRootNameProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issuers) > 0 {
+		for iNdEx := len(m.Issuers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Issuers[iNdEx])
+			copy(dAtA[i:], m.Issuers[iNdEx])
+			i = encodeVarintName(dAtA, i, uint64(len(m.Issuers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.IssuancePolicy != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.IssuancePolicy))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimNameDepositProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimNameDepositProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimNameDepositProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameBound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameBound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameUnbound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameUnbound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameUnbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameIssuancePolicySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameIssuancePolicySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameIssuancePolicySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issuers) > 0 {
+		for iNdEx := len(m.Issuers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Issuers[iNdEx])
+			copy(dAtA[i:], m.Issuers[iNdEx])
+			i = encodeVarintName(dAtA, i, uint64(len(m.Issuers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.IssuancePolicy) > 0 {
+		i -= len(m.IssuancePolicy)
+		copy(dAtA[i:], m.IssuancePolicy)
+		i = encodeVarintName(dAtA, i, uint64(len(m.IssuancePolicy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameLeaseRenewed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameLeaseRenewed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameLeaseRenewed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDomainVerificationRequested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDomainVerificationRequested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDomainVerificationRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDomainVerificationAttested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDomainVerificationAttested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDomainVerificationAttested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameDepositPaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameDepositPaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameDepositPaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReleaseTime) > 0 {
+		i -= len(m.ReleaseTime)
+		copy(dAtA[i:], m.ReleaseTime)
+		i = encodeVarintName(dAtA, i, uint64(len(m.ReleaseTime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameDepositRefunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameDepositRefunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameDepositRefunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameDepositClaimed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameDepositClaimed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameDepositClaimed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintName(dAtA []byte, offset int, v uint64) int {
+	offset -= sovName(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSegmentLength != 0 {
+		n += 1 + sovName(uint64(m.MaxSegmentLength))
+	}
+	if m.MinSegmentLength != 0 {
+		n += 1 + sovName(uint64(m.MinSegmentLength))
+	}
+	if m.MaxNameLevels != 0 {
+		n += 1 + sovName(uint64(m.MaxNameLevels))
+	}
+	if m.AllowUnrestrictedNames {
+		n += 2
+	}
+	if m.DefaultLeaseSeconds != 0 {
+		n += 1 + sovName(uint64(m.DefaultLeaseSeconds))
+	}
+	if m.MaxLeaseSeconds != 0 {
+		n += 1 + sovName(uint64(m.MaxLeaseSeconds))
+	}
+	if m.LeaseGraceSeconds != 0 {
+		n += 1 + sovName(uint64(m.LeaseGraceSeconds))
+	}
+	l = len(m.DomainNameRegex)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.DomainVerifier)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.AllowUnicodeNames {
+		n += 2
+	}
+	l = m.BindingDeposit.Size()
+	n += 1 + l + sovName(uint64(l))
+	if m.DepositHoldingSeconds != 0 {
+		n += 1 + sovName(uint64(m.DepositHoldingSeconds))
+	}
+	return n
+}
+
+func (m *NameRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	if m.IssuancePolicy != 0 {
+		n += 1 + sovName(uint64(m.IssuancePolicy))
+	}
+	if len(m.Issuers) > 0 {
+		for _, s := range m.Issuers {
+			l = len(s)
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NameLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovName(uint64(l))
+	return n
+}
+
+func (m *DomainVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovName(uint64(m.Status))
+	}
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *NameDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovName(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ReleaseTime)
+	n += 1 + l + sovName(uint64(l))
+	return n
+}
+
+func (m *CreateRootNameProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	if m.IssuancePolicy != 0 {
+		n += 1 + sovName(uint64(m.IssuancePolicy))
+	}
+	if len(m.Issuers) > 0 {
+		for _, s := range m.Issuers {
+			l = len(s)
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClaimNameDepositProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameBound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameUnbound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameIssuancePolicySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.IssuancePolicy)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if len(m.Issuers) > 0 {
+		for _, s := range m.Issuers {
+			l = len(s)
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventNameLeaseRenewed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDomainVerificationRequested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDomainVerificationAttested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameDepositPaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.ReleaseTime)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameDepositRefunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameDepositClaimed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func sovName(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozName(x uint64) (n int) {
+	return sovName(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+			}
+			m.MaxSegmentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSegmentLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+			}
+			m.MinSegmentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSegmentLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+			}
+			m.MaxNameLevels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNameLevels |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUnrestrictedNames = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultLeaseSeconds", wireType)
+			}
+			m.DefaultLeaseSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultLeaseSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseSeconds", wireType)
+			}
+			m.MaxLeaseSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLeaseSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseGraceSeconds", wireType)
+			}
+			m.LeaseGraceSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseGraceSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainNameRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainNameRegex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainVerifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainVerifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnicodeNames", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUnicodeNames = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BindingDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositHoldingSeconds", wireType)
+			}
+			m.DepositHoldingSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositHoldingSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuancePolicy", wireType)
+			}
+			m.IssuancePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuancePolicy |= NameIssuancePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuers = append(m.Issuers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DomainVerificationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRootNameProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRootNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuancePolicy", wireType)
+			}
+			m.IssuancePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuancePolicy |= NameIssuancePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuers = append(m.Issuers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimNameDepositProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimNameDepositProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimNameDepositProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameBound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameBound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameUnbound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameUnbound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameIssuancePolicySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameIssuancePolicySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameIssuancePolicySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuancePolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuancePolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuers = append(m.Issuers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *CreateRootNameProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	if m.Restricted {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *EventNameBound) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+func (m *EventNameLeaseRenewed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameLeaseRenewed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameLeaseRenewed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventNameUnbound) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovName(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozName(x uint64) (n int) {
-	return sovName(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EventDomainVerificationRequested) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -723,17 +4562,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDomainVerificationRequested: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDomainVerificationRequested: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.MaxSegmentLength = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -743,16 +4582,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSegmentLength |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.MinSegmentLength = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -762,16 +4614,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinSegmentLength |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
 			}
-			m.MaxNameLevels = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -781,31 +4646,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNameLevels |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
 			}
-			m.AllowUnrestrictedNames = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -827,7 +4685,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NameRecord) Unmarshal(dAtA []byte) error {
+func (m *EventDomainVerificationAttested) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -850,10 +4708,10 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NameRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDomainVerificationAttested: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDomainVerificationAttested: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -890,7 +4748,7 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -918,13 +4776,13 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -934,12 +4792,56 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Restricted = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -961,7 +4863,7 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
+func (m *EventNameDepositPaid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -984,15 +4886,15 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRootNameProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameDepositPaid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRootNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameDepositPaid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1020,11 +4922,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1052,11 +4954,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1084,11 +4986,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1116,28 +5018,8 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.ReleaseTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1159,7 +5041,7 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameBound) Unmarshal(dAtA []byte) error {
+func (m *EventNameDepositRefunded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1182,15 +5064,15 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameBound: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameDepositRefunded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameDepositRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1218,11 +5100,11 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1250,7 +5132,39 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1273,7 +5187,7 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
+func (m *EventNameDepositClaimed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1296,15 +5210,15 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameUnbound: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameDepositClaimed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameUnbound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameDepositClaimed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1332,11 +5246,11 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1364,7 +5278,71 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -65,3 +65,73 @@ func (s *NameRecordTestSuite) TestNameRecordValidateBasic() {
 		})
 	}
 }
+
+func (s *NameRecordTestSuite) TestNameRecordIssuancePolicy() {
+	issuer := sdk.AccAddress("issuer______________").String()
+	other := sdk.AccAddress("other_______________").String()
+
+	nr := NewNameRecord("example", s.addr, false)
+	s.Require().Equal(NameIssuancePolicy_NAME_ISSUANCE_POLICY_OPEN, nr.EffectiveIssuancePolicy())
+	s.Require().True(nr.CanIssue(other))
+
+	nr = NewNameRecord("example", s.addr, true)
+	s.Require().Equal(NameIssuancePolicy_NAME_ISSUANCE_POLICY_OWNER_ONLY, nr.EffectiveIssuancePolicy())
+	s.Require().True(nr.CanIssue(s.addr.String()))
+	s.Require().False(nr.CanIssue(other))
+
+	nr.SetIssuancePolicy(NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST, []string{issuer})
+	s.Require().NoError(nr.ValidateBasic())
+	s.Require().True(nr.Restricted)
+	s.Require().True(nr.CanIssue(s.addr.String()))
+	s.Require().True(nr.CanIssue(issuer))
+	s.Require().False(nr.CanIssue(other))
+	s.Require().Equal(fmt.Sprintf("example: %s [allowlist: %s]", s.addr, issuer), nr.String())
+
+	nr.SetIssuancePolicy(NameIssuancePolicy_NAME_ISSUANCE_POLICY_OPEN, nil)
+	s.Require().False(nr.Restricted)
+	s.Require().True(nr.CanIssue(other))
+}
+
+func (s *NameRecordTestSuite) TestValidateIssuancePolicy() {
+	issuer := sdk.AccAddress("issuer______________").String()
+	cases := map[string]struct {
+		policy   NameIssuancePolicy
+		issuers  []string
+		errValue string
+	}{
+		"unspecified":          {NameIssuancePolicy_NAME_ISSUANCE_POLICY_UNSPECIFIED, nil, ""},
+		"open":                 {NameIssuancePolicy_NAME_ISSUANCE_POLICY_OPEN, nil, ""},
+		"allowlist":            {NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST, []string{issuer}, ""},
+		"unknown policy":       {NameIssuancePolicy(9), nil, "invalid name issuance policy: 9"},
+		"issuers without list": {NameIssuancePolicy_NAME_ISSUANCE_POLICY_OWNER_ONLY, []string{issuer}, "issuers can only be listed with the NAME_ISSUANCE_POLICY_ALLOWLIST policy"},
+		"allowlist no issuers": {NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST, nil, "the NAME_ISSUANCE_POLICY_ALLOWLIST policy requires at least one issuer"},
+		"invalid issuer":       {NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST, []string{"bad"}, "invalid issuer address bad: decoding bech32 failed: invalid bech32 string length 3"},
+		"duplicate issuer":     {NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST, []string{issuer, issuer}, "duplicate issuer address " + issuer},
+	}
+	for n, tc := range cases {
+		tc := tc
+		s.Run(n, func() {
+			err := ValidateIssuancePolicy(tc.policy, tc.issuers)
+			if len(tc.errValue) > 0 {
+				s.EqualError(err, tc.errValue)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *NameRecordTestSuite) TestParseNameIssuancePolicy() {
+	for in, want := range map[string]NameIssuancePolicy{
+		"open":                           NameIssuancePolicy_NAME_ISSUANCE_POLICY_OPEN,
+		"Owner-Only":                     NameIssuancePolicy_NAME_ISSUANCE_POLICY_OWNER_ONLY,
+		"allowlist":                      NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST,
+		"NAME_ISSUANCE_POLICY_ALLOWLIST": NameIssuancePolicy_NAME_ISSUANCE_POLICY_ALLOWLIST,
+	} {
+		policy, err := ParseNameIssuancePolicy(in)
+		s.Require().NoError(err, in)
+		s.Require().Equal(want, policy, in)
+	}
+	_, err := ParseNameIssuancePolicy("closed")
+	s.Require().EqualError(err, "invalid name issuance policy: closed")
+}
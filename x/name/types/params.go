@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"regexp"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -12,6 +14,16 @@ const (
 	DefaultMaxSegmentLength       = uint32(32)
 	DefaultMaxSegments            = uint32(16)
 	DefaultAllowUnrestrictedNames = true
+	DefaultDefaultLeaseSeconds    = uint64(0)
+	DefaultMaxLeaseSeconds        = uint64(0)
+	DefaultLeaseGraceSeconds      = uint64(0)
+	DefaultDomainNameRegex        = ""
+	DefaultDomainVerifier         = ""
+	DefaultAllowUnicodeNames      = false
+	DefaultDepositHoldingSeconds  = uint64(0)
+
+	// MaxLeaseSecondsLimit is the largest lease param value (about 100 years).
+	MaxLeaseSecondsLimit = uint64(100 * 365 * 24 * 60 * 60)
 )
 
 // Parameter store keys
@@ -24,8 +36,29 @@ var (
 	ParamStoreKeyMaxNameLevels = []byte("MaxNameLevels")
 	// determines if unrestricted name keys are allowed or not
 	ParamStoreKeyAllowUnrestrictedNames = []byte("AllowUnrestrictedNames")
+	// lease duration applied to bound names that do not request one, zero for names that do not expire
+	ParamStoreKeyDefaultLeaseSeconds = []byte("DefaultLeaseSeconds")
+	// maximum lease duration that a name may be bound or renewed for, zero for no maximum
+	ParamStoreKeyMaxLeaseSeconds = []byte("MaxLeaseSeconds")
+	// time after a lease expires before the name is unbound
+	ParamStoreKeyLeaseGraceSeconds = []byte("LeaseGraceSeconds")
+	// regex of names that are external domains and must be verified before being bound
+	ParamStoreKeyDomainNameRegex = []byte("DomainNameRegex")
+	// address of the verifier that attests to domain verifications
+	ParamStoreKeyDomainVerifier = []byte("DomainVerifier")
+	// determines if name segments can use unicode letters
+	ParamStoreKeyAllowUnicodeNames = []byte("AllowUnicodeNames")
+	// refundable deposit required to bind a name directly under an unrestricted root name
+	ParamStoreKeyBindingDeposit = []byte("BindingDeposit")
+	// time a binding deposit is held before it can be reclaimed or claimed by governance
+	ParamStoreKeyDepositHoldingSeconds = []byte("DepositHoldingSeconds")
 )
 
+// DefaultBindingDeposit returns the default binding deposit param, a zero coin that disables binding deposits.
+func DefaultBindingDeposit() sdk.Coin {
+	return sdk.Coin{Amount: sdk.ZeroInt()}
+}
+
 // ParamKeyTable for slashing module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -37,12 +70,28 @@ func NewParams(
 	minSegmentLength uint32,
 	maxNameLevels uint32,
 	allowUnrestrictedNames bool,
+	defaultLeaseSeconds uint64,
+	maxLeaseSeconds uint64,
+	leaseGraceSeconds uint64,
+	domainNameRegex string,
+	domainVerifier string,
+	allowUnicodeNames bool,
+	bindingDeposit sdk.Coin,
+	depositHoldingSeconds uint64,
 ) Params {
 	return Params{
 		MaxSegmentLength:       maxSegmentLength,
 		MinSegmentLength:       minSegmentLength,
 		MaxNameLevels:          maxNameLevels,
 		AllowUnrestrictedNames: allowUnrestrictedNames,
+		DefaultLeaseSeconds:    defaultLeaseSeconds,
+		MaxLeaseSeconds:        maxLeaseSeconds,
+		LeaseGraceSeconds:      leaseGraceSeconds,
+		DomainNameRegex:        domainNameRegex,
+		DomainVerifier:         domainVerifier,
+		AllowUnicodeNames:      allowUnicodeNames,
+		BindingDeposit:         bindingDeposit,
+		DepositHoldingSeconds:  depositHoldingSeconds,
 	}
 }
 
@@ -53,6 +102,14 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyMinSegmentLength, &p.MinSegmentLength, validateIntParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxNameLevels, &p.MaxNameLevels, validateIntParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyAllowUnrestrictedNames, &p.AllowUnrestrictedNames, validateAllowUnrestrictedNames),
+		paramtypes.NewParamSetPair(ParamStoreKeyDefaultLeaseSeconds, &p.DefaultLeaseSeconds, validateLeaseSecondsParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxLeaseSeconds, &p.MaxLeaseSeconds, validateLeaseSecondsParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyLeaseGraceSeconds, &p.LeaseGraceSeconds, validateLeaseSecondsParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyDomainNameRegex, &p.DomainNameRegex, validateDomainNameRegexParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyDomainVerifier, &p.DomainVerifier, validateDomainVerifierParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyAllowUnicodeNames, &p.AllowUnicodeNames, validateAllowUnicodeNames),
+		paramtypes.NewParamSetPair(ParamStoreKeyBindingDeposit, &p.BindingDeposit, validateBindingDepositParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyDepositHoldingSeconds, &p.DepositHoldingSeconds, validateLeaseSecondsParam),
 	}
 }
 
@@ -63,6 +120,14 @@ func DefaultParams() Params {
 		DefaultMinSegmentLength,
 		DefaultMaxSegments,
 		DefaultAllowUnrestrictedNames,
+		DefaultDefaultLeaseSeconds,
+		DefaultMaxLeaseSeconds,
+		DefaultLeaseGraceSeconds,
+		DefaultDomainNameRegex,
+		DefaultDomainVerifier,
+		DefaultAllowUnicodeNames,
+		DefaultBindingDeposit(),
+		DefaultDepositHoldingSeconds,
 	)
 }
 
@@ -98,6 +163,30 @@ func (p *Params) Equal(that interface{}) bool {
 	if p.MinSegmentLength != that1.MinSegmentLength {
 		return false
 	}
+	if p.DefaultLeaseSeconds != that1.DefaultLeaseSeconds {
+		return false
+	}
+	if p.MaxLeaseSeconds != that1.MaxLeaseSeconds {
+		return false
+	}
+	if p.LeaseGraceSeconds != that1.LeaseGraceSeconds {
+		return false
+	}
+	if p.DomainNameRegex != that1.DomainNameRegex {
+		return false
+	}
+	if p.DomainVerifier != that1.DomainVerifier {
+		return false
+	}
+	if p.AllowUnicodeNames != that1.AllowUnicodeNames {
+		return false
+	}
+	if p.BindingDeposit.Denom != that1.BindingDeposit.Denom || !coinAmount(p.BindingDeposit).Equal(coinAmount(that1.BindingDeposit)) {
+		return false
+	}
+	if p.DepositHoldingSeconds != that1.DepositHoldingSeconds {
+		return false
+	}
 
 	return true
 }
@@ -118,3 +207,70 @@ func validateAllowUnrestrictedNames(i interface{}) error {
 	}
 	return nil
 }
+
+func validateAllowUnicodeNames(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateLeaseSecondsParam(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > MaxLeaseSecondsLimit {
+		return fmt.Errorf("lease seconds must not exceed %d: %d", MaxLeaseSecondsLimit, v)
+	}
+	return nil
+}
+
+func validateBindingDepositParam(i interface{}) error {
+	deposit, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	deposit.Amount = coinAmount(deposit)
+	if deposit.Denom == "" && deposit.IsZero() {
+		return nil
+	}
+	if err := deposit.Validate(); err != nil {
+		return fmt.Errorf("invalid binding deposit: %w", err)
+	}
+	return nil
+}
+
+// coinAmount returns the amount of a coin, treating an unset amount as zero.
+func coinAmount(coin sdk.Coin) sdk.Int {
+	if coin.Amount.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return coin.Amount
+}
+
+func validateDomainNameRegexParam(i interface{}) error {
+	exp, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(exp) > 0 && (exp[0:1] == "^" || exp[len(exp)-1:] == "$") {
+		return fmt.Errorf("invalid parameter, domain name regex must not contain anchors ^,$")
+	}
+	_, err := regexp.Compile(fmt.Sprintf(`^%s$`, exp))
+	return err
+}
+
+func validateDomainVerifierParam(i interface{}) error {
+	verifier, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(verifier) > 0 {
+		if _, err := sdk.AccAddressFromBech32(verifier); err != nil {
+			return fmt.Errorf("invalid domain verifier: %w", err)
+		}
+	}
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestDefaultParams(t *testing.T) {
@@ -16,12 +18,28 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultMaxSegmentLength, p.MaxSegmentLength)
 	require.Equal(t, DefaultMaxSegments, p.MaxNameLevels)
 	require.Equal(t, DefaultAllowUnrestrictedNames, p.AllowUnrestrictedNames)
+	require.Equal(t, DefaultDefaultLeaseSeconds, p.DefaultLeaseSeconds)
+	require.Equal(t, DefaultMaxLeaseSeconds, p.MaxLeaseSeconds)
+	require.Equal(t, DefaultLeaseGraceSeconds, p.LeaseGraceSeconds)
+	require.Equal(t, DefaultDomainNameRegex, p.DomainNameRegex)
+	require.Equal(t, DefaultDomainVerifier, p.DomainVerifier)
+	require.Equal(t, DefaultAllowUnicodeNames, p.AllowUnicodeNames)
+	require.Equal(t, DefaultBindingDeposit(), p.BindingDeposit)
+	require.Equal(t, DefaultDepositHoldingSeconds, p.DepositHoldingSeconds)
 
-	require.True(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(1, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, 1, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, 1, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, false)))
+	require.True(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultDefaultLeaseSeconds, DefaultMaxLeaseSeconds, DefaultLeaseGraceSeconds, DefaultDomainNameRegex, DefaultDomainVerifier, DefaultAllowUnicodeNames, DefaultBindingDeposit(), DefaultDepositHoldingSeconds)))
+	require.False(t, p.Equal(NewParams(1, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultDefaultLeaseSeconds, DefaultMaxLeaseSeconds, DefaultLeaseGraceSeconds, DefaultDomainNameRegex, DefaultDomainVerifier, DefaultAllowUnicodeNames, DefaultBindingDeposit(), DefaultDepositHoldingSeconds)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, 1, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultDefaultLeaseSeconds, DefaultMaxLeaseSeconds, DefaultLeaseGraceSeconds, DefaultDomainNameRegex, DefaultDomainVerifier, DefaultAllowUnicodeNames, DefaultBindingDeposit(), DefaultDepositHoldingSeconds)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, 1, DefaultAllowUnrestrictedNames, DefaultDefaultLeaseSeconds, DefaultMaxLeaseSeconds, DefaultLeaseGraceSeconds, DefaultDomainNameRegex, DefaultDomainVerifier, DefaultAllowUnicodeNames, DefaultBindingDeposit(), DefaultDepositHoldingSeconds)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, false, DefaultDefaultLeaseSeconds, DefaultMaxLeaseSeconds, DefaultLeaseGraceSeconds, DefaultDomainNameRegex, DefaultDomainVerifier, DefaultAllowUnicodeNames, DefaultBindingDeposit(), DefaultDepositHoldingSeconds)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, 60, DefaultMaxLeaseSeconds, DefaultLeaseGraceSeconds, DefaultDomainNameRegex, DefaultDomainVerifier, DefaultAllowUnicodeNames, DefaultBindingDeposit(), DefaultDepositHoldingSeconds)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultDefaultLeaseSeconds, 60, DefaultLeaseGraceSeconds, DefaultDomainNameRegex, DefaultDomainVerifier, DefaultAllowUnicodeNames, DefaultBindingDeposit(), DefaultDepositHoldingSeconds)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultDefaultLeaseSeconds, DefaultMaxLeaseSeconds, 60, DefaultDomainNameRegex, DefaultDomainVerifier, DefaultAllowUnicodeNames, DefaultBindingDeposit(), DefaultDepositHoldingSeconds)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultDefaultLeaseSeconds, DefaultMaxLeaseSeconds, DefaultLeaseGraceSeconds, `[a-z]+\.com`, DefaultDomainVerifier, DefaultAllowUnicodeNames, DefaultBindingDeposit(), DefaultDepositHoldingSeconds)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultDefaultLeaseSeconds, DefaultMaxLeaseSeconds, DefaultLeaseGraceSeconds, DefaultDomainNameRegex, sdk.AccAddress("verifier").String(), DefaultAllowUnicodeNames, DefaultBindingDeposit(), DefaultDepositHoldingSeconds)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultDefaultLeaseSeconds, DefaultMaxLeaseSeconds, DefaultLeaseGraceSeconds, DefaultDomainNameRegex, DefaultDomainVerifier, true, DefaultBindingDeposit(), DefaultDepositHoldingSeconds)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultDefaultLeaseSeconds, DefaultMaxLeaseSeconds, DefaultLeaseGraceSeconds, DefaultDomainNameRegex, DefaultDomainVerifier, DefaultAllowUnicodeNames, sdk.NewInt64Coin("hash", 10), DefaultDepositHoldingSeconds)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultDefaultLeaseSeconds, DefaultMaxLeaseSeconds, DefaultLeaseGraceSeconds, DefaultDomainNameRegex, DefaultDomainVerifier, DefaultAllowUnicodeNames, DefaultBindingDeposit(), 60)))
 
 	var p2 *Params
 	require.True(t, p2.Equal(nil))
@@ -30,13 +48,13 @@ func TestDefaultParams(t *testing.T) {
 
 func TestParamString(t *testing.T) {
 	p := DefaultParams()
-	require.Equal(t, `max_segment_length:32 min_segment_length:2 max_name_levels:16 allow_unrestricted_names:true `, p.String())
+	require.Equal(t, `max_segment_length:32 min_segment_length:2 max_name_levels:16 allow_unrestricted_names:true binding_deposit:<amount:"0" > `, p.String())
 }
 
 func TestParamSetPairs(t *testing.T) {
 	p := DefaultParams()
 	pairs := p.ParamSetPairs()
-	require.Equal(t, 4, len(pairs))
+	require.Equal(t, 12, len(pairs))
 
 	for i := range pairs {
 		switch string(pairs[i].Key) {
@@ -55,6 +73,32 @@ func TestParamSetPairs(t *testing.T) {
 			require.Error(t, pairs[i].ValidatorFn("foo"))
 			require.Error(t, pairs[i].ValidatorFn(-1000))
 			require.NoError(t, pairs[i].ValidatorFn(uint32(1000)))
+		case string(ParamStoreKeyDefaultLeaseSeconds), string(ParamStoreKeyMaxLeaseSeconds), string(ParamStoreKeyLeaseGraceSeconds),
+			string(ParamStoreKeyDepositHoldingSeconds):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(MaxLeaseSecondsLimit+1))
+			require.NoError(t, pairs[i].ValidatorFn(uint64(86400)))
+		case string(ParamStoreKeyDomainNameRegex):
+			require.Error(t, pairs[i].ValidatorFn(1))
+			require.Error(t, pairs[i].ValidatorFn(`^[a-z]+\.com$`))
+			require.Error(t, pairs[i].ValidatorFn(`[a-z+\.com`))
+			require.NoError(t, pairs[i].ValidatorFn(""))
+			require.NoError(t, pairs[i].ValidatorFn(`([a-z0-9-]+\.)+(com|org|net)`))
+		case string(ParamStoreKeyDomainVerifier):
+			require.Error(t, pairs[i].ValidatorFn(1))
+			require.Error(t, pairs[i].ValidatorFn("not-an-address"))
+			require.NoError(t, pairs[i].ValidatorFn(""))
+			require.NoError(t, pairs[i].ValidatorFn(sdk.AccAddress("verifier").String()))
+		case string(ParamStoreKeyAllowUnicodeNames):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.NoError(t, pairs[i].ValidatorFn(true))
+		case string(ParamStoreKeyBindingDeposit):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(sdk.Coin{Denom: "1", Amount: sdk.NewInt(10)}))
+			require.Error(t, pairs[i].ValidatorFn(sdk.Coin{Denom: "hash", Amount: sdk.NewInt(-1)}))
+			require.NoError(t, pairs[i].ValidatorFn(DefaultBindingDeposit()))
+			require.NoError(t, pairs[i].ValidatorFn(sdk.Coin{}))
+			require.NoError(t, pairs[i].ValidatorFn(sdk.NewInt64Coin("hash", 10)))
 		default:
 			require.Fail(t, "unexpected param set pair")
 		}
@@ -11,14 +11,21 @@ import (
 const (
 	// ProposalTypeCreateRootName defines the type for a CreateRootNameProposal
 	ProposalTypeCreateRootName = "CreateRootName"
+	// ProposalTypeClaimNameDeposit defines the type for a ClaimNameDepositProposal
+	ProposalTypeClaimNameDeposit = "ClaimNameDeposit"
 )
 
 // Assert CreateRootNameProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = &CreateRootNameProposal{}
+var (
+	_ govtypes.Content = &CreateRootNameProposal{}
+	_ govtypes.Content = &ClaimNameDepositProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCreateRootName)
 	govtypes.RegisterProposalTypeCodec(&CreateRootNameProposal{}, "provenance/CreateRootNameProposal")
+	govtypes.RegisterProposalType(ProposalTypeClaimNameDeposit)
+	govtypes.RegisterProposalTypeCodec(&ClaimNameDepositProposal{}, "provenance/ClaimNameDepositProposal")
 }
 
 // NewCreateRootNameProposal create a new governance proposal request to create a root name
@@ -62,6 +69,9 @@ func (crnp CreateRootNameProposal) ValidateBasic() error {
 	if strings.Contains(crnp.Name, ".") {
 		return ErrNameContainsSegments
 	}
+	if err = ValidateIssuancePolicy(crnp.IssuancePolicy, crnp.Issuers); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -76,5 +86,64 @@ func (crnp CreateRootNameProposal) String() string {
   Name:        %s
   Restricted:  %v
 `, crnp.Title, crnp.Description, crnp.Owner, crnp.Name, crnp.Restricted))
+	if crnp.IssuancePolicy != NameIssuancePolicy_NAME_ISSUANCE_POLICY_UNSPECIFIED {
+		b.WriteString(fmt.Sprintf(`  Policy:      %s
+`, crnp.IssuancePolicy))
+	}
+	if len(crnp.Issuers) > 0 {
+		b.WriteString(fmt.Sprintf(`  Issuers:     %s
+`, strings.Join(crnp.Issuers, ", ")))
+	}
+	return b.String()
+}
+
+// NewClaimNameDepositProposal create a new governance proposal request to claim the binding deposit of a name
+//nolint:interfacer
+func NewClaimNameDepositProposal(title, description, name string, recipient sdk.AccAddress) *ClaimNameDepositProposal {
+	return &ClaimNameDepositProposal{
+		Title:       title,
+		Description: description,
+		Name:        name,
+		Recipient:   recipient.String(),
+	}
+}
+
+// GetTitle returns the title of a claim name deposit proposal.
+func (cndp ClaimNameDepositProposal) GetTitle() string { return cndp.Title }
+
+// GetDescription returns the description of a claim name deposit proposal.
+func (cndp ClaimNameDepositProposal) GetDescription() string { return cndp.Description }
+
+// ProposalRoute returns the routing key of a claim name deposit proposal.
+func (cndp ClaimNameDepositProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a claim name deposit proposal.
+func (cndp ClaimNameDepositProposal) ProposalType() string { return ProposalTypeClaimNameDeposit }
+
+// ValidateBasic runs basic stateless validity checks
+func (cndp ClaimNameDepositProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(cndp)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(cndp.Name) == "" {
+		return ErrInvalidLengthName
+	}
+	if _, err := sdk.AccAddressFromBech32(cndp.Recipient); err != nil {
+		return ErrInvalidAddress
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (cndp ClaimNameDepositProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Claim Name Deposit Proposal:
+  Title:       %s
+  Description: %s
+  Name:        %s
+  Recipient:   %s
+`, cndp.Title, cndp.Description, cndp.Name, cndp.Recipient))
 	return b.String()
 }
@@ -30,6 +30,26 @@ func TestParameterChangeProposal(t *testing.T) {
 `, crnp.String())
 }
 
+func TestClaimNameDepositProposal(t *testing.T) {
+	recipient := sdk.AccAddress("recipient")
+	cndp := NewClaimNameDepositProposal("test title", "test description", "squat.root", recipient)
+
+	require.Equal(t, RouterKey, cndp.ProposalRoute())
+	require.Equal(t, ProposalTypeClaimNameDeposit, cndp.ProposalType())
+	require.NoError(t, cndp.ValidateBasic())
+	require.Equal(t, fmt.Sprintf(`Claim Name Deposit Proposal:
+  Title:       test title
+  Description: test description
+  Name:        squat.root
+  Recipient:   %s
+`, recipient), cndp.String())
+
+	cndp.Recipient = ""
+	require.ErrorIs(t, cndp.ValidateBasic(), ErrInvalidAddress)
+	cndp = NewClaimNameDepositProposal("test title", "test description", " ", recipient)
+	require.ErrorIs(t, cndp.ValidateBasic(), ErrInvalidLengthName)
+}
+
 type IntegrationTestSuite struct {
 	suite.Suite
 }
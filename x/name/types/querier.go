@@ -17,6 +17,11 @@ type QueryNameResult struct {
 	Restricted bool   `json:"restricted"`
 }
 
+// NewQueryNameResult creates a query result from a name record.
+func NewQueryNameResult(record NameRecord) QueryNameResult {
+	return QueryNameResult{Name: record.Name, Address: record.Address, Restricted: record.Restricted}
+}
+
 // String implements fmt.Stringer
 func (r QueryNameResult) String() string {
 	return r.Name + "->" + r.Address // bech32 string
@@ -13,7 +13,7 @@ func TestQuerierNameResult(t *testing.T) {
 	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 	nr := NewNameRecord("example.name", addr, true)
 	// check that result can wrap a name record
-	qnr := QueryNameResult(nr)
+	qnr := NewQueryNameResult(nr)
 	require.NotNil(t, qnr)
 	require.Equal(t, qnr.Name, "example.name")
 	require.Equal(t, qnr.Address, addr.String())
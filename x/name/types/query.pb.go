@@ -280,6 +280,404 @@ func (m *QueryReverseLookupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryReverseLookupResponse proto.InternalMessageInfo
 
+// QuerySubtreeRequest is the request type for the Query/Subtree method.
+type QuerySubtreeRequest struct {
+	// root name of the subtree to return
+	Root string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySubtreeRequest) Reset()         { *m = QuerySubtreeRequest{} }
+func (m *QuerySubtreeRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubtreeRequest) ProtoMessage()    {}
+func (*QuerySubtreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{6}
+}
+func (m *QuerySubtreeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubtreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubtreeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubtreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubtreeRequest.Merge(m, src)
+}
+func (m *QuerySubtreeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubtreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubtreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubtreeRequest proto.InternalMessageInfo
+
+// QuerySubtreeResponse is the response type for the Query/Subtree method.
+type QuerySubtreeResponse struct {
+	// the name records at or below the root name, each name followed by the names below it
+	Records []NameRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySubtreeResponse) Reset()         { *m = QuerySubtreeResponse{} }
+func (m *QuerySubtreeResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubtreeResponse) ProtoMessage()    {}
+func (*QuerySubtreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{7}
+}
+func (m *QuerySubtreeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubtreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubtreeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubtreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubtreeResponse.Merge(m, src)
+}
+func (m *QuerySubtreeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubtreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubtreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubtreeResponse proto.InternalMessageInfo
+
+// QueryLeaseRequest is the request type for the Query/Lease method.
+type QueryLeaseRequest struct {
+	// name to find the lease for
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryLeaseRequest) Reset()         { *m = QueryLeaseRequest{} }
+func (m *QueryLeaseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaseRequest) ProtoMessage()    {}
+func (*QueryLeaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{8}
+}
+func (m *QueryLeaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLeaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaseRequest.Merge(m, src)
+}
+func (m *QueryLeaseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLeaseRequest proto.InternalMessageInfo
+
+// QueryLeaseResponse is the response type for the Query/Lease method.
+type QueryLeaseResponse struct {
+	// the lease of the name, empty if the name does not expire
+	Lease *NameLease `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"`
+}
+
+func (m *QueryLeaseResponse) Reset()         { *m = QueryLeaseResponse{} }
+func (m *QueryLeaseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaseResponse) ProtoMessage()    {}
+func (*QueryLeaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{9}
+}
+func (m *QueryLeaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLeaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaseResponse.Merge(m, src)
+}
+func (m *QueryLeaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLeaseResponse proto.InternalMessageInfo
+
+// QueryDomainVerificationRequest is the request type for the Query/DomainVerification method.
+type QueryDomainVerificationRequest struct {
+	// name of the external domain to find the verification for
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryDomainVerificationRequest) Reset()         { *m = QueryDomainVerificationRequest{} }
+func (m *QueryDomainVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainVerificationRequest) ProtoMessage()    {}
+func (*QueryDomainVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{10}
+}
+func (m *QueryDomainVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomainVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainVerificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDomainVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainVerificationRequest.Merge(m, src)
+}
+func (m *QueryDomainVerificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDomainVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDomainVerificationRequest proto.InternalMessageInfo
+
+// QueryDomainVerificationResponse is the response type for the Query/DomainVerification method.
+type QueryDomainVerificationResponse struct {
+	// the verification of the domain
+	Verification *DomainVerification `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification,omitempty"`
+}
+
+func (m *QueryDomainVerificationResponse) Reset()         { *m = QueryDomainVerificationResponse{} }
+func (m *QueryDomainVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainVerificationResponse) ProtoMessage()    {}
+func (*QueryDomainVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{11}
+}
+func (m *QueryDomainVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomainVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDomainVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainVerificationResponse.Merge(m, src)
+}
+func (m *QueryDomainVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDomainVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDomainVerificationResponse proto.InternalMessageInfo
+
+// QueryDomainVerificationsRequest is the request type for the Query/DomainVerifications method.
+type QueryDomainVerificationsRequest struct {
+	// status limits the results to verifications with this status, all verifications are included if unspecified
+	Status DomainVerificationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=provenance.name.v1.DomainVerificationStatus" json:"status,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDomainVerificationsRequest) Reset()         { *m = QueryDomainVerificationsRequest{} }
+func (m *QueryDomainVerificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainVerificationsRequest) ProtoMessage()    {}
+func (*QueryDomainVerificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{12}
+}
+func (m *QueryDomainVerificationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomainVerificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainVerificationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDomainVerificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainVerificationsRequest.Merge(m, src)
+}
+func (m *QueryDomainVerificationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDomainVerificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainVerificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDomainVerificationsRequest proto.InternalMessageInfo
+
+// QueryDomainVerificationsResponse is the response type for the Query/DomainVerifications method.
+type QueryDomainVerificationsResponse struct {
+	// the verifications of external domains
+	Verifications []DomainVerification `protobuf:"bytes,1,rep,name=verifications,proto3" json:"verifications"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDomainVerificationsResponse) Reset()         { *m = QueryDomainVerificationsResponse{} }
+func (m *QueryDomainVerificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainVerificationsResponse) ProtoMessage()    {}
+func (*QueryDomainVerificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{13}
+}
+func (m *QueryDomainVerificationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomainVerificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainVerificationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDomainVerificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainVerificationsResponse.Merge(m, src)
+}
+func (m *QueryDomainVerificationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDomainVerificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainVerificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDomainVerificationsResponse proto.InternalMessageInfo
+
+// QueryDepositRequest is the request type for the Query/Deposit method.
+type QueryDepositRequest struct {
+	// name to find the binding deposit for
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryDepositRequest) Reset()         { *m = QueryDepositRequest{} }
+func (m *QueryDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositRequest) ProtoMessage()    {}
+func (*QueryDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{14}
+}
+func (m *QueryDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositRequest.Merge(m, src)
+}
+func (m *QueryDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositRequest proto.InternalMessageInfo
+
+// QueryDepositResponse is the response type for the Query/Deposit method.
+type QueryDepositResponse struct {
+	// the binding deposit held for the name, empty if no deposit is held
+	Deposit *NameDeposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *QueryDepositResponse) Reset()         { *m = QueryDepositResponse{} }
+func (m *QueryDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositResponse) ProtoMessage()    {}
+func (*QueryDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{15}
+}
+func (m *QueryDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositResponse.Merge(m, src)
+}
+func (m *QueryDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.name.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.name.v1.QueryParamsResponse")
@@ -287,45 +685,77 @@ func init() {
 	proto.RegisterType((*QueryResolveResponse)(nil), "provenance.name.v1.QueryResolveResponse")
 	proto.RegisterType((*QueryReverseLookupRequest)(nil), "provenance.name.v1.QueryReverseLookupRequest")
 	proto.RegisterType((*QueryReverseLookupResponse)(nil), "provenance.name.v1.QueryReverseLookupResponse")
+	proto.RegisterType((*QuerySubtreeRequest)(nil), "provenance.name.v1.QuerySubtreeRequest")
+	proto.RegisterType((*QuerySubtreeResponse)(nil), "provenance.name.v1.QuerySubtreeResponse")
+	proto.RegisterType((*QueryLeaseRequest)(nil), "provenance.name.v1.QueryLeaseRequest")
+	proto.RegisterType((*QueryLeaseResponse)(nil), "provenance.name.v1.QueryLeaseResponse")
+	proto.RegisterType((*QueryDomainVerificationRequest)(nil), "provenance.name.v1.QueryDomainVerificationRequest")
+	proto.RegisterType((*QueryDomainVerificationResponse)(nil), "provenance.name.v1.QueryDomainVerificationResponse")
+	proto.RegisterType((*QueryDomainVerificationsRequest)(nil), "provenance.name.v1.QueryDomainVerificationsRequest")
+	proto.RegisterType((*QueryDomainVerificationsResponse)(nil), "provenance.name.v1.QueryDomainVerificationsResponse")
+	proto.RegisterType((*QueryDepositRequest)(nil), "provenance.name.v1.QueryDepositRequest")
+	proto.RegisterType((*QueryDepositResponse)(nil), "provenance.name.v1.QueryDepositResponse")
 }
 
 func init() { proto.RegisterFile("provenance/name/v1/query.proto", fileDescriptor_4e9b0d5536fc961a) }
 
 var fileDescriptor_4e9b0d5536fc961a = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xb1, 0x6e, 0x13, 0x41,
-	0x10, 0xbd, 0x4d, 0x82, 0x03, 0x8b, 0x68, 0x06, 0x23, 0x99, 0x53, 0x38, 0xa3, 0x53, 0xe4, 0x44,
-	0x11, 0xd9, 0x8d, 0x93, 0x06, 0x51, 0xa6, 0x80, 0x06, 0x09, 0x73, 0x25, 0xdd, 0xda, 0x59, 0x1d,
-	0x27, 0xec, 0x9b, 0xcb, 0xed, 0xf9, 0x44, 0x14, 0xb9, 0x81, 0x82, 0x94, 0x48, 0xb4, 0x14, 0xf9,
-	0x04, 0x3e, 0x23, 0x65, 0x24, 0x1a, 0x2a, 0x84, 0x6c, 0x0a, 0x3e, 0x03, 0xdd, 0xee, 0x5a, 0xb6,
-	0xe5, 0x0d, 0xa6, 0x5b, 0xcf, 0xbc, 0x37, 0xef, 0x8d, 0xdf, 0x1c, 0x0d, 0xb2, 0x1c, 0x4b, 0x99,
-	0x8a, 0xb4, 0x27, 0x79, 0x2a, 0x06, 0x92, 0x97, 0x6d, 0x7e, 0x3a, 0x94, 0xf9, 0x19, 0xcb, 0x72,
-	0x2c, 0x10, 0x60, 0xd6, 0x67, 0x55, 0x9f, 0x95, 0x6d, 0x7f, 0xaf, 0x87, 0x6a, 0x80, 0x8a, 0x77,
-	0x85, 0x92, 0x06, 0xcc, 0xcb, 0x76, 0x57, 0x16, 0xa2, 0xcd, 0x33, 0x11, 0x27, 0xa9, 0x28, 0x12,
-	0x4c, 0x0d, 0xdf, 0xaf, 0xc7, 0x18, 0xa3, 0x7e, 0xf2, 0xea, 0x65, 0xab, 0x5b, 0x31, 0x62, 0xdc,
-	0x97, 0x5c, 0x64, 0x09, 0x17, 0x69, 0x8a, 0x85, 0xa6, 0x28, 0xdb, 0x7d, 0xe4, 0xf0, 0xa4, 0xb5,
-	0x75, 0x3b, 0xac, 0x53, 0x78, 0x5d, 0x89, 0x76, 0x44, 0x2e, 0x06, 0x2a, 0x92, 0xa7, 0x43, 0xa9,
-	0x8a, 0xf0, 0x15, 0xbd, 0xbf, 0x50, 0x55, 0x19, 0xa6, 0x4a, 0xc2, 0x53, 0x5a, 0xcb, 0x74, 0xa5,
-	0x41, 0x1e, 0x93, 0xdd, 0xbb, 0x87, 0x3e, 0x5b, 0x5e, 0x88, 0x19, 0xce, 0xf1, 0xc6, 0xd5, 0xcf,
-	0xa6, 0x17, 0x59, 0x7c, 0x78, 0x64, 0x07, 0x46, 0x52, 0x61, 0xbf, 0x94, 0x56, 0x07, 0x80, 0x6e,
-	0x54, 0x34, 0x3d, 0xee, 0x4e, 0xa4, 0xdf, 0xcf, 0x6e, 0x5f, 0x5c, 0x36, 0xbd, 0x3f, 0x97, 0x4d,
-	0x2f, 0x3c, 0xa0, 0xf5, 0x45, 0x92, 0xb5, 0xd1, 0xa0, 0x9b, 0xe2, 0xe4, 0x24, 0x97, 0x4a, 0x59,
-	0xe2, 0xf4, 0x67, 0xf8, 0x89, 0xd0, 0x87, 0x96, 0x52, 0xca, 0x5c, 0xc9, 0x97, 0x88, 0xef, 0x86,
-	0xd9, 0x54, 0xed, 0x46, 0x1e, 0x3c, 0xa7, 0x74, 0xf6, 0x67, 0x37, 0xd6, 0xf4, 0x72, 0x2d, 0x66,
-	0x92, 0x61, 0x55, 0x32, 0xcc, 0xc4, 0x68, 0x93, 0x61, 0x1d, 0x11, 0x4f, 0x77, 0x88, 0xe6, 0x98,
-	0x73, 0xde, 0x3f, 0x12, 0xea, 0xbb, 0x9c, 0xd8, 0x15, 0x66, 0x8b, 0xaf, 0x4f, 0x17, 0x87, 0x17,
-	0x0e, 0x13, 0x3b, 0x2b, 0x4d, 0x98, 0x81, 0x6e, 0x17, 0x87, 0xdf, 0xd6, 0xe9, 0x2d, 0xed, 0x02,
-	0x46, 0xb4, 0x66, 0x82, 0x81, 0x96, 0x2b, 0xb4, 0xe5, 0x1b, 0xf0, 0x77, 0x56, 0xe2, 0x8c, 0x74,
-	0x18, 0x7e, 0xf8, 0xfe, 0xfb, 0xcb, 0xda, 0x16, 0xf8, 0xdc, 0x71, 0x6a, 0x26, 0x7f, 0xb8, 0x20,
-	0x74, 0xd3, 0xc6, 0x08, 0x37, 0x0f, 0x5e, 0xbc, 0x0e, 0x7f, 0x77, 0x35, 0xd0, 0x5a, 0xd8, 0xd3,
-	0x16, 0xb6, 0x21, 0x74, 0x59, 0xc8, 0x0d, 0x98, 0x9f, 0x57, 0x85, 0x11, 0x7c, 0x25, 0xf4, 0xde,
-	0x42, 0x28, 0xb0, 0xff, 0x0f, 0x9d, 0xe5, 0x33, 0xf2, 0xd9, 0xff, 0xc2, 0xad, 0xb9, 0x27, 0xda,
-	0x5c, 0x0b, 0xb6, 0x5d, 0xe6, 0xfa, 0x1a, 0xcb, 0xcf, 0xed, 0x25, 0x8e, 0x8e, 0x7b, 0x57, 0xe3,
-	0x80, 0x5c, 0x8f, 0x03, 0xf2, 0x6b, 0x1c, 0x90, 0xcf, 0x93, 0xc0, 0xbb, 0x9e, 0x04, 0xde, 0x8f,
-	0x49, 0xe0, 0xd1, 0x07, 0x09, 0x3a, 0x94, 0x3b, 0xe4, 0xcd, 0x41, 0x9c, 0x14, 0x6f, 0x87, 0x5d,
-	0xd6, 0xc3, 0xc1, 0x9c, 0xc4, 0x7e, 0x82, 0xf3, 0x82, 0xef, 0x8d, 0x64, 0x71, 0x96, 0x49, 0xd5,
-	0xad, 0xe9, 0x8f, 0xff, 0xe8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0xb0, 0x67, 0x48, 0xb1,
-	0x04, 0x00, 0x00,
+	// 880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x33, 0xcb, 0x36, 0x61, 0xdf, 0xb2, 0x48, 0xcc, 0x06, 0xa9, 0x58, 0xbb, 0x4e, 0x65,
+	0x2d, 0xd9, 0xa8, 0xda, 0xb5, 0xf3, 0x03, 0x21, 0xe0, 0xb0, 0x87, 0x55, 0x05, 0x12, 0xaa, 0xa0,
+	0xb8, 0x12, 0x07, 0x38, 0x4d, 0x92, 0x21, 0x58, 0x24, 0x1e, 0xd7, 0xe3, 0x04, 0xaa, 0x28, 0x12,
+	0x82, 0x03, 0x3d, 0x22, 0x71, 0xe5, 0xd0, 0x2b, 0x57, 0x24, 0x90, 0xf8, 0x0f, 0xca, 0xad, 0x12,
+	0x17, 0x4e, 0x08, 0xb5, 0x1c, 0xf8, 0x33, 0x90, 0x67, 0x9e, 0x89, 0xad, 0xd8, 0x89, 0x7b, 0xc8,
+	0xcd, 0x19, 0xbf, 0xef, 0x7b, 0x9f, 0xf7, 0xe6, 0xf9, 0xbd, 0x80, 0x19, 0x84, 0x62, 0xc6, 0x7d,
+	0xe6, 0x0f, 0xb8, 0xe3, 0xb3, 0x09, 0x77, 0x66, 0x1d, 0xe7, 0x64, 0xca, 0xc3, 0x53, 0x3b, 0x08,
+	0x45, 0x24, 0x28, 0x5d, 0xbe, 0xb7, 0xe3, 0xf7, 0xf6, 0xac, 0x63, 0xec, 0x0f, 0x84, 0x9c, 0x08,
+	0xe9, 0xf4, 0x99, 0xe4, 0xda, 0xd8, 0x99, 0x75, 0xfa, 0x3c, 0x62, 0x1d, 0x27, 0x60, 0x23, 0xcf,
+	0x67, 0x91, 0x27, 0x7c, 0xad, 0x37, 0xea, 0x23, 0x31, 0x12, 0xea, 0xd1, 0x89, 0x9f, 0xf0, 0xf4,
+	0xc1, 0x48, 0x88, 0xd1, 0x98, 0x3b, 0x2c, 0xf0, 0x1c, 0xe6, 0xfb, 0x22, 0x52, 0x12, 0x89, 0x6f,
+	0x1f, 0xe6, 0x30, 0xa9, 0xd8, 0xea, 0xb5, 0x55, 0x07, 0xfa, 0x51, 0x1c, 0xf4, 0x88, 0x85, 0x6c,
+	0x22, 0x5d, 0x7e, 0x32, 0xe5, 0x32, 0xb2, 0x3e, 0x84, 0xfb, 0x99, 0x53, 0x19, 0x08, 0x5f, 0x72,
+	0xfa, 0x16, 0x54, 0x03, 0x75, 0xb2, 0x4b, 0xf6, 0x48, 0xeb, 0x6e, 0xd7, 0xb0, 0x57, 0x13, 0xb2,
+	0xb5, 0xe6, 0xf9, 0xed, 0x8b, 0xbf, 0x1a, 0x15, 0x17, 0xed, 0xad, 0x1e, 0x3a, 0x74, 0xb9, 0x14,
+	0xe3, 0x19, 0xc7, 0x38, 0x94, 0xc2, 0xed, 0x58, 0xa6, 0xdc, 0xdd, 0x71, 0xd5, 0xf3, 0x3b, 0x2f,
+	0x9e, 0x9d, 0x37, 0x2a, 0xff, 0x9e, 0x37, 0x2a, 0x56, 0x1b, 0xea, 0x59, 0x11, 0x62, 0xec, 0x42,
+	0x8d, 0x0d, 0x87, 0x21, 0x97, 0x12, 0x85, 0xc9, 0x4f, 0xeb, 0x3b, 0x02, 0xaf, 0xa1, 0x64, 0xc6,
+	0x43, 0xc9, 0x0f, 0x85, 0xf8, 0x62, 0x1a, 0x24, 0xd1, 0x0a, 0x75, 0xf4, 0x5d, 0x80, 0x65, 0xb1,
+	0x77, 0x6f, 0xa9, 0xe4, 0x9a, 0xb6, 0xbe, 0x19, 0x3b, 0xbe, 0x19, 0x5b, 0x5f, 0x23, 0xde, 0x8c,
+	0x7d, 0xc4, 0x46, 0x49, 0x0e, 0x6e, 0x4a, 0x99, 0x62, 0xff, 0x96, 0x80, 0x91, 0x47, 0x82, 0x29,
+	0x2c, 0x13, 0x7f, 0x21, 0x49, 0x9c, 0xbe, 0x97, 0x03, 0xf1, 0x78, 0x23, 0x84, 0x76, 0x58, 0x40,
+	0x31, 0xc7, 0xb2, 0x1f, 0x4f, 0xfb, 0x51, 0xc8, 0xd3, 0x65, 0x0f, 0x85, 0x88, 0x92, 0xb2, 0xc7,
+	0xcf, 0x5b, 0x28, 0xc1, 0x4f, 0x04, 0xea, 0xd9, 0xe8, 0x98, 0xfc, 0x33, 0xa8, 0x85, 0x7c, 0x20,
+	0xc2, 0xa1, 0x54, 0xf9, 0xdf, 0xed, 0x9a, 0x79, 0x7d, 0xf4, 0x01, 0x9b, 0x70, 0x57, 0x99, 0x61,
+	0x2f, 0x25, 0xa2, 0x6d, 0x14, 0xaa, 0x03, 0xaf, 0x28, 0xd4, 0x43, 0xce, 0x64, 0xc9, 0xee, 0x3c,
+	0x06, 0x9a, 0x96, 0x60, 0x6e, 0x3d, 0xd8, 0x19, 0xc7, 0x07, 0xf8, 0x85, 0x3c, 0x2c, 0xca, 0x4c,
+	0xab, 0xb4, 0x6d, 0xca, 0xe9, 0x33, 0x30, 0x95, 0xd3, 0x03, 0x31, 0x61, 0x9e, 0xff, 0x31, 0x0f,
+	0xbd, 0xcf, 0xbc, 0x81, 0x82, 0x2d, 0x07, 0xf5, 0x25, 0x34, 0x0a, 0xf5, 0x48, 0xf8, 0x3e, 0xbc,
+	0x34, 0x4b, 0x9d, 0x23, 0x68, 0x33, 0x0f, 0x34, 0xc7, 0x4b, 0x46, 0x9b, 0x0a, 0xfc, 0x1b, 0x29,
+	0x8c, 0x9c, 0x4c, 0x15, 0x7a, 0x00, 0x55, 0x19, 0xb1, 0x68, 0xaa, 0x3f, 0xbf, 0x97, 0xbb, 0x4f,
+	0xca, 0xc5, 0x3c, 0x56, 0x1a, 0x17, 0xb5, 0x5b, 0x68, 0xd4, 0xdf, 0x09, 0xec, 0x15, 0xb3, 0x63,
+	0xd9, 0x5c, 0xb8, 0x97, 0x4e, 0x3d, 0x69, 0xdd, 0x92, 0x75, 0xc3, 0x16, 0xce, 0xba, 0xd8, 0x46,
+	0x23, 0x27, 0x83, 0xf6, 0x80, 0x07, 0x42, 0x7a, 0x51, 0xb9, 0xae, 0xf9, 0x14, 0xea, 0x59, 0x11,
+	0xe6, 0xfc, 0x36, 0xd4, 0x86, 0xfa, 0x08, 0xbb, 0xa4, 0x51, 0xd4, 0xce, 0x89, 0x32, 0xb1, 0x5f,
+	0x3a, 0xef, 0xfe, 0x7a, 0x07, 0x76, 0x94, 0x77, 0xba, 0x80, 0xaa, 0x5e, 0x0e, 0x34, 0xb7, 0x6a,
+	0xab, 0x7b, 0xc8, 0x78, 0xbc, 0xd1, 0x4e, 0x93, 0x5a, 0xd6, 0x37, 0x7f, 0xfc, 0xf3, 0xc3, 0xad,
+	0x07, 0xd4, 0x70, 0x72, 0xd6, 0x9d, 0xde, 0x41, 0xf4, 0x8c, 0x40, 0x0d, 0x57, 0x09, 0x2d, 0x76,
+	0x9c, 0xdd, 0x50, 0x46, 0x6b, 0xb3, 0x21, 0x22, 0xec, 0x2b, 0x84, 0x47, 0xd4, 0xca, 0x43, 0x08,
+	0xb5, 0xb1, 0x33, 0x8f, 0x0f, 0x16, 0xf4, 0x47, 0x02, 0xf7, 0x32, 0x8b, 0x81, 0x3e, 0x5d, 0x13,
+	0x67, 0x75, 0x95, 0x19, 0x76, 0x59, 0x73, 0x84, 0x7b, 0xa2, 0xe0, 0x9a, 0xf4, 0x51, 0x1e, 0xdc,
+	0x58, 0xd9, 0x3a, 0x73, 0xdc, 0x86, 0x0b, 0x55, 0x29, 0x1c, 0xda, 0x6b, 0x2a, 0x95, 0x5d, 0x2a,
+	0x46, 0x6b, 0xb3, 0x61, 0x99, 0x4a, 0x49, 0x6d, 0xec, 0xcc, 0xe3, 0xad, 0xb4, 0xa0, 0x5f, 0x13,
+	0xd8, 0x51, 0xb3, 0x92, 0xbe, 0x5e, 0xe8, 0x3f, 0x3d, 0xb4, 0x8d, 0xe6, 0x26, 0x33, 0x84, 0x68,
+	0x29, 0x08, 0x8b, 0xee, 0xe5, 0x56, 0x24, 0x36, 0x4d, 0x2e, 0xeb, 0x17, 0x02, 0x74, 0xf5, 0x8b,
+	0xa6, 0xdd, 0xc2, 0x40, 0x85, 0xc3, 0xdb, 0xe8, 0xdd, 0x48, 0x83, 0xa4, 0x6f, 0x2a, 0xd2, 0x36,
+	0xb5, 0xf3, 0x48, 0x87, 0x4a, 0x87, 0xa8, 0x4e, 0x7a, 0xbc, 0xd0, 0x9f, 0x09, 0xdc, 0x5f, 0x75,
+	0x2b, 0xe9, 0x4d, 0x20, 0xfe, 0xff, 0x12, 0xdf, 0xb8, 0x99, 0x08, 0xd1, 0xdb, 0x0a, 0x7d, 0x9f,
+	0xb6, 0xd6, 0xa0, 0x67, 0x47, 0x62, 0xdc, 0x7a, 0x38, 0x4c, 0xd6, 0xb4, 0x5e, 0x76, 0xba, 0x19,
+	0xad, 0xcd, 0x86, 0x65, 0x5a, 0x0f, 0x67, 0x17, 0x16, 0xf3, 0xf9, 0xe0, 0xe2, 0xca, 0x24, 0x97,
+	0x57, 0x26, 0xf9, 0xfb, 0xca, 0x24, 0xdf, 0x5f, 0x9b, 0x95, 0xcb, 0x6b, 0xb3, 0xf2, 0xe7, 0xb5,
+	0x59, 0x81, 0x57, 0x3d, 0x91, 0x13, 0xf1, 0x88, 0x7c, 0xd2, 0x1e, 0x79, 0xd1, 0xe7, 0xd3, 0xbe,
+	0x3d, 0x10, 0x93, 0x54, 0x80, 0xa7, 0x9e, 0x48, 0x87, 0xfb, 0x4a, 0x07, 0x8c, 0x4e, 0x03, 0x2e,
+	0xfb, 0x55, 0xf5, 0x37, 0xbc, 0xf7, 0xdf, 0x00, 0xe9, 0xbc, 0x00, 0xdc, 0x3b, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -346,6 +776,16 @@ type QueryClient interface {
 	Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error)
 	// ReverseLookup queries for all names bound against a given address
 	ReverseLookup(ctx context.Context, in *QueryReverseLookupRequest, opts ...grpc.CallOption) (*QueryReverseLookupResponse, error)
+	// Subtree queries for all name records at or below a given root name, each name followed by the names below it
+	Subtree(ctx context.Context, in *QuerySubtreeRequest, opts ...grpc.CallOption) (*QuerySubtreeResponse, error)
+	// Lease queries for the lease expiration of a name
+	Lease(ctx context.Context, in *QueryLeaseRequest, opts ...grpc.CallOption) (*QueryLeaseResponse, error)
+	// DomainVerification queries for the verification of an external domain
+	DomainVerification(ctx context.Context, in *QueryDomainVerificationRequest, opts ...grpc.CallOption) (*QueryDomainVerificationResponse, error)
+	// DomainVerifications queries for the verifications of external domains, optionally filtered by status
+	DomainVerifications(ctx context.Context, in *QueryDomainVerificationsRequest, opts ...grpc.CallOption) (*QueryDomainVerificationsResponse, error)
+	// Deposit queries for the binding deposit held for a name
+	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
 }
 
 type queryClient struct {
@@ -383,6 +823,51 @@ func (c *queryClient) ReverseLookup(ctx context.Context, in *QueryReverseLookupR
 	return out, nil
 }
 
+func (c *queryClient) Subtree(ctx context.Context, in *QuerySubtreeRequest, opts ...grpc.CallOption) (*QuerySubtreeResponse, error) {
+	out := new(QuerySubtreeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/Subtree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Lease(ctx context.Context, in *QueryLeaseRequest, opts ...grpc.CallOption) (*QueryLeaseResponse, error) {
+	out := new(QueryLeaseResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/Lease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DomainVerification(ctx context.Context, in *QueryDomainVerificationRequest, opts ...grpc.CallOption) (*QueryDomainVerificationResponse, error) {
+	out := new(QueryDomainVerificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/DomainVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DomainVerifications(ctx context.Context, in *QueryDomainVerificationsRequest, opts ...grpc.CallOption) (*QueryDomainVerificationsResponse, error) {
+	out := new(QueryDomainVerificationsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/DomainVerifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
+	out := new(QueryDepositResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the name module.
@@ -391,6 +876,16 @@ type QueryServer interface {
 	Resolve(context.Context, *QueryResolveRequest) (*QueryResolveResponse, error)
 	// ReverseLookup queries for all names bound against a given address
 	ReverseLookup(context.Context, *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error)
+	// Subtree queries for all name records at or below a given root name, each name followed by the names below it
+	Subtree(context.Context, *QuerySubtreeRequest) (*QuerySubtreeResponse, error)
+	// Lease queries for the lease expiration of a name
+	Lease(context.Context, *QueryLeaseRequest) (*QueryLeaseResponse, error)
+	// DomainVerification queries for the verification of an external domain
+	DomainVerification(context.Context, *QueryDomainVerificationRequest) (*QueryDomainVerificationResponse, error)
+	// DomainVerifications queries for the verifications of external domains, optionally filtered by status
+	DomainVerifications(context.Context, *QueryDomainVerificationsRequest) (*QueryDomainVerificationsResponse, error)
+	// Deposit queries for the binding deposit held for a name
+	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -406,6 +901,21 @@ func (*UnimplementedQueryServer) Resolve(ctx context.Context, req *QueryResolveR
 func (*UnimplementedQueryServer) ReverseLookup(ctx context.Context, req *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReverseLookup not implemented")
 }
+func (*UnimplementedQueryServer) Subtree(ctx context.Context, req *QuerySubtreeRequest) (*QuerySubtreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subtree not implemented")
+}
+func (*UnimplementedQueryServer) Lease(ctx context.Context, req *QueryLeaseRequest) (*QueryLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lease not implemented")
+}
+func (*UnimplementedQueryServer) DomainVerification(ctx context.Context, req *QueryDomainVerificationRequest) (*QueryDomainVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainVerification not implemented")
+}
+func (*UnimplementedQueryServer) DomainVerifications(ctx context.Context, req *QueryDomainVerificationsRequest) (*QueryDomainVerificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainVerifications not implemented")
+}
+func (*UnimplementedQueryServer) Deposit(ctx context.Context, req *QueryDepositRequest) (*QueryDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -465,6 +975,96 @@ func _Query_ReverseLookup_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Subtree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubtreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Subtree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/Subtree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Subtree(ctx, req.(*QuerySubtreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Lease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Lease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/Lease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lease(ctx, req.(*QueryLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DomainVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomainVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DomainVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/DomainVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DomainVerification(ctx, req.(*QueryDomainVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DomainVerifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomainVerificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DomainVerifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/DomainVerifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DomainVerifications(ctx, req.(*QueryDomainVerificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposit(ctx, req.(*QueryDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.name.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -481,6 +1081,26 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReverseLookup",
 			Handler:    _Query_ReverseLookup_Handler,
 		},
+		{
+			MethodName: "Subtree",
+			Handler:    _Query_Subtree_Handler,
+		},
+		{
+			MethodName: "Lease",
+			Handler:    _Query_Lease_Handler,
+		},
+		{
+			MethodName: "DomainVerification",
+			Handler:    _Query_DomainVerification_Handler,
+		},
+		{
+			MethodName: "DomainVerifications",
+			Handler:    _Query_DomainVerifications_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Query_Deposit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/name/v1/query.proto",
@@ -688,106 +1308,1401 @@ func (m *QueryReverseLookupResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySubtreeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QuerySubtreeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySubtreeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryResolveRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySubtreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubtreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubtreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryResolveResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLeaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReverseLookupRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Lease != nil {
+		{
+			size, err := m.Lease.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReverseLookupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDomainVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Name) > 0 {
-		for _, s := range m.Name {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verification != nil {
+		{
+			size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainVerificationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainVerificationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainVerificationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainVerificationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainVerificationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainVerificationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Verifications) > 0 {
+		for iNdEx := len(m.Verifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryResolveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReverseLookupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReverseLookupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		for _, s := range m.Name {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubtreeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubtreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lease != nil {
+		l = m.Lease.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Verification != nil {
+		l = m.Verification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainVerificationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainVerificationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Verifications) > 0 {
+		for _, e := range m.Verifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReverseLookupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReverseLookupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReverseLookupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReverseLookupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubtreeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubtreeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubtreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubtreeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubtreeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubtreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, NameRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -810,12 +2725,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -837,7 +2784,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -860,15 +2807,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -895,7 +2842,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Lease == nil {
+				m.Lease = &NameLease{}
+			}
+			if err := m.Lease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -920,7 +2870,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDomainVerificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -943,10 +2893,10 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDomainVerificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDomainVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1002,7 +2952,7 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDomainVerificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1025,17 +2975,17 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDomainVerificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDomainVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1045,23 +2995,27 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Verification == nil {
+				m.Verification = &DomainVerification{}
+			}
+			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1084,7 +3038,7 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDomainVerificationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1107,17 +3061,36 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReverseLookupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDomainVerificationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReverseLookupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDomainVerificationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DomainVerificationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1127,23 +3100,111 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomainVerificationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainVerificationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainVerificationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifications = append(m.Verifications, DomainVerification{})
+			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1175,7 +3236,7 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1202,7 +3263,7 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1225,10 +3286,10 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReverseLookupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReverseLookupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1261,11 +3322,61 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = append(m.Name, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1292,10 +3403,10 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Deposit == nil {
+				m.Deposit = &NameDeposit{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
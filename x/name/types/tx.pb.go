@@ -36,6 +36,8 @@ type MsgBindNameRequest struct {
 	Parent NameRecord `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent"`
 	// The name record to bind under the parent
 	Record NameRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+	// The lease duration in seconds, zero to use the default lease duration param.
+	LeaseSeconds uint64 `protobuf:"varint,3,opt,name=lease_seconds,json=leaseSeconds,proto3" json:"lease_seconds,omitempty"`
 }
 
 func (m *MsgBindNameRequest) Reset()         { *m = MsgBindNameRequest{} }
@@ -185,38 +187,483 @@ func (m *MsgDeleteNameResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteNameResponse proto.InternalMessageInfo
 
+// MsgRenewNameRequest defines an sdk.Msg type that is used to extend the lease of an existing address/name binding.
+type MsgRenewNameRequest struct {
+	// The name being renewed
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address the name is bound to
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The number of seconds to extend the lease by, zero to use the default lease duration param.
+	LeaseSeconds uint64 `protobuf:"varint,3,opt,name=lease_seconds,json=leaseSeconds,proto3" json:"lease_seconds,omitempty"`
+}
+
+func (m *MsgRenewNameRequest) Reset()         { *m = MsgRenewNameRequest{} }
+func (m *MsgRenewNameRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewNameRequest) ProtoMessage()    {}
+func (*MsgRenewNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{4}
+}
+func (m *MsgRenewNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewNameRequest.Merge(m, src)
+}
+func (m *MsgRenewNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewNameRequest proto.InternalMessageInfo
+
+// MsgRenewNameResponse defines the Msg/RenewName response type.
+type MsgRenewNameResponse struct {
+}
+
+func (m *MsgRenewNameResponse) Reset()         { *m = MsgRenewNameResponse{} }
+func (m *MsgRenewNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewNameResponse) ProtoMessage()    {}
+func (*MsgRenewNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{5}
+}
+func (m *MsgRenewNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewNameResponse.Merge(m, src)
+}
+func (m *MsgRenewNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewNameResponse proto.InternalMessageInfo
+
+// MsgRequestDomainVerificationRequest defines an sdk.Msg type that is used to request the verification of an external
+// domain before binding a name for it.
+type MsgRequestDomainVerificationRequest struct {
+	// The name of the external domain
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address the name will be bound to
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgRequestDomainVerificationRequest) Reset()         { *m = MsgRequestDomainVerificationRequest{} }
+func (m *MsgRequestDomainVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestDomainVerificationRequest) ProtoMessage()    {}
+func (*MsgRequestDomainVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{6}
+}
+func (m *MsgRequestDomainVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestDomainVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestDomainVerificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestDomainVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestDomainVerificationRequest.Merge(m, src)
+}
+func (m *MsgRequestDomainVerificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestDomainVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestDomainVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestDomainVerificationRequest proto.InternalMessageInfo
+
+// MsgRequestDomainVerificationResponse defines the Msg/RequestDomainVerification response type.
+type MsgRequestDomainVerificationResponse struct {
+	// The challenge to publish in a DNS TXT record of the domain
+	Challenge string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+}
+
+func (m *MsgRequestDomainVerificationResponse) Reset()         { *m = MsgRequestDomainVerificationResponse{} }
+func (m *MsgRequestDomainVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestDomainVerificationResponse) ProtoMessage()    {}
+func (*MsgRequestDomainVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{7}
+}
+func (m *MsgRequestDomainVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestDomainVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestDomainVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestDomainVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestDomainVerificationResponse.Merge(m, src)
+}
+func (m *MsgRequestDomainVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestDomainVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestDomainVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestDomainVerificationResponse proto.InternalMessageInfo
+
+func (m *MsgRequestDomainVerificationResponse) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
+}
+
+// MsgAttestDomainVerificationRequest defines an sdk.Msg type that is used by the domain verifier to attest to the
+// verification of an external domain.
+type MsgAttestDomainVerificationRequest struct {
+	// The name of the external domain
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address of the domain verifier
+	Verifier string `protobuf:"bytes,2,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	// The challenge found in the DNS TXT record of the domain
+	Challenge string `protobuf:"bytes,3,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// Whether the domain is verified, false to reject the verification
+	Verified bool `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+}
+
+func (m *MsgAttestDomainVerificationRequest) Reset()         { *m = MsgAttestDomainVerificationRequest{} }
+func (m *MsgAttestDomainVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestDomainVerificationRequest) ProtoMessage()    {}
+func (*MsgAttestDomainVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{8}
+}
+func (m *MsgAttestDomainVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestDomainVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestDomainVerificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestDomainVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestDomainVerificationRequest.Merge(m, src)
+}
+func (m *MsgAttestDomainVerificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestDomainVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestDomainVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestDomainVerificationRequest proto.InternalMessageInfo
+
+// MsgAttestDomainVerificationResponse defines the Msg/AttestDomainVerification response type.
+type MsgAttestDomainVerificationResponse struct {
+}
+
+func (m *MsgAttestDomainVerificationResponse) Reset()         { *m = MsgAttestDomainVerificationResponse{} }
+func (m *MsgAttestDomainVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestDomainVerificationResponse) ProtoMessage()    {}
+func (*MsgAttestDomainVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{9}
+}
+func (m *MsgAttestDomainVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestDomainVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestDomainVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestDomainVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestDomainVerificationResponse.Merge(m, src)
+}
+func (m *MsgAttestDomainVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestDomainVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestDomainVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestDomainVerificationResponse proto.InternalMessageInfo
+
+// MsgReclaimNameDepositRequest defines an sdk.Msg type that is used by a depositor to reclaim the deposit paid to bind
+// a name.  The holding period must have passed and the name must have attributes or child names bound under it.
+type MsgReclaimNameDepositRequest struct {
+	// The name the deposit was paid for
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address that paid the deposit
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+}
+
+func (m *MsgReclaimNameDepositRequest) Reset()         { *m = MsgReclaimNameDepositRequest{} }
+func (m *MsgReclaimNameDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimNameDepositRequest) ProtoMessage()    {}
+func (*MsgReclaimNameDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{10}
+}
+func (m *MsgReclaimNameDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReclaimNameDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimNameDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReclaimNameDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimNameDepositRequest.Merge(m, src)
+}
+func (m *MsgReclaimNameDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimNameDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimNameDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReclaimNameDepositRequest proto.InternalMessageInfo
+
+// MsgReclaimNameDepositResponse defines the Msg/ReclaimNameDeposit response type.
+type MsgReclaimNameDepositResponse struct {
+}
+
+func (m *MsgReclaimNameDepositResponse) Reset()         { *m = MsgReclaimNameDepositResponse{} }
+func (m *MsgReclaimNameDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimNameDepositResponse) ProtoMessage()    {}
+func (*MsgReclaimNameDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{11}
+}
+func (m *MsgReclaimNameDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReclaimNameDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimNameDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReclaimNameDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimNameDepositResponse.Merge(m, src)
+}
+func (m *MsgReclaimNameDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimNameDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimNameDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReclaimNameDepositResponse proto.InternalMessageInfo
+
+// MsgSetNamePolicyRequest defines an sdk.Msg type that is used by the owner of a name to set the policy controlling
+// which addresses can bind names directly under it.
+type MsgSetNamePolicyRequest struct {
+	// The name to set the issuance policy of
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address the name is bound to
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The issuance policy of the name
+	IssuancePolicy NameIssuancePolicy `protobuf:"varint,3,opt,name=issuance_policy,json=issuancePolicy,proto3,enum=provenance.name.v1.NameIssuancePolicy" json:"issuance_policy,omitempty"`
+	// The addresses allowed to bind names under the name, only used with the allowlist policy
+	Issuers []string `protobuf:"bytes,4,rep,name=issuers,proto3" json:"issuers,omitempty"`
+}
+
+func (m *MsgSetNamePolicyRequest) Reset()         { *m = MsgSetNamePolicyRequest{} }
+func (m *MsgSetNamePolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNamePolicyRequest) ProtoMessage()    {}
+func (*MsgSetNamePolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{12}
+}
+func (m *MsgSetNamePolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNamePolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNamePolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetNamePolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNamePolicyRequest.Merge(m, src)
+}
+func (m *MsgSetNamePolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNamePolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNamePolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetNamePolicyRequest proto.InternalMessageInfo
+
+// MsgSetNamePolicyResponse defines the Msg/SetNamePolicy response type.
+type MsgSetNamePolicyResponse struct {
+}
+
+func (m *MsgSetNamePolicyResponse) Reset()         { *m = MsgSetNamePolicyResponse{} }
+func (m *MsgSetNamePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNamePolicyResponse) ProtoMessage()    {}
+func (*MsgSetNamePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{13}
+}
+func (m *MsgSetNamePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNamePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNamePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetNamePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNamePolicyResponse.Merge(m, src)
+}
+func (m *MsgSetNamePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNamePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNamePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetNamePolicyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgBindNameRequest)(nil), "provenance.name.v1.MsgBindNameRequest")
 	proto.RegisterType((*MsgBindNameResponse)(nil), "provenance.name.v1.MsgBindNameResponse")
 	proto.RegisterType((*MsgDeleteNameRequest)(nil), "provenance.name.v1.MsgDeleteNameRequest")
 	proto.RegisterType((*MsgDeleteNameResponse)(nil), "provenance.name.v1.MsgDeleteNameResponse")
+	proto.RegisterType((*MsgRenewNameRequest)(nil), "provenance.name.v1.MsgRenewNameRequest")
+	proto.RegisterType((*MsgRenewNameResponse)(nil), "provenance.name.v1.MsgRenewNameResponse")
+	proto.RegisterType((*MsgRequestDomainVerificationRequest)(nil), "provenance.name.v1.MsgRequestDomainVerificationRequest")
+	proto.RegisterType((*MsgRequestDomainVerificationResponse)(nil), "provenance.name.v1.MsgRequestDomainVerificationResponse")
+	proto.RegisterType((*MsgAttestDomainVerificationRequest)(nil), "provenance.name.v1.MsgAttestDomainVerificationRequest")
+	proto.RegisterType((*MsgAttestDomainVerificationResponse)(nil), "provenance.name.v1.MsgAttestDomainVerificationResponse")
+	proto.RegisterType((*MsgReclaimNameDepositRequest)(nil), "provenance.name.v1.MsgReclaimNameDepositRequest")
+	proto.RegisterType((*MsgReclaimNameDepositResponse)(nil), "provenance.name.v1.MsgReclaimNameDepositResponse")
+	proto.RegisterType((*MsgSetNamePolicyRequest)(nil), "provenance.name.v1.MsgSetNamePolicyRequest")
+	proto.RegisterType((*MsgSetNamePolicyResponse)(nil), "provenance.name.v1.MsgSetNamePolicyResponse")
 }
 
 func init() { proto.RegisterFile("provenance/name/v1/tx.proto", fileDescriptor_eacf6cd967218635) }
 
 var fileDescriptor_eacf6cd967218635 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4b, 0xcc, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x2f,
-	0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x42, 0x48, 0xea, 0x81, 0x24, 0xf5, 0xca,
-	0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xd2, 0xfa, 0x20, 0x16, 0x44, 0xa5, 0x94, 0x2c,
-	0x16, 0x63, 0xc0, 0x3a, 0xc0, 0xd2, 0x4a, 0xb3, 0x18, 0xb9, 0x84, 0x7c, 0x8b, 0xd3, 0x9d, 0x32,
-	0xf3, 0x52, 0xfc, 0x12, 0x73, 0x53, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0x6c, 0xb8,
-	0xd8, 0x0a, 0x12, 0x8b, 0x52, 0xf3, 0x4a, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf4,
-	0x30, 0x2d, 0xd4, 0x83, 0x68, 0x48, 0xce, 0x2f, 0x4a, 0x71, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21,
-	0x08, 0xaa, 0x07, 0xa4, 0xbb, 0x08, 0x2c, 0x2e, 0xc1, 0x44, 0x8a, 0x6e, 0x88, 0x1e, 0x2b, 0x8e,
-	0x8e, 0x05, 0xf2, 0x0c, 0x2f, 0x16, 0xc8, 0x33, 0x28, 0x89, 0x72, 0x09, 0xa3, 0xb8, 0xad, 0xb8,
-	0x20, 0x3f, 0xaf, 0x38, 0x55, 0x29, 0x8e, 0x4b, 0xc4, 0xb7, 0x38, 0xdd, 0x25, 0x35, 0x27, 0xb5,
-	0x24, 0x15, 0xcd, 0xd1, 0x50, 0x6b, 0x19, 0x29, 0xb2, 0x56, 0x9c, 0x4b, 0x14, 0xcd, 0x7c, 0x88,
-	0xc5, 0x46, 0x47, 0x19, 0xb9, 0x98, 0x7d, 0x8b, 0xd3, 0x85, 0xa2, 0xb9, 0x38, 0x60, 0x8e, 0x12,
-	0x52, 0xc3, 0x66, 0x09, 0x66, 0x88, 0x4a, 0xa9, 0x13, 0x54, 0x07, 0xb1, 0x44, 0x28, 0x91, 0x8b,
-	0x0b, 0x61, 0xb5, 0x90, 0x06, 0x0e, 0x6d, 0x18, 0xbe, 0x97, 0xd2, 0x24, 0x42, 0x25, 0xc4, 0x0a,
-	0xa7, 0xe4, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2,
-	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0xe0, 0x12, 0xcd, 0xcc, 0xc7,
-	0x62, 0x4c, 0x00, 0x63, 0x94, 0x41, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
-	0x3e, 0x42, 0x81, 0x6e, 0x66, 0x3e, 0x12, 0x4f, 0xbf, 0x02, 0x92, 0xc2, 0x4a, 0x2a, 0x0b, 0x52,
-	0x8b, 0x93, 0xd8, 0xc0, 0x09, 0xcc, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x80, 0x68, 0x9e, 0xb8,
-	0xc8, 0x02, 0x00, 0x00,
+	// 688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xee, 0xd8, 0x8a, 0xed, 0xab, 0x60, 0x32, 0x16, 0x59, 0x57, 0xd8, 0x36, 0xc5, 0x8f, 0x1a,
+	0xb5, 0x05, 0x4c, 0xc4, 0x18, 0x2f, 0x92, 0x5e, 0x3c, 0x54, 0xc9, 0x92, 0x98, 0x88, 0x09, 0x64,
+	0xd9, 0x8e, 0xcb, 0x98, 0xed, 0xcc, 0xba, 0xb3, 0x14, 0xf8, 0x07, 0x26, 0x26, 0xc6, 0xa3, 0x47,
+	0xee, 0xfe, 0x01, 0x6f, 0x5e, 0x39, 0x72, 0xf4, 0x64, 0x0c, 0x5c, 0xfc, 0x19, 0x66, 0x67, 0xa7,
+	0xed, 0xf6, 0x63, 0x0b, 0x7b, 0xeb, 0xbc, 0xf3, 0x3e, 0x1f, 0xef, 0x74, 0x9e, 0xc9, 0xc2, 0x6d,
+	0xcf, 0xe7, 0x1d, 0xc2, 0x2c, 0x66, 0x93, 0x3a, 0xb3, 0xda, 0xa4, 0xde, 0x59, 0xae, 0x07, 0x07,
+	0x35, 0xcf, 0xe7, 0x01, 0xc7, 0xb8, 0xbf, 0x59, 0x0b, 0x37, 0x6b, 0x9d, 0x65, 0xbd, 0xe8, 0x70,
+	0x87, 0xcb, 0xed, 0x7a, 0xf8, 0x2b, 0xea, 0xd4, 0x17, 0xc6, 0xd0, 0x48, 0x84, 0xdc, 0xae, 0xfc,
+	0x42, 0x80, 0x9b, 0xc2, 0x59, 0xa3, 0xac, 0xf5, 0xda, 0x6a, 0x13, 0x93, 0x7c, 0xda, 0x23, 0x22,
+	0xc0, 0x2f, 0x60, 0xca, 0xb3, 0x7c, 0xc2, 0x02, 0x0d, 0x95, 0x51, 0xf5, 0xea, 0x8a, 0x51, 0x1b,
+	0x15, 0xac, 0x45, 0x00, 0x9b, 0xfb, 0xad, 0xb5, 0xdc, 0xf1, 0x9f, 0x52, 0xc6, 0x54, 0x98, 0x10,
+	0xed, 0xcb, 0xba, 0x76, 0x29, 0x0d, 0x3a, 0xc2, 0xe0, 0x45, 0x98, 0x76, 0x89, 0x25, 0xc8, 0xb6,
+	0x20, 0x36, 0x67, 0x2d, 0xa1, 0x65, 0xcb, 0xa8, 0x9a, 0x33, 0xaf, 0xc9, 0xe2, 0x46, 0x54, 0x7b,
+	0x9e, 0xff, 0x7c, 0x54, 0xca, 0xfc, 0x3b, 0x2a, 0x65, 0x2a, 0xb3, 0x70, 0x63, 0x60, 0x00, 0xe1,
+	0x71, 0x26, 0x48, 0x65, 0x0b, 0x8a, 0x4d, 0xe1, 0x34, 0x88, 0x4b, 0x02, 0x32, 0x34, 0x99, 0xf2,
+	0x86, 0xd2, 0x7b, 0x8b, 0xc9, 0xce, 0xc1, 0xec, 0x10, 0xbf, 0x12, 0x66, 0xd2, 0x8f, 0x49, 0x18,
+	0xd9, 0x8f, 0xeb, 0x62, 0xc8, 0x85, 0xec, 0x52, 0xb5, 0x60, 0xca, 0xdf, 0xb8, 0x08, 0x97, 0xf9,
+	0x3e, 0x23, 0xbe, 0x3c, 0xa6, 0x82, 0x19, 0x2d, 0xd2, 0xce, 0x7f, 0x13, 0x8a, 0x83, 0x7a, 0xca,
+	0xc7, 0x3b, 0x58, 0x94, 0x75, 0x29, 0xdf, 0xe0, 0x6d, 0x8b, 0xb2, 0xb7, 0xc4, 0xa7, 0x1f, 0xa8,
+	0x6d, 0x05, 0x94, 0xb3, 0xd4, 0xbe, 0x62, 0x92, 0x0d, 0xb8, 0x33, 0x99, 0x3a, 0xb2, 0x80, 0xe7,
+	0xa1, 0x60, 0xef, 0x5a, 0xae, 0x4b, 0x98, 0xd3, 0x15, 0xe8, 0x17, 0x2a, 0xdf, 0x11, 0x54, 0x9a,
+	0xc2, 0x79, 0x19, 0x04, 0xa9, 0x0d, 0xea, 0x90, 0xef, 0xc8, 0xd6, 0x9e, 0xc7, 0xde, 0x7a, 0x50,
+	0x34, 0x3b, 0x24, 0x1a, 0x43, 0xb6, 0xb4, 0x5c, 0x19, 0x55, 0xf3, 0x3d, 0x64, 0xfc, 0xcf, 0xbd,
+	0x0b, 0x8b, 0x13, 0x9d, 0xa9, 0x23, 0xde, 0x84, 0x79, 0x79, 0x0e, 0xb6, 0x6b, 0xd1, 0x76, 0x78,
+	0xf8, 0x0d, 0xe2, 0x71, 0x41, 0x83, 0x49, 0xd6, 0xe7, 0xa1, 0xd0, 0x8a, 0xba, 0x78, 0xd7, 0x7b,
+	0xbf, 0x10, 0xb3, 0x50, 0x82, 0x85, 0x04, 0x6e, 0x25, 0xfe, 0x13, 0xc1, 0x5c, 0x53, 0x38, 0x1b,
+	0x24, 0x08, 0x77, 0xd7, 0xb9, 0x4b, 0xed, 0xc3, 0xf4, 0x97, 0xed, 0x0d, 0x5c, 0xa7, 0x42, 0xec,
+	0x85, 0xb7, 0x7f, 0xdb, 0x93, 0x1c, 0xf2, 0xcc, 0x66, 0x56, 0xee, 0x25, 0xe5, 0xe2, 0x95, 0x6a,
+	0x57, 0x8a, 0x33, 0x74, 0x60, 0x8d, 0x35, 0xb8, 0x12, 0x56, 0x88, 0x2f, 0xb4, 0x5c, 0x39, 0x5b,
+	0x2d, 0x98, 0xdd, 0x65, 0x6c, 0x36, 0x1d, 0xb4, 0x51, 0xe7, 0xd1, 0x58, 0x2b, 0x3f, 0xa6, 0x20,
+	0xdb, 0x14, 0x0e, 0x7e, 0x0f, 0xf9, 0x6e, 0xa6, 0xf1, 0x58, 0x2f, 0xa3, 0xaf, 0x96, 0x7e, 0xff,
+	0xdc, 0x3e, 0x75, 0x31, 0x2d, 0x80, 0x7e, 0x72, 0x71, 0x35, 0x01, 0x36, 0xf2, 0x78, 0xe8, 0x0f,
+	0x2e, 0xd0, 0xa9, 0x24, 0xb6, 0xa0, 0xd0, 0xcb, 0x24, 0x4e, 0x32, 0x36, 0xfc, 0x4a, 0xe8, 0xd5,
+	0xf3, 0x1b, 0x15, 0xff, 0x57, 0x04, 0xb7, 0x12, 0x13, 0x88, 0x57, 0x13, 0x79, 0x26, 0x3f, 0x07,
+	0xfa, 0xb3, 0xf4, 0x40, 0x65, 0xe8, 0x0b, 0x02, 0x2d, 0x29, 0x31, 0xf8, 0x69, 0x02, 0xed, 0x39,
+	0xe1, 0xd7, 0x57, 0x53, 0xe3, 0x94, 0x9b, 0x43, 0xc0, 0xa3, 0xd9, 0xc1, 0x4b, 0x89, 0xd3, 0x25,
+	0x44, 0x58, 0x5f, 0x4e, 0x81, 0x50, 0xd2, 0x1f, 0x61, 0x7a, 0xe0, 0x6a, 0xe3, 0x87, 0x09, 0x1c,
+	0xe3, 0xa2, 0xab, 0x3f, 0xba, 0x58, 0x73, 0xa4, 0xb5, 0x66, 0x1f, 0x9f, 0x1a, 0xe8, 0xe4, 0xd4,
+	0x40, 0x7f, 0x4f, 0x0d, 0xf4, 0xed, 0xcc, 0xc8, 0x9c, 0x9c, 0x19, 0x99, 0xdf, 0x67, 0x46, 0x06,
+	0x66, 0x29, 0x1f, 0xc3, 0xb4, 0x8e, 0x36, 0x97, 0x1c, 0x1a, 0xec, 0xee, 0xed, 0xd4, 0x6c, 0xde,
+	0xae, 0xf7, 0x1b, 0x1e, 0x53, 0x1e, 0x5b, 0xd5, 0x0f, 0xa2, 0x6f, 0x85, 0xe0, 0xd0, 0x23, 0x62,
+	0x67, 0x4a, 0x7e, 0x2a, 0x3c, 0xf9, 0x3f, 0x00, 0xa4, 0xa4, 0x9a, 0xe9, 0x92, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -235,6 +682,18 @@ type MsgClient interface {
 	BindName(ctx context.Context, in *MsgBindNameRequest, opts ...grpc.CallOption) (*MsgBindNameResponse, error)
 	// DeleteName defines a method to verify a particular invariance.
 	DeleteName(ctx context.Context, in *MsgDeleteNameRequest, opts ...grpc.CallOption) (*MsgDeleteNameResponse, error)
+	// RenewName extends the lease of a bound name.
+	RenewName(ctx context.Context, in *MsgRenewNameRequest, opts ...grpc.CallOption) (*MsgRenewNameResponse, error)
+	// RequestDomainVerification starts the verification of an external domain, returning the challenge to publish in a
+	// DNS TXT record of the domain.
+	RequestDomainVerification(ctx context.Context, in *MsgRequestDomainVerificationRequest, opts ...grpc.CallOption) (*MsgRequestDomainVerificationResponse, error)
+	// AttestDomainVerification records whether the verifier found the challenge in a DNS TXT record of the domain.
+	AttestDomainVerification(ctx context.Context, in *MsgAttestDomainVerificationRequest, opts ...grpc.CallOption) (*MsgAttestDomainVerificationResponse, error)
+	// ReclaimNameDeposit returns the deposit paid to bind a name to its depositor once the holding period has passed
+	// and the name is in use.
+	ReclaimNameDeposit(ctx context.Context, in *MsgReclaimNameDepositRequest, opts ...grpc.CallOption) (*MsgReclaimNameDepositResponse, error)
+	// SetNamePolicy sets the policy controlling who can bind names directly under a name.
+	SetNamePolicy(ctx context.Context, in *MsgSetNamePolicyRequest, opts ...grpc.CallOption) (*MsgSetNamePolicyResponse, error)
 }
 
 type msgClient struct {
@@ -263,12 +722,69 @@ func (c *msgClient) DeleteName(ctx context.Context, in *MsgDeleteNameRequest, op
 	return out, nil
 }
 
+func (c *msgClient) RenewName(ctx context.Context, in *MsgRenewNameRequest, opts ...grpc.CallOption) (*MsgRenewNameResponse, error) {
+	out := new(MsgRenewNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/RenewName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestDomainVerification(ctx context.Context, in *MsgRequestDomainVerificationRequest, opts ...grpc.CallOption) (*MsgRequestDomainVerificationResponse, error) {
+	out := new(MsgRequestDomainVerificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/RequestDomainVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestDomainVerification(ctx context.Context, in *MsgAttestDomainVerificationRequest, opts ...grpc.CallOption) (*MsgAttestDomainVerificationResponse, error) {
+	out := new(MsgAttestDomainVerificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/AttestDomainVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReclaimNameDeposit(ctx context.Context, in *MsgReclaimNameDepositRequest, opts ...grpc.CallOption) (*MsgReclaimNameDepositResponse, error) {
+	out := new(MsgReclaimNameDepositResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/ReclaimNameDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetNamePolicy(ctx context.Context, in *MsgSetNamePolicyRequest, opts ...grpc.CallOption) (*MsgSetNamePolicyResponse, error) {
+	out := new(MsgSetNamePolicyResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/SetNamePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// BindName binds a name to an address under a root name.
 	BindName(context.Context, *MsgBindNameRequest) (*MsgBindNameResponse, error)
 	// DeleteName defines a method to verify a particular invariance.
 	DeleteName(context.Context, *MsgDeleteNameRequest) (*MsgDeleteNameResponse, error)
+	// RenewName extends the lease of a bound name.
+	RenewName(context.Context, *MsgRenewNameRequest) (*MsgRenewNameResponse, error)
+	// RequestDomainVerification starts the verification of an external domain, returning the challenge to publish in a
+	// DNS TXT record of the domain.
+	RequestDomainVerification(context.Context, *MsgRequestDomainVerificationRequest) (*MsgRequestDomainVerificationResponse, error)
+	// AttestDomainVerification records whether the verifier found the challenge in a DNS TXT record of the domain.
+	AttestDomainVerification(context.Context, *MsgAttestDomainVerificationRequest) (*MsgAttestDomainVerificationResponse, error)
+	// ReclaimNameDeposit returns the deposit paid to bind a name to its depositor once the holding period has passed
+	// and the name is in use.
+	ReclaimNameDeposit(context.Context, *MsgReclaimNameDepositRequest) (*MsgReclaimNameDepositResponse, error)
+	// SetNamePolicy sets the policy controlling who can bind names directly under a name.
+	SetNamePolicy(context.Context, *MsgSetNamePolicyRequest) (*MsgSetNamePolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -281,6 +797,21 @@ func (*UnimplementedMsgServer) BindName(ctx context.Context, req *MsgBindNameReq
 func (*UnimplementedMsgServer) DeleteName(ctx context.Context, req *MsgDeleteNameRequest) (*MsgDeleteNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteName not implemented")
 }
+func (*UnimplementedMsgServer) RenewName(ctx context.Context, req *MsgRenewNameRequest) (*MsgRenewNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewName not implemented")
+}
+func (*UnimplementedMsgServer) RequestDomainVerification(ctx context.Context, req *MsgRequestDomainVerificationRequest) (*MsgRequestDomainVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDomainVerification not implemented")
+}
+func (*UnimplementedMsgServer) AttestDomainVerification(ctx context.Context, req *MsgAttestDomainVerificationRequest) (*MsgAttestDomainVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestDomainVerification not implemented")
+}
+func (*UnimplementedMsgServer) ReclaimNameDeposit(ctx context.Context, req *MsgReclaimNameDepositRequest) (*MsgReclaimNameDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReclaimNameDeposit not implemented")
+}
+func (*UnimplementedMsgServer) SetNamePolicy(ctx context.Context, req *MsgSetNamePolicyRequest) (*MsgSetNamePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamePolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -322,18 +853,128 @@ func _Msg_DeleteName_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.name.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "BindName",
-			Handler:    _Msg_BindName_Handler,
-		},
+func _Msg_RenewName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/RenewName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewName(ctx, req.(*MsgRenewNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestDomainVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestDomainVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestDomainVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/RequestDomainVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestDomainVerification(ctx, req.(*MsgRequestDomainVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestDomainVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestDomainVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestDomainVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/AttestDomainVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestDomainVerification(ctx, req.(*MsgAttestDomainVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReclaimNameDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReclaimNameDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReclaimNameDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/ReclaimNameDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReclaimNameDeposit(ctx, req.(*MsgReclaimNameDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetNamePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNamePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNamePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/SetNamePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNamePolicy(ctx, req.(*MsgSetNamePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.name.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BindName",
+			Handler:    _Msg_BindName_Handler,
+		},
 		{
 			MethodName: "DeleteName",
 			Handler:    _Msg_DeleteName_Handler,
 		},
+		{
+			MethodName: "RenewName",
+			Handler:    _Msg_RenewName_Handler,
+		},
+		{
+			MethodName: "RequestDomainVerification",
+			Handler:    _Msg_RequestDomainVerification_Handler,
+		},
+		{
+			MethodName: "AttestDomainVerification",
+			Handler:    _Msg_AttestDomainVerification_Handler,
+		},
+		{
+			MethodName: "ReclaimNameDeposit",
+			Handler:    _Msg_ReclaimNameDeposit_Handler,
+		},
+		{
+			MethodName: "SetNamePolicy",
+			Handler:    _Msg_SetNamePolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/name/v1/tx.proto",
@@ -359,6 +1000,11 @@ func (m *MsgBindNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LeaseSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LeaseSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -430,97 +1076,1509 @@ func (m *MsgDeleteNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDeleteNameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteNameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgBindNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LeaseSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LeaseSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestDomainVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestDomainVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestDomainVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestDomainVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestDomainVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestDomainVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestDomainVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestDomainVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestDomainVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestDomainVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestDomainVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestDomainVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimNameDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimNameDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimNameDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimNameDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimNameDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimNameDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNamePolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNamePolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNamePolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issuers) > 0 {
+		for iNdEx := len(m.Issuers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Issuers[iNdEx])
+			copy(dAtA[i:], m.Issuers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Issuers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.IssuancePolicy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IssuancePolicy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNamePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNamePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNamePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgBindNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Parent.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LeaseSeconds != 0 {
+		n += 1 + sovTx(uint64(m.LeaseSeconds))
+	}
+	return n
+}
+
+func (m *MsgBindNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenewNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LeaseSeconds != 0 {
+		n += 1 + sovTx(uint64(m.LeaseSeconds))
+	}
+	return n
+}
+
+func (m *MsgRenewNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestDomainVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestDomainVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttestDomainVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAttestDomainVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReclaimNameDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReclaimNameDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetNamePolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IssuancePolicy != 0 {
+		n += 1 + sovTx(uint64(m.IssuancePolicy))
+	}
+	if len(m.Issuers) > 0 {
+		for _, s := range m.Issuers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetNamePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseSeconds", wireType)
+			}
+			m.LeaseSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBindNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseSeconds", wireType)
+			}
+			m.LeaseSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestDomainVerificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestDomainVerificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestDomainVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestDomainVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestDomainVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestDomainVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestDomainVerificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestDomainVerificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestDomainVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Parent.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgBindNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgDeleteNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAttestDomainVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestDomainVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestDomainVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgDeleteNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgReclaimNameDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -543,17 +2601,17 @@ func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBindNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReclaimNameDepositRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBindNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReclaimNameDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -563,30 +2621,29 @@ func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -596,24 +2653,23 @@ func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -636,7 +2692,7 @@ func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBindNameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReclaimNameDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -659,10 +2715,10 @@ func (m *MsgBindNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBindNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReclaimNameDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBindNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReclaimNameDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -686,7 +2742,7 @@ func (m *MsgBindNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetNamePolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -709,17 +2765,17 @@ func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetNamePolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetNamePolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -729,24 +2785,106 @@ func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuancePolicy", wireType)
+			}
+			m.IssuancePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuancePolicy |= NameIssuancePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuers = append(m.Issuers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -769,7 +2907,7 @@ func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetNamePolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -792,10 +2930,10 @@ func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetNamePolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetNamePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/resolver/types"
+)
+
+// GetQueryCmd is the top-level command for resolver CLI queries.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the resolver module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetResolveCmd(),
+	)
+
+	return queryCmd
+}
+
+// GetResolveCmd returns the command handler for resolving an id to the resource it refers to.
+func GetResolveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve [id]",
+		Short: "Resolve a marker denom, an account, marker, or metadata address, or a name to its resource",
+		Long: `Resolve a marker denom, an account, marker, or metadata address, or a name to its resource.
+
+Metadata addresses resolve to their scope, session, record, or specification, and account addresses to their marker
+or account.  Any other id resolves to the marker with that denom if there is one, otherwise to the bound name.`,
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %[1]s query resolver resolve nhash
+$ %[1]s query resolver resolve scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+$ %[1]s query resolver resolve example.pb`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Resolve(context.Background(), &types.QueryResolveRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	"github.com/provenance-io/provenance/x/resolver/types"
+)
+
+// Keeper defines the resolver module Keeper
+type Keeper struct {
+	accountKeeper  types.AccountKeeper
+	markerKeeper   types.MarkerKeeper
+	nameKeeper     types.NameKeeper
+	metadataKeeper types.MetadataKeeper
+}
+
+// NewKeeper returns a resolver keeper. It handles:
+// - identifying whether an id is a marker denom or address, a metadata address, a name, or an account address
+// - looking up the resource an id resolves to in the module that owns it
+//
+// The resolver module has no state of its own.
+func NewKeeper(
+	accountKeeper types.AccountKeeper,
+	markerKeeper types.MarkerKeeper,
+	nameKeeper types.NameKeeper,
+	metadataKeeper types.MetadataKeeper,
+) Keeper {
+	return Keeper{
+		accountKeeper:  accountKeeper,
+		markerKeeper:   markerKeeper,
+		nameKeeper:     nameKeeper,
+		metadataKeeper: metadataKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// ResolveID identifies the type of resource an id refers to and looks it up.  Bech32 metadata addresses resolve to
+// their scope, session, record, or specification, and bech32 account addresses to their marker or account.  Any
+// other id resolves to the marker with that denom if there is one, otherwise to the bound name.
+func (k Keeper) ResolveID(ctx sdk.Context, id string) (*types.QueryResolveResponse, error) {
+	id = strings.TrimSpace(id)
+	if len(id) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "id cannot be empty")
+	}
+	if maddr, err := metadatatypes.MetadataAddressFromBech32(id); err == nil {
+		return k.resolveMetadataAddress(ctx, maddr)
+	}
+	if addr, err := sdk.AccAddressFromBech32(id); err == nil {
+		return k.resolveAddress(ctx, addr)
+	}
+	if markerAddr, err := markertypes.MarkerAddress(id); err == nil {
+		if m, err := k.markerKeeper.GetMarker(ctx, markerAddr); err == nil && m != nil {
+			return markerResponse(m)
+		}
+	}
+	if name, err := k.nameKeeper.Normalize(ctx, id); err == nil {
+		if record, err := k.nameKeeper.GetRecordByName(ctx, name); err == nil && record != nil {
+			return &types.QueryResolveResponse{
+				Type:    types.ResourceType_RESOURCE_TYPE_NAME,
+				Address: record.Address,
+				Name:    record,
+			}, nil
+		}
+	}
+	return nil, sdkerrors.Wrapf(types.ErrNotResolved, "%s is not a marker denom, address, or bound name", id)
+}
+
+// resolveAddress resolves an account address to its marker, or to its account when it is not a marker.  An address
+// without an account still resolves to a plain account, it has not received anything yet.
+func (k Keeper) resolveAddress(ctx sdk.Context, addr sdk.AccAddress) (*types.QueryResolveResponse, error) {
+	if m, err := k.markerKeeper.GetMarker(ctx, addr); err == nil && m != nil {
+		return markerResponse(m)
+	}
+	res := &types.QueryResolveResponse{Type: types.ResourceType_RESOURCE_TYPE_ACCOUNT, Address: addr.String()}
+	if acc := k.accountKeeper.GetAccount(ctx, addr); acc != nil {
+		any, err := codectypes.NewAnyWithValue(acc)
+		if err != nil {
+			return nil, err
+		}
+		res.Account = any
+	}
+	return res, nil
+}
+
+// resolveMetadataAddress resolves a metadata address to the scope, session, record, or specification it identifies.
+func (k Keeper) resolveMetadataAddress(ctx sdk.Context, maddr metadatatypes.MetadataAddress) (*types.QueryResolveResponse, error) {
+	res := &types.QueryResolveResponse{Address: maddr.String()}
+	found := false
+	switch {
+	case maddr.IsScopeAddress():
+		res.Type = types.ResourceType_RESOURCE_TYPE_SCOPE
+		var scope metadatatypes.Scope
+		if scope, found = k.metadataKeeper.GetScope(ctx, maddr); found {
+			res.Scope = &scope
+		}
+	case maddr.IsSessionAddress():
+		res.Type = types.ResourceType_RESOURCE_TYPE_SESSION
+		var session metadatatypes.Session
+		if session, found = k.metadataKeeper.GetSession(ctx, maddr); found {
+			res.Session = &session
+		}
+	case maddr.IsRecordAddress():
+		res.Type = types.ResourceType_RESOURCE_TYPE_RECORD
+		var record metadatatypes.Record
+		if record, found = k.metadataKeeper.GetRecord(ctx, maddr); found {
+			res.Record = &record
+		}
+	case maddr.IsScopeSpecificationAddress():
+		res.Type = types.ResourceType_RESOURCE_TYPE_SCOPE_SPECIFICATION
+		var spec metadatatypes.ScopeSpecification
+		if spec, found = k.metadataKeeper.GetScopeSpecification(ctx, maddr); found {
+			res.ScopeSpecification = &spec
+		}
+	case maddr.IsContractSpecificationAddress():
+		res.Type = types.ResourceType_RESOURCE_TYPE_CONTRACT_SPECIFICATION
+		var spec metadatatypes.ContractSpecification
+		if spec, found = k.metadataKeeper.GetContractSpecification(ctx, maddr); found {
+			res.ContractSpecification = &spec
+		}
+	case maddr.IsRecordSpecificationAddress():
+		res.Type = types.ResourceType_RESOURCE_TYPE_RECORD_SPECIFICATION
+		var spec metadatatypes.RecordSpecification
+		if spec, found = k.metadataKeeper.GetRecordSpecification(ctx, maddr); found {
+			res.RecordSpecification = &spec
+		}
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNotResolved, "metadata address %s not found", maddr)
+	}
+	return res, nil
+}
+
+// markerResponse returns the response resolving an id to a marker.
+func markerResponse(m markertypes.MarkerAccountI) (*types.QueryResolveResponse, error) {
+	any, err := codectypes.NewAnyWithValue(m)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryResolveResponse{
+		Type:    types.ResourceType_RESOURCE_TYPE_MARKER,
+		Address: m.GetAddress().String(),
+		Marker:  any,
+	}, nil
+}
@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	"github.com/provenance-io/provenance/x/resolver/keeper"
+	"github.com/provenance-io/provenance/x/resolver/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app    *simapp.App
+	ctx    sdk.Context
+	keeper keeper.Keeper
+
+	user sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = simapp.Setup(false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Height: 100})
+	s.keeper = s.app.ResolverKeeper
+	s.user = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+func (s *KeeperTestSuite) TestResolveMarkerDenom() {
+	m := markertypes.NewEmptyMarkerAccount("resolvecoin", s.user.String(), nil)
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, m))
+	// a name that is also a marker denom resolves to the marker
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "resolvecoin", s.user, false))
+
+	res, err := s.keeper.ResolveID(s.ctx, " resolvecoin ")
+	s.Require().NoError(err)
+	s.Require().Equal(types.ResourceType_RESOURCE_TYPE_MARKER, res.Type)
+	s.Require().Equal(m.GetAddress().String(), res.Address)
+	s.Require().Nil(res.Account)
+	s.Require().Nil(res.Name)
+	s.requireMarker(m, res)
+}
+
+func (s *KeeperTestSuite) TestResolveMarkerAccount() {
+	m := markertypes.NewEmptyMarkerAccount("resolvecoin", s.user.String(), nil)
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, m))
+
+	res, err := s.keeper.ResolveID(s.ctx, m.GetAddress().String())
+	s.Require().NoError(err)
+	s.Require().Equal(types.ResourceType_RESOURCE_TYPE_MARKER, res.Type)
+	s.Require().Equal(m.GetAddress().String(), res.Address)
+	s.Require().Nil(res.Account, "a marker account resolves to its marker")
+	s.requireMarker(m, res)
+}
+
+// requireMarker asserts that the response holds the marker.
+func (s *KeeperTestSuite) requireMarker(expected markertypes.MarkerAccountI, res *types.QueryResolveResponse) {
+	s.Require().NotNil(res.Marker)
+	var m markertypes.MarkerAccountI
+	s.Require().NoError(s.app.InterfaceRegistry().UnpackAny(res.Marker, &m))
+	s.Require().Equal(expected.GetDenom(), m.GetDenom())
+	s.Require().Equal(expected.GetAddress(), m.GetAddress())
+}
+
+func (s *KeeperTestSuite) TestResolveAccount() {
+	res, err := s.keeper.ResolveID(s.ctx, s.user.String())
+	s.Require().NoError(err)
+	s.Require().Equal(types.ResourceType_RESOURCE_TYPE_ACCOUNT, res.Type)
+	s.Require().Equal(s.user.String(), res.Address)
+	s.Require().Nil(res.Account, "an address without an account has no account")
+
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user))
+	res, err = s.keeper.ResolveID(s.ctx, s.user.String())
+	s.Require().NoError(err)
+	s.Require().Equal(types.ResourceType_RESOURCE_TYPE_ACCOUNT, res.Type)
+	s.Require().Equal(s.user.String(), res.Address)
+	s.Require().Nil(res.Marker)
+	s.Require().NotNil(res.Account)
+	var acc authtypes.AccountI
+	s.Require().NoError(s.app.InterfaceRegistry().UnpackAny(res.Account, &acc))
+	s.Require().Equal(s.user, acc.GetAddress())
+}
+
+func (s *KeeperTestSuite) TestResolveName() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "example", s.user, false))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "resolve.example", s.user, false))
+
+	res, err := s.keeper.ResolveID(s.ctx, "Resolve.Example")
+	s.Require().NoError(err)
+	s.Require().Equal(types.ResourceType_RESOURCE_TYPE_NAME, res.Type)
+	s.Require().Equal(s.user.String(), res.Address)
+	s.Require().Equal("resolve.example", res.Name.Name)
+	s.Require().Nil(res.Marker)
+
+	_, err = s.keeper.ResolveID(s.ctx, "missing.example")
+	s.Require().ErrorIs(err, types.ErrNotResolved, "unbound name")
+}
+
+func (s *KeeperTestSuite) TestResolveMetadataAddress() {
+	scopeUUID := uuid.New()
+	scopeID := metadatatypes.ScopeMetadataAddress(scopeUUID)
+	sessionID := metadatatypes.SessionMetadataAddress(scopeUUID, uuid.New())
+	recordID := metadatatypes.RecordMetadataAddress(scopeUUID, "resolverecord")
+	scopeSpecID := metadatatypes.ScopeSpecMetadataAddress(uuid.New())
+	cSpecUUID := uuid.New()
+	cSpecID := metadatatypes.ContractSpecMetadataAddress(cSpecUUID)
+	recSpecID := metadatatypes.RecordSpecMetadataAddress(cSpecUUID, "resolverecord")
+
+	owners := []metadatatypes.Party{{Address: s.user.String(), Role: metadatatypes.PartyType_PARTY_TYPE_OWNER}}
+	s.app.MetadataKeeper.SetScope(s.ctx, *metadatatypes.NewScope(scopeID, scopeSpecID, owners, []string{s.user.String()}, s.user.String()))
+	s.app.MetadataKeeper.SetSession(s.ctx, metadatatypes.Session{SessionId: sessionID, SpecificationId: cSpecID, Parties: owners, Name: "resolvesession"})
+	s.app.MetadataKeeper.SetRecord(s.ctx, metadatatypes.Record{Name: "resolverecord", SessionId: sessionID, SpecificationId: recSpecID})
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, metadatatypes.ScopeSpecification{SpecificationId: scopeSpecID, OwnerAddresses: []string{s.user.String()}})
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, metadatatypes.ContractSpecification{SpecificationId: cSpecID, OwnerAddresses: []string{s.user.String()}})
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, metadatatypes.RecordSpecification{SpecificationId: recSpecID, Name: "resolverecord"})
+
+	tests := []struct {
+		name     string
+		id       metadatatypes.MetadataAddress
+		missing  metadatatypes.MetadataAddress
+		expected types.ResourceType
+		resource func(res *types.QueryResolveResponse) metadatatypes.MetadataAddress
+	}{
+		{"scope", scopeID, metadatatypes.ScopeMetadataAddress(uuid.New()), types.ResourceType_RESOURCE_TYPE_SCOPE,
+			func(res *types.QueryResolveResponse) metadatatypes.MetadataAddress { return res.Scope.ScopeId }},
+		{"session", sessionID, metadatatypes.SessionMetadataAddress(scopeUUID, uuid.New()), types.ResourceType_RESOURCE_TYPE_SESSION,
+			func(res *types.QueryResolveResponse) metadatatypes.MetadataAddress { return res.Session.SessionId }},
+		{"record", recordID, metadatatypes.RecordMetadataAddress(scopeUUID, "missing"), types.ResourceType_RESOURCE_TYPE_RECORD,
+			func(res *types.QueryResolveResponse) metadatatypes.MetadataAddress {
+				return res.Record.SessionId.MustGetAsRecordAddress(res.Record.Name)
+			}},
+		{"scope specification", scopeSpecID, metadatatypes.ScopeSpecMetadataAddress(uuid.New()), types.ResourceType_RESOURCE_TYPE_SCOPE_SPECIFICATION,
+			func(res *types.QueryResolveResponse) metadatatypes.MetadataAddress {
+				return res.ScopeSpecification.SpecificationId
+			}},
+		{"contract specification", cSpecID, metadatatypes.ContractSpecMetadataAddress(uuid.New()), types.ResourceType_RESOURCE_TYPE_CONTRACT_SPECIFICATION,
+			func(res *types.QueryResolveResponse) metadatatypes.MetadataAddress {
+				return res.ContractSpecification.SpecificationId
+			}},
+		{"record specification", recSpecID, metadatatypes.RecordSpecMetadataAddress(cSpecUUID, "missing"), types.ResourceType_RESOURCE_TYPE_RECORD_SPECIFICATION,
+			func(res *types.QueryResolveResponse) metadatatypes.MetadataAddress {
+				return res.RecordSpecification.SpecificationId
+			}},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			res, err := s.keeper.ResolveID(s.ctx, tc.id.String())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, res.Type)
+			s.Require().Equal(tc.id.String(), res.Address)
+			s.Require().Equal(tc.id, tc.resource(res))
+
+			_, err = s.keeper.ResolveID(s.ctx, tc.missing.String())
+			s.Require().ErrorIs(err, types.ErrNotResolved, "unknown %s", tc.name)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestResolveNotFound() {
+	_, err := s.keeper.ResolveID(s.ctx, "")
+	s.Require().Error(err)
+	_, err = s.keeper.ResolveID(s.ctx, "unknowncoin")
+	s.Require().ErrorIs(err, types.ErrNotResolved)
+	_, err = s.keeper.Resolve(sdk.WrapSDKContext(s.ctx), &types.QueryResolveRequest{Id: "unknown.name"})
+	s.Require().Error(err)
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/resolver/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Resolve queries for the type of resource an id refers to and the resource itself
+func (k Keeper) Resolve(c context.Context, req *types.QueryResolveRequest) (*types.QueryResolveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	res, err := k.ResolveID(ctx, req.Id)
+	switch {
+	case types.ErrNotResolved.Is(err):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return res, nil
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	"github.com/provenance-io/provenance/x/resolver/types"
+)
+
+type QueryServerTestSuite struct {
+	suite.Suite
+
+	app         *simapp.App
+	ctx         sdk.Context
+	queryClient types.QueryClient
+
+	user   sdk.AccAddress
+	marker markertypes.MarkerAccountI
+	scope  metadatatypes.MetadataAddress
+}
+
+func TestQueryServerTestSuite(t *testing.T) {
+	suite.Run(t, new(QueryServerTestSuite))
+}
+
+func (s *QueryServerTestSuite) SetupTest() {
+	s.app = simapp.Setup(false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Height: 100})
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, s.app.ResolverKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+
+	s.user = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user))
+
+	s.marker = markertypes.NewEmptyMarkerAccount("resolvecoin", s.user.String(), nil)
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, s.marker))
+
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "example", s.user, false))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "resolve.example", s.user, false))
+
+	s.scope = metadatatypes.ScopeMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScope(s.ctx, *metadatatypes.NewScope(s.scope, nil,
+		[]metadatatypes.Party{{Address: s.user.String(), Role: metadatatypes.PartyType_PARTY_TYPE_OWNER}},
+		[]string{s.user.String()}, s.user.String()))
+}
+
+func (s *QueryServerTestSuite) TestResolve() {
+	tests := []struct {
+		name     string
+		id       string
+		expected types.ResourceType
+		address  string
+	}{
+		{"metadata address", s.scope.String(), types.ResourceType_RESOURCE_TYPE_SCOPE, s.scope.String()},
+		{"account", s.user.String(), types.ResourceType_RESOURCE_TYPE_ACCOUNT, s.user.String()},
+		{"marker account", s.marker.GetAddress().String(), types.ResourceType_RESOURCE_TYPE_MARKER, s.marker.GetAddress().String()},
+		{"marker denom", "resolvecoin", types.ResourceType_RESOURCE_TYPE_MARKER, s.marker.GetAddress().String()},
+		{"name", "resolve.example", types.ResourceType_RESOURCE_TYPE_NAME, s.user.String()},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.Resolve(gocontext.Background(), &types.QueryResolveRequest{Id: tc.id})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, res.Type)
+			s.Require().Equal(tc.address, res.Address)
+			switch tc.expected {
+			case types.ResourceType_RESOURCE_TYPE_SCOPE:
+				s.Require().Equal(s.scope, res.Scope.ScopeId)
+			case types.ResourceType_RESOURCE_TYPE_ACCOUNT:
+				s.Require().NotNil(res.Account)
+			case types.ResourceType_RESOURCE_TYPE_MARKER:
+				var m markertypes.MarkerAccountI
+				s.Require().NoError(s.app.InterfaceRegistry().UnpackAny(res.Marker, &m))
+				s.Require().Equal("resolvecoin", m.GetDenom())
+			case types.ResourceType_RESOURCE_TYPE_NAME:
+				s.Require().Equal(tc.id, res.Name.Name)
+			}
+		})
+	}
+}
+
+func (s *QueryServerTestSuite) TestResolveErrors() {
+	tests := []struct {
+		name string
+		id   string
+		code codes.Code
+	}{
+		{"empty id", " ", codes.InvalidArgument},
+		{"unknown metadata address", metadatatypes.ScopeMetadataAddress(uuid.New()).String(), codes.NotFound},
+		{"unknown denom", "unknowncoin", codes.NotFound},
+		{"unbound name", "missing.example", codes.NotFound},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			_, err := s.queryClient.Resolve(gocontext.Background(), &types.QueryResolveRequest{Id: tc.id})
+			s.Require().Error(err)
+			s.Require().Equal(tc.code, status.Code(err), err.Error())
+		})
+	}
+
+	_, err := s.app.ResolverKeeper.Resolve(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err), "nil request")
+}
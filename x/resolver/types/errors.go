@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/resolver module errors
+var (
+	// ErrNotResolved occurs when an id is not a marker, metadata address, name, or account address
+	ErrNotResolved = sdkerrors.Register(ModuleName, 2, "id does not resolve to a resource")
+)
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+// AccountKeeper defines the expected account keeper used to look up plain accounts (noalias)
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// MarkerKeeper defines the expected marker keeper used to resolve marker denoms and addresses (noalias)
+type MarkerKeeper interface {
+	GetMarker(ctx sdk.Context, address sdk.AccAddress) (markertypes.MarkerAccountI, error)
+}
+
+// NameKeeper defines the expected name keeper used to resolve names (noalias)
+type NameKeeper interface {
+	Normalize(ctx sdk.Context, name string) (string, error)
+	GetRecordByName(ctx sdk.Context, name string) (record *nametypes.NameRecord, err error)
+}
+
+// MetadataKeeper defines the expected metadata keeper used to resolve metadata addresses (noalias)
+type MetadataKeeper interface {
+	GetScope(ctx sdk.Context, id metadatatypes.MetadataAddress) (metadatatypes.Scope, bool)
+	GetSession(ctx sdk.Context, id metadatatypes.MetadataAddress) (metadatatypes.Session, bool)
+	GetRecord(ctx sdk.Context, id metadatatypes.MetadataAddress) (metadatatypes.Record, bool)
+	GetScopeSpecification(ctx sdk.Context, id metadatatypes.MetadataAddress) (metadatatypes.ScopeSpecification, bool)
+	GetContractSpecification(ctx sdk.Context, id metadatatypes.MetadataAddress) (metadatatypes.ContractSpecification, bool)
+	GetRecordSpecification(ctx sdk.Context, id metadatatypes.MetadataAddress) (metadatatypes.RecordSpecification, bool)
+}
@@ -0,0 +1,9 @@
+package types
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "resolver"
+
+	// QuerierRoute is the querier route for resolver
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,1272 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/resolver/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types2 "github.com/provenance-io/provenance/x/metadata/types"
+	types1 "github.com/provenance-io/provenance/x/name/types"
+	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ResourceType is the type of resource an id resolves to.
+type ResourceType int32
+
+const (
+	// RESOURCE_TYPE_UNSPECIFIED is an unknown resource type
+	ResourceType_RESOURCE_TYPE_UNSPECIFIED ResourceType = 0
+	// RESOURCE_TYPE_MARKER is a marker, resolved from its denom or marker account address
+	ResourceType_RESOURCE_TYPE_MARKER ResourceType = 1
+	// RESOURCE_TYPE_SCOPE is a metadata scope
+	ResourceType_RESOURCE_TYPE_SCOPE ResourceType = 2
+	// RESOURCE_TYPE_SESSION is a metadata session
+	ResourceType_RESOURCE_TYPE_SESSION ResourceType = 3
+	// RESOURCE_TYPE_RECORD is a metadata record
+	ResourceType_RESOURCE_TYPE_RECORD ResourceType = 4
+	// RESOURCE_TYPE_SCOPE_SPECIFICATION is a metadata scope specification
+	ResourceType_RESOURCE_TYPE_SCOPE_SPECIFICATION ResourceType = 5
+	// RESOURCE_TYPE_CONTRACT_SPECIFICATION is a metadata contract specification
+	ResourceType_RESOURCE_TYPE_CONTRACT_SPECIFICATION ResourceType = 6
+	// RESOURCE_TYPE_RECORD_SPECIFICATION is a metadata record specification
+	ResourceType_RESOURCE_TYPE_RECORD_SPECIFICATION ResourceType = 7
+	// RESOURCE_TYPE_NAME is a bound name
+	ResourceType_RESOURCE_TYPE_NAME ResourceType = 8
+	// RESOURCE_TYPE_ACCOUNT is an account address that is not a marker
+	ResourceType_RESOURCE_TYPE_ACCOUNT ResourceType = 9
+)
+
+var ResourceType_name = map[int32]string{
+	0: "RESOURCE_TYPE_UNSPECIFIED",
+	1: "RESOURCE_TYPE_MARKER",
+	2: "RESOURCE_TYPE_SCOPE",
+	3: "RESOURCE_TYPE_SESSION",
+	4: "RESOURCE_TYPE_RECORD",
+	5: "RESOURCE_TYPE_SCOPE_SPECIFICATION",
+	6: "RESOURCE_TYPE_CONTRACT_SPECIFICATION",
+	7: "RESOURCE_TYPE_RECORD_SPECIFICATION",
+	8: "RESOURCE_TYPE_NAME",
+	9: "RESOURCE_TYPE_ACCOUNT",
+}
+
+var ResourceType_value = map[string]int32{
+	"RESOURCE_TYPE_UNSPECIFIED":            0,
+	"RESOURCE_TYPE_MARKER":                 1,
+	"RESOURCE_TYPE_SCOPE":                  2,
+	"RESOURCE_TYPE_SESSION":                3,
+	"RESOURCE_TYPE_RECORD":                 4,
+	"RESOURCE_TYPE_SCOPE_SPECIFICATION":    5,
+	"RESOURCE_TYPE_CONTRACT_SPECIFICATION": 6,
+	"RESOURCE_TYPE_RECORD_SPECIFICATION":   7,
+	"RESOURCE_TYPE_NAME":                   8,
+	"RESOURCE_TYPE_ACCOUNT":                9,
+}
+
+func (x ResourceType) String() string {
+	return proto.EnumName(ResourceType_name, int32(x))
+}
+
+func (ResourceType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0636ca6a03e3ff5b, []int{0}
+}
+
+// QueryResolveRequest is the request type for the Query/Resolve method.
+type QueryResolveRequest struct {
+	// a marker denom, a bech32 account, marker, or metadata address, or a name
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryResolveRequest) Reset()         { *m = QueryResolveRequest{} }
+func (m *QueryResolveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveRequest) ProtoMessage()    {}
+func (*QueryResolveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636ca6a03e3ff5b, []int{0}
+}
+func (m *QueryResolveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResolveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveRequest.Merge(m, src)
+}
+func (m *QueryResolveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolveRequest proto.InternalMessageInfo
+
+func (m *QueryResolveRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryResolveResponse is the response type for the Query/Resolve method.  Only the field of the resolved resource
+// type is set.
+type QueryResolveResponse struct {
+	// the type of resource the id resolves to
+	Type ResourceType `protobuf:"varint,1,opt,name=type,proto3,enum=provenance.resolver.v1.ResourceType" json:"type,omitempty"`
+	// the bech32 address of the resource, e.g. the marker address of a denom or the address a name is bound to
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// the marker of a marker denom or address
+	Marker *types.Any `protobuf:"bytes,3,opt,name=marker,proto3" json:"marker,omitempty"`
+	// the account of an account address, unset when the address has no account yet
+	Account *types.Any `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	// the record of a bound name
+	Name *types1.NameRecord `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// the scope of a scope address
+	Scope *types2.Scope `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope,omitempty"`
+	// the session of a session address
+	Session *types2.Session `protobuf:"bytes,7,opt,name=session,proto3" json:"session,omitempty"`
+	// the record of a record address
+	Record *types2.Record `protobuf:"bytes,8,opt,name=record,proto3" json:"record,omitempty"`
+	// the scope specification of a scope specification address
+	ScopeSpecification *types2.ScopeSpecification `protobuf:"bytes,9,opt,name=scope_specification,json=scopeSpecification,proto3" json:"scope_specification,omitempty" yaml:"scope_specification"`
+	// the contract specification of a contract specification address
+	ContractSpecification *types2.ContractSpecification `protobuf:"bytes,10,opt,name=contract_specification,json=contractSpecification,proto3" json:"contract_specification,omitempty" yaml:"contract_specification"`
+	// the record specification of a record specification address
+	RecordSpecification *types2.RecordSpecification `protobuf:"bytes,11,opt,name=record_specification,json=recordSpecification,proto3" json:"record_specification,omitempty" yaml:"record_specification"`
+}
+
+func (m *QueryResolveResponse) Reset()         { *m = QueryResolveResponse{} }
+func (m *QueryResolveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveResponse) ProtoMessage()    {}
+func (*QueryResolveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636ca6a03e3ff5b, []int{1}
+}
+func (m *QueryResolveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResolveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveResponse.Merge(m, src)
+}
+func (m *QueryResolveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolveResponse proto.InternalMessageInfo
+
+func (m *QueryResolveResponse) GetType() ResourceType {
+	if m != nil {
+		return m.Type
+	}
+	return ResourceType_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (m *QueryResolveResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryResolveResponse) GetMarker() *types.Any {
+	if m != nil {
+		return m.Marker
+	}
+	return nil
+}
+
+func (m *QueryResolveResponse) GetAccount() *types.Any {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *QueryResolveResponse) GetName() *types1.NameRecord {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *QueryResolveResponse) GetScope() *types2.Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+func (m *QueryResolveResponse) GetSession() *types2.Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+func (m *QueryResolveResponse) GetRecord() *types2.Record {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+func (m *QueryResolveResponse) GetScopeSpecification() *types2.ScopeSpecification {
+	if m != nil {
+		return m.ScopeSpecification
+	}
+	return nil
+}
+
+func (m *QueryResolveResponse) GetContractSpecification() *types2.ContractSpecification {
+	if m != nil {
+		return m.ContractSpecification
+	}
+	return nil
+}
+
+func (m *QueryResolveResponse) GetRecordSpecification() *types2.RecordSpecification {
+	if m != nil {
+		return m.RecordSpecification
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("provenance.resolver.v1.ResourceType", ResourceType_name, ResourceType_value)
+	proto.RegisterType((*QueryResolveRequest)(nil), "provenance.resolver.v1.QueryResolveRequest")
+	proto.RegisterType((*QueryResolveResponse)(nil), "provenance.resolver.v1.QueryResolveResponse")
+}
+
+func init() {
+	proto.RegisterFile("provenance/resolver/v1/query.proto", fileDescriptor_0636ca6a03e3ff5b)
+}
+
+var fileDescriptor_0636ca6a03e3ff5b = []byte{
+	// 788 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0xe3, 0x90, 0x3f, 0x30, 0x20, 0x14, 0x4d, 0x02, 0xd7, 0xc9, 0xbd, 0x71, 0xc0, 0x02,
+	0x84, 0x80, 0xd8, 0x22, 0x5c, 0x5d, 0xdd, 0x56, 0x5d, 0x34, 0x18, 0x57, 0x8a, 0x2a, 0x92, 0x74,
+	0x12, 0x16, 0xed, 0x26, 0x32, 0xce, 0x90, 0x5a, 0x10, 0x8f, 0xb1, 0x9d, 0xa8, 0x11, 0xaa, 0x2a,
+	0x75, 0xd3, 0x6d, 0xd5, 0x6e, 0xfb, 0x18, 0xbc, 0x40, 0x77, 0x15, 0x8b, 0x0a, 0xa9, 0x9b, 0xae,
+	0x50, 0x05, 0x7d, 0x02, 0x9e, 0xa0, 0xf2, 0xd8, 0x29, 0x76, 0xe2, 0xa0, 0xae, 0xf0, 0x70, 0x7e,
+	0xdf, 0x77, 0xbe, 0x39, 0x4e, 0x4e, 0x00, 0x6f, 0x98, 0xa4, 0x8f, 0x75, 0x45, 0x57, 0xb1, 0x68,
+	0x62, 0x8b, 0x9c, 0xf4, 0xb1, 0x29, 0xf6, 0xb7, 0xc5, 0xd3, 0x1e, 0x36, 0x07, 0x82, 0x61, 0x12,
+	0x9b, 0xc0, 0xc5, 0x3b, 0x46, 0x18, 0x32, 0x42, 0x7f, 0x3b, 0x97, 0xe9, 0x90, 0x0e, 0xa1, 0x88,
+	0xe8, 0x3c, 0xb9, 0x74, 0xee, 0x9f, 0x0e, 0x21, 0x9d, 0x13, 0x2c, 0x2a, 0x86, 0x26, 0x2a, 0xba,
+	0x4e, 0x6c, 0xc5, 0xd6, 0x88, 0x6e, 0x79, 0xd5, 0xac, 0x57, 0xa5, 0xa7, 0xc3, 0xde, 0x91, 0xa8,
+	0xe8, 0x83, 0x61, 0x49, 0x25, 0x56, 0x97, 0x58, 0x2d, 0xd7, 0xd1, 0x3d, 0x78, 0x25, 0x7f, 0xca,
+	0x2e, 0xb6, 0x95, 0xb6, 0x62, 0x2b, 0x4e, 0x4a, 0x4b, 0x25, 0x06, 0xf6, 0x98, 0x8d, 0x49, 0x8c,
+	0x81, 0x55, 0xed, 0x48, 0x53, 0x69, 0x0c, 0x8f, 0xcd, 0xfb, 0x58, 0x5d, 0xe9, 0x62, 0x87, 0x73,
+	0xfe, 0xba, 0x65, 0x7e, 0x15, 0xa4, 0x9f, 0x39, 0xf7, 0x47, 0xee, 0x65, 0x11, 0x3e, 0xed, 0x61,
+	0xcb, 0x86, 0xf3, 0x20, 0xaa, 0xb5, 0x59, 0x66, 0x89, 0x59, 0x9f, 0x41, 0x51, 0xad, 0xcd, 0x7f,
+	0x4d, 0x80, 0x4c, 0x90, 0xb3, 0x0c, 0xa2, 0x5b, 0x18, 0xfe, 0x0f, 0x62, 0xf6, 0xc0, 0xc0, 0x14,
+	0x9d, 0x2f, 0xad, 0x08, 0xe1, 0xf3, 0x13, 0x1c, 0x59, 0xcf, 0x54, 0x71, 0x73, 0x60, 0x60, 0x44,
+	0x15, 0x90, 0x05, 0x49, 0xa5, 0xdd, 0x36, 0xb1, 0x65, 0xb1, 0x51, 0xda, 0x67, 0x78, 0x84, 0x8f,
+	0x41, 0xa2, 0xab, 0x98, 0xc7, 0xd8, 0x64, 0xa7, 0x96, 0x98, 0xf5, 0xd9, 0x52, 0x46, 0x70, 0x27,
+	0x29, 0x0c, 0x27, 0x29, 0x94, 0xf5, 0xc1, 0x2e, 0xbc, 0x38, 0x2f, 0xce, 0xef, 0x53, 0xae, 0xac,
+	0xaa, 0xa4, 0xa7, 0xdb, 0x15, 0xe4, 0xe9, 0xe0, 0x23, 0x90, 0x54, 0xdc, 0xff, 0xb1, 0xb1, 0x7b,
+	0x2c, 0xe6, 0x2e, 0xce, 0x8b, 0xd3, 0xbf, 0xc5, 0x43, 0x09, 0x2c, 0x81, 0x98, 0x33, 0x21, 0x36,
+	0x4e, 0xa5, 0x9c, 0xff, 0x4e, 0x74, 0x72, 0xfd, 0x6d, 0xa1, 0xaa, 0x74, 0x31, 0xc2, 0x2a, 0x31,
+	0xdb, 0x88, 0xb2, 0x70, 0x07, 0xc4, 0xe9, 0x1b, 0x62, 0x13, 0x54, 0x94, 0xf7, 0x8b, 0x86, 0xaf,
+	0xc8, 0x11, 0x36, 0x1c, 0x08, 0xb9, 0x2c, 0x7c, 0x00, 0x92, 0x16, 0xb6, 0x2c, 0x8d, 0xe8, 0x6c,
+	0x92, 0xca, 0x0a, 0x13, 0x65, 0x2e, 0x86, 0x86, 0x3c, 0xfc, 0x0f, 0x24, 0x4c, 0xda, 0x9f, 0x9d,
+	0x1e, 0x4f, 0xe9, 0x57, 0x7a, 0x29, 0x3d, 0x1a, 0x9e, 0x81, 0x34, 0xed, 0xdd, 0x0a, 0x7c, 0x56,
+	0xd8, 0x19, 0x6a, 0xb2, 0x71, 0x6f, 0xea, 0x86, 0x5f, 0xb1, 0xcb, 0xdd, 0x5e, 0x15, 0x72, 0x03,
+	0xa5, 0x7b, 0xf2, 0x90, 0x0f, 0x31, 0xe4, 0x11, 0xb4, 0xc6, 0x34, 0xf0, 0x1d, 0x03, 0x16, 0x55,
+	0xa2, 0xdb, 0xa6, 0xa2, 0xda, 0x23, 0x01, 0x00, 0x0d, 0x50, 0x9c, 0x14, 0x40, 0xf2, 0x54, 0xc1,
+	0x0c, 0xcb, 0xb7, 0x57, 0x85, 0xbc, 0x9b, 0x21, 0xdc, 0x96, 0x47, 0x0b, 0x6a, 0x98, 0x12, 0xbe,
+	0x01, 0x19, 0x77, 0x20, 0x23, 0x31, 0x66, 0x69, 0x8c, 0xcd, 0xfb, 0x87, 0x19, 0x0c, 0x51, 0xb8,
+	0xbd, 0x2a, 0xfc, 0xed, 0x86, 0x08, 0xb3, 0xe4, 0x51, 0xda, 0x1c, 0x57, 0x6d, 0x7c, 0x8e, 0x82,
+	0x39, 0xff, 0x97, 0x02, 0xe6, 0x41, 0x16, 0xc9, 0x8d, 0xda, 0x01, 0x92, 0xe4, 0x56, 0xf3, 0x79,
+	0x5d, 0x6e, 0x1d, 0x54, 0x1b, 0x75, 0x59, 0xaa, 0x3c, 0xa9, 0xc8, 0x7b, 0xa9, 0x08, 0x64, 0x41,
+	0x26, 0x58, 0xde, 0x2f, 0xa3, 0xa7, 0x32, 0x4a, 0x31, 0xf0, 0x2f, 0x90, 0x0e, 0x56, 0x1a, 0x52,
+	0xad, 0x2e, 0xa7, 0xa2, 0x30, 0x0b, 0x16, 0x46, 0x0a, 0x72, 0xa3, 0x51, 0xa9, 0x55, 0x53, 0x53,
+	0xe3, 0x6e, 0x48, 0x96, 0x6a, 0x68, 0x2f, 0x15, 0x83, 0xab, 0x60, 0x39, 0xc4, 0xad, 0xe5, 0x45,
+	0x91, 0xca, 0x4d, 0xc7, 0x20, 0x0e, 0xd7, 0xc1, 0x4a, 0x10, 0x93, 0x6a, 0xd5, 0x26, 0x2a, 0x4b,
+	0xcd, 0x11, 0x32, 0x01, 0xd7, 0x00, 0x1f, 0xd6, 0x6a, 0x84, 0x4b, 0xc2, 0x45, 0x00, 0x83, 0x5c,
+	0xb5, 0xbc, 0x2f, 0xa7, 0xa6, 0xc7, 0x6f, 0x51, 0x96, 0xa4, 0xda, 0x41, 0xb5, 0x99, 0x9a, 0x29,
+	0x7d, 0x62, 0x40, 0x9c, 0x2e, 0x25, 0xf8, 0x81, 0x01, 0x49, 0x6f, 0x33, 0xc1, 0xcd, 0x49, 0x3b,
+	0x28, 0x64, 0xcf, 0xe5, 0xb6, 0xfe, 0x0c, 0x76, 0x97, 0x1d, 0xbf, 0xf5, 0xf6, 0xdb, 0xcf, 0x8f,
+	0xd1, 0x35, 0xb8, 0x22, 0x4e, 0xf8, 0x29, 0xf1, 0x9e, 0xc5, 0x33, 0xad, 0xfd, 0x7a, 0xf7, 0xf8,
+	0xcb, 0x35, 0xc7, 0x5c, 0x5e, 0x73, 0xcc, 0x8f, 0x6b, 0x8e, 0x79, 0x7f, 0xc3, 0x45, 0x2e, 0x6f,
+	0xb8, 0xc8, 0xf7, 0x1b, 0x2e, 0x02, 0xb2, 0x1a, 0x99, 0xd0, 0xb7, 0xce, 0xbc, 0xf8, 0xb7, 0xa3,
+	0xd9, 0x2f, 0x7b, 0x87, 0x82, 0x4a, 0xba, 0xbe, 0x36, 0x45, 0x8d, 0xf8, 0x9b, 0xbe, 0xba, 0x6b,
+	0xeb, 0x2c, 0x53, 0xeb, 0x30, 0x41, 0x17, 0xdb, 0xce, 0xaf, 0x01, 0x00, 0x62, 0x4c, 0x06, 0xe8,
+	0xe5, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Resolve identifies whether an id is a marker denom or address, a metadata address, a name, or an account address
+	// and returns the resource it resolves to.
+	Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error) {
+	out := new(QueryResolveResponse)
+	err := c.cc.Invoke(ctx, "/provenance.resolver.v1.Query/Resolve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Resolve identifies whether an id is a marker denom or address, a metadata address, a name, or an account address
+	// and returns the resource it resolves to.
+	Resolve(context.Context, *QueryResolveRequest) (*QueryResolveResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Resolve(ctx context.Context, req *QueryResolveRequest) (*QueryResolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resolve not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Resolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Resolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.resolver.v1.Query/Resolve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Resolve(ctx, req.(*QueryResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.resolver.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Resolve",
+			Handler:    _Query_Resolve_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/resolver/v1/query.proto",
+}
+
+func (m *QueryResolveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordSpecification != nil {
+		{
+			size, err := m.RecordSpecification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ContractSpecification != nil {
+		{
+			size, err := m.ContractSpecification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ScopeSpecification != nil {
+		{
+			size, err := m.ScopeSpecification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Session != nil {
+		{
+			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Name != nil {
+		{
+			size, err := m.Name.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Marker != nil {
+		{
+			size, err := m.Marker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryResolveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Marker != nil {
+		l = m.Marker.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Name != nil {
+		l = m.Name.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Session != nil {
+		l = m.Session.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ScopeSpecification != nil {
+		l = m.ScopeSpecification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ContractSpecification != nil {
+		l = m.ContractSpecification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordSpecification != nil {
+		l = m.RecordSpecification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ResourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Marker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Marker == nil {
+				m.Marker = &types.Any{}
+			}
+			if err := m.Marker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &types.Any{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Name == nil {
+				m.Name = &types1.NameRecord{}
+			}
+			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &types2.Scope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Session == nil {
+				m.Session = &types2.Session{}
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &types2.Record{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeSpecification == nil {
+				m.ScopeSpecification = &types2.ScopeSpecification{}
+			}
+			if err := m.ScopeSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractSpecification == nil {
+				m.ContractSpecification = &types2.ContractSpecification{}
+			}
+			if err := m.ContractSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecordSpecification == nil {
+				m.RecordSpecification = &types2.RecordSpecification{}
+			}
+			if err := m.RecordSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
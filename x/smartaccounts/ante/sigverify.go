@@ -0,0 +1,252 @@
+package ante
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// SmartAccountKeeper defines the smartaccounts functionality needed to verify signatures.
+type SmartAccountKeeper interface {
+	Authenticate(
+		ctx sdk.Context, addr sdk.AccAddress, pubKey cryptotypes.PubKey, signBytes []byte, sig []byte, msgTypeURLs []string,
+	) error
+}
+
+// authenticatedKey is the context key marking a tx whose signatures were verified by the SigVerificationDecorator.
+type authenticatedKey struct{}
+
+// SigVerificationDecorator verifies the signatures of txs where a signer used a public key that is not the key of the
+// signer's account. Those signatures are accepted only if one of the account's registered authenticators accepts them.
+// All other signatures of such a tx are verified as the SDK would, and the SDK signature decorators wrapped with
+// SkipIfAuthenticated are then skipped. Txs signed only with account keys are passed through untouched.
+//
+// CONTRACT: must run before the SDK SetPubKeyDecorator, which rejects signatures made with other public keys.
+type SigVerificationDecorator struct {
+	ak              ante.AccountKeeper
+	sak             SmartAccountKeeper
+	signModeHandler authsigning.SignModeHandler
+	sigGasConsumer  ante.SignatureVerificationGasConsumer
+}
+
+// NewSigVerificationDecorator creates a new SigVerificationDecorator
+func NewSigVerificationDecorator(
+	ak ante.AccountKeeper, sak SmartAccountKeeper,
+	signModeHandler authsigning.SignModeHandler, sigGasConsumer ante.SignatureVerificationGasConsumer,
+) SigVerificationDecorator {
+	return SigVerificationDecorator{
+		ak:              ak,
+		sak:             sak,
+		signModeHandler: signModeHandler,
+		sigGasConsumer:  sigGasConsumer,
+	}
+}
+
+var _ sdk.AnteDecorator = SigVerificationDecorator{}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// simulated txs are not signed, the SDK decorators do not verify them.
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	signers := sigTx.GetSigners()
+	if len(sigs) != len(signers) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
+	}
+	if !hasAlternateSigner(sigs, signers) {
+		return next(ctx, tx, simulate)
+	}
+
+	msgTypeURLs := make([]string, len(tx.GetMsgs()))
+	for i, msg := range tx.GetMsgs() {
+		msgTypeURLs[i] = sdk.MsgTypeURL(msg)
+	}
+	params := svd.ak.GetParams(ctx)
+	var events sdk.Events
+	for i, sig := range sigs {
+		acc, err := ante.GetSignerAcc(ctx, svd.ak, signers[i])
+		if err != nil {
+			return ctx, err
+		}
+
+		alternate := isAlternateSigner(sig, signers[i])
+		pubKey := sig.PubKey
+		if !alternate {
+			// same as the SetPubKeyDecorator, the account key is stored with the first tx it signs.
+			if acc.GetPubKey() == nil && pubKey != nil {
+				if err = acc.SetPubKey(pubKey); err != nil {
+					return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+				}
+				svd.ak.SetAccount(ctx, acc)
+			}
+			pubKey = acc.GetPubKey()
+			if pubKey == nil {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+			}
+		}
+
+		sig.PubKey = pubKey
+		if err = svd.sigGasConsumer(ctx.GasMeter(), sig, params); err != nil {
+			return ctx, err
+		}
+
+		if sig.Sequence != acc.GetSequence() {
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrWrongSequence,
+				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+			)
+		}
+
+		var accNum uint64
+		if ctx.BlockHeight() != 0 {
+			accNum = acc.GetAccountNumber()
+		}
+		signerData := authsigning.SignerData{
+			ChainID:       ctx.ChainID(),
+			AccountNumber: accNum,
+			Sequence:      acc.GetSequence(),
+		}
+
+		// no need to verify signatures on recheck tx
+		if !ctx.IsReCheckTx() {
+			if alternate {
+				err = svd.authenticate(ctx, tx, signers[i], pubKey, sig.Data, signerData, msgTypeURLs)
+			} else if err = authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx); err != nil {
+				err = sdkerrors.Wrapf(sdkerrors.ErrUnauthorized,
+					"signature verification failed; please verify account number (%d) and chain-id (%s)", accNum, ctx.ChainID())
+			}
+			if err != nil {
+				return ctx, err
+			}
+		}
+
+		sigEvents, err := signatureEvents(signers[i], sig)
+		if err != nil {
+			return ctx, err
+		}
+		events = append(events, sigEvents...)
+	}
+
+	ctx.EventManager().EmitEvents(events)
+
+	return next(ctx.WithValue(authenticatedKey{}, true), tx, simulate)
+}
+
+// authenticate asks the smartaccounts keeper to verify a signature made with a public key other than the account key.
+func (svd SigVerificationDecorator) authenticate(
+	ctx sdk.Context, tx sdk.Tx, signer sdk.AccAddress, pubKey cryptotypes.PubKey,
+	data signing.SignatureData, signerData authsigning.SignerData, msgTypeURLs []string,
+) error {
+	single, ok := data.(*signing.SingleSignatureData)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "signatures for %s made with an authenticator must be single signatures", signer)
+	}
+	signBytes, err := svd.signModeHandler.GetSignBytes(single.SignMode, signerData, tx)
+	if err != nil {
+		return err
+	}
+	return svd.sak.Authenticate(ctx, signer, pubKey, signBytes, single.Signature, msgTypeURLs)
+}
+
+// SkipIfAuthenticatedDecorator skips the wrapped decorator for txs verified by the SigVerificationDecorator.
+type SkipIfAuthenticatedDecorator struct {
+	decorator sdk.AnteDecorator
+}
+
+// SkipIfAuthenticated wraps an SDK signature decorator so that it is skipped for txs verified by the
+// SigVerificationDecorator.
+func SkipIfAuthenticated(decorator sdk.AnteDecorator) SkipIfAuthenticatedDecorator {
+	return SkipIfAuthenticatedDecorator{decorator: decorator}
+}
+
+var _ sdk.AnteDecorator = SkipIfAuthenticatedDecorator{}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (sd SkipIfAuthenticatedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if IsAuthenticated(ctx) {
+		return next(ctx, tx, simulate)
+	}
+	return sd.decorator.AnteHandle(ctx, tx, simulate, next)
+}
+
+// IsAuthenticated returns true if the signatures of the tx being processed were verified by the
+// SigVerificationDecorator.
+func IsAuthenticated(ctx sdk.Context) bool {
+	authenticated, ok := ctx.Value(authenticatedKey{}).(bool)
+	return ok && authenticated
+}
+
+// hasAlternateSigner returns true if any signature was made with a public key other than the signer's account key.
+func hasAlternateSigner(sigs []signing.SignatureV2, signers []sdk.AccAddress) bool {
+	for i, sig := range sigs {
+		if isAlternateSigner(sig, signers[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func isAlternateSigner(sig signing.SignatureV2, signer sdk.AccAddress) bool {
+	return sig.PubKey != nil && !bytes.Equal(sig.PubKey.Address(), signer)
+}
+
+// signatureEvents returns the tx events emitted by the SetPubKeyDecorator so that txs can be indexed by
+// signature and account sequence.
+func signatureEvents(signer sdk.AccAddress, sig signing.SignatureV2) (sdk.Events, error) {
+	events := sdk.Events{sdk.NewEvent(sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyAccountSequence, fmt.Sprintf("%s/%d", signer, sig.Sequence)),
+	)}
+	sigBzs, err := signatureDataToBz(sig.Data)
+	if err != nil {
+		return nil, err
+	}
+	for _, sigBz := range sigBzs {
+		events = append(events, sdk.NewEvent(sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeySignature, base64.StdEncoding.EncodeToString(sigBz)),
+		))
+	}
+	return events, nil
+}
+
+// signatureDataToBz converts signature data into raw bytes, the same as the SDK ante package.
+func signatureDataToBz(data signing.SignatureData) ([][]byte, error) {
+	switch data := data.(type) {
+	case *signing.SingleSignatureData:
+		return [][]byte{data.Signature}, nil
+	case *signing.MultiSignatureData:
+		sigs := [][]byte{}
+		for _, d := range data.Signatures {
+			nestedSigs, err := signatureDataToBz(d)
+			if err != nil {
+				return nil, err
+			}
+			sigs = append(sigs, nestedSigs...)
+		}
+		multisig := cryptotypes.MultiSignature{
+			Signatures: sigs,
+		}
+		aggregatedSig, err := multisig.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		return append(sigs, aggregatedSig), nil
+	default:
+		return nil, sdkerrors.ErrInvalidType.Wrapf("unexpected signature data type %T", data)
+	}
+}
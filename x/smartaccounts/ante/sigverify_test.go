@@ -0,0 +1,95 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/smartaccounts/ante"
+	"github.com/provenance-io/provenance/x/smartaccounts/types"
+)
+
+// signedTx builds a tx with a single message sent from the signer address and signs it with the given key.
+func signedTx(t *testing.T, txConfig client.TxConfig, ctx sdk.Context, accNum, seq uint64, signer sdk.AccAddress, key cryptotypes.PrivKey) sdk.Tx {
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(signer, signer, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1)))))
+	builder.SetGasLimit(200000)
+
+	signMode := txConfig.SignModeHandler().DefaultMode()
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   key.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: seq,
+	}))
+	signerData := authsigning.SignerData{ChainID: ctx.ChainID(), AccountNumber: accNum, Sequence: seq}
+	sig, err := tx.SignWithPrivKey(signMode, signerData, builder, key, txConfig, seq)
+	require.NoError(t, err)
+	require.NoError(t, builder.SetSignatures(sig))
+	return builder.GetTx()
+}
+
+func TestSigVerificationDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: "smartaccounts-test", Height: 2})
+	txConfig := simapp.MakeEncodingConfig().TxConfig
+
+	ownerKey := secp256k1.GenPrivKey()
+	owner := sdk.AccAddress(ownerKey.PubKey().Address())
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, owner)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	altKey := secp256k1.GenPrivKey()
+
+	decorator := ante.NewSigVerificationDecorator(
+		app.AccountKeeper, app.SmartAccountsKeeper, txConfig.SignModeHandler(), authante.DefaultSigVerificationGasConsumer,
+	)
+	var authenticated bool
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		authenticated = ante.IsAuthenticated(ctx)
+		return ctx, nil
+	}
+
+	_, err := decorator.AnteHandle(ctx, signedTx(t, txConfig, ctx, acc.GetAccountNumber(), 0, owner, ownerKey), false, next)
+	require.NoError(t, err)
+	require.False(t, authenticated, "txs signed with the account key are left to the SDK decorators")
+
+	altTx := signedTx(t, txConfig, ctx, acc.GetAccountNumber(), 0, owner, altKey)
+	_, err = decorator.AnteHandle(ctx, altTx, false, next)
+	require.ErrorIs(t, err, types.ErrUnauthorized, "no authenticator registered for the alternate key")
+
+	authenticator, err := types.NewPubKeyAuthenticator(owner, altKey.PubKey(), []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	require.NoError(t, err)
+	_, err = app.SmartAccountsKeeper.AddAuthenticator(ctx, authenticator)
+	require.NoError(t, err)
+
+	_, err = decorator.AnteHandle(ctx, altTx, false, next)
+	require.NoError(t, err)
+	require.True(t, authenticated, "txs signed with an authenticator are marked as authenticated")
+	require.Nil(t, app.AccountKeeper.GetAccount(ctx, owner).GetPubKey(), "the alternate key is not stored on the account")
+
+	_, err = decorator.AnteHandle(ctx, signedTx(t, txConfig, ctx, acc.GetAccountNumber(), 1, owner, altKey), false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+
+	_, err = decorator.AnteHandle(ctx.WithChainID("other-chain"), altTx, false, next)
+	require.ErrorIs(t, err, types.ErrUnauthorized, "the signature must be over the tx sign bytes")
+
+	skipped := ante.SkipIfAuthenticated(authante.NewSetPubKeyDecorator(app.AccountKeeper))
+	_, err = skipped.AnteHandle(ctx, altTx, false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey, "the SDK decorator rejects txs that were not authenticated")
+	_, err = decorator.AnteHandle(ctx, altTx, false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return skipped.AnteHandle(ctx, tx, simulate, next)
+	})
+	require.NoError(t, err, "the SDK decorator is skipped for authenticated txs")
+}
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/smartaccounts/types"
+)
+
+// GetQueryCmd is the top-level command for smartaccounts CLI queries.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the smartaccounts module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetParamsCmd(),
+		GetAuthenticatorsCmd(),
+	)
+
+	return queryCmd
+}
+
+// GetParamsCmd returns the command handler for smartaccounts parameter querying.
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current smartaccounts parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query smartaccounts params`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAuthenticatorsCmd returns the command handler for querying the authenticators of an account.
+func GetAuthenticatorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "authenticators [address]",
+		Aliases: []string{"auth", "a"},
+		Short:   "Query the authenticators registered for an account",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %s query smartaccounts authenticators tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("account address must be a Bech32 string: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Authenticators(context.Background(), &types.QueryAuthenticatorsRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
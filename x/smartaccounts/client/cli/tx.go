@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/smartaccounts/types"
+)
+
+// FlagMsgTypes is the flag for limiting the messages an authenticator may sign.
+const FlagMsgTypes = "msg-types"
+
+// NewTxCmd is the top-level command for smartaccounts CLI transactions.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Transaction commands for the smartaccounts module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		NewAddPubKeyAuthenticatorCmd(),
+		NewAddWasmAuthenticatorCmd(),
+		NewRemoveAuthenticatorCmd(),
+	)
+	return txCmd
+}
+
+// NewAddPubKeyAuthenticatorCmd creates a command for registering an additional public key for the from account.
+func NewAddPubKeyAuthenticatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-pubkey [pubkey]",
+		Short: "Allow an additional public key to sign transactions for the from account",
+		Long: `The public key is given in JSON, e.g. {"@type":"/cosmos.crypto.secp256k1.PubKey","key":"..."},
+as output by the keys show command.`,
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %s tx smartaccounts add-pubkey '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A1R6..."}' --%s /cosmos.bank.v1beta1.MsgSend`,
+			version.AppName, FlagMsgTypes),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err = clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return fmt.Errorf("invalid public key: %w", err)
+			}
+			msgTypes, err := cmd.Flags().GetStringSlice(FlagMsgTypes)
+			if err != nil {
+				return err
+			}
+			authenticator, err := types.NewPubKeyAuthenticator(clientCtx.GetFromAddress(), pk, msgTypes)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddAuthenticatorRequest(authenticator)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addMsgTypesFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAddWasmAuthenticatorCmd creates a command for registering a smart contract authenticator for the from account.
+func NewAddWasmAuthenticatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-wasm [contract-address]",
+		Short:   "Allow a smart contract to authenticate transactions for the from account",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %s tx smartaccounts add-wasm tp14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s96lrg8`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("contract address must be a Bech32 string: %w", err)
+			}
+			msgTypes, err := cmd.Flags().GetStringSlice(FlagMsgTypes)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddAuthenticatorRequest(types.NewWasmAuthenticator(clientCtx.GetFromAddress(), contract, msgTypes))
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addMsgTypesFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRemoveAuthenticatorCmd creates a command for removing an authenticator from the from account.
+func NewRemoveAuthenticatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove [id]",
+		Aliases: []string{"r"},
+		Short:   "Remove an authenticator from the from account",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %s tx smartaccounts remove 1`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid authenticator id: %w", err)
+			}
+
+			msg := types.NewMsgRemoveAuthenticatorRequest(clientCtx.GetFromAddress(), id)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addMsgTypesFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagMsgTypes, []string{}, "Type urls of the messages the authenticator may sign (default all messages)")
+}
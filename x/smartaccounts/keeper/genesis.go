@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/smartaccounts/types"
+)
+
+// InitGenesis creates the initial genesis state for the smartaccounts module.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	if err := data.ValidateBasic(); err != nil {
+		panic(err)
+	}
+	for _, authenticator := range data.Authenticators {
+		if err := k.importAuthenticator(ctx, authenticator); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis exports the current keeper state of the smartaccounts module.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllAuthenticators(ctx))
+}
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/provenance-io/provenance/x/smartaccounts/types"
+)
+
+// Keeper defines the smartaccounts module Keeper
+type Keeper struct {
+	// The reference to the Paramstore to get and set smartaccounts specific params
+	paramSpace paramtypes.Subspace
+
+	// Used to query smart contracts registered as authenticators.
+	wasmKeeper types.WasmKeeper
+
+	// Key to access the key-value store from sdk.Context.
+	storeKey sdk.StoreKey
+
+	// The codec codec for binary encoding/decoding.
+	cdc codec.BinaryCodec
+}
+
+// NewKeeper returns a smartaccounts keeper. It handles:
+// - registering and removing the authenticators of an account
+// - authenticating signatures made on behalf of an account
+//
+// CONTRACT: the parameter Subspace must have the param key table already initialized
+func NewKeeper(
+	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace, wasmKeeper types.WasmKeeper,
+) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:   key,
+		paramSpace: paramSpace,
+		wasmKeeper: wasmKeeper,
+		cdc:        cdc,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthenticator returns an authenticator of an account, or nil if it does not exist.
+func (k Keeper) GetAuthenticator(ctx sdk.Context, addr sdk.AccAddress, id uint64) *types.Authenticator {
+	bz := ctx.KVStore(k.storeKey).Get(types.AuthenticatorKey(addr, id))
+	if bz == nil {
+		return nil
+	}
+	var authenticator types.Authenticator
+	k.cdc.MustUnmarshal(bz, &authenticator)
+	return &authenticator
+}
+
+// GetAuthenticators returns all authenticators of an account, ordered by id.
+func (k Keeper) GetAuthenticators(ctx sdk.Context, addr sdk.AccAddress) []types.Authenticator {
+	authenticators := []types.Authenticator{}
+	k.iterateAuthenticators(ctx, types.AccountAuthenticatorsKeyPrefix(addr), func(a types.Authenticator) bool {
+		authenticators = append(authenticators, a)
+		return false
+	})
+	return authenticators
+}
+
+// GetAllAuthenticators returns the authenticators of all accounts.
+func (k Keeper) GetAllAuthenticators(ctx sdk.Context) []types.Authenticator {
+	authenticators := []types.Authenticator{}
+	k.iterateAuthenticators(ctx, types.AuthenticatorKeyPrefix, func(a types.Authenticator) bool {
+		authenticators = append(authenticators, a)
+		return false
+	})
+	return authenticators
+}
+
+// AddAuthenticator assigns the next id of the account to an authenticator and stores it.
+func (k Keeper) AddAuthenticator(ctx sdk.Context, authenticator types.Authenticator) (uint64, error) {
+	if err := authenticator.ValidateBasic(); err != nil {
+		return 0, sdkerrors.Wrap(types.ErrInvalidAuthenticator, err.Error())
+	}
+	addr, err := sdk.AccAddressFromBech32(authenticator.Address)
+	if err != nil {
+		return 0, err
+	}
+	if authenticator.AuthenticatorType == types.AuthenticatorType_AUTHENTICATOR_TYPE_WASM {
+		contract, err := sdk.AccAddressFromBech32(authenticator.ContractAddress)
+		if err != nil {
+			return 0, err
+		}
+		if k.wasmKeeper == nil || !k.wasmKeeper.HasContractInfo(ctx, contract) {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidAuthenticator, "no contract found at address \"%s\"", authenticator.ContractAddress)
+		}
+	}
+	max := k.GetMaxAuthenticators(ctx)
+	if uint32(len(k.GetAuthenticators(ctx, addr))) >= max {
+		return 0, sdkerrors.Wrapf(types.ErrTooManyAuthenticators, "account %s already has the maximum of %d", authenticator.Address, max)
+	}
+
+	authenticator.Id = k.nextAuthenticatorID(ctx, addr)
+	k.setAuthenticator(ctx, addr, authenticator)
+	k.setNextAuthenticatorID(ctx, addr, authenticator.Id+1)
+
+	return authenticator.Id, ctx.EventManager().EmitTypedEvent(types.NewEventAuthenticatorAdded(authenticator))
+}
+
+// RemoveAuthenticator removes an authenticator from an account.
+func (k Keeper) RemoveAuthenticator(ctx sdk.Context, addr sdk.AccAddress, id uint64) error {
+	if k.GetAuthenticator(ctx, addr, id) == nil {
+		return sdkerrors.Wrapf(types.ErrAuthenticatorNotFound, "no authenticator %d for account %s", id, addr)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.AuthenticatorKey(addr, id))
+	return ctx.EventManager().EmitTypedEvent(types.NewEventAuthenticatorRemoved(addr.String(), id))
+}
+
+// Authenticate returns nil if an authenticator of the account that may sign the given message types accepts the
+// signature of the sign bytes made with the public key.
+func (k Keeper) Authenticate(
+	ctx sdk.Context, addr sdk.AccAddress, pubKey cryptotypes.PubKey, signBytes []byte, sig []byte, msgTypeURLs []string,
+) error {
+	var authenticated bool
+	k.iterateAuthenticators(ctx, types.AccountAuthenticatorsKeyPrefix(addr), func(a types.Authenticator) bool {
+		if !a.AllowsMsgTypeURLs(msgTypeURLs) {
+			return false
+		}
+		switch a.AuthenticatorType {
+		case types.AuthenticatorType_AUTHENTICATOR_TYPE_PUB_KEY:
+			pk := a.GetPubKey()
+			authenticated = pk != nil && pk.Equals(pubKey) && pubKey.VerifySignature(signBytes, sig)
+		case types.AuthenticatorType_AUTHENTICATOR_TYPE_WASM:
+			valid, err := k.authenticateWithContract(ctx, a, addr, pubKey, signBytes, sig, msgTypeURLs)
+			if err != nil {
+				k.Logger(ctx).Debug("authenticator contract query failed", "contract", a.ContractAddress, "err", err)
+			}
+			authenticated = valid
+		}
+		return authenticated
+	})
+	if !authenticated {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "account %s", addr)
+	}
+	return nil
+}
+
+// authenticateWithContract queries the smart contract of a wasm authenticator and returns whether it accepts the
+// signature.
+func (k Keeper) authenticateWithContract(
+	ctx sdk.Context, a types.Authenticator,
+	addr sdk.AccAddress, pubKey cryptotypes.PubKey, signBytes []byte, sig []byte, msgTypeURLs []string,
+) (bool, error) {
+	if k.wasmKeeper == nil {
+		return false, fmt.Errorf("unable to query authenticator \"%s\"", a.ContractAddress)
+	}
+	contract, err := sdk.AccAddressFromBech32(a.ContractAddress)
+	if err != nil {
+		return false, err
+	}
+	req, err := json.Marshal(types.NewAuthenticateQuery(addr, pubKey, signBytes, sig, msgTypeURLs))
+	if err != nil {
+		return false, err
+	}
+	bz, err := k.wasmKeeper.QuerySmart(ctx, contract, req)
+	if err != nil {
+		return false, err
+	}
+	var res types.AuthenticateResponse
+	if err = json.Unmarshal(bz, &res); err != nil {
+		return false, fmt.Errorf("invalid authenticator \"%s\" response: %w", a.ContractAddress, err)
+	}
+	return res.Valid, nil
+}
+
+// importAuthenticator stores an authenticator with its existing id.
+func (k Keeper) importAuthenticator(ctx sdk.Context, authenticator types.Authenticator) error {
+	if err := authenticator.ValidateBasic(); err != nil {
+		return err
+	}
+	addr, err := sdk.AccAddressFromBech32(authenticator.Address)
+	if err != nil {
+		return err
+	}
+	k.setAuthenticator(ctx, addr, authenticator)
+	if authenticator.Id >= k.nextAuthenticatorID(ctx, addr) {
+		k.setNextAuthenticatorID(ctx, addr, authenticator.Id+1)
+	}
+	return nil
+}
+
+func (k Keeper) setAuthenticator(ctx sdk.Context, addr sdk.AccAddress, authenticator types.Authenticator) {
+	ctx.KVStore(k.storeKey).Set(types.AuthenticatorKey(addr, authenticator.Id), k.cdc.MustMarshal(&authenticator))
+}
+
+func (k Keeper) nextAuthenticatorID(ctx sdk.Context, addr sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextAuthenticatorIDKey(addr))
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextAuthenticatorID(ctx sdk.Context, addr sdk.AccAddress, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextAuthenticatorIDKey(addr), sdk.Uint64ToBigEndian(id))
+}
+
+// iterateAuthenticators calls the handler for each authenticator under the prefix until the handler returns true.
+func (k Keeper) iterateAuthenticators(ctx sdk.Context, prefix []byte, handle func(types.Authenticator) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var authenticator types.Authenticator
+		k.cdc.MustUnmarshal(iterator.Value(), &authenticator)
+		if handle(authenticator) {
+			break
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/smartaccounts/keeper"
+	"github.com/provenance-io/provenance/x/smartaccounts/types"
+)
+
+const msgSendTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+
+// mockWasmKeeper accepts signatures over the expected sign bytes sent to a single contract.
+type mockWasmKeeper struct {
+	contract sdk.AccAddress
+	expected string
+	queries  []types.AuthenticateQuery
+}
+
+func (m *mockWasmKeeper) QuerySmart(_ sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	if !contractAddr.Equals(m.contract) {
+		return nil, fmt.Errorf("unknown contract")
+	}
+	var query types.AuthenticateQuery
+	if err := json.Unmarshal(req, &query); err != nil {
+		return nil, err
+	}
+	m.queries = append(m.queries, query)
+	return json.Marshal(types.AuthenticateResponse{Valid: string(query.Authenticate.SignBytes) == m.expected})
+}
+
+func (m *mockWasmKeeper) HasContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) bool {
+	return contractAddress.Equals(m.contract)
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app    *simapp.App
+	ctx    sdk.Context
+	wasm   *mockWasmKeeper
+	keeper keeper.Keeper
+
+	user1Addr sdk.AccAddress
+	user2Addr sdk.AccAddress
+
+	altKey   *secp256k1.PrivKey
+	altPub   cryptotypes.PubKey
+	contract sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = simapp.Setup(false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
+	s.contract = sdk.AccAddress("authenticator_contract")
+	s.wasm = &mockWasmKeeper{contract: s.contract, expected: "sign me"}
+	s.keeper = keeper.NewKeeper(
+		s.app.AppCodec(), s.app.GetKey(types.StoreKey), s.app.GetSubspace(types.ModuleName), s.wasm,
+	)
+
+	s.user1Addr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.user2Addr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.altKey = secp256k1.GenPrivKey()
+	s.altPub = s.altKey.PubKey()
+}
+
+func (s *KeeperTestSuite) addPubKeyAuthenticator(addr sdk.AccAddress, msgTypeURLs ...string) uint64 {
+	authenticator, err := types.NewPubKeyAuthenticator(addr, s.altPub, msgTypeURLs)
+	s.Require().NoError(err)
+	id, err := s.keeper.AddAuthenticator(s.ctx, authenticator)
+	s.Require().NoError(err)
+	return id
+}
+
+func (s *KeeperTestSuite) TestAddRemoveAuthenticator() {
+	s.Run("ids are assigned in order per account", func() {
+		s.Require().Equal(uint64(1), s.addPubKeyAuthenticator(s.user1Addr))
+		s.Require().Equal(uint64(2), s.addPubKeyAuthenticator(s.user1Addr))
+		s.Require().Equal(uint64(1), s.addPubKeyAuthenticator(s.user2Addr))
+		s.Require().Len(s.keeper.GetAuthenticators(s.ctx, s.user1Addr), 2)
+		s.Require().Len(s.keeper.GetAllAuthenticators(s.ctx), 3)
+	})
+	s.Run("wasm authenticators must be contracts", func() {
+		_, err := s.keeper.AddAuthenticator(s.ctx, types.NewWasmAuthenticator(s.user1Addr, s.user2Addr, nil))
+		s.Require().ErrorIs(err, types.ErrInvalidAuthenticator)
+		id, err := s.keeper.AddAuthenticator(s.ctx, types.NewWasmAuthenticator(s.user1Addr, s.contract, nil))
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(3), id)
+	})
+	s.Run("removed ids are not reused", func() {
+		s.Require().NoError(s.keeper.RemoveAuthenticator(s.ctx, s.user1Addr, 3))
+		s.Require().Nil(s.keeper.GetAuthenticator(s.ctx, s.user1Addr, 3))
+		s.Require().Equal(uint64(4), s.addPubKeyAuthenticator(s.user1Addr))
+	})
+	s.Run("unknown authenticators cannot be removed", func() {
+		err := s.keeper.RemoveAuthenticator(s.ctx, s.user2Addr, 2)
+		s.Require().ErrorIs(err, types.ErrAuthenticatorNotFound)
+	})
+	s.Run("accounts are limited to the max authenticators", func() {
+		s.keeper.SetParams(s.ctx, types.NewParams(3))
+		authenticator, err := types.NewPubKeyAuthenticator(s.user1Addr, s.altPub, nil)
+		s.Require().NoError(err)
+		_, err = s.keeper.AddAuthenticator(s.ctx, authenticator)
+		s.Require().ErrorIs(err, types.ErrTooManyAuthenticators)
+	})
+}
+
+func (s *KeeperTestSuite) TestAuthenticatePubKey() {
+	signBytes := []byte("sign me")
+	sig, err := s.altKey.Sign(signBytes)
+	s.Require().NoError(err)
+
+	err = s.keeper.Authenticate(s.ctx, s.user1Addr, s.altPub, signBytes, sig, []string{msgSendTypeURL})
+	s.Require().ErrorIs(err, types.ErrUnauthorized, "no authenticators registered")
+
+	s.addPubKeyAuthenticator(s.user1Addr, "/provenance.name.v1.MsgBindNameRequest")
+	err = s.keeper.Authenticate(s.ctx, s.user1Addr, s.altPub, signBytes, sig, []string{msgSendTypeURL})
+	s.Require().ErrorIs(err, types.ErrUnauthorized, "message type not allowed")
+
+	s.addPubKeyAuthenticator(s.user1Addr, msgSendTypeURL)
+	s.Require().NoError(s.keeper.Authenticate(s.ctx, s.user1Addr, s.altPub, signBytes, sig, []string{msgSendTypeURL}))
+
+	err = s.keeper.Authenticate(s.ctx, s.user1Addr, s.altPub, []byte("other bytes"), sig, []string{msgSendTypeURL})
+	s.Require().ErrorIs(err, types.ErrUnauthorized, "signature must match the sign bytes")
+	err = s.keeper.Authenticate(s.ctx, s.user2Addr, s.altPub, signBytes, sig, []string{msgSendTypeURL})
+	s.Require().ErrorIs(err, types.ErrUnauthorized, "authenticators only sign for their account")
+}
+
+func (s *KeeperTestSuite) TestAuthenticateWasm() {
+	_, err := s.keeper.AddAuthenticator(s.ctx, types.NewWasmAuthenticator(s.user1Addr, s.contract, nil))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.keeper.Authenticate(s.ctx, s.user1Addr, s.altPub, []byte("sign me"), []byte("sig"), []string{msgSendTypeURL}))
+	s.Require().Len(s.wasm.queries, 1)
+	s.Require().Equal(s.user1Addr.String(), s.wasm.queries[0].Authenticate.Account)
+	s.Require().Equal(s.altPub.Bytes(), s.wasm.queries[0].Authenticate.PubKey)
+	s.Require().Equal([]string{msgSendTypeURL}, s.wasm.queries[0].Authenticate.MsgTypeURLs)
+
+	err = s.keeper.Authenticate(s.ctx, s.user1Addr, s.altPub, []byte("other bytes"), []byte("sig"), []string{msgSendTypeURL})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.addPubKeyAuthenticator(s.user1Addr)
+	s.addPubKeyAuthenticator(s.user1Addr, msgSendTypeURL)
+	s.Require().NoError(s.keeper.RemoveAuthenticator(s.ctx, s.user1Addr, 1))
+
+	exported := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Len(exported.Authenticators, 1)
+	s.Require().Equal(uint64(2), exported.Authenticators[0].Id)
+
+	user1Addr := s.user1Addr
+	s.SetupTest()
+	s.keeper.InitGenesis(s.ctx, exported)
+	s.Require().Equal(exported.Authenticators, s.keeper.GetAllAuthenticators(s.ctx))
+	s.Require().Equal(uint64(3), s.addPubKeyAuthenticator(user1Addr), "next id continues after imported authenticators")
+}
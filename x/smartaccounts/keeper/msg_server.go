@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/smartaccounts/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the smartaccounts MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) AddAuthenticator(goCtx context.Context, msg *types.MsgAddAuthenticatorRequest) (*types.MsgAddAuthenticatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := k.Keeper.AddAuthenticator(ctx, msg.Authenticator())
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.EventTelemetryKeyAdd},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel("type", msg.AuthenticatorType.String()),
+			},
+		)
+	}()
+
+	return &types.MsgAddAuthenticatorResponse{Id: id}, nil
+}
+
+func (k msgServer) RemoveAuthenticator(goCtx context.Context, msg *types.MsgRemoveAuthenticatorRequest) (*types.MsgRemoveAuthenticatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err = k.Keeper.RemoveAuthenticator(ctx, addr, msg.Id); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		telemetry.IncrCounter(1, types.ModuleName, types.EventTelemetryKeyRemove)
+	}()
+
+	return &types.MsgRemoveAuthenticatorResponse{}, nil
+}
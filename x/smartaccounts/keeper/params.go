@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/smartaccounts/types"
+)
+
+// GetParams returns the total set of smartaccounts parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	return types.Params{
+		MaxAuthenticators: k.GetMaxAuthenticators(ctx),
+	}
+}
+
+// SetParams sets the smartaccounts parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetMaxAuthenticators returns the current maximum number of authenticators per account (or default if unset)
+func (k Keeper) GetMaxAuthenticators(ctx sdk.Context) (max uint32) {
+	max = types.DefaultMaxAuthenticators
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyMaxAuthenticators) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyMaxAuthenticators, &max)
+	}
+	return
+}
@@ -0,0 +1,44 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/provenance-io/provenance/x/smartaccounts/types"
+)
+
+// Simulation parameter constants
+const (
+	MaxAuthenticators = "max_authenticators"
+)
+
+// GenMaxAuthenticators randomized MaxAuthenticators
+func GenMaxAuthenticators(r *rand.Rand) uint32 {
+	return uint32(r.Intn(20) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for smartaccounts
+func RandomizedGenState(simState *module.SimulationState) {
+	var maxAuthenticators uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAuthenticators, &maxAuthenticators, simState.Rand,
+		func(r *rand.Rand) { maxAuthenticators = GenMaxAuthenticators(r) },
+	)
+
+	smartAccountsGenesis := types.GenesisState{
+		Params:         types.NewParams(maxAuthenticators),
+		Authenticators: []types.Authenticator{},
+	}
+
+	bz, err := json.MarshalIndent(&smartAccountsGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated smartaccounts parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&smartAccountsGenesis)
+}
@@ -0,0 +1,30 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/provenance-io/provenance/x/smartaccounts/types"
+)
+
+const (
+	keyMaxAuthenticators = "MaxAuthenticators"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, keyMaxAuthenticators,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxAuthenticators(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = (*Authenticator)(nil)
+
+// NewPubKeyAuthenticator creates a new authenticator that accepts signatures from an additional public key.
+func NewPubKeyAuthenticator(address sdk.AccAddress, pubKey cryptotypes.PubKey, msgTypeURLs []string) (Authenticator, error) { // nolint:interfacer
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return Authenticator{}, err
+	}
+	return Authenticator{
+		Address:           address.String(),
+		AuthenticatorType: AuthenticatorType_AUTHENTICATOR_TYPE_PUB_KEY,
+		PubKey:            pkAny,
+		MsgTypeUrls:       msgTypeURLs,
+	}, nil
+}
+
+// NewWasmAuthenticator creates a new authenticator that delegates signature verification to a smart contract.
+func NewWasmAuthenticator(address sdk.AccAddress, contract sdk.AccAddress, msgTypeURLs []string) Authenticator { // nolint:interfacer
+	return Authenticator{
+		Address:           address.String(),
+		AuthenticatorType: AuthenticatorType_AUTHENTICATOR_TYPE_WASM,
+		ContractAddress:   contract.String(),
+		MsgTypeUrls:       msgTypeURLs,
+	}
+}
+
+// ValidateBasic performs basic format checking of the authenticator.
+func (a Authenticator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return fmt.Errorf("invalid authenticator address: %w", err)
+	}
+	return validateAuthenticatorFields(a.AuthenticatorType, a.PubKey, a.ContractAddress, a.MsgTypeUrls)
+}
+
+// GetPubKey returns the public key of a pub key authenticator, or nil if it does not have one.
+func (a Authenticator) GetPubKey() cryptotypes.PubKey {
+	if a.PubKey == nil {
+		return nil
+	}
+	pk, ok := a.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil
+	}
+	return pk
+}
+
+// AllowsMsgTypeURLs returns true if the authenticator may sign for all of the given message types.
+func (a Authenticator) AllowsMsgTypeURLs(msgTypeURLs []string) bool {
+	if len(a.MsgTypeUrls) == 0 {
+		return true
+	}
+	allowed := make(map[string]bool, len(a.MsgTypeUrls))
+	for _, url := range a.MsgTypeUrls {
+		allowed[url] = true
+	}
+	for _, url := range msgTypeURLs {
+		if !allowed[url] {
+			return false
+		}
+	}
+	return true
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a Authenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if a.PubKey == nil {
+		return nil
+	}
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(a.PubKey, &pk)
+}
+
+// validateAuthenticatorFields checks that the fields required by the authenticator type are set.
+func validateAuthenticatorFields(authType AuthenticatorType, pubKey *codectypes.Any, contract string, msgTypeURLs []string) error {
+	switch authType {
+	case AuthenticatorType_AUTHENTICATOR_TYPE_PUB_KEY:
+		if pubKey == nil {
+			return fmt.Errorf("pub key authenticator must have a pub key")
+		}
+		if len(contract) > 0 {
+			return fmt.Errorf("pub key authenticator cannot have a contract address")
+		}
+	case AuthenticatorType_AUTHENTICATOR_TYPE_WASM:
+		if pubKey != nil {
+			return fmt.Errorf("wasm authenticator cannot have a pub key")
+		}
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return fmt.Errorf("invalid wasm authenticator contract address: %w", err)
+		}
+	default:
+		return fmt.Errorf("invalid authenticator type: %s", authType)
+	}
+	for _, url := range msgTypeURLs {
+		if len(strings.TrimSpace(url)) == 0 {
+			return fmt.Errorf("authenticator msg type urls cannot be blank")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/smartaccounts module errors
+var (
+	// ErrInvalidAuthenticator occurs when an authenticator is not valid
+	ErrInvalidAuthenticator = sdkerrors.Register(ModuleName, 2, "invalid authenticator")
+	// ErrAuthenticatorNotFound occurs when an authenticator does not exist for an account
+	ErrAuthenticatorNotFound = sdkerrors.Register(ModuleName, 3, "authenticator not found")
+	// ErrTooManyAuthenticators occurs when an account would exceed the maximum number of authenticators
+	ErrTooManyAuthenticators = sdkerrors.Register(ModuleName, 4, "too many authenticators")
+	// ErrUnauthorized occurs when no authenticator of an account accepts a signature
+	ErrUnauthorized = sdkerrors.Register(ModuleName, 5, "signature not accepted by any authenticator")
+)
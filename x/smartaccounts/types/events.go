@@ -0,0 +1,25 @@
+package types
+
+const (
+	// EventTelemetryKeyAdd add telemetry metrics key
+	EventTelemetryKeyAdd string = "add"
+	// EventTelemetryKeyRemove remove telemetry metrics key
+	EventTelemetryKeyRemove string = "remove"
+)
+
+// NewEventAuthenticatorAdded returns a new instance of EventAuthenticatorAdded
+func NewEventAuthenticatorAdded(authenticator Authenticator) *EventAuthenticatorAdded {
+	return &EventAuthenticatorAdded{
+		Address:           authenticator.Address,
+		Id:                authenticator.Id,
+		AuthenticatorType: authenticator.AuthenticatorType.String(),
+	}
+}
+
+// NewEventAuthenticatorRemoved returns a new instance of EventAuthenticatorRemoved
+func NewEventAuthenticatorRemoved(address string, id uint64) *EventAuthenticatorRemoved {
+	return &EventAuthenticatorRemoved{
+		Address: address,
+		Id:      id,
+	}
+}
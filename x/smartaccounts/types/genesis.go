@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = (*GenesisState)(nil)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, authenticators []Authenticator) *GenesisState {
+	return &GenesisState{
+		Params:         params,
+		Authenticators: authenticators,
+	}
+}
+
+// ValidateBasic ensures a genesis state is valid.
+func (state GenesisState) ValidateBasic() error {
+	seen := make(map[string]bool, len(state.Authenticators))
+	for _, a := range state.Authenticators {
+		if err := a.ValidateBasic(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%d", a.Address, a.Id)
+		if seen[key] {
+			return fmt.Errorf("duplicate authenticator %d for address %s", a.Id, a.Address)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (state GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for i := range state.Authenticators {
+		if err := state.Authenticators[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DefaultGenesisState returns the default module state at genesis.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:         DefaultParams(),
+		Authenticators: []Authenticator{},
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "smartaccounts"
+
+	// StoreKey is the store key string for smartaccounts
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for smartaccounts
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for smartaccounts
+	QuerierRoute = ModuleName
+)
+
+var (
+	// AuthenticatorKeyPrefix is a prefix added to keys for storing the authenticators of an account.
+	AuthenticatorKeyPrefix = []byte{0x01}
+	// NextAuthenticatorIDKeyPrefix is a prefix added to keys for storing the next authenticator id of an account.
+	NextAuthenticatorIDKeyPrefix = []byte{0x02}
+)
+
+// AccountAuthenticatorsKeyPrefix returns the key prefix for all authenticators of an account.
+func AccountAuthenticatorsKeyPrefix(addr sdk.AccAddress) []byte {
+	return append(AuthenticatorKeyPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// AuthenticatorKey returns the key for an authenticator of an account.
+func AuthenticatorKey(addr sdk.AccAddress, id uint64) []byte {
+	return append(AccountAuthenticatorsKeyPrefix(addr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetAuthenticatorIDFromKey returns the authenticator id at the end of an authenticator key.
+func GetAuthenticatorIDFromKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// NextAuthenticatorIDKey returns the key for the next authenticator id of an account.
+func NextAuthenticatorIDKey(addr sdk.AccAddress) []byte {
+	return append(NextAuthenticatorIDKeyPrefix, address.MustLengthPrefix(addr)...)
+}
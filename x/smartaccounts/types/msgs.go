@@ -0,0 +1,122 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TypeMsgAddAuthenticator    = "add_authenticator"
+	TypeMsgRemoveAuthenticator = "remove_authenticator"
+)
+
+// Compile time interface checks.
+var (
+	_ sdk.Msg                            = &MsgAddAuthenticatorRequest{}
+	_ sdk.Msg                            = &MsgRemoveAuthenticatorRequest{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgAddAuthenticatorRequest)(nil)
+)
+
+// NewMsgAddAuthenticatorRequest creates a new add authenticator message
+func NewMsgAddAuthenticatorRequest(authenticator Authenticator) *MsgAddAuthenticatorRequest {
+	return &MsgAddAuthenticatorRequest{
+		Address:           authenticator.Address,
+		AuthenticatorType: authenticator.AuthenticatorType,
+		PubKey:            authenticator.PubKey,
+		ContractAddress:   authenticator.ContractAddress,
+		MsgTypeUrls:       authenticator.MsgTypeUrls,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgAddAuthenticatorRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the message action.
+func (msg MsgAddAuthenticatorRequest) Type() string { return TypeMsgAddAuthenticator }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgAddAuthenticatorRequest) ValidateBasic() error {
+	if len(msg.Address) == 0 {
+		return fmt.Errorf("empty address")
+	}
+	return msg.Authenticator().ValidateBasic()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAddAuthenticatorRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the account.
+func (msg MsgAddAuthenticatorRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(fmt.Errorf("invalid address value on message: %w", err))
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// Authenticator returns the authenticator to register, without an id.
+func (msg MsgAddAuthenticatorRequest) Authenticator() Authenticator {
+	return Authenticator{
+		Address:           msg.Address,
+		AuthenticatorType: msg.AuthenticatorType,
+		PubKey:            msg.PubKey,
+		ContractAddress:   msg.ContractAddress,
+		MsgTypeUrls:       msg.MsgTypeUrls,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgAddAuthenticatorRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if msg.PubKey == nil {
+		return nil
+	}
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.PubKey, &pk)
+}
+
+// NewMsgRemoveAuthenticatorRequest creates a new remove authenticator message
+func NewMsgRemoveAuthenticatorRequest(address sdk.AccAddress, id uint64) *MsgRemoveAuthenticatorRequest { // nolint:interfacer
+	return &MsgRemoveAuthenticatorRequest{Address: address.String(), Id: id}
+}
+
+// Route returns the name of the module.
+func (msg MsgRemoveAuthenticatorRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the message action.
+func (msg MsgRemoveAuthenticatorRequest) Type() string { return TypeMsgRemoveAuthenticator }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRemoveAuthenticatorRequest) ValidateBasic() error {
+	if len(msg.Address) == 0 {
+		return fmt.Errorf("empty address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRemoveAuthenticatorRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the account.
+func (msg MsgRemoveAuthenticatorRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(fmt.Errorf("invalid address value on message: %w", err))
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgAddAuthenticatorValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	contract := sdk.AccAddress("authenticator_contract")
+	pubKeyAuthenticator, err := NewPubKeyAuthenticator(addr, secp256k1.GenPrivKey().PubKey(), nil)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name   string
+		msg    *MsgAddAuthenticatorRequest
+		errMsg string
+	}{
+		{"pub key authenticator", NewMsgAddAuthenticatorRequest(pubKeyAuthenticator), ""},
+		{"wasm authenticator", NewMsgAddAuthenticatorRequest(NewWasmAuthenticator(addr, contract, []string{"/cosmos.bank.v1beta1.MsgSend"})), ""},
+		{"empty address", &MsgAddAuthenticatorRequest{}, "empty address"},
+		{
+			"unspecified type",
+			&MsgAddAuthenticatorRequest{Address: addr.String()},
+			"invalid authenticator type: AUTHENTICATOR_TYPE_UNSPECIFIED",
+		},
+		{
+			"pub key authenticator without a key",
+			&MsgAddAuthenticatorRequest{Address: addr.String(), AuthenticatorType: AuthenticatorType_AUTHENTICATOR_TYPE_PUB_KEY},
+			"pub key authenticator must have a pub key",
+		},
+		{
+			"wasm authenticator with a key",
+			&MsgAddAuthenticatorRequest{Address: addr.String(), AuthenticatorType: AuthenticatorType_AUTHENTICATOR_TYPE_WASM, PubKey: pubKeyAuthenticator.PubKey, ContractAddress: contract.String()},
+			"wasm authenticator cannot have a pub key",
+		},
+		{
+			"blank msg type url",
+			NewMsgAddAuthenticatorRequest(NewWasmAuthenticator(addr, contract, []string{" "})),
+			"authenticator msg type urls cannot be blank",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errMsg) == 0 {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{addr}, tc.msg.GetSigners())
+			} else {
+				require.EqualError(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestAuthenticatorAllowsMsgTypeURLs(t *testing.T) {
+	all := Authenticator{}
+	require.True(t, all.AllowsMsgTypeURLs([]string{"/a", "/b"}))
+
+	scoped := Authenticator{MsgTypeUrls: []string{"/a", "/b"}}
+	require.True(t, scoped.AllowsMsgTypeURLs([]string{"/a", "/b", "/a"}))
+	require.False(t, scoped.AllowsMsgTypeURLs([]string{"/a", "/c"}))
+}
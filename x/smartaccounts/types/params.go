@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Default parameter namespace
+const (
+	DefaultMaxAuthenticators = uint32(10)
+)
+
+// Parameter store keys
+var (
+	ParamStoreKeyMaxAuthenticators = []byte("MaxAuthenticators")
+)
+
+// String implements stringer interface
+func (params Params) String() string {
+	out, _ := yaml.Marshal(params)
+	return string(out)
+}
+
+// ParamKeyTable for smartaccounts module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams create a new Params object
+func NewParams(maxAuthenticators uint32) Params {
+	return Params{
+		MaxAuthenticators: maxAuthenticators,
+	}
+}
+
+// ParamSetPairs - Implements params.ParamSet
+func (params *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxAuthenticators, &params.MaxAuthenticators, validateMaxAuthenticators),
+	}
+}
+
+// DefaultParams defines the parameters for this module
+func DefaultParams() Params {
+	return NewParams(DefaultMaxAuthenticators)
+}
+
+func validateMaxAuthenticators(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,1194 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/smartaccounts/v1/smartaccounts.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AuthenticatorType defines how an authenticator verifies the signature on a transaction.
+type AuthenticatorType int32
+
+const (
+	// AUTHENTICATOR_TYPE_UNSPECIFIED is an error condition
+	AuthenticatorType_AUTHENTICATOR_TYPE_UNSPECIFIED AuthenticatorType = 0
+	// AUTHENTICATOR_TYPE_PUB_KEY verifies the signature against an additional public key
+	AuthenticatorType_AUTHENTICATOR_TYPE_PUB_KEY AuthenticatorType = 1
+	// AUTHENTICATOR_TYPE_WASM delegates verification of the signature to a smart contract
+	AuthenticatorType_AUTHENTICATOR_TYPE_WASM AuthenticatorType = 2
+)
+
+var AuthenticatorType_name = map[int32]string{
+	0: "AUTHENTICATOR_TYPE_UNSPECIFIED",
+	1: "AUTHENTICATOR_TYPE_PUB_KEY",
+	2: "AUTHENTICATOR_TYPE_WASM",
+}
+
+var AuthenticatorType_value = map[string]int32{
+	"AUTHENTICATOR_TYPE_UNSPECIFIED": 0,
+	"AUTHENTICATOR_TYPE_PUB_KEY":     1,
+	"AUTHENTICATOR_TYPE_WASM":        2,
+}
+
+func (x AuthenticatorType) String() string {
+	return proto.EnumName(AuthenticatorType_name, int32(x))
+}
+
+func (AuthenticatorType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2f5578269ecd8ae0, []int{0}
+}
+
+// Params defines the set of params for the smartaccounts module.
+type Params struct {
+	// maximum number of authenticators an account may register
+	MaxAuthenticators uint32 `protobuf:"varint,1,opt,name=max_authenticators,json=maxAuthenticators,proto3" json:"max_authenticators,omitempty"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f5578269ecd8ae0, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxAuthenticators() uint32 {
+	if m != nil {
+		return m.MaxAuthenticators
+	}
+	return 0
+}
+
+// Authenticator is an alternative method of authenticating transactions signed on behalf of an account.
+type Authenticator struct {
+	// the id of the authenticator, unique for the account
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the account the authenticator signs for
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// the type of the authenticator
+	AuthenticatorType AuthenticatorType `protobuf:"varint,3,opt,name=authenticator_type,json=authenticatorType,proto3,enum=provenance.smartaccounts.v1.AuthenticatorType" json:"authenticator_type,omitempty"`
+	// the public key allowed to sign for the account, set for pub key authenticators
+	PubKey *types.Any `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// the address of the smart contract that verifies signatures, set for wasm authenticators
+	ContractAddress string `protobuf:"bytes,5,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// the type urls of the messages the authenticator may sign, empty for all messages
+	MsgTypeUrls []string `protobuf:"bytes,6,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (m *Authenticator) Reset()         { *m = Authenticator{} }
+func (m *Authenticator) String() string { return proto.CompactTextString(m) }
+func (*Authenticator) ProtoMessage()    {}
+func (*Authenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f5578269ecd8ae0, []int{1}
+}
+func (m *Authenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Authenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Authenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Authenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Authenticator.Merge(m, src)
+}
+func (m *Authenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Authenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Authenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Authenticator proto.InternalMessageInfo
+
+// EventAuthenticatorAdded is emitted when an authenticator is registered for an account.
+type EventAuthenticatorAdded struct {
+	Address           string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Id                uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	AuthenticatorType string `protobuf:"bytes,3,opt,name=authenticator_type,json=authenticatorType,proto3" json:"authenticator_type,omitempty"`
+}
+
+func (m *EventAuthenticatorAdded) Reset()         { *m = EventAuthenticatorAdded{} }
+func (m *EventAuthenticatorAdded) String() string { return proto.CompactTextString(m) }
+func (*EventAuthenticatorAdded) ProtoMessage()    {}
+func (*EventAuthenticatorAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f5578269ecd8ae0, []int{2}
+}
+func (m *EventAuthenticatorAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAuthenticatorAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAuthenticatorAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAuthenticatorAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAuthenticatorAdded.Merge(m, src)
+}
+func (m *EventAuthenticatorAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAuthenticatorAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAuthenticatorAdded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAuthenticatorAdded proto.InternalMessageInfo
+
+func (m *EventAuthenticatorAdded) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventAuthenticatorAdded) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventAuthenticatorAdded) GetAuthenticatorType() string {
+	if m != nil {
+		return m.AuthenticatorType
+	}
+	return ""
+}
+
+// EventAuthenticatorRemoved is emitted when an authenticator is removed from an account.
+type EventAuthenticatorRemoved struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *EventAuthenticatorRemoved) Reset()         { *m = EventAuthenticatorRemoved{} }
+func (m *EventAuthenticatorRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventAuthenticatorRemoved) ProtoMessage()    {}
+func (*EventAuthenticatorRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f5578269ecd8ae0, []int{3}
+}
+func (m *EventAuthenticatorRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAuthenticatorRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAuthenticatorRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAuthenticatorRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAuthenticatorRemoved.Merge(m, src)
+}
+func (m *EventAuthenticatorRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAuthenticatorRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAuthenticatorRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAuthenticatorRemoved proto.InternalMessageInfo
+
+func (m *EventAuthenticatorRemoved) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventAuthenticatorRemoved) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("provenance.smartaccounts.v1.AuthenticatorType", AuthenticatorType_name, AuthenticatorType_value)
+	proto.RegisterType((*Params)(nil), "provenance.smartaccounts.v1.Params")
+	proto.RegisterType((*Authenticator)(nil), "provenance.smartaccounts.v1.Authenticator")
+	proto.RegisterType((*EventAuthenticatorAdded)(nil), "provenance.smartaccounts.v1.EventAuthenticatorAdded")
+	proto.RegisterType((*EventAuthenticatorRemoved)(nil), "provenance.smartaccounts.v1.EventAuthenticatorRemoved")
+}
+
+func init() {
+	proto.RegisterFile("provenance/smartaccounts/v1/smartaccounts.proto", fileDescriptor_2f5578269ecd8ae0)
+}
+
+var fileDescriptor_2f5578269ecd8ae0 = []byte{
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0xeb, 0xac, 0x74, 0x9a, 0xa7, 0x8e, 0xd6, 0xaa, 0xb4, 0xb4, 0x93, 0x42, 0xd5, 0x53,
+	0x41, 0xaa, 0xa3, 0x8d, 0x13, 0x93, 0x38, 0xa4, 0x23, 0x40, 0x35, 0x31, 0xa2, 0xac, 0x15, 0x1a,
+	0x12, 0x8a, 0xdc, 0xc4, 0x64, 0x11, 0x4d, 0x1c, 0xd9, 0x4e, 0xd5, 0xbc, 0x01, 0x47, 0x8e, 0x1c,
+	0x79, 0x08, 0xc4, 0x33, 0x20, 0x4e, 0x3b, 0x72, 0x44, 0xed, 0x8b, 0xa0, 0x26, 0x2d, 0x2c, 0x5b,
+	0x99, 0xc4, 0x29, 0xf9, 0xfe, 0x7f, 0x7f, 0xfa, 0xf2, 0xff, 0xc5, 0x1f, 0xd4, 0x63, 0xce, 0xa6,
+	0x34, 0x22, 0x91, 0x4b, 0x75, 0x11, 0x12, 0x2e, 0x89, 0xeb, 0xb2, 0x24, 0x92, 0x42, 0x9f, 0x1e,
+	0x16, 0x05, 0x1c, 0x73, 0x26, 0x19, 0x3a, 0xf8, 0xdb, 0x80, 0x8b, 0xfe, 0xf4, 0xb0, 0xd5, 0x74,
+	0x99, 0x08, 0x99, 0x70, 0xb2, 0xa3, 0x7a, 0x5e, 0xe4, 0x7d, 0xad, 0x86, 0xcf, 0x7c, 0x96, 0xeb,
+	0xcb, 0xb7, 0x95, 0xda, 0xf4, 0x19, 0xf3, 0x27, 0x54, 0xcf, 0xaa, 0x71, 0xf2, 0x5e, 0x27, 0x51,
+	0x9a, 0x5b, 0x9d, 0xa7, 0xb0, 0x62, 0x11, 0x4e, 0x42, 0x81, 0x7a, 0x10, 0x85, 0x64, 0xe6, 0x90,
+	0x44, 0x5e, 0xd2, 0x48, 0x06, 0x2e, 0x91, 0x8c, 0x0b, 0x15, 0xb4, 0x41, 0xb7, 0x6a, 0xd7, 0x43,
+	0x32, 0x33, 0x0a, 0xc6, 0x71, 0xf9, 0xf3, 0x97, 0x07, 0xa5, 0xce, 0x37, 0x05, 0x56, 0x0b, 0x06,
+	0xda, 0x83, 0x4a, 0xe0, 0x65, 0x6d, 0x65, 0x5b, 0x09, 0x3c, 0xa4, 0xc2, 0x6d, 0xe2, 0x79, 0x9c,
+	0x0a, 0xa1, 0x2a, 0x6d, 0xd0, 0xdd, 0xb1, 0xd7, 0x25, 0x7a, 0x07, 0x51, 0x61, 0x98, 0x23, 0xd3,
+	0x98, 0xaa, 0x5b, 0x6d, 0xd0, 0xdd, 0x3b, 0xc2, 0xf8, 0x0e, 0x00, 0xb8, 0x30, 0x71, 0x98, 0xc6,
+	0xd4, 0xae, 0x93, 0x9b, 0x12, 0x7a, 0x01, 0xb7, 0xe3, 0x64, 0xec, 0x7c, 0xa0, 0xa9, 0x5a, 0x6e,
+	0x83, 0xee, 0xee, 0x51, 0x03, 0xe7, 0x18, 0xf0, 0x1a, 0x03, 0x36, 0xa2, 0xb4, 0xaf, 0xfe, 0xf8,
+	0xda, 0x6b, 0xac, 0x18, 0xba, 0x3c, 0x8d, 0x25, 0xc3, 0x56, 0x32, 0x3e, 0xa5, 0xa9, 0x5d, 0x89,
+	0xb3, 0x27, 0x7a, 0x08, 0x6b, 0x2e, 0x8b, 0x24, 0x27, 0xae, 0x74, 0xd6, 0x51, 0xee, 0x65, 0x51,
+	0xee, 0xaf, 0x75, 0x63, 0x15, 0xa9, 0x03, 0xab, 0xa1, 0xf0, 0xb3, 0x20, 0x4e, 0xc2, 0x27, 0x42,
+	0xad, 0xb4, 0xb7, 0xba, 0x3b, 0xf6, 0x6e, 0x28, 0xfc, 0xe5, 0x37, 0x8d, 0xf8, 0x44, 0x1c, 0x97,
+	0x3f, 0x2e, 0xc1, 0x71, 0xb8, 0x6f, 0x4e, 0x69, 0x24, 0x0b, 0x51, 0x0c, 0xcf, 0xa3, 0x05, 0x62,
+	0xa0, 0x48, 0x2c, 0x67, 0xab, 0xfc, 0x61, 0xdb, 0xfb, 0x27, 0xc1, 0x9d, 0x0d, 0x44, 0x3a, 0x26,
+	0x6c, 0xde, 0x9e, 0x69, 0xd3, 0x90, 0x4d, 0xff, 0x67, 0xea, 0x23, 0x09, 0xeb, 0xb7, 0x7e, 0x00,
+	0xea, 0x40, 0xcd, 0x18, 0x0d, 0x5f, 0x9a, 0x67, 0xc3, 0xc1, 0x89, 0x31, 0x7c, 0x6d, 0x3b, 0xc3,
+	0x0b, 0xcb, 0x74, 0x46, 0x67, 0xe7, 0x96, 0x79, 0x32, 0x78, 0x3e, 0x30, 0x9f, 0xd5, 0x4a, 0x48,
+	0x83, 0xad, 0x0d, 0x67, 0xac, 0x51, 0xdf, 0x39, 0x35, 0x2f, 0x6a, 0x00, 0x1d, 0xc0, 0xfd, 0x0d,
+	0xfe, 0x1b, 0xe3, 0xfc, 0x55, 0x4d, 0xe9, 0x27, 0xdf, 0xe7, 0x1a, 0xb8, 0x9a, 0x6b, 0xe0, 0xd7,
+	0x5c, 0x03, 0x9f, 0x16, 0x5a, 0xe9, 0x6a, 0xa1, 0x95, 0x7e, 0x2e, 0xb4, 0x12, 0xd4, 0x02, 0x76,
+	0xd7, 0x6d, 0xb1, 0xc0, 0xdb, 0x27, 0x7e, 0x20, 0x2f, 0x93, 0x31, 0x76, 0x59, 0x78, 0x6d, 0x13,
+	0x7b, 0x01, 0xbb, 0x56, 0xe9, 0xb3, 0x1b, 0x9b, 0xb9, 0x64, 0x29, 0xc6, 0x95, 0xec, 0xb2, 0x3c,
+	0xfe, 0x3d, 0x00, 0xb8, 0x98, 0x38, 0x8a, 0xc2, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAuthenticators != 0 {
+		i = encodeVarintSmartaccounts(dAtA, i, uint64(m.MaxAuthenticators))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Authenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Authenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Authenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintSmartaccounts(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSmartaccounts(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSmartaccounts(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AuthenticatorType != 0 {
+		i = encodeVarintSmartaccounts(dAtA, i, uint64(m.AuthenticatorType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSmartaccounts(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSmartaccounts(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAuthenticatorAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAuthenticatorAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAuthenticatorAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuthenticatorType) > 0 {
+		i -= len(m.AuthenticatorType)
+		copy(dAtA[i:], m.AuthenticatorType)
+		i = encodeVarintSmartaccounts(dAtA, i, uint64(len(m.AuthenticatorType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintSmartaccounts(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSmartaccounts(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAuthenticatorRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAuthenticatorRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAuthenticatorRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintSmartaccounts(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSmartaccounts(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSmartaccounts(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSmartaccounts(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAuthenticators != 0 {
+		n += 1 + sovSmartaccounts(uint64(m.MaxAuthenticators))
+	}
+	return n
+}
+
+func (m *Authenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSmartaccounts(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSmartaccounts(uint64(l))
+	}
+	if m.AuthenticatorType != 0 {
+		n += 1 + sovSmartaccounts(uint64(m.AuthenticatorType))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovSmartaccounts(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSmartaccounts(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovSmartaccounts(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventAuthenticatorAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSmartaccounts(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovSmartaccounts(uint64(m.Id))
+	}
+	l = len(m.AuthenticatorType)
+	if l > 0 {
+		n += 1 + l + sovSmartaccounts(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAuthenticatorRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSmartaccounts(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovSmartaccounts(uint64(m.Id))
+	}
+	return n
+}
+
+func sovSmartaccounts(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSmartaccounts(x uint64) (n int) {
+	return sovSmartaccounts(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSmartaccounts
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAuthenticators", wireType)
+			}
+			m.MaxAuthenticators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAuthenticators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSmartaccounts(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Authenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSmartaccounts
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Authenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Authenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorType", wireType)
+			}
+			m.AuthenticatorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticatorType |= AuthenticatorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSmartaccounts(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAuthenticatorAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSmartaccounts
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAuthenticatorAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAuthenticatorAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSmartaccounts(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAuthenticatorRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSmartaccounts
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAuthenticatorRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAuthenticatorRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSmartaccounts(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSmartaccounts
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSmartaccounts(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSmartaccounts
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSmartaccounts
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSmartaccounts
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSmartaccounts
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSmartaccounts
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSmartaccounts
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSmartaccounts        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSmartaccounts          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSmartaccounts = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,42 @@
+package types
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AuthenticateQuery is the smart query sent to an authenticator contract to verify the signature on a transaction.
+type AuthenticateQuery struct {
+	Authenticate AuthenticateParams `json:"authenticate"`
+}
+
+// AuthenticateParams are the signature details sent to an authenticator contract.  Byte values are base64 encoded.
+type AuthenticateParams struct {
+	Account     string   `json:"account"`
+	PubKey      []byte   `json:"pub_key"`
+	PubKeyType  string   `json:"pub_key_type"`
+	SignBytes   []byte   `json:"sign_bytes"`
+	Signature   []byte   `json:"signature"`
+	MsgTypeURLs []string `json:"msg_type_urls"`
+}
+
+// AuthenticateResponse is the response expected from an authenticator contract.
+type AuthenticateResponse struct {
+	Valid bool `json:"valid"`
+}
+
+// NewAuthenticateQuery creates the authenticator contract query for a signature
+func NewAuthenticateQuery(
+	account sdk.AccAddress, pubKey cryptotypes.PubKey, signBytes []byte, signature []byte, msgTypeURLs []string,
+) AuthenticateQuery {
+	return AuthenticateQuery{
+		Authenticate: AuthenticateParams{
+			Account:     account.String(),
+			PubKey:      pubKey.Bytes(),
+			PubKeyType:  pubKey.Type(),
+			SignBytes:   signBytes,
+			Signature:   signature,
+			MsgTypeURLs: msgTypeURLs,
+		},
+	}
+}